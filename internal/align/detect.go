@@ -0,0 +1,90 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package align
+
+import (
+	"strings"
+
+	cfgAlign "github.com/ActiveMemory/ctx/internal/config/align"
+	"github.com/ActiveMemory/ctx/internal/config/regex"
+	"github.com/ActiveMemory/ctx/internal/entity"
+)
+
+// Detect finds the course corrections in one session, in message
+// order. A correction is a user turn that follows:
+//
+//   - an interrupt marker ("[Request interrupted by user]"); text
+//     after the marker, or the next user turn, is the intent
+//   - a rejected tool use; feedback typed with the rejection, or
+//     the next user turn, is the intent
+//   - an assistant turn, and opens with correction wording
+//
+// The diverging assumption is the assistant turn the user cut off.
+//
+// Parameters:
+//   - s: the parsed session
+//   - scrub: redacts secrets from quoted text
+//
+// Returns:
+//   - []Candidate: one candidate per correction (nil when none)
+func Detect(s *entity.Session, scrub func(string) string) []Candidate {
+	var (
+		out      []Candidate
+		last     *entity.Message
+		signal   string
+		cutShort *entity.Message
+	)
+	for i := range s.Messages {
+		m := &s.Messages[i]
+		if m.BelongsToAssistant() {
+			if m.Text != "" || m.UsesTools() {
+				last = m
+			}
+			continue
+		}
+		if !m.BelongsToUser() {
+			continue
+		}
+
+		if said, rejected := rejection(m); rejected {
+			if said != "" {
+				out = append(out, candidate(
+					s, i, m, last, said, cfgAlign.SignalRejected, scrub,
+				))
+				signal, last = "", nil
+				continue
+			}
+			signal, cutShort = cfgAlign.SignalRejected, last
+		}
+
+		text := m.Text
+		if loc := regex.AlignInterrupt.FindStringIndex(text); loc != nil {
+			if signal == "" {
+				signal, cutShort = cfgAlign.SignalInterrupt, last
+			}
+			text = text[loc[1]:]
+		}
+		text = strings.TrimSpace(text)
+		if text == "" || regex.AlignSkip.MatchString(text) {
+			continue
+		}
+
+		switch {
+		case signal != "":
+			out = append(out, candidate(
+				s, i, m, cutShort, text, signal, scrub,
+			))
+			signal = ""
+		case last != nil && regex.AlignCorrection.MatchString(text):
+			out = append(out, candidate(
+				s, i, m, last, text, cfgAlign.SignalPhrase, scrub,
+			))
+		}
+		last = nil
+	}
+	return out
+}
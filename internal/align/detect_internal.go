@@ -0,0 +1,147 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package align
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	cfgAlign "github.com/ActiveMemory/ctx/internal/config/align"
+	"github.com/ActiveMemory/ctx/internal/config/embed/text"
+	cfgEntry "github.com/ActiveMemory/ctx/internal/config/entry"
+	"github.com/ActiveMemory/ctx/internal/config/regex"
+	cfgTime "github.com/ActiveMemory/ctx/internal/config/time"
+	"github.com/ActiveMemory/ctx/internal/config/token"
+	"github.com/ActiveMemory/ctx/internal/entity"
+)
+
+// rejection reports whether a user turn rejects a tool use, and the
+// feedback the user typed with the rejection.
+//
+// Parameters:
+//   - m: a user message
+//
+// Returns:
+//   - string: the typed feedback (empty when there is none)
+//   - bool: true when a tool result records a rejection
+func rejection(m *entity.Message) (string, bool) {
+	for _, r := range m.ToolResults {
+		if !r.IsError || !regex.AlignRejected.MatchString(r.Content) {
+			continue
+		}
+		said := regex.AlignRejectedSaid.FindStringSubmatch(r.Content)
+		if said == nil {
+			return "", true
+		}
+		return strings.TrimSpace(said[1]), true
+	}
+	return "", false
+}
+
+// candidate fills a Candidate for one correction. Intent worded as
+// a standing rule makes a convention; anything else a learning.
+//
+// Parameters:
+//   - s: the source session
+//   - i: the correcting message's position in the session
+//   - m: the correcting message
+//   - from: the assistant turn that was corrected (nil if none)
+//   - intent: the user's restated intent
+//   - signal: the signal that found the correction
+//   - scrub: redacts secrets from quoted text
+//
+// Returns:
+//   - Candidate: the proposed entry
+func candidate(
+	s *entity.Session, i int, m, from *entity.Message,
+	intent, signal string, scrub func(string) string,
+) Candidate {
+	intent = clip(scrub(intent))
+	assumed := clip(scrub(assumption(from)))
+
+	kind, signals := cfgEntry.Learning, []string{signal}
+	if regex.AlignRule.MatchString(intent) {
+		kind = cfgEntry.Convention
+		signals = append(signals, cfgAlign.SignalRule)
+	}
+
+	when := m.Timestamp
+	if when.IsZero() {
+		when = s.StartTime
+	}
+	date := when.Format(cfgTime.DateFormat)
+	short := s.ID
+	if len(short) > cfgAlign.SessionIDLen {
+		short = short[:cfgAlign.SessionIDLen]
+	}
+
+	return Candidate{
+		ID:    fmt.Sprintf(cfgAlign.IDFormat, short, i),
+		Kind:  kind,
+		Title: intent,
+		Context: fmt.Sprintf(
+			desc.Text(text.DescKeyAlignContext),
+			short, date, s.Tool, assumed,
+		),
+		Lesson:      fmt.Sprintf(desc.Text(text.DescKeyAlignLesson), intent),
+		Application: desc.Text(text.DescKeyAlignApplication),
+		Assumption:  assumed,
+		Intent:      intent,
+		Session:     s.ID,
+		Tool:        s.Tool,
+		Date:        date,
+		Branch:      s.GitBranch,
+		Signals:     signals,
+	}
+}
+
+// assumption describes what the corrected assistant turn was
+// doing: the first paragraph of its text, or the tools it called.
+//
+// Parameters:
+//   - m: the corrected assistant turn (nil if none)
+//
+// Returns:
+//   - string: the assumption, unclipped
+func assumption(m *entity.Message) string {
+	if m == nil {
+		return desc.Text(text.DescKeyAlignUnknown)
+	}
+	if para, _, _ := strings.Cut(
+		strings.TrimSpace(m.Text), token.DoubleNewline,
+	); para != "" {
+		return para
+	}
+	names := make([]string, 0, len(m.ToolUses))
+	for _, u := range m.ToolUses {
+		names = append(names, u.Name)
+	}
+	return fmt.Sprintf(
+		desc.Text(text.DescKeyAlignTools),
+		strings.Join(names, cfgAlign.ToolSep),
+	)
+}
+
+// clip collapses whitespace to single spaces and shortens the
+// result to cfgAlign.MaxText runes, ending in an ellipsis when cut.
+//
+// Parameters:
+//   - s: text to clip
+//
+// Returns:
+//   - string: the clipped single-line text
+func clip(s string) string {
+	s = strings.Join(strings.Fields(s), token.Space)
+	if utf8.RuneCountInString(s) <= cfgAlign.MaxText {
+		return s
+	}
+	runes := []rune(s)
+	keep := cfgAlign.MaxText - utf8.RuneCountInString(token.Ellipsis)
+	return strings.TrimSpace(string(runes[:keep])) + token.Ellipsis
+}
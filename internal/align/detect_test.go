@@ -0,0 +1,210 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package align_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ActiveMemory/ctx/internal/align"
+	cfgAlign "github.com/ActiveMemory/ctx/internal/config/align"
+	cfgEntry "github.com/ActiveMemory/ctx/internal/config/entry"
+	"github.com/ActiveMemory/ctx/internal/entity"
+)
+
+func keep(s string) string { return s }
+
+func user(text string) entity.Message {
+	return entity.Message{Role: "user", Text: text}
+}
+
+func assistant(text string, tools ...string) entity.Message {
+	m := entity.Message{Role: "assistant", Text: text}
+	for _, name := range tools {
+		m.ToolUses = append(m.ToolUses, entity.ToolUse{Name: name})
+	}
+	return m
+}
+
+func rejected(content string) entity.Message {
+	return entity.Message{Role: "user", ToolResults: []entity.ToolResult{
+		{Content: content, IsError: true},
+	}}
+}
+
+func session(msgs ...entity.Message) *entity.Session {
+	return &entity.Session{
+		ID:        "0123456789abcdef",
+		GitBranch: "feature/x",
+		StartTime: time.Date(2026, 3, 4, 5, 6, 7, 0, time.UTC),
+		Messages:  msgs,
+	}
+}
+
+func TestDetect(t *testing.T) {
+	tests := []struct {
+		name       string
+		msgs       []entity.Message
+		wantIntent []string
+		wantSignal string
+		wantFrom   string
+	}{
+		{
+			name: "interrupt then next turn",
+			msgs: []entity.Message{
+				user("add caching"),
+				assistant("I'll add Redis.\n\nFirst, the client.", "Bash"),
+				user("[Request interrupted by user for tool use]"),
+				user("use the in-memory LRU we already have"),
+			},
+			wantIntent: []string{"use the in-memory LRU we already have"},
+			wantSignal: cfgAlign.SignalInterrupt,
+			wantFrom:   "I'll add Redis.",
+		},
+		{
+			name: "interrupt with inline text",
+			msgs: []entity.Message{
+				assistant("", "Write", "Edit"),
+				user("[Request interrupted by user] keep it in one file"),
+			},
+			wantIntent: []string{"keep it in one file"},
+			wantSignal: cfgAlign.SignalInterrupt,
+			wantFrom:   "calling Write, Edit",
+		},
+		{
+			name: "rejected tool with feedback",
+			msgs: []entity.Message{
+				assistant("Deleting the fixtures.", "Bash"),
+				rejected("The user doesn't want to proceed with this " +
+					"tool use. The tool use was rejected. " +
+					"To tell you how to proceed, the user said:\n" +
+					"regenerate them instead"),
+			},
+			wantIntent: []string{"regenerate them instead"},
+			wantSignal: cfgAlign.SignalRejected,
+			wantFrom:   "Deleting the fixtures.",
+		},
+		{
+			name: "rejected tool then next turn",
+			msgs: []entity.Message{
+				assistant("Pushing to main.", "Bash"),
+				rejected("The user doesn't want to proceed with this " +
+					"tool use. The tool use was rejected."),
+				user("open a PR instead"),
+			},
+			wantIntent: []string{"open a PR instead"},
+			wantSignal: cfgAlign.SignalRejected,
+			wantFrom:   "Pushing to main.",
+		},
+		{
+			name: "correction phrase",
+			msgs: []entity.Message{
+				assistant("Renamed the package to util."),
+				user("No, that's not what I meant: rename the file"),
+				assistant("Done."),
+				user("thanks, now run the tests"),
+			},
+			wantIntent: []string{
+				"No, that's not what I meant: rename the file",
+			},
+			wantSignal: cfgAlign.SignalPhrase,
+			wantFrom:   "Renamed the package to util.",
+		},
+		{
+			name: "wrapper text is skipped",
+			msgs: []entity.Message{
+				assistant("Working.", "Read"),
+				user("[Request interrupted by user]"),
+				user("<command-name>/clear</command-name>"),
+				user("stop using panics"),
+			},
+			wantIntent: []string{"stop using panics"},
+			wantSignal: cfgAlign.SignalInterrupt,
+			wantFrom:   "Working.",
+		},
+		{
+			name: "phrase without a prior assistant turn",
+			msgs: []entity.Message{
+				user("No, start from the README"),
+			},
+		},
+		{
+			name: "ordinary follow-up",
+			msgs: []entity.Message{
+				assistant("Added the flag."),
+				user("great, also document it"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := align.Detect(session(tt.msgs...), keep)
+			if len(got) != len(tt.wantIntent) {
+				t.Fatalf("Detect() = %d candidates %+v, want %d",
+					len(got), got, len(tt.wantIntent))
+			}
+			for i, c := range got {
+				if c.Intent != tt.wantIntent[i] {
+					t.Errorf("Intent = %q, want %q", c.Intent, tt.wantIntent[i])
+				}
+				if c.Signals[0] != tt.wantSignal {
+					t.Errorf("Signals = %v, want %s first", c.Signals, tt.wantSignal)
+				}
+				if c.Assumption != tt.wantFrom {
+					t.Errorf("Assumption = %q, want %q",
+						c.Assumption, tt.wantFrom)
+				}
+				if c.Kind != cfgEntry.Learning {
+					t.Errorf("Kind = %q, want learning", c.Kind)
+				}
+				if !strings.HasPrefix(c.ID, "01234567-") {
+					t.Errorf("ID = %q, want short session prefix", c.ID)
+				}
+				if c.Date != "2026-03-04" || c.Branch != "feature/x" {
+					t.Errorf("Date/Branch = %q/%q", c.Date, c.Branch)
+				}
+			}
+		})
+	}
+}
+
+func TestDetectRuleIsConvention(t *testing.T) {
+	got := align.Detect(session(
+		assistant("Added a mock for the database."),
+		user("no, we never mock the database in this repo"),
+	), keep)
+	if len(got) != 1 {
+		t.Fatalf("Detect() = %+v, want one candidate", got)
+	}
+	c := got[0]
+	if c.Kind != cfgEntry.Convention {
+		t.Errorf("Kind = %q, want convention", c.Kind)
+	}
+	want := []string{cfgAlign.SignalPhrase, cfgAlign.SignalRule}
+	if strings.Join(c.Signals, ",") != strings.Join(want, ",") {
+		t.Errorf("Signals = %v, want %v", c.Signals, want)
+	}
+	if c.Context == "" || c.Lesson == "" || c.Application == "" {
+		t.Errorf("entry fields not filled: %+v", c)
+	}
+}
+
+func TestDetectClipsLongText(t *testing.T) {
+	long := "stop " + strings.Repeat("word  \n", cfgAlign.MaxText)
+	got := align.Detect(session(assistant("x"), user(long)), keep)
+	if len(got) != 1 {
+		t.Fatalf("Detect() = %+v, want one candidate", got)
+	}
+	if n := len([]rune(got[0].Intent)); n > cfgAlign.MaxText {
+		t.Errorf("Intent has %d runes, want <= %d", n, cfgAlign.MaxText)
+	}
+	if strings.Contains(got[0].Intent, "\n") {
+		t.Errorf("Intent keeps newlines: %q", got[0].Intent)
+	}
+}
@@ -0,0 +1,82 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package align
+
+import (
+	cfgAlign "github.com/ActiveMemory/ctx/internal/config/align"
+	cfgQueue "github.com/ActiveMemory/ctx/internal/config/queue"
+	"github.com/ActiveMemory/ctx/internal/entity"
+)
+
+// Params builds the entry parameters for an accepted candidate. The
+// session provenance is the source session, so the entry stays
+// traceable to the conversation it came from.
+//
+// Parameters:
+//   - c: the accepted candidate
+//   - branch: the current branch, used when the session recorded
+//     none (empty falls back to "align")
+//   - commit: the current short HEAD (empty falls back to "align")
+//   - contextDir: the context directory to write into
+//
+// Returns:
+//   - entity.EntryParams: parameters for entry.ValidateAndWrite
+func Params(c Candidate, branch, commit, contextDir string) entity.EntryParams {
+	if c.Branch != "" {
+		branch = c.Branch
+	}
+	if branch == "" {
+		branch = cfgAlign.Provenance
+	}
+	if commit == "" {
+		commit = cfgAlign.Provenance
+	}
+	return entity.EntryParams{
+		Type:        c.Kind,
+		Content:     c.Title,
+		Context:     c.Context,
+		Lesson:      c.Lesson,
+		Application: c.Application,
+		SessionID:   c.Session,
+		Branch:      branch,
+		Commit:      commit,
+		ContextDir:  contextDir,
+	}
+}
+
+// Init sets the current schema version and makes the maps
+// non-nil, so a missing or hand-trimmed ledger is usable.
+func (l *Ledger) Init() {
+	l.Version = cfgAlign.LedgerVersion
+	if l.Sessions == nil {
+		l.Sessions = map[string]int{}
+	}
+	if l.Dispositions == nil {
+		l.Dispositions = map[string]string{}
+	}
+}
+
+// Dispose records a disposition for candidate id. Accepting counts
+// toward the persisted total.
+//
+// Parameters:
+//   - id: the disposed candidate's ID
+//   - disposition: accepted or rejected
+func (l *Ledger) Dispose(id, disposition string) {
+	l.Dispositions[id] = disposition
+	if disposition == cfgQueue.Accepted {
+		l.Stats.TotalPersisted++
+	}
+}
+
+// Key returns the candidate ID, which accept and reject select by.
+//
+// Returns:
+//   - string: the candidate ID
+func (c Candidate) Key() string {
+	return c.ID
+}
@@ -0,0 +1,23 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package align is the deterministic engine behind ctx align: it
+// walks parsed AI sessions for course corrections — a user
+// interrupting an assistant turn, rejecting a tool use, or opening
+// a turn with "no," or "that's not what I meant" — and turns each
+// into a candidate LEARNINGS.md entry, or a CONVENTIONS.md entry
+// when the restated intent reads as a standing rule.
+//
+// Each candidate records the assumption the agent was acting on
+// (its last text or the tools it called) and the user's restated
+// intent, both redacted and clipped. No model is involved.
+// Candidates land in a review file under .context/state/; nothing
+// reaches a context file until a human accepts it, at which point
+// it goes through entry.ValidateAndWrite like any other entry. A
+// ledger beside the review file records the sessions already
+// scanned and every disposition, so re-runs never re-propose. See
+// specs/ctx-align.md.
+package align
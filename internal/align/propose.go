@@ -0,0 +1,59 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package align
+
+import "github.com/ActiveMemory/ctx/internal/entity"
+
+// Propose detects corrections in sessions and returns the
+// candidates not already known. A session the ledger recorded at
+// its current length is skipped unless force is set; every session
+// scanned is recorded with its message count. A candidate is
+// dropped when it was disposed before or is still pending review.
+//
+// Parameters:
+//   - sessions: the sessions to scan
+//   - ledger: the dedup ledger, updated in place
+//   - pending: the candidates already awaiting review
+//   - force: re-scan sessions the ledger already records
+//   - scrub: redacts secrets from quoted text
+//
+// Returns:
+//   - int: the number of sessions scanned
+//   - []Candidate: the new candidates, in session order
+func Propose(
+	sessions []*entity.Session, ledger *Ledger, pending []Candidate,
+	force bool, scrub func(string) string,
+) (int, []Candidate) {
+	seen := make(map[string]bool, len(pending))
+	for _, p := range pending {
+		seen[p.ID] = true
+	}
+
+	scanned := 0
+	var fresh []Candidate
+	for _, s := range sessions {
+		n, done := ledger.Sessions[s.ID]
+		if done && n >= len(s.Messages) && !force {
+			continue
+		}
+		scanned++
+		ledger.Sessions[s.ID] = len(s.Messages)
+		for _, c := range Detect(s, scrub) {
+			if _, disposed := ledger.Dispositions[c.ID]; disposed {
+				continue
+			}
+			if seen[c.ID] {
+				continue
+			}
+			seen[c.ID] = true
+			fresh = append(fresh, c)
+		}
+	}
+	ledger.Stats.TotalSessions += scanned
+	ledger.Stats.TotalCorrections += len(fresh)
+	return scanned, fresh
+}
@@ -0,0 +1,102 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package align_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/ActiveMemory/ctx/internal/align"
+	cfgAlign "github.com/ActiveMemory/ctx/internal/config/align"
+	cfgQueue "github.com/ActiveMemory/ctx/internal/config/queue"
+	"github.com/ActiveMemory/ctx/internal/entity"
+	"github.com/ActiveMemory/ctx/internal/queue"
+)
+
+func TestProposeDedup(t *testing.T) {
+	s := session(
+		assistant("Added a retry loop."),
+		user("no, fail fast instead"),
+		assistant("Switched to sqlite."),
+		user("stop, keep postgres"),
+	)
+	sessions := []*entity.Session{s}
+	ledger := &align.Ledger{}
+	if loadErr := queue.LoadLedger(
+		align.Queue, t.TempDir(), ledger,
+	); loadErr != nil {
+		t.Fatal(loadErr)
+	}
+
+	scanned, fresh := align.Propose(sessions, ledger, nil, false, keep)
+	if scanned != 1 || len(fresh) != 2 {
+		t.Fatalf("first pass scanned %d, proposed %d; want 1, 2",
+			scanned, len(fresh))
+	}
+
+	scanned, again := align.Propose(sessions, ledger, fresh, false, keep)
+	if scanned != 0 || len(again) != 0 {
+		t.Errorf("re-run scanned %d, proposed %d; want 0, 0",
+			scanned, len(again))
+	}
+
+	pending := queue.Dispose(ledger, fresh, fresh[0].ID, cfgQueue.Rejected)
+	_, forced := align.Propose(sessions, ledger, pending, true, keep)
+	if len(forced) != 0 {
+		t.Errorf("forced re-run proposed %+v; want none (one rejected, "+
+			"one pending)", forced)
+	}
+
+	s.Messages = append(s.Messages,
+		assistant("Dropped the index."),
+		user("I said keep the index"),
+	)
+	scanned, grown := align.Propose(sessions, ledger, pending, false, keep)
+	if scanned != 1 || len(grown) != 1 {
+		t.Errorf("grown session scanned %d, proposed %d; want 1, 1",
+			scanned, len(grown))
+	}
+	if ledger.Stats.TotalCorrections != 3 {
+		t.Errorf("TotalCorrections = %d, want 3",
+			ledger.Stats.TotalCorrections)
+	}
+}
+
+func TestParamsProvenance(t *testing.T) {
+	c := align.Candidate{Kind: "learning", Session: "abc", Branch: "b1"}
+	p := align.Params(c, "main", "", "/ctx")
+	if p.Branch != "b1" || p.Commit != cfgAlign.Provenance ||
+		p.SessionID != "abc" || p.ContextDir != "/ctx" {
+		t.Errorf("Params() = %+v", p)
+	}
+	c.Branch = ""
+	if p = align.Params(c, "main", "1a2b3c4", ""); p.Branch != "main" ||
+		p.Commit != "1a2b3c4" {
+		t.Errorf("Params() without session branch = %+v", p)
+	}
+}
+
+func TestStoreRoundTrip(t *testing.T) {
+	ctxDir := t.TempDir()
+	pending := align.Detect(session(
+		assistant("Working."),
+		user("wait, not that file"),
+	), keep)
+	if saveErr := queue.SaveReview(align.Queue, ctxDir, pending); saveErr != nil {
+		t.Fatal(saveErr)
+	}
+	got, loadErr := queue.LoadReview[align.Candidate](align.Queue, ctxDir)
+	if loadErr != nil {
+		t.Fatal(loadErr)
+	}
+	if len(got) != 1 || got[0].ID != pending[0].ID {
+		t.Errorf("LoadReview() = %+v, want %+v", got, pending)
+	}
+	if filepath.Dir(queue.ReviewPath(align.Queue, ctxDir)) == ctxDir {
+		t.Errorf("review file should live under the state directory")
+	}
+}
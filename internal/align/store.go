@@ -0,0 +1,21 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package align
+
+import (
+	cfgAlign "github.com/ActiveMemory/ctx/internal/config/align"
+	"github.com/ActiveMemory/ctx/internal/entity"
+)
+
+// Queue is the ctx align review queue: align-review.json and
+// align-ledger.json under .context/state/, read and written
+// through the queue package.
+var Queue = entity.ReviewQueue{
+	Name:   cfgAlign.Queue,
+	Review: cfgAlign.FileReview,
+	Ledger: cfgAlign.FileLedger,
+}
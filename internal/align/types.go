@@ -0,0 +1,73 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package align
+
+// Candidate is one proposed LEARNINGS.md or CONVENTIONS.md entry,
+// persisted in the review file until it is accepted or rejected.
+// The entry fields may be edited in the review file before accept;
+// the learning fields are filled for conventions too, so changing
+// the kind needs no other edit.
+type Candidate struct {
+	// ID is the stable identifier used by accept and reject: the
+	// session ID prefix and the correcting message's position
+	// (e.g. "1a2b3c4d-42").
+	ID string `json:"id"`
+	// Kind is the entry type, learning or convention.
+	Kind string `json:"kind"`
+	// Title is the entry title: the user's restated intent.
+	Title string `json:"title"`
+	// Context is the learning context, citing the session and the
+	// diverging assumption.
+	Context string `json:"context"`
+	// Lesson is the learning's lesson.
+	Lesson string `json:"lesson"`
+	// Application is the learning's application.
+	Application string `json:"application"`
+	// Assumption is what the agent was doing when corrected: its
+	// last text, or the tools it called.
+	Assumption string `json:"assumption"`
+	// Intent is the user's restated intent.
+	Intent string `json:"intent"`
+	// Session is the source session ID.
+	Session string `json:"session"`
+	// Tool is the AI tool that recorded the session.
+	Tool string `json:"tool"`
+	// Date is the correction date (YYYY-MM-DD).
+	Date string `json:"date"`
+	// Branch is the session's git branch, when recorded.
+	Branch string `json:"branch,omitempty"`
+	// Signals explains why the moment was proposed (e.g.
+	// "interrupt", "rejected-tool", "rule").
+	Signals []string `json:"signals"`
+}
+
+// Stats are the ledger's running totals.
+type Stats struct {
+	// TotalSessions counts sessions scanned across all runs.
+	TotalSessions int `json:"total_sessions"`
+	// TotalCorrections counts candidates proposed across all runs.
+	TotalCorrections int `json:"total_corrections"`
+	// TotalPersisted counts candidates accepted into context files.
+	TotalPersisted int `json:"total_persisted"`
+}
+
+// Ledger is the dedup record persisted in align-ledger.json.
+type Ledger struct {
+	// Version is the ledger schema version.
+	Version int `json:"version"`
+	// LastScanned is the RFC 3339 time of the last run.
+	LastScanned string `json:"last_scanned,omitempty"`
+	// Stats are the running totals.
+	Stats Stats `json:"stats"`
+	// Sessions maps each scanned session ID to the number of
+	// messages it had, so a session that grew is scanned again.
+	Sessions map[string]int `json:"sessions"`
+	// Dispositions maps each reviewed candidate ID to accepted or
+	// rejected; a disposed candidate is never re-proposed, even
+	// under --force.
+	Dispositions map[string]string `json:"dispositions,omitempty"`
+}
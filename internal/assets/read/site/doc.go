@@ -0,0 +1,18 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package site provides access to the static site assets
+// embedded in the binary.
+//
+// The built-in site renderer copies these into every site
+// it builds. [Style] returns the site stylesheet; [Script]
+// returns the client-side search script, which reads the
+// search index the renderer writes next to it.
+//
+// Example:
+//
+//	css, err := site.Style()
+package site
@@ -0,0 +1,30 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package site
+
+import (
+	"github.com/ActiveMemory/ctx/internal/assets"
+	"github.com/ActiveMemory/ctx/internal/config/asset"
+)
+
+// Style reads the embedded site stylesheet.
+//
+// Returns:
+//   - []byte: CSS content
+//   - error: Non-nil if the file is not found or read fails
+func Style() ([]byte, error) {
+	return assets.FS.ReadFile(asset.PathSiteCSS)
+}
+
+// Script reads the embedded client-side search script.
+//
+// Returns:
+//   - []byte: JavaScript content
+//   - error: Non-nil if the file is not found or read fails
+func Script() ([]byte, error) {
+	return assets.FS.ReadFile(asset.PathSiteJS)
+}
@@ -0,0 +1,99 @@
+// Client-side search for sites rendered by ctx.
+//
+// Loads the search index named by the body's data-index
+// attribute on first use and ranks pages by how many query
+// words they contain, title matches first.
+(function () {
+  "use strict";
+
+  var input = document.getElementById("search");
+  var results = document.getElementById("results");
+  var article = document.querySelector("main article");
+  var root = document.body.getAttribute("data-root") || "";
+  var indexURL = document.body.getAttribute("data-index");
+  var pages = null;
+
+  function load(done) {
+    if (pages !== null) {
+      done();
+      return;
+    }
+    fetch(indexURL)
+      .then(function (r) { return r.json(); })
+      .then(function (data) { pages = data; done(); })
+      .catch(function () { pages = []; done(); });
+  }
+
+  function words(text) {
+    return text.toLowerCase().split(/[^\p{L}\p{N}]+/u)
+      .filter(function (w) { return w.length > 0; });
+  }
+
+  function snippet(text, term) {
+    var at = text.toLowerCase().indexOf(term);
+    var from = Math.max(0, at - 60);
+    var out = text.slice(from, from + 180);
+    return (from > 0 ? "…" : "") + out + "…";
+  }
+
+  function rank(query) {
+    var terms = words(query);
+    if (terms.length === 0) {
+      return [];
+    }
+    var hits = [];
+    pages.forEach(function (p) {
+      var title = p.title.toLowerCase();
+      var text = p.text.toLowerCase();
+      var score = 0;
+      for (var i = 0; i < terms.length; i++) {
+        var inTitle = title.indexOf(terms[i]) >= 0;
+        var inText = text.indexOf(terms[i]) >= 0;
+        if (!inTitle && !inText) {
+          return;
+        }
+        score += (inTitle ? 10 : 0) + (inText ? 1 : 0);
+      }
+      hits.push({ page: p, score: score });
+    });
+    hits.sort(function (a, b) { return b.score - a.score; });
+    return hits.slice(0, 50).map(function (h) {
+      return { page: h.page, snippet: snippet(h.page.text, terms[0]) };
+    });
+  }
+
+  function show(query) {
+    results.textContent = "";
+    if (query.trim() === "") {
+      results.hidden = true;
+      article.hidden = false;
+      return;
+    }
+    var hits = rank(query);
+    var list = document.createElement("ol");
+    hits.forEach(function (h) {
+      var item = document.createElement("li");
+      var link = document.createElement("a");
+      link.href = root + h.page.url;
+      link.textContent = h.page.title || h.page.url;
+      var text = document.createElement("p");
+      text.textContent = h.snippet;
+      item.appendChild(link);
+      item.appendChild(text);
+      list.appendChild(item);
+    });
+    var count = document.createElement("p");
+    count.textContent = hits.length + " result" +
+      (hits.length === 1 ? "" : "s");
+    results.appendChild(count);
+    results.appendChild(list);
+    results.hidden = false;
+    article.hidden = true;
+  }
+
+  if (input && results && article && indexURL) {
+    input.addEventListener("input", function () {
+      load(function () { show(input.value); });
+    });
+  }
+})();
@@ -20,6 +20,8 @@
 //     line and its inline tags (`#priority:`,
 //     `#session:`, `#branch:`, `#commit:`, `#added:`).
 //     Used by `ctx task add`.
+//   - **`tpl_graph.go`**: DOT and Mermaid fragments
+//     for the `ctx task graph` dependency graph.
 //   - **`tpl_hub_entry.go`**: markdown rendering of one
 //     hub entry (date header + origin tag + content
 //     body + horizontal rule). Consumed by
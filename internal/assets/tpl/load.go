@@ -37,6 +37,7 @@ func init() {
 	LoopScript = parseTemplate("templates/loop-script.sh.tmpl")
 	MetaTable = parseTemplate("templates/meta-table.html.tmpl")
 	Details = parseTemplate("templates/details.html.tmpl")
+	SitePage = parseTemplate("templates/site-page.html.tmpl")
 	ZensicalProject = loadStatic("templates/zensical-project.toml")
 	ZensicalTheme = loadStatic("templates/zensical-theme.toml")
 }
@@ -45,6 +45,10 @@ var MetaTable *template.Template
 // Data: [DetailsData].
 var Details *template.Template
 
+// SitePage renders one page of a built-in static site.
+// Data: [entity.SitePage].
+var SitePage *template.Template
+
 // Render executes a parsed template handle against data.
 //
 // The handle is always non-nil for a registered template (a parse
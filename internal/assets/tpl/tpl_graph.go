@@ -0,0 +1,77 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package tpl
+
+// Graphviz DOT templates for ctx task graph.
+//
+// Node names are generated (see GraphTaskName); labels arrive
+// escaped for a double-quoted DOT string.
+const (
+	// GraphDOTOpen starts the digraph.
+	GraphDOTOpen = "digraph tasks {\n  rankdir=LR;\n  node [shape=box];\n"
+
+	// GraphDOTTask declares a pending task.
+	// Args: node name, label.
+	GraphDOTTask = "  %s [label=\"%s\"];\n"
+
+	// GraphDOTDone declares a completed task.
+	// Args: node name, label.
+	GraphDOTDone = "  %s [label=\"%s\", style=dashed, fontcolor=gray];\n"
+
+	// GraphDOTBlocker declares an external blocker.
+	// Args: node name, label.
+	GraphDOTBlocker = "  %s [label=\"%s\", shape=note];\n"
+
+	// GraphDOTEdge draws prerequisite -> dependent.
+	// Args: prerequisite node name, dependent node name.
+	GraphDOTEdge = "  %s -> %s;\n"
+
+	// GraphDOTClose ends the digraph.
+	GraphDOTClose = "}\n"
+)
+
+// Mermaid flowchart templates for ctx task graph.
+//
+// Labels arrive escaped for a double-quoted Mermaid node.
+const (
+	// GraphMermaidOpen starts the flowchart.
+	GraphMermaidOpen = "flowchart LR\n"
+
+	// GraphMermaidTask declares a pending task.
+	// Args: node name, label.
+	GraphMermaidTask = "  %s[\"%s\"]\n"
+
+	// GraphMermaidDone declares a completed task.
+	// Args: node name, label.
+	GraphMermaidDone = "  %s[\"%s\"]:::done\n"
+
+	// GraphMermaidBlocker declares an external blocker.
+	// Args: node name, label.
+	GraphMermaidBlocker = "  %s>\"%s\"]\n"
+
+	// GraphMermaidEdge draws prerequisite --> dependent.
+	// Args: prerequisite node name, dependent node name.
+	GraphMermaidEdge = "  %s --> %s\n"
+
+	// GraphMermaidClose styles completed tasks.
+	GraphMermaidClose = "  classDef done stroke-dasharray: 5 5,color:gray\n"
+)
+
+// Node names and labels shared by both graph formats.
+const (
+	// GraphTaskName names a task node.
+	// Args: position of the task in TASKS.md order.
+	GraphTaskName = "t%d"
+
+	// GraphBlockerName names an external blocker node.
+	// Args: position of the blocker in first-seen order.
+	GraphBlockerName = "x%d"
+
+	// GraphLabelID labels a task that has an explicit #id.
+	// Args: task text, ID.
+	GraphLabelID = "%s (%s)"
+)
@@ -0,0 +1,166 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package tpl
+
+// HTML block templates for the built-in Markdown renderer.
+//
+// Block templates end in a newline so rendered blocks can be
+// concatenated. Content arguments are already-rendered HTML;
+// attribute arguments are already escaped.
+const (
+	// HTMLHeading formats a heading.
+	// Args: level, anchor id, inline HTML.
+	HTMLHeading = "<h%d id=\"%s\">%s</h%[1]d>\n"
+
+	// HTMLParagraph formats a paragraph.
+	// Args: inline HTML.
+	HTMLParagraph = "<p>%s</p>\n"
+
+	// HTMLRule is a thematic break.
+	HTMLRule = "<hr />\n"
+
+	// HTMLCode formats a code block.
+	// Args: escaped code.
+	HTMLCode = "<pre><code>%s</code></pre>\n"
+
+	// HTMLCodeLang formats a code block with a language.
+	// Args: language, escaped code.
+	HTMLCodeLang = "<pre><code class=\"language-%s\">%s</code></pre>\n"
+
+	// HTMLBlockquote formats a block quote.
+	// Args: block HTML.
+	HTMLBlockquote = "<blockquote>\n%s</blockquote>\n"
+
+	// HTMLList formats a bullet list.
+	// Args: item HTML.
+	HTMLList = "<ul>\n%s</ul>\n"
+
+	// HTMLOrdered formats an ordered list starting at 1.
+	// Args: item HTML.
+	HTMLOrdered = "<ol>\n%s</ol>\n"
+
+	// HTMLOrderedFrom formats an ordered list with an
+	// explicit start number.
+	// Args: start number, item HTML.
+	HTMLOrderedFrom = "<ol start=\"%d\">\n%s</ol>\n"
+
+	// HTMLItem formats a list item.
+	// Args: item HTML.
+	HTMLItem = "<li>%s</li>\n"
+
+	// HTMLTaskOpen is the checkbox of an open task item.
+	HTMLTaskOpen = "<input type=\"checkbox\" disabled /> "
+
+	// HTMLTaskDone is the checkbox of a done task item.
+	HTMLTaskDone = "<input type=\"checkbox\" checked disabled /> "
+
+	// HTMLTable formats a table.
+	// Args: head row HTML, body rows HTML.
+	HTMLTable = "<table>\n<thead>\n%s</thead>\n" +
+		"<tbody>\n%s</tbody>\n</table>\n"
+
+	// HTMLRow formats a table row.
+	// Args: cells HTML.
+	HTMLRow = "<tr>%s</tr>\n"
+
+	// HTMLCell formats a table cell.
+	// Args: tag (th or td), inline HTML.
+	HTMLCell = "<%s>%s</%[1]s>"
+
+	// HTMLCellAlign formats an aligned table cell.
+	// Args: tag (th or td), alignment, inline HTML.
+	HTMLCellAlign = "<%s style=\"text-align: %s\">%s</%[1]s>"
+
+	// HTMLAdmonition formats an admonition.
+	// Args: type, title HTML, body HTML.
+	HTMLAdmonition = "<div class=\"admonition %s\">\n" +
+		"<p class=\"admonition-title\">%s</p>\n%s</div>\n"
+
+	// HTMLAdmonitionBare formats an admonition without a
+	// title.
+	// Args: type, body HTML.
+	HTMLAdmonitionBare = "<div class=\"admonition %s\">\n%s</div>\n"
+
+	// HTMLCollapsible formats a collapsible admonition.
+	// Args: type, open attribute, title HTML, body HTML.
+	HTMLCollapsible = "<details class=\"admonition %s\"%s>\n" +
+		"<summary>%s</summary>\n%s</details>\n"
+
+	// HTMLOpenAttr marks a collapsible admonition as
+	// expanded.
+	HTMLOpenAttr = " open"
+
+	// HTMLTabs formats a set of content tabs.
+	// Args: tabs HTML.
+	HTMLTabs = "<div class=\"tabbed-set\">\n%s</div>\n"
+
+	// HTMLTab formats one content tab.
+	// Args: label HTML, body HTML.
+	HTMLTab = "<div class=\"tabbed-block\">\n" +
+		"<p class=\"tabbed-label\">%s</p>\n%s</div>\n"
+)
+
+// HTML inline templates for the built-in Markdown renderer.
+const (
+	// HTMLCodeSpan formats inline code.
+	// Args: escaped code.
+	HTMLCodeSpan = "<code>%s</code>"
+
+	// HTMLStrong formats strong emphasis.
+	// Args: inline HTML.
+	HTMLStrong = "<strong>%s</strong>"
+
+	// HTMLEm formats emphasis.
+	// Args: inline HTML.
+	HTMLEm = "<em>%s</em>"
+
+	// HTMLDel formats strikethrough.
+	// Args: inline HTML.
+	HTMLDel = "<del>%s</del>"
+
+	// HTMLLink formats a link.
+	// Args: escaped URL, inline HTML.
+	HTMLLink = "<a href=\"%s\">%s</a>"
+
+	// HTMLLinkTitle formats a link with a title.
+	// Args: escaped URL, escaped title, inline HTML.
+	HTMLLinkTitle = "<a href=\"%s\" title=\"%s\">%s</a>"
+
+	// HTMLImage formats an image.
+	// Args: escaped URL, escaped alt text.
+	HTMLImage = "<img src=\"%s\" alt=\"%s\" />"
+
+	// HTMLBreak is a hard line break.
+	HTMLBreak = "<br />\n"
+)
+
+// HTML tag templates for re-emitting a whitelisted raw
+// HTML tag from its parsed form.
+const (
+	// HTMLTagOpen starts an opening tag.
+	// Args: tag name.
+	HTMLTagOpen = "<%s"
+
+	// HTMLTagAttr formats one attribute.
+	// Args: attribute name, escaped value.
+	HTMLTagAttr = " %s=\"%s\""
+
+	// HTMLTagBareAttr formats an attribute without a
+	// value.
+	// Args: attribute name.
+	HTMLTagBareAttr = " %s"
+
+	// HTMLTagEnd ends an opening tag.
+	HTMLTagEnd = ">"
+
+	// HTMLTagSelfClose ends a self-closing tag.
+	HTMLTagSelfClose = " />"
+
+	// HTMLTagClose formats a closing tag.
+	// Args: tag name.
+	HTMLTagClose = "</%s>"
+)
@@ -8,13 +8,67 @@ package tpl
 
 // Hub entry markdown rendering template.
 const (
+	// HubEntryAnchor tags a rendered entry with its hub ID so
+	// a later retraction or supersession can find it.
+	//
+	// Args (in order):
+	//   - id: hub entry ID
+	HubEntryAnchor = "<!-- hub-entry: %s -->\n"
+
 	// HubEntryMarkdown formats a single hub entry as markdown
-	// with a date header, origin tag, and horizontal rule.
+	// with a date header, ID anchor, origin tag, and
+	// horizontal rule.
+	//
+	// Args (in order):
+	//   - date: formatted date string
+	//   - title: first line of content (used as heading)
+	//   - id: hub entry ID (see HubEntryAnchor)
+	//   - origin: entry origin identifier
+	//   - content: full entry content
+	HubEntryMarkdown = "## [%s] %s\n" + HubEntryAnchor +
+		"\n**Origin**: %s\n\n%s\n\n---\n\n"
+
+	// HubSupersedeMarkdown formats an entry that replaces an
+	// earlier one. Same layout as HubEntryMarkdown plus a
+	// line naming the replaced entry.
 	//
 	// Args (in order):
 	//   - date: formatted date string
 	//   - title: first line of content (used as heading)
+	//   - id: hub entry ID (see HubEntryAnchor)
 	//   - origin: entry origin identifier
+	//   - ref: ID of the superseded entry
+	//   - reason: why it was superseded
 	//   - content: full entry content
-	HubEntryMarkdown = "## [%s] %s\n\n**Origin**: %s\n\n%s\n\n---\n\n"
+	HubSupersedeMarkdown = "## [%s] %s\n" + HubEntryAnchor +
+		"\n**Origin**: %s\n\n**Supersedes**: %s (%s)\n\n" +
+		"%s\n\n---\n\n"
+
+	// HubRetractedMark is inserted under the anchor of a
+	// retracted entry.
+	//
+	// Args (in order):
+	//   - origin: project that retracted it
+	//   - reason: why it was retracted
+	HubRetractedMark = "\n> **Retracted** by %s: %s\n"
+
+	// HubSupersededMark is inserted under the anchor of a
+	// superseded entry.
+	//
+	// Args (in order):
+	//   - id: ID of the replacing entry
+	//   - reason: why it was superseded
+	HubSupersededMark = "\n> **Superseded** by %s: %s\n"
+
+	// HubRetractionMarkdown records a retraction whose target
+	// is not in the file (rendered before anchors existed,
+	// or filtered out locally).
+	//
+	// Args (in order):
+	//   - date: formatted date string
+	//   - ref: ID of the retracted entry
+	//   - origin: project that retracted it
+	//   - reason: why it was retracted
+	HubRetractionMarkdown = "## [%s] Retracted %s\n\n" +
+		"**Origin**: %s\n\n**Reason**: %s\n\n---\n\n"
 )
@@ -95,6 +95,38 @@ const (
 	// Args: path, title, link.
 	JournalLongtailCodeEntry = "- `%s` - [%s](../%s.md)"
 
+	// JournalStatsSummary formats the totals line of the session stats
+	// page.
+	// Args: sessions, turns, tokens in, tokens out, average length,
+	// average turns, tool calls, failure percentage.
+	JournalStatsSummary = "**Sessions**: %d | **Turns**: %d" +
+		" | **Tokens**: %s in / %s out" +
+		" | **Avg length**: %s, %.1f turns" +
+		" | **Tool calls**: %d (%.1f%% failed)"
+
+	// JournalStatsSection formats a section heading on the stats page.
+	// Args: section label.
+	JournalStatsSection = "## %s"
+
+	// JournalStatsHead is the header of a stats breakdown table.
+	// Args: key column label.
+	JournalStatsHead = "| %s | Sessions | Turns | Tokens in" +
+		" | Tokens out | Avg length | Tool calls | Failed |\n" +
+		"|---|--:|--:|--:|--:|--:|--:|--:|"
+
+	// JournalStatsRow formats one row of a stats breakdown table.
+	// Args: key, sessions, turns, tokens in, tokens out, average
+	// length, tool calls, failure percentage.
+	JournalStatsRow = "| %s | %d | %d | %s | %s | %s | %d | %.1f%% |"
+
+	// JournalToolStatsHead is the header of the tool call table.
+	JournalToolStatsHead = "| Tool | Calls | Failed | Failure rate |\n" +
+		"|---|--:|--:|--:|"
+
+	// JournalToolStatsRow formats one row of the tool call table.
+	// Args: tool name, calls, errors, failure percentage.
+	JournalToolStatsRow = "| `%s` | %d | %d | %.1f%% |"
+
 	// JournalNavItem formats a navigation item in zensical.toml.
 	// Args: label, path.
 	JournalNavItem = `  { "%s" = "%s" },`
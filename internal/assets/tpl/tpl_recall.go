@@ -78,6 +78,14 @@ const (
 	// Args: key, value.
 	FmInt = "%s: %d"
 
+	// FmList formats the key line of a YAML frontmatter list.
+	// Args: key.
+	FmList = "%s:"
+
+	// FmListItem formats one item of a YAML frontmatter list.
+	// Args: value.
+	FmListItem = "  - %s"
+
 	// ToolDisplay formats a tool name with its key parameter.
 	// Args: tool name, parameter value.
 	ToolDisplay = "%s: %s"
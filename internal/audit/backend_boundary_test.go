@@ -0,0 +1,108 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// ================================================================
+// STOP — Read internal/audit/README.md before editing this file.
+//
+// These tests enforce project conventions. The codebase is clean:
+// all checks pass with zero violations, zero exceptions.
+//
+// If a test fails after your change, fix the code under test.
+// Do NOT add allowlist entries, bump grandfathered counters, or
+// weaken checks. Exceptions require a dedicated PR with
+// justification for every entry. See README.md for the full policy.
+// ================================================================
+
+package audit
+
+import (
+	"go/types"
+	"strings"
+	"testing"
+)
+
+// backendPkg is the optional AI backend layer.
+const backendPkg = "github.com/ActiveMemory/ctx/internal/backend"
+
+// deterministicRoots are the command trees that assemble context or
+// run from hooks during ceremonies. They must work with no backend
+// configured, so none of them may reach internal/backend.
+var deterministicRoots = []string{
+	"github.com/ActiveMemory/ctx/internal/cli/agent",
+	"github.com/ActiveMemory/ctx/internal/cli/status",
+	"github.com/ActiveMemory/ctx/internal/cli/system",
+	"github.com/ActiveMemory/ctx/internal/cli/hook",
+}
+
+// TestDeterministicCoreAvoidsBackend ensures ctx agent, ctx status,
+// and the hook plumbing (ctx system, ctx hook) do not import
+// internal/backend, directly or transitively. AI commands are
+// optional and fail closed; coupling the deterministic core to them
+// would make core behavior depend on a reachable model.
+//
+// See specs/ctx-ai-backend.md (Validation Rules).
+func TestDeterministicCoreAvoidsBackend(t *testing.T) {
+	pkgs := loadPackages(t)
+	var violations []string
+
+	for _, pkg := range pkgs {
+		if !underDeterministicRoot(pkg.PkgPath) || pkg.Types == nil {
+			continue
+		}
+		if via := importChain(pkg.Types, map[string]bool{}); via != nil {
+			violations = append(violations,
+				pkg.PkgPath+" -> "+strings.Join(via, " -> "),
+			)
+		}
+	}
+
+	for _, v := range violations {
+		t.Errorf("deterministic core reaches the AI backend: %s", v)
+	}
+}
+
+// underDeterministicRoot reports whether pkgPath is one of the
+// deterministic roots or a subpackage of one.
+//
+// Parameters:
+//   - pkgPath: package import path
+//
+// Returns:
+//   - bool: true when the package must not reach internal/backend
+func underDeterministicRoot(pkgPath string) bool {
+	for _, root := range deterministicRoots {
+		if pkgPath == root || strings.HasPrefix(pkgPath, root+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// importChain walks pkg's imports depth-first and returns the path
+// to internal/backend, or nil when it is unreachable.
+//
+// Parameters:
+//   - pkg: package to walk
+//   - seen: visited import paths
+//
+// Returns:
+//   - []string: import chain ending at internal/backend, or nil
+func importChain(pkg *types.Package, seen map[string]bool) []string {
+	for _, imp := range pkg.Imports() {
+		path := imp.Path()
+		if path == backendPkg {
+			return []string{path}
+		}
+		if seen[path] {
+			continue
+		}
+		seen[path] = true
+		if rest := importChain(imp, seen); rest != nil {
+			return append([]string{path}, rest...)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package backend
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	cfgBackend "github.com/ActiveMemory/ctx/internal/config/backend"
+	errBackend "github.com/ActiveMemory/ctx/internal/err/backend"
+)
+
+// Name returns the backend type.
+//
+// Returns:
+//   - string: the .ctxrc key (vllm, openai, ...)
+func (c *client) Name() string {
+	return c.cfg.Name
+}
+
+// Endpoint returns the normalized base URL.
+//
+// Returns:
+//   - string: base URL without a trailing /v1
+func (c *client) Endpoint() string {
+	return c.cfg.Endpoint
+}
+
+// Ping lists the backend's models (GET /v1/models).
+//
+// Parameters:
+//   - ctx: request context
+//
+// Returns:
+//   - string: the first listed model
+//   - error: transport, auth, upstream, or decode failure, or an
+//     empty model list
+func (c *client) Ping(ctx context.Context) (string, error) {
+	body, doErr := c.do(ctx, http.MethodGet, cfgBackend.PathModels, nil)
+	if doErr != nil {
+		return "", doErr
+	}
+	var list modelList
+	if decodeErr := json.Unmarshal(body, &list); decodeErr != nil {
+		return "", errBackend.Decode(c.cfg.Name, decodeErr)
+	}
+	if len(list.Data) == 0 {
+		return "", errBackend.NoModel(c.cfg.Name)
+	}
+	return list.Data[0].ID, nil
+}
+
+// Complete sends one schema-constrained chat completion at
+// temperature 0. The model is the request's, else the backend's
+// default_model, else the first model the backend lists. Upstream
+// errors are relayed verbatim and never retried.
+//
+// Parameters:
+//   - ctx: request context
+//   - req: the structured-output request
+//
+// Returns:
+//   - Response: the answering model and raw content
+//   - error: transport, auth, model, upstream, or decode failure
+func (c *client) Complete(ctx context.Context, req Request) (Response, error) {
+	model, modelErr := c.model(ctx, req.Model)
+	if modelErr != nil {
+		return Response{}, modelErr
+	}
+
+	payload, marshalErr := json.Marshal(chatRequest{
+		Model: model,
+		Messages: []chatMessage{
+			{Role: cfgBackend.RoleSystem, Content: req.System},
+			{Role: cfgBackend.RoleUser, Content: req.Prompt},
+		},
+		ResponseFormat: responseFormat{
+			Type: cfgBackend.FormatJSONSchema,
+			JSONSchema: jsonSchema{
+				Name:   req.SchemaName,
+				Strict: true,
+				Schema: req.Schema,
+			},
+		},
+	})
+	if marshalErr != nil {
+		return Response{}, errBackend.Request(c.cfg.Name, marshalErr)
+	}
+
+	body, doErr := c.do(ctx, http.MethodPost, cfgBackend.PathChat, payload)
+	if doErr != nil {
+		return Response{}, doErr
+	}
+	var reply chatResponse
+	if decodeErr := json.Unmarshal(body, &reply); decodeErr != nil {
+		return Response{}, errBackend.Decode(c.cfg.Name, decodeErr)
+	}
+	if len(reply.Choices) == 0 {
+		return Response{}, errBackend.EmptyReply(c.cfg.Name)
+	}
+	if reply.Model == "" {
+		reply.Model = model
+	}
+	return Response{
+		Model:   reply.Model,
+		Content: reply.Choices[0].Message.Content,
+	}, nil
+}
@@ -0,0 +1,185 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package backend
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	cfgBackend "github.com/ActiveMemory/ctx/internal/config/backend"
+	"github.com/ActiveMemory/ctx/internal/config/embed/text"
+	cfgHTTP "github.com/ActiveMemory/ctx/internal/config/http"
+	cfgWarn "github.com/ActiveMemory/ctx/internal/config/warn"
+	errBackend "github.com/ActiveMemory/ctx/internal/err/backend"
+	ctxIo "github.com/ActiveMemory/ctx/internal/io"
+	logWarn "github.com/ActiveMemory/ctx/internal/log/warn"
+)
+
+// model resolves the model for a completion.
+//
+// Parameters:
+//   - ctx: request context
+//   - requested: the request's model ("" when unset)
+//
+// Returns:
+//   - string: requested, else default_model, else the first listed
+//   - error: from [client.Ping] when the model must be discovered
+func (c *client) model(ctx context.Context, requested string) (string, error) {
+	if requested != "" {
+		return requested, nil
+	}
+	if c.cfg.Model != "" {
+		return c.cfg.Model, nil
+	}
+	return c.Ping(ctx)
+}
+
+// do sends one request and returns the body of a 2xx reply.
+// At most [cfgBackend.MaxResponseBody] bytes are read; a
+// longer reply fails.
+//
+// Parameters:
+//   - ctx: request context
+//   - method: HTTP method
+//   - path: API path (joined to the endpoint)
+//   - payload: JSON body (nil for none)
+//
+// Returns:
+//   - []byte: the reply body
+//   - error: a fail-closed backend error for any other outcome
+func (c *client) do(
+	ctx context.Context, method, path string, payload []byte,
+) ([]byte, error) {
+	var reader io.Reader
+	if payload != nil {
+		reader = bytes.NewReader(payload)
+	}
+	req, reqErr := http.NewRequestWithContext(
+		ctx, method, c.cfg.Endpoint+path, reader,
+	)
+	if reqErr != nil {
+		return nil, errBackend.Request(c.cfg.Name, reqErr)
+	}
+	if payload != nil {
+		req.Header.Set(cfgBackend.HeaderContentType, cfgHTTP.MimeJSON)
+	}
+	if key := c.apiKey(); key != "" {
+		req.Header.Set(
+			cfgBackend.HeaderAuthorization,
+			fmt.Sprintf(cfgBackend.BearerFormat, key),
+		)
+	}
+
+	resp, sendErr := ctxIo.SafeDo(req, c.cfg.Timeout)
+	if sendErr != nil {
+		if timedOut(sendErr) {
+			return nil, errBackend.Timeout(c.cfg.Name, c.cfg.Timeout)
+		}
+		return nil, errBackend.Unreachable(
+			c.cfg.Name, c.cfg.Endpoint, sendErr,
+		)
+	}
+	defer func() {
+		if closeErr := resp.Body.Close(); closeErr != nil {
+			logWarn.Warn(cfgWarn.CloseResponse, closeErr)
+		}
+	}()
+
+	// Read one byte past the cap so an oversized reply is
+	// detected rather than silently truncated.
+	body, readErr := io.ReadAll(
+		io.LimitReader(resp.Body, cfgBackend.MaxResponseBody+1),
+	)
+	if readErr != nil {
+		if timedOut(readErr) {
+			return nil, errBackend.Timeout(c.cfg.Name, c.cfg.Timeout)
+		}
+		return nil, errBackend.Decode(c.cfg.Name, readErr)
+	}
+	if len(body) > cfgBackend.MaxResponseBody {
+		return nil, errBackend.TooLarge(
+			c.cfg.Name, cfgBackend.MaxResponseBody,
+		)
+	}
+	if resp.StatusCode >= http.StatusOK &&
+		resp.StatusCode < http.StatusMultipleChoices {
+		return body, nil
+	}
+	return nil, c.statusErr(resp, path, body)
+}
+
+// statusErr maps a non-2xx reply to a backend error, relaying the
+// upstream body verbatim (capped at [cfgBackend.MaxErrorBody]).
+//
+// Parameters:
+//   - resp: the reply
+//   - path: the requested API path
+//   - body: the reply body
+//
+// Returns:
+//   - error: auth, model, or upstream error
+func (c *client) statusErr(
+	resp *http.Response, path string, body []byte,
+) error {
+	if len(body) > cfgBackend.MaxErrorBody {
+		body = body[:cfgBackend.MaxErrorBody]
+	}
+	relayed := strings.TrimSpace(string(body))
+	switch {
+	case resp.StatusCode == http.StatusUnauthorized ||
+		resp.StatusCode == http.StatusForbidden:
+		return errBackend.Auth(
+			c.cfg.Name, resp.Status, relayed, c.authHint(),
+		)
+	case resp.StatusCode == http.StatusNotFound &&
+		path == cfgBackend.PathChat:
+		return errBackend.Model(c.cfg.Name, resp.Status, relayed)
+	default:
+		return errBackend.Upstream(c.cfg.Name, resp.Status, relayed)
+	}
+}
+
+// apiKey reads the credential from the configured environment
+// variable. ctx never stores the key.
+//
+// Returns:
+//   - string: the key, or "" when no variable is configured or set
+func (c *client) apiKey() string {
+	if c.cfg.APIKeyEnv == "" {
+		return ""
+	}
+	return os.Getenv(c.cfg.APIKeyEnv)
+}
+
+// authHint names where the credential comes from, for auth errors.
+//
+// Returns:
+//   - string: the environment variable, or the .ctxrc key to set
+func (c *client) authHint() string {
+	if c.cfg.APIKeyEnv != "" {
+		return c.cfg.APIKeyEnv
+	}
+	return fmt.Sprintf(desc.Text(text.DescKeyErrBackendAuthHint), c.cfg.Name)
+}
+
+// timedOut reports whether a transport error is a timeout.
+//
+// Parameters:
+//   - err: the transport error
+//
+// Returns:
+//   - bool: true for deadline and client timeouts
+func timedOut(err error) bool {
+	return errors.Is(err, context.DeadlineExceeded) || os.IsTimeout(err)
+}
@@ -0,0 +1,243 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package backend_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ActiveMemory/ctx/internal/backend"
+	cfgBackend "github.com/ActiveMemory/ctx/internal/config/backend"
+)
+
+const testSchema = `{"type":"object"}`
+
+// fakeServer starts a local OpenAI-compatible server that lists
+// one model and answers chat requests with the given content.
+func fakeServer(
+	t *testing.T, content string, seen *map[string]any,
+) *httptest.Server {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/models", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"data":[{"id":"local-model"},{"id":"other"}]}`))
+	})
+	mux.HandleFunc("/v1/chat/completions",
+		func(w http.ResponseWriter, r *http.Request) {
+			if seen != nil {
+				body := map[string]any{}
+				if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+					t.Errorf("decode request: %v", err)
+				}
+				body["authorization"] = r.Header.Get("Authorization")
+				*seen = body
+			}
+			reply, _ := json.Marshal(map[string]any{
+				"model": "local-model",
+				"choices": []any{map[string]any{
+					"message": map[string]any{
+						"role": "assistant", "content": content,
+					},
+				}},
+			})
+			_, _ = w.Write(reply)
+		})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func newBackend(url string) backend.Backend {
+	return backend.New(backend.Config{
+		Name: "vllm", Endpoint: url, Timeout: 5 * time.Second,
+	})
+}
+
+func TestPing_FirstModel(t *testing.T) {
+	srv := fakeServer(t, "", nil)
+	model, err := newBackend(srv.URL).Ping(context.Background())
+	if err != nil {
+		t.Fatalf("Ping() error = %v", err)
+	}
+	if model != "local-model" {
+		t.Errorf("Ping() = %q, want local-model", model)
+	}
+}
+
+func TestComplete_SchemaConstrained(t *testing.T) {
+	var seen map[string]any
+	srv := fakeServer(t, `{"target":"skip","reason":"x"}`, &seen)
+	t.Setenv("TEST_BACKEND_KEY", "secret")
+
+	b := backend.New(backend.Config{
+		Name: "openai", Endpoint: srv.URL, APIKeyEnv: "TEST_BACKEND_KEY",
+		Model: "pinned", Timeout: 5 * time.Second,
+	})
+	resp, err := b.Complete(context.Background(), backend.Request{
+		System: "sys", Prompt: "in", SchemaName: "s",
+		Schema: json.RawMessage(testSchema),
+	})
+	if err != nil {
+		t.Fatalf("Complete() error = %v", err)
+	}
+	if resp.Content != `{"target":"skip","reason":"x"}` {
+		t.Errorf("Content = %q", resp.Content)
+	}
+	if seen["model"] != "pinned" {
+		t.Errorf("model = %v, want pinned", seen["model"])
+	}
+	if seen["temperature"] != float64(0) {
+		t.Errorf("temperature = %v, want 0", seen["temperature"])
+	}
+	if seen["authorization"] != "Bearer secret" {
+		t.Errorf("authorization = %v", seen["authorization"])
+	}
+	format, _ := seen["response_format"].(map[string]any)
+	if format["type"] != "json_schema" {
+		t.Errorf("response_format.type = %v", format["type"])
+	}
+	schema, _ := format["json_schema"].(map[string]any)
+	if schema["strict"] != true || schema["name"] != "s" {
+		t.Errorf("json_schema = %v", schema)
+	}
+}
+
+func TestComplete_DiscoversModel(t *testing.T) {
+	var seen map[string]any
+	srv := fakeServer(t, "{}", &seen)
+	if _, err := newBackend(srv.URL).Complete(
+		context.Background(), backend.Request{Schema: json.RawMessage(testSchema)},
+	); err != nil {
+		t.Fatalf("Complete() error = %v", err)
+	}
+	if seen["model"] != "local-model" {
+		t.Errorf("model = %v, want the first listed model", seen["model"])
+	}
+	if seen["authorization"] != "" {
+		t.Errorf("no api_key_env must send no credential, got %v",
+			seen["authorization"])
+	}
+}
+
+func TestComplete_FailsClosed(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		body   string
+		want   []string
+	}{
+		{"unauthorized", http.StatusUnauthorized, `{"error":"bad key"}`,
+			[]string{"rejected the credential", `{"error":"bad key"}`,
+				"backends.vllm.api_key_env"}},
+		{"forbidden", http.StatusForbidden, "denied",
+			[]string{"rejected the credential", "denied"}},
+		{"model not found", http.StatusNotFound, "no such model",
+			[]string{"rejected the model selection", "no such model"}},
+		{"server error", http.StatusInternalServerError, "boom",
+			[]string{"returned 500", "boom"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			srv := httptest.NewServer(http.HandlerFunc(
+				func(w http.ResponseWriter, _ *http.Request) {
+					calls++
+					w.WriteHeader(tt.status)
+					_, _ = w.Write([]byte(tt.body))
+				}))
+			defer srv.Close()
+
+			b := backend.New(backend.Config{
+				Name: "vllm", Endpoint: srv.URL, Model: "m",
+				Timeout: 5 * time.Second,
+			})
+			_, err := b.Complete(context.Background(), backend.Request{
+				Schema: json.RawMessage(testSchema),
+			})
+			if err == nil {
+				t.Fatal("expected error")
+			}
+			for _, w := range tt.want {
+				if !strings.Contains(err.Error(), w) {
+					t.Errorf("error %q missing %q", err, w)
+				}
+			}
+			if calls != 1 {
+				t.Errorf("calls = %d, want 1 (no retries)", calls)
+			}
+		})
+	}
+}
+
+func TestComplete_OversizedReply(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(strings.Repeat(
+				" ", cfgBackend.MaxResponseBody+1,
+			)))
+		}))
+	defer srv.Close()
+
+	b := backend.New(backend.Config{
+		Name: "vllm", Endpoint: srv.URL, Model: "m",
+		Timeout: 5 * time.Second,
+	})
+	_, err := b.Complete(context.Background(), backend.Request{
+		Schema: json.RawMessage(testSchema),
+	})
+	if err == nil || !strings.Contains(err.Error(), "response exceeds") {
+		t.Fatalf("Complete() error = %v, want size cap", err)
+	}
+}
+
+func TestPing_Timeout(t *testing.T) {
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(
+		func(_ http.ResponseWriter, _ *http.Request) {
+			<-release
+		}))
+	defer srv.Close()
+	defer close(release)
+
+	b := backend.New(backend.Config{
+		Name: "vllm", Endpoint: srv.URL, Timeout: 50 * time.Millisecond,
+	})
+	_, err := b.Ping(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "timed out after 50ms") {
+		t.Fatalf("Ping() error = %v, want timeout", err)
+	}
+}
+
+func TestPing_Unreachable(t *testing.T) {
+	srv := httptest.NewServer(http.NotFoundHandler())
+	url := srv.URL
+	srv.Close()
+
+	_, err := newBackend(url).Ping(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "unreachable at "+url) {
+		t.Fatalf("Ping() error = %v, want unreachable", err)
+	}
+}
+
+func TestPing_NoModels(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(`{"data":[]}`))
+		}))
+	defer srv.Close()
+
+	_, err := newBackend(srv.URL).Ping(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "lists no models") {
+		t.Fatalf("Ping() error = %v, want no-model error", err)
+	}
+}
@@ -0,0 +1,26 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package backend is the optional AI backend layer: a registry of
+// OpenAI-compatible HTTP backends (vllm, openai, ollama, lmstudio,
+// and a generic openai-compatible entry) configured in the .ctxrc
+// backends table.
+//
+// [Select] resolves the backend a command dispatches through:
+// an explicit --backend name, else backends.default, else the
+// single configured entry. Multiple entries without a selector, an
+// empty table, or an unknown name are errors; there is no implicit
+// choice.
+//
+// A [Backend] offers [Backend.Ping] (GET /v1/models) and
+// [Backend.Complete] (POST /v1/chat/completions with a strict
+// json_schema response format). Both fail closed: transport
+// errors, timeouts, and non-2xx replies surface as errors that
+// relay the upstream body verbatim, and nothing is retried.
+//
+// The deterministic core (ctx agent, ctx status, hooks) never
+// imports this package; an audit test enforces the boundary.
+package backend
@@ -0,0 +1,129 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package backend
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	cfgBackend "github.com/ActiveMemory/ctx/internal/config/backend"
+	"github.com/ActiveMemory/ctx/internal/config/token"
+	"github.com/ActiveMemory/ctx/internal/entity"
+	errBackend "github.com/ActiveMemory/ctx/internal/err/backend"
+	"github.com/ActiveMemory/ctx/internal/rc"
+)
+
+// Supported returns the registered backend types, comma-separated,
+// for error messages and help text.
+//
+// Returns:
+//   - string: e.g. "vllm, openai, ollama, lmstudio, openai-compatible"
+func Supported() string {
+	return strings.Join(cfgBackend.Types, token.CommaSpace)
+}
+
+// Resolve validates one .ctxrc backend entry and fills type
+// defaults. The endpoint falls back to the type's default, a
+// trailing slash or /v1 suffix is trimmed, and the timeout falls
+// back to [cfgBackend.DefaultTimeout]. Malformed values are refused
+// with an error naming the offending key; nothing is silently
+// defaulted.
+//
+// Parameters:
+//   - name: backend type (the .ctxrc key)
+//   - e: the raw entry
+//
+// Returns:
+//   - Config: the validated definition
+//   - error: unknown type, missing or invalid endpoint, or invalid
+//     timeout
+func Resolve(name string, e entity.BackendEntry) (Config, error) {
+	if !slices.Contains(cfgBackend.Types, name) {
+		return Config{}, errBackend.UnknownType(name, Supported())
+	}
+
+	endpoint := strings.TrimSpace(e.Endpoint)
+	if endpoint == "" {
+		endpoint = cfgBackend.Endpoint[name]
+	}
+	if endpoint == "" {
+		return Config{}, errBackend.MissingKey(name, cfgBackend.KeyEndpoint)
+	}
+	endpoint = normalizeEndpoint(endpoint)
+	if !validEndpoint(endpoint) {
+		return Config{}, errBackend.InvalidEndpoint(name, e.Endpoint)
+	}
+
+	timeout := cfgBackend.DefaultTimeout
+	if raw := strings.TrimSpace(e.Timeout); raw != "" {
+		d, parseErr := time.ParseDuration(raw)
+		if parseErr != nil || d <= 0 {
+			return Config{}, errBackend.InvalidTimeout(name, e.Timeout)
+		}
+		timeout = d
+	}
+
+	return Config{
+		Name:      name,
+		Endpoint:  endpoint,
+		APIKeyEnv: strings.TrimSpace(e.APIKeyEnv),
+		Model:     strings.TrimSpace(e.Model),
+		Timeout:   timeout,
+	}, nil
+}
+
+// Select picks the backend an AI command dispatches through. An
+// explicit name wins; otherwise backends.default; otherwise the
+// only configured entry. Every other case fails closed.
+//
+// Parameters:
+//   - name: the --backend flag value ("" when omitted)
+//
+// Returns:
+//   - Backend: the selected backend
+//   - error: no backends configured, ambiguous selection, unknown
+//     default, unconfigured name, or an invalid entry
+func Select(name string) (Backend, error) {
+	entries := rc.Backends()
+	if len(entries) == 0 {
+		return nil, errBackend.NotConfigured()
+	}
+
+	if name == "" {
+		picked, pickErr := pick(entries, rc.BackendDefault())
+		if pickErr != nil {
+			return nil, pickErr
+		}
+		name = picked
+	}
+
+	e, ok := entries[name]
+	if !ok {
+		if !slices.Contains(cfgBackend.Types, name) {
+			return nil, errBackend.UnknownType(name, Supported())
+		}
+		return nil, errBackend.NotFound(name)
+	}
+
+	cfg, resolveErr := Resolve(name, e)
+	if resolveErr != nil {
+		return nil, resolveErr
+	}
+	return New(cfg), nil
+}
+
+// New returns the HTTP client for a validated definition.
+//
+// Parameters:
+//   - cfg: definition from [Resolve]
+//
+// Returns:
+//   - Backend: the backend
+func New(cfg Config) Backend {
+	return &client{cfg: cfg}
+}
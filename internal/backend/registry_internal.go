@@ -0,0 +1,73 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package backend
+
+import (
+	"net/url"
+	"strings"
+
+	cfgBackend "github.com/ActiveMemory/ctx/internal/config/backend"
+	cfgHTTP "github.com/ActiveMemory/ctx/internal/config/http"
+	"github.com/ActiveMemory/ctx/internal/config/token"
+	"github.com/ActiveMemory/ctx/internal/entity"
+	errBackend "github.com/ActiveMemory/ctx/internal/err/backend"
+)
+
+// pick chooses a backend name when --backend is omitted.
+//
+// Parameters:
+//   - entries: configured backends (non-empty)
+//   - def: backends.default ("" when unset)
+//
+// Returns:
+//   - string: the chosen backend
+//   - error: unknown default, or several entries and no default
+func pick(entries map[string]entity.BackendEntry, def string) (string, error) {
+	if def != "" {
+		if _, ok := entries[def]; !ok {
+			return "", errBackend.UnknownDefault(def)
+		}
+		return def, nil
+	}
+	if len(entries) > 1 {
+		return "", errBackend.Ambiguous()
+	}
+	for name := range entries {
+		return name, nil
+	}
+	return "", errBackend.NotConfigured()
+}
+
+// normalizeEndpoint trims trailing slashes and a trailing /v1 so
+// request paths can always be joined as /v1/...
+//
+// Parameters:
+//   - endpoint: configured base URL
+//
+// Returns:
+//   - string: the bare base URL
+func normalizeEndpoint(endpoint string) string {
+	endpoint = strings.TrimRight(endpoint, token.Slash)
+	endpoint = strings.TrimSuffix(endpoint, cfgBackend.PathVersion)
+	return strings.TrimRight(endpoint, token.Slash)
+}
+
+// validEndpoint reports whether endpoint is an absolute http:// or
+// https:// URL with a host.
+//
+// Parameters:
+//   - endpoint: normalized base URL
+//
+// Returns:
+//   - bool: true when usable
+func validEndpoint(endpoint string) bool {
+	u, parseErr := url.Parse(endpoint)
+	if parseErr != nil || u.Host == "" {
+		return false
+	}
+	return u.Scheme == cfgHTTP.SchemeHTTP || u.Scheme == cfgHTTP.SchemeHTTPS
+}
@@ -0,0 +1,132 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package backend_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ActiveMemory/ctx/internal/backend"
+	"github.com/ActiveMemory/ctx/internal/entity"
+	"github.com/ActiveMemory/ctx/internal/rc"
+	"github.com/ActiveMemory/ctx/internal/testutil/testctx"
+)
+
+func TestResolve(t *testing.T) {
+	tests := []struct {
+		name     string
+		backend  string
+		entry    entity.BackendEntry
+		endpoint string
+		timeout  time.Duration
+		wantErr  string
+	}{
+		{name: "type defaults", backend: "ollama",
+			endpoint: "http://localhost:11434", timeout: 30 * time.Second},
+		{name: "trailing v1 trimmed", backend: "vllm",
+			entry: entity.BackendEntry{
+				Endpoint: "http://gpu:8000/v1/", Timeout: "2m",
+			},
+			endpoint: "http://gpu:8000", timeout: 2 * time.Minute},
+		{name: "generic needs endpoint", backend: "openai-compatible",
+			wantErr: "backends.openai-compatible.endpoint is required"},
+		{name: "bad scheme", backend: "vllm",
+			entry:   entity.BackendEntry{Endpoint: "ftp://gpu"},
+			wantErr: "must be an http:// or https:// URL"},
+		{name: "bad timeout", backend: "vllm",
+			entry:   entity.BackendEntry{Timeout: "soon"},
+			wantErr: "backends.vllm.timeout"},
+		{name: "unknown type", backend: "anthropic",
+			wantErr: "unknown backend"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := backend.Resolve(tt.backend, tt.entry)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("Resolve() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Resolve() error = %v", err)
+			}
+			if cfg.Endpoint != tt.endpoint || cfg.Timeout != tt.timeout {
+				t.Errorf("Resolve() = %+v", cfg)
+			}
+		})
+	}
+}
+
+// writeRC declares a temp project with the given .ctxrc body.
+func writeRC(t *testing.T, body string) {
+	t.Helper()
+	dir := t.TempDir()
+	orig, _ := os.Getwd()
+	if err := os.Chdir(dir); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		_ = os.Chdir(orig)
+		rc.Reset()
+	})
+	_ = os.MkdirAll(filepath.Join(dir, ".context"), 0o750)
+	testctx.Declare(t, dir)
+	if err := os.WriteFile(
+		filepath.Join(dir, ".ctxrc"), []byte(body), 0o600,
+	); err != nil {
+		t.Fatal(err)
+	}
+	rc.Reset()
+}
+
+func TestSelect(t *testing.T) {
+	two := "backends:\n  vllm: {}\n  ollama: {}\n"
+	tests := []struct {
+		name    string
+		rc      string
+		flag    string
+		want    string
+		wantErr string
+	}{
+		{name: "none configured", rc: "",
+			wantErr: "no backend configured"},
+		{name: "single entry", rc: "backends:\n  ollama: {}\n",
+			want: "ollama"},
+		{name: "multiple without selector", rc: two,
+			wantErr: "multiple backends configured"},
+		{name: "flag wins", rc: two, flag: "vllm", want: "vllm"},
+		{name: "default", rc: two + "  default: ollama\n", want: "ollama"},
+		{name: "unknown default", rc: two + "  default: openai\n",
+			wantErr: "backends.default `openai`"},
+		{name: "not configured", rc: two, flag: "openai",
+			wantErr: "backend `openai` is not configured"},
+		{name: "unregistered", rc: two, flag: "gpt",
+			wantErr: "unknown backend"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			writeRC(t, tt.rc)
+			b, err := backend.Select(tt.flag)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("Select() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Select() error = %v", err)
+			}
+			if b.Name() != tt.want {
+				t.Errorf("Select() = %q, want %q", b.Name(), tt.want)
+			}
+		})
+	}
+}
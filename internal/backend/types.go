@@ -0,0 +1,156 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package backend
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// Backend is one configured OpenAI-compatible AI backend.
+type Backend interface {
+	// Name returns the backend type (its .ctxrc key).
+	Name() string
+	// Endpoint returns the normalized base URL.
+	Endpoint() string
+	// Ping checks reachability and returns the first model the
+	// backend lists.
+	Ping(ctx context.Context) (string, error)
+	// Complete sends one schema-constrained chat request.
+	Complete(ctx context.Context, req Request) (Response, error)
+}
+
+// Config is a validated backend definition.
+//
+// Fields:
+//   - Name: Backend type (vllm, openai, ...)
+//   - Endpoint: Base URL without a trailing /v1
+//   - APIKeyEnv: Environment variable holding the key ("" = none)
+//   - Model: Default model ("" = first listed model)
+//   - Timeout: Per-request timeout
+type Config struct {
+	Name      string
+	Endpoint  string
+	APIKeyEnv string
+	Model     string
+	Timeout   time.Duration
+}
+
+// Request is one structured-output completion request.
+//
+// Fields:
+//   - Model: Model override ("" = the backend's default model)
+//   - System: Instruction message
+//   - Prompt: Input message
+//   - SchemaName: Name sent with the JSON schema
+//   - Schema: JSON schema the answer must match
+type Request struct {
+	Model      string
+	System     string
+	Prompt     string
+	SchemaName string
+	Schema     json.RawMessage
+}
+
+// Response is the answer to a [Request].
+//
+// Fields:
+//   - Model: Model that produced the answer
+//   - Content: Raw message content (JSON matching the schema)
+type Response struct {
+	Model   string
+	Content string
+}
+
+// client implements [Backend] over the OpenAI-compatible HTTP API.
+//
+// Fields:
+//   - cfg: Validated backend definition
+type client struct {
+	cfg Config
+}
+
+// chatRequest is the /v1/chat/completions request body.
+//
+// Fields:
+//   - Model: Model identifier
+//   - Messages: System and user messages
+//   - Temperature: Sampling temperature (0 for repeatable output)
+//   - ResponseFormat: Schema constraint
+type chatRequest struct {
+	Model          string         `json:"model"`
+	Messages       []chatMessage  `json:"messages"`
+	Temperature    float64        `json:"temperature"`
+	ResponseFormat responseFormat `json:"response_format"`
+}
+
+// chatMessage is one chat message.
+//
+// Fields:
+//   - Role: system, user, or assistant
+//   - Content: Message text
+type chatMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+// responseFormat selects schema-constrained output.
+//
+// Fields:
+//   - Type: Always json_schema
+//   - JSONSchema: The named schema
+type responseFormat struct {
+	Type       string     `json:"type"`
+	JSONSchema jsonSchema `json:"json_schema"`
+}
+
+// jsonSchema is the named, strict schema of a response format.
+//
+// Fields:
+//   - Name: Schema name
+//   - Strict: Reject answers that do not match
+//   - Schema: The JSON schema
+type jsonSchema struct {
+	Name   string          `json:"name"`
+	Strict bool            `json:"strict"`
+	Schema json.RawMessage `json:"schema"`
+}
+
+// chatResponse is the /v1/chat/completions reply body.
+//
+// Fields:
+//   - Model: Model that answered
+//   - Choices: Completion choices (the first is used)
+type chatResponse struct {
+	Model   string       `json:"model"`
+	Choices []chatChoice `json:"choices"`
+}
+
+// chatChoice is one completion choice.
+//
+// Fields:
+//   - Message: The assistant message
+type chatChoice struct {
+	Message chatMessage `json:"message"`
+}
+
+// modelList is the /v1/models reply body.
+//
+// Fields:
+//   - Data: Listed models
+type modelList struct {
+	Data []modelEntry `json:"data"`
+}
+
+// modelEntry is one listed model.
+//
+// Fields:
+//   - ID: Model identifier
+type modelEntry struct {
+	ID string `json:"id"`
+}
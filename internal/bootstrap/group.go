@@ -8,6 +8,8 @@ package bootstrap
 
 import (
 	"github.com/ActiveMemory/ctx/internal/cli/agent"
+	"github.com/ActiveMemory/ctx/internal/cli/ai"
+	"github.com/ActiveMemory/ctx/internal/cli/align"
 	"github.com/ActiveMemory/ctx/internal/cli/change"
 	"github.com/ActiveMemory/ctx/internal/cli/compact"
 	"github.com/ActiveMemory/ctx/internal/cli/config"
@@ -18,6 +20,7 @@ import (
 	"github.com/ActiveMemory/ctx/internal/cli/doctor"
 	"github.com/ActiveMemory/ctx/internal/cli/dream"
 	"github.com/ActiveMemory/ctx/internal/cli/drift"
+	"github.com/ActiveMemory/ctx/internal/cli/fleet"
 	ctxFmt "github.com/ActiveMemory/ctx/internal/cli/fmt"
 	"github.com/ActiveMemory/ctx/internal/cli/guide"
 	"github.com/ActiveMemory/ctx/internal/cli/handover"
@@ -32,10 +35,12 @@ import (
 	"github.com/ActiveMemory/ctx/internal/cli/loop"
 	"github.com/ActiveMemory/ctx/internal/cli/mcp"
 	"github.com/ActiveMemory/ctx/internal/cli/memory"
+	"github.com/ActiveMemory/ctx/internal/cli/mine"
 	"github.com/ActiveMemory/ctx/internal/cli/pad"
 	"github.com/ActiveMemory/ctx/internal/cli/permission"
 	"github.com/ActiveMemory/ctx/internal/cli/prune"
 	"github.com/ActiveMemory/ctx/internal/cli/remind"
+	"github.com/ActiveMemory/ctx/internal/cli/search"
 	"github.com/ActiveMemory/ctx/internal/cli/serve"
 	"github.com/ActiveMemory/ctx/internal/cli/setup"
 	"github.com/ActiveMemory/ctx/internal/cli/site"
@@ -76,12 +81,13 @@ func gettingStarted() []registration {
 // commands live under the artifacts group as ctx <noun> add.
 //
 // Returns:
-//   - []registration: Load, agent, skill, sync, drift, compact,
-//     and fmt commands
+//   - []registration: Load, agent, search, skill, sync, drift,
+//     compact, and fmt commands
 func contextCmds() []registration {
 	return []registration{
 		{load.Cmd, embedCmd.GroupContext},
 		{agent.Cmd, embedCmd.GroupContext},
+		{search.Cmd, embedCmd.GroupContext},
 		{skill.Cmd, embedCmd.GroupContext},
 		{sync.Cmd, embedCmd.GroupContext},
 		{drift.Cmd, embedCmd.GroupContext},
@@ -99,12 +105,14 @@ func contextCmds() []registration {
 // a computed table of contents.
 //
 // Returns:
-//   - []registration: Decision, learning, task, convention,
-//     index, kb, and handover commands
+//   - []registration: Decision, learning, mine, align, task,
+//     convention, index, kb, and handover commands
 func artifacts() []registration {
 	return []registration{
 		{decision.Cmd, embedCmd.GroupArtifacts},
 		{learning.Cmd, embedCmd.GroupArtifacts},
+		{mine.Cmd, embedCmd.GroupArtifacts},
+		{align.Cmd, embedCmd.GroupArtifacts},
 		{task.Cmd, embedCmd.GroupArtifacts},
 		{convention.Cmd, embedCmd.GroupArtifacts},
 		{index.Cmd, embedCmd.GroupArtifacts},
@@ -145,20 +153,23 @@ func runtimeCmds() []registration {
 // integrations returns command registrations for the integrations group.
 //
 // This group covers commands that connect ctx to external
-// systems: AI-tool setup, the ctx Hub server and its clients,
-// the MCP server, webhooks, watchers, and loop harnesses.
+// systems: AI-tool setup, the optional AI backend, the ctx Hub
+// server and its clients, task leasing between agents, the MCP
+// server, webhooks, watchers, and loop harnesses.
 //
 // Returns:
-//   - []registration: Setup, steering, trigger, serve, hub,
-//     connect, mcp, watch, and loop commands
+//   - []registration: Setup, ai, steering, trigger, serve, hub,
+//     connect, fleet, mcp, watch, and loop commands
 func integrations() []registration {
 	return []registration{
 		{setup.Cmd, embedCmd.GroupIntegration},
+		{ai.Cmd, embedCmd.GroupIntegration},
 		{steering.Cmd, embedCmd.GroupIntegration},
 		{trigger.Cmd, embedCmd.GroupIntegration},
 		{serve.Cmd, embedCmd.GroupIntegration},
 		{cliHub.Cmd, embedCmd.GroupIntegration},
 		{connection.Cmd, embedCmd.GroupIntegration},
+		{fleet.Cmd, embedCmd.GroupIntegration},
 		{mcp.Cmd, embedCmd.GroupIntegration},
 		{watch.Cmd, embedCmd.GroupIntegration},
 		{loop.Cmd, embedCmd.GroupIntegration},
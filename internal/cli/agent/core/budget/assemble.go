@@ -94,15 +94,18 @@ func AssemblePacket(
 		return pkt
 	}
 
-	// Extract keywords from tasks for relevance scoring
+	// Extract keywords from tasks for relevance scoring; rank
+	// entries against them with the search index when it is
+	// available.
 	keywords := score.ExtractTaskKeywords(pkt.Tasks)
+	ranks := IndexRanks(ctx.Dir, keywords)
 
 	// Tier 4+5: Decisions + Learnings (share remaining budget)
 	decisionBlocks := ParseEntryBlocks(ctx, cfgCtx.Decision)
 	learningBlocks := ParseEntryBlocks(ctx, cfgCtx.Learning)
 
-	scoredDecisions := score.All(decisionBlocks, keywords, now)
-	scoredLearnings := score.All(learningBlocks, keywords, now)
+	scoredDecisions := score.All(decisionBlocks, keywords, ranks, now)
+	scoredLearnings := score.All(learningBlocks, keywords, ranks, now)
 
 	// Split the remaining budget: proportional to content size, minimum 30% each
 	decTokens, learnTokens := Split(
@@ -7,6 +7,8 @@
 package budget
 
 import (
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -434,3 +436,61 @@ func TestAssemblePacket_SkillOmittedWhenBudgetExhausted(t *testing.T) {
 		t.Error("expected skill to be omitted when budget exhausted")
 	}
 }
+
+func TestIndexRanks(t *testing.T) {
+	dir := t.TempDir()
+	decisions := `# Decisions
+
+## [2026-03-01-090000] Retry hub publishes with backoff
+
+Publishing retries with exponential backoff.
+
+## [2026-01-10-120000] Use JSONL for the hub log
+
+Append-only JSONL keeps the log simple.
+`
+	path := filepath.Join(dir, "DECISIONS.md")
+	if err := os.WriteFile(path, []byte(decisions), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	learnings := `# Learnings
+
+## [2026-01-10-120000] Retry storms need jitter
+
+Backoff without jitter synchronizes clients.
+`
+	lpath := filepath.Join(dir, "LEARNINGS.md")
+	if err := os.WriteFile(lpath, []byte(learnings), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	ranks := IndexRanks(dir, []string{"backoff", "retry"})
+	best := score.RankKey(
+		"2026-03-01-090000", "Retry hub publishes with backoff",
+	)
+	if ranks[best] != 1.0 {
+		t.Errorf("best match rank = %v, want 1.0", ranks)
+	}
+	unmatched := score.RankKey("2026-01-10-120000", "Use JSONL for the hub log")
+	if _, ok := ranks[unmatched]; ok {
+		t.Errorf("unmatched entry ranked: %v", ranks)
+	}
+	// A learning sharing the decision's timestamp ranks on its own.
+	learning := score.RankKey("2026-01-10-120000", "Retry storms need jitter")
+	if ranks[learning] == 0 {
+		t.Errorf("learning with a shared timestamp not ranked: %v", ranks)
+	}
+	if _, statErr := os.Stat(
+		filepath.Join(dir, "state", "search-index.json"),
+	); !os.IsNotExist(statErr) {
+		t.Errorf("ranking wrote the search index: %v", statErr)
+	}
+
+	if IndexRanks(dir, nil) != nil {
+		t.Error("expected nil ranks without keywords")
+	}
+	if IndexRanks("", []string{"retry"}) != nil {
+		t.Error("expected nil ranks without a context directory")
+	}
+}
@@ -0,0 +1,67 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package budget
+
+import (
+	"strings"
+
+	"github.com/ActiveMemory/ctx/internal/cli/agent/core/score"
+	"github.com/ActiveMemory/ctx/internal/config/entry"
+	cfgSearch "github.com/ActiveMemory/ctx/internal/config/search"
+	"github.com/ActiveMemory/ctx/internal/config/token"
+	"github.com/ActiveMemory/ctx/internal/search"
+)
+
+// IndexRanks ranks decisions and learnings against task keywords
+// with the context search index.
+//
+// The index is refreshed in memory only; ctx agent never writes
+// the index file, which stays the job of ctx search.
+//
+// Scores are divided by the best score so they share the 0.0-1.0
+// range of keyword-overlap relevance. An entry matched in several
+// places (for example, also in a theme file) keeps its best score.
+//
+// Parameters:
+//   - ctxDir: Context directory holding the index
+//   - keywords: Task keywords to rank by
+//
+// Returns:
+//   - map[string]float64: Normalized scores keyed by
+//     [score.RankKey]; nil when there are no keywords or the index
+//     cannot be opened, so callers fall back to keyword overlap
+func IndexRanks(ctxDir string, keywords []string) map[string]float64 {
+	if ctxDir == "" || len(keywords) == 0 {
+		return nil
+	}
+	ix, openErr := search.Read(ctxDir)
+	if openErr != nil {
+		return nil
+	}
+	query := strings.Join(append([]string{
+		cfgSearch.FieldType + entry.Decision,
+		cfgSearch.FieldType + entry.Learning,
+	}, keywords...), token.Space)
+	hits, searchErr := ix.Search(query, 0)
+	if searchErr != nil {
+		return nil
+	}
+
+	ranks := make(map[string]float64)
+	if len(hits) == 0 || hits[0].Score <= 0 {
+		return ranks
+	}
+	best := hits[0].Score
+	for _, h := range hits {
+		if h.Key == "" {
+			continue
+		}
+		key := score.RankKey(h.Key, h.Title)
+		ranks[key] = max(ranks[key], h.Score/best)
+	}
+	return ranks
+}
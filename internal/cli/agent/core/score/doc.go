@@ -35,10 +35,16 @@
 //     Stop words come from the embedded list in
 //     [internal/assets/read/lookup.StopWords].
 //
-// [Score](entry, taskKeywords) sums the two for a 0.0-2.0
-// composite. [All](entries, taskKeywords) is the bulk
-// scorer that returns parallel slices for the budget
-// allocator.
+//     When the context search index is available, the
+//     budget allocator ranks entries against the task
+//     keywords with BM25 instead and passes the normalized
+//     scores in; [Ranked](entry, ranks) reads them. Range
+//     0.0-1.0 either way.
+//
+// [Score](entry, taskKeywords, ranks) sums the two for a
+// 0.0-2.0 composite. [All](entries, taskKeywords, ranks) is
+// the bulk scorer that returns parallel slices for the
+// budget allocator.
 //
 // # Why Bucketed Recency
 //
@@ -52,5 +58,6 @@
 //
 // # Concurrency
 //
-// All functions are pure. Concurrent callers never race.
+// All functions are pure: ranks are computed by the caller.
+// Concurrent callers never race.
 package score
@@ -13,6 +13,7 @@ import (
 	"github.com/ActiveMemory/ctx/internal/assets/read/lookup"
 	"github.com/ActiveMemory/ctx/internal/config/agent"
 	cfgTime "github.com/ActiveMemory/ctx/internal/config/time"
+	cfgToken "github.com/ActiveMemory/ctx/internal/config/token"
 	"github.com/ActiveMemory/ctx/internal/context/token"
 	"github.com/ActiveMemory/ctx/internal/heading"
 	"github.com/ActiveMemory/ctx/internal/i18n"
@@ -80,22 +81,64 @@ func Relevance(eb *heading.EntryBlock, keywords []string) float64 {
 	return float64(matches) / float64(agent.RelevanceMatchCap)
 }
 
+// RankKey identifies an entry in search-index ranks.
+//
+// The timestamp alone is not unique: a decision and a learning
+// recorded in the same second share one. The title tells them
+// apart, while copies of one entry in the archive or a theme
+// file still share a key.
+//
+// Parameters:
+//   - timestamp: Entry timestamp (YYYY-MM-DD-HHMMSS)
+//   - title: Entry heading text after the timestamp
+//
+// Returns:
+//   - string: The rank key
+func RankKey(timestamp, title string) string {
+	return timestamp + cfgToken.Space + title
+}
+
+// Ranked returns an entry's relevance from search-index ranks.
+//
+// Entries the index did not match score 0.0.
+//
+// Parameters:
+//   - eb: Entry block to score
+//   - ranks: Normalized search scores (0.0-1.0) keyed by
+//     [RankKey]
+//
+// Returns:
+//   - float64: Relevance score between 0.0 and 1.0
+func Ranked(eb *heading.EntryBlock, ranks map[string]float64) float64 {
+	return ranks[RankKey(eb.Entry.Timestamp, eb.Entry.Title)]
+}
+
 // Score computes the combined relevance score for an entry block.
 //
 // Superseded entries always get score 0.0.
 // All other entries get recency and task relevance (range 0.0-2.0).
+// Task relevance comes from ranks when the caller has them and
+// falls back to keyword overlap otherwise.
 //
 // Parameters:
 //   - eb: Entry block to score
 //   - keywords: Task keywords for relevance matching
+//   - ranks: Search-index ranks by [RankKey] (nil to use
+//     keyword overlap)
 //   - now: Current time for recency calculation
 //
 // Returns:
 //   - float64: Combined score (0.0-2.0), or 0.0 if superseded
-func Score(eb *heading.EntryBlock, keywords []string, now time.Time) float64 {
+func Score(
+	eb *heading.EntryBlock, keywords []string,
+	ranks map[string]float64, now time.Time,
+) float64 {
 	if eb.IsSuperseded() {
 		return 0.0
 	}
+	if ranks != nil {
+		return Recency(eb, now) + Ranked(eb, ranks)
+	}
 	return Recency(eb, now) + Relevance(eb, keywords)
 }
 
@@ -134,16 +177,19 @@ func ExtractTaskKeywords(tasks []string) []string {
 // Parameters:
 //   - blocks: Parsed entry blocks from a knowledge file
 //   - keywords: Task keywords for relevance matching
+//   - ranks: Search-index ranks by entry timestamp (nil to use
+//     keyword overlap)
 //   - now: Current time for recency scoring
 //
 // Returns:
 //   - []ScoredEntry: Entries sorted by score descending, with token estimates
 func All(
-	blocks []heading.EntryBlock, keywords []string, now time.Time,
+	blocks []heading.EntryBlock, keywords []string,
+	ranks map[string]float64, now time.Time,
 ) []Entry {
 	scored := make([]Entry, 0, len(blocks))
 	for i := range blocks {
-		s := Score(&blocks[i], keywords, now)
+		s := Score(&blocks[i], keywords, ranks, now)
 		tokens := token.EstimateString(blocks[i].BlockContent())
 		scored = append(scored, Entry{
 			EntryBlock: blocks[i],
@@ -116,7 +116,7 @@ func TestScoreEntry_Superseded(t *testing.T) {
 			"~~Superseded by [2026-02-19-130000] New decision~~",
 		},
 	}
-	got := Score(&eb, []string{"decision"}, now)
+	got := Score(&eb, []string{"decision"}, nil, now)
 	if got != 0.0 {
 		t.Errorf("superseded entry score = %v, want 0.0", got)
 	}
@@ -129,13 +129,36 @@ func TestScoreEntry_Combined(t *testing.T) {
 		"2026-02-19", "Hook edge cases",
 		"hooks fail silently in agent mode",
 	)
-	got := Score(&eb, []string{"hook", "agent", "scoring"}, now)
+	got := Score(&eb, []string{"hook", "agent", "scoring"}, nil, now)
 	// recency = 1.0, relevance = 2/3 ≈ 1.667
 	if got < 1.66 || got > 1.67 {
 		t.Errorf("Entry() = %v, want ~1.667", got)
 	}
 }
 
+func TestScoreEntry_Ranked(t *testing.T) {
+	now := time.Date(2026, 2, 19, 12, 0, 0, 0, time.Local)
+	eb := makeBlock(
+		"2026-02-19", "Hook edge cases",
+		"hooks fail silently in agent mode",
+	)
+	ranks := map[string]float64{
+		RankKey("2026-02-19-120000", "Hook edge cases"): 0.5,
+		// Same second, different entry: must not leak in.
+		RankKey("2026-02-19-120000", "Other entry"): 0.9,
+	}
+	// Ranks replace keyword overlap: 1.0 recency + 0.5 rank.
+	got := Score(&eb, []string{"hook", "agent", "scoring"}, ranks, now)
+	if got != 1.5 {
+		t.Errorf("Score() with ranks = %v, want 1.5", got)
+	}
+	// An entry the index did not match gets no relevance.
+	got = Score(&eb, nil, map[string]float64{}, now)
+	if got != 1.0 {
+		t.Errorf("Score() unmatched = %v, want 1.0", got)
+	}
+}
+
 func TestExtractTaskKeywords(t *testing.T) {
 	tasks := []string{
 		"- [ ] Implement hook scoring for the agent",
@@ -206,7 +229,7 @@ func TestScoreEntries_Ordering(t *testing.T) {
 		makeBlock("2026-02-10", "Medium age", "hook configuration"),
 	}
 	keywords := []string{"hook", "scoring", "agent"}
-	scored := All(blocks, keywords, now)
+	scored := All(blocks, keywords, nil, now)
 
 	if len(scored) != 3 {
 		t.Fatalf("expected 3 scored entries, got %d", len(scored))
@@ -226,7 +249,7 @@ func TestScoreEntries_Ordering(t *testing.T) {
 
 func TestScoreEntries_Empty(t *testing.T) {
 	now := time.Now()
-	scored := All(nil, nil, now)
+	scored := All(nil, nil, nil, now)
 	if len(scored) != 0 {
 		t.Errorf("expected empty scored entries, got %d", len(scored))
 	}
@@ -240,7 +263,7 @@ func TestScoreEntries_TokenEstimate(t *testing.T) {
 			"This is some body content for testing tokens.",
 		),
 	}
-	scored := All(blocks, nil, now)
+	scored := All(blocks, nil, nil, now)
 	if scored[0].Tokens <= 0 {
 		t.Error("expected positive token estimate")
 	}
@@ -0,0 +1,27 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package ai
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/cli/ai/cmd/classify"
+	"github.com/ActiveMemory/ctx/internal/cli/ai/cmd/ping"
+	"github.com/ActiveMemory/ctx/internal/cli/parent"
+	"github.com/ActiveMemory/ctx/internal/config/embed/cmd"
+)
+
+// Cmd returns the "ctx ai" parent command.
+//
+// Returns:
+//   - *cobra.Command: The ai command with subcommands registered
+func Cmd() *cobra.Command {
+	return parent.Cmd(cmd.DescKeyAI, cmd.UseAI,
+		ping.Cmd(),
+		classify.Cmd(),
+	)
+}
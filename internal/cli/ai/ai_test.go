@@ -0,0 +1,229 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package ai
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ActiveMemory/ctx/internal/memory"
+	"github.com/ActiveMemory/ctx/internal/testutil/testctx"
+)
+
+const testMemory = `# Memory
+
+## Parser notes
+The YAML parser treats a bare "on" as a boolean.
+
+## Naming
+Always use kebab-case for file names.
+`
+
+// newProject declares a temp project with one context file, a
+// MEMORY.md, and a .ctxrc pointing the vllm backend at endpoint.
+func newProject(t *testing.T, endpoint string) string {
+	t.Helper()
+	root := t.TempDir()
+	ctxDir := testctx.Declare(t, root)
+	if err := os.MkdirAll(ctxDir, 0o750); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(
+		filepath.Join(ctxDir, "DECISIONS.md"), []byte("# Decisions\n"), 0o600,
+	); err != nil {
+		t.Fatal(err)
+	}
+	if endpoint != "" {
+		rc := "backends:\n  vllm:\n    endpoint: " + endpoint + "\n"
+		if err := os.WriteFile(
+			filepath.Join(root, ".ctxrc"), []byte(rc), 0o600,
+		); err != nil {
+			t.Fatal(err)
+		}
+	}
+	memDir := filepath.Join(
+		root, ".claude", "projects", memory.ProjectSlug(root), "memory",
+	)
+	if err := os.MkdirAll(memDir, 0o750); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(
+		filepath.Join(memDir, "MEMORY.md"), []byte(testMemory), 0o600,
+	); err != nil {
+		t.Fatal(err)
+	}
+	return ctxDir
+}
+
+// fakeBackend answers /v1/models and chat completions with content,
+// counting chat requests.
+func fakeBackend(t *testing.T, content string, calls *int) string {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/models", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"data":[{"id":"local-model"}]}`))
+	})
+	mux.HandleFunc("/v1/chat/completions",
+		func(w http.ResponseWriter, _ *http.Request) {
+			*calls++
+			reply, _ := json.Marshal(map[string]any{
+				"model": "local-model",
+				"choices": []any{map[string]any{
+					"message": map[string]any{"content": content},
+				}},
+			})
+			_, _ = w.Write(reply)
+		})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return srv.URL
+}
+
+func run(t *testing.T, args ...string) (string, error) {
+	t.Helper()
+	c := Cmd()
+	var out bytes.Buffer
+	c.SetOut(&out)
+	c.SetErr(&out)
+	c.SetArgs(args)
+	err := c.Execute()
+	return out.String(), err
+}
+
+// snapshot reads every Markdown file in the context directory.
+func snapshot(t *testing.T, ctxDir string) map[string]string {
+	t.Helper()
+	files, _ := filepath.Glob(filepath.Join(ctxDir, "*.md"))
+	out := map[string]string{}
+	for _, f := range files {
+		data, err := os.ReadFile(f)
+		if err != nil {
+			t.Fatal(err)
+		}
+		out[f] = string(data)
+	}
+	return out
+}
+
+func TestPing(t *testing.T) {
+	calls := 0
+	url := fakeBackend(t, "", &calls)
+	newProject(t, url)
+
+	out, err := run(t, "ping")
+	if err != nil {
+		t.Fatalf("ping: %v", err)
+	}
+	if !strings.Contains(out, "vllm reachable at "+url) ||
+		!strings.Contains(out, "local-model") {
+		t.Errorf("output = %q", out)
+	}
+}
+
+func TestPing_NoBackendFailsClosed(t *testing.T) {
+	newProject(t, "")
+	_, err := run(t, "ping")
+	if err == nil || !strings.Contains(err.Error(), "no backend configured") {
+		t.Fatalf("ping error = %v, want no backend configured", err)
+	}
+}
+
+func TestClassify_WritesProposal(t *testing.T) {
+	calls := 0
+	url := fakeBackend(t, `{"target":"learning","reason":"a gotcha"}`, &calls)
+	ctxDir := newProject(t, url)
+	before := snapshot(t, ctxDir)
+
+	out, err := run(t, "classify")
+	if err != nil {
+		t.Fatalf("classify: %v", err)
+	}
+	if calls != 1 {
+		t.Errorf("chat calls = %d, want 1 (rule-classified entry skipped)",
+			calls)
+	}
+
+	files, _ := filepath.Glob(filepath.Join(
+		ctxDir, "state", "proposals", "memory-classify-*.json",
+	))
+	if len(files) != 1 {
+		t.Fatalf("proposal files = %v, want 1\n%s", files, out)
+	}
+	data, readErr := os.ReadFile(files[0])
+	if readErr != nil {
+		t.Fatal(readErr)
+	}
+	var got proposalFileView
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatal(err)
+	}
+	if got.Backend != "vllm" || got.Model != "local-model" ||
+		len(got.Proposals) != 1 {
+		t.Fatalf("proposal file = %+v", got)
+	}
+	p := got.Proposals[0]
+	if p.Target != "learning" || p.Rule != "skip" ||
+		!strings.Contains(p.Text, "bare \"on\"") {
+		t.Errorf("proposal = %+v", p)
+	}
+
+	after := snapshot(t, ctxDir)
+	if len(after) != len(before) {
+		t.Fatalf("context files changed: %v -> %v", before, after)
+	}
+	for f, content := range before {
+		if after[f] != content {
+			t.Errorf("%s changed", f)
+		}
+	}
+}
+
+func TestClassify_AllSendsEveryEntry(t *testing.T) {
+	calls := 0
+	url := fakeBackend(t, `{"target":"convention","reason":"rule"}`, &calls)
+	newProject(t, url)
+
+	if _, err := run(t, "classify", "--all"); err != nil {
+		t.Fatalf("classify --all: %v", err)
+	}
+	if calls != 2 {
+		t.Errorf("chat calls = %d, want 2", calls)
+	}
+}
+
+func TestClassify_RejectsAnswerOutsideSchema(t *testing.T) {
+	calls := 0
+	url := fakeBackend(t, `{"target":"wiki","reason":"?"}`, &calls)
+	ctxDir := newProject(t, url)
+
+	_, err := run(t, "classify")
+	if err == nil || !strings.Contains(err.Error(), "does not match the schema") {
+		t.Fatalf("classify error = %v, want schema mismatch", err)
+	}
+	if _, statErr := os.Stat(
+		filepath.Join(ctxDir, "state", "proposals"),
+	); !os.IsNotExist(statErr) {
+		t.Errorf("proposal queue written on failure: %v", statErr)
+	}
+}
+
+// proposalFileView mirrors the proposal file for decoding.
+type proposalFileView struct {
+	Backend   string `json:"backend"`
+	Model     string `json:"model"`
+	Proposals []struct {
+		Text   string `json:"text"`
+		Rule   string `json:"rule"`
+		Target string `json:"target"`
+	} `json:"proposals"`
+}
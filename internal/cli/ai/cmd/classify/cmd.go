@@ -0,0 +1,42 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package classify
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	coreClassify "github.com/ActiveMemory/ctx/internal/cli/ai/core/classify"
+	"github.com/ActiveMemory/ctx/internal/config/embed/cmd"
+	"github.com/ActiveMemory/ctx/internal/config/embed/flag"
+	cFlag "github.com/ActiveMemory/ctx/internal/config/flag"
+	"github.com/ActiveMemory/ctx/internal/flagbind"
+)
+
+// Cmd returns the ai classify subcommand.
+//
+// Returns:
+//   - *cobra.Command: configured classify subcommand
+func Cmd() *cobra.Command {
+	var opts coreClassify.Opts
+
+	short, long := desc.Command(cmd.DescKeyAIClassify)
+	c := &cobra.Command{
+		Use:     cmd.UseAIClassify,
+		Short:   short,
+		Long:    long,
+		Example: desc.Example(cmd.DescKeyAIClassify),
+		Args:    cobra.NoArgs,
+		RunE: func(cobraCmd *cobra.Command, _ []string) error {
+			return Run(cobraCmd, opts)
+		},
+	}
+
+	flagbind.StringFlag(c, &opts.Backend, cFlag.Backend, flag.DescKeyAIBackend)
+	flagbind.BoolFlag(c, &opts.All, cFlag.All, flag.DescKeyAIClassifyAll)
+	return c
+}
@@ -0,0 +1,12 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package classify wires the "ctx ai classify" subcommand.
+//
+// It builds the cobra command with the --backend selector and the
+// --all switch and delegates to the classify core logic, which
+// writes the backend's proposals to the proposal queue.
+package classify
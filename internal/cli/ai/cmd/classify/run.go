@@ -0,0 +1,25 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package classify
+
+import (
+	"github.com/spf13/cobra"
+
+	coreClassify "github.com/ActiveMemory/ctx/internal/cli/ai/core/classify"
+)
+
+// Run delegates to the classify core logic.
+//
+// Parameters:
+//   - cmd: cobra command for output
+//   - opts: the --backend and --all values
+//
+// Returns:
+//   - error: non-nil on selection, backend, read, or write failure
+func Run(cmd *cobra.Command, opts coreClassify.Opts) error {
+	return coreClassify.Run(cmd, opts)
+}
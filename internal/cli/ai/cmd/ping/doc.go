@@ -0,0 +1,12 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package ping wires the "ctx ai ping" subcommand.
+//
+// It builds the cobra command with the --backend selector and
+// delegates to the ping core logic, which lists the backend's
+// models and reports the first one.
+package ping
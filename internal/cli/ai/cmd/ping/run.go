@@ -0,0 +1,25 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package ping
+
+import (
+	"github.com/spf13/cobra"
+
+	corePing "github.com/ActiveMemory/ctx/internal/cli/ai/core/ping"
+)
+
+// Run delegates to the ping core logic.
+//
+// Parameters:
+//   - cmd: cobra command for output
+//   - name: the --backend value ("" selects the default)
+//
+// Returns:
+//   - error: non-nil when no backend is selectable or reachable
+func Run(cmd *cobra.Command, name string) error {
+	return corePing.Run(cmd, name)
+}
@@ -0,0 +1,95 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package classify
+
+import (
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/backend"
+	"github.com/ActiveMemory/ctx/internal/cli/memory/core/resolve"
+	cfgBackend "github.com/ActiveMemory/ctx/internal/config/backend"
+	cfgFmt "github.com/ActiveMemory/ctx/internal/config/format"
+	errState "github.com/ActiveMemory/ctx/internal/err/state"
+	"github.com/ActiveMemory/ctx/internal/format"
+	"github.com/ActiveMemory/ctx/internal/memory"
+	writeAI "github.com/ActiveMemory/ctx/internal/write/ai"
+)
+
+// Run classifies pending MEMORY.md entries through the selected
+// backend and writes the proposals to the proposal queue.
+//
+// Parameters:
+//   - cmd: cobra command for output
+//   - opts: the --backend and --all values
+//
+// Returns:
+//   - error: selection, discovery, read, backend, or write failure
+func Run(cmd *cobra.Command, opts Opts) error {
+	contextDir, projectRoot, ctxErr := resolve.ContextAndRoot(cmd)
+	if ctxErr != nil {
+		return ctxErr
+	}
+	b, selectErr := backend.Select(opts.Backend)
+	if selectErr != nil {
+		cmd.SilenceUsage = true
+		return selectErr
+	}
+
+	sourcePath, discoverErr := resolve.DiscoverSource(cmd, projectRoot)
+	if discoverErr != nil {
+		return discoverErr
+	}
+	sourceData, readErr := resolve.ReadSource(sourcePath)
+	if readErr != nil {
+		return readErr
+	}
+	state, loadErr := memory.LoadState(contextDir)
+	if loadErr != nil {
+		return errState.Load(loadErr)
+	}
+
+	entries := pending(memory.Entries(string(sourceData)), state, opts.All)
+	if len(entries) == 0 {
+		writeAI.ClassifyNone(cmd)
+		return nil
+	}
+
+	out := proposalFile{
+		Kind:    cfgBackend.KindMemoryClassify,
+		Created: time.Now().UTC(),
+		Backend: b.Name(),
+		Source:  sourcePath,
+	}
+	for _, e := range entries {
+		a, model, askErr := ask(cmd.Context(), b, e.Text)
+		if askErr != nil {
+			cmd.SilenceUsage = true
+			return askErr
+		}
+		out.Model = model
+		p := proposal{
+			Hash:   memory.EntryHash(e.Text),
+			Line:   e.StartLine,
+			Title:  format.TruncateFirstLine(e.Text, cfgFmt.TruncateTitle),
+			Text:   e.Text,
+			Rule:   memory.Classify(e).Target,
+			Target: a.Target,
+			Reason: a.Reason,
+		}
+		out.Proposals = append(out.Proposals, p)
+		writeAI.ClassifyEntry(cmd, p.Title, p.Target, p.Reason)
+	}
+
+	path, saveErr := save(contextDir, out)
+	if saveErr != nil {
+		return saveErr
+	}
+	writeAI.ClassifySummary(cmd, len(out.Proposals), b.Name(), out.Model, path)
+	return nil
+}
@@ -0,0 +1,123 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package classify
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strconv"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	"github.com/ActiveMemory/ctx/internal/backend"
+	cfgBackend "github.com/ActiveMemory/ctx/internal/config/backend"
+	"github.com/ActiveMemory/ctx/internal/config/dir"
+	"github.com/ActiveMemory/ctx/internal/config/embed/text"
+	"github.com/ActiveMemory/ctx/internal/config/fs"
+	cfgMemory "github.com/ActiveMemory/ctx/internal/config/memory"
+	cfgTime "github.com/ActiveMemory/ctx/internal/config/time"
+	errBackend "github.com/ActiveMemory/ctx/internal/err/backend"
+	ctxIo "github.com/ActiveMemory/ctx/internal/io"
+	"github.com/ActiveMemory/ctx/internal/memory"
+)
+
+// pending keeps the entries to send: not yet imported and, unless
+// all is set, not already classified by the keyword rules.
+//
+// Parameters:
+//   - entries: parsed MEMORY.md entries
+//   - state: memory bridge state (import hashes)
+//   - all: also keep rule-classified entries
+//
+// Returns:
+//   - []memory.Entry: entries to classify, in file order
+func pending(
+	entries []memory.Entry, state memory.State, all bool,
+) []memory.Entry {
+	var out []memory.Entry
+	for _, e := range entries {
+		if state.Imported(memory.EntryHash(e.Text)) {
+			continue
+		}
+		if !all && memory.Classify(e).Target != cfgMemory.TargetSkip {
+			continue
+		}
+		out = append(out, e)
+	}
+	return out
+}
+
+// ask sends one entry to the backend and validates the answer.
+//
+// Parameters:
+//   - ctx: request context
+//   - b: the selected backend
+//   - entryText: the MEMORY.md entry
+//
+// Returns:
+//   - answer: the validated classification
+//   - string: the model that answered
+//   - error: backend failure or an answer outside the schema
+func ask(
+	ctx context.Context, b backend.Backend, entryText string,
+) (answer, string, error) {
+	resp, completeErr := b.Complete(ctx, backend.Request{
+		System:     desc.Text(text.DescKeyAIClassifySystem),
+		Prompt:     entryText,
+		SchemaName: cfgBackend.SchemaClassifyName,
+		Schema:     json.RawMessage(cfgBackend.SchemaClassify),
+	})
+	if completeErr != nil {
+		return answer{}, "", completeErr
+	}
+	var a answer
+	if decodeErr := json.Unmarshal(
+		[]byte(resp.Content), &a,
+	); decodeErr != nil {
+		return answer{}, "", errBackend.Answer(b.Name(), decodeErr.Error())
+	}
+	if !slices.Contains(cfgBackend.ClassifyTargets, a.Target) {
+		return answer{}, "", errBackend.Answer(
+			b.Name(), strconv.Quote(a.Target),
+		)
+	}
+	return a, resp.Model, nil
+}
+
+// save writes the proposal file under .context/state/proposals/.
+//
+// Parameters:
+//   - contextDir: the context directory
+//   - out: the proposals
+//
+// Returns:
+//   - string: the written path
+//   - error: on directory creation, encode, or write failure
+func save(contextDir string, out proposalFile) (string, error) {
+	queue := filepath.Join(contextDir, dir.State, cfgBackend.DirProposals)
+	if mkErr := ctxIo.SafeMkdirAll(
+		queue, fs.PermRestrictedDir,
+	); mkErr != nil {
+		return "", errBackend.WriteFile(queue, mkErr)
+	}
+	path := filepath.Join(queue, fmt.Sprintf(
+		cfgBackend.FileMemoryClassify,
+		out.Created.Format(cfgTime.RFC3339Compact),
+	))
+	data, encodeErr := json.MarshalIndent(out, "", cfgBackend.JSONIndent)
+	if encodeErr != nil {
+		return "", errBackend.WriteFile(path, encodeErr)
+	}
+	if writeErr := ctxIo.SafeWriteFileAtomic(
+		path, data, fs.PermFile,
+	); writeErr != nil {
+		return "", errBackend.WriteFile(path, writeErr)
+	}
+	return path, nil
+}
@@ -0,0 +1,20 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package classify implements ctx ai classify: the structured-output
+// consumer of the AI backend layer.
+//
+// It reads MEMORY.md the way ctx memory import does, drops entries
+// already imported, and by default keeps only those the keyword
+// rules (memory.Classify) would skip. Each remaining entry goes to
+// the backend with the memory_classification JSON schema; the
+// answer is checked against the schema's enum again before use.
+//
+// The proposals land in one JSON file under
+// .context/state/proposals/. No .context/*.md file is touched and
+// no import state is recorded; a failed request aborts the run
+// before anything is written.
+package classify
@@ -0,0 +1,67 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package classify
+
+import "time"
+
+// Opts holds the ctx ai classify flag values.
+//
+// Fields:
+//   - Backend: the --backend value ("" selects the default)
+//   - All: also send entries the keyword rules classify
+type Opts struct {
+	Backend string
+	All     bool
+}
+
+// answer is the schema-constrained backend reply.
+//
+// Fields:
+//   - Target: decision, learning, convention, task, or skip
+//   - Reason: one-line justification
+type answer struct {
+	Target string `json:"target"`
+	Reason string `json:"reason"`
+}
+
+// proposal is one classified MEMORY.md entry.
+//
+// Fields:
+//   - Hash: memory.EntryHash of the entry text
+//   - Line: 1-based line of the entry in MEMORY.md
+//   - Title: the entry's first line, truncated
+//   - Text: the full entry text
+//   - Rule: the keyword-rule target (skip when no rule matched)
+//   - Target: the backend's proposed target
+//   - Reason: the backend's justification
+type proposal struct {
+	Hash   string `json:"hash"`
+	Line   int    `json:"line"`
+	Title  string `json:"title"`
+	Text   string `json:"text"`
+	Rule   string `json:"rule"`
+	Target string `json:"target"`
+	Reason string `json:"reason"`
+}
+
+// proposalFile is the proposal queue artifact for one run.
+//
+// Fields:
+//   - Kind: artifact kind (memory-classify)
+//   - Created: run time (UTC)
+//   - Backend: backend that answered
+//   - Model: model that answered
+//   - Source: the MEMORY.md path
+//   - Proposals: one entry per classified MEMORY.md entry
+type proposalFile struct {
+	Kind      string     `json:"kind"`
+	Created   time.Time  `json:"created"`
+	Backend   string     `json:"backend"`
+	Model     string     `json:"model"`
+	Source    string     `json:"source"`
+	Proposals []proposal `json:"proposals"`
+}
@@ -0,0 +1,12 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package core groups the ctx ai command's logic into focused
+// subpackages: ping (the reachability check) and classify
+// (schema-constrained classification of MEMORY.md entries into a
+// proposal file). The commands stay thin Cobra wrappers; backend
+// selection and the HTTP contract live in internal/backend.
+package core
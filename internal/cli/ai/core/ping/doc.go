@@ -0,0 +1,11 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package ping implements ctx ai ping: select the backend, list its
+// models with GET /v1/models, and report the backend name, endpoint,
+// and first model. Any failure is returned as-is so the command
+// exits non-zero; the check never falls back to another backend.
+package ping
@@ -0,0 +1,37 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package ping
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/backend"
+	writeAI "github.com/ActiveMemory/ctx/internal/write/ai"
+)
+
+// Run checks the selected backend is reachable.
+//
+// Parameters:
+//   - cmd: cobra command for output
+//   - name: the --backend value ("" selects the default)
+//
+// Returns:
+//   - error: selection, transport, auth, or upstream failure
+func Run(cmd *cobra.Command, name string) error {
+	b, selectErr := backend.Select(name)
+	if selectErr != nil {
+		cmd.SilenceUsage = true
+		return selectErr
+	}
+	model, pingErr := b.Ping(cmd.Context())
+	if pingErr != nil {
+		cmd.SilenceUsage = true
+		return pingErr
+	}
+	writeAI.Ping(cmd, b.Name(), b.Endpoint(), model)
+	return nil
+}
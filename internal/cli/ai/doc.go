@@ -0,0 +1,27 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package ai implements the "ctx ai" command: optional AI verbs
+// dispatched through an OpenAI-compatible backend configured in the
+// .ctxrc backends table (see ctx setup --backend).
+//
+// Every verb fails closed. With no backend configured, an
+// unreachable endpoint, a rejected credential, or a timeout the
+// command exits non-zero with the reason; there is no fallback to a
+// non-AI path. AI output never edits .context/*.md: it is written
+// to the proposal queue under .context/state/proposals/.
+//
+// # Subcommands
+//
+//   - ping: reachability check reporting the first listed model
+//   - classify: propose context targets for MEMORY.md entries
+//
+// # Subpackages
+//
+//	cmd/ping, cmd/classify: cobra wiring
+//	core/ping: the reachability check
+//	core/classify: schema-constrained MEMORY.md classification
+package ai
@@ -0,0 +1,57 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package align
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	alignPass "github.com/ActiveMemory/ctx/internal/cli/align/core/pass"
+	"github.com/ActiveMemory/ctx/internal/cli/queue/cmd/accept"
+	"github.com/ActiveMemory/ctx/internal/cli/queue/cmd/reject"
+	"github.com/ActiveMemory/ctx/internal/cli/queue/cmd/review"
+	"github.com/ActiveMemory/ctx/internal/config/embed/cmd"
+	"github.com/ActiveMemory/ctx/internal/config/embed/flag"
+	cFlag "github.com/ActiveMemory/ctx/internal/config/flag"
+	"github.com/ActiveMemory/ctx/internal/flagbind"
+)
+
+// Cmd returns the align command with its subcommands.
+//
+// Invoked with no subcommand, it runs one scan pass over the
+// session transcripts.
+//
+// Returns:
+//   - *cobra.Command: the align command with review/accept/reject
+//     subcommands
+func Cmd() *cobra.Command {
+	var opts alignPass.Opts
+
+	short, long := desc.Command(cmd.DescKeyAlign)
+	c := &cobra.Command{
+		Use:     cmd.UseAlign,
+		Short:   short,
+		Long:    long,
+		Example: desc.Example(cmd.DescKeyAlign),
+		Args:    cobra.NoArgs,
+		RunE: func(cobraCmd *cobra.Command, _ []string) error {
+			return alignPass.Run(cobraCmd, opts)
+		},
+	}
+
+	flagbind.StringFlag(c, &opts.Since, cFlag.Since, flag.DescKeyAlignSince)
+	flagbind.StringFlag(c, &opts.Until, cFlag.Until, flag.DescKeyAlignUntil)
+	flagbind.BoolFlag(c, &opts.AllProjects,
+		cFlag.AllProjects, flag.DescKeyAlignAllProjects,
+	)
+	flagbind.BoolFlag(c, &opts.Force, cFlag.Force, flag.DescKeyAlignForce)
+
+	c.AddCommand(review.Cmd(spec))
+	c.AddCommand(accept.Cmd(spec))
+	c.AddCommand(reject.Cmd(spec))
+	return c
+}
@@ -0,0 +1,12 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package core holds the ctx align command's domain logic: pass
+// (one scan over session transcripts). Review, accept, and reject
+// are the shared subcommands in internal/cli/queue. The command
+// itself stays a thin Cobra wrapper; the detector and ledger live
+// in internal/align.
+package core
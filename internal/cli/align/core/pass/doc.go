@@ -0,0 +1,12 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package pass runs one ctx align pass: read the session
+// transcripts within the requested window, detect the course
+// corrections in sessions the ledger has not seen at their current
+// length, append the new candidates to the review file, and record
+// the scanned sessions and totals in the ledger.
+package pass
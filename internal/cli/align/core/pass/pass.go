@@ -0,0 +1,92 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package pass
+
+import (
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/align"
+	"github.com/ActiveMemory/ctx/internal/cli/journal/core/query"
+	errSession "github.com/ActiveMemory/ctx/internal/err/session"
+	"github.com/ActiveMemory/ctx/internal/journal/redact"
+	"github.com/ActiveMemory/ctx/internal/queue"
+	"github.com/ActiveMemory/ctx/internal/rc"
+	writeAlign "github.com/ActiveMemory/ctx/internal/write/align"
+)
+
+// Run executes one scan pass and prints a summary.
+//
+// Parameters:
+//   - cmd: cobra command for output
+//   - opts: the resolved run parameters
+//
+// Returns:
+//   - error: a context-directory, date, session-scan, redaction,
+//     or state-file failure
+func Run(cmd *cobra.Command, opts Opts) error {
+	ctxDir, ctxErr := rc.ContextDir()
+	if ctxErr != nil {
+		return ctxErr
+	}
+	since, until, windowErr := query.Window(opts.Since, opts.Until)
+	if windowErr != nil {
+		return windowErr
+	}
+	ledger := &align.Ledger{}
+	if ledgerErr := queue.LoadLedger(
+		align.Queue, ctxDir, ledger,
+	); ledgerErr != nil {
+		return ledgerErr
+	}
+	pending, reviewErr := queue.LoadReview[align.Candidate](
+		align.Queue, ctxDir,
+	)
+	if reviewErr != nil {
+		return reviewErr
+	}
+	// Quoted turns land in tracked files once accepted, so they
+	// get the same secret redaction as imported journal entries.
+	red, redactErr := redact.Load()
+	if redactErr != nil {
+		cmd.SilenceUsage = true
+		return redactErr
+	}
+	sessions, scanErr := query.FindSessions(opts.AllProjects)
+	if scanErr != nil {
+		cmd.SilenceUsage = true
+		return errSession.Find(scanErr)
+	}
+
+	scrub := func(s string) string {
+		// Acceptable discard: the count of secrets replaced is unused.
+		out, _ := red.Apply(s)
+		return out
+	}
+	scanned, fresh := align.Propose(
+		query.Filter(sessions, "", "", since, until),
+		ledger, pending, opts.Force, scrub,
+	)
+	if len(fresh) > 0 {
+		if saveErr := queue.SaveReview(
+			align.Queue, ctxDir, append(pending, fresh...),
+		); saveErr != nil {
+			return saveErr
+		}
+	}
+	ledger.LastScanned = time.Now().UTC().Format(time.RFC3339)
+	if saveErr := queue.SaveLedger(
+		align.Queue, ctxDir, ledger,
+	); saveErr != nil {
+		return saveErr
+	}
+	writeAlign.Summary(
+		cmd, scanned, len(fresh), queue.ReviewPath(align.Queue, ctxDir),
+	)
+	return nil
+}
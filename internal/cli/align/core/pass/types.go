@@ -0,0 +1,24 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package pass
+
+// Opts carries the scan parameters resolved from flags.
+//
+// Fields:
+//   - Since: earliest session start date (YYYY-MM-DD; empty for
+//     no bound)
+//   - Until: latest session start date (YYYY-MM-DD; empty for no
+//     bound)
+//   - AllProjects: scan sessions from every project, not just the
+//     current one
+//   - Force: re-scan sessions the ledger already records
+type Opts struct {
+	Since       string
+	Until       string
+	AllProjects bool
+	Force       bool
+}
@@ -0,0 +1,30 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package align implements the "ctx align" command: a
+// deterministic pass over AI session transcripts that finds course
+// corrections and proposes them as LEARNINGS.md and CONVENTIONS.md
+// entries for human review.
+//
+// Invoked with no subcommand, it parses the project's sessions,
+// scans each one not yet in the ledger for interrupts, rejected
+// tool uses, and correction wording, and adds the resulting
+// candidates to .context/state/align-review.json. Nothing reaches
+// a context file until a candidate is accepted.
+//
+// # Subcommands
+//
+//   - review: list pending candidates
+//   - accept: write candidates through entry.ValidateAndWrite
+//   - reject: discard candidates (recorded; never re-proposed)
+//
+// The review, accept, and reject subcommands are the shared ones
+// in internal/cli/queue, built from this command's queue spec.
+//
+// # Subpackages
+//
+//	core/pass: one scan over session transcripts
+package align
@@ -0,0 +1,34 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package align
+
+import (
+	"github.com/ActiveMemory/ctx/internal/align"
+	coreReview "github.com/ActiveMemory/ctx/internal/cli/queue/core/review"
+	"github.com/ActiveMemory/ctx/internal/config/embed/cmd"
+	"github.com/ActiveMemory/ctx/internal/config/embed/flag"
+	"github.com/ActiveMemory/ctx/internal/entity"
+	writeAlign "github.com/ActiveMemory/ctx/internal/write/align"
+)
+
+// spec is the ctx align review queue: its files and ledger, how an
+// accepted candidate becomes an entry, and its output.
+var spec = coreReview.Spec[align.Candidate]{
+	Queue: align.Queue,
+	NewLedger: func() entity.ReviewLedger {
+		return &align.Ledger{}
+	},
+	Params:     align.Params,
+	Accepted:   writeAlign.Accepted,
+	Rejected:   writeAlign.Rejected,
+	Review:     writeAlign.Review,
+	ReviewNone: writeAlign.ReviewNone,
+	ReviewKey:  cmd.DescKeyAlignReview,
+	AcceptKey:  cmd.DescKeyAlignAccept,
+	RejectKey:  cmd.DescKeyAlignReject,
+	AllKey:     flag.DescKeyAlignAll,
+}
@@ -19,6 +19,7 @@ import (
 	ctxIo "github.com/ActiveMemory/ctx/internal/io"
 	"github.com/ActiveMemory/ctx/internal/rc"
 	"github.com/ActiveMemory/ctx/internal/tidy"
+	"github.com/ActiveMemory/ctx/internal/txn"
 	writeCompact "github.com/ActiveMemory/ctx/internal/write/compact"
 )
 
@@ -34,56 +35,61 @@ import (
 // Returns:
 //   - error: Non-nil if context loading fails or .context/ is not found
 func Run(cmd *cobra.Command, archive bool) error {
-	if _, ctxErr := rc.RequireContextDir(); ctxErr != nil {
+	ctxDir, ctxErr := rc.RequireContextDir()
+	if ctxErr != nil {
 		cmd.SilenceUsage = true
 		return ctxErr
 	}
-	ctx, err := load.Do("")
-	if err != nil {
-		if _, ok := errors.AsType[*errCtx.NotFoundError](err); ok {
-			return errInit.ContextNotInitialized()
+	// Load, compact, and rewrite under one lock so entries written
+	// by another writer in the meantime are not dropped.
+	return txn.Do(ctxDir, func() error {
+		ctx, err := load.Do("")
+		if err != nil {
+			if _, ok := errors.AsType[*errCtx.NotFoundError](err); ok {
+				return errInit.ContextNotInitialized()
+			}
+			return err
 		}
-		return err
-	}
 
-	// Enable archiving if configured in .ctxrc
-	if rc.AutoArchive() {
-		archive = true
-	}
+		// Enable archiving if configured in .ctxrc
+		if rc.AutoArchive() {
+			archive = true
+		}
 
-	writeCompact.ReportHeading(cmd)
+		writeCompact.ReportHeading(cmd)
 
-	changes := 0
+		changes := 0
 
-	// Process TASKS.md
-	tasksChanges, compactErr := task.CompactTasks(cmd, ctx, archive)
-	if compactErr != nil {
-		writeCompact.TaskError(cmd, compactErr)
-	} else {
-		changes += tasksChanges
-	}
+		// Process TASKS.md
+		tasksChanges, compactErr := task.CompactTasks(cmd, ctx, archive)
+		if compactErr != nil {
+			writeCompact.TaskError(cmd, compactErr)
+		} else {
+			changes += tasksChanges
+		}
 
-	// Reload context to pick up TASKS.md changes, then clean sections.
-	ctx, err = load.Do("")
-	if err == nil {
-		result := tidy.CompactContext(ctx)
-		for i, sc := range result.SectionsCleaned {
-			if writeErr := ctxIo.SafeWriteFile(
-				result.SectionFileUpdates[i].Path,
-				result.SectionFileUpdates[i].Content,
-				fs.PermFile,
-			); writeErr == nil {
-				writeCompact.SectionsRemoved(cmd, sc.Removed, sc.FileName)
-				changes += sc.Removed
+		// Reload context to pick up TASKS.md changes, then clean sections.
+		ctx, err = load.Do("")
+		if err == nil {
+			result := tidy.CompactContext(ctx)
+			for i, sc := range result.SectionsCleaned {
+				if writeErr := ctxIo.SafeWriteFileAtomic(
+					result.SectionFileUpdates[i].Path,
+					result.SectionFileUpdates[i].Content,
+					fs.PermFile,
+				); writeErr == nil {
+					writeCompact.SectionsRemoved(cmd, sc.Removed, sc.FileName)
+					changes += sc.Removed
+				}
 			}
 		}
-	}
 
-	if changes == 0 {
-		writeCompact.ReportClean(cmd)
-	} else {
-		writeCompact.ReportSummary(cmd, changes)
-	}
+		if changes == 0 {
+			writeCompact.ReportClean(cmd)
+		} else {
+			writeCompact.ReportSummary(cmd, changes)
+		}
 
-	return nil
+		return nil
+	})
 }
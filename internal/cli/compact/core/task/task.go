@@ -62,7 +62,7 @@ func CompactTasks(
 
 	// Write TASKS.md.
 	if result.TasksFileUpdate != nil {
-		if writeErr := ctxIo.SafeWriteFile(
+		if writeErr := ctxIo.SafeWriteFileAtomic(
 			result.TasksFileUpdate.Path,
 			result.TasksFileUpdate.Content,
 			fs.PermFile,
@@ -14,6 +14,10 @@ import (
 	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
 	corePub "github.com/ActiveMemory/ctx/internal/cli/connection/core/publish"
 	"github.com/ActiveMemory/ctx/internal/config/embed/cmd"
+	"github.com/ActiveMemory/ctx/internal/config/embed/flag"
+	cFlag "github.com/ActiveMemory/ctx/internal/config/flag"
+	cfgHub "github.com/ActiveMemory/ctx/internal/config/hub"
+	"github.com/ActiveMemory/ctx/internal/flagbind"
 	"github.com/ActiveMemory/ctx/internal/hub"
 )
 
@@ -22,9 +26,14 @@ import (
 // Returns:
 //   - *cobra.Command: The publish subcommand
 func Cmd() *cobra.Command {
+	var (
+		supersedes string
+		reason     string
+	)
+
 	short, long := desc.Command(cmd.DescKeyConnectionPublish)
 
-	return &cobra.Command{
+	c := &cobra.Command{
 		Use:     cmd.UseConnectionPublish,
 		Short:   short,
 		Long:    long,
@@ -37,10 +46,26 @@ func Cmd() *cobra.Command {
 				Type:      args[0],
 				Content:   args[1],
 				Timestamp: time.Now().Unix(),
+				Ref:       supersedes,
+				Reason:    reason,
+			}
+			if supersedes != "" {
+				entry.Kind = cfgHub.KindSupersede
 			}
 			return corePub.Run(
 				cobraCmd, []hub.PublishEntry{entry},
 			)
 		},
 	}
+
+	flagbind.StringFlag(
+		c, &supersedes,
+		cFlag.Supersedes, flag.DescKeyConnectionSupersedes,
+	)
+	flagbind.StringFlag(
+		c, &reason,
+		cFlag.Reason, flag.DescKeyConnectionReason,
+	)
+
+	return c
 }
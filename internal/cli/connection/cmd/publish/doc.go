@@ -26,8 +26,13 @@
 //
 // # Flags
 //
-// None. Connection settings are read from the
-// encrypted config at .context/.connect.enc.
+//   - --supersedes: ID of an earlier entry this one
+//     replaces; publishes a supersede record
+//   - --reason: why the earlier entry is replaced
+//     (required with --supersedes)
+//
+// Connection settings are read from the encrypted
+// config at .context/.connect.enc.
 //
 // # Output
 //
@@ -22,7 +22,12 @@ import (
 // Returns:
 //   - *cobra.Command: The register subcommand
 func Cmd() *cobra.Command {
-	var adminToken string
+	var (
+		adminToken string
+		tlsCA      string
+		tlsCert    string
+		tlsKey     string
+	)
 
 	short, long := desc.Command(cmd.DescKeyConnectionRegister)
 
@@ -37,6 +42,7 @@ func Cmd() *cobra.Command {
 		) error {
 			return coreReg.Run(
 				cobraCmd, args[0], adminToken,
+				coreReg.TLSFiles(tlsCA, tlsCert, tlsKey),
 			)
 		},
 	}
@@ -48,6 +54,18 @@ func Cmd() *cobra.Command {
 	// Acceptable discard: MarkFlagRequired only errors on an
 	// unregistered flag name; the flag is bound immediately above.
 	_ = c.MarkFlagRequired(cFlag.Token)
+	flagbind.StringFlag(
+		c, &tlsCA,
+		cFlag.TLSCA, flag.DescKeyConnectionTLSCA,
+	)
+	flagbind.StringFlag(
+		c, &tlsCert,
+		cFlag.TLSCert, flag.DescKeyConnectionTLSCert,
+	)
+	flagbind.StringFlag(
+		c, &tlsKey,
+		cFlag.TLSKey, flag.DescKeyConnectionTLSKey,
+	)
 
 	return c
 }
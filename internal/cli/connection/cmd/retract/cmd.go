@@ -0,0 +1,51 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package retract
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	coreRetract "github.com/ActiveMemory/ctx/internal/cli/connection/core/retract"
+	"github.com/ActiveMemory/ctx/internal/config/embed/cmd"
+	"github.com/ActiveMemory/ctx/internal/config/embed/flag"
+	cFlag "github.com/ActiveMemory/ctx/internal/config/flag"
+	"github.com/ActiveMemory/ctx/internal/flagbind"
+)
+
+// Cmd returns the connect retract subcommand.
+//
+// Returns:
+//   - *cobra.Command: The retract subcommand
+func Cmd() *cobra.Command {
+	var reason string
+
+	short, long := desc.Command(cmd.DescKeyConnectionRetract)
+
+	c := &cobra.Command{
+		Use:     cmd.UseConnectionRetract,
+		Short:   short,
+		Long:    long,
+		Example: desc.Example(cmd.DescKeyConnectionRetract),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(
+			cobraCmd *cobra.Command, args []string,
+		) error {
+			return coreRetract.Run(cobraCmd, args[0], reason)
+		},
+	}
+
+	flagbind.StringFlag(
+		c, &reason,
+		cFlag.Reason, flag.DescKeyConnectionReason,
+	)
+	// Acceptable discard: MarkFlagRequired only errors on an
+	// unregistered flag name; the flag is bound immediately above.
+	_ = c.MarkFlagRequired(cFlag.Reason)
+
+	return c
+}
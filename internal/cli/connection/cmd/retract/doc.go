@@ -0,0 +1,32 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package retract implements the "ctx connection retract"
+// subcommand that withdraws an entry from a ctx Hub.
+//
+// # What It Does
+//
+// Publishes a retraction record referencing an earlier
+// entry. The hub keeps the original in its append-only
+// log but stops serving it to fresh readers; projects
+// that already synced it see it marked as retracted in
+// .context/hub/.
+//
+// # Arguments
+//
+//   - args[0]: ID of the entry to retract, as shown in
+//     the hub-entry comment under each heading in
+//     .context/hub/*.md
+//
+// # Flags
+//
+//   - --reason: why the entry is withdrawn (required)
+//
+// # Delegation
+//
+// [Cmd] builds the cobra.Command and delegates to
+// [coreRetract.Run].
+package retract
@@ -0,0 +1,69 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package search
+
+import (
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	coreSearch "github.com/ActiveMemory/ctx/internal/cli/connection/core/search"
+	"github.com/ActiveMemory/ctx/internal/config/embed/cmd"
+	"github.com/ActiveMemory/ctx/internal/config/embed/flag"
+	cFlag "github.com/ActiveMemory/ctx/internal/config/flag"
+	"github.com/ActiveMemory/ctx/internal/config/token"
+	"github.com/ActiveMemory/ctx/internal/flagbind"
+)
+
+// Cmd returns the connect search subcommand.
+//
+// Returns:
+//   - *cobra.Command: The search subcommand
+func Cmd() *cobra.Command {
+	var opts coreSearch.Opts
+
+	short, long := desc.Command(cmd.DescKeyConnectionSearch)
+
+	c := &cobra.Command{
+		Use:     cmd.UseConnectionSearch,
+		Short:   short,
+		Long:    long,
+		Example: desc.Example(cmd.DescKeyConnectionSearch),
+		Args:    cobra.ArbitraryArgs,
+		RunE: func(
+			cobraCmd *cobra.Command, args []string,
+		) error {
+			return coreSearch.Run(
+				cobraCmd, strings.Join(args, token.Space), opts,
+			)
+		},
+	}
+
+	flagbind.StringArrayFlag(
+		c, &opts.Types,
+		cFlag.Type, flag.DescKeyConnectionSearchType,
+	)
+	flagbind.StringArrayFlag(
+		c, &opts.Origins,
+		cFlag.Origin, flag.DescKeyConnectionSearchOrigin,
+	)
+	flagbind.BindStringFlags(c,
+		[]*string{&opts.Since, &opts.Until},
+		[]string{cFlag.Since, cFlag.Until},
+		[]string{
+			flag.DescKeyConnectionSearchSince,
+			flag.DescKeyConnectionSearchUntil,
+		},
+	)
+	flagbind.IntFlag(
+		c, &opts.Limit,
+		cFlag.Limit, 0, flag.DescKeyConnectionSearchLimit,
+	)
+
+	return c
+}
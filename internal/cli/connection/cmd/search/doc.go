@@ -0,0 +1,35 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package search implements the "ctx connection search"
+// subcommand that queries every entry on a ctx Hub
+// without syncing them locally.
+//
+// # What It Does
+//
+// Sends the query words and filters to the hub's Search
+// RPC and prints the matches newest first, one per
+// entry, with the hub entry ID so a result can be
+// retracted or superseded.
+//
+// # Arguments
+//
+//   - args: words that must all appear in an entry's
+//     content; none lists the newest entries that pass
+//     the filters
+//
+// # Flags
+//
+//   - --type: entry type to include (repeatable)
+//   - --origin: origin project to include (repeatable)
+//   - --since, --until: date range, YYYY-MM-DD
+//   - --limit: maximum matches (hub default 50)
+//
+// # Delegation
+//
+// [Cmd] builds the cobra.Command and delegates to
+// [coreSearch.Run].
+package search
@@ -12,6 +12,8 @@ import (
 	"github.com/ActiveMemory/ctx/internal/cli/connection/cmd/listen"
 	"github.com/ActiveMemory/ctx/internal/cli/connection/cmd/publish"
 	"github.com/ActiveMemory/ctx/internal/cli/connection/cmd/register"
+	"github.com/ActiveMemory/ctx/internal/cli/connection/cmd/retract"
+	"github.com/ActiveMemory/ctx/internal/cli/connection/cmd/search"
 	connectStatus "github.com/ActiveMemory/ctx/internal/cli/connection/cmd/status"
 	"github.com/ActiveMemory/ctx/internal/cli/connection/cmd/subscribe"
 	connectSync "github.com/ActiveMemory/ctx/internal/cli/connection/cmd/sync"
@@ -30,6 +32,8 @@ func Cmd() *cobra.Command {
 		subscribe.Cmd(),
 		connectSync.Cmd(),
 		publish.Cmd(),
+		retract.Cmd(),
+		search.Cmd(),
 		listen.Cmd(),
 		connectStatus.Cmd(),
 	)
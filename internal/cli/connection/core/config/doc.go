@@ -10,13 +10,17 @@
 // # Config Type
 //
 // [Config] is the persisted hub connection state. It
-// holds three fields:
+// holds these fields:
 //
 //   - HubAddr: the gRPC address (host:port) of the hub.
 //   - Token: the client bearer token received during
 //     registration.
 //   - Types: an optional list of subscribed entry types
 //     for filtered listening.
+//   - TLS: certificate paths and the pinned hub
+//     certificate fingerprint; zero for plaintext hubs.
+//   - ClientFingerprint: this machine's certificate
+//     fingerprint under mutual TLS, kept for display.
 //
 // Config is serialized as JSON and encrypted at rest.
 //
@@ -6,14 +6,24 @@
 
 package config
 
+import "github.com/ActiveMemory/ctx/internal/hub"
+
 // Config is the persisted hub connection configuration.
 //
 // Fields:
 //   - HubAddr: hub gRPC address (host:port)
 //   - Token: client bearer token for RPCs
 //   - Types: subscribed entry types (empty = all)
+//   - TLS: CA bundle, client key pair, and the hub
+//     certificate fingerprint pinned at registration
+//     (zero value = plaintext)
+//   - ClientFingerprint: hex SHA-256 of the client
+//     certificate presented at registration (mutual TLS
+//     only; informational)
 type Config struct {
-	HubAddr string   `json:"hub_addr"`
-	Token   string   `json:"token"`
-	Types   []string `json:"types,omitempty"`
+	HubAddr           string        `json:"hub_addr"`
+	Token             string        `json:"token"`
+	Types             []string      `json:"types,omitempty"`
+	TLS               hub.TLSConfig `json:"tls,omitzero"`
+	ClientFingerprint string        `json:"client_fingerprint,omitempty"`
 }
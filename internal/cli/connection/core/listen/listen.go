@@ -38,7 +38,7 @@ func Run(cmd *cobra.Command, _ []string) error {
 	}
 
 	client, dialErr := hub.NewClient(
-		cfg.HubAddr, cfg.Token,
+		cfg.HubAddr, cfg.Token, cfg.TLS,
 	)
 	if dialErr != nil {
 		return dialErr
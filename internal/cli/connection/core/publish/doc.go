@@ -18,13 +18,20 @@
 //  1. Load the encrypted connection config via
 //     connectCfg.Load to obtain the hub address and
 //     bearer token.
-//  2. Dial the hub with hub.NewClient, establishing a
+//  2. Stamp entries lacking an ID with
+//     hub.GenerateEntryID and entries lacking an
+//     Origin with the project directory name.
+//  3. Dial the hub with hub.NewClient, establishing a
 //     gRPC connection.
-//  3. Call client.Publish with the entries, sending
+//  4. Call client.Publish with the entries, sending
 //     them in a single batch RPC.
-//  4. Print a confirmation showing the number of
+//  5. Print a confirmation showing the number of
 //     published entries via writeConnect.Published.
 //
+// [Send] performs steps 1-4 without printing; the
+// retract subpackage uses it to publish a retraction
+// record with its own confirmation.
+//
 // The function returns an error if config loading,
 // connection setup, or the publish RPC fails. The gRPC
 // connection is closed via a deferred Close call.
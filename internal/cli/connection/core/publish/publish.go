@@ -32,13 +32,37 @@ import (
 func Run(
 	cmd *cobra.Command, entries []hub.PublishEntry,
 ) error {
+	if sendErr := Send(entries); sendErr != nil {
+		return sendErr
+	}
+	writeConnect.Published(cmd, len(entries))
+	return nil
+}
+
+// Send publishes entries to the hub without printing.
+//
+// Entries without an ID get a fresh one and entries
+// without an Origin are attributed to this project, since
+// the hub rejects either being empty.
+//
+// Parameters:
+//   - entries: entries to publish; ID and Origin are
+//     filled in place when empty
+//
+// Returns:
+//   - error: non-nil if config load, stamping, or
+//     publish fails
+func Send(entries []hub.PublishEntry) error {
 	cfg, loadErr := connectCfg.Load()
 	if loadErr != nil {
 		return loadErr
 	}
+	if stampErr := stamp(entries); stampErr != nil {
+		return stampErr
+	}
 
 	client, dialErr := hub.NewClient(
-		cfg.HubAddr, cfg.Token,
+		cfg.HubAddr, cfg.Token, cfg.TLS,
 	)
 	if dialErr != nil {
 		return dialErr
@@ -52,10 +76,5 @@ func Run(
 	_, pubErr := client.Publish(
 		context.Background(), entries,
 	)
-	if pubErr != nil {
-		return pubErr
-	}
-
-	writeConnect.Published(cmd, len(entries))
-	return nil
+	return pubErr
 }
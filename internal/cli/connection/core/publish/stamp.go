@@ -0,0 +1,48 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package publish
+
+import (
+	"path/filepath"
+
+	"github.com/ActiveMemory/ctx/internal/hub"
+	"github.com/ActiveMemory/ctx/internal/rc"
+)
+
+// stamp fills the ID and Origin of entries that lack them.
+// The origin is the name of the directory holding the
+// context directory, i.e. the project name.
+//
+// Parameters:
+//   - entries: entries to stamp in place
+//
+// Returns:
+//   - error: non-nil if ID generation or context
+//     directory resolution fails
+func stamp(entries []hub.PublishEntry) error {
+	for i := range entries {
+		if entries[i].ID != "" {
+			continue
+		}
+		id, idErr := hub.GenerateEntryID()
+		if idErr != nil {
+			return idErr
+		}
+		entries[i].ID = id
+	}
+	for i := range entries {
+		if entries[i].Origin != "" {
+			continue
+		}
+		ctxDir, ctxErr := rc.ContextDir()
+		if ctxErr != nil {
+			return ctxErr
+		}
+		entries[i].Origin = filepath.Base(filepath.Dir(ctxDir))
+	}
+	return nil
+}
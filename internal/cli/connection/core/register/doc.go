@@ -15,7 +15,8 @@
 //
 // The execution flow is:
 //
-//  1. Dial the hub at the provided gRPC address using
+//  1. Resolve any TLS file paths to absolute form and
+//     dial the hub at the provided gRPC address using
 //     hub.NewClient with an empty bearer token (the
 //     admin token is sent as a registration parameter,
 //     not as a connection credential).
@@ -24,12 +25,15 @@
 //  3. Call client.Register with the admin token and
 //     project name. The hub returns a client ID and a
 //     client bearer token for future RPCs.
-//  4. Build a connectCfg.Config with the hub address
-//     and client token, then persist it via
+//  4. Build a connectCfg.Config with the hub address,
+//     client token, TLS settings, and the hub
+//     certificate fingerprint observed during the
+//     handshake, then persist it via
 //     connectCfg.Save. The config is encrypted at rest
 //     in .context/.connect.enc.
 //  5. Print a confirmation with the assigned client ID
-//     via writeConnect.Registered.
+//     via writeConnect.Registered, followed by the
+//     pinned certificate fingerprints when TLS is on.
 //
 // The function returns an error if dialing, registration,
 // or config persistence fails. The gRPC connection is
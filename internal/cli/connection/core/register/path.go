@@ -0,0 +1,40 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package register
+
+import (
+	"path/filepath"
+
+	"github.com/ActiveMemory/ctx/internal/hub"
+)
+
+// absolute resolves every certificate path in cfg against
+// the working directory. The stored connection config is
+// read by hooks and commands that run from other
+// directories, so relative paths would stop resolving.
+//
+// Parameters:
+//   - cfg: transport settings as given on the command line
+//
+// Returns:
+//   - hub.TLSConfig: the same settings with absolute paths
+//   - error: non-nil if the working directory is unknown
+func absolute(cfg hub.TLSConfig) (hub.TLSConfig, error) {
+	for _, p := range []*string{
+		&cfg.CAFile, &cfg.CertFile, &cfg.KeyFile,
+	} {
+		if *p == "" {
+			continue
+		}
+		abs, absErr := filepath.Abs(*p)
+		if absErr != nil {
+			return cfg, absErr
+		}
+		*p = abs
+	}
+	return cfg, nil
+}
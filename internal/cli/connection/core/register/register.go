@@ -24,12 +24,16 @@ import (
 //
 // Connects to the hub, sends the admin token and project
 // name, receives a client token, and stores the encrypted
-// connection config in .context/.connect.enc.
+// connection config in .context/.connect.enc. Over TLS the
+// hub's certificate fingerprint is pinned in the stored
+// config, alongside the client certificate's when mutual
+// TLS is in use.
 //
 // Parameters:
 //   - cmd: cobra command for output
 //   - hubAddr: hub gRPC address (host:port)
 //   - adminToken: admin token from hub startup
+//   - tlsCfg: certificate material (zero = plaintext)
 //
 // Returns:
 //   - error: non-nil if registration or storage fails
@@ -37,8 +41,18 @@ func Run(
 	cmd *cobra.Command,
 	hubAddr string,
 	adminToken string,
+	tlsCfg hub.TLSConfig,
 ) error {
-	client, dialErr := hub.NewClient(hubAddr, "")
+	tlsCfg, absErr := absolute(tlsCfg)
+	if absErr != nil {
+		return absErr
+	}
+	clientFP, fpErr := hub.CertFingerprint(tlsCfg)
+	if fpErr != nil {
+		return fpErr
+	}
+
+	client, dialErr := hub.NewClient(hubAddr, "", tlsCfg)
 	if dialErr != nil {
 		return dialErr
 	}
@@ -64,14 +78,39 @@ func Run(
 		return regErr
 	}
 
+	tlsCfg.Fingerprint = client.Fingerprint()
 	cfg := connectCfg.Config{
-		HubAddr: hubAddr,
-		Token:   resp.ClientToken,
+		HubAddr:           hubAddr,
+		Token:             resp.ClientToken,
+		TLS:               tlsCfg,
+		ClientFingerprint: clientFP,
 	}
 	if saveErr := connectCfg.Save(cfg); saveErr != nil {
 		return saveErr
 	}
 
 	writeConnect.Registered(cmd, resp.ClientID)
+	writeConnect.Fingerprints(
+		cmd, tlsCfg.Fingerprint, clientFP,
+	)
 	return nil
 }
+
+// TLSFiles bundles the register flags into the hub
+// transport settings. All-empty input yields the zero
+// value, which keeps the connection in plaintext.
+//
+// Parameters:
+//   - caFile: --tls-ca value
+//   - certFile: --tls-cert value
+//   - keyFile: --tls-key value
+//
+// Returns:
+//   - hub.TLSConfig: transport settings for [Run]
+func TLSFiles(caFile, certFile, keyFile string) hub.TLSConfig {
+	return hub.TLSConfig{
+		CAFile:   caFile,
+		CertFile: certFile,
+		KeyFile:  keyFile,
+	}
+}
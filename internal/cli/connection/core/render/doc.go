@@ -26,7 +26,19 @@
 //     by entry sequence number; re-running with
 //     the same sequence range produces no
 //     duplicates because the importer tracks last-
-//     seen sequence per file.
+//     seen sequence per file. Fleet entries (task
+//     leases from `ctx fleet`) are skipped.
+//
+// # Corrections
+//
+// Every block carries a `<!-- hub-entry: ID -->` anchor
+// under its heading. A retraction inserts a quoted
+// "Retracted" line under its target's anchor; a
+// supersede record is appended like any entry (naming
+// what it replaces) and inserts a "Superseded" line
+// under the old entry. Re-applying a mark is a no-op.
+// A retraction whose target is not in the file is
+// appended as a standalone notice.
 //
 // # File Layout
 //
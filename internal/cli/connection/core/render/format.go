@@ -13,7 +13,7 @@ import (
 	"time"
 
 	"github.com/ActiveMemory/ctx/internal/assets/tpl"
-	"github.com/ActiveMemory/ctx/internal/config/fs"
+	cfgEntry "github.com/ActiveMemory/ctx/internal/config/entry"
 	cfgHub "github.com/ActiveMemory/ctx/internal/config/hub"
 	cfgTime "github.com/ActiveMemory/ctx/internal/config/time"
 	"github.com/ActiveMemory/ctx/internal/config/token"
@@ -32,7 +32,9 @@ func typedFileName(entryType string) string {
 	return entryType + cfgHub.SuffixPluralMD
 }
 
-// groupByType groups entries by their Type field.
+// groupByType groups entries by their Type field. Fleet
+// entries (claims, assigns, releases) are task leases,
+// not context, and are left out.
 //
 // Parameters:
 //   - entries: Slice of hub entries to group
@@ -45,42 +47,86 @@ func groupByType(
 	result := make(map[string][]hub.EntryMsg)
 	for i := range entries {
 		t := entries[i].Type
+		if cfgEntry.FleetTypes[t] {
+			continue
+		}
 		result[t] = append(result[t], entries[i])
 	}
 	return result
 }
 
-// toMarkdown renders a slice of entries as markdown.
+// apply renders a batch of same-type entries onto an
+// existing document. Plain entries and supersede records
+// are appended; retractions and supersessions also mark
+// the entry they reference, in place, under its anchor.
 //
 // Parameters:
-//   - entries: Slice of hub entries to render
+//   - doc: current file content (may be empty)
+//   - entries: entries to render, in sequence order
 //
 // Returns:
-//   - string: Concatenated markdown for all entries
-func toMarkdown(entries []hub.EntryMsg) string {
-	var b strings.Builder
+//   - string: updated file content
+func apply(doc string, entries []hub.EntryMsg) string {
 	for i := range entries {
-		writeEntry(&b, &entries[i])
+		e := &entries[i]
+		switch e.Kind {
+		case cfgHub.KindRetract:
+			doc = markRetracted(doc, e)
+		case cfgHub.KindSupersede:
+			doc += supersedeBlock(e)
+			doc, _ = mark(doc, e.Ref, fmt.Sprintf(
+				tpl.HubSupersededMark, e.ID, e.Reason,
+			))
+		default:
+			doc += entryBlock(e)
+		}
 	}
-	return b.String()
+	return doc
 }
 
-// writeEntry renders a single entry as markdown with
-// origin tag and date header.
+// entryBlock renders a single entry as markdown with
+// date header, ID anchor, and origin tag.
 //
 // Parameters:
-//   - b: Builder to append markdown to
 //   - e: Entry to render
-func writeEntry(b *strings.Builder, e *hub.EntryMsg) {
-	ts := time.Unix(e.Timestamp, 0).UTC()
-	date := ts.Format(cfgTime.DateFormat)
-	if _, err := fmt.Fprintf(b,
+//
+// Returns:
+//   - string: markdown block
+func entryBlock(e *hub.EntryMsg) string {
+	return fmt.Sprintf(
 		tpl.HubEntryMarkdown,
-		date, firstLine(e.Content),
-		e.Origin, e.Content,
-	); err != nil {
-		return
-	}
+		entryDate(e), firstLine(e.Content),
+		e.ID, e.Origin, e.Content,
+	)
+}
+
+// supersedeBlock renders a replacement entry, naming the
+// entry it supersedes.
+//
+// Parameters:
+//   - e: supersede record to render
+//
+// Returns:
+//   - string: markdown block
+func supersedeBlock(e *hub.EntryMsg) string {
+	return fmt.Sprintf(
+		tpl.HubSupersedeMarkdown,
+		entryDate(e), firstLine(e.Content),
+		e.ID, e.Origin, e.Ref, e.Reason, e.Content,
+	)
+}
+
+// entryDate formats an entry's timestamp for headings.
+//
+// Parameters:
+//   - e: Entry whose timestamp to format
+//
+// Returns:
+//   - string: UTC date
+func entryDate(e *hub.EntryMsg) string {
+	return time.Unix(e.Timestamp, 0).UTC().Format(
+		cfgTime.DateFormat,
+	)
 }
 
 // firstLine returns the first line of s for use as a title.
@@ -97,23 +143,19 @@ func firstLine(s string) string {
 	return s
 }
 
-// appendShared appends content to a file, creating it if
-// needed.
+// readShared returns the current content of a hub file,
+// or an empty string when it does not exist yet.
 //
 // Parameters:
 //   - path: Target file path
-//   - content: Content to append
 //
 // Returns:
-//   - error: Non-nil on I/O failure
-func appendShared(path, content string) error {
+//   - string: File content
+//   - error: Non-nil on I/O failure other than absence
+func readShared(path string) (string, error) {
 	existing, readErr := io.SafeReadUserFile(path)
 	if readErr != nil && !os.IsNotExist(readErr) {
-		return readErr
+		return "", readErr
 	}
-	return io.SafeWriteFile(
-		path,
-		append(existing, []byte(content)...),
-		fs.PermFile,
-	)
+	return string(existing), nil
 }
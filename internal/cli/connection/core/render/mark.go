@@ -0,0 +1,63 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package render
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ActiveMemory/ctx/internal/assets/tpl"
+	"github.com/ActiveMemory/ctx/internal/hub"
+)
+
+// mark inserts a note directly under the anchor of the
+// entry with the given ID. Re-applying the same note is a
+// no-op, so replaying a sync window does not stack marks.
+//
+// Parameters:
+//   - doc: file content to edit
+//   - id: hub ID of the entry to mark
+//   - note: rendered mark to insert
+//
+// Returns:
+//   - string: updated content
+//   - bool: true if the entry's anchor was found
+func mark(doc, id, note string) (string, bool) {
+	anchor := fmt.Sprintf(tpl.HubEntryAnchor, id)
+	at := strings.Index(doc, anchor)
+	if at < 0 {
+		return doc, false
+	}
+	at += len(anchor)
+	if strings.HasPrefix(doc[at:], note) {
+		return doc, true
+	}
+	return doc[:at] + note + doc[at:], true
+}
+
+// markRetracted marks the entry a retraction refers to.
+// When that entry is not in the file, a standalone notice
+// is appended instead so the retraction is not lost.
+//
+// Parameters:
+//   - doc: file content to edit
+//   - e: retraction record
+//
+// Returns:
+//   - string: updated content
+func markRetracted(doc string, e *hub.EntryMsg) string {
+	marked, found := mark(doc, e.Ref, fmt.Sprintf(
+		tpl.HubRetractedMark, e.Origin, e.Reason,
+	))
+	if found {
+		return marked
+	}
+	return doc + fmt.Sprintf(
+		tpl.HubRetractionMarkdown,
+		entryDate(e), e.Ref, e.Origin, e.Reason,
+	)
+}
@@ -19,6 +19,10 @@ import (
 // WriteEntries renders hub entries as markdown and appends
 // them to type-specific files in .context/hub/.
 //
+// Retractions and supersede records also mark the entry
+// they reference in place, so readers of the shared file
+// see that it no longer stands.
+//
 // Parameters:
 //   - entries: hub entries to render
 //
@@ -41,11 +45,14 @@ func WriteEntries(entries []hub.EntryMsg) error {
 		fPath := filepath.Join(
 			dir, typedFileName(entryType),
 		)
-		content := toMarkdown(group)
-		if appendErr := appendShared(
-			fPath, content,
-		); appendErr != nil {
-			return appendErr
+		doc, readErr := readShared(fPath)
+		if readErr != nil {
+			return readErr
+		}
+		if writeErr := io.SafeWriteFile(
+			fPath, []byte(apply(doc, group)), fs.PermFile,
+		); writeErr != nil {
+			return writeErr
 		}
 	}
 	return nil
@@ -149,3 +149,75 @@ func TestTypedFileName(t *testing.T) {
 		}
 	}
 }
+
+func TestWriteEntries_MarksCorrections(t *testing.T) {
+	tmpDir := t.TempDir()
+	ctxDir := filepath.Join(tmpDir, ".context")
+	if mkErr := os.MkdirAll(ctxDir, 0750); mkErr != nil {
+		t.Fatal(mkErr)
+	}
+
+	origDir, _ := os.Getwd()
+	if chErr := os.Chdir(tmpDir); chErr != nil {
+		t.Fatal(chErr)
+	}
+	defer func() { _ = os.Chdir(origDir) }()
+	testctx.Declare(t, tmpDir)
+
+	first := []hub.EntryMsg{
+		{ID: "a1", Type: "decision", Content: "Use MySQL",
+			Origin: "alpha", Timestamp: 1710422400, Sequence: 1},
+		{ID: "b2", Type: "decision", Content: "Use Go",
+			Origin: "alpha", Timestamp: 1710422401, Sequence: 2},
+	}
+	if writeErr := WriteEntries(first); writeErr != nil {
+		t.Fatal(writeErr)
+	}
+
+	corrections := []hub.EntryMsg{
+		{ID: "r3", Type: "decision", Kind: "retract",
+			Ref: "a1", Reason: "wrong project",
+			Origin: "alpha", Timestamp: 1710422402, Sequence: 3},
+		{ID: "s4", Type: "decision", Kind: "supersede",
+			Ref: "b2", Reason: "generics landed",
+			Content: "Use Go 1.22", Origin: "beta",
+			Timestamp: 1710422403, Sequence: 4},
+		{ID: "r5", Type: "decision", Kind: "retract",
+			Ref: "gone", Reason: "cleanup",
+			Origin: "beta", Timestamp: 1710422404, Sequence: 5},
+	}
+	// Applying twice must not stack the in-place marks.
+	for range 2 {
+		if writeErr := WriteEntries(corrections[:2]); writeErr != nil {
+			t.Fatal(writeErr)
+		}
+	}
+	if writeErr := WriteEntries(corrections[2:]); writeErr != nil {
+		t.Fatal(writeErr)
+	}
+
+	data, readErr := os.ReadFile(
+		filepath.Join(ctxDir, "hub", "decisions.md"),
+	)
+	if readErr != nil {
+		t.Fatal(readErr)
+	}
+	doc := string(data)
+
+	retracted := "<!-- hub-entry: a1 -->\n\n" +
+		"> **Retracted** by alpha: wrong project\n"
+	if strings.Count(doc, retracted) != 1 {
+		t.Errorf("a1 not marked retracted once:\n%s", doc)
+	}
+	superseded := "<!-- hub-entry: b2 -->\n\n" +
+		"> **Superseded** by s4: generics landed\n"
+	if !strings.Contains(doc, superseded) {
+		t.Errorf("b2 not marked superseded:\n%s", doc)
+	}
+	if !strings.Contains(doc, "**Supersedes**: b2 (generics landed)") {
+		t.Error("replacement does not name superseded entry")
+	}
+	if !strings.Contains(doc, "Retracted gone") {
+		t.Error("missing notice for retraction of unknown entry")
+	}
+}
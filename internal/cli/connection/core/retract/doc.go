@@ -0,0 +1,26 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package retract implements entry retraction for the
+// ctx connection retract command.
+//
+// # Run
+//
+// [Run] builds a retraction record (Kind retract, Ref
+// naming the withdrawn entry, and a reason) and sends
+// it through the publish subpackage's Send, which loads
+// the connection config, stamps the record's ID and
+// origin, and calls the Publish RPC.
+//
+// The hub log stays append-only: the original entry is
+// never rewritten. The hub validates that the target
+// exists and was not already corrected, then omits both
+// from fresh syncs while delivering the retraction to
+// readers that already hold the target.
+//
+// On success a confirmation naming the retracted ID is
+// printed via writeConnect.Retracted.
+package retract
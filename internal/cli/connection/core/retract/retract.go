@@ -0,0 +1,49 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package retract
+
+import (
+	"time"
+
+	"github.com/spf13/cobra"
+
+	corePub "github.com/ActiveMemory/ctx/internal/cli/connection/core/publish"
+	cfgHub "github.com/ActiveMemory/ctx/internal/config/hub"
+	"github.com/ActiveMemory/ctx/internal/hub"
+	writeConnect "github.com/ActiveMemory/ctx/internal/write/connect"
+)
+
+// Run publishes a retraction record for an earlier hub
+// entry.
+//
+// The record leaves Type empty; the hub stamps the
+// target's type so subscribers filtering by type still
+// receive it.
+//
+// Parameters:
+//   - cmd: cobra command for output
+//   - id: ID of the entry to retract
+//   - reason: why the entry is withdrawn
+//
+// Returns:
+//   - error: non-nil if the hub rejects the retraction
+//     or the publish fails
+func Run(cmd *cobra.Command, id, reason string) error {
+	record := hub.PublishEntry{
+		Kind:      cfgHub.KindRetract,
+		Ref:       id,
+		Reason:    reason,
+		Timestamp: time.Now().Unix(),
+	}
+	if sendErr := corePub.Send(
+		[]hub.PublishEntry{record},
+	); sendErr != nil {
+		return sendErr
+	}
+	writeConnect.Retracted(cmd, id)
+	return nil
+}
@@ -0,0 +1,31 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package search implements hub full-text search for the
+// ctx connection search command and the ctx_hub_search
+// MCP tool.
+//
+// # Request
+//
+// [Request] turns the query words and flag values into a
+// hub.SearchRequest. --since and --until take
+// YYYY-MM-DD dates; --until covers the whole day.
+//
+// # Query
+//
+// [Query] loads the encrypted connection config, dials
+// the hub, and calls the Search RPC. The hub matches
+// whole words case-insensitively against its term index
+// and applies the client's read policy, so nothing is
+// synced locally.
+//
+// # Run
+//
+// [Run] combines the two for the CLI and prints one
+// line per match (date, type, origin, ID, first line of
+// content) via writeConnect, plus a hint when the hub
+// had more matches than the limit.
+package search
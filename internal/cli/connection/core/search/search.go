@@ -0,0 +1,135 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package search
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	connectCfg "github.com/ActiveMemory/ctx/internal/cli/connection/core/config"
+	"github.com/ActiveMemory/ctx/internal/config/flag"
+	cfgTime "github.com/ActiveMemory/ctx/internal/config/time"
+	"github.com/ActiveMemory/ctx/internal/config/token"
+	cfgWarn "github.com/ActiveMemory/ctx/internal/config/warn"
+	"github.com/ActiveMemory/ctx/internal/err/date"
+	"github.com/ActiveMemory/ctx/internal/hub"
+	logWarn "github.com/ActiveMemory/ctx/internal/log/warn"
+	"github.com/ActiveMemory/ctx/internal/parse"
+	writeConnect "github.com/ActiveMemory/ctx/internal/write/connect"
+)
+
+// Request builds a hub search request from query text and
+// filters.
+//
+// Parameters:
+//   - text: words that must all appear in an entry
+//   - opts: type, origin, date range, and limit filters
+//
+// Returns:
+//   - *hub.SearchRequest: request for [Query]
+//   - error: non-nil if --since or --until is malformed
+func Request(text string, opts Opts) (*hub.SearchRequest, error) {
+	req := &hub.SearchRequest{
+		Text:    text,
+		Types:   opts.Types,
+		Origins: opts.Origins,
+		Limit:   opts.Limit,
+	}
+	since, sinceErr := parse.Date(opts.Since)
+	if sinceErr != nil {
+		return nil, date.Invalid(
+			flag.PrefixLong+flag.Since, opts.Since, sinceErr,
+		)
+	}
+	if !since.IsZero() {
+		req.Since = since.Unix()
+	}
+	until, untilErr := parse.Date(opts.Until)
+	if untilErr != nil {
+		return nil, date.Invalid(
+			flag.PrefixLong+flag.Until, opts.Until, untilErr,
+		)
+	}
+	if !until.IsZero() {
+		// --until is inclusive: advance to end of day
+		req.Until = until.Add(cfgTime.InclusiveUntilOffset).Unix()
+	}
+	return req, nil
+}
+
+// Query runs a search against the connected hub.
+//
+// Parameters:
+//   - req: search filters
+//
+// Returns:
+//   - *hub.SearchResponse: matches, newest first
+//   - error: non-nil if config load, dial, or the RPC fails
+func Query(req *hub.SearchRequest) (*hub.SearchResponse, error) {
+	cfg, loadErr := connectCfg.Load()
+	if loadErr != nil {
+		return nil, loadErr
+	}
+
+	client, dialErr := hub.NewClient(
+		cfg.HubAddr, cfg.Token, cfg.TLS,
+	)
+	if dialErr != nil {
+		return nil, dialErr
+	}
+	defer func() {
+		if cerr := client.Close(); cerr != nil {
+			logWarn.Warn(cfgWarn.CloseHubClient, cerr)
+		}
+	}()
+
+	return client.Search(context.Background(), req)
+}
+
+// Run searches the hub and prints the matches.
+//
+// Parameters:
+//   - cmd: cobra command for output
+//   - text: words that must all appear in an entry
+//   - opts: type, origin, date range, and limit filters
+//
+// Returns:
+//   - error: non-nil if a date is malformed or the search
+//     fails
+func Run(cmd *cobra.Command, text string, opts Opts) error {
+	req, reqErr := Request(text, opts)
+	if reqErr != nil {
+		return reqErr
+	}
+	resp, searchErr := Query(req)
+	if searchErr != nil {
+		return searchErr
+	}
+
+	if len(resp.Entries) == 0 {
+		writeConnect.SearchNone(cmd)
+		return nil
+	}
+	for i := range resp.Entries {
+		e := &resp.Entries[i]
+		title, _, _ := strings.Cut(e.Content, token.NewlineLF)
+		writeConnect.SearchHit(
+			cmd,
+			time.Unix(e.Timestamp, 0).UTC().Format(
+				cfgTime.DateFormat,
+			),
+			e.Type, e.Origin, e.ID, title,
+		)
+	}
+	if resp.More {
+		writeConnect.SearchMore(cmd, len(resp.Entries))
+	}
+	return nil
+}
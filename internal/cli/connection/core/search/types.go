@@ -0,0 +1,25 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package search
+
+// Opts holds the search filters.
+//
+// Fields:
+//   - Types: entry types to include (empty = all)
+//   - Origins: origin projects to include (empty = all)
+//   - Since: earliest entry date, YYYY-MM-DD (empty =
+//     unbounded)
+//   - Until: latest entry date, YYYY-MM-DD, inclusive
+//     (empty = unbounded)
+//   - Limit: maximum results (0 = hub default)
+type Opts struct {
+	Types   []string
+	Origins []string
+	Since   string
+	Until   string
+	Limit   int
+}
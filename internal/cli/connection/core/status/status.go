@@ -33,7 +33,7 @@ func Run(cmd *cobra.Command, _ []string) error {
 	}
 
 	client, dialErr := hub.NewClient(
-		cfg.HubAddr, cfg.Token,
+		cfg.HubAddr, cfg.Token, cfg.TLS,
 	)
 	if dialErr != nil {
 		return dialErr
@@ -55,5 +55,8 @@ func Run(cmd *cobra.Command, _ []string) error {
 		cmd, cfg.HubAddr,
 		resp.TotalEntries, resp.ConnectedClients,
 	)
+	writeConnect.Fingerprints(
+		cmd, cfg.TLS.Fingerprint, cfg.ClientFingerprint,
+	)
 	return nil
 }
@@ -42,7 +42,7 @@ func Run(cmd *cobra.Command) error {
 	defer releaseLock()
 
 	client, dialErr := hub.NewClient(
-		cfg.HubAddr, cfg.Token,
+		cfg.HubAddr, cfg.Token, cfg.TLS,
 	)
 	if dialErr != nil {
 		return dialErr
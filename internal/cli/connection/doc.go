@@ -18,6 +18,8 @@
 //   - subscribe: subscribe to context topics on the Hub
 //   - sync: pull latest context from subscribed topics
 //   - publish: push local context entries to the Hub
+//   - retract: withdraw an entry published earlier
+//   - search: full-text search across hub entries
 //   - listen: stream real-time events from the Hub
 //   - status: show connection state and subscription info
 //
@@ -27,6 +29,8 @@
 //	cmd/subscribe: topic subscription management
 //	cmd/sync: context pull from Hub
 //	cmd/publish: context push to Hub
+//	cmd/retract: entry retraction
+//	cmd/search: hub-side entry search
 //	cmd/listen: real-time event streaming
 //	cmd/status: connection status display
 //	core: shared Hub client helpers
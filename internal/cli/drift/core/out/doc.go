@@ -25,7 +25,11 @@
 //     timestamp, the per-issue detail (file,
 //     line, type, message, path, rule), and the
 //     passed-check list. Stable shape suitable
-//     for `jq` parsing in CI scripts.
+//     for `jq` parsing in CI scripts. The
+//     document itself comes from
+//     [DriftOutput](report), which the MCP
+//     ctx_drift tool also returns as its
+//     structured result.
 //
 // # Why Two Renderers
 //
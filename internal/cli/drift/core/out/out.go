@@ -149,17 +149,18 @@ func DriftText(
 	return nil
 }
 
-// DriftJSON writes the drift report as pretty-printed JSON.
+// DriftOutput builds the machine-readable drift report shared by
+// `ctx drift --json` and the MCP ctx_drift tool.
+//
+// Empty issue and check lists are emitted as empty arrays, not
+// null, so consumers can iterate unconditionally.
 //
 // Parameters:
-//   - cmd: Cobra command for output stream
-//   - report: Drift detection report to serialize
+//   - report: Drift detection report to convert
 //
 // Returns:
-//   - error: Non-nil if JSON encoding fails
-func DriftJSON(
-	cmd *cobra.Command, report *drift.Report,
-) error {
+//   - JSONOutput: Report ready for JSON encoding
+func DriftOutput(report *drift.Report) JSONOutput {
 	output := JSONOutput{
 		Timestamp:  time.Now().UTC().Format(time.RFC3339),
 		Status:     report.Status(),
@@ -167,8 +168,30 @@ func DriftJSON(
 		Violations: report.Violations,
 		Passed:     report.Passed,
 	}
+	if output.Warnings == nil {
+		output.Warnings = []drift.Issue{}
+	}
+	if output.Violations == nil {
+		output.Violations = []drift.Issue{}
+	}
+	if output.Passed == nil {
+		output.Passed = []cfgDrift.CheckName{}
+	}
+	return output
+}
 
+// DriftJSON writes the drift report as pretty-printed JSON.
+//
+// Parameters:
+//   - cmd: Cobra command for output stream
+//   - report: Drift detection report to serialize
+//
+// Returns:
+//   - error: Non-nil if JSON encoding fails
+func DriftJSON(
+	cmd *cobra.Command, report *drift.Report,
+) error {
 	enc := json.NewEncoder(cmd.OutOrStdout())
 	enc.SetIndent("", token.Indent2)
-	return enc.Encode(output)
+	return enc.Encode(DriftOutput(report))
 }
@@ -37,6 +37,10 @@ func FormatCheckName(name cfgDrift.CheckName) string {
 		return desc.Text(
 			text.DescKeyDriftCheckTemplateHeader,
 		)
+	case cfgDrift.CheckTaskDeps:
+		return desc.Text(text.DescKeyDriftCheckTaskDeps)
+	case cfgDrift.CheckSkillLock:
+		return desc.Text(text.DescKeyDriftCheckSkillLock)
 	default:
 		return name
 	}
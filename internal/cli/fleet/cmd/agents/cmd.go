@@ -0,0 +1,32 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package agents
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	"github.com/ActiveMemory/ctx/internal/config/embed/cmd"
+)
+
+// Cmd returns the fleet agents subcommand.
+//
+// Returns:
+//   - *cobra.Command: configured agents subcommand
+func Cmd() *cobra.Command {
+	short, long := desc.Command(cmd.DescKeyFleetAgents)
+	return &cobra.Command{
+		Use:     cmd.UseFleetAgents,
+		Short:   short,
+		Long:    long,
+		Example: desc.Example(cmd.DescKeyFleetAgents),
+		Args:    cobra.NoArgs,
+		RunE: func(cobraCmd *cobra.Command, _ []string) error {
+			return Run(cobraCmd)
+		},
+	}
+}
@@ -0,0 +1,12 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package agents wires the "ctx fleet agents" subcommand.
+//
+// It lists the agent profiles registered in
+// .context/fleet.yaml: tool, context window, cost tier, and
+// capabilities.
+package agents
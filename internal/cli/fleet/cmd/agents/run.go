@@ -0,0 +1,28 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package agents
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/cli/fleet/core/profile"
+)
+
+// Run delegates to the profile listing.
+//
+// Parameters:
+//   - cmd: cobra command for output
+//
+// Returns:
+//   - error: non-nil if fleet.yaml is unreadable or invalid
+func Run(cmd *cobra.Command) error {
+	if listErr := profile.List(cmd); listErr != nil {
+		cmd.SilenceUsage = true
+		return listErr
+	}
+	return nil
+}
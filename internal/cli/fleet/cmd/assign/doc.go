@@ -0,0 +1,12 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package assign wires the "ctx fleet assign" subcommand.
+//
+// It hands a pending task to the agent named by --agent. An
+// assignment is the human override: it replaces whatever
+// lease was in force.
+package assign
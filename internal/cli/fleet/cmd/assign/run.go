@@ -0,0 +1,43 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package assign
+
+import (
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/cli/fleet/core/lease"
+	"github.com/ActiveMemory/ctx/internal/cli/fleet/core/profile"
+)
+
+// Run checks the assignee against fleet.yaml and assigns the
+// task.
+//
+// Parameters:
+//   - cmd: cobra command for output
+//   - query: task number, key, or text
+//   - agent: the assignee
+//   - ttl: lease length
+//
+// Returns:
+//   - error: non-nil when the agent, task, or lease is
+//     invalid, or the hub cannot be reached
+func Run(
+	cmd *cobra.Command, query, agent string, ttl time.Duration,
+) error {
+	id, agentErr := profile.Agent(agent)
+	if agentErr != nil {
+		cmd.SilenceUsage = true
+		return agentErr
+	}
+	if assignErr := lease.Assign(cmd, query, id, ttl); assignErr != nil {
+		cmd.SilenceUsage = true
+		return assignErr
+	}
+	return nil
+}
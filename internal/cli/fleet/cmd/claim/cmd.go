@@ -0,0 +1,50 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package claim
+
+import (
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	"github.com/ActiveMemory/ctx/internal/config/embed/cmd"
+	"github.com/ActiveMemory/ctx/internal/config/embed/flag"
+	cFlag "github.com/ActiveMemory/ctx/internal/config/flag"
+	cfgFleet "github.com/ActiveMemory/ctx/internal/config/fleet"
+	"github.com/ActiveMemory/ctx/internal/flagbind"
+)
+
+// Cmd returns the fleet claim subcommand.
+//
+// Returns:
+//   - *cobra.Command: configured claim subcommand
+func Cmd() *cobra.Command {
+	var (
+		agent string
+		ttl   time.Duration
+	)
+
+	short, long := desc.Command(cmd.DescKeyFleetClaim)
+	c := &cobra.Command{
+		Use:     cmd.UseFleetClaim,
+		Short:   short,
+		Long:    long,
+		Example: desc.Example(cmd.DescKeyFleetClaim),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cobraCmd *cobra.Command, args []string) error {
+			return Run(cobraCmd, args[0], agent, ttl)
+		},
+	}
+
+	flagbind.StringFlag(c, &agent, cFlag.Agent, flag.DescKeyFleetClaimAgent)
+	flagbind.DurationFlag(
+		c, &ttl, cFlag.Lease, cfgFleet.DefaultLease,
+		flag.DescKeyFleetClaimLease,
+	)
+	return c
+}
@@ -0,0 +1,13 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package claim wires the "ctx fleet claim" subcommand.
+//
+// It takes a lease on a pending task for the calling agent
+// (--agent or CTX_AGENT). The hub refuses the claim while
+// another agent holds the task; claiming a task one already
+// holds renews the lease.
+package claim
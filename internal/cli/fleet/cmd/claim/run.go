@@ -0,0 +1,42 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package claim
+
+import (
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/cli/fleet/core/lease"
+	"github.com/ActiveMemory/ctx/internal/cli/fleet/core/profile"
+)
+
+// Run resolves the calling agent and claims the task.
+//
+// Parameters:
+//   - cmd: cobra command for output
+//   - query: task number, key, or text
+//   - agent: the --agent value ("" falls back to CTX_AGENT)
+//   - ttl: lease length
+//
+// Returns:
+//   - error: non-nil when the agent, task, or lease is
+//     invalid, or the hub refuses the claim
+func Run(
+	cmd *cobra.Command, query, agent string, ttl time.Duration,
+) error {
+	id, agentErr := profile.Agent(agent)
+	if agentErr != nil {
+		cmd.SilenceUsage = true
+		return agentErr
+	}
+	if claimErr := lease.Claim(cmd, query, id, ttl); claimErr != nil {
+		cmd.SilenceUsage = true
+		return claimErr
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package release
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	"github.com/ActiveMemory/ctx/internal/config/embed/cmd"
+	"github.com/ActiveMemory/ctx/internal/config/embed/flag"
+	cFlag "github.com/ActiveMemory/ctx/internal/config/flag"
+	"github.com/ActiveMemory/ctx/internal/flagbind"
+)
+
+// Cmd returns the fleet release subcommand.
+//
+// Returns:
+//   - *cobra.Command: configured release subcommand
+func Cmd() *cobra.Command {
+	var agent string
+
+	short, long := desc.Command(cmd.DescKeyFleetRelease)
+	c := &cobra.Command{
+		Use:     cmd.UseFleetRelease,
+		Short:   short,
+		Long:    long,
+		Example: desc.Example(cmd.DescKeyFleetRelease),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cobraCmd *cobra.Command, args []string) error {
+			return Run(cobraCmd, args[0], agent)
+		},
+	}
+
+	flagbind.StringFlag(c, &agent, cFlag.Agent, flag.DescKeyFleetReleaseAgent)
+	return c
+}
@@ -0,0 +1,13 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package release wires the "ctx fleet release" subcommand.
+//
+// It gives back the calling agent's lease on a task, named by
+// number, key, or text. Only the holder can release; a task
+// that was completed since it was claimed is released by the
+// key ctx fleet status prints.
+package release
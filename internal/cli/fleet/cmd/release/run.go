@@ -0,0 +1,37 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package release
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/cli/fleet/core/lease"
+	"github.com/ActiveMemory/ctx/internal/cli/fleet/core/profile"
+)
+
+// Run resolves the calling agent and releases its lease.
+//
+// Parameters:
+//   - cmd: cobra command for output
+//   - query: task number, key, or text
+//   - agent: the --agent value ("" falls back to CTX_AGENT)
+//
+// Returns:
+//   - error: non-nil when the agent or task is unknown, or
+//     the hub refuses the release
+func Run(cmd *cobra.Command, query, agent string) error {
+	id, agentErr := profile.Agent(agent)
+	if agentErr != nil {
+		cmd.SilenceUsage = true
+		return agentErr
+	}
+	if releaseErr := lease.Release(cmd, query, id); releaseErr != nil {
+		cmd.SilenceUsage = true
+		return releaseErr
+	}
+	return nil
+}
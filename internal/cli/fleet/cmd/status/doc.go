@@ -0,0 +1,13 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package status wires the "ctx fleet status" subcommand.
+//
+// It prints every pending task with its key and current
+// holder, read from the task leases on the ctx Hub, then
+// any lease whose task is no longer pending so it can be
+// released.
+package status
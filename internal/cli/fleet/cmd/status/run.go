@@ -0,0 +1,28 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package status
+
+import (
+	"github.com/spf13/cobra"
+
+	coreStatus "github.com/ActiveMemory/ctx/internal/cli/fleet/core/status"
+)
+
+// Run delegates to the fleet status core logic.
+//
+// Parameters:
+//   - cmd: cobra command for output
+//
+// Returns:
+//   - error: non-nil if TASKS.md or the hub cannot be read
+func Run(cmd *cobra.Command) error {
+	if statusErr := coreStatus.Run(cmd); statusErr != nil {
+		cmd.SilenceUsage = true
+		return statusErr
+	}
+	return nil
+}
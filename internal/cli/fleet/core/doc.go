@@ -0,0 +1,16 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package core holds the business logic behind ctx fleet,
+// split by concern:
+//
+//   - profile: the .context/fleet.yaml agent registry and
+//     agent ID resolution
+//   - pending: pending tasks, their keys, and task argument
+//     resolution
+//   - lease: reading and publishing task leases on the hub
+//   - status: the fleet view of TASKS.md
+package core
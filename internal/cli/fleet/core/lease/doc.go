@@ -0,0 +1,22 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package lease reads and writes task leases on the ctx Hub
+// for ctx fleet and ctx_next.
+//
+// Leases travel as claim, assign, and release entries. The
+// hub grants them atomically, so two agents racing for one
+// task cannot both win; [Held] asks the hub for its lease
+// table to show who holds what, without syncing the fleet
+// log. [Claim] takes a pending task for the
+// calling agent, [Assign] hands one to a named agent
+// (overriding any holder), and [Release] gives a lease back.
+// A task that was completed or reworded since it was leased
+// can still be released by its key.
+//
+// Every lease expires: an agent that crashes or walks away
+// frees its tasks once the lease lapses.
+package lease
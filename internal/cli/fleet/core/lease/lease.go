@@ -0,0 +1,151 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package lease
+
+import (
+	"context"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	cfgEntry "github.com/ActiveMemory/ctx/internal/config/entry"
+	cfgFleet "github.com/ActiveMemory/ctx/internal/config/fleet"
+	cfgWarn "github.com/ActiveMemory/ctx/internal/config/warn"
+	"github.com/ActiveMemory/ctx/internal/hub"
+	logWarn "github.com/ActiveMemory/ctx/internal/log/warn"
+	writeFleet "github.com/ActiveMemory/ctx/internal/write/fleet"
+)
+
+// Held returns the task leases in force, keyed by task key.
+//
+// Returns:
+//   - map[string]hub.EntryMsg: task key to the claim or
+//     assign that holds it
+//   - bool: false when the project has no hub connection
+//     (the map is then empty and error nil)
+//   - error: non-nil if the connection config is unreadable
+//     or the hub cannot be reached within the lookup timeout
+func Held() (map[string]hub.EntryMsg, bool, error) {
+	cfg, connected, loadErr := connection()
+	if loadErr != nil || !connected {
+		return nil, connected, loadErr
+	}
+	client, dialErr := hub.NewClient(cfg.HubAddr, cfg.Token, cfg.TLS)
+	if dialErr != nil {
+		return nil, true, dialErr
+	}
+	defer func() {
+		if cerr := client.Close(); cerr != nil {
+			logWarn.Warn(cfgWarn.CloseHubClient, cerr)
+		}
+	}()
+
+	ctx, cancel := context.WithTimeout(
+		context.Background(), cfgFleet.LookupTimeout,
+	)
+	defer cancel()
+	held, leaseErr := client.Leases(ctx)
+	if leaseErr != nil {
+		return nil, true, leaseErr
+	}
+	return held, true, nil
+}
+
+// Claim takes a pending task for agent.
+//
+// Parameters:
+//   - cmd: cobra command for output
+//   - query: task number, key, or text
+//   - agent: claiming agent
+//   - ttl: lease length
+//
+// Returns:
+//   - error: non-nil on a bad lease, unknown task, missing
+//     connection, or when another agent holds the task
+func Claim(
+	cmd *cobra.Command, query, agent string, ttl time.Duration,
+) error {
+	t, findErr := find(query)
+	if findErr != nil {
+		return findErr
+	}
+	if pubErr := publish(
+		cfgEntry.Claim, t.Key, t.Content, agent, ttl,
+	); pubErr != nil {
+		return pubErr
+	}
+	writeFleet.Claimed(
+		cmd, t.Index, t.Key, agent, time.Now().Add(ttl), t.Content,
+	)
+	return nil
+}
+
+// Assign hands a pending task to agent, overriding any
+// current holder.
+//
+// Parameters:
+//   - cmd: cobra command for output
+//   - query: task number, key, or text
+//   - agent: assignee
+//   - ttl: lease length
+//
+// Returns:
+//   - error: non-nil on a bad lease, unknown task, or
+//     missing connection
+func Assign(
+	cmd *cobra.Command, query, agent string, ttl time.Duration,
+) error {
+	t, findErr := find(query)
+	if findErr != nil {
+		return findErr
+	}
+	if pubErr := publish(
+		cfgEntry.Assign, t.Key, t.Content, agent, ttl,
+	); pubErr != nil {
+		return pubErr
+	}
+	writeFleet.Assigned(
+		cmd, t.Index, t.Key, agent, time.Now().Add(ttl), t.Content,
+	)
+	return nil
+}
+
+// Release gives back agent's lease on a task. The task may
+// be named by its key even when it is no longer pending.
+//
+// Parameters:
+//   - cmd: cobra command for output
+//   - query: task number, key, or text
+//   - agent: releasing agent
+//
+// Returns:
+//   - error: non-nil on an unknown task, missing connection,
+//     or when agent does not hold the task
+func Release(cmd *cobra.Command, query, agent string) error {
+	key, content := query, ""
+	t, findErr := find(query)
+	if findErr == nil {
+		key, content = t.Key, t.Content
+	} else {
+		held, _, heldErr := Held()
+		if heldErr != nil {
+			return heldErr
+		}
+		e, ok := held[query]
+		if !ok {
+			return findErr
+		}
+		content = e.Content
+	}
+	if pubErr := publish(
+		cfgEntry.Release, key, content, agent, 0,
+	); pubErr != nil {
+		return pubErr
+	}
+	writeFleet.Released(cmd, key, agent, content)
+	return nil
+}
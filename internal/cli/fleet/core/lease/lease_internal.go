@@ -0,0 +1,86 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package lease
+
+import (
+	"errors"
+	"os"
+	"time"
+
+	connectCfg "github.com/ActiveMemory/ctx/internal/cli/connection/core/config"
+	corePub "github.com/ActiveMemory/ctx/internal/cli/connection/core/publish"
+	"github.com/ActiveMemory/ctx/internal/cli/fleet/core/pending"
+	cfgEntry "github.com/ActiveMemory/ctx/internal/config/entry"
+	cfgHub "github.com/ActiveMemory/ctx/internal/config/hub"
+	errFleet "github.com/ActiveMemory/ctx/internal/err/fleet"
+	"github.com/ActiveMemory/ctx/internal/hub"
+)
+
+// connection loads the project's hub connection.
+//
+// Returns:
+//   - connectCfg.Config: the connection (zero when absent)
+//   - bool: false when the project was never registered
+//   - error: non-nil if the config exists but is unreadable
+func connection() (connectCfg.Config, bool, error) {
+	cfg, loadErr := connectCfg.Load()
+	if errors.Is(loadErr, os.ErrNotExist) {
+		return cfg, false, nil
+	}
+	return cfg, loadErr == nil, loadErr
+}
+
+// find resolves a task argument against TASKS.md.
+//
+// Parameters:
+//   - query: task number, key, or text
+//
+// Returns:
+//   - pending.Task: the matching pending task
+//   - error: non-nil if TASKS.md is unreadable or nothing
+//     (or more than one task) matches
+func find(query string) (pending.Task, error) {
+	tasks, listErr := pending.List()
+	if listErr != nil {
+		return pending.Task{}, listErr
+	}
+	return pending.Find(tasks, query)
+}
+
+// publish sends one fleet entry to the hub.
+//
+// Parameters:
+//   - typ: claim, assign, or release
+//   - key: task key
+//   - content: task text, for readers of the log
+//   - agent: agent the lease is for
+//   - ttl: lease length (0 for a release)
+//
+// Returns:
+//   - error: non-nil on an out-of-range lease, a missing
+//     connection, or a hub rejection
+func publish(
+	typ, key, content, agent string, ttl time.Duration,
+) error {
+	secs := int64(ttl / time.Second)
+	if typ != cfgEntry.Release && (secs < 1 || secs > cfgHub.MaxLeaseTTL) {
+		return errFleet.InvalidLease(ttl)
+	}
+	_, connected, loadErr := connection()
+	if loadErr != nil {
+		return loadErr
+	}
+	if !connected {
+		return errFleet.NotConnected()
+	}
+	return corePub.Send([]hub.PublishEntry{{
+		Type:      typ,
+		Content:   content,
+		Timestamp: time.Now().Unix(),
+		Lease:     hub.Lease{Task: key, Agent: agent, TTL: secs},
+	}})
+}
@@ -0,0 +1,20 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package pending lists the pending top-level tasks in
+// TASKS.md the way ctx_next numbers them, each with its
+// stable task key, and resolves a task argument to one of
+// them.
+//
+// A task argument is a pending task number, a task key as
+// printed by ctx fleet status, or text that appears in
+// exactly one pending task (case-insensitive).
+//
+// Pick chooses the task ctx_next suggests under fleet
+// leases: one the calling agent holds first, then the
+// first task nobody holds. Tasks with unmet #depends or
+// #blocked-by annotations are never suggested.
+package pending
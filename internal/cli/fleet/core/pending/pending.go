@@ -0,0 +1,158 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package pending
+
+import (
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	cfgCtx "github.com/ActiveMemory/ctx/internal/config/ctx"
+	"github.com/ActiveMemory/ctx/internal/config/token"
+	errFleet "github.com/ActiveMemory/ctx/internal/err/fleet"
+	errTask "github.com/ActiveMemory/ctx/internal/err/task"
+	"github.com/ActiveMemory/ctx/internal/hub"
+	"github.com/ActiveMemory/ctx/internal/i18n"
+	"github.com/ActiveMemory/ctx/internal/io"
+	mcpTask "github.com/ActiveMemory/ctx/internal/mcp/handler/task"
+	"github.com/ActiveMemory/ctx/internal/rc"
+	"github.com/ActiveMemory/ctx/internal/task"
+)
+
+// List reads TASKS.md and returns its pending top-level
+// tasks.
+//
+// Returns:
+//   - []Task: pending tasks in file order
+//   - error: non-nil if the context directory or TASKS.md
+//     cannot be read
+func List() ([]Task, error) {
+	ctxDir, ctxErr := rc.ContextDir()
+	if ctxErr != nil {
+		return nil, ctxErr
+	}
+	data, readErr := io.SafeReadUserFile(
+		filepath.Join(ctxDir, cfgCtx.Task),
+	)
+	if readErr != nil {
+		return nil, errTask.FileRead(readErr)
+	}
+	return Parse(strings.Split(string(data), token.NewlineLF)), nil
+}
+
+// Parse returns the pending top-level tasks in TASKS.md
+// lines, each with its unmet dependencies.
+//
+// Parameters:
+//   - lines: TASKS.md split by newline
+//
+// Returns:
+//   - []Task: pending tasks in file order
+func Parse(lines []string) []Task {
+	nodes := task.Nodes(lines)
+	index := task.Index(nodes)
+	var tasks []Task
+	mcpTask.ForEachPending(lines, func(p mcpTask.Pending) bool {
+		tasks = append(tasks, Task{
+			Index: p.Index, Content: p.Content, Key: task.Key(p.Content),
+			Blockers: task.Unmet(p.Content, nodes, index),
+		})
+		return false
+	})
+	return tasks
+}
+
+// Find resolves a task argument against the pending tasks.
+//
+// Parameters:
+//   - tasks: pending tasks from [List]
+//   - query: task number, task key, or task text
+//
+// Returns:
+//   - Task: the matching task
+//   - error: non-nil when nothing or more than one task
+//     matches
+func Find(tasks []Task, query string) (Task, error) {
+	if n, convErr := strconv.Atoi(query); convErr == nil {
+		for _, t := range tasks {
+			if t.Index == n {
+				return t, nil
+			}
+		}
+		return Task{}, errFleet.TaskNotFound(query)
+	}
+	for _, t := range tasks {
+		if t.Key == query {
+			return t, nil
+		}
+	}
+	var found []Task
+	folded := i18n.Fold(query)
+	for _, t := range tasks {
+		if strings.Contains(i18n.Fold(t.Content), folded) {
+			found = append(found, t)
+		}
+	}
+	switch len(found) {
+	case 0:
+		return Task{}, errFleet.TaskNotFound(query)
+	case 1:
+		return found[0], nil
+	default:
+		return Task{}, errFleet.TaskAmbiguous(query)
+	}
+}
+
+// Pick chooses the next task for agent under the given
+// fleet leases.
+//
+// Blocked tasks are never chosen; they are skipped and
+// counted. Of the rest, a task the agent already holds wins,
+// so an agent resumes its own work first. Otherwise the
+// first task nobody holds is chosen. Tasks other agents hold
+// are skipped and counted. With no leases and no
+// dependencies this is the first pending task.
+//
+// Parameters:
+//   - tasks: pending tasks in file order
+//   - held: live leases keyed by task key (see hub.Leases)
+//   - agent: calling agent ID; empty means every held task
+//     belongs to someone else
+//
+// Returns:
+//   - Choice: the chosen task, or Found false with Skipped
+//     and Blocked counting what was passed over when
+//     nothing is free
+func Pick(
+	tasks []Task, held map[string]hub.EntryMsg, agent string,
+) Choice {
+	var free Choice
+	skipped, blocked := 0, 0
+	for _, t := range tasks {
+		lease, ok := held[t.Key]
+		switch {
+		case len(t.Blockers) > 0:
+			blocked++
+		case ok && agent != "" && lease.Lease.Agent == agent:
+			return Choice{
+				Task: t, Found: true, Held: true,
+				Expires: lease.Lease.Expires,
+			}
+		case ok:
+			skipped++
+		case !free.Found:
+			free = Choice{
+				Task: t, Found: true,
+				Skipped: skipped, Blocked: blocked,
+			}
+		}
+	}
+	if free.Found {
+		return free
+	}
+	return Choice{Skipped: skipped, Blocked: blocked}
+}
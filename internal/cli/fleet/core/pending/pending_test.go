@@ -0,0 +1,143 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package pending
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/ActiveMemory/ctx/internal/hub"
+)
+
+func TestFind(t *testing.T) {
+	tasks := []Task{
+		{Index: 1, Content: "Add rate limiter", Key: "aaaaaaaaaaaa"},
+		{Index: 2, Content: "Fix rate display", Key: "bbbbbbbbbbbb"},
+		{Index: 3, Content: "Write docs", Key: "cccccccccccc"},
+	}
+	tests := []struct {
+		name    string
+		query   string
+		want    int
+		wantErr bool
+	}{
+		{"by number", "2", 2, false},
+		{"by key", "cccccccccccc", 3, false},
+		{"by unique text", "LIMITER", 1, false},
+		{"ambiguous text", "rate", 0, true},
+		{"unknown number", "9", 0, true},
+		{"no match", "deploy", 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Find(tasks, tt.query)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("Find(%q) = %+v, want error", tt.query, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Find(%q): %v", tt.query, err)
+			}
+			if got.Index != tt.want {
+				t.Errorf("Find(%q) = #%d, want #%d",
+					tt.query, got.Index, tt.want)
+			}
+		})
+	}
+}
+
+// leased builds a lease table holding each task key for an
+// agent.
+func leased(holders map[string]string) map[string]hub.EntryMsg {
+	held := make(map[string]hub.EntryMsg, len(holders))
+	for key, agent := range holders {
+		held[key] = hub.EntryMsg{
+			Type:  "claim",
+			Lease: hub.Lease{Task: key, Agent: agent, Expires: 42},
+		}
+	}
+	return held
+}
+
+func TestPick(t *testing.T) {
+	tasks := Parse(strings.Split(strings.Join([]string{
+		"# Tasks",
+		"- [ ] First",
+		"- [ ] Second",
+		"- [ ] Third",
+	}, "\n"), "\n"))
+	first, second, third := tasks[0].Key, tasks[1].Key, tasks[2].Key
+
+	tests := []struct {
+		name        string
+		holders     map[string]string
+		agent       string
+		wantFound   bool
+		wantTask    string
+		wantHeld    bool
+		wantSkipped int
+	}{
+		{"no leases", nil, "alpha", true, "First", false, 0},
+		{"skips others", map[string]string{
+			first: "beta",
+		}, "alpha", true, "Second", false, 1},
+		{"prefers own", map[string]string{
+			first: "beta", third: "alpha",
+		}, "alpha", true, "Third", true, 0},
+		{"all held", map[string]string{
+			first: "beta", second: "beta", third: "gamma",
+		}, "alpha", false, "", false, 3},
+		{"no agent", map[string]string{
+			first: "alpha",
+		}, "", true, "Second", false, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Pick(tasks, leased(tt.holders), tt.agent)
+			if got.Found != tt.wantFound ||
+				got.Content != tt.wantTask ||
+				got.Held != tt.wantHeld ||
+				got.Skipped != tt.wantSkipped {
+				t.Errorf("Pick() = %+v", got)
+			}
+			if got.Held && got.Expires != 42 {
+				t.Errorf("Expires = %d, want 42", got.Expires)
+			}
+		})
+	}
+}
+
+func TestPickSkipsBlocked(t *testing.T) {
+	tasks := Parse(strings.Split(strings.Join([]string{
+		"# Tasks",
+		"- [ ] Deploy #id:deploy #depends:build",
+		"- [ ] Build #id:build #blocked-by:vendor-sdk",
+		"- [ ] Docs #depends:lint",
+		"- [x] Lint #id:lint",
+	}, "\n"), "\n"))
+
+	got := Pick(tasks, nil, "alpha")
+	if !got.Found || got.Content != "Docs #depends:lint" {
+		t.Fatalf("Pick() = %+v, want Docs", got)
+	}
+	if got.Blocked != 2 {
+		t.Errorf("Blocked = %d, want 2", got.Blocked)
+	}
+	if want := []string{"build"}; !reflect.DeepEqual(
+		tasks[0].Blockers, want,
+	) {
+		t.Errorf("Blockers = %v, want %v", tasks[0].Blockers, want)
+	}
+
+	none := Pick(tasks[:2], nil, "alpha")
+	if none.Found || none.Blocked != 2 {
+		t.Errorf("Pick(blocked only) = %+v, want 2 blocked", none)
+	}
+}
@@ -0,0 +1,44 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package pending
+
+// Task is one pending top-level task.
+//
+// Fields:
+//   - Index: 1-based position among pending tasks, as
+//     ctx_next reports it
+//   - Content: task text without the checkbox
+//   - Key: stable task key (see task.Key)
+//   - Blockers: unmet #depends and #blocked-by references
+//     (see task.Unmet); empty when the task is ready
+type Task struct {
+	Index    int
+	Content  string
+	Key      string
+	Blockers []string
+}
+
+// Choice is the pending task ctx_next suggests once fleet
+// leases are taken into account.
+//
+// Fields:
+//   - Task: the chosen task (zero when Found is false)
+//   - Found: true when a task is available to the agent
+//   - Held: true when the agent already holds the task
+//   - Expires: Unix expiry of the agent's lease when Held
+//   - Skipped: pending tasks passed over because other
+//     agents hold them
+//   - Blocked: pending tasks passed over because they wait
+//     on unfinished tasks or external blockers
+type Choice struct {
+	Task
+	Found   bool
+	Held    bool
+	Expires int64
+	Skipped int
+	Blocked int
+}
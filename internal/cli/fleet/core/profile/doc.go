@@ -0,0 +1,17 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package profile loads the agent registry in
+// .context/fleet.yaml and resolves which agent a ctx fleet
+// command acts for.
+//
+// [Load] reads and validates the profiles: every agent needs
+// a unique id, and a cost tier, when set, must be low, medium,
+// or high. A missing file is an empty registry. [Agent] picks
+// the agent ID from --agent or CTX_AGENT and, when the
+// registry lists agents, insists the ID is one of them.
+// [List] prints the profiles for ctx fleet agents.
+package profile
@@ -0,0 +1,115 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package profile
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+
+	"github.com/ActiveMemory/ctx/internal/config/env"
+	cfgFleet "github.com/ActiveMemory/ctx/internal/config/fleet"
+	"github.com/ActiveMemory/ctx/internal/config/token"
+	"github.com/ActiveMemory/ctx/internal/entity"
+	errFleet "github.com/ActiveMemory/ctx/internal/err/fleet"
+	"github.com/ActiveMemory/ctx/internal/io"
+	"github.com/ActiveMemory/ctx/internal/rc"
+	writeFleet "github.com/ActiveMemory/ctx/internal/write/fleet"
+)
+
+// Load reads and validates .context/fleet.yaml.
+//
+// Returns:
+//   - entity.FleetProfile: the registry (empty when the file
+//     is missing)
+//   - string: the fleet.yaml path, for messages
+//   - error: non-nil if the file is unreadable or invalid
+func Load() (entity.FleetProfile, string, error) {
+	var p entity.FleetProfile
+	ctxDir, ctxErr := rc.ContextDir()
+	if ctxErr != nil {
+		return p, "", ctxErr
+	}
+	path := filepath.Join(ctxDir, cfgFleet.File)
+
+	data, readErr := io.SafeReadUserFile(path)
+	if errors.Is(readErr, os.ErrNotExist) {
+		return p, path, nil
+	}
+	if readErr != nil {
+		return p, path, errFleet.ParseProfile(path, readErr)
+	}
+	if yamlErr := yaml.Unmarshal(data, &p); yamlErr != nil {
+		return p, path, errFleet.ParseProfile(path, yamlErr)
+	}
+	return p, path, validate(p, path)
+}
+
+// Agent resolves the agent a lease command acts for.
+//
+// Parameters:
+//   - id: the --agent value ("" falls back to CTX_AGENT)
+//
+// Returns:
+//   - string: the agent ID
+//   - error: non-nil when no ID is given, fleet.yaml is
+//     invalid, or it lists agents but not this one
+func Agent(id string) (string, error) {
+	if id == "" {
+		id = strings.TrimSpace(os.Getenv(env.Agent))
+	}
+	if id == "" {
+		return "", errFleet.NoAgent()
+	}
+	p, path, loadErr := Load()
+	if loadErr != nil {
+		return "", loadErr
+	}
+	if len(p.Agents) == 0 {
+		return id, nil
+	}
+	for _, a := range p.Agents {
+		if a.ID == id {
+			return id, nil
+		}
+	}
+	return "", errFleet.UnknownAgent(id, path)
+}
+
+// List prints every agent profile for ctx fleet agents.
+//
+// Parameters:
+//   - cmd: cobra command for output
+//
+// Returns:
+//   - error: non-nil if fleet.yaml is unreadable or invalid
+func List(cmd *cobra.Command) error {
+	p, path, loadErr := Load()
+	if loadErr != nil {
+		return loadErr
+	}
+	if len(p.Agents) == 0 {
+		writeFleet.NoAgents(cmd, path)
+		return nil
+	}
+	for _, a := range p.Agents {
+		window := ""
+		if a.ContextWindow > 0 {
+			window = strconv.Itoa(a.ContextWindow)
+		}
+		writeFleet.Agent(
+			cmd, a.ID, a.Tool, window, a.CostTier,
+			strings.Join(a.Capabilities, token.CommaSpace),
+		)
+	}
+	return nil
+}
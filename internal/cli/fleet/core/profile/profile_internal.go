@@ -0,0 +1,39 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package profile
+
+import (
+	cfgFleet "github.com/ActiveMemory/ctx/internal/config/fleet"
+	"github.com/ActiveMemory/ctx/internal/entity"
+	errFleet "github.com/ActiveMemory/ctx/internal/err/fleet"
+)
+
+// validate checks that every profile has a unique id and a
+// known cost tier.
+//
+// Parameters:
+//   - p: decoded registry
+//   - path: fleet.yaml path, for messages
+//
+// Returns:
+//   - error: the first problem found, or nil
+func validate(p entity.FleetProfile, path string) error {
+	seen := make(map[string]bool, len(p.Agents))
+	for i, a := range p.Agents {
+		if a.ID == "" {
+			return errFleet.AgentID(path, i+1)
+		}
+		if seen[a.ID] {
+			return errFleet.DuplicateAgent(path, a.ID)
+		}
+		seen[a.ID] = true
+		if a.CostTier != "" && !cfgFleet.CostTiers[a.CostTier] {
+			return errFleet.CostTier(path, a.ID, a.CostTier)
+		}
+	}
+	return nil
+}
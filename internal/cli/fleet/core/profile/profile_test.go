@@ -0,0 +1,43 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package profile
+
+import (
+	"testing"
+
+	"github.com/ActiveMemory/ctx/internal/entity"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		agents  []entity.FleetAgent
+		wantErr bool
+	}{
+		{"empty", nil, false},
+		{"valid", []entity.FleetAgent{
+			{ID: "claude-1", CostTier: "high"},
+			{ID: "cursor-2"},
+		}, false},
+		{"missing id", []entity.FleetAgent{{Tool: "claude"}}, true},
+		{"duplicate id", []entity.FleetAgent{
+			{ID: "a"}, {ID: "a"},
+		}, true},
+		{"unknown cost tier", []entity.FleetAgent{
+			{ID: "a", CostTier: "free"},
+		}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := entity.FleetProfile{Agents: tt.agents}
+			err := validate(p, "fleet.yaml")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validate() = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
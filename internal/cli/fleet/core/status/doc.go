@@ -0,0 +1,11 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package status prints the fleet view of TASKS.md for ctx
+// fleet status: every pending task with its key and, when
+// leased, its holder and expiry; then any live lease whose
+// task is no longer pending, so it can be released by key.
+package status
@@ -0,0 +1,73 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package status
+
+import (
+	"slices"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/cli/fleet/core/lease"
+	"github.com/ActiveMemory/ctx/internal/cli/fleet/core/pending"
+	cfgEntry "github.com/ActiveMemory/ctx/internal/config/entry"
+	errFleet "github.com/ActiveMemory/ctx/internal/err/fleet"
+	writeFleet "github.com/ActiveMemory/ctx/internal/write/fleet"
+)
+
+// Run prints every pending task with its lease, then the
+// live leases on tasks that are no longer pending.
+//
+// Parameters:
+//   - cmd: cobra command for output
+//
+// Returns:
+//   - error: non-nil if TASKS.md is unreadable, the project
+//     has no hub connection, or the hub is unreachable
+func Run(cmd *cobra.Command) error {
+	tasks, listErr := pending.List()
+	if listErr != nil {
+		return listErr
+	}
+	held, connected, heldErr := lease.Held()
+	if heldErr != nil {
+		return heldErr
+	}
+	if !connected {
+		return errFleet.NotConnected()
+	}
+
+	if len(tasks) == 0 {
+		writeFleet.StatusNone(cmd)
+	}
+	for _, t := range tasks {
+		e, ok := held[t.Key]
+		if !ok {
+			writeFleet.StatusFree(cmd, t.Index, t.Key, t.Content)
+			continue
+		}
+		writeFleet.StatusHeld(
+			cmd, t.Index, t.Key, e.Type == cfgEntry.Assign,
+			e.Lease.Agent, time.Unix(e.Lease.Expires, 0), t.Content,
+		)
+		delete(held, t.Key)
+	}
+
+	stale := make([]string, 0, len(held))
+	for key := range held {
+		stale = append(stale, key)
+	}
+	slices.Sort(stale)
+	for _, key := range stale {
+		e := held[key]
+		writeFleet.StatusStale(
+			cmd, key, e.Type == cfgEntry.Assign,
+			e.Lease.Agent, time.Unix(e.Lease.Expires, 0), e.Content,
+		)
+	}
+	return nil
+}
@@ -0,0 +1,36 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package fleet implements the "ctx fleet" command: sharing out
+// TASKS.md items between several agents working on one
+// repository, through task leases on the ctx Hub.
+//
+// A lease is a claim, assign, or release entry. The hub grants
+// leases atomically and stamps their expiry, so two agents
+// racing for one task cannot both win and a crashed agent's
+// tasks free themselves. Agent capability profiles (tool,
+// context window, cost tier, capabilities) live in
+// .context/fleet.yaml; when it lists agents, only those agents
+// may hold leases. The ctx_next MCP tool skips tasks other
+// agents hold.
+//
+// # Subcommands
+//
+//   - agents: list the agent profiles in fleet.yaml
+//   - status: pending tasks with their keys and holders
+//   - claim: lease a pending task for the calling agent
+//   - assign: hand a pending task to a named agent
+//   - release: give a lease back
+//
+// # Subpackages
+//
+//	cmd/agents, cmd/status, cmd/claim, cmd/assign,
+//	cmd/release: cobra wiring
+//	core/profile: fleet.yaml and agent ID resolution
+//	core/pending: pending tasks and task argument resolution
+//	core/lease: reading and publishing leases on the hub
+//	core/status: the fleet view of TASKS.md
+package fleet
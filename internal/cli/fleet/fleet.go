@@ -0,0 +1,33 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package fleet
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/cli/fleet/cmd/agents"
+	"github.com/ActiveMemory/ctx/internal/cli/fleet/cmd/assign"
+	"github.com/ActiveMemory/ctx/internal/cli/fleet/cmd/claim"
+	"github.com/ActiveMemory/ctx/internal/cli/fleet/cmd/release"
+	"github.com/ActiveMemory/ctx/internal/cli/fleet/cmd/status"
+	"github.com/ActiveMemory/ctx/internal/cli/parent"
+	"github.com/ActiveMemory/ctx/internal/config/embed/cmd"
+)
+
+// Cmd returns the "ctx fleet" parent command.
+//
+// Returns:
+//   - *cobra.Command: The fleet command with subcommands registered
+func Cmd() *cobra.Command {
+	return parent.Cmd(cmd.DescKeyFleet, cmd.UseFleet,
+		agents.Cmd(),
+		status.Cmd(),
+		claim.Cmd(),
+		assign.Cmd(),
+		release.Cmd(),
+	)
+}
@@ -0,0 +1,28 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package policy
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/cli/hub/cmd/policy/set"
+	"github.com/ActiveMemory/ctx/internal/cli/hub/cmd/policy/show"
+	"github.com/ActiveMemory/ctx/internal/cli/parent"
+	"github.com/ActiveMemory/ctx/internal/config/embed/cmd"
+)
+
+// Cmd returns the hub policy parent command.
+//
+// Returns:
+//   - *cobra.Command: The policy command with show and set
+//     subcommands
+func Cmd() *cobra.Command {
+	return parent.Cmd(cmd.DescKeyHubPolicy, cmd.UseHubPolicy,
+		show.Cmd(),
+		set.Cmd(),
+	)
+}
@@ -0,0 +1,27 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package policy provides the "ctx hub policy" parent
+// command.
+//
+// # Overview
+//
+// This package groups the per-client access policy
+// subcommands under one namespace:
+//
+//   - show: print a client's policy.
+//   - set: replace a client's policy.
+//
+// # Usage
+//
+//	ctx hub policy show <client-id>
+//	ctx hub policy set <client-id> [flags]
+//
+// # Behavior
+//
+// [Cmd] uses the parent.Cmd helper; running it without a
+// subcommand prints the help text.
+package policy
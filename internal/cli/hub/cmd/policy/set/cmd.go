@@ -0,0 +1,86 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package set
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	corePolicy "github.com/ActiveMemory/ctx/internal/cli/hub/core/policy"
+	"github.com/ActiveMemory/ctx/internal/config/cli"
+	"github.com/ActiveMemory/ctx/internal/config/embed/cmd"
+	"github.com/ActiveMemory/ctx/internal/config/embed/flag"
+	"github.com/ActiveMemory/ctx/internal/config/env"
+	cFlag "github.com/ActiveMemory/ctx/internal/config/flag"
+	errHub "github.com/ActiveMemory/ctx/internal/err/hub"
+	"github.com/ActiveMemory/ctx/internal/flagbind"
+	"github.com/ActiveMemory/ctx/internal/hub"
+)
+
+// Cmd returns the hub policy set subcommand.
+//
+// Returns:
+//   - *cobra.Command: The set subcommand
+func Cmd() *cobra.Command {
+	var (
+		adminToken string
+		p          hub.Policy
+	)
+
+	short, long := desc.Command(cmd.DescKeyHubPolicySet)
+
+	c := &cobra.Command{
+		Use:     cmd.UseHubPolicySet,
+		Short:   short,
+		Long:    long,
+		Example: desc.Example(cmd.DescKeyHubPolicySet),
+		Args:    cobra.ExactArgs(1),
+		// Hub stores at ~/.ctx/hub-data/, not .context/.
+		// Spec: specs/single-source-context-anchor.md.
+		Annotations: map[string]string{cli.AnnotationSkipInit: cli.AnnotationTrue},
+		RunE: func(
+			cobraCmd *cobra.Command, args []string,
+		) error {
+			// Admin token: --token flag takes precedence, then
+			// the CTX_HUB_ADMIN_TOKEN environment variable.
+			token := adminToken
+			if token == "" {
+				token = os.Getenv(env.HubAdmin)
+			}
+			if token == "" {
+				cobraCmd.SilenceUsage = true
+				return errHub.AdminTokenRequired()
+			}
+			return corePolicy.Set(cobraCmd, args[0], token, p)
+		},
+	}
+
+	flagbind.StringFlag(
+		c, &adminToken,
+		cFlag.Token, flag.DescKeyHubPolicyAuth,
+	)
+	flagbind.BoolFlag(
+		c, &p.ReadOnly,
+		cFlag.ReadOnly, flag.DescKeyHubPolicyReadOnly,
+	)
+	flagbind.StringArrayFlag(
+		c, &p.Publish,
+		cFlag.Publish, flag.DescKeyHubPolicyPublish,
+	)
+	flagbind.StringArrayFlag(
+		c, &p.Types,
+		cFlag.ReadType, flag.DescKeyHubPolicyReadType,
+	)
+	flagbind.StringArrayFlag(
+		c, &p.Origins,
+		cFlag.ReadOrigin, flag.DescKeyHubPolicyReadOrigin,
+	)
+
+	return c
+}
@@ -0,0 +1,23 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package set wires the ctx hub policy set subcommand.
+//
+// # Overview
+//
+// [Cmd] builds the cobra command that replaces one client's
+// access policy. The client ID is the single positional
+// argument; --read-only, --publish, --read-type, and
+// --read-origin describe the new policy, and the admin
+// token comes from --token or CTX_HUB_ADMIN_TOKEN.
+//
+// # Behavior
+//
+// The flags form a complete policy: anything not given is
+// unrestricted, so running with no flags restores full
+// access. The list flags are repeatable. The hub validates
+// entry types and rejects unknown ones.
+package set
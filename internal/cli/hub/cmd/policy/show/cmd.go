@@ -0,0 +1,66 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package show
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	corePolicy "github.com/ActiveMemory/ctx/internal/cli/hub/core/policy"
+	"github.com/ActiveMemory/ctx/internal/config/cli"
+	"github.com/ActiveMemory/ctx/internal/config/embed/cmd"
+	"github.com/ActiveMemory/ctx/internal/config/embed/flag"
+	"github.com/ActiveMemory/ctx/internal/config/env"
+	cFlag "github.com/ActiveMemory/ctx/internal/config/flag"
+	errHub "github.com/ActiveMemory/ctx/internal/err/hub"
+	"github.com/ActiveMemory/ctx/internal/flagbind"
+)
+
+// Cmd returns the hub policy show subcommand.
+//
+// Returns:
+//   - *cobra.Command: The show subcommand
+func Cmd() *cobra.Command {
+	var adminToken string
+
+	short, long := desc.Command(cmd.DescKeyHubPolicyShow)
+
+	c := &cobra.Command{
+		Use:     cmd.UseHubPolicyShow,
+		Short:   short,
+		Long:    long,
+		Example: desc.Example(cmd.DescKeyHubPolicyShow),
+		Args:    cobra.ExactArgs(1),
+		// Hub stores at ~/.ctx/hub-data/, not .context/.
+		// Spec: specs/single-source-context-anchor.md.
+		Annotations: map[string]string{cli.AnnotationSkipInit: cli.AnnotationTrue},
+		RunE: func(
+			cobraCmd *cobra.Command, args []string,
+		) error {
+			// Admin token: --token flag takes precedence, then
+			// the CTX_HUB_ADMIN_TOKEN environment variable.
+			token := adminToken
+			if token == "" {
+				token = os.Getenv(env.HubAdmin)
+			}
+			if token == "" {
+				cobraCmd.SilenceUsage = true
+				return errHub.AdminTokenRequired()
+			}
+			return corePolicy.Show(cobraCmd, args[0], token)
+		},
+	}
+
+	flagbind.StringFlag(
+		c, &adminToken,
+		cFlag.Token, flag.DescKeyHubPolicyAuth,
+	)
+
+	return c
+}
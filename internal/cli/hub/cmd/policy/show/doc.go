@@ -0,0 +1,22 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package show wires the ctx hub policy show subcommand.
+//
+// # Overview
+//
+// [Cmd] builds the cobra command that prints one client's
+// access policy. It takes the client ID as its single
+// positional argument and the admin token from the --token
+// flag or the CTX_HUB_ADMIN_TOKEN environment variable,
+// then delegates to the core policy package.
+//
+// # Behavior
+//
+// The command resolves the admin token (flag first, then
+// environment) and fails early if neither is set. It skips
+// context init, like the other hub subcommands.
+package show
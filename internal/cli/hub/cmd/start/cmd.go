@@ -22,7 +22,10 @@ import (
 //
 // Starts the ctx Hub gRPC server either in the foreground or
 // as a detached daemon. When --peers is set, joins a Raft
-// cluster for leader election.
+// cluster for leader election. --tls-cert/--tls-key switch
+// the listener (and Raft peer link) to TLS; --tls-ca adds
+// mandatory client certificates. --admin-addr opens the
+// read-only HTTP gateway and Prometheus metrics.
 //
 // Returns:
 //   - *cobra.Command: The start subcommand
@@ -32,6 +35,10 @@ func Cmd() *cobra.Command {
 		port     int
 		dataDir  string
 		peersStr string
+		tlsCert  string
+		tlsKey   string
+		tlsCA    string
+		admin    string
 	)
 
 	short, long := desc.Command(cmd.DescKeyHubStart)
@@ -48,14 +55,15 @@ func Cmd() *cobra.Command {
 		// Spec: specs/single-source-context-anchor.md.
 		Annotations: map[string]string{cli.AnnotationSkipInit: cli.AnnotationTrue},
 		RunE: func(cobraCmd *cobra.Command, _ []string) error {
+			tlsCfg := server.TLSFiles(tlsCert, tlsKey, tlsCA)
 			if isDaemon {
 				return server.RunDaemon(
-					cobraCmd, port, dataDir,
+					cobraCmd, port, dataDir, tlsCfg, admin,
 				)
 			}
 			peers := server.ParsePeers(peersStr)
 			return server.Run(
-				cobraCmd, port, dataDir, peers,
+				cobraCmd, port, dataDir, peers, tlsCfg, admin,
 			)
 		},
 	}
@@ -77,6 +85,23 @@ func Cmd() *cobra.Command {
 		c, &peersStr,
 		cFlag.Peers, flag.DescKeyHubStartPeers,
 	)
+	flagbind.StringFlag(
+		c, &tlsCert,
+		cFlag.TLSCert, flag.DescKeyHubStartTLSCert,
+	)
+	flagbind.StringFlag(
+		c, &tlsKey,
+		cFlag.TLSKey, flag.DescKeyHubStartTLSKey,
+	)
+	flagbind.StringFlag(
+		c, &tlsCA,
+		cFlag.TLSCA, flag.DescKeyHubStartTLSCA,
+	)
+
+	flagbind.StringFlag(
+		c, &admin,
+		cFlag.AdminAddr, flag.DescKeyHubStartAdminAddr,
+	)
 
 	return c
 }
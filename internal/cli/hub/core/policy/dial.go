@@ -0,0 +1,27 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package policy
+
+import (
+	connectCfg "github.com/ActiveMemory/ctx/internal/cli/connection/core/config"
+	"github.com/ActiveMemory/ctx/internal/hub"
+)
+
+// dial opens an admin connection to the configured hub.
+// No bearer token is attached; the policy RPCs are
+// admin-gated instead.
+//
+// Returns:
+//   - *hub.Client: connected client; caller closes it
+//   - error: non-nil if config load or dial fails
+func dial() (*hub.Client, error) {
+	cfg, loadErr := connectCfg.Load()
+	if loadErr != nil {
+		return nil, loadErr
+	}
+	return hub.NewClient(cfg.HubAddr, "", cfg.TLS)
+}
@@ -0,0 +1,28 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package policy implements the ctx hub policy show and set
+// commands.
+//
+// # Overview
+//
+// A client policy limits what one registered client may do
+// on the hub: which entry types it may publish, which types
+// and origins it may sync or listen to, and whether it may
+// publish at all. The hub enforces the policy on every
+// Publish, Sync, and Listen; this package only reads and
+// replaces it.
+//
+// # Behavior
+//
+// [Show] calls the admin-gated Policy RPC and prints the
+// result. [Set] calls SetPolicy with a complete policy
+// built from the command's flags; the hub replaces the
+// stored policy wholesale and rejects unknown entry types.
+// Both authenticate with the hub admin token, resolved by
+// the command layer, and read the hub address from the
+// saved connection config, like ctx hub revoke.
+package policy
@@ -0,0 +1,83 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package policy
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	cfgWarn "github.com/ActiveMemory/ctx/internal/config/warn"
+	"github.com/ActiveMemory/ctx/internal/hub"
+	logWarn "github.com/ActiveMemory/ctx/internal/log/warn"
+)
+
+// Show prints a client's access policy.
+//
+// Parameters:
+//   - cmd: cobra command for output
+//   - clientID: ID of the client
+//   - adminToken: hub admin token (already resolved from flag
+//     or environment by the caller)
+//
+// Returns:
+//   - error: non-nil if config load, dial, or the RPC fails
+func Show(cmd *cobra.Command, clientID, adminToken string) error {
+	client, dialErr := dial()
+	if dialErr != nil {
+		return dialErr
+	}
+	defer func() {
+		if cerr := client.Close(); cerr != nil {
+			logWarn.Warn(cfgWarn.CloseHubClient, cerr)
+		}
+	}()
+
+	resp, callErr := client.Policy(
+		context.Background(), adminToken, clientID,
+	)
+	if callErr != nil {
+		return callErr
+	}
+	report(cmd, resp)
+	return nil
+}
+
+// Set replaces a client's access policy and prints the
+// result.
+//
+// Parameters:
+//   - cmd: cobra command for output
+//   - clientID: ID of the client
+//   - adminToken: hub admin token (already resolved from flag
+//     or environment by the caller)
+//   - p: complete new policy
+//
+// Returns:
+//   - error: non-nil if config load, dial, or the RPC fails
+func Set(
+	cmd *cobra.Command, clientID, adminToken string, p hub.Policy,
+) error {
+	client, dialErr := dial()
+	if dialErr != nil {
+		return dialErr
+	}
+	defer func() {
+		if cerr := client.Close(); cerr != nil {
+			logWarn.Warn(cfgWarn.CloseHubClient, cerr)
+		}
+	}()
+
+	resp, callErr := client.SetPolicy(
+		context.Background(), adminToken, clientID, p,
+	)
+	if callErr != nil {
+		return callErr
+	}
+	report(cmd, resp)
+	return nil
+}
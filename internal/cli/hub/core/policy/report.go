@@ -0,0 +1,27 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package policy
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/hub"
+	writeHub "github.com/ActiveMemory/ctx/internal/write/hub"
+)
+
+// report writes a Policy RPC reply to the command output.
+//
+// Parameters:
+//   - cmd: cobra command for output
+//   - resp: policy reply from the hub
+func report(cmd *cobra.Command, resp *hub.PolicyResponse) {
+	p := resp.Policy
+	writeHub.Policy(
+		cmd, resp.ClientID, resp.ProjectName,
+		p.ReadOnly, p.Publish, p.Types, p.Origins,
+	)
+}
@@ -43,7 +43,7 @@ func Run(
 		return loadErr
 	}
 
-	client, dialErr := hub.NewClient(cfg.HubAddr, "")
+	client, dialErr := hub.NewClient(cfg.HubAddr, "", cfg.TLS)
 	if dialErr != nil {
 		return dialErr
 	}
@@ -21,6 +21,7 @@ import (
 	cfgWarn "github.com/ActiveMemory/ctx/internal/config/warn"
 	errServe "github.com/ActiveMemory/ctx/internal/err/serve"
 	execDaemon "github.com/ActiveMemory/ctx/internal/exec/daemon"
+	"github.com/ActiveMemory/ctx/internal/hub"
 	"github.com/ActiveMemory/ctx/internal/io"
 	logWarn "github.com/ActiveMemory/ctx/internal/log/warn"
 	writeServe "github.com/ActiveMemory/ctx/internal/write/serve"
@@ -35,11 +36,18 @@ import (
 //   - cmd: cobra command for output
 //   - port: TCP port to listen on
 //   - dataDir: hub data directory (empty = default)
+//   - tlsCfg: certificate material forwarded to the child
+//   - adminAddr: admin gateway address forwarded to the
+//     child (empty = no gateway)
 //
 // Returns:
 //   - error: non-nil if fork or PID file write fails
 func RunDaemon(
-	cmd *cobra.Command, port int, dataDir string,
+	cmd *cobra.Command,
+	port int,
+	dataDir string,
+	tlsCfg hub.TLSConfig,
+	adminAddr string,
 ) error {
 	if dataDir == "" {
 		defaultDir, dirErr := defaultDataDir()
@@ -59,6 +67,12 @@ func RunDaemon(
 		cfgHub.FmtFlagPrefix + cfgFlag.Port, strconv.Itoa(port),
 		cfgHub.FmtFlagPrefix + cfgFlag.DataDir, dataDir,
 	}
+	args = append(args, tlsArgs(tlsCfg)...)
+	if adminAddr != "" {
+		args = append(args,
+			cfgHub.FmtFlagPrefix+cfgFlag.AdminAddr, adminAddr,
+		)
+	}
 
 	pid, startErr := execDaemon.Start(binPath, args)
 	if startErr != nil {
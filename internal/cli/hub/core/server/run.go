@@ -15,7 +15,9 @@ import (
 
 	cfgHub "github.com/ActiveMemory/ctx/internal/config/hub"
 	"github.com/ActiveMemory/ctx/internal/config/token"
+	cfgWarn "github.com/ActiveMemory/ctx/internal/config/warn"
 	"github.com/ActiveMemory/ctx/internal/hub"
+	logWarn "github.com/ActiveMemory/ctx/internal/log/warn"
 	writeServe "github.com/ActiveMemory/ctx/internal/write/serve"
 )
 
@@ -34,6 +36,25 @@ func ParsePeers(s string) []string {
 	return strings.Split(s, token.Comma)
 }
 
+// TLSFiles bundles the start flags into the hub transport
+// settings. All-empty input yields the zero value, which
+// keeps the hub in plaintext.
+//
+// Parameters:
+//   - certFile: --tls-cert value
+//   - keyFile: --tls-key value
+//   - caFile: --tls-ca value
+//
+// Returns:
+//   - hub.TLSConfig: transport settings for [Run]
+func TLSFiles(certFile, keyFile, caFile string) hub.TLSConfig {
+	return hub.TLSConfig{
+		CertFile: certFile,
+		KeyFile:  keyFile,
+		CAFile:   caFile,
+	}
+}
+
 // DefaultPort returns the default hub listen port.
 //
 // Returns:
@@ -46,12 +67,18 @@ func DefaultPort() int { return defaultPort }
 // On subsequent runs, loads the existing token.
 // If dataDir is empty, uses ~/.ctx/hub-data/.
 // If peers is non-empty, starts Raft cluster for HA.
+// The same certificate material secures client RPCs and
+// the Raft peer link. A non-empty adminAddr also starts
+// the read-only admin gateway, which shares the TLS
+// settings and stops with the hub.
 //
 // Parameters:
 //   - cmd: cobra command for output
 //   - port: TCP port to listen on
 //   - dataDir: hub data directory (empty = default)
 //   - peers: peer addresses for cluster mode (may be nil)
+//   - tlsCfg: certificate material (zero = plaintext)
+//   - adminAddr: admin gateway address (empty = disabled)
 //
 // Returns:
 //   - error: non-nil if setup or server startup fails
@@ -60,6 +87,8 @@ func Run(
 	port int,
 	dataDir string,
 	peers []string,
+	tlsCfg hub.TLSConfig,
+	adminAddr string,
 ) error {
 	dataDir, resolveErr := resolveDataDir(dataDir)
 	if resolveErr != nil {
@@ -78,14 +107,17 @@ func Run(
 		return tokenErr
 	}
 
-	srv := hub.NewServer(store, adminToken)
+	srv, srvErr := hub.NewServer(store, adminToken, tlsCfg)
+	if srvErr != nil {
+		return srvErr
+	}
 
 	// Start Raft cluster if peers are configured.
 	if len(peers) > 0 {
 		bindAddr := fmt.Sprintf(cfgHub.FmtPort, port+1)
 		cluster, clusterErr := hub.NewCluster(
 			fmt.Sprintf(cfgHub.FmtPort, port),
-			bindAddr, dataDir, peers,
+			bindAddr, dataDir, peers, tlsCfg,
 		)
 		if clusterErr != nil {
 			return clusterErr
@@ -101,5 +133,20 @@ func Run(
 
 	writeServe.HubStarted(cmd, lis.Addr())
 
+	if adminAddr != "" {
+		adminLis, adminLisErr := net.Listen(
+			cfgHub.RaftTransport, adminAddr,
+		)
+		if adminLisErr != nil {
+			return adminLisErr
+		}
+		writeServe.AdminStarted(cmd, adminLis.Addr())
+		go func() {
+			if adminErr := srv.ServeAdmin(adminLis); adminErr != nil {
+				logWarn.Warn(cfgWarn.HubAdmin, adminErr)
+			}
+		}()
+	}
+
 	return srv.Serve(lis)
 }
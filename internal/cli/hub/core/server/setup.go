@@ -13,6 +13,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/ActiveMemory/ctx/internal/config/dir"
+	cfgFlag "github.com/ActiveMemory/ctx/internal/config/flag"
 	"github.com/ActiveMemory/ctx/internal/config/fs"
 	cfgHub "github.com/ActiveMemory/ctx/internal/config/hub"
 	"github.com/ActiveMemory/ctx/internal/hub"
@@ -90,3 +91,28 @@ func loadOrCreateAdmin(
 
 	return adminToken, nil
 }
+
+// tlsArgs renders the non-empty TLS settings back into
+// start flags for the daemon re-exec.
+//
+// Parameters:
+//   - cfg: certificate material from the parent invocation
+//
+// Returns:
+//   - []string: flag/value pairs (empty for plaintext)
+func tlsArgs(cfg hub.TLSConfig) []string {
+	var args []string
+	for _, f := range []struct{ name, value string }{
+		{cfgFlag.TLSCert, cfg.CertFile},
+		{cfgFlag.TLSKey, cfg.KeyFile},
+		{cfgFlag.TLSCA, cfg.CAFile},
+	} {
+		if f.value == "" {
+			continue
+		}
+		args = append(
+			args, cfgHub.FmtFlagPrefix+f.name, f.value,
+		)
+	}
+	return args
+}
@@ -23,6 +23,7 @@
 //   - stepdown: ask the current leader to yield its role
 //     to another node
 //   - revoke: invalidate a client's token by client ID
+//   - policy: view or edit a client's access policy
 //
 // # Subpackages
 //
@@ -32,5 +33,6 @@
 //	cmd/peer: peer management
 //	cmd/stepdown: leader yield
 //	cmd/revoke: client token revocation
+//	cmd/policy: per-client access policies
 //	core: shared Hub client and config helpers
 package hub
@@ -10,6 +10,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/ActiveMemory/ctx/internal/cli/hub/cmd/peer"
+	"github.com/ActiveMemory/ctx/internal/cli/hub/cmd/policy"
 	"github.com/ActiveMemory/ctx/internal/cli/hub/cmd/revoke"
 	"github.com/ActiveMemory/ctx/internal/cli/hub/cmd/start"
 	hubStatus "github.com/ActiveMemory/ctx/internal/cli/hub/cmd/status"
@@ -23,7 +24,7 @@ import (
 //
 // Returns:
 //   - *cobra.Command: hub with start, stop, status, peer,
-//     stepdown, revoke
+//     stepdown, revoke, policy
 func Cmd() *cobra.Command {
 	return parent.Cmd(
 		cmd.DescKeyHub, cmd.UseHub,
@@ -33,5 +34,6 @@ func Cmd() *cobra.Command {
 		peer.Cmd(),
 		stepdown.Cmd(),
 		revoke.Cmd(),
+		policy.Cmd(),
 	)
 }
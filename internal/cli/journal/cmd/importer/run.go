@@ -30,9 +30,11 @@ import (
 	errSession "github.com/ActiveMemory/ctx/internal/err/session"
 	"github.com/ActiveMemory/ctx/internal/i18n"
 	ctxIo "github.com/ActiveMemory/ctx/internal/io"
+	journalRedact "github.com/ActiveMemory/ctx/internal/journal/redact"
 	"github.com/ActiveMemory/ctx/internal/journal/schema"
 	"github.com/ActiveMemory/ctx/internal/journal/state"
 	"github.com/ActiveMemory/ctx/internal/rc"
+	"github.com/ActiveMemory/ctx/internal/txn"
 	"github.com/ActiveMemory/ctx/internal/write/err"
 	writeRecall "github.com/ActiveMemory/ctx/internal/write/journal"
 	writeSchema "github.com/ActiveMemory/ctx/internal/write/schema"
@@ -59,6 +61,13 @@ func Run(cmd *cobra.Command, args []string, opts entity.ImportOpts) error {
 		return validateErr
 	}
 
+	// A project redaction rule that does not compile fails here,
+	// before anything is scanned or written.
+	red, redactErr := journalRedact.Load()
+	if redactErr != nil {
+		return redactErr
+	}
+
 	// 2. Bare import (no args, no --all) → show help (T2.8).
 	if len(args) == 0 && !opts.All {
 		return cmd.Help()
@@ -109,56 +118,80 @@ func Run(cmd *cobra.Command, args []string, opts entity.ImportOpts) error {
 		return errFs.Mkdir(dir.Journal, mkErr)
 	}
 
-	// 5. Load state + build index.
-	jState, loadErr := state.Load(journalDir)
-	if loadErr != nil {
-		return errJournal.LoadState(loadErr)
-	}
-	sessionIndex := index.Session(journalDir)
-
-	// 6. Build the plan.
-	importPlan := plan.Import(
-		toImport, journalDir, sessionIndex, jState, opts, singleSession,
-	)
-
-	// 7. Execute renames.
-	renamed := 0
-	for _, rop := range importPlan.RenameOps {
-		index.RenameJournalFiles(journalDir, rop.OldBase, rop.NewBase, rop.NumParts)
-		jState.Rename(
-			rop.OldBase+file.ExtMarkdown, rop.NewBase+file.ExtMarkdown,
+	// 5. Confirmation prompt for regeneration, planned against
+	// the current state before the write lock is taken so the
+	// lock is not held while waiting on the user.
+	if !opts.DryRun && !opts.Yes && !singleSession {
+		preState, loadErr := state.Load(journalDir)
+		if loadErr != nil {
+			return errJournal.LoadState(loadErr)
+		}
+		preview := plan.Import(
+			toImport, journalDir, index.Session(journalDir),
+			preState, opts, singleSession,
 		)
-		renamed++
+		if preview.RegenCount > 0 {
+			ok, promptErr := confirm.Import(cmd, preview)
+			if promptErr != nil {
+				return promptErr
+			}
+			if !ok {
+				writeRecall.Aborted(cmd)
+				return nil
+			}
+		}
 	}
 
-	// 8. Dry-run → print summary and return.
-	if opts.DryRun {
-		writeRecall.ImportSummary(
-			cmd, importPlan.NewCount, importPlan.GrownCount,
-			importPlan.RegenCount, importPlan.SkipCount,
-			importPlan.LockedCount, true,
+	// Load, plan, write, and save under one lock so a concurrent
+	// writer's state change is not dropped.
+	var imported, updated, renamed, skipped int
+	lockErr := txn.Do(ctxDir, func() error {
+		// 6. Load state + build index.
+		jState, loadErr := state.Load(journalDir)
+		if loadErr != nil {
+			return errJournal.LoadState(loadErr)
+		}
+		sessionIndex := index.Session(journalDir)
+
+		// 7. Build the plan.
+		importPlan := plan.Import(
+			toImport, journalDir, sessionIndex, jState, opts, singleSession,
 		)
-		return nil
-	}
 
-	// 9. Confirmation prompt for regeneration.
-	if importPlan.RegenCount > 0 && !opts.Yes && !singleSession {
-		ok, promptErr := confirm.Import(cmd, importPlan)
-		if promptErr != nil {
-			return promptErr
+		// 8. Execute renames.
+		for _, rop := range importPlan.RenameOps {
+			index.RenameJournalFiles(
+				journalDir, rop.OldBase, rop.NewBase, rop.NumParts,
+			)
+			jState.Rename(
+				rop.OldBase+file.ExtMarkdown, rop.NewBase+file.ExtMarkdown,
+			)
+			renamed++
 		}
-		if !ok {
-			writeRecall.Aborted(cmd)
+
+		// 9. Dry-run → print summary and return.
+		if opts.DryRun {
+			writeRecall.ImportSummary(
+				cmd, importPlan.NewCount, importPlan.GrownCount,
+				importPlan.RegenCount, importPlan.SkipCount,
+				importPlan.LockedCount, true,
+			)
 			return nil
 		}
-	}
 
-	// 10. Execute the import.
-	imported, updated, skipped := execute.Import(cmd, importPlan, jState, opts)
+		// 10. Execute the import.
+		imported, updated, skipped = execute.Import(
+			cmd, importPlan, jState, red, opts,
+		)
 
-	// 11. Persist journal state.
-	if saveErr := jState.Save(journalDir); saveErr != nil {
-		err.WarnFile(cmd, journal.File, saveErr)
+		// 11. Persist journal state.
+		if saveErr := jState.Save(journalDir); saveErr != nil {
+			err.WarnFile(cmd, journal.File, saveErr)
+		}
+		return nil
+	})
+	if lockErr != nil || opts.DryRun {
+		return lockErr
 	}
 
 	// 12. Schema drift check on imported source files.
@@ -0,0 +1,45 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package redact
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	"github.com/ActiveMemory/ctx/internal/config/embed/cmd"
+	"github.com/ActiveMemory/ctx/internal/config/embed/flag"
+	cFlag "github.com/ActiveMemory/ctx/internal/config/flag"
+	"github.com/ActiveMemory/ctx/internal/flagbind"
+)
+
+// Cmd returns the "ctx journal redact" subcommand.
+//
+// Returns:
+//   - *cobra.Command: Command for redacting secrets from journal
+//     entries
+func Cmd() *cobra.Command {
+	var check bool
+
+	short, long := desc.Command(cmd.DescKeyJournalRedact)
+
+	c := &cobra.Command{
+		Use:     cmd.UseJournalRedact,
+		Short:   short,
+		Long:    long,
+		Example: desc.Example(cmd.DescKeyJournalRedact),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return Run(cmd, args, check)
+		},
+	}
+
+	flagbind.BoolFlag(
+		c, &check,
+		cFlag.Check, flag.DescKeyJournalRedactCheck,
+	)
+
+	return c
+}
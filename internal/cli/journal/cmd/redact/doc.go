@@ -0,0 +1,35 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package redact implements the "ctx journal redact"
+// command.
+//
+// # Overview
+//
+// Import replaces secrets with placeholders before it
+// writes an entry. This command applies the same redactor
+// to entries already in .context/journal/: ones imported
+// before redaction existed, with redaction disabled, or
+// under an older ruleset.
+//
+// # Flags
+//
+//	--check  Report secrets by file and line without
+//	         rewriting anything; exit non-zero when any
+//	         are found.
+//
+// # Arguments
+//
+// Optional patterns select entries by slug, date, or short
+// ID, as with lock. No pattern selects every entry.
+//
+// # Behavior
+//
+// [Cmd] builds the cobra.Command and registers the flag.
+// [Run] delegates to the core redact package, which
+// rewrites entries in place, skips locked ones, and
+// records the ruleset version in the journal state.
+package redact
@@ -0,0 +1,26 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package redact
+
+import (
+	"github.com/spf13/cobra"
+
+	coreRedact "github.com/ActiveMemory/ctx/internal/cli/journal/core/redact"
+)
+
+// Run delegates to the core redact runner.
+//
+// Parameters:
+//   - cmd: Cobra command for output
+//   - args: Patterns to match against journal filenames
+//   - check: Report secrets instead of rewriting entries
+//
+// Returns:
+//   - error: Non-nil on I/O failure or when check finds a secret
+func Run(cmd *cobra.Command, args []string, check bool) error {
+	return coreRedact.Run(cmd, args, check)
+}
@@ -31,9 +31,10 @@ import (
 //   - *cobra.Command: Command for generating a static site from journal entries
 func Cmd() *cobra.Command {
 	var (
-		output string
-		serve  bool
-		build  bool
+		output      string
+		serve       bool
+		build       bool
+		useZensical bool
 	)
 
 	short, long := desc.Command(cmd.DescKeyJournalSite)
@@ -43,7 +44,7 @@ func Cmd() *cobra.Command {
 		Long:    long,
 		Example: desc.Example(cmd.DescKeyJournalSite),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return Run(cmd, output, build, serve)
+			return Run(cmd, output, build, serve, useZensical)
 		},
 	}
 
@@ -53,6 +54,9 @@ func Cmd() *cobra.Command {
 	)
 	flagbind.BoolFlag(c, &build, cFlag.Build, flag.DescKeyJournalSiteBuild)
 	flagbind.BoolFlag(c, &serve, cFlag.Serve, flag.DescKeyJournalSiteServe)
+	flagbind.BoolFlag(
+		c, &useZensical, cFlag.Zensical, flag.DescKeyJournalSiteZensical,
+	)
 
 	return c
 }
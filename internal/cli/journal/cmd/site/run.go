@@ -7,11 +7,13 @@
 package site
 
 import (
+	"net"
 	"os"
 	"path/filepath"
 
 	"github.com/spf13/cobra"
 
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
 	readJournal "github.com/ActiveMemory/ctx/internal/assets/read/journal"
 	"github.com/ActiveMemory/ctx/internal/cli/journal/core/collapse"
 	"github.com/ActiveMemory/ctx/internal/cli/journal/core/consolidate"
@@ -20,13 +22,17 @@ import (
 	"github.com/ActiveMemory/ctx/internal/cli/journal/core/generate"
 	"github.com/ActiveMemory/ctx/internal/cli/journal/core/normalize"
 	"github.com/ActiveMemory/ctx/internal/cli/journal/core/parse"
+	"github.com/ActiveMemory/ctx/internal/cli/journal/core/query"
 	"github.com/ActiveMemory/ctx/internal/cli/journal/core/reduce"
 	"github.com/ActiveMemory/ctx/internal/cli/journal/core/section"
+	"github.com/ActiveMemory/ctx/internal/cli/journal/core/stats"
 	"github.com/ActiveMemory/ctx/internal/cli/journal/core/turn"
 	"github.com/ActiveMemory/ctx/internal/config/dir"
+	"github.com/ActiveMemory/ctx/internal/config/embed/text"
 	"github.com/ActiveMemory/ctx/internal/config/file"
 	"github.com/ActiveMemory/ctx/internal/config/fs"
 	cfgJournal "github.com/ActiveMemory/ctx/internal/config/journal"
+	cfgSite "github.com/ActiveMemory/ctx/internal/config/site"
 	"github.com/ActiveMemory/ctx/internal/config/zensical"
 	"github.com/ActiveMemory/ctx/internal/entity"
 	errFs "github.com/ActiveMemory/ctx/internal/err/fs"
@@ -35,26 +41,31 @@ import (
 	ctxIo "github.com/ActiveMemory/ctx/internal/io"
 	"github.com/ActiveMemory/ctx/internal/journal/state"
 	"github.com/ActiveMemory/ctx/internal/rc"
+	renderSite "github.com/ActiveMemory/ctx/internal/site"
 	"github.com/ActiveMemory/ctx/internal/wrap"
 	"github.com/ActiveMemory/ctx/internal/write/err"
 	writeJournal "github.com/ActiveMemory/ctx/internal/write/journal"
+	writeSite "github.com/ActiveMemory/ctx/internal/write/site"
 )
 
 // Run handles the journal site command.
 //
 // Scans .context/journal/ for Markdown files, generates a zensical project
-// structure, and optionally builds or serves the site.
+// structure, and optionally builds or serves the site. Builds use the
+// built-in renderer and write HTML to <output>/site unless useZensical
+// hands them to the zensical binary.
 //
 // Parameters:
 //   - cmd: Cobra command for output stream
 //   - output: Output directory for the generated site
-//   - build: If true, run zensical build after generating
-//   - serve: If true, run zensical serve after generating
+//   - build: If true, render HTML after generating
+//   - serve: If true, render HTML and serve it after generating
+//   - useZensical: If true, build and serve through zensical
 //
 // Returns:
 //   - error: Non-nil if generation fails
 func Run(
-	cmd *cobra.Command, output string, build, serve bool,
+	cmd *cobra.Command, output string, build, serve, useZensical bool,
 ) error {
 	ctxDir, ctxErr := rc.RequireContextDir()
 	if ctxErr != nil {
@@ -191,9 +202,26 @@ func Run(
 		err.WarnFile(cmd, cfgJournal.File, saveErr)
 	}
 
+	// Generate the stats page from the sessions behind the journal.
+	// Best-effort: a missing session store must not fail site build.
+	hasStats := false
+	sessions, findErr := query.FindSessions(false)
+	if findErr == nil && len(sessions) > 0 {
+		statsPath := filepath.Join(docsDir, file.Stats)
+		page := stats.Markdown(stats.Compute(sessions))
+		if writeErr := ctxIo.SafeWriteFile(
+			statsPath, []byte(page), fs.PermFile,
+		); writeErr != nil {
+			err.WarnFile(cmd, file.Stats, writeErr)
+		} else {
+			hasStats = true
+		}
+	}
+
 	// Remove orphan site files: entries whose source was renamed or deleted.
-	knownFiles := make(map[string]bool, len(entries)+1)
+	knownFiles := make(map[string]bool, len(entries)+2)
 	knownFiles[file.Index] = true
+	knownFiles[file.Stats] = hasStats
 	for _, e := range entries {
 		knownFiles[e.Filename] = true
 	}
@@ -324,7 +352,7 @@ func Run(
 
 	// Generate zensical.toml
 	tomlContent := generate.ZensicalToml(
-		entries, topics, keyFiles, sessionTypes,
+		entries, topics, keyFiles, sessionTypes, hasStats,
 	)
 	tomlPath := filepath.Join(output, zensical.Toml)
 	if writeErr := ctxIo.SafeWriteFile(
@@ -334,14 +362,34 @@ func Run(
 		return errFs.FileWrite(tomlPath, writeErr)
 	}
 
-	if serve {
+	if useZensical && serve {
 		writeJournal.InfoSiteStarting(cmd)
 		return execZensical.Run(output, zensical.CmdServe)
-	} else if build {
+	} else if useZensical && build {
 		writeJournal.InfoSiteBuilding(cmd)
 		return execZensical.Run(output, zensical.CmdBuild)
 	}
 
+	if serve || build {
+		siteDir := filepath.Join(output, cfgSite.Dir)
+		pages, buildErr := renderSite.Build(
+			docsDir, siteDir, desc.Text(text.DescKeySiteTitleJournal),
+			generate.Nav(entries, topics, keyFiles, sessionTypes, hasStats),
+		)
+		if buildErr != nil {
+			return buildErr
+		}
+		writeSite.Built(cmd, pages, siteDir)
+		if !serve {
+			return nil
+		}
+		return renderSite.Serve(
+			siteDir, cfgSite.DefaultAddr, func(addr net.Addr) {
+				writeSite.Serving(cmd, siteDir, addr)
+			},
+		)
+	}
+
 	writeJournal.InfoSiteGenerated(cmd, len(entries), output, zensical.Bin)
 
 	return nil
@@ -0,0 +1,68 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package stats
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	coreStats "github.com/ActiveMemory/ctx/internal/cli/journal/core/stats"
+	"github.com/ActiveMemory/ctx/internal/config/embed/cmd"
+	"github.com/ActiveMemory/ctx/internal/config/embed/flag"
+	cFlag "github.com/ActiveMemory/ctx/internal/config/flag"
+	"github.com/ActiveMemory/ctx/internal/flagbind"
+)
+
+// Cmd returns the journal stats subcommand.
+//
+// Returns:
+//   - *cobra.Command: Command for summarizing session usage
+func Cmd() *cobra.Command {
+	var opts coreStats.Opts
+
+	short, long := desc.Command(cmd.DescKeyJournalStats)
+
+	c := &cobra.Command{
+		Use:     cmd.UseJournalStats,
+		Short:   short,
+		Long:    long,
+		Example: desc.Example(cmd.DescKeyJournalStats),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return Run(cmd, opts)
+		},
+	}
+
+	flagbind.BindStringFlagsP(c,
+		[]*string{&opts.Project, &opts.Tool},
+		[]string{cFlag.Project, cFlag.Tool},
+		[]string{cFlag.ShortProject, cFlag.ShortTool},
+		[]string{
+			flag.DescKeyJournalStatsProject,
+			flag.DescKeyJournalStatsTool,
+		},
+	)
+	flagbind.BindStringFlags(c,
+		[]*string{&opts.Since, &opts.Until},
+		[]string{cFlag.Since, cFlag.Until},
+		[]string{
+			flag.DescKeyJournalStatsSince,
+			flag.DescKeyJournalStatsUntil,
+		},
+	)
+	flagbind.BindBoolFlags(c,
+		[]*bool{&opts.AllProjects, &opts.JSON, &opts.Markdown},
+		[]string{cFlag.AllProjects, cFlag.JSON, cFlag.Markdown},
+		[]string{
+			flag.DescKeyJournalStatsAllProjects,
+			flag.DescKeyJournalStatsJSON,
+			flag.DescKeyJournalStatsMarkdown,
+		},
+	)
+
+	return c
+}
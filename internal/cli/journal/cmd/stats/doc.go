@@ -0,0 +1,44 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package stats implements the "ctx journal stats" command.
+//
+// # Overview
+//
+// The stats command aggregates the parsed AI sessions
+// that "ctx journal source" lists into a usage report:
+// session and turn counts, token spend, average session
+// length, and tool-call frequency and failure rate, in
+// total and broken down by week, day, model, AI tool, and
+// git branch.
+//
+// # Flags
+//
+//	-p, --project <name> Filter by project name.
+//	-t, --tool <name>    Filter by tool name.
+//	    --since <date>    Count sessions on or after this
+//	                      date.
+//	    --until <date>    Count sessions on or before this
+//	                      date.
+//	    --all-projects    Scan all project directories.
+//	    --json            Print the stats as JSON.
+//	    --markdown        Print the Markdown report that
+//	                      "ctx journal site" publishes.
+//
+// # Behavior
+//
+// [Cmd] builds the cobra.Command and registers the flags.
+// [Run] finds and filters sessions the way the source
+// list does, computes the stats with the core stats
+// package, and renders them in the requested format.
+//
+// # Output
+//
+// The default output is a plain-text summary with one
+// aligned block per breakdown. --json emits the full
+// entity.SessionStats document; --markdown emits the
+// page with one table per breakdown.
+package stats
@@ -0,0 +1,73 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package stats
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	"github.com/ActiveMemory/ctx/internal/cli/journal/core/query"
+	coreStats "github.com/ActiveMemory/ctx/internal/cli/journal/core/stats"
+	"github.com/ActiveMemory/ctx/internal/config/embed/text"
+	errSession "github.com/ActiveMemory/ctx/internal/err/session"
+	writeJournal "github.com/ActiveMemory/ctx/internal/write/journal"
+)
+
+// Run finds sessions, applies the filters, and prints their stats.
+// --json wins over --markdown when both are given.
+//
+// Parameters:
+//   - cmd: Cobra command for output stream
+//   - opts: the command's flags
+//
+// Returns:
+//   - error: non-nil if date parsing, scanning, or JSON encoding
+//     fails
+func Run(cmd *cobra.Command, opts coreStats.Opts) error {
+	since, until, windowErr := query.Window(opts.Since, opts.Until)
+	if windowErr != nil {
+		return windowErr
+	}
+
+	sessions, scanErr := query.FindSessions(opts.AllProjects)
+	if scanErr != nil {
+		return errSession.Find(scanErr)
+	}
+	if len(sessions) == 0 && !opts.JSON {
+		writeJournal.NoSessionsWithHint(cmd, opts.AllProjects)
+		return nil
+	}
+
+	filtered := query.Filter(
+		sessions, opts.Project, opts.Tool, since, until,
+	)
+	st := coreStats.Compute(filtered)
+
+	switch {
+	case opts.JSON:
+		return writeJournal.StatsJSON(cmd, st)
+	case len(filtered) == 0:
+		writeJournal.NoFiltersMatch(cmd)
+		return nil
+	case opts.Markdown:
+		writeJournal.StatsMarkdown(cmd, coreStats.Markdown(st))
+		return nil
+	}
+
+	writeJournal.StatsTotals(cmd, st.Totals)
+	for _, sec := range coreStats.Sections(st) {
+		keys := make([]string, len(sec.Buckets))
+		for i, b := range sec.Buckets {
+			keys[i] = coreStats.Key(b.Key)
+		}
+		writeJournal.StatsBreakdown(cmd, sec.Title, keys, sec.Buckets)
+	}
+	writeJournal.StatsToolCalls(cmd,
+		desc.Text(text.DescKeyLabelStatsToolCalls), st.ToolCalls,
+	)
+	return nil
+}
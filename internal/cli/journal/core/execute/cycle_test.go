@@ -69,7 +69,7 @@ func TestImportCycle_SelfHealAndForeignEdit(t *testing.T) {
 	if p.Actions[0].Action != entity.ActionNew {
 		t.Fatalf("first run action = %v, want ActionNew", p.Actions[0].Action)
 	}
-	execute.Import(cmd, p, st, opts)
+	execute.Import(cmd, p, st, nil, opts)
 	entryPath := p.Actions[0].Path
 	filename := p.Actions[0].Filename
 	if !fileExists(entryPath) {
@@ -90,7 +90,7 @@ func TestImportCycle_SelfHealAndForeignEdit(t *testing.T) {
 	if p.Actions[0].Action != entity.ActionSkip {
 		t.Fatalf("unchanged action = %v, want ActionSkip", p.Actions[0].Action)
 	}
-	execute.Import(cmd, p, st, opts)
+	execute.Import(cmd, p, st, nil, opts)
 	if readAll(t, entryPath) != before {
 		t.Error("Unchanged sweep must leave the entry byte-identical")
 	}
@@ -112,7 +112,7 @@ func TestImportCycle_SelfHealAndForeignEdit(t *testing.T) {
 		t.Errorf("grown sweep: GrownCount=%d RegenCount=%d, want 1 and 0",
 			p.GrownCount, p.RegenCount)
 	}
-	execute.Import(cmd, p, st, opts)
+	execute.Import(cmd, p, st, nil, opts)
 	grown := readAll(t, entryPath)
 	if grown == before {
 		t.Error("Grown re-render should update the entry body")
@@ -136,7 +136,7 @@ func TestImportCycle_SelfHealAndForeignEdit(t *testing.T) {
 		t.Fatalf("foreign-edit action = %v, want ActionForeignEdit",
 			p.Actions[0].Action)
 	}
-	execute.Import(cmd, p, st, opts)
+	execute.Import(cmd, p, st, nil, opts)
 	if readAll(t, entryPath) != edited {
 		t.Error("a hand-edited entry must survive a Grown sweep byte-identical")
 	}
@@ -169,7 +169,7 @@ func TestImportCycle_FailedWriteDoesNotAdvanceSource(t *testing.T) {
 		t.Fatalf("state load: %v", err)
 	}
 	p := plan.Import([]*entity.Session{s}, jdir, idx, st, opts, false)
-	execute.Import(cmd, p, st, opts)
+	execute.Import(cmd, p, st, nil, opts)
 	entryPath := p.Actions[0].Path
 	recBefore, ok := st.SessionSource("cycle-fail")
 	if !ok {
@@ -202,7 +202,7 @@ func TestImportCycle_FailedWriteDoesNotAdvanceSource(t *testing.T) {
 		t.Fatalf("mkdir entry: %v", mkErr)
 	}
 
-	execute.Import(cmd, p, st, opts)
+	execute.Import(cmd, p, st, nil, opts)
 
 	recAfter, ok := st.SessionSource("cycle-fail")
 	if !ok {
@@ -21,6 +21,7 @@ import (
 	"github.com/ActiveMemory/ctx/internal/config/token"
 	"github.com/ActiveMemory/ctx/internal/entity"
 	"github.com/ActiveMemory/ctx/internal/io"
+	"github.com/ActiveMemory/ctx/internal/journal/redact"
 	"github.com/ActiveMemory/ctx/internal/journal/state"
 	"github.com/ActiveMemory/ctx/internal/write/err"
 	writeRecall "github.com/ActiveMemory/ctx/internal/write/journal"
@@ -32,6 +33,8 @@ import (
 //   - cmd: Cobra command for output.
 //   - plan: the import plan with file actions.
 //   - jstate: journal state to update as files are imported.
+//   - red: secret redactor applied before each write; nil writes
+//     entries unredacted.
 //   - opts: import flag values.
 //
 // Returns:
@@ -42,6 +45,7 @@ func Import(
 	cmd *cobra.Command,
 	plan entity.ImportPlan,
 	jstate *state.State,
+	red *redact.Redactor,
 	opts entity.ImportOpts,
 ) (imported, updated, skipped int) {
 	// Track per-session outcomes so the recorded source stat is advanced
@@ -84,6 +88,11 @@ func Import(
 			token.Ellipsis,
 		)
 
+		// Redact secrets before anything touches disk. The render
+		// hash below is taken over the redacted body, so a later
+		// growth sweep still recognizes the file as ctx-owned.
+		content, secrets := red.Apply(content)
+
 		fileExists := fa.Action == entity.ActionRegenerate
 
 		// Preserve enriched YAML frontmatter from the existing file.
@@ -118,6 +127,7 @@ func Import(
 		}
 
 		jstate.MarkImported(fa.Filename)
+		jstate.SetRedaction(fa.Filename, red.Version())
 
 		// Record the hash of the body ctx just wrote, so a later growth
 		// sweep can prove the file is still ctx-owned before re-rendering.
@@ -132,6 +142,7 @@ func Import(
 		} else {
 			writeRecall.ImportedFile(cmd, fa.Filename, "")
 		}
+		writeRecall.ImportRedacted(cmd, secrets)
 	}
 
 	// Commit the observed source stats for sessions that caught up. A
@@ -8,6 +8,7 @@ package generate
 
 import (
 	"fmt"
+	"path"
 	"path/filepath"
 	"strings"
 	"unicode/utf8"
@@ -165,45 +166,54 @@ func InjectedSourceLink(content, sourcePath string) string {
 	return link + content
 }
 
-// ZensicalToml creates the zensical.toml configuration for the
-// journal site.
+// Nav builds the journal site navigation: the home page,
+// the topic, key-file, and session-type indexes that exist,
+// the stats page, and a "Recent Sessions" section.
+//
+// Suggestion sessions and multi-part continuations are left
+// out of the recent list; titles are cut to
+// journal.MaxNavTitleLen runes.
 //
 // Parameters:
-//   - entries: All journal entries for navigation
+//   - entries: All journal entries, newest first
 //   - topics: Topic index data for nav links
 //   - keyFiles: Key file index data for nav links
 //   - sessionTypes: Session type index data for nav links
+//   - hasStats: Whether the site has a session stats page
 //
 // Returns:
-//   - string: Complete zensical.toml content
-func ZensicalToml(
+//   - []entity.NavItem: Navigation with paths relative to
+//     the docs directory
+func Nav(
 	entries []entity.JournalEntry, topics []entity.TopicData,
 	keyFiles []entity.KeyFileData, sessionTypes []entity.TypeData,
-) string {
-	var sb strings.Builder
-	nl := token.NewlineLF
-
-	sb.WriteString(tpl.ZensicalProject + nl)
-
-	// Build navigation
-	sb.WriteString(zensical.TomlNavOpen + nl)
-	io.SafeFprintf(&sb, tpl.JournalNavItem+nl,
-		desc.Text(text.DescKeyLabelHome), file.Index)
+	hasStats bool,
+) []entity.NavItem {
+	nav := []entity.NavItem{{
+		Title: desc.Text(text.DescKeyLabelHome), Path: file.Index,
+	}}
 	if len(topics) > 0 {
-		io.SafeFprintf(&sb, tpl.JournalNavItem+nl,
-			desc.Text(text.DescKeyLabelTopics),
-			filepath.Join(dir.JournTopics, file.Index),
-		)
+		nav = append(nav, entity.NavItem{
+			Title: desc.Text(text.DescKeyLabelTopics),
+			Path:  path.Join(dir.JournTopics, file.Index),
+		})
 	}
 	if len(keyFiles) > 0 {
-		io.SafeFprintf(&sb, tpl.JournalNavItem+nl,
-			desc.Text(text.DescKeyLabelFiles),
-			filepath.Join(dir.JournalFiles, file.Index))
+		nav = append(nav, entity.NavItem{
+			Title: desc.Text(text.DescKeyLabelFiles),
+			Path:  path.Join(dir.JournalFiles, file.Index),
+		})
 	}
 	if len(sessionTypes) > 0 {
-		io.SafeFprintf(&sb, tpl.JournalNavItem+nl,
-			desc.Text(text.DescKeyLabelTypes),
-			filepath.Join(dir.JournalTypes, file.Index))
+		nav = append(nav, entity.NavItem{
+			Title: desc.Text(text.DescKeyLabelTypes),
+			Path:  path.Join(dir.JournalTypes, file.Index),
+		})
+	}
+	if hasStats {
+		nav = append(nav, entity.NavItem{
+			Title: desc.Text(text.DescKeyLabelStats), Path: file.Stats,
+		})
 	}
 
 	// Filter out suggestion sessions and multi-part continuations from navigation
@@ -224,21 +234,68 @@ func ZensicalToml(
 		recent = recent[:journal.MaxRecentSessions]
 	}
 
-	io.SafeFprintf(&sb,
-		tpl.JournalNavSection+nl, desc.Text(text.DescKeyHeadingRecentSessions))
+	sessions := entity.NavItem{
+		Title:    desc.Text(text.DescKeyHeadingRecentSessions),
+		Children: make([]entity.NavItem, 0, len(recent)),
+	}
 	for _, e := range recent {
 		title := e.Title
 		if utf8.RuneCountInString(title) > journal.MaxNavTitleLen {
 			runes := []rune(title)
 			title = string(runes[:journal.MaxNavTitleLen]) + token.Ellipsis
 		}
-		title = strings.ReplaceAll(
-			title, token.DoubleQuote, token.EscapedDoubleQuote,
+		sessions.Children = append(sessions.Children, entity.NavItem{
+			Title: title, Path: e.Filename,
+		})
+	}
+	return append(nav, sessions)
+}
+
+// ZensicalToml creates the zensical.toml configuration for the
+// journal site. Its nav is [Nav], so the zensical build and the
+// built-in renderer list the same pages.
+//
+// Parameters:
+//   - entries: All journal entries for navigation
+//   - topics: Topic index data for nav links
+//   - keyFiles: Key file index data for nav links
+//   - sessionTypes: Session type index data for nav links
+//   - hasStats: Whether the site has a session stats page
+//
+// Returns:
+//   - string: Complete zensical.toml content
+func ZensicalToml(
+	entries []entity.JournalEntry, topics []entity.TopicData,
+	keyFiles []entity.KeyFileData, sessionTypes []entity.TypeData,
+	hasStats bool,
+) string {
+	var sb strings.Builder
+	nl := token.NewlineLF
+	quote := func(s string) string {
+		return strings.ReplaceAll(
+			s, token.DoubleQuote, token.EscapedDoubleQuote,
 		)
-		io.SafeFprintf(&sb,
-			tpl.JournalNavSessionItem+nl, title, e.Filename)
 	}
-	sb.WriteString(zensical.TomlNavSectionClose + nl)
+
+	sb.WriteString(tpl.ZensicalProject + nl)
+
+	// Build navigation
+	sb.WriteString(zensical.TomlNavOpen + nl)
+	for _, item := range Nav(
+		entries, topics, keyFiles, sessionTypes, hasStats,
+	) {
+		if item.Children == nil {
+			io.SafeFprintf(&sb, tpl.JournalNavItem+nl,
+				quote(item.Title), item.Path)
+			continue
+		}
+		io.SafeFprintf(&sb, tpl.JournalNavSection+nl, quote(item.Title))
+		for _, child := range item.Children {
+			io.SafeFprintf(&sb, tpl.JournalNavSessionItem+nl,
+				quote(child.Title), child.Path)
+		}
+		sb.WriteString(zensical.TomlNavSectionClose + nl)
+	}
 	sb.WriteString(zensical.TomlNavClose + nl + nl)
 
 	sb.WriteString(tpl.ZensicalExtraCSS + nl)
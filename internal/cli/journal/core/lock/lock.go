@@ -28,6 +28,7 @@ import (
 	"github.com/ActiveMemory/ctx/internal/journal/state"
 	ctxLog "github.com/ActiveMemory/ctx/internal/log/warn"
 	"github.com/ActiveMemory/ctx/internal/rc"
+	"github.com/ActiveMemory/ctx/internal/txn"
 	writeRecall "github.com/ActiveMemory/ctx/internal/write/journal"
 )
 
@@ -266,60 +267,64 @@ func Run(
 	}
 	journalDir := filepath.Join(ctxDir, dir.Journal)
 
-	jState, loadErr := state.Load(journalDir)
-	if loadErr != nil {
-		return journal.LoadState(loadErr)
-	}
-
-	// Collect matching .md files.
-	files, matchErr := MatchJournalFiles(journalDir, args, all)
-	if matchErr != nil {
-		return matchErr
-	}
-	if len(files) == 0 {
-		if all {
-			writeRecall.LockUnlockNone(cmd)
-		} else {
-			return journal.NoEntriesMatch(strings.Join(args, token.CommaSpace))
+	// Load, update, and save under one lock so a concurrent
+	// writer's state change is not dropped.
+	return txn.Do(ctxDir, func() error {
+		jState, loadErr := state.Load(journalDir)
+		if loadErr != nil {
+			return journal.LoadState(loadErr)
 		}
-		return nil
-	}
 
-	verb := session.FrontmatterLocked
-	if !lock {
-		verb = session.Unlocked
-	}
-
-	count := 0
-	for _, filename := range files {
-		alreadyLocked := jState.Locked(filename)
-		if lock && alreadyLocked {
-			continue
+		// Collect matching .md files.
+		files, matchErr := MatchJournalFiles(journalDir, args, all)
+		if matchErr != nil {
+			return matchErr
 		}
-		if !lock && !alreadyLocked {
-			continue
+		if len(files) == 0 {
+			if all {
+				writeRecall.LockUnlockNone(cmd)
+			} else {
+				return journal.NoEntriesMatch(strings.Join(args, token.CommaSpace))
+			}
+			return nil
 		}
 
-		// Update state.
-		if lock {
-			jState.Mark(filename, session.FrontmatterLocked)
-		} else {
-			jState.Clear(filename, session.FrontmatterLocked)
+		verb := session.FrontmatterLocked
+		if !lock {
+			verb = session.Unlocked
 		}
 
-		// Update frontmatter for human visibility.
-		path := filepath.Join(journalDir, filename)
-		UpdateFrontmatter(path, lock)
+		count := 0
+		for _, filename := range files {
+			alreadyLocked := jState.Locked(filename)
+			if lock && alreadyLocked {
+				continue
+			}
+			if !lock && !alreadyLocked {
+				continue
+			}
+
+			// Update state.
+			if lock {
+				jState.Mark(filename, session.FrontmatterLocked)
+			} else {
+				jState.Clear(filename, session.FrontmatterLocked)
+			}
 
-		writeRecall.LockUnlockEntry(cmd, filename, verb)
-		count++
-	}
+			// Update frontmatter for human visibility.
+			path := filepath.Join(journalDir, filename)
+			UpdateFrontmatter(path, lock)
 
-	if saveErr := jState.Save(journalDir); saveErr != nil {
-		return journal.SaveState(saveErr)
-	}
+			writeRecall.LockUnlockEntry(cmd, filename, verb)
+			count++
+		}
 
-	writeRecall.LockUnlockSummary(cmd, verb, count)
+		if saveErr := jState.Save(journalDir); saveErr != nil {
+			return journal.SaveState(saveErr)
+		}
 
-	return nil
+		writeRecall.LockUnlockSummary(cmd, verb, count)
+
+		return nil
+	})
 }
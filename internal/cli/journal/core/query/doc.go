@@ -14,9 +14,9 @@
 //
 // # Session Discovery
 //
-// [FindSessions] is the sole exported function. When
-// allProjects is false, it resolves the current working
-// directory and delegates to parser.FindSessionsForCWD,
+// When allProjects is false, [FindSessions] resolves
+// the current working directory and delegates to
+// parser.FindSessionsForCWD,
 // returning only sessions whose project path matches.
 // When allProjects is true, it calls
 // parser.FindSessions to scan all known session
@@ -27,6 +27,14 @@
 // to the plan package for import planning or to the
 // write layer for listing.
 //
+// # Filtering
+//
+// [Window] parses the --since and --until flag values
+// into a time window (--until is inclusive of its day),
+// and [Filter] applies the --project, --tool, and window
+// flags. The source list and stats commands share them
+// so both count the same sessions.
+//
 // # Error Handling
 //
 // If the working directory cannot be determined (e.g.
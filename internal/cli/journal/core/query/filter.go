@@ -0,0 +1,91 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package query
+
+import (
+	"strings"
+	goTime "time"
+
+	"github.com/ActiveMemory/ctx/internal/config/flag"
+	"github.com/ActiveMemory/ctx/internal/config/time"
+	"github.com/ActiveMemory/ctx/internal/entity"
+	"github.com/ActiveMemory/ctx/internal/err/date"
+	"github.com/ActiveMemory/ctx/internal/i18n"
+	"github.com/ActiveMemory/ctx/internal/parse"
+)
+
+// Window parses the --since and --until flag values into a time
+// window. An empty value leaves that side open (zero time). --until
+// is inclusive: it is advanced to the end of its day.
+//
+// Parameters:
+//   - since: --since value (YYYY-MM-DD) or ""
+//   - until: --until value (YYYY-MM-DD) or ""
+//
+// Returns:
+//   - goTime.Time: window start, zero when open
+//   - goTime.Time: window end, zero when open
+//   - error: non-nil if either value is not a valid date
+func Window(since, until string) (goTime.Time, goTime.Time, error) {
+	var sinceTime, untilTime goTime.Time
+	if since != "" {
+		parsed, sinceErr := parse.Date(since)
+		if sinceErr != nil {
+			return sinceTime, untilTime, date.Invalid(
+				flag.PrefixLong+flag.Since, since, sinceErr,
+			)
+		}
+		sinceTime = parsed
+	}
+	if until != "" {
+		parsed, untilErr := parse.Date(until)
+		if untilErr != nil {
+			return sinceTime, untilTime, date.Invalid(
+				flag.PrefixLong+flag.Until, until, untilErr,
+			)
+		}
+		untilTime = parsed.Add(time.InclusiveUntilOffset)
+	}
+	return sinceTime, untilTime, nil
+}
+
+// Filter keeps the sessions matching the --project, --tool, and
+// date window flags. Empty strings and zero times match everything.
+//
+// Parameters:
+//   - sessions: sessions to filter
+//   - project: case-insensitive substring of the project name
+//   - tool: exact tool identifier
+//   - since: earliest start time
+//   - until: latest start time
+//
+// Returns:
+//   - []*entity.Session: matching sessions in their original order
+func Filter(
+	sessions []*entity.Session,
+	project, tool string, since, until goTime.Time,
+) []*entity.Session {
+	var filtered []*entity.Session
+	for _, s := range sessions {
+		if project != "" && !strings.Contains(
+			i18n.Fold(s.Project), i18n.Fold(project),
+		) {
+			continue
+		}
+		if tool != "" && s.Tool != tool {
+			continue
+		}
+		if !since.IsZero() && s.StartTime.Before(since) {
+			continue
+		}
+		if !until.IsZero() && s.StartTime.After(until) {
+			continue
+		}
+		filtered = append(filtered, s)
+	}
+	return filtered
+}
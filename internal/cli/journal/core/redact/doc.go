@@ -0,0 +1,34 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package redact runs the secret redactor over journal
+// entries that are already on disk, backing
+// `ctx journal redact`.
+//
+// Import redacts every entry it writes (see
+// [internal/journal/redact]). This package covers the
+// rest: entries imported before redaction existed, entries
+// written with redaction disabled, and entries that went
+// through an older ruleset after a new rule was added.
+//
+// # The Surface
+//
+//   - **[Run](cmd, args, check)**: scans the matching
+//     entries. With check set it only reports where the
+//     secrets are and fails when any are found, for use in
+//     CI and pre-commit hooks. Otherwise it rewrites the
+//     entries in place and records the ruleset version in
+//     the journal state.
+//
+// # Ownership
+//
+// Rewriting an entry refreshes its render hash only when
+// the hash proved the entry ctx-owned beforehand. A
+// hand-edited entry stays marked as edited, so a later
+// growth sweep still refuses to clobber it.
+//
+// Locked entries are reported and left untouched.
+package redact
@@ -0,0 +1,147 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package redact
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/cli/journal/core/extract"
+	coreLock "github.com/ActiveMemory/ctx/internal/cli/journal/core/lock"
+	"github.com/ActiveMemory/ctx/internal/config/dir"
+	"github.com/ActiveMemory/ctx/internal/config/fs"
+	"github.com/ActiveMemory/ctx/internal/config/session"
+	"github.com/ActiveMemory/ctx/internal/config/token"
+	"github.com/ActiveMemory/ctx/internal/err/journal"
+	"github.com/ActiveMemory/ctx/internal/io"
+	journalRedact "github.com/ActiveMemory/ctx/internal/journal/redact"
+	"github.com/ActiveMemory/ctx/internal/journal/state"
+	"github.com/ActiveMemory/ctx/internal/rc"
+	"github.com/ActiveMemory/ctx/internal/txn"
+	"github.com/ActiveMemory/ctx/internal/write/err"
+	writeRecall "github.com/ActiveMemory/ctx/internal/write/journal"
+)
+
+// Run scans journal entries for secrets and either reports
+// or redacts them. The project rules from .ctxrc apply even
+// when import-time redaction is disabled, so the check can
+// still guard a project that opted out.
+//
+// Parameters:
+//   - cmd: Cobra command for output
+//   - args: patterns matched against journal filenames; none
+//     selects every entry
+//   - check: report secrets instead of rewriting entries
+//
+// Returns:
+//   - error: non-nil on configuration or I/O failure, or when
+//     check finds a secret
+func Run(cmd *cobra.Command, args []string, check bool) error {
+	red, newErr := journalRedact.New(
+		rc.RedactRules(), rc.RedactAllow(), rc.RedactEntropy(),
+	)
+	if newErr != nil {
+		return newErr
+	}
+
+	ctxDir, ctxErr := rc.RequireContextDir()
+	if ctxErr != nil {
+		cmd.SilenceUsage = true
+		return ctxErr
+	}
+	journalDir := filepath.Join(ctxDir, dir.Journal)
+
+	// Load, redact, and save under one lock so a concurrent
+	// writer's state change is not dropped.
+	return txn.Do(ctxDir, func() error {
+		jState, loadErr := state.Load(journalDir)
+		if loadErr != nil {
+			return journal.LoadState(loadErr)
+		}
+
+		files, matchErr := coreLock.MatchJournalFiles(
+			journalDir, args, len(args) == 0,
+		)
+		if matchErr != nil {
+			return matchErr
+		}
+		if len(files) == 0 && len(args) > 0 {
+			return journal.NoEntriesMatch(strings.Join(args, token.CommaSpace))
+		}
+
+		secrets, entries := 0, 0
+		for _, filename := range files {
+			path := filepath.Join(journalDir, filename)
+			data, readErr := io.SafeReadUserFile(path)
+			if readErr != nil {
+				err.WarnFile(cmd, filename, readErr)
+				continue
+			}
+			content := string(data)
+
+			if check {
+				findings := red.Scan(content)
+				for _, f := range findings {
+					writeRecall.RedactFinding(cmd, filename, f.Line, f.Rule)
+				}
+				if len(findings) > 0 {
+					secrets += len(findings)
+					entries++
+				}
+				continue
+			}
+
+			if jState.Locked(filename) {
+				if len(red.Scan(content)) > 0 {
+					writeRecall.SkipFile(
+						cmd, filename, session.FrontmatterLocked,
+					)
+				}
+				continue
+			}
+
+			redacted, n := red.Apply(content)
+			if n > 0 {
+				owned := jState.RenderHash(filename) ==
+					state.HashRender(extract.StripFrontmatter(content))
+				if writeErr := io.SafeWriteFileAtomic(
+					path, []byte(redacted), fs.PermFile,
+				); writeErr != nil {
+					err.WarnFile(cmd, filename, writeErr)
+					continue
+				}
+				if owned {
+					jState.SetRenderHash(
+						filename,
+						state.HashRender(extract.StripFrontmatter(redacted)),
+					)
+				}
+				writeRecall.RedactedEntry(cmd, filename, n)
+				secrets += n
+				entries++
+			}
+			jState.SetRedaction(filename, red.Version())
+		}
+
+		if check {
+			if secrets > 0 {
+				cmd.SilenceUsage = true
+				return journal.SecretsFound(secrets, entries)
+			}
+			writeRecall.RedactClean(cmd, len(files))
+			return nil
+		}
+
+		if saveErr := jState.Save(journalDir); saveErr != nil {
+			return journal.SaveState(saveErr)
+		}
+		writeRecall.RedactSummary(cmd, secrets, entries, red.Version())
+		return nil
+	})
+}
@@ -219,6 +219,7 @@ func JournalEntryPart(
 		if s.Model != "" {
 			frontmatter.WriteFmString(&sb, session.FmKeyModel, s.Model)
 		}
+		frontmatter.WriteFmList(&sb, session.FmKeyGitRefs, s.GitRefs)
 		if s.TotalTokensIn > 0 {
 			frontmatter.WriteFmInt(&sb, session.FmKeyTokensIn, s.TotalTokensIn)
 		}
@@ -270,6 +271,12 @@ func JournalEntryPart(
 				Label: desc.Text(text.DescKeyLabelMetaModel), Value: s.Model,
 			})
 		}
+		if len(s.GitRefs) > 0 {
+			metaRows = append(metaRows, tpl.MetaRow{
+				Label: desc.Text(text.DescKeyLabelMetaCommits),
+				Value: strings.Join(s.GitRefs, token.CommaSpace),
+			})
+		}
 		metaOut := tpl.RenderOr(tpl.MetaTable, tpl.MetaTableData{
 			Summary: summaryText, Rows: metaRows,
 		}, "")
@@ -56,6 +56,11 @@ func TestFormatToolUse(t *testing.T) {
 			tool: entity.ToolUse{Name: "Read", Input: `{"file_path":"/tmp/test.go"}`},
 			want: "Read: /tmp/test.go",
 		},
+		{
+			name: "Aider add",
+			tool: entity.ToolUse{Name: "Add", Input: `{"file_path":"greet.go"}`},
+			want: "Add: greet.go",
+		},
 		{
 			name: "Bash tool short",
 			tool: entity.ToolUse{Name: "Bash", Input: `{"command":"ls -la"}`},
@@ -480,6 +485,33 @@ func TestFormatJournalEntryPart_SessionIDInFrontmatter(t *testing.T) {
 	}
 }
 
+func TestFormatJournalEntryPart_GitRefs(t *testing.T) {
+	t.Setenv("TZ", "UTC")
+
+	s := &entity.Session{
+		ID:        "abc12345-full-session-uuid",
+		Tool:      "aider",
+		Project:   "myproject",
+		GitRefs:   []string{"1a2b3c4", "9f8e7d6"},
+		StartTime: time.Date(2026, 1, 15, 10, 30, 0, 0, time.UTC),
+		TurnCount: 1,
+		Messages: []entity.Message{
+			{Role: "user", Text: "Hello"},
+		},
+	}
+
+	got := JournalEntryPart(
+		s, s.Messages, 0, 1, 1, "base", "",
+	)
+
+	if !strings.Contains(got, "git_refs:\n  - 1a2b3c4\n  - 9f8e7d6\n") {
+		t.Errorf("missing git_refs list in frontmatter:\n%s", got)
+	}
+	if !strings.Contains(got, "1a2b3c4, 9f8e7d6") {
+		t.Error("missing commits metadata row")
+	}
+}
+
 func TestFormatJournalEntryPart_TitleInFrontmatterAndHeading(t *testing.T) {
 	t.Setenv("TZ", "UTC")
 
@@ -6,7 +6,10 @@
 
 package format
 
-import "github.com/ActiveMemory/ctx/internal/config/session"
+import (
+	cfgAider "github.com/ActiveMemory/ctx/internal/config/aider"
+	"github.com/ActiveMemory/ctx/internal/config/session"
+)
 
 // toolDisplayKey maps tool names to the JSON input key that best
 // describes each invocation.
@@ -20,4 +23,5 @@ var toolDisplayKey = map[string]string{
 	session.ToolWebFetch:  session.ToolInputURL,
 	session.ToolWebSearch: session.ToolInputQuery,
 	session.ToolTask:      session.ToolInputDescription,
+	cfgAider.ToolAdd:      session.ToolInputFilePath,
 }
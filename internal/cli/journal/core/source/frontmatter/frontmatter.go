@@ -59,6 +59,29 @@ func WriteFmString(sb *strings.Builder, key, value string) {
 	}
 }
 
+// WriteFmList writes a YAML frontmatter list field. Nothing is
+// written for an empty list.
+//
+// Parameters:
+//   - sb: String builder to write to
+//   - key: Frontmatter key
+//   - values: Bare string items
+func WriteFmList(sb *strings.Builder, key string, values []string) {
+	if len(values) == 0 {
+		return
+	}
+	_, writeErr := fmt.Fprintf(sb, tpl.FmList+token.NewlineLF, key)
+	if writeErr != nil {
+		return
+	}
+	for _, v := range values {
+		_, writeErr = fmt.Fprintf(sb, tpl.FmListItem+token.NewlineLF, v)
+		if writeErr != nil {
+			return
+		}
+	}
+}
+
 // WriteFmInt writes a YAML frontmatter integer field.
 //
 // Parameters:
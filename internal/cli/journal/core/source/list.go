@@ -9,8 +9,6 @@ package source
 import (
 	"fmt"
 	"strconv"
-	"strings"
-	goTime "time"
 
 	"github.com/spf13/cobra"
 
@@ -19,15 +17,10 @@ import (
 	"github.com/ActiveMemory/ctx/internal/cli/journal/core/query"
 	srcFmt "github.com/ActiveMemory/ctx/internal/cli/journal/core/source/format"
 	"github.com/ActiveMemory/ctx/internal/config/embed/text"
-	"github.com/ActiveMemory/ctx/internal/config/flag"
 	"github.com/ActiveMemory/ctx/internal/config/journal"
 	"github.com/ActiveMemory/ctx/internal/config/time"
-	"github.com/ActiveMemory/ctx/internal/entity"
-	"github.com/ActiveMemory/ctx/internal/err/date"
 	errSession "github.com/ActiveMemory/ctx/internal/err/session"
 	sharedFmt "github.com/ActiveMemory/ctx/internal/format"
-	"github.com/ActiveMemory/ctx/internal/i18n"
-	"github.com/ActiveMemory/ctx/internal/parse"
 	writeRecall "github.com/ActiveMemory/ctx/internal/write/journal"
 )
 
@@ -43,28 +36,11 @@ import (
 // Returns:
 //   - error: non-nil if date parsing or scanning fails
 func RunList(cmd *cobra.Command, opts Opts) error {
-	// Parse date filters (only when flags are set).
-	var sinceTime, untilTime goTime.Time
-	if opts.Since != "" {
-		var sinceErr error
-		sinceTime, sinceErr = parse.Date(opts.Since)
-		if sinceErr != nil {
-			return date.Invalid(
-				flag.PrefixLong+flag.Since,
-				opts.Since, sinceErr,
-			)
-		}
-	}
-	if opts.Until != "" {
-		parsed, untilErr := parse.Date(opts.Until)
-		if untilErr != nil {
-			return date.Invalid(
-				flag.PrefixLong+flag.Until,
-				opts.Until, untilErr,
-			)
-		}
-		// --until is inclusive: advance to end of day
-		untilTime = parsed.Add(time.InclusiveUntilOffset)
+	sinceTime, untilTime, windowErr := query.Window(
+		opts.Since, opts.Until,
+	)
+	if windowErr != nil {
+		return windowErr
 	}
 
 	sessions, scanErr := query.FindSessions(
@@ -81,28 +57,9 @@ func RunList(cmd *cobra.Command, opts Opts) error {
 		return nil
 	}
 
-	// Apply filters
-	var filtered []*entity.Session
-	for _, s := range sessions {
-		if opts.Project != "" && !strings.Contains(
-			i18n.Fold(s.Project),
-			i18n.Fold(opts.Project),
-		) {
-			continue
-		}
-		if opts.Tool != "" && s.Tool != opts.Tool {
-			continue
-		}
-		if opts.Since != "" &&
-			s.StartTime.Before(sinceTime) {
-			continue
-		}
-		if opts.Until != "" &&
-			s.StartTime.After(untilTime) {
-			continue
-		}
-		filtered = append(filtered, s)
-	}
+	filtered := query.Filter(
+		sessions, opts.Project, opts.Tool, sinceTime, untilTime,
+	)
 
 	if len(filtered) == 0 {
 		writeRecall.NoFiltersMatch(cmd)
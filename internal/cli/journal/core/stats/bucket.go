@@ -0,0 +1,227 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package stats
+
+import (
+	"cmp"
+	"slices"
+	"time"
+
+	"github.com/ActiveMemory/ctx/internal/entity"
+)
+
+// bucket returns the bucket for key, creating it on first use.
+//
+// Parameters:
+//   - m: buckets by key
+//   - key: bucket key
+//
+// Returns:
+//   - *entity.StatsBucket: the bucket
+func bucket(
+	m map[string]*entity.StatsBucket, key string,
+) *entity.StatsBucket {
+	b, ok := m[key]
+	if !ok {
+		b = &entity.StatsBucket{Key: key}
+		m[key] = b
+	}
+	return b
+}
+
+// add folds one session into a bucket.
+//
+// Parameters:
+//   - b: destination bucket
+//   - s: the session
+//   - calls: the session's tool calls
+//   - failed: the session's failed tool results
+func add(b *entity.StatsBucket, s *entity.Session, calls, failed int) {
+	b.Sessions++
+	b.Turns += s.TurnCount
+	b.TokensIn += s.TotalTokensIn
+	b.TokensOut += s.TotalTokensOut
+	b.Tokens += s.TotalTokensIn + s.TotalTokensOut
+	b.Duration += s.Duration
+	b.ToolCalls += calls
+	b.ToolErrors += failed
+	if s.HasErrors || failed > 0 {
+		b.ErrorSessions++
+	}
+}
+
+// finish fills in a bucket's averages and failure rate.
+//
+// Parameters:
+//   - b: a bucket with its sums collected
+//
+// Returns:
+//   - entity.StatsBucket: the completed bucket
+func finish(b entity.StatsBucket) entity.StatsBucket {
+	if b.Sessions > 0 {
+		b.AvgDuration = b.Duration / time.Duration(b.Sessions)
+		b.AvgTurns = float64(b.Turns) / float64(b.Sessions)
+	}
+	b.FailureRate = rate(b.ToolErrors, b.ToolCalls)
+	return b
+}
+
+// chronological completes the buckets and orders them by key, which
+// for day and week keys is oldest first.
+//
+// Parameters:
+//   - m: buckets by key
+//
+// Returns:
+//   - []entity.StatsBucket: completed buckets in key order
+func chronological(
+	m map[string]*entity.StatsBucket,
+) []entity.StatsBucket {
+	out := collect(m)
+	slices.SortFunc(out, func(a, b entity.StatsBucket) int {
+		return cmp.Compare(a.Key, b.Key)
+	})
+	return out
+}
+
+// heaviest completes the buckets and orders them by token spend,
+// then by session count, then by key.
+//
+// Parameters:
+//   - m: buckets by key
+//
+// Returns:
+//   - []entity.StatsBucket: completed buckets, most tokens first
+func heaviest(m map[string]*entity.StatsBucket) []entity.StatsBucket {
+	out := collect(m)
+	slices.SortFunc(out, func(a, b entity.StatsBucket) int {
+		if c := cmp.Compare(b.Tokens, a.Tokens); c != 0 {
+			return c
+		}
+		if c := cmp.Compare(b.Sessions, a.Sessions); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Key, b.Key)
+	})
+	return out
+}
+
+// collect completes every bucket in a map.
+//
+// Parameters:
+//   - m: buckets by key
+//
+// Returns:
+//   - []entity.StatsBucket: completed buckets in map order (empty,
+//     not nil, for an empty map)
+func collect(m map[string]*entity.StatsBucket) []entity.StatsBucket {
+	out := make([]entity.StatsBucket, 0, len(m))
+	for _, b := range m {
+		out = append(out, finish(*b))
+	}
+	return out
+}
+
+// countToolCalls counts a session's tool calls and failed results,
+// adding them to the per-tool counters. A failed result is
+// attributed to the tool whose call ID it answers; results without
+// a known call still count toward the session's failures.
+//
+// Parameters:
+//   - s: the session
+//   - calls: per-tool counters, updated in place
+//
+// Returns:
+//   - int: tool calls in the session
+//   - int: failed tool results in the session
+func countToolCalls(
+	s *entity.Session, calls map[string]*entity.ToolCallStats,
+) (int, int) {
+	total, failed := 0, 0
+	names := make(map[string]string)
+	for _, m := range s.Messages {
+		for _, u := range m.ToolUses {
+			total++
+			tool(calls, u.Name).Calls++
+			if u.ID != "" {
+				names[u.ID] = u.Name
+			}
+		}
+	}
+	for _, m := range s.Messages {
+		for _, r := range m.ToolResults {
+			if !r.IsError {
+				continue
+			}
+			failed++
+			if name, ok := names[r.ToolUseID]; ok {
+				tool(calls, name).Errors++
+			}
+		}
+	}
+	return total, failed
+}
+
+// tool returns the counters for a tool name, creating them on first
+// use.
+//
+// Parameters:
+//   - calls: per-tool counters
+//   - name: tool name
+//
+// Returns:
+//   - *entity.ToolCallStats: the counters
+func tool(
+	calls map[string]*entity.ToolCallStats, name string,
+) *entity.ToolCallStats {
+	t, ok := calls[name]
+	if !ok {
+		t = &entity.ToolCallStats{Name: name}
+		calls[name] = t
+	}
+	return t
+}
+
+// rankCalls completes the per-tool counters and orders them by call
+// count, then by name.
+//
+// Parameters:
+//   - calls: per-tool counters
+//
+// Returns:
+//   - []entity.ToolCallStats: counters, most calls first
+func rankCalls(
+	calls map[string]*entity.ToolCallStats,
+) []entity.ToolCallStats {
+	out := make([]entity.ToolCallStats, 0, len(calls))
+	for _, t := range calls {
+		t.FailureRate = rate(t.Errors, t.Calls)
+		out = append(out, *t)
+	}
+	slices.SortFunc(out, func(a, b entity.ToolCallStats) int {
+		if c := cmp.Compare(b.Calls, a.Calls); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Name, b.Name)
+	})
+	return out
+}
+
+// rate divides failures by attempts.
+//
+// Parameters:
+//   - failed: failures
+//   - total: attempts
+//
+// Returns:
+//   - float64: failed / total, or 0 when there were no attempts
+func rate(failed, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+	return float64(failed) / float64(total)
+}
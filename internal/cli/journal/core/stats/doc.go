@@ -0,0 +1,43 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package stats aggregates parsed AI sessions into the usage
+// report behind `ctx journal stats` and the journal site's
+// stats page.
+//
+// # The Surface
+//
+//   - **[Compute](sessions)**: folds sessions into an
+//     [entity.SessionStats]: totals, breakdowns by day, ISO
+//     week, model, AI tool, and git branch, and per-tool call
+//     counts with failure rates.
+//   - **[Markdown](stats)**: renders the stats as a Markdown
+//     page with one table per breakdown. `ctx journal site`
+//     writes it to `stats.md`.
+//   - **[Sections](stats)** and **[Key](key)**: the
+//     breakdowns in display order and the display form of
+//     a bucket key, shared by the Markdown and text
+//     renderers.
+//   - **[Opts]**: the flags of `ctx journal stats`.
+//
+// # What Is Counted
+//
+// Every bucket carries session and turn counts, input and
+// output tokens, summed and average session length, and tool
+// calls with their failures. A tool call is any
+// [entity.ToolUse]; a failure is an [entity.ToolResult]
+// marked IsError, attributed to the call whose ID it
+// answers. Days and weeks use the session's local start
+// time. Sessions that did not record a model or branch are
+// grouped under an empty key.
+//
+// # Ordering
+//
+// Day and week buckets are chronological so the report
+// reads as a timeline. Model, tool, and branch buckets are
+// ordered by token spend, and tool calls by call count, so
+// the heaviest consumers come first.
+package stats
@@ -0,0 +1,71 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package stats
+
+import (
+	"strings"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	"github.com/ActiveMemory/ctx/internal/assets/tpl"
+	srcFmt "github.com/ActiveMemory/ctx/internal/cli/journal/core/source/format"
+	"github.com/ActiveMemory/ctx/internal/config/embed/text"
+	"github.com/ActiveMemory/ctx/internal/config/journal"
+	"github.com/ActiveMemory/ctx/internal/config/token"
+	"github.com/ActiveMemory/ctx/internal/entity"
+	"github.com/ActiveMemory/ctx/internal/format"
+	"github.com/ActiveMemory/ctx/internal/io"
+)
+
+// Markdown renders the stats as a Markdown page: a totals line,
+// one table per breakdown, and the tool call table.
+//
+// Parameters:
+//   - st: computed stats
+//
+// Returns:
+//   - string: the page content
+func Markdown(st entity.SessionStats) string {
+	var sb strings.Builder
+	nl := token.NewlineLF
+	t := st.Totals
+
+	sb.WriteString(desc.Text(text.DescKeyHeadingSessionStats) + nl + nl)
+	io.SafeFprintf(&sb, tpl.JournalStatsSummary+nl,
+		t.Sessions, t.Turns,
+		format.Tokens(t.TokensIn), format.Tokens(t.TokensOut),
+		srcFmt.Duration(t.AvgDuration), t.AvgTurns,
+		t.ToolCalls, t.FailureRate*journal.PercentScale,
+	)
+
+	for _, sec := range Sections(st) {
+		sb.WriteString(nl)
+		io.SafeFprintf(&sb, tpl.JournalStatsSection+nl+nl, sec.Title)
+		io.SafeFprintf(&sb, tpl.JournalStatsHead+nl, sec.Column)
+		for _, b := range sec.Buckets {
+			io.SafeFprintf(&sb, tpl.JournalStatsRow+nl,
+				Key(b.Key), b.Sessions, b.Turns,
+				format.Tokens(b.TokensIn), format.Tokens(b.TokensOut),
+				srcFmt.Duration(b.AvgDuration),
+				b.ToolCalls, b.FailureRate*journal.PercentScale,
+			)
+		}
+	}
+
+	if len(st.ToolCalls) > 0 {
+		sb.WriteString(nl)
+		io.SafeFprintf(&sb, tpl.JournalStatsSection+nl+nl,
+			desc.Text(text.DescKeyLabelStatsToolCalls))
+		sb.WriteString(tpl.JournalToolStatsHead + nl)
+		for _, c := range st.ToolCalls {
+			io.SafeFprintf(&sb, tpl.JournalToolStatsRow+nl,
+				c.Name, c.Calls, c.Errors,
+				c.FailureRate*journal.PercentScale,
+			)
+		}
+	}
+	return sb.String()
+}
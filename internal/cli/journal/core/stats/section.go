@@ -0,0 +1,73 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package stats
+
+import (
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	"github.com/ActiveMemory/ctx/internal/config/embed/text"
+	"github.com/ActiveMemory/ctx/internal/entity"
+)
+
+// Sections lists the breakdowns of a report in display order: by
+// week, day, model, AI tool, and branch. Empty breakdowns are
+// left out.
+//
+// Parameters:
+//   - st: computed stats
+//
+// Returns:
+//   - []Section: the non-empty breakdowns
+func Sections(st entity.SessionStats) []Section {
+	all := []Section{
+		{
+			Title:   desc.Text(text.DescKeyLabelStatsByWeek),
+			Column:  desc.Text(text.DescKeyLabelStatsColWeek),
+			Buckets: st.ByWeek,
+		},
+		{
+			Title:   desc.Text(text.DescKeyLabelStatsByDay),
+			Column:  desc.Text(text.DescKeyLabelStatsColDay),
+			Buckets: st.ByDay,
+		},
+		{
+			Title:   desc.Text(text.DescKeyLabelStatsByModel),
+			Column:  desc.Text(text.DescKeyLabelStatsColModel),
+			Buckets: st.ByModel,
+		},
+		{
+			Title:   desc.Text(text.DescKeyLabelStatsByTool),
+			Column:  desc.Text(text.DescKeyLabelStatsColTool),
+			Buckets: st.ByTool,
+		},
+		{
+			Title:   desc.Text(text.DescKeyLabelStatsByBranch),
+			Column:  desc.Text(text.DescKeyLabelStatsColBranch),
+			Buckets: st.ByBranch,
+		},
+	}
+	out := all[:0]
+	for _, sec := range all {
+		if len(sec.Buckets) > 0 {
+			out = append(out, sec)
+		}
+	}
+	return out
+}
+
+// Key returns a bucket key for display, naming the empty key.
+//
+// Parameters:
+//   - key: bucket key
+//
+// Returns:
+//   - string: the key, or the "unrecorded" label when empty
+func Key(key string) string {
+	if key == "" {
+		return desc.Text(text.DescKeyLabelStatsUnrecorded)
+	}
+	return key
+}
@@ -0,0 +1,63 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package stats
+
+import (
+	"fmt"
+
+	"github.com/ActiveMemory/ctx/internal/config/journal"
+	cfgTime "github.com/ActiveMemory/ctx/internal/config/time"
+	"github.com/ActiveMemory/ctx/internal/entity"
+)
+
+// Compute aggregates sessions into totals, breakdowns by day, week,
+// model, AI tool, and git branch, and per-tool call counts.
+//
+// Parameters:
+//   - sessions: parsed sessions (nil entries are skipped)
+//
+// Returns:
+//   - entity.SessionStats: the aggregate; breakdowns are empty (not
+//     nil) when there are no sessions
+func Compute(sessions []*entity.Session) entity.SessionStats {
+	var totals entity.StatsBucket
+	byDay := make(map[string]*entity.StatsBucket)
+	byWeek := make(map[string]*entity.StatsBucket)
+	byModel := make(map[string]*entity.StatsBucket)
+	byTool := make(map[string]*entity.StatsBucket)
+	byBranch := make(map[string]*entity.StatsBucket)
+	calls := make(map[string]*entity.ToolCallStats)
+
+	for _, s := range sessions {
+		if s == nil {
+			continue
+		}
+		n, failed := countToolCalls(s, calls)
+		start := s.StartTime.Local()
+		year, week := start.ISOWeek()
+		for _, b := range []*entity.StatsBucket{
+			&totals,
+			bucket(byDay, start.Format(cfgTime.DateFormat)),
+			bucket(byWeek, fmt.Sprintf(journal.WeekKeyFormat, year, week)),
+			bucket(byModel, s.Model),
+			bucket(byTool, s.Tool),
+			bucket(byBranch, s.GitBranch),
+		} {
+			add(b, s, n, failed)
+		}
+	}
+
+	return entity.SessionStats{
+		Totals:    finish(totals),
+		ByDay:     chronological(byDay),
+		ByWeek:    chronological(byWeek),
+		ByModel:   heaviest(byModel),
+		ByTool:    heaviest(byTool),
+		ByBranch:  heaviest(byBranch),
+		ToolCalls: rankCalls(calls),
+	}
+}
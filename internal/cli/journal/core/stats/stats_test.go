@@ -0,0 +1,134 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package stats
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ActiveMemory/ctx/internal/entity"
+)
+
+// fixture returns three sessions over two ISO weeks, two models,
+// and two tools; one has no branch.
+func fixture() []*entity.Session {
+	day := func(d, h int) time.Time {
+		return time.Date(2026, 3, d, h, 0, 0, 0, time.Local)
+	}
+	return []*entity.Session{
+		{
+			Tool: "claude-code", Model: "claude-sonnet-4",
+			GitBranch: "main", StartTime: day(2, 9),
+			Duration: 30 * time.Minute, TurnCount: 4,
+			TotalTokensIn: 1000, TotalTokensOut: 200,
+			Messages: []entity.Message{
+				{ToolUses: []entity.ToolUse{
+					{ID: "a", Name: "Bash"}, {ID: "b", Name: "Read"},
+				}},
+				{ToolResults: []entity.ToolResult{
+					{ToolUseID: "a", IsError: true},
+					{ToolUseID: "b"},
+				}},
+			},
+		},
+		{
+			Tool: "claude-code", Model: "claude-sonnet-4",
+			GitBranch: "feat/x", StartTime: day(3, 14),
+			Duration: 10 * time.Minute, TurnCount: 2,
+			TotalTokensIn: 500, TotalTokensOut: 100,
+			Messages: []entity.Message{
+				{ToolUses: []entity.ToolUse{{ID: "c", Name: "Bash"}}},
+				{ToolResults: []entity.ToolResult{{ToolUseID: "c"}}},
+			},
+		},
+		{
+			Tool: "codex", Model: "gpt-5-codex",
+			StartTime: day(9, 10),
+			Duration:  20 * time.Minute, TurnCount: 3,
+			TotalTokensIn: 4000, TotalTokensOut: 400,
+		},
+	}
+}
+
+func TestCompute(t *testing.T) {
+	st := Compute(fixture())
+
+	tot := st.Totals
+	if tot.Sessions != 3 || tot.Turns != 9 || tot.Tokens != 6200 {
+		t.Errorf("totals = %+v", tot)
+	}
+	if tot.AvgDuration != 20*time.Minute || tot.AvgTurns != 3 {
+		t.Errorf("averages = %v, %v", tot.AvgDuration, tot.AvgTurns)
+	}
+	if tot.ToolCalls != 3 || tot.ToolErrors != 1 ||
+		tot.ErrorSessions != 1 {
+		t.Errorf("tool totals = %+v", tot)
+	}
+
+	if len(st.ByWeek) != 2 || st.ByWeek[0].Key != "2026-W10" ||
+		st.ByWeek[0].Sessions != 2 || st.ByWeek[1].Key != "2026-W11" {
+		t.Errorf("ByWeek = %+v", st.ByWeek)
+	}
+	if len(st.ByDay) != 3 || st.ByDay[0].Key != "2026-03-02" {
+		t.Errorf("ByDay = %+v", st.ByDay)
+	}
+	// Most tokens first.
+	if len(st.ByModel) != 2 || st.ByModel[0].Key != "gpt-5-codex" {
+		t.Errorf("ByModel = %+v", st.ByModel)
+	}
+	if len(st.ByTool) != 2 || st.ByTool[1].Key != "claude-code" ||
+		st.ByTool[1].FailureRate != 1.0/3 {
+		t.Errorf("ByTool = %+v", st.ByTool)
+	}
+	if len(st.ByBranch) != 3 || st.ByBranch[0].Key != "" {
+		t.Errorf("ByBranch = %+v", st.ByBranch)
+	}
+
+	want := []entity.ToolCallStats{
+		{Name: "Bash", Calls: 2, Errors: 1, FailureRate: 0.5},
+		{Name: "Read", Calls: 1},
+	}
+	if len(st.ToolCalls) != len(want) {
+		t.Fatalf("ToolCalls = %+v", st.ToolCalls)
+	}
+	for i, w := range want {
+		if st.ToolCalls[i] != w {
+			t.Errorf("ToolCalls[%d] = %+v, want %+v",
+				i, st.ToolCalls[i], w)
+		}
+	}
+}
+
+func TestCompute_Empty(t *testing.T) {
+	st := Compute(nil)
+	if st.Totals.Sessions != 0 || st.ByDay == nil ||
+		st.ToolCalls == nil {
+		t.Errorf("empty stats = %+v", st)
+	}
+	if len(Sections(st)) != 0 {
+		t.Error("empty stats have sections")
+	}
+}
+
+func TestMarkdown(t *testing.T) {
+	got := Markdown(Compute(fixture()))
+	for _, want := range []string{
+		"# Session Stats",
+		"**Sessions**: 3 | **Turns**: 9",
+		"## By Week",
+		"| 2026-W10 | 2 | 6 |",
+		"## By Branch",
+		"| (unrecorded) | 1 |",
+		"## Tool Calls",
+		"| `Bash` | 2 | 1 | 50.0% |",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("report missing %q:\n%s", want, got)
+		}
+	}
+}
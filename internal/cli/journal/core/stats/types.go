@@ -0,0 +1,41 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package stats
+
+import "github.com/ActiveMemory/ctx/internal/entity"
+
+// Section is one breakdown of the stats report.
+//
+// Fields:
+//   - Title: section label (e.g. "By Week")
+//   - Column: label of the key column (e.g. "Week")
+//   - Buckets: the breakdown's buckets in report order
+type Section struct {
+	Title   string
+	Column  string
+	Buckets []entity.StatsBucket
+}
+
+// Opts holds the flags of the journal stats subcommand.
+//
+// Fields:
+//   - Project: Filter by project name
+//   - Tool: Filter by tool name
+//   - Since: Count sessions on or after this date
+//   - Until: Count sessions on or before this date
+//   - AllProjects: Include all projects
+//   - JSON: Print JSON instead of text
+//   - Markdown: Print the Markdown report instead of text
+type Opts struct {
+	Project     string
+	Tool        string
+	Since       string
+	Until       string
+	AllProjects bool
+	JSON        bool
+	Markdown    bool
+}
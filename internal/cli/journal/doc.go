@@ -15,6 +15,10 @@
 // # Subcommands
 //
 //   - source: list or inspect raw journal entries
+//   - stats: summarize session usage, token spend, and
+//     tool-call failures
+//   - redact: replace secrets in existing entries with
+//     placeholders, or report them with --check
 //   - import: ingest exported session files into the
 //     journal directory
 //   - schema: output the journal entry JSON Schema
@@ -33,6 +37,8 @@
 // # Subpackages
 //
 //	cmd/source: entry listing and inspection
+//	cmd/stats: session usage statistics
+//	cmd/redact: secret redaction
 //	cmd/importer: session file ingestion
 //	cmd/schema: JSON Schema output
 //	cmd/lock, cmd/unlock: entry finalization
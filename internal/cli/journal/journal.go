@@ -12,9 +12,11 @@ import (
 	"github.com/ActiveMemory/ctx/internal/cli/journal/cmd/importer"
 	"github.com/ActiveMemory/ctx/internal/cli/journal/cmd/lock"
 	"github.com/ActiveMemory/ctx/internal/cli/journal/cmd/obsidian"
+	"github.com/ActiveMemory/ctx/internal/cli/journal/cmd/redact"
 	journalSchema "github.com/ActiveMemory/ctx/internal/cli/journal/cmd/schema"
 	"github.com/ActiveMemory/ctx/internal/cli/journal/cmd/site"
 	"github.com/ActiveMemory/ctx/internal/cli/journal/cmd/source"
+	"github.com/ActiveMemory/ctx/internal/cli/journal/cmd/stats"
 	journalSync "github.com/ActiveMemory/ctx/internal/cli/journal/cmd/sync"
 	"github.com/ActiveMemory/ctx/internal/cli/journal/cmd/unlock"
 	"github.com/ActiveMemory/ctx/internal/cli/parent"
@@ -32,6 +34,8 @@ import (
 func Cmd() *cobra.Command {
 	return parent.Cmd(cmd.DescKeyJournal, cmd.UseJournal,
 		source.Cmd(),
+		stats.Cmd(),
+		redact.Cmd(),
 		importer.Cmd(),
 		journalSchema.Cmd(),
 		lock.Cmd(),
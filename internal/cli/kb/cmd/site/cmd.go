@@ -0,0 +1,50 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package site
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	"github.com/ActiveMemory/ctx/internal/config/embed/cmd"
+	"github.com/ActiveMemory/ctx/internal/config/embed/flag"
+	cFlag "github.com/ActiveMemory/ctx/internal/config/flag"
+	"github.com/ActiveMemory/ctx/internal/flagbind"
+)
+
+// Cmd returns the `ctx kb site` command.
+//
+// The --output default is resolved inside [Run] so the context
+// directory is only consulted after cobra has parsed the flags.
+//
+// Returns:
+//   - *cobra.Command: configured command.
+func Cmd() *cobra.Command {
+	var (
+		output string
+		serve  bool
+	)
+
+	short, long := desc.Command(cmd.DescKeyKBSite)
+	c := &cobra.Command{
+		Use:   cmd.UseKBSite,
+		Short: short,
+		Long:  long,
+		Args:  cobra.NoArgs,
+		RunE: func(cobraCmd *cobra.Command, _ []string) error {
+			return Run(cobraCmd, output, serve)
+		},
+	}
+
+	flagbind.StringFlagPDefault(
+		c, &output, cFlag.Output, cFlag.ShortOutput,
+		"", flag.DescKeyKBSiteOutput,
+	)
+	flagbind.BoolFlag(c, &serve, cFlag.Serve, flag.DescKeyKBSiteServe)
+
+	return c
+}
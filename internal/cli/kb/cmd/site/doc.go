@@ -0,0 +1,22 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package site implements `ctx kb site`.
+//
+// The command renders every Markdown file under .context/kb/
+// to a static HTML site with the built-in renderer, so the kb
+// can be browsed without an external site builder. The sidebar
+// mirrors the kb folder layout and a client-side search index
+// is written alongside the pages. --serve hosts the result on
+// the loopback address until interrupted.
+//
+// # Related packages
+//
+//   - [github.com/ActiveMemory/ctx/internal/site] renders and
+//     serves the site.
+//   - [github.com/ActiveMemory/ctx/internal/cli/kb/core/path]
+//     resolves the kb source and the default output directory.
+package site
@@ -0,0 +1,66 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package site
+
+import (
+	"net"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	kbPath "github.com/ActiveMemory/ctx/internal/cli/kb/core/path"
+	"github.com/ActiveMemory/ctx/internal/config/embed/text"
+	cfgSite "github.com/ActiveMemory/ctx/internal/config/site"
+	"github.com/ActiveMemory/ctx/internal/err/fs"
+	renderSite "github.com/ActiveMemory/ctx/internal/site"
+	writeSite "github.com/ActiveMemory/ctx/internal/write/site"
+)
+
+// Run renders .context/kb/ to HTML and optionally serves it.
+//
+// Parameters:
+//   - cobraCmd: cobra command for output.
+//   - output: output directory; empty means .context/site/kb/.
+//   - serve: when true, serve the rendered site until
+//     interrupted.
+//
+// Returns:
+//   - error: missing kb, render, or server failure.
+func Run(cobraCmd *cobra.Command, output string, serve bool) error {
+	kbDir, kbErr := kbPath.KBDir()
+	if kbErr != nil {
+		return kbErr
+	}
+	if info, statErr := os.Stat(kbDir); statErr != nil || !info.IsDir() {
+		cobraCmd.SilenceUsage = true
+		return fs.DirNotFound(kbDir)
+	}
+	if output == "" {
+		siteDir, siteErr := kbPath.SiteKBDir()
+		if siteErr != nil {
+			return siteErr
+		}
+		output = siteDir
+	}
+
+	pages, buildErr := renderSite.Build(
+		kbDir, output, desc.Text(text.DescKeySiteTitleKB), nil,
+	)
+	if buildErr != nil {
+		return buildErr
+	}
+	writeSite.Built(cobraCmd, pages, output)
+	if !serve {
+		return nil
+	}
+	return renderSite.Serve(
+		output, cfgSite.DefaultAddr, func(addr net.Addr) {
+			writeSite.Serving(cobraCmd, output, addr)
+		},
+	)
+}
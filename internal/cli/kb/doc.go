@@ -16,7 +16,7 @@
 //   - ctx kb site-review: mechanical audit (skill-driven).
 //   - ctx kb ground: re-grounding pass (skill-driven).
 //   - ctx kb reindex: refresh the CTX:KB:TOPICS managed block.
-//   - ctx kb site build/serve/customize: render via zensical.
+//   - ctx kb site: render the kb to static HTML (--serve).
 //
 // See specs/kb-editorial-pipeline.md for the editorial
 // pipeline contract.
@@ -14,6 +14,7 @@ import (
 	"github.com/ActiveMemory/ctx/internal/cli/kb/cmd/ingest"
 	"github.com/ActiveMemory/ctx/internal/cli/kb/cmd/note"
 	kbReindex "github.com/ActiveMemory/ctx/internal/cli/kb/cmd/reindex"
+	kbSite "github.com/ActiveMemory/ctx/internal/cli/kb/cmd/site"
 	"github.com/ActiveMemory/ctx/internal/cli/kb/cmd/sitereview"
 	"github.com/ActiveMemory/ctx/internal/cli/kb/cmd/topic"
 	"github.com/ActiveMemory/ctx/internal/cli/parent"
@@ -25,13 +26,14 @@ import (
 //
 // Returns:
 //   - *cobra.Command: kb parent with topic, ingest, ask,
-//     site-review, ground, note, and reindex.
+//     site-review, site, ground, note, and reindex.
 func Cmd() *cobra.Command {
 	return parent.Cmd(cmd.DescKeyKB, cmd.UseKB,
 		topic.Cmd(),
 		ingest.Cmd(),
 		ask.Cmd(),
 		sitereview.Cmd(),
+		kbSite.Cmd(),
 		ground.Cmd(),
 		note.Cmd(),
 		kbReindex.Cmd(),
@@ -7,26 +7,37 @@
 package root
 
 import (
+	"time"
+
 	"github.com/spf13/cobra"
 
+	coreServe "github.com/ActiveMemory/ctx/internal/cli/mcp/core/serve"
 	internalMcp "github.com/ActiveMemory/ctx/internal/mcp/server"
 	"github.com/ActiveMemory/ctx/internal/rc"
 )
 
-// Cmd starts the MCP server over stdin/stdout.
+// Cmd starts the MCP server over stdin/stdout, or over
+// Streamable HTTP when an address is given.
 //
 // Parameters:
 //   - cmd: Cobra command for version access
-//   - _: Unused positional arguments
+//   - httpAddr: Streamable HTTP listen address (empty = stdio)
+//   - token: HTTP bearer token (empty = generate)
+//   - idle: HTTP session idle timeout (0 = never expire)
 //
 // Returns:
 //   - error: Non-nil if the server fails to start or encounters an I/O error
-func Cmd(cmd *cobra.Command, _ []string) error {
+func Cmd(
+	cmd *cobra.Command, httpAddr, token string, idle time.Duration,
+) error {
 	ctxDir, err := rc.RequireContextDir()
 	if err != nil {
 		cmd.SilenceUsage = true
 		return err
 	}
+	if httpAddr != "" {
+		return coreServe.HTTP(cmd, ctxDir, httpAddr, token, idle)
+	}
 	srv := internalMcp.New(ctxDir, cmd.Root().Version)
 	return srv.Serve()
 }
@@ -27,7 +27,7 @@ func TestMcpServe_FailsClosedWhenNoDotContext(t *testing.T) {
 	c := &cobra.Command{Use: "serve"}
 	c.SetArgs(nil)
 
-	err := Cmd(c, nil)
+	err := Cmd(c, "", "", 0)
 	if err == nil {
 		t.Fatal("Cmd() err = nil, want non-nil when $PWD has no .context/")
 	}
@@ -21,16 +21,23 @@
 //
 // # Flags
 //
-// This command accepts no flags. It reads the context
-// directory from rc and the version from the root
-// cobra.Command.
+//	--http <addr>    Serve the MCP Streamable HTTP
+//	                 transport on addr instead of stdio.
+//	--token <token>  Bearer token HTTP clients must send
+//	                 (or $CTX_MCP_TOKEN; generated when
+//	                 unset).
+//
+// The context directory comes from rc and the version
+// from the root cobra.Command.
 //
 // # Behavior
 //
-// [Cmd] creates a new MCP server instance using the
-// resolved context directory and the CLI version string,
-// then calls srv.Serve which blocks until the client
-// disconnects or an I/O error occurs.
+// Without --http, [Cmd] creates a new MCP server instance
+// using the resolved context directory and the CLI version
+// string, then calls srv.Serve which blocks until the
+// client disconnects or an I/O error occurs. With --http
+// it hands off to [internal/cli/mcp/core/serve.HTTP],
+// which serves any number of clients until Ctrl-C.
 //
 // The server registers tools for reading context files,
 // querying project state, and other context operations
@@ -38,7 +45,9 @@
 //
 // # Output
 //
-// All communication happens over stdin/stdout in
-// JSON-RPC 2.0 format. No human-readable output is
-// produced on stderr under normal operation.
+// In stdio mode all communication happens over
+// stdin/stdout in JSON-RPC 2.0 format. No human-readable
+// output is produced on stderr under normal operation.
+// In HTTP mode the endpoint (and a generated token, if
+// any) is printed to stdout at startup.
 package root
@@ -0,0 +1,18 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package serve runs the MCP server behind
+// `ctx mcp serve --http`.
+//
+// [HTTP] resolves the bearer token (generating one when
+// none is configured), listens on the requested address,
+// prints the endpoint, and serves the MCP Streamable HTTP
+// transport until Ctrl-C, when every open session and
+// event stream is closed.
+//
+// Protocol handling lives in [internal/mcp/server]; this
+// package only owns process setup and output.
+package serve
@@ -0,0 +1,74 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package serve
+
+import (
+	"context"
+	"net"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	cfgTransport "github.com/ActiveMemory/ctx/internal/config/mcp/transport"
+	mcpServer "github.com/ActiveMemory/ctx/internal/mcp/server"
+	writeServe "github.com/ActiveMemory/ctx/internal/write/serve"
+)
+
+// HTTP serves MCP over Streamable HTTP until interrupted.
+//
+// An empty token is replaced by a generated one, which is
+// printed once so clients can be configured with it.
+//
+// Parameters:
+//   - cmd: Cobra command for output and version access
+//   - contextDir: path to the .context/ directory
+//   - addr: listen address (host:port)
+//   - token: bearer token (empty = generate)
+//   - idle: session idle timeout (0 = never expire)
+//
+// Returns:
+//   - error: non-nil if token generation, the listener, or
+//     the server fails
+func HTTP(
+	cmd *cobra.Command, contextDir, addr, token string,
+	idle time.Duration,
+) error {
+	generated := token == ""
+	if generated {
+		newToken, tokenErr := mcpServer.GenerateToken()
+		if tokenErr != nil {
+			return tokenErr
+		}
+		token = newToken
+	}
+
+	lis, lisErr := net.Listen(cfgTransport.Network, addr)
+	if lisErr != nil {
+		return lisErr
+	}
+
+	srv := mcpServer.NewHTTP(
+		contextDir, cmd.Root().Version, token, idle,
+	)
+	writeServe.MCPStarted(cmd, lis.Addr())
+	if generated {
+		writeServe.MCPToken(cmd, token)
+	}
+
+	ctx, stop := signal.NotifyContext(
+		context.Background(), os.Interrupt,
+	)
+	defer stop()
+	go func() {
+		<-ctx.Done()
+		srv.Shutdown()
+	}()
+
+	return srv.Serve(lis)
+}
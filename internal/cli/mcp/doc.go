@@ -9,7 +9,7 @@
 //
 // The MCP server exposes ctx context operations as MCP
 // tools that AI coding assistants can invoke over stdio
-// transport. This allows tools like Claude Code, Cursor,
+// or Streamable HTTP transport. This allows tools like Claude Code, Cursor,
 // and other MCP-aware clients to read, write, and query
 // project context without shelling out to the ctx CLI.
 //
@@ -21,4 +21,6 @@
 //	  operations. The command annotates itself with SkipInit
 //	  so it can run without a fully initialized .context/
 //	  directory.
+//	core/serve: Streamable HTTP startup for --http: token
+//	  resolution, listener, and Ctrl-C shutdown.
 package mcp
@@ -7,12 +7,20 @@
 package mcp
 
 import (
+	"os"
+	"time"
+
 	"github.com/spf13/cobra"
 
 	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
 	"github.com/ActiveMemory/ctx/internal/cli/mcp/cmd/root"
 	"github.com/ActiveMemory/ctx/internal/config/cli"
 	"github.com/ActiveMemory/ctx/internal/config/embed/cmd"
+	"github.com/ActiveMemory/ctx/internal/config/embed/flag"
+	"github.com/ActiveMemory/ctx/internal/config/env"
+	cFlag "github.com/ActiveMemory/ctx/internal/config/flag"
+	cfgTransport "github.com/ActiveMemory/ctx/internal/config/mcp/transport"
+	"github.com/ActiveMemory/ctx/internal/flagbind"
 )
 
 // serveCmd returns the mcp serve subcommand.
@@ -20,14 +28,33 @@ import (
 // Returns:
 //   - *cobra.Command: Configured serve subcommand with init-skip annotation
 func serveCmd() *cobra.Command {
+	var httpAddr, token string
+	var idle time.Duration
+
 	serveShort, serveLong := desc.Command(cmd.DescKeyMcpServe)
-	return &cobra.Command{
+	c := &cobra.Command{
 		Use:          cmd.UseMcpServe,
 		Short:        serveShort,
 		Long:         serveLong,
 		Example:      desc.Example(cmd.DescKeyMcpServe),
 		Annotations:  map[string]string{cli.AnnotationSkipInit: cli.AnnotationTrue},
 		SilenceUsage: true,
-		RunE:         root.Cmd,
+		RunE: func(cobraCmd *cobra.Command, _ []string) error {
+			// Bearer token: --token flag takes precedence, then
+			// the CTX_MCP_TOKEN environment variable.
+			if token == "" {
+				token = os.Getenv(env.MCPToken)
+			}
+			return root.Cmd(cobraCmd, httpAddr, token, idle)
+		},
 	}
+
+	flagbind.StringFlag(c, &httpAddr, cFlag.HTTP, flag.DescKeyMcpServeHTTP)
+	flagbind.StringFlag(c, &token, cFlag.Token, flag.DescKeyMcpServeToken)
+	flagbind.DurationFlag(
+		c, &idle, cFlag.SessionTimeout, cfgTransport.SessionIdleTimeout,
+		flag.DescKeyMcpServeSessionTimeout,
+	)
+
+	return c
 }
@@ -0,0 +1,12 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package core holds the ctx mine command's domain logic: pass
+// (one mining run over git history). Review, accept, and reject
+// are the shared subcommands in internal/cli/queue. The command
+// itself stays a thin Cobra wrapper; the classifier and ledger live
+// in internal/mine.
+package core
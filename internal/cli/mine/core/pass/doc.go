@@ -0,0 +1,11 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package pass runs one ctx mine pass: read git log within the
+// requested window, classify commits not yet in the ledger, append
+// the new candidates to the review file, and record the mined
+// commits and totals in the ledger.
+package pass
@@ -0,0 +1,78 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package pass
+
+import (
+	"time"
+
+	"github.com/spf13/cobra"
+
+	cfgTime "github.com/ActiveMemory/ctx/internal/config/time"
+	"github.com/ActiveMemory/ctx/internal/mine"
+	"github.com/ActiveMemory/ctx/internal/queue"
+	"github.com/ActiveMemory/ctx/internal/rc"
+	writeMine "github.com/ActiveMemory/ctx/internal/write/mine"
+)
+
+// Run executes one mining pass and prints a summary.
+//
+// Parameters:
+//   - cmd: cobra command for output
+//   - opts: the resolved run parameters
+//
+// Returns:
+//   - error: a context-directory, git, or state-file failure
+func Run(cmd *cobra.Command, opts Opts) error {
+	ctxDir, ctxErr := rc.ContextDir()
+	if ctxErr != nil {
+		return ctxErr
+	}
+	ledger := &mine.Ledger{}
+	if ledgerErr := queue.LoadLedger(
+		mine.Queue, ctxDir, ledger,
+	); ledgerErr != nil {
+		return ledgerErr
+	}
+	pending, reviewErr := queue.LoadReview[mine.Candidate](
+		mine.Queue, ctxDir,
+	)
+	if reviewErr != nil {
+		return reviewErr
+	}
+	known, knownErr := mine.KnownTitles(ctxDir)
+	if knownErr != nil {
+		return knownErr
+	}
+	commits, logErr := mine.Log(opts.Since, opts.Until, opts.Limit)
+	if logErr != nil {
+		cmd.SilenceUsage = true
+		return logErr
+	}
+
+	now := time.Now()
+	mined, fresh := mine.Propose(
+		commits, ledger, pending, known, opts.Force,
+		now.Format(cfgTime.DateFormat),
+	)
+	if len(fresh) > 0 {
+		if saveErr := queue.SaveReview(
+			mine.Queue, ctxDir, append(pending, fresh...),
+		); saveErr != nil {
+			return saveErr
+		}
+	}
+	ledger.LastMined = now.UTC().Format(time.RFC3339)
+	if saveErr := queue.SaveLedger(
+		mine.Queue, ctxDir, ledger,
+	); saveErr != nil {
+		return saveErr
+	}
+	writeMine.Summary(
+		cmd, mined, len(fresh), queue.ReviewPath(mine.Queue, ctxDir),
+	)
+	return nil
+}
@@ -0,0 +1,23 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package pass
+
+// Opts carries the mining-run parameters resolved from flags.
+//
+// Fields:
+//   - Since: oldest commit date (git date expression; empty for
+//     no bound)
+//   - Until: newest commit date (git date expression; empty for
+//     no bound)
+//   - Limit: maximum commits to read
+//   - Force: re-mine commits the ledger already records
+type Opts struct {
+	Since string
+	Until string
+	Limit int
+	Force bool
+}
@@ -0,0 +1,28 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package mine implements the "ctx mine" command: a deterministic
+// pass over git history that proposes DECISIONS.md and LEARNINGS.md
+// entries for human review.
+//
+// Invoked with no subcommand, it reads git log, classifies each
+// commit not yet in the ledger, and adds the resulting candidates to
+// .context/state/mine-review.json. Nothing reaches a context file
+// until a candidate is accepted.
+//
+// # Subcommands
+//
+//   - review: list pending candidates
+//   - accept: write candidates through entry.ValidateAndWrite
+//   - reject: discard candidates (recorded; never re-proposed)
+//
+// The review, accept, and reject subcommands are the shared ones
+// in internal/cli/queue, built from this command's queue spec.
+//
+// # Subpackages
+//
+//	core/pass: one mining run
+package mine
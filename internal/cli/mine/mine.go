@@ -0,0 +1,58 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package mine
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	minePass "github.com/ActiveMemory/ctx/internal/cli/mine/core/pass"
+	"github.com/ActiveMemory/ctx/internal/cli/queue/cmd/accept"
+	"github.com/ActiveMemory/ctx/internal/cli/queue/cmd/reject"
+	"github.com/ActiveMemory/ctx/internal/cli/queue/cmd/review"
+	"github.com/ActiveMemory/ctx/internal/config/embed/cmd"
+	"github.com/ActiveMemory/ctx/internal/config/embed/flag"
+	cFlag "github.com/ActiveMemory/ctx/internal/config/flag"
+	cfgMine "github.com/ActiveMemory/ctx/internal/config/mine"
+	"github.com/ActiveMemory/ctx/internal/flagbind"
+)
+
+// Cmd returns the mine command with its subcommands.
+//
+// Invoked with no subcommand, it runs one mining pass over git
+// history.
+//
+// Returns:
+//   - *cobra.Command: the mine command with review/accept/reject
+//     subcommands
+func Cmd() *cobra.Command {
+	var opts minePass.Opts
+
+	short, long := desc.Command(cmd.DescKeyMine)
+	c := &cobra.Command{
+		Use:     cmd.UseMine,
+		Short:   short,
+		Long:    long,
+		Example: desc.Example(cmd.DescKeyMine),
+		Args:    cobra.NoArgs,
+		RunE: func(cobraCmd *cobra.Command, _ []string) error {
+			return minePass.Run(cobraCmd, opts)
+		},
+	}
+
+	flagbind.StringFlag(c, &opts.Since, cFlag.Since, flag.DescKeyMineSince)
+	flagbind.StringFlag(c, &opts.Until, cFlag.Until, flag.DescKeyMineUntil)
+	flagbind.IntFlag(c, &opts.Limit,
+		cFlag.Limit, cfgMine.DefaultLimit, flag.DescKeyMineLimit,
+	)
+	flagbind.BoolFlag(c, &opts.Force, cFlag.Force, flag.DescKeyMineForce)
+
+	c.AddCommand(review.Cmd(spec))
+	c.AddCommand(accept.Cmd(spec))
+	c.AddCommand(reject.Cmd(spec))
+	return c
+}
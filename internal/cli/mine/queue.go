@@ -0,0 +1,38 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package mine
+
+import (
+	coreReview "github.com/ActiveMemory/ctx/internal/cli/queue/core/review"
+	"github.com/ActiveMemory/ctx/internal/config/embed/cmd"
+	"github.com/ActiveMemory/ctx/internal/config/embed/flag"
+	"github.com/ActiveMemory/ctx/internal/entity"
+	"github.com/ActiveMemory/ctx/internal/mine"
+	writeMine "github.com/ActiveMemory/ctx/internal/write/mine"
+)
+
+// spec is the ctx mine review queue: its files and ledger, how an
+// accepted candidate becomes an entry, and its output.
+var spec = coreReview.Spec[mine.Candidate]{
+	Queue: mine.Queue,
+	NewLedger: func() entity.ReviewLedger {
+		return &mine.Ledger{}
+	},
+	Params: func(
+		c mine.Candidate, branch, _, ctxDir string,
+	) entity.EntryParams {
+		return mine.Params(c, branch, ctxDir)
+	},
+	Accepted:   writeMine.Accepted,
+	Rejected:   writeMine.Rejected,
+	Review:     writeMine.Review,
+	ReviewNone: writeMine.ReviewNone,
+	ReviewKey:  cmd.DescKeyMineReview,
+	AcceptKey:  cmd.DescKeyMineAccept,
+	RejectKey:  cmd.DescKeyMineReject,
+	AllKey:     flag.DescKeyMineAll,
+}
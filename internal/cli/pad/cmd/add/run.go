@@ -13,6 +13,7 @@ import (
 	"github.com/ActiveMemory/ctx/internal/cli/pad/core/parse"
 	"github.com/ActiveMemory/ctx/internal/cli/pad/core/store"
 	"github.com/ActiveMemory/ctx/internal/rc"
+	"github.com/ActiveMemory/ctx/internal/txn"
 	writePad "github.com/ActiveMemory/ctx/internal/write/pad"
 )
 
@@ -29,29 +30,32 @@ import (
 // Returns:
 //   - error: Non-nil on read/write failure or too large
 func Run(cmd *cobra.Command, text, filePath string) error {
-	if _, ctxErr := rc.RequireContextDir(); ctxErr != nil {
+	ctxDir, ctxErr := rc.RequireContextDir()
+	if ctxErr != nil {
 		cmd.SilenceUsage = true
 		return ctxErr
 	}
-	var entries []parse.Entry
-	var id int
-	var addErr error
+	return txn.Do(ctxDir, func() error {
+		var entries []parse.Entry
+		var id int
+		var addErr error
 
-	if filePath != "" {
-		entries, id, addErr = coreAdd.BlobWithID(
-			text, filePath)
-	} else {
-		entries, id, addErr = coreAdd.EntryWithID(text)
-	}
-	if addErr != nil {
-		return addErr
-	}
+		if filePath != "" {
+			entries, id, addErr = coreAdd.BlobWithID(
+				text, filePath)
+		} else {
+			entries, id, addErr = coreAdd.EntryWithID(text)
+		}
+		if addErr != nil {
+			return addErr
+		}
 
-	writeErr := store.WriteEntriesWithIDs(cmd, entries)
-	if writeErr != nil {
-		return writeErr
-	}
+		writeErr := store.WriteEntriesWithIDs(cmd, entries)
+		if writeErr != nil {
+			return writeErr
+		}
 
-	writePad.EntryAdded(cmd, id)
-	return nil
+		writePad.EntryAdded(cmd, id)
+		return nil
+	})
 }
@@ -12,6 +12,7 @@ import (
 	coreEdit "github.com/ActiveMemory/ctx/internal/cli/pad/core/edit"
 	"github.com/ActiveMemory/ctx/internal/cli/pad/core/store"
 	"github.com/ActiveMemory/ctx/internal/rc"
+	"github.com/ActiveMemory/ctx/internal/txn"
 	writePad "github.com/ActiveMemory/ctx/internal/write/pad"
 )
 
@@ -25,41 +26,44 @@ import (
 //   - error: Non-nil on invalid index, type mismatch,
 //     or read/write failure
 func Run(cmd *cobra.Command, opts coreEdit.Opts) error {
-	if _, ctxErr := rc.RequireContextDir(); ctxErr != nil {
+	ctxDir, ctxErr := rc.RequireContextDir()
+	if ctxErr != nil {
 		cmd.SilenceUsage = true
 		return ctxErr
 	}
-	var entries []string
-	var editErr error
+	return txn.Do(ctxDir, func() error {
+		var entries []string
+		var editErr error
 
-	switch opts.Mode {
-	case coreEdit.ModeAppend:
-		entries, editErr = coreEdit.Append(
-			opts.N, opts.Text,
-		)
-	case coreEdit.ModePrepend:
-		entries, editErr = coreEdit.Prepend(
-			opts.N, opts.Text,
-		)
-	case coreEdit.ModeBlob:
-		entries, editErr = coreEdit.UpdateBlob(
-			opts.N, opts.FilePath, opts.LabelText,
-		)
-	default:
-		entries, editErr = coreEdit.Replace(
-			opts.N, opts.Text,
-		)
-	}
-	if editErr != nil {
-		return editErr
-	}
+		switch opts.Mode {
+		case coreEdit.ModeAppend:
+			entries, editErr = coreEdit.Append(
+				opts.N, opts.Text,
+			)
+		case coreEdit.ModePrepend:
+			entries, editErr = coreEdit.Prepend(
+				opts.N, opts.Text,
+			)
+		case coreEdit.ModeBlob:
+			entries, editErr = coreEdit.UpdateBlob(
+				opts.N, opts.FilePath, opts.LabelText,
+			)
+		default:
+			entries, editErr = coreEdit.Replace(
+				opts.N, opts.Text,
+			)
+		}
+		if editErr != nil {
+			return editErr
+		}
 
-	if writeErr := store.WriteEntries(
-		cmd, entries,
-	); writeErr != nil {
-		return writeErr
-	}
+		if writeErr := store.WriteEntries(
+			cmd, entries,
+		); writeErr != nil {
+			return writeErr
+		}
 
-	writePad.EntryUpdated(cmd, opts.N)
-	return nil
+		writePad.EntryUpdated(cmd, opts.N)
+		return nil
+	})
 }
@@ -14,6 +14,7 @@ import (
 	"github.com/ActiveMemory/ctx/internal/cli/pad/core/store"
 	errFs "github.com/ActiveMemory/ctx/internal/err/fs"
 	"github.com/ActiveMemory/ctx/internal/rc"
+	"github.com/ActiveMemory/ctx/internal/txn"
 	"github.com/ActiveMemory/ctx/internal/write/pad"
 )
 
@@ -34,75 +35,78 @@ func Run(
 	keyFile string,
 	dryRun bool,
 ) error {
-	if _, ctxErr := rc.RequireContextDir(); ctxErr != nil {
+	ctxDir, ctxErr := rc.RequireContextDir()
+	if ctxErr != nil {
 		cmd.SilenceUsage = true
 		return ctxErr
 	}
-	current, readErr := store.ReadEntries()
-	if readErr != nil {
-		return readErr
-	}
-
-	key, keyErr := merge.LoadKey(keyFile)
-	if keyErr != nil {
-		return keyErr
-	}
-
-	seen := make(map[string]bool, len(current))
-	for _, e := range current {
-		seen[e] = true
-	}
-
-	blobLabels := merge.BuildBlobLabelMap(current)
-
-	var added, dupes int
-	var newEntries []string
+	return txn.Do(ctxDir, func() error {
+		current, readErr := store.ReadEntries()
+		if readErr != nil {
+			return readErr
+		}
 
-	for _, file := range files {
-		entries, fileErr := merge.ReadFileEntries(file, key)
-		if fileErr != nil {
-			return errFs.OpenFile(file, fileErr)
+		key, keyErr := merge.LoadKey(keyFile)
+		if keyErr != nil {
+			return keyErr
 		}
 
-		if merge.HasBinaryEntries(entries) {
-			pad.MergeBinaryWarning(cmd, file)
+		seen := make(map[string]bool, len(current))
+		for _, e := range current {
+			seen[e] = true
 		}
 
-		for _, entry := range entries {
-			if seen[entry] {
-				dupes++
-				pad.MergeDupe(cmd, blob.DisplayEntry(entry))
-				continue
+		blobLabels := merge.BuildBlobLabelMap(current)
+
+		var added, dupes int
+		var newEntries []string
+
+		for _, file := range files {
+			entries, fileErr := merge.ReadFileEntries(file, key)
+			if fileErr != nil {
+				return errFs.OpenFile(file, fileErr)
 			}
-			seen[entry] = true
 
-			if conflict, label := merge.HasBlobConflict(entry, blobLabels); conflict {
-				pad.MergeBlobConflict(cmd, label)
+			if merge.HasBinaryEntries(entries) {
+				pad.MergeBinaryWarning(cmd, file)
 			}
 
-			newEntries = append(newEntries, entry)
-			added++
-			pad.MergeAdded(cmd, blob.DisplayEntry(entry), file)
+			for _, entry := range entries {
+				if seen[entry] {
+					dupes++
+					pad.MergeDupe(cmd, blob.DisplayEntry(entry))
+					continue
+				}
+				seen[entry] = true
+
+				if conflict, label := merge.HasBlobConflict(entry, blobLabels); conflict {
+					pad.MergeBlobConflict(cmd, label)
+				}
+
+				newEntries = append(newEntries, entry)
+				added++
+				pad.MergeAdded(cmd, blob.DisplayEntry(entry), file)
+			}
 		}
-	}
 
-	if added == 0 {
-		pad.MergeSummary(cmd, added, dupes, dryRun)
-		return nil
-	}
+		if added == 0 {
+			pad.MergeSummary(cmd, added, dupes, dryRun)
+			return nil
+		}
 
-	if dryRun {
-		pad.MergeSummary(cmd, added, dupes, dryRun)
-		return nil
-	}
+		if dryRun {
+			pad.MergeSummary(cmd, added, dupes, dryRun)
+			return nil
+		}
 
-	merged := make([]string, 0, len(current)+len(newEntries))
-	merged = append(merged, current...)
-	merged = append(merged, newEntries...)
-	if writeErr := store.WriteEntries(cmd, merged); writeErr != nil {
-		return writeErr
-	}
+		merged := make([]string, 0, len(current)+len(newEntries))
+		merged = append(merged, current...)
+		merged = append(merged, newEntries...)
+		if writeErr := store.WriteEntries(cmd, merged); writeErr != nil {
+			return writeErr
+		}
 
-	pad.MergeSummary(cmd, added, dupes, false)
-	return nil
+		pad.MergeSummary(cmd, added, dupes, false)
+		return nil
+	})
 }
@@ -12,6 +12,7 @@ import (
 	"github.com/ActiveMemory/ctx/internal/cli/pad/core/store"
 	"github.com/ActiveMemory/ctx/internal/cli/pad/core/validate"
 	"github.com/ActiveMemory/ctx/internal/rc"
+	"github.com/ActiveMemory/ctx/internal/txn"
 	"github.com/ActiveMemory/ctx/internal/write/pad"
 )
 
@@ -25,34 +26,37 @@ import (
 // Returns:
 //   - error: Non-nil on invalid index or read/write failure
 func Run(cmd *cobra.Command, n, m int) error {
-	if _, ctxErr := rc.RequireContextDir(); ctxErr != nil {
+	ctxDir, ctxErr := rc.RequireContextDir()
+	if ctxErr != nil {
 		cmd.SilenceUsage = true
 		return ctxErr
 	}
-	entries, err := store.ReadEntries()
-	if err != nil {
-		return err
-	}
-
-	if validErr := validate.Index(n, entries); validErr != nil {
-		return validErr
-	}
-	if validErr := validate.Index(m, entries); validErr != nil {
-		return validErr
-	}
-
-	// Extract the entry at position n
-	entry := entries[n-1]
-	// Remove it
-	entries = append(entries[:n-1], entries[n:]...)
-	// Insert at position m (adjust for 0-based)
-	idx := m - 1
-	entries = append(entries[:idx], append([]string{entry}, entries[idx:]...)...)
-
-	if writeErr := store.WriteEntries(cmd, entries); writeErr != nil {
-		return writeErr
-	}
-
-	pad.EntryMoved(cmd, n, m)
-	return nil
+	return txn.Do(ctxDir, func() error {
+		entries, err := store.ReadEntries()
+		if err != nil {
+			return err
+		}
+
+		if validErr := validate.Index(n, entries); validErr != nil {
+			return validErr
+		}
+		if validErr := validate.Index(m, entries); validErr != nil {
+			return validErr
+		}
+
+		// Extract the entry at position n
+		entry := entries[n-1]
+		// Remove it
+		entries = append(entries[:n-1], entries[n:]...)
+		// Insert at position m (adjust for 0-based)
+		idx := m - 1
+		entries = append(entries[:idx], append([]string{entry}, entries[idx:]...)...)
+
+		if writeErr := store.WriteEntries(cmd, entries); writeErr != nil {
+			return writeErr
+		}
+
+		pad.EntryMoved(cmd, n, m)
+		return nil
+	})
 }
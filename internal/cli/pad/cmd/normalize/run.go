@@ -12,6 +12,7 @@ import (
 	"github.com/ActiveMemory/ctx/internal/cli/pad/core/parse"
 	"github.com/ActiveMemory/ctx/internal/cli/pad/core/store"
 	"github.com/ActiveMemory/ctx/internal/rc"
+	"github.com/ActiveMemory/ctx/internal/txn"
 	writePad "github.com/ActiveMemory/ctx/internal/write/pad"
 )
 
@@ -23,27 +24,30 @@ import (
 // Returns:
 //   - error: Non-nil on read/write failure
 func Run(cmd *cobra.Command) error {
-	if _, ctxErr := rc.RequireContextDir(); ctxErr != nil {
+	ctxDir, ctxErr := rc.RequireContextDir()
+	if ctxErr != nil {
 		cmd.SilenceUsage = true
 		return ctxErr
 	}
-	entries, readErr := store.ReadEntriesWithIDs()
-	if readErr != nil {
-		return readErr
-	}
+	return txn.Do(ctxDir, func() error {
+		entries, readErr := store.ReadEntriesWithIDs()
+		if readErr != nil {
+			return readErr
+		}
 
-	if len(entries) == 0 {
-		writePad.Empty(cmd)
-		return nil
-	}
+		if len(entries) == 0 {
+			writePad.Empty(cmd)
+			return nil
+		}
 
-	normalized := parse.Normalize(entries)
+		normalized := parse.Normalize(entries)
 
-	writeErr := store.WriteEntriesWithIDs(cmd, normalized)
-	if writeErr != nil {
-		return writeErr
-	}
+		writeErr := store.WriteEntriesWithIDs(cmd, normalized)
+		if writeErr != nil {
+			return writeErr
+		}
 
-	writePad.Normalized(cmd, len(normalized))
-	return nil
+		writePad.Normalized(cmd, len(normalized))
+		return nil
+	})
 }
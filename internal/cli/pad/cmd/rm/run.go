@@ -13,6 +13,7 @@ import (
 	"github.com/ActiveMemory/ctx/internal/cli/pad/core/store"
 	errPad "github.com/ActiveMemory/ctx/internal/err/pad"
 	"github.com/ActiveMemory/ctx/internal/rc"
+	"github.com/ActiveMemory/ctx/internal/txn"
 	"github.com/ActiveMemory/ctx/internal/write/pad"
 )
 
@@ -27,40 +28,43 @@ import (
 // Returns:
 //   - error: Non-nil on invalid ID or read/write failure
 func Run(cmd *cobra.Command, ids []int) error {
-	if _, ctxErr := rc.RequireContextDir(); ctxErr != nil {
+	ctxDir, ctxErr := rc.RequireContextDir()
+	if ctxErr != nil {
 		cmd.SilenceUsage = true
 		return ctxErr
 	}
-	entries, readErr := store.ReadEntriesWithIDs()
-	if readErr != nil {
-		return readErr
-	}
+	return txn.Do(ctxDir, func() error {
+		entries, readErr := store.ReadEntriesWithIDs()
+		if readErr != nil {
+			return readErr
+		}
 
-	// Resolve all IDs before deleting any.
-	removeSet := make(map[int]bool, len(ids))
-	for _, id := range ids {
-		idx := parse.FindByID(entries, id)
-		if idx < 0 {
-			return errPad.EntryNotFound(id)
+		// Resolve all IDs before deleting any.
+		removeSet := make(map[int]bool, len(ids))
+		for _, id := range ids {
+			idx := parse.FindByID(entries, id)
+			if idx < 0 {
+				return errPad.EntryNotFound(id)
+			}
+			removeSet[id] = true
 		}
-		removeSet[id] = true
-	}
 
-	// Filter out removed entries.
-	var remaining []parse.Entry
-	for _, e := range entries {
-		if !removeSet[e.ID] {
-			remaining = append(remaining, e)
+		// Filter out removed entries.
+		var remaining []parse.Entry
+		for _, e := range entries {
+			if !removeSet[e.ID] {
+				remaining = append(remaining, e)
+			}
 		}
-	}
 
-	writeErr := store.WriteEntriesWithIDs(cmd, remaining)
-	if writeErr != nil {
-		return writeErr
-	}
+		writeErr := store.WriteEntriesWithIDs(cmd, remaining)
+		if writeErr != nil {
+			return writeErr
+		}
 
-	for _, id := range ids {
-		pad.EntryRemoved(cmd, id)
-	}
-	return nil
+		for _, id := range ids {
+			pad.EntryRemoved(cmd, id)
+		}
+		return nil
+	})
 }
@@ -11,6 +11,7 @@ import (
 
 	"github.com/ActiveMemory/ctx/internal/cli/pad/core/load"
 	"github.com/ActiveMemory/ctx/internal/rc"
+	"github.com/ActiveMemory/ctx/internal/txn"
 )
 
 // Run imports entries into the scratchpad from a file, stdin, or directory.
@@ -26,12 +27,15 @@ import (
 // Returns:
 //   - error: Non-nil on read/write failure
 func Run(cmd *cobra.Command, path string, blobs bool) error {
-	if _, ctxErr := rc.RequireContextDir(); ctxErr != nil {
+	ctxDir, ctxErr := rc.RequireContextDir()
+	if ctxErr != nil {
 		cmd.SilenceUsage = true
 		return ctxErr
 	}
-	if blobs {
-		return load.Blobs(cmd, path)
-	}
-	return load.Lines(cmd, path)
+	return txn.Do(ctxDir, func() error {
+		if blobs {
+			return load.Blobs(cmd, path)
+		}
+		return load.Lines(cmd, path)
+	})
 }
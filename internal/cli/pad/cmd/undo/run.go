@@ -11,6 +11,7 @@ import (
 
 	"github.com/ActiveMemory/ctx/internal/cli/pad/core/store"
 	"github.com/ActiveMemory/ctx/internal/rc"
+	"github.com/ActiveMemory/ctx/internal/txn"
 	writePad "github.com/ActiveMemory/ctx/internal/write/pad"
 )
 
@@ -28,21 +29,23 @@ import (
 //   - error: non-nil only on history-read, snapshot-take, or
 //     restore-copy failures
 func Run(cmd *cobra.Command) error {
-	if _, ctxErr := rc.RequireContextDir(); ctxErr != nil {
+	ctxDir, ctxErr := rc.RequireContextDir()
+	if ctxErr != nil {
 		cmd.SilenceUsage = true
 		return ctxErr
 	}
+	return txn.Do(ctxDir, func() error {
+		slot, restoreErr := store.Restore(cmd)
+		if restoreErr != nil {
+			return restoreErr
+		}
 
-	slot, restoreErr := store.Restore(cmd)
-	if restoreErr != nil {
-		return restoreErr
-	}
+		if slot == "" {
+			writePad.NoHistory(cmd)
+			return nil
+		}
 
-	if slot == "" {
-		writePad.NoHistory(cmd)
+		writePad.Restored(cmd, slot)
 		return nil
-	}
-
-	writePad.Restored(cmd, slot)
-	return nil
+	})
 }
@@ -86,7 +86,7 @@ func SnapshotBefore(cmd *cobra.Command) error {
 
 	snapName := buildSnapshotName(cmd, padPath, time.Now().UTC())
 	snapPath := filepath.Join(dir, snapName)
-	if writeErr := io.SafeWriteFile(
+	if writeErr := io.SafeWriteFileAtomic(
 		snapPath, data, info.Mode().Perm(),
 	); writeErr != nil {
 		return errPad.HistoryWrite(writeErr)
@@ -133,7 +133,7 @@ func Restore(cmd *cobra.Command) (string, error) {
 		return "", errPad.HistoryRestore(readErr)
 	}
 
-	if writeErr := io.SafeWriteFile(
+	if writeErr := io.SafeWriteFileAtomic(
 		padPath, data, fs.PermFile,
 	); writeErr != nil {
 		return "", errPad.HistoryRestore(writeErr)
@@ -132,7 +132,7 @@ func EnsureGitignore(contextDir, filename string) error {
 	if len(content) > 0 && !strings.HasSuffix(string(content), token.NewlineLF) {
 		sep = token.NewlineLF
 	}
-	return io.SafeWriteFile(
+	return io.SafeWriteFileAtomic(
 		file.FileGitignore,
 		[]byte(string(content)+sep+entry+token.NewlineLF), fs.PermFile,
 	)
@@ -165,6 +165,10 @@ func ReadEntriesWithIDs() ([]parse.Entry, error) {
 // run for plaintext and encrypted modes; both are no-ops on
 // first write (no prior blob to preserve).
 //
+// The pad is replaced atomically (temp file plus rename). Callers
+// that read the pad first hold the context directory's write lock
+// (internal/txn) through this write.
+//
 // Parameters:
 //   - cmd: Cobra command for diagnostic output
 //   - entries: Entries with stable IDs to write
@@ -185,7 +189,7 @@ func WriteEntriesWithIDs(
 	}
 
 	if !rc.ScratchpadEncrypt() {
-		if writeErr := io.SafeWriteFile(
+		if writeErr := io.SafeWriteFileAtomic(
 			path, plaintext, fs.PermFile,
 		); writeErr != nil {
 			return writeErr
@@ -214,7 +218,7 @@ func WriteEntriesWithIDs(
 		return errCrypto.EncryptFailed(encErr)
 	}
 
-	if writeErr := io.SafeWriteFile(
+	if writeErr := io.SafeWriteFileAtomic(
 		path, ciphertext, fs.PermFile,
 	); writeErr != nil {
 		return writeErr
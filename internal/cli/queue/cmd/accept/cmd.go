@@ -0,0 +1,43 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package accept
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	coreReview "github.com/ActiveMemory/ctx/internal/cli/queue/core/review"
+	"github.com/ActiveMemory/ctx/internal/config/embed/cmd"
+	cFlag "github.com/ActiveMemory/ctx/internal/config/flag"
+	"github.com/ActiveMemory/ctx/internal/entity"
+	"github.com/ActiveMemory/ctx/internal/flagbind"
+)
+
+// Cmd returns the accept subcommand for a review queue.
+//
+// Parameters:
+//   - s: the parent command's queue spec
+//
+// Returns:
+//   - *cobra.Command: configured accept subcommand
+func Cmd[C entity.ReviewCandidate](s coreReview.Spec[C]) *cobra.Command {
+	var all bool
+
+	short, long := desc.Command(s.AcceptKey)
+	c := &cobra.Command{
+		Use:     cmd.UseQueueAccept,
+		Short:   short,
+		Long:    long,
+		Example: desc.Example(s.AcceptKey),
+		RunE: func(cobraCmd *cobra.Command, args []string) error {
+			return Run(cobraCmd, s, args, all)
+		},
+	}
+
+	flagbind.BoolFlag(c, &all, cFlag.All, s.AllKey)
+	return c
+}
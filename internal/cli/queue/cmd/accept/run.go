@@ -0,0 +1,30 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package accept
+
+import (
+	"github.com/spf13/cobra"
+
+	coreReview "github.com/ActiveMemory/ctx/internal/cli/queue/core/review"
+	"github.com/ActiveMemory/ctx/internal/entity"
+)
+
+// Run delegates to the review core Accept logic.
+//
+// Parameters:
+//   - cmd: cobra command for output
+//   - s: the parent command's queue spec
+//   - ids: the candidate IDs
+//   - all: apply to every pending candidate
+//
+// Returns:
+//   - error: a selection or persistence failure
+func Run[C entity.ReviewCandidate](
+	cmd *cobra.Command, s coreReview.Spec[C], ids []string, all bool,
+) error {
+	return coreReview.Accept(cmd, s, ids, all)
+}
@@ -0,0 +1,14 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package reject wires the "reject [id...]" subcommand shared by
+// ctx mine and ctx align.
+//
+// It builds the cobra command from the parent's queue spec and
+// delegates to the review core logic, which selects the named
+// candidates (or all with --all) and records them as rejected so
+// they are never re-proposed.
+package reject
@@ -0,0 +1,37 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package review
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	coreReview "github.com/ActiveMemory/ctx/internal/cli/queue/core/review"
+	"github.com/ActiveMemory/ctx/internal/config/embed/cmd"
+	"github.com/ActiveMemory/ctx/internal/entity"
+)
+
+// Cmd returns the review subcommand for a review queue.
+//
+// Parameters:
+//   - s: the parent command's queue spec
+//
+// Returns:
+//   - *cobra.Command: configured review subcommand
+func Cmd[C entity.ReviewCandidate](s coreReview.Spec[C]) *cobra.Command {
+	short, long := desc.Command(s.ReviewKey)
+	return &cobra.Command{
+		Use:     cmd.UseQueueReview,
+		Short:   short,
+		Long:    long,
+		Example: desc.Example(s.ReviewKey),
+		Args:    cobra.NoArgs,
+		RunE: func(cobraCmd *cobra.Command, _ []string) error {
+			return Run(cobraCmd, s)
+		},
+	}
+}
@@ -0,0 +1,13 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package review wires the "review" subcommand shared by ctx mine
+// and ctx align.
+//
+// It builds the cobra command from the parent's queue spec and
+// delegates to the review core logic, which lists the pending
+// candidates through the parent's output.
+package review
@@ -0,0 +1,28 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package review
+
+import (
+	"github.com/spf13/cobra"
+
+	coreReview "github.com/ActiveMemory/ctx/internal/cli/queue/core/review"
+	"github.com/ActiveMemory/ctx/internal/entity"
+)
+
+// Run delegates to the review core logic.
+//
+// Parameters:
+//   - cmd: cobra command for output
+//   - s: the parent command's queue spec
+//
+// Returns:
+//   - error: non-nil on a resolution or read failure
+func Run[C entity.ReviewCandidate](
+	cmd *cobra.Command, s coreReview.Spec[C],
+) error {
+	return coreReview.List(cmd, s)
+}
@@ -0,0 +1,11 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package core holds the review-queue logic shared by the ctx mine
+// and ctx align subcommands: review (listing pending candidates and
+// accepting or rejecting them by id). The queue files and ledger
+// handling live in internal/queue.
+package core
@@ -0,0 +1,17 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package review lists, accepts, and rejects the candidates in a
+// command's review queue.
+//
+// A [Spec] supplies what differs between ctx mine and ctx align:
+// the queue files, the ledger type, how an accepted candidate
+// becomes entry parameters, and the output. Accept writes each
+// candidate through entry.ValidateAndWrite; both dispositions
+// remove the candidate from the review file and record it in the
+// ledger so it is never re-proposed. Accept and reject run under
+// the context write lock.
+package review
@@ -0,0 +1,100 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package review
+
+import (
+	"github.com/spf13/cobra"
+
+	cfgQueue "github.com/ActiveMemory/ctx/internal/config/queue"
+	"github.com/ActiveMemory/ctx/internal/entity"
+	"github.com/ActiveMemory/ctx/internal/entry"
+	execGit "github.com/ActiveMemory/ctx/internal/exec/git"
+	"github.com/ActiveMemory/ctx/internal/queue"
+	"github.com/ActiveMemory/ctx/internal/rc"
+)
+
+// List prints the pending candidates, or a none-pending message.
+//
+// Parameters:
+//   - cmd: cobra command for output
+//   - s: the command's queue spec
+//
+// Returns:
+//   - error: a context-directory or review-file failure
+func List[C entity.ReviewCandidate](cmd *cobra.Command, s Spec[C]) error {
+	ctxDir, ctxErr := rc.ContextDir()
+	if ctxErr != nil {
+		return ctxErr
+	}
+	pending, loadErr := queue.LoadReview[C](s.Queue, ctxDir)
+	if loadErr != nil {
+		return loadErr
+	}
+	if len(pending) == 0 {
+		s.ReviewNone(cmd)
+		return nil
+	}
+	s.Review(cmd, pending, queue.ReviewPath(s.Queue, ctxDir))
+	return nil
+}
+
+// Accept writes the selected candidates to their context files and
+// records them as accepted. Candidates accepted before a write
+// failure stay accepted; the failing one stays pending.
+//
+// Parameters:
+//   - cmd: cobra command for output
+//   - s: the command's queue spec
+//   - ids: the candidate IDs to accept
+//   - all: accept every pending candidate
+//
+// Returns:
+//   - error: a selection, validation, write, or state-file failure
+func Accept[C entity.ReviewCandidate](
+	cmd *cobra.Command, s Spec[C], ids []string, all bool,
+) error {
+	return dispose(cmd, s, ids, all, func(ctxDir string, st *state[C]) error {
+		branch, commit := execGit.CurrentBranch(), execGit.ShortHead()
+		for _, c := range st.selected {
+			if writeErr := entry.ValidateAndWrite(
+				s.Params(c, branch, commit, ctxDir),
+			); writeErr != nil {
+				return writeErr
+			}
+			st.pending = queue.Dispose(
+				st.ledger, st.pending, c.Key(), cfgQueue.Accepted,
+			)
+			s.Accepted(cmd, c)
+		}
+		return nil
+	})
+}
+
+// Reject discards the selected candidates and records them as
+// rejected.
+//
+// Parameters:
+//   - cmd: cobra command for output
+//   - s: the command's queue spec
+//   - ids: the candidate IDs to reject
+//   - all: reject every pending candidate
+//
+// Returns:
+//   - error: a selection or state-file failure
+func Reject[C entity.ReviewCandidate](
+	cmd *cobra.Command, s Spec[C], ids []string, all bool,
+) error {
+	return dispose(cmd, s, ids, all, func(_ string, st *state[C]) error {
+		for _, c := range st.selected {
+			st.pending = queue.Dispose(
+				st.ledger, st.pending, c.Key(), cfgQueue.Rejected,
+			)
+			s.Rejected(cmd, c)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,98 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package review
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/entity"
+	"github.com/ActiveMemory/ctx/internal/queue"
+	"github.com/ActiveMemory/ctx/internal/rc"
+	"github.com/ActiveMemory/ctx/internal/txn"
+)
+
+// dispose loads the queue, selects the candidates named by ids (or
+// all of them), applies fn, and saves the review file and ledger,
+// all under the context write lock. The state is saved even when
+// fn fails, so dispositions made before the failure persist.
+//
+// Parameters:
+//   - cmd: cobra command for output
+//   - s: the command's queue spec
+//   - ids: the candidate IDs to select
+//   - all: select every pending candidate
+//   - fn: disposes the selection, given the context directory
+//
+// Returns:
+//   - error: a context-directory, state-file, or selection failure,
+//     or the error fn returned
+func dispose[C entity.ReviewCandidate](
+	cmd *cobra.Command, s Spec[C], ids []string, all bool,
+	fn func(ctxDir string, st *state[C]) error,
+) error {
+	ctxDir, ctxErr := rc.ContextDir()
+	if ctxErr != nil {
+		cmd.SilenceUsage = true
+		return ctxErr
+	}
+	return txn.Do(ctxDir, func() error {
+		st, loadErr := load(s, ctxDir, ids, all)
+		if loadErr != nil {
+			cmd.SilenceUsage = true
+			return loadErr
+		}
+		fnErr := fn(ctxDir, st)
+		if saveErr := queue.SaveReview(
+			s.Queue, ctxDir, st.pending,
+		); saveErr != nil {
+			return saveErr
+		}
+		if saveErr := queue.SaveLedger(
+			s.Queue, ctxDir, st.ledger,
+		); saveErr != nil {
+			return saveErr
+		}
+		if fnErr != nil {
+			cmd.SilenceUsage = true
+		}
+		return fnErr
+	})
+}
+
+// load reads the ledger and review file and selects the candidates
+// named by ids (or all of them).
+//
+// Parameters:
+//   - s: the command's queue spec
+//   - ctxDir: the context directory
+//   - ids: the candidate IDs to select
+//   - all: select every pending candidate
+//
+// Returns:
+//   - *state[C]: the loaded state with its selection
+//   - error: a state-file or selection failure
+func load[C entity.ReviewCandidate](
+	s Spec[C], ctxDir string, ids []string, all bool,
+) (*state[C], error) {
+	ledger := s.NewLedger()
+	if ledgerErr := queue.LoadLedger(
+		s.Queue, ctxDir, ledger,
+	); ledgerErr != nil {
+		return nil, ledgerErr
+	}
+	pending, reviewErr := queue.LoadReview[C](s.Queue, ctxDir)
+	if reviewErr != nil {
+		return nil, reviewErr
+	}
+	selected, selectErr := queue.Select(s.Queue, pending, ids, all)
+	if selectErr != nil {
+		return nil, selectErr
+	}
+	return &state[C]{
+		ledger: ledger, pending: pending, selected: selected,
+	}, nil
+}
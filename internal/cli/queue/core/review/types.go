@@ -0,0 +1,55 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package review
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/entity"
+)
+
+// Spec is what a command supplies to share the review, accept, and
+// reject subcommands over its queue.
+//
+// Fields:
+//   - Queue: the queue's name and state files
+//   - NewLedger: returns an empty ledger of the command's type
+//   - Params: builds the entry for an accepted candidate from the
+//     current branch, short HEAD, and context directory
+//   - Accepted: confirms an accepted candidate
+//   - Rejected: confirms a rejected candidate
+//   - Review: prints the pending candidates and the review file
+//   - ReviewNone: prints the none-pending message
+//   - ReviewKey, AcceptKey, RejectKey: description keys of the
+//     subcommands
+//   - AllKey: description key of the accept and reject --all flag
+type Spec[C entity.ReviewCandidate] struct {
+	Queue      entity.ReviewQueue
+	NewLedger  func() entity.ReviewLedger
+	Params     func(c C, branch, commit, ctxDir string) entity.EntryParams
+	Accepted   func(cmd *cobra.Command, c C)
+	Rejected   func(cmd *cobra.Command, c C)
+	Review     func(cmd *cobra.Command, pending []C, path string)
+	ReviewNone func(cmd *cobra.Command)
+	ReviewKey  string
+	AcceptKey  string
+	RejectKey  string
+	AllKey     string
+}
+
+// state is the loaded review file and ledger for one disposition
+// command, plus the candidates it selected.
+//
+// Fields:
+//   - ledger: the dedup ledger
+//   - pending: the candidates awaiting review
+//   - selected: the candidates named by the command, in order
+type state[C entity.ReviewCandidate] struct {
+	ledger   entity.ReviewLedger
+	pending  []C
+	selected []C
+}
@@ -0,0 +1,18 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package queue holds the review, accept, and reject subcommands
+// shared by "ctx mine" and "ctx align". It is not a command of its
+// own: each parent builds the subcommands from a
+// [review.Spec] naming its queue, its entry parameters, and its
+// output.
+//
+// # Subpackages
+//
+//	cmd/review: pending-candidate listing
+//	cmd/accept, cmd/reject: disposition primitives
+//	core/review: load, select, dispose, and save a queue
+package queue
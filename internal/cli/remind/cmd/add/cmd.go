@@ -21,7 +21,7 @@ import (
 // Returns:
 //   - *cobra.Command: Configured add subcommand
 func Cmd() *cobra.Command {
-	var afterFlag string
+	var afterFlag, everyFlag, branchFlag string
 
 	short, _ := desc.Command(cmd.DescKeyRemindAdd)
 
@@ -31,7 +31,7 @@ func Cmd() *cobra.Command {
 		Example: desc.Example(cmd.DescKeyRemindAdd),
 		Args:    cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return Run(cmd, args[0], afterFlag)
+			return Run(cmd, args[0], afterFlag, everyFlag, branchFlag)
 		},
 	}
 
@@ -39,6 +39,12 @@ func Cmd() *cobra.Command {
 		cFlag.After, cFlag.ShortAfter,
 		flag.DescKeyRemindAddAfter,
 	)
+	flagbind.StringFlag(c, &everyFlag,
+		cFlag.Every, flag.DescKeyRemindAddEvery,
+	)
+	flagbind.StringFlag(c, &branchFlag,
+		cFlag.Branch, flag.DescKeyRemindAddBranch,
+	)
 
 	return c
 }
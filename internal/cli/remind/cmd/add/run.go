@@ -11,6 +11,7 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"github.com/ActiveMemory/ctx/internal/cli/remind/core/schedule"
 	"github.com/ActiveMemory/ctx/internal/cli/remind/core/store"
 	cfgTime "github.com/ActiveMemory/ctx/internal/config/time"
 	errDate "github.com/ActiveMemory/ctx/internal/err/date"
@@ -25,32 +26,51 @@ import (
 //   - cmd: Cobra command for output
 //   - message: Reminder text
 //   - after: Optional date gate in YYYY-MM-DD format (empty string to skip)
+//   - every: Optional recurrence (shorthand or RRULE); a recurring
+//     reminder without a date gate starts today
+//   - branch: Optional git branch the reminder is scoped to
 //
 // Returns:
-//   - error: Non-nil on read/write failure or invalid date
-func Run(cmd *cobra.Command, message, after string) error {
-	reminders, readErr := store.Read()
-	if readErr != nil {
-		return readErr
+//   - error: Non-nil on read/write failure, invalid date, or
+//     invalid recurrence
+func Run(cmd *cobra.Command, message, after, every, branch string) error {
+	if every != "" {
+		if _, ruleErr := schedule.Parse(every); ruleErr != nil {
+			return ruleErr
+		}
+		if after == "" {
+			after = time.Now().Format(cfgTime.DateFormat)
+		}
 	}
 
-	r := store.Reminder{
-		ID:      store.NextID(reminders),
-		Message: message,
-		Created: time.Now().UTC().Format(time.RFC3339),
-	}
-	if after != "" {
-		if _, parseErr := time.Parse(cfgTime.DateFormat, after); parseErr != nil {
-			return errDate.InvalidValue(after)
+	return store.Locked(func() error {
+		reminders, readErr := store.Read()
+		if readErr != nil {
+			return readErr
 		}
-		r.After = &after
-	}
 
-	reminders = append(reminders, r)
-	if writeErr := store.Write(reminders); writeErr != nil {
-		return writeErr
-	}
+		r := store.Reminder{
+			ID:      store.NextID(reminders),
+			Message: message,
+			Created: time.Now().UTC().Format(time.RFC3339),
+			Every:   every,
+			Branch:  branch,
+		}
+		if after != "" {
+			if _, parseErr := time.Parse(cfgTime.DateFormat, after); parseErr != nil {
+				return errDate.InvalidValue(after)
+			}
+			r.After = &after
+		}
+
+		reminders = append(reminders, r)
+		if writeErr := store.Write(reminders); writeErr != nil {
+			return writeErr
+		}
 
-	remind.Added(cmd, r.ID, r.Message, r.After)
-	return nil
+		remind.Added(
+			cmd, r.ID, r.Message, r.After, schedule.Notes(r, time.Now()),
+		)
+		return nil
+	})
 }
@@ -25,7 +25,7 @@ import (
 // Returns:
 //   - *cobra.Command: Configured dismiss subcommand
 func Cmd() *cobra.Command {
-	var allFlag bool
+	var allFlag, stopFlag bool
 
 	short, _ := desc.Command(cmd.DescKeyRemindDismiss)
 
@@ -36,7 +36,7 @@ func Cmd() *cobra.Command {
 		Example: desc.Example(cmd.DescKeyRemindDismiss),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if allFlag {
-				return Run(cmd, nil, allFlag)
+				return Run(cmd, nil, allFlag, stopFlag)
 			}
 			if len(args) == 0 {
 				return errReminder.IDRequired()
@@ -45,13 +45,16 @@ func Cmd() *cobra.Command {
 			if parseErr != nil {
 				return parseErr
 			}
-			return Run(cmd, ids, allFlag)
+			return Run(cmd, ids, allFlag, stopFlag)
 		},
 	}
 
 	flagbind.BoolFlag(c, &allFlag,
 		cFlag.All, flag.DescKeyRemindDismissAll,
 	)
+	flagbind.BoolFlag(c, &stopFlag,
+		cFlag.Stop, flag.DescKeyRemindDismissStop,
+	)
 
 	return c
 }
@@ -10,7 +10,10 @@
 // # Behavior
 //
 // The command removes one or more reminders from the
-// JSON store. Arguments can be individual IDs or
+// JSON store. A recurring reminder is kept instead and
+// moves to its next occurrence (dismissing it before
+// it fires skips that occurrence) unless --stop is
+// set. Arguments can be individual IDs or
 // ranges (e.g., "3 5-7"). When the --all flag is
 // set, all reminders are dismissed regardless of
 // any positional arguments.
@@ -28,10 +31,13 @@
 //	--all    Dismiss every reminder in the store.
 //	         When set, positional ID arguments are
 //	         ignored.
+//	--stop   Remove recurring reminders instead of
+//	         rescheduling them.
 //
 // # Output
 //
-// Each dismissed reminder prints a confirmation line.
+// Each dismissed reminder prints a confirmation line;
+// a rescheduled one shows its next occurrence.
 // When --all is used, a summary of the total count
 // is printed. On failure, returns an error for
 // missing IDs or write problems.
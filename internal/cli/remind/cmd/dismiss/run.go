@@ -12,22 +12,25 @@ import (
 	coreDismiss "github.com/ActiveMemory/ctx/internal/cli/remind/core/dismiss"
 )
 
-// Run dismisses reminders. When all is true, removes every
-// reminder. Otherwise removes the reminders identified by ids.
+// Run dismisses reminders. When all is true, dismisses every
+// reminder. Otherwise dismisses the reminders identified by ids.
+// Recurring reminders move to their next occurrence unless stop
+// is true.
 //
 // Parameters:
 //   - cmd: Cobra command for output
 //   - ids: Reminder IDs to dismiss (ignored when all is true)
 //   - all: When true, dismiss all reminders
+//   - stop: When true, remove recurring reminders outright
 //
 // Returns:
 //   - error: Non-nil on invalid ID, missing reminder,
 //     or write failure
 func Run(
-	cmd *cobra.Command, ids []int, all bool,
+	cmd *cobra.Command, ids []int, all, stop bool,
 ) error {
 	if all {
-		return coreDismiss.All(cmd)
+		return coreDismiss.All(cmd, stop)
 	}
-	return coreDismiss.Many(cmd, ids)
+	return coreDismiss.Many(cmd, ids, stop)
 }
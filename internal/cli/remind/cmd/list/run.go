@@ -11,6 +11,7 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"github.com/ActiveMemory/ctx/internal/cli/remind/core/schedule"
 	"github.com/ActiveMemory/ctx/internal/cli/remind/core/store"
 	cfgTime "github.com/ActiveMemory/ctx/internal/config/time"
 	"github.com/ActiveMemory/ctx/internal/write/remind"
@@ -36,9 +37,12 @@ func Run(cmd *cobra.Command) error {
 		return nil
 	}
 
-	today := time.Now().Format(cfgTime.DateFormat)
+	now := time.Now()
+	today := now.Format(cfgTime.DateFormat)
 	for _, r := range reminders {
-		remind.Item(cmd, r.ID, r.Message, r.After, today)
+		remind.Item(cmd, r.ID, r.Message,
+			schedule.Next(r), schedule.Notes(r, now), today,
+		)
 	}
 
 	return nil
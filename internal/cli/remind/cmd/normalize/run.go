@@ -21,24 +21,26 @@ import (
 // Returns:
 //   - error: Non-nil on read/write failure
 func Run(cmd *cobra.Command) error {
-	reminders, readErr := store.Read()
-	if readErr != nil {
-		return readErr
-	}
-
-	if len(reminders) == 0 {
-		remind.None(cmd)
+	return store.Locked(func() error {
+		reminders, readErr := store.Read()
+		if readErr != nil {
+			return readErr
+		}
+
+		if len(reminders) == 0 {
+			remind.None(cmd)
+			return nil
+		}
+
+		for i := range reminders {
+			reminders[i].ID = i + 1
+		}
+
+		if writeErr := store.Write(reminders); writeErr != nil {
+			return writeErr
+		}
+
+		remind.Normalized(cmd, len(reminders))
 		return nil
-	}
-
-	for i := range reminders {
-		reminders[i].ID = i + 1
-	}
-
-	if writeErr := store.Write(reminders); writeErr != nil {
-		return writeErr
-	}
-
-	remind.Normalized(cmd, len(reminders))
-	return nil
+	})
 }
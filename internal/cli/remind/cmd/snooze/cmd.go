@@ -0,0 +1,40 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package snooze
+
+import (
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	"github.com/ActiveMemory/ctx/internal/config/embed/cmd"
+)
+
+// Cmd returns the remind snooze subcommand.
+//
+// Returns:
+//   - *cobra.Command: Configured snooze subcommand
+func Cmd() *cobra.Command {
+	short, long := desc.Command(cmd.DescKeyRemindSnooze)
+
+	return &cobra.Command{
+		Use:     cmd.UseRemindSnooze,
+		Short:   short,
+		Long:    long,
+		Example: desc.Example(cmd.DescKeyRemindSnooze),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, convErr := strconv.Atoi(args[0])
+			if convErr != nil {
+				return convErr
+			}
+			cmd.SilenceUsage = true
+			return Run(cmd, id, args[1])
+		},
+	}
+}
@@ -0,0 +1,38 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package snooze implements the "ctx remind snooze"
+// subcommand for hiding a reminder for a while.
+//
+// # Behavior
+//
+// The command takes a reminder ID and a duration. The
+// duration is a day or week count (3d, 2w), a Go
+// duration (90m, 4h), or a future date (YYYY-MM-DD,
+// meaning its start). The reminder keeps its schedule
+// but is left out of hook nudges until the snooze
+// ends; ctx remind list marks it as snoozed.
+//
+// Snoozing again replaces the previous snooze, and
+// dismissing the reminder clears it.
+//
+// # Flags
+//
+// None. This command takes no flags.
+//
+// # Output
+//
+// On success, prints the reminder with the local time
+// the snooze ends. Invalid durations and unknown IDs
+// return errors without modifying the store.
+//
+// # Delegation
+//
+// Duration parsing is handled by [schedule.Snooze].
+// The update is a locked read-modify-write through
+// [store.Locked], [store.Read] and [store.Write].
+// Output goes through [remind.Snoozed].
+package snooze
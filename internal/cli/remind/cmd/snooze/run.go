@@ -0,0 +1,59 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package snooze
+
+import (
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/cli/remind/core/schedule"
+	"github.com/ActiveMemory/ctx/internal/cli/remind/core/store"
+	cfgTime "github.com/ActiveMemory/ctx/internal/config/time"
+	errReminder "github.com/ActiveMemory/ctx/internal/err/reminder"
+	"github.com/ActiveMemory/ctx/internal/write/remind"
+)
+
+// Run hides a reminder until the snooze ends.
+//
+// Parameters:
+//   - cmd: Cobra command for output
+//   - id: Reminder ID
+//   - duration: Snooze length (3d, 1w, 4h, or YYYY-MM-DD)
+//
+// Returns:
+//   - error: Non-nil on invalid duration, missing reminder,
+//     or read/write failure
+func Run(cmd *cobra.Command, id int, duration string) error {
+	until, snoozeErr := schedule.Snooze(duration, time.Now())
+	if snoozeErr != nil {
+		return snoozeErr
+	}
+
+	return store.Locked(func() error {
+		reminders, readErr := store.Read()
+		if readErr != nil {
+			return readErr
+		}
+
+		for i := range reminders {
+			if reminders[i].ID != id {
+				continue
+			}
+			stamp := until.UTC().Format(time.RFC3339)
+			reminders[i].Snoozed = &stamp
+			if writeErr := store.Write(reminders); writeErr != nil {
+				return writeErr
+			}
+			remind.Snoozed(cmd, id, reminders[i].Message,
+				until.Local().Format(cfgTime.DateTimeFmt),
+			)
+			return nil
+		}
+		return errReminder.NotFound(id)
+	})
+}
@@ -7,77 +7,107 @@
 package dismiss
 
 import (
+	"time"
+
 	"github.com/spf13/cobra"
 
+	"github.com/ActiveMemory/ctx/internal/cli/remind/core/schedule"
 	"github.com/ActiveMemory/ctx/internal/cli/remind/core/store"
 	errReminder "github.com/ActiveMemory/ctx/internal/err/reminder"
 	"github.com/ActiveMemory/ctx/internal/write/remind"
 )
 
-// Many removes one or more reminders by ID. All IDs are resolved
-// before any deletion to avoid ordering issues.
+// Many dismisses one or more reminders by ID. All IDs are resolved
+// before any change to avoid ordering issues. One-shot reminders
+// are removed; recurring ones move to their next occurrence unless
+// stop is set.
 //
 // Parameters:
 //   - cmd: Cobra command for status output
 //   - ids: Reminder IDs to dismiss
+//   - stop: When true, remove recurring reminders too
 //
 // Returns:
 //   - error: Non-nil on missing reminder or write failure
-func Many(cmd *cobra.Command, ids []int) error {
-	reminders, readErr := store.Read()
-	if readErr != nil {
-		return readErr
-	}
+func Many(cmd *cobra.Command, ids []int, stop bool) error {
+	return store.Locked(func() error {
+		reminders, readErr := store.Read()
+		if readErr != nil {
+			return readErr
+		}
 
-	removeSet := make(map[int]bool, len(ids))
-	for _, id := range ids {
-		found := false
-		for _, r := range reminders {
-			if r.ID == id {
-				found = true
-				break
+		removeSet := make(map[int]bool, len(ids))
+		for _, id := range ids {
+			found := false
+			for _, r := range reminders {
+				if r.ID == id {
+					found = true
+					break
+				}
 			}
+			if !found {
+				return errReminder.NotFound(id)
+			}
+			removeSet[id] = true
 		}
-		if !found {
-			return errReminder.NotFound(id)
-		}
-		removeSet[id] = true
-	}
 
-	var remaining []store.Reminder
-	for _, r := range reminders {
-		if removeSet[r.ID] {
+		now := time.Now()
+		var remaining []store.Reminder
+		for _, r := range reminders {
+			if !removeSet[r.ID] {
+				remaining = append(remaining, r)
+				continue
+			}
+			if kept, recurs := schedule.Dismiss(r, now); recurs && !stop {
+				remind.Rescheduled(cmd, kept.ID, kept.Message,
+					schedule.Next(kept),
+				)
+				remaining = append(remaining, kept)
+				continue
+			}
 			remind.Dismissed(cmd, r.ID, r.Message)
-		} else {
-			remaining = append(remaining, r)
 		}
-	}
 
-	return store.Write(remaining)
+		return store.Write(remaining)
+	})
 }
 
-// All removes every active reminder.
+// All dismisses every active reminder. One-shot reminders are
+// removed; recurring ones move to their next occurrence unless
+// stop is set.
 //
 // Parameters:
 //   - cmd: Cobra command for status output
+//   - stop: When true, remove recurring reminders too
 //
 // Returns:
 //   - error: Non-nil on read or write failure
-func All(cmd *cobra.Command) error {
-	reminders, readErr := store.Read()
-	if readErr != nil {
-		return readErr
-	}
+func All(cmd *cobra.Command, stop bool) error {
+	return store.Locked(func() error {
+		reminders, readErr := store.Read()
+		if readErr != nil {
+			return readErr
+		}
 
-	if len(reminders) == 0 {
-		remind.None(cmd)
-		return nil
-	}
+		if len(reminders) == 0 {
+			remind.None(cmd)
+			return nil
+		}
 
-	for _, r := range reminders {
-		remind.Dismissed(cmd, r.ID, r.Message)
-	}
-	remind.DismissedAll(cmd, len(reminders))
+		now := time.Now()
+		remaining := []store.Reminder{}
+		for _, r := range reminders {
+			if kept, recurs := schedule.Dismiss(r, now); recurs && !stop {
+				remind.Rescheduled(cmd, kept.ID, kept.Message,
+					schedule.Next(kept),
+				)
+				remaining = append(remaining, kept)
+				continue
+			}
+			remind.Dismissed(cmd, r.ID, r.Message)
+		}
+		remind.DismissedAll(cmd, len(reminders))
 
-	return store.Write([]store.Reminder{})
+		return store.Write(remaining)
+	})
 }
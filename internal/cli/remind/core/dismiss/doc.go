@@ -20,11 +20,19 @@
 // Each dismissed reminder produces a confirmation message
 // via [internal/write/remind].
 //
+// # Recurring Reminders
+//
+// A recurring reminder is not removed: [schedule.Dismiss]
+// marks it dismissed through today (or through its next
+// occurrence, when dismissed early) and it is written back,
+// so it fires again on the occurrence after that. The stop
+// argument removes recurring reminders like one-shot ones.
+//
 // # Bulk Dismissal
 //
 // [All] clears every active reminder. If the store is
 // already empty, it prints a "no reminders" message and
 // returns. Otherwise it prints a confirmation for each
-// dismissed reminder and a summary count, then writes an
-// empty slice to the store file.
+// dismissed reminder and a summary count, then writes back
+// only the rescheduled recurring reminders.
 package dismiss
@@ -15,7 +15,7 @@
 //
 // # Subpackages
 //
-// The core package delegates to two subpackages:
+// The core package delegates to three subpackages:
 //
 // The store subpackage handles persistence. [store.Read]
 // loads reminders from the JSON file, returning nil when
@@ -28,11 +28,19 @@
 // The dismiss subpackage handles removal. [dismiss.Many]
 // removes one or more reminders by ID, validating all
 // IDs before any deletion to avoid partial removal.
-// [dismiss.All] clears every active reminder.
+// [dismiss.All] clears every active reminder. Recurring
+// reminders are kept and rescheduled instead.
+//
+// The schedule subpackage decides when reminders fire.
+// [schedule.Parse] reads --every rules, [schedule.Next]
+// computes a reminder's next date, [schedule.Due] picks
+// what the check-reminder hook shows (honoring snoozes
+// and branch scope), and [schedule.Dismiss] and
+// [schedule.Snooze] update the schedule state.
 //
 // # Data Flow
 //
 // The cmd/ layer parses flags and arguments, then calls
-// store or dismiss functions. Output is delegated to
+// store, dismiss, or schedule functions. Output is delegated to
 // the write/remind package for formatted messages.
 package core
@@ -0,0 +1,33 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package schedule decides when a reminder fires: its next
+// occurrence, whether it is due now, and what dismissing or
+// snoozing it does.
+//
+// # Recurrence
+//
+// A reminder with Every set repeats. Every holds a
+// shorthand (daily, weekly, monthly, yearly) or an RFC 5545
+// RRULE subset at day granularity: FREQ, INTERVAL, BYDAY
+// (DAILY and WEEKLY) and BYMONTHDAY (MONTHLY, negative
+// values counting back from month end). [Parse] validates
+// it. The rule is anchored on the reminder's After date.
+//
+// # Dismissal
+//
+// Dismissing a one-shot reminder removes it. Dismissing a
+// recurring one keeps it and records the date it was
+// dismissed through (Dismissed); [Next] then computes the
+// first occurrence after that date. The store stays one
+// JSON array of reminders, so older files load unchanged.
+//
+// # Due
+//
+// [Due] filters what the check-reminder hook shows: not
+// snoozed, on the reminder's branch if it has one, and
+// with its next occurrence today or earlier.
+package schedule
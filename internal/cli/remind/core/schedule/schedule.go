@@ -0,0 +1,222 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package schedule
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	"github.com/ActiveMemory/ctx/internal/cli/remind/core/store"
+	"github.com/ActiveMemory/ctx/internal/config/embed/text"
+	"github.com/ActiveMemory/ctx/internal/config/regex"
+	"github.com/ActiveMemory/ctx/internal/config/reminder"
+	cfgTime "github.com/ActiveMemory/ctx/internal/config/time"
+	errReminder "github.com/ActiveMemory/ctx/internal/err/reminder"
+	execGit "github.com/ActiveMemory/ctx/internal/exec/git"
+	"github.com/ActiveMemory/ctx/internal/i18n"
+	"github.com/ActiveMemory/ctx/internal/io"
+)
+
+// Parse reads a recurrence: a shorthand from reminder.Every or
+// an RRULE such as FREQ=WEEKLY;INTERVAL=2;BYDAY=FR, with or
+// without the RRULE: prefix. Names are case-insensitive.
+//
+// Parameters:
+//   - spec: the --every value
+//
+// Returns:
+//   - Rule: the parsed rule
+//   - error: non-nil when spec is not a supported recurrence
+func Parse(spec string) (Rule, error) {
+	s := strings.TrimSpace(spec)
+	if rrule, ok := reminder.Every[i18n.Fold(s)]; ok {
+		s = rrule
+	}
+	s = strings.TrimPrefix(strings.ToUpper(s), reminder.RulePrefix)
+
+	rule := Rule{Interval: 1}
+	for _, part := range strings.Split(s, reminder.RulePartSep) {
+		if part == "" {
+			continue
+		}
+		name, value, ok := strings.Cut(part, reminder.RuleValueSep)
+		if !ok || !apply(&rule, name, value) {
+			return Rule{}, errReminder.InvalidRule(spec)
+		}
+	}
+	if !valid(rule) {
+		return Rule{}, errReminder.InvalidRule(spec)
+	}
+	return rule, nil
+}
+
+// Next returns the date a reminder next fires.
+//
+// A one-shot reminder fires on its After date, or right away
+// without one. A recurring reminder fires on the first
+// occurrence of its rule on or after After that is later than
+// its Dismissed date. A rule that is no longer valid (edited
+// by hand) is treated as one-shot.
+//
+// Parameters:
+//   - r: the reminder
+//
+// Returns:
+//   - string: YYYY-MM-DD, or empty when due immediately
+func Next(r store.Reminder) string {
+	rule, ruleErr := Parse(r.Every)
+	if r.Every == "" || ruleErr != nil {
+		if r.After == nil {
+			return ""
+		}
+		return *r.After
+	}
+
+	start := anchor(r)
+	from := start
+	if r.Dismissed != nil {
+		if d, ok := day(*r.Dismissed); ok && !d.Before(from) {
+			from = d.AddDate(0, 0, 1)
+		}
+	}
+	// Acceptable discard: with no match in the search span,
+	// first returns the span's end and the reminder sleeps.
+	next, _ := first(rule, start, from)
+	return next.Format(cfgTime.DateFormat)
+}
+
+// Due returns the reminders the check-reminder hook should
+// show at now: not snoozed, on their branch when scoped to
+// one, and next firing today or earlier. The git branch is
+// only looked up when some reminder is branch-scoped.
+//
+// Parameters:
+//   - reminders: all reminders
+//   - now: the current time
+//
+// Returns:
+//   - []store.Reminder: the due reminders, in store order
+func Due(reminders []store.Reminder, now time.Time) []store.Reminder {
+	today := now.Format(cfgTime.DateFormat)
+	branch, looked := "", false
+	var due []store.Reminder
+	for _, r := range reminders {
+		if snoozed(r, now) {
+			continue
+		}
+		if r.Branch != "" {
+			if !looked {
+				branch, looked = execGit.CurrentBranch(), true
+			}
+			if r.Branch != branch {
+				continue
+			}
+		}
+		if next := Next(r); next == "" || next <= today {
+			due = append(due, r)
+		}
+	}
+	return due
+}
+
+// Dismiss applies a dismissal. A recurring reminder is kept
+// and marked dismissed through today, or through its next
+// occurrence when that is still ahead (dismissing early skips
+// it); any snooze is cleared. A one-shot reminder is left for
+// the caller to remove.
+//
+// Parameters:
+//   - r: the reminder being dismissed
+//   - now: the current time
+//
+// Returns:
+//   - store.Reminder: r, rescheduled when recurring
+//   - bool: true when r recurs and should be kept
+func Dismiss(r store.Reminder, now time.Time) (store.Reminder, bool) {
+	if r.Every == "" {
+		return r, false
+	}
+	if _, ruleErr := Parse(r.Every); ruleErr != nil {
+		return r, false
+	}
+	through := now.Format(cfgTime.DateFormat)
+	if next := Next(r); next > through {
+		through = next
+	}
+	r.Dismissed = &through
+	r.Snoozed = nil
+	return r, true
+}
+
+// Snooze returns the time a snooze of the given length ends.
+// Accepted: Go durations (90m, 2h), day and week counts (3d,
+// 1w), or a future date (YYYY-MM-DD, meaning its start).
+//
+// Parameters:
+//   - spec: the snooze length
+//   - now: the current time
+//
+// Returns:
+//   - time.Time: when the reminder shows again
+//   - error: non-nil when spec is not understood or not in
+//     the future
+func Snooze(spec string, now time.Time) (time.Time, error) {
+	if m := regex.SnoozeDays.FindStringSubmatch(spec); m != nil {
+		n, convErr := strconv.Atoi(m[1])
+		if convErr != nil || n < 1 {
+			return time.Time{}, errReminder.InvalidSnooze(spec)
+		}
+		if m[2] == reminder.SnoozeWeek {
+			n *= cfgTime.DaysPerWeek
+		}
+		return now.AddDate(0, 0, n), nil
+	}
+	if d, durErr := time.ParseDuration(spec); durErr == nil && d > 0 {
+		return now.Add(d), nil
+	}
+	t, dateErr := time.ParseInLocation(
+		cfgTime.DateFormat, spec, now.Location(),
+	)
+	if dateErr == nil && t.After(now) {
+		return t, nil
+	}
+	return time.Time{}, errReminder.InvalidSnooze(spec)
+}
+
+// Notes annotates a reminder's schedule for listings: its
+// recurrence, its branch scope, and an active snooze.
+//
+// Parameters:
+//   - r: the reminder
+//   - now: the current time
+//
+// Returns:
+//   - string: space-led annotations, or empty
+func Notes(r store.Reminder, now time.Time) string {
+	var sb strings.Builder
+	if r.Every != "" {
+		io.SafeFprintf(&sb,
+			desc.Text(text.DescKeyReminderNoteEvery), r.Every,
+		)
+	}
+	if r.Branch != "" {
+		io.SafeFprintf(&sb,
+			desc.Text(text.DescKeyReminderNoteBranch), r.Branch,
+		)
+	}
+	if snoozed(r, now) {
+		// Acceptable discard: snoozed already parsed it.
+		until, _ := time.Parse(time.RFC3339, *r.Snoozed)
+		io.SafeFprintf(&sb,
+			desc.Text(text.DescKeyReminderNoteSnoozed),
+			until.Local().Format(cfgTime.DateTimeFmt),
+		)
+	}
+	return sb.String()
+}
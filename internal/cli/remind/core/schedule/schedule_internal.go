@@ -0,0 +1,255 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package schedule
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ActiveMemory/ctx/internal/cli/remind/core/store"
+	"github.com/ActiveMemory/ctx/internal/config/reminder"
+	cfgTime "github.com/ActiveMemory/ctx/internal/config/time"
+)
+
+// apply sets one RRULE part on rule.
+//
+// Parameters:
+//   - rule: the rule being built
+//   - name: part name (upper case)
+//   - value: part value (upper case)
+//
+// Returns:
+//   - bool: false for an unknown part or a bad value
+func apply(rule *Rule, name, value string) bool {
+	switch name {
+	case reminder.RuleFreq:
+		switch value {
+		case reminder.FreqDaily, reminder.FreqWeekly,
+			reminder.FreqMonthly, reminder.FreqYearly:
+			rule.Freq = value
+			return true
+		}
+		return false
+	case reminder.RuleInterval:
+		n, convErr := strconv.Atoi(value)
+		rule.Interval = n
+		return convErr == nil && n >= 1 && n <= reminder.MaxInterval
+	case reminder.RuleByDay:
+		for _, code := range strings.Split(value, reminder.RuleListSep) {
+			wd, ok := reminder.Weekdays[code]
+			if !ok {
+				return false
+			}
+			rule.ByDay = append(rule.ByDay, wd)
+		}
+		return true
+	case reminder.RuleByMonthDay:
+		for _, v := range strings.Split(value, reminder.RuleListSep) {
+			n, convErr := strconv.Atoi(v)
+			if convErr != nil || n == 0 ||
+				n > reminder.MaxMonthDay || n < -reminder.MaxMonthDay {
+				return false
+			}
+			rule.ByMonthDay = append(rule.ByMonthDay, n)
+		}
+		return true
+	default:
+		return false
+	}
+}
+
+// valid reports whether the parts of a rule fit together:
+// FREQ is set, BYDAY only with DAILY or WEEKLY, BYMONTHDAY
+// only with MONTHLY.
+//
+// Parameters:
+//   - rule: the parsed rule
+//
+// Returns:
+//   - bool: true when the rule can be evaluated
+func valid(rule Rule) bool {
+	if rule.Freq == "" {
+		return false
+	}
+	if len(rule.ByDay) > 0 && rule.Freq != reminder.FreqDaily &&
+		rule.Freq != reminder.FreqWeekly {
+		return false
+	}
+	return len(rule.ByMonthDay) == 0 || rule.Freq == reminder.FreqMonthly
+}
+
+// anchor is the date a recurring reminder's rule counts from:
+// its After date, else the local date it was created.
+//
+// Parameters:
+//   - r: the reminder
+//
+// Returns:
+//   - time.Time: midnight UTC of the anchor date
+func anchor(r store.Reminder) time.Time {
+	if r.After != nil {
+		if d, ok := day(*r.After); ok {
+			return d
+		}
+	}
+	created, parseErr := time.Parse(time.RFC3339, r.Created)
+	if parseErr != nil {
+		created = time.Now()
+	}
+	// Acceptable discard: a formatted date always parses.
+	d, _ := day(created.Local().Format(cfgTime.DateFormat))
+	return d
+}
+
+// day parses a YYYY-MM-DD date as midnight UTC, so day
+// arithmetic is free of DST shifts.
+//
+// Parameters:
+//   - s: the date
+//
+// Returns:
+//   - time.Time: the date at midnight UTC
+//   - bool: false when s is not a date
+func day(s string) (time.Time, bool) {
+	d, parseErr := time.Parse(cfgTime.DateFormat, s)
+	return d, parseErr == nil
+}
+
+// first finds the first occurrence of rule on or after from.
+//
+// Parameters:
+//   - rule: the recurrence
+//   - start: the anchor date
+//   - from: the earliest acceptable date (not before start)
+//
+// Returns:
+//   - time.Time: the occurrence, or the end of the search
+//     span when there is none
+//   - bool: false when nothing matched
+func first(rule Rule, start, from time.Time) (time.Time, bool) {
+	span := reminder.SearchYears * reminder.DaysPerYear * rule.Interval
+	limit := from.AddDate(0, 0, span)
+	for d := from; d.Before(limit); d = d.AddDate(0, 0, 1) {
+		if occurs(rule, start, d) {
+			return d, true
+		}
+	}
+	return limit, false
+}
+
+// occurs reports whether rule fires on day d.
+//
+// Parameters:
+//   - rule: the recurrence
+//   - start: the anchor date
+//   - d: a date not before start
+//
+// Returns:
+//   - bool: true when d is an occurrence
+func occurs(rule Rule, start, d time.Time) bool {
+	switch rule.Freq {
+	case reminder.FreqDaily:
+		return days(start, d)%rule.Interval == 0 &&
+			(len(rule.ByDay) == 0 || onWeekday(rule.ByDay, d))
+	case reminder.FreqWeekly:
+		weeks := days(monday(start), monday(d)) / cfgTime.DaysPerWeek
+		weekdays := rule.ByDay
+		if len(weekdays) == 0 {
+			weekdays = []time.Weekday{start.Weekday()}
+		}
+		return weeks%rule.Interval == 0 && onWeekday(weekdays, d)
+	case reminder.FreqMonthly:
+		months := (d.Year()-start.Year())*cfgTime.MonthsPerYear +
+			int(d.Month()-start.Month())
+		monthDays := rule.ByMonthDay
+		if len(monthDays) == 0 {
+			monthDays = []int{start.Day()}
+		}
+		return months%rule.Interval == 0 && onMonthDay(monthDays, d)
+	default:
+		return d.Month() == start.Month() && d.Day() == start.Day() &&
+			(d.Year()-start.Year())%rule.Interval == 0
+	}
+}
+
+// days counts whole days from a to b.
+//
+// Parameters:
+//   - a, b: midnight UTC dates
+//
+// Returns:
+//   - int: b minus a in days
+func days(a, b time.Time) int {
+	return int(b.Sub(a) / (cfgTime.HoursPerDay * time.Hour))
+}
+
+// monday returns the Monday starting d's week (RRULE's
+// default week start).
+//
+// Parameters:
+//   - d: a date
+//
+// Returns:
+//   - time.Time: the Monday on or before d
+func monday(d time.Time) time.Time {
+	back := (int(d.Weekday()) - int(time.Monday) + cfgTime.DaysPerWeek) %
+		cfgTime.DaysPerWeek
+	return d.AddDate(0, 0, -back)
+}
+
+// onWeekday reports whether d falls on one of weekdays.
+//
+// Parameters:
+//   - weekdays: the allowed weekdays
+//   - d: a date
+//
+// Returns:
+//   - bool: true on a match
+func onWeekday(weekdays []time.Weekday, d time.Time) bool {
+	for _, wd := range weekdays {
+		if d.Weekday() == wd {
+			return true
+		}
+	}
+	return false
+}
+
+// onMonthDay reports whether d is one of monthDays, where a
+// negative value counts back from the month's last day.
+//
+// Parameters:
+//   - monthDays: the allowed days of the month
+//   - d: a date
+//
+// Returns:
+//   - bool: true on a match
+func onMonthDay(monthDays []int, d time.Time) bool {
+	last := time.Date(d.Year(), d.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day()
+	for _, md := range monthDays {
+		if md == d.Day() || (md < 0 && last+md+1 == d.Day()) {
+			return true
+		}
+	}
+	return false
+}
+
+// snoozed reports whether r is snoozed past now.
+//
+// Parameters:
+//   - r: the reminder
+//   - now: the current time
+//
+// Returns:
+//   - bool: true while the snooze lasts
+func snoozed(r store.Reminder, now time.Time) bool {
+	if r.Snoozed == nil {
+		return false
+	}
+	until, parseErr := time.Parse(time.RFC3339, *r.Snoozed)
+	return parseErr == nil && now.Before(until)
+}
@@ -0,0 +1,200 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package schedule
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ActiveMemory/ctx/internal/cli/remind/core/store"
+)
+
+func ptr(s string) *string { return &s }
+
+func TestParse(t *testing.T) {
+	valid := []string{
+		"daily", "Weekly", "monthly", "yearly",
+		"FREQ=WEEKLY;INTERVAL=2;BYDAY=FR", "FREQ=YEARLY;INTERVAL=366",
+		"RRULE:FREQ=MONTHLY;BYMONTHDAY=-1",
+		"freq=daily;byday=mo,tu,we,th,fr",
+	}
+	for _, spec := range valid {
+		if _, err := Parse(spec); err != nil {
+			t.Errorf("Parse(%q) = %v, want nil", spec, err)
+		}
+	}
+
+	invalid := []string{
+		"", "fortnightly", "FREQ=HOURLY", "INTERVAL=2",
+		"FREQ=WEEKLY;INTERVAL=0", "FREQ=DAILY;INTERVAL=7000000;BYDAY=TU",
+		"FREQ=WEEKLY;BYDAY=XX",
+		"FREQ=MONTHLY;BYMONTHDAY=32", "FREQ=MONTHLY;BYDAY=MO",
+		"FREQ=WEEKLY;BYMONTHDAY=1", "FREQ=DAILY;COUNT=3",
+		"0 9 * * 1",
+	}
+	for _, spec := range invalid {
+		if _, err := Parse(spec); err == nil {
+			t.Errorf("Parse(%q) = nil, want error", spec)
+		}
+	}
+}
+
+func TestNext(t *testing.T) {
+	tests := []struct {
+		name      string
+		every     string
+		after     string
+		dismissed string
+		want      string
+	}{
+		// 2026-01-01 is a Thursday.
+		{"one-shot", "", "2026-03-01", "", "2026-03-01"},
+		{"daily from start", "daily", "2026-01-01", "", "2026-01-01"},
+		{"daily after dismissal", "daily", "2026-01-01",
+			"2026-01-05", "2026-01-06"},
+		{"weekly on start weekday", "weekly", "2026-01-01",
+			"2026-01-01", "2026-01-08"},
+		{"weekly on friday", "FREQ=WEEKLY;BYDAY=FR", "2026-01-01",
+			"", "2026-01-02"},
+		{"fortnightly friday", "FREQ=WEEKLY;INTERVAL=2;BYDAY=FR",
+			"2026-01-01", "2026-01-02", "2026-01-16"},
+		{"monthly on the 31st skips short months",
+			"monthly", "2026-01-31", "2026-01-31", "2026-03-31"},
+		{"last day of month", "FREQ=MONTHLY;BYMONTHDAY=-1",
+			"2026-02-01", "", "2026-02-28"},
+		{"yearly", "yearly", "2024-02-29", "2024-02-29", "2028-02-29"},
+		{"weekdays", "FREQ=DAILY;BYDAY=MO,TU,WE,TH,FR", "2026-01-01",
+			"2026-01-02", "2026-01-05"},
+		{"dismissal before start", "daily", "2026-01-10",
+			"2026-01-01", "2026-01-10"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := store.Reminder{Every: tt.every, After: ptr(tt.after)}
+			if tt.dismissed != "" {
+				r.Dismissed = ptr(tt.dismissed)
+			}
+			if got := Next(r); got != tt.want {
+				t.Errorf("Next = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	if got := Next(store.Reminder{}); got != "" {
+		t.Errorf("Next(no gate) = %q, want empty", got)
+	}
+}
+
+func TestDismiss(t *testing.T) {
+	now := time.Date(2026, 1, 7, 12, 0, 0, 0, time.Local)
+
+	oneShot := store.Reminder{ID: 1}
+	if _, keep := Dismiss(oneShot, now); keep {
+		t.Error("one-shot reminder kept, want removed")
+	}
+
+	// Overdue since Friday 2026-01-02: dismissing on Wednesday
+	// acknowledges it and the next Friday is up.
+	weekly := store.Reminder{
+		ID: 2, Every: "FREQ=WEEKLY;BYDAY=FR",
+		After: ptr("2026-01-01"), Snoozed: ptr("2026-01-08T00:00:00Z"),
+	}
+	got, keep := Dismiss(weekly, now)
+	if !keep {
+		t.Fatal("recurring reminder removed, want kept")
+	}
+	if got.Snoozed != nil {
+		t.Error("snooze not cleared")
+	}
+	if next := Next(got); next != "2026-01-09" {
+		t.Errorf("Next after dismiss = %q, want 2026-01-09", next)
+	}
+
+	// Dismissed again before Friday: that occurrence is skipped.
+	got, _ = Dismiss(got, now)
+	if next := Next(got); next != "2026-01-16" {
+		t.Errorf("Next after early dismiss = %q, want 2026-01-16", next)
+	}
+}
+
+func TestSnooze(t *testing.T) {
+	now := time.Date(2026, 1, 7, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		spec string
+		want time.Time
+	}{
+		{"3d", now.AddDate(0, 0, 3)},
+		{"2w", now.AddDate(0, 0, 14)},
+		{"90m", now.Add(90 * time.Minute)},
+		{"2026-02-01", time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		got, err := Snooze(tt.spec, now)
+		if err != nil {
+			t.Errorf("Snooze(%q) error: %v", tt.spec, err)
+			continue
+		}
+		if !got.Equal(tt.want) {
+			t.Errorf("Snooze(%q) = %v, want %v", tt.spec, got, tt.want)
+		}
+	}
+
+	for _, spec := range []string{"", "0d", "-1h", "soon", "2025-01-01"} {
+		if _, err := Snooze(spec, now); err == nil {
+			t.Errorf("Snooze(%q) = nil error, want error", spec)
+		}
+	}
+}
+
+func TestDue(t *testing.T) {
+	t.Chdir(t.TempDir())
+	now := time.Date(2026, 1, 7, 12, 0, 0, 0, time.Local)
+
+	reminders := []store.Reminder{
+		{ID: 1, Message: "now"},
+		{ID: 2, Message: "later", After: ptr("2026-02-01")},
+		{ID: 3, Message: "snoozed",
+			Snoozed: ptr(now.Add(time.Hour).Format(time.RFC3339))},
+		{ID: 4, Message: "woke",
+			Snoozed: ptr(now.Add(-time.Hour).Format(time.RFC3339))},
+		{ID: 5, Message: "other branch", Branch: "feature/x"},
+		{ID: 6, Message: "weekly", Every: "weekly",
+			After: ptr("2025-12-31"), Dismissed: ptr("2025-12-31")},
+	}
+	var ids []int
+	for _, r := range Due(reminders, now) {
+		ids = append(ids, r.ID)
+	}
+	want := []int{1, 4, 6}
+	if len(ids) != len(want) {
+		t.Fatalf("Due IDs = %v, want %v", ids, want)
+	}
+	for i := range want {
+		if ids[i] != want[i] {
+			t.Fatalf("Due IDs = %v, want %v", ids, want)
+		}
+	}
+}
+
+func TestNotes(t *testing.T) {
+	now := time.Date(2026, 1, 7, 12, 0, 0, 0, time.Local)
+	r := store.Reminder{
+		Every: "weekly", Branch: "main",
+		Snoozed: ptr(now.Add(time.Hour).Format(time.RFC3339)),
+	}
+	got := Notes(r, now)
+	for _, want := range []string{"every weekly", "branch main", "snoozed"} {
+		if !strings.Contains(got, want) {
+			t.Errorf("Notes = %q, want %q", got, want)
+		}
+	}
+	if got := Notes(store.Reminder{}, now); got != "" {
+		t.Errorf("Notes(plain) = %q, want empty", got)
+	}
+}
@@ -0,0 +1,26 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package schedule
+
+import "time"
+
+// Rule is a parsed recurrence.
+//
+// Fields:
+//   - Freq: reminder.FreqDaily, FreqWeekly, FreqMonthly or
+//     FreqYearly
+//   - Interval: repeat every Interval periods (at least 1)
+//   - ByDay: weekdays; for WEEKLY the days it fires (default:
+//     the start date's weekday), for DAILY a filter
+//   - ByMonthDay: days of the month for MONTHLY (default: the
+//     start date's day); negative counts from month end
+type Rule struct {
+	Freq       string
+	Interval   int
+	ByDay      []time.Weekday
+	ByMonthDay []int
+}
@@ -16,6 +16,12 @@
 // creation timestamp, and an optional date gate (After)
 // that defers the reminder until a specific date.
 //
+// Scheduling fields are optional and omitted when empty:
+// Every (recurrence rule), Branch (git branch scope),
+// Dismissed (date a recurring reminder was last dismissed
+// through) and Snoozed (RFC 3339 time a snooze ends). The
+// core/schedule package interprets them.
+//
 // # CRUD Operations
 //
 //   - [Read] loads all reminders from the JSON file.
@@ -18,6 +18,7 @@ import (
 	errReminder "github.com/ActiveMemory/ctx/internal/err/reminder"
 	"github.com/ActiveMemory/ctx/internal/io"
 	"github.com/ActiveMemory/ctx/internal/rc"
+	"github.com/ActiveMemory/ctx/internal/txn"
 )
 
 // Read reads all reminders from the JSON file.
@@ -46,7 +47,8 @@ func Read() ([]Reminder, error) {
 	return reminders, nil
 }
 
-// Write writes all reminders to the JSON file.
+// Write writes all reminders to the JSON file, replacing it
+// atomically. Callers that read first hold the lock via [Locked].
 //
 // Parameters:
 //   - reminders: The reminder slice to persist
@@ -64,7 +66,24 @@ func Write(reminders []Reminder) error {
 	if pathErr != nil {
 		return pathErr
 	}
-	return io.SafeWriteFile(path, data, fs.PermFile)
+	return io.SafeWriteFileAtomic(path, data, fs.PermFile)
+}
+
+// Locked runs fn under the context directory's write lock. A Read,
+// change, and Write inside fn is never interleaved with another
+// writer's, so concurrent adds and dismissals are not lost.
+//
+// Parameters:
+//   - fn: the read-modify-write to run
+//
+// Returns:
+//   - error: a context-directory or lock failure, or fn's error
+func Locked(fn func() error) error {
+	ctxDir, ctxErr := rc.ContextDir()
+	if ctxErr != nil {
+		return ctxErr
+	}
+	return txn.Do(ctxDir, fn)
 }
 
 // NextID returns the next available reminder ID
@@ -13,10 +13,21 @@ package store
 //   - Message: Reminder text
 //   - Created: ISO 8601 creation timestamp
 //   - After: Optional trigger date (YYYY-MM-DD), nil for
-//     immediate
+//     immediate; the first occurrence of a recurring reminder
+//   - Every: Optional recurrence (an RRULE); empty for a
+//     one-shot reminder
+//   - Branch: Optional git branch the reminder is scoped to
+//   - Dismissed: Date (YYYY-MM-DD) a recurring reminder was
+//     last dismissed through; it next fires after this date
+//   - Snoozed: Optional RFC 3339 time the reminder is hidden
+//     until
 type Reminder struct {
-	ID      int     `json:"id"`
-	Message string  `json:"message"`
-	Created string  `json:"created"`
-	After   *string `json:"after"` // nullable YYYY-MM-DD
+	ID        int     `json:"id"`
+	Message   string  `json:"message"`
+	Created   string  `json:"created"`
+	After     *string `json:"after"` // nullable YYYY-MM-DD
+	Every     string  `json:"every,omitempty"`
+	Branch    string  `json:"branch,omitempty"`
+	Dismissed *string `json:"dismissed,omitempty"`
+	Snoozed   *string `json:"snoozed,omitempty"`
 }
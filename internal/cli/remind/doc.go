@@ -17,31 +17,40 @@
 // # Subcommands
 //
 //   - **`ctx remind add <text>`**: appends a reminder
-//     with optional `--after <YYYY-MM-DD>` date gate
-//     and `--once` (auto-dismiss after first surface).
+//     with optional `--after <YYYY-MM-DD>` date gate,
+//     `--every <rule>` recurrence (daily, weekly,
+//     monthly, yearly, or an RRULE) and `--branch`
+//     scoping.
 //   - **`ctx remind list`**: prints all open
-//     reminders (with date-gating respected).
-//   - **`ctx remind dismiss <id>`**: marks one
-//     reminder dismissed (or `--all`).
+//     reminders with their next date and schedule.
+//   - **`ctx remind dismiss <id>`**: removes one
+//     reminder (or `--all`); a recurring one moves to
+//     its next occurrence unless `--stop` is given.
+//   - **`ctx remind snooze <id> <duration>`**: hides
+//     a reminder until the snooze ends.
 //
 // # The Surface Path
 //
 // At session start, the `checkreminder` system hook
 // (`internal/cli/system/cmd/checkreminder`) reads the
-// reminder store, filters by date and dismissal
-// status, and emits the un-dismissed entries through
-// the VERBATIM relay so the user (and the agent) both
-// see them as the first interaction of the session.
+// reminder store, keeps the reminders that are due
+// (by date gate, or by next occurrence after the last
+// dismissal), not snoozed, and on the current branch,
+// and emits them through the VERBATIM relay so the
+// user (and the agent) both see them as the first
+// interaction of the session.
 //
 // # Storage
 //
-// `.context/state/reminders.jsonl`: append-only,
-// one [Reminder] per line. Dismissals are recorded as
-// new lines (state-machine-over-log style); the
-// reader collapses to the latest state per ID.
+// `.context/reminders.json`: a JSON array of reminders.
+// Recurrence, branch, dismissal and snooze state are
+// optional fields, so files written before they existed
+// read unchanged.
 //
 // # Concurrency
 //
-// Filesystem-bound and stateless. Concurrent writes
-// would race on the JSONL append; ctx is single-process.
+// Filesystem-bound and stateless. Every read-modify-write
+// of reminders.json runs under the context directory's write
+// lock (store.Locked) and commits with a temp-file rename, so
+// concurrent agents and hooks never drop each other's changes.
 package remind
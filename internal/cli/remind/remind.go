@@ -14,6 +14,7 @@ import (
 	"github.com/ActiveMemory/ctx/internal/cli/remind/cmd/dismiss"
 	"github.com/ActiveMemory/ctx/internal/cli/remind/cmd/list"
 	remindNormalize "github.com/ActiveMemory/ctx/internal/cli/remind/cmd/normalize"
+	"github.com/ActiveMemory/ctx/internal/cli/remind/cmd/snooze"
 	"github.com/ActiveMemory/ctx/internal/config/embed/cmd"
 	"github.com/ActiveMemory/ctx/internal/config/embed/flag"
 	cFlag "github.com/ActiveMemory/ctx/internal/config/flag"
@@ -28,7 +29,7 @@ import (
 // Returns:
 //   - *cobra.Command: Configured remind command with subcommands
 func Cmd() *cobra.Command {
-	var afterFlag string
+	var afterFlag, everyFlag, branchFlag string
 
 	short, long := desc.Command(cmd.DescKeyRemind)
 
@@ -40,7 +41,9 @@ func Cmd() *cobra.Command {
 		Args:    cobra.ArbitraryArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if len(args) > 0 {
-				return add.Run(cmd, args[0], afterFlag)
+				return add.Run(
+					cmd, args[0], afterFlag, everyFlag, branchFlag,
+				)
 			}
 			return list.Run(cmd)
 		},
@@ -50,11 +53,18 @@ func Cmd() *cobra.Command {
 		cFlag.After, cFlag.ShortAfter,
 		flag.DescKeyRemindAfter,
 	)
+	flagbind.StringFlag(c, &everyFlag,
+		cFlag.Every, flag.DescKeyRemindEvery,
+	)
+	flagbind.StringFlag(c, &branchFlag,
+		cFlag.Branch, flag.DescKeyRemindBranch,
+	)
 
 	c.AddCommand(add.Cmd())
 	c.AddCommand(list.Cmd())
 	c.AddCommand(dismiss.Cmd())
 	c.AddCommand(remindNormalize.Cmd())
+	c.AddCommand(snooze.Cmd())
 
 	return c
 }
@@ -266,3 +266,99 @@ func TestDismiss_All(t *testing.T) {
 		t.Errorf("got %d reminders, want 0", len(reminders))
 	}
 }
+
+func TestAdd_Every(t *testing.T) {
+	setup(t)
+
+	out, err := runCmd(newRemindCmd(
+		"add", "rotate the pad key", "--every", "monthly",
+		"--branch", "main",
+	))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(out, "(every monthly)") ||
+		!strings.Contains(out, "(branch main)") {
+		t.Errorf("output = %q, want schedule notes", out)
+	}
+
+	reminders, readErr := store.Read()
+	if readErr != nil {
+		t.Fatalf("read reminders: %v", readErr)
+	}
+	r := reminders[0]
+	if r.Every != "monthly" || r.Branch != "main" || r.After == nil {
+		t.Errorf("reminder = %+v, want every, branch and start date", r)
+	}
+}
+
+func TestAdd_InvalidEvery(t *testing.T) {
+	setup(t)
+
+	_, err := runCmd(newRemindCmd("add", "test", "--every", "FREQ=HOURLY"))
+	if err == nil {
+		t.Fatal("expected error for unsupported recurrence")
+	}
+}
+
+func TestDismiss_Recurring(t *testing.T) {
+	setup(t)
+
+	_, _ = runCmd(newRemindCmd("add", "weekly review", "--every", "weekly"))
+
+	out, err := runCmd(newRemindCmd("dismiss", "1"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(out, "(next: ") {
+		t.Errorf("output = %q, want next occurrence", out)
+	}
+
+	reminders, readErr := store.Read()
+	if readErr != nil {
+		t.Fatalf("read reminders: %v", readErr)
+	}
+	if len(reminders) != 1 || reminders[0].Dismissed == nil {
+		t.Fatalf("reminders = %+v, want one kept and dismissed", reminders)
+	}
+
+	if _, stopErr := runCmd(newRemindCmd("dismiss", "1", "--stop")); stopErr != nil {
+		t.Fatalf("unexpected error: %v", stopErr)
+	}
+	reminders, readErr = store.Read()
+	if readErr != nil {
+		t.Fatalf("read reminders: %v", readErr)
+	}
+	if len(reminders) != 0 {
+		t.Errorf("got %d reminders after --stop, want 0", len(reminders))
+	}
+}
+
+func TestSnooze(t *testing.T) {
+	setup(t)
+
+	_, _ = runCmd(newRemindCmd("add", "check CI"))
+
+	out, err := runCmd(newRemindCmd("snooze", "1", "3d"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(out, "snoozed until") {
+		t.Errorf("output = %q, want snooze confirmation", out)
+	}
+
+	list, listErr := runCmd(newRemindCmd("list"))
+	if listErr != nil {
+		t.Fatalf("unexpected error: %v", listErr)
+	}
+	if !strings.Contains(list, "(snoozed until") {
+		t.Errorf("list = %q, want snooze note", list)
+	}
+
+	if _, badErr := runCmd(newRemindCmd("snooze", "1", "later")); badErr == nil {
+		t.Error("expected error for invalid duration")
+	}
+	if _, missErr := runCmd(newRemindCmd("snooze", "9", "1d")); missErr == nil {
+		t.Error("expected error for unknown ID")
+	}
+}
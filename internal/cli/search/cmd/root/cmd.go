@@ -0,0 +1,66 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package root
+
+import (
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	"github.com/ActiveMemory/ctx/internal/config/embed/cmd"
+	"github.com/ActiveMemory/ctx/internal/config/embed/flag"
+	cFlag "github.com/ActiveMemory/ctx/internal/config/flag"
+	cfgSearch "github.com/ActiveMemory/ctx/internal/config/search"
+	"github.com/ActiveMemory/ctx/internal/config/token"
+	"github.com/ActiveMemory/ctx/internal/flagbind"
+)
+
+// Cmd returns the "ctx search" command.
+//
+// All positional arguments are joined into one query, so quoting the whole
+// query is optional; quotes inside it still mark phrases.
+//
+// Flags:
+//   - --limit: Maximum matches to print (0 = all)
+//   - --json: Emit a JSON array of hits instead of lines
+//   - --rebuild: Discard the stored index and rebuild it first
+//
+// Returns:
+//   - *cobra.Command: Configured search command with flags registered.
+func Cmd() *cobra.Command {
+	var (
+		limit      int
+		jsonOutput bool
+		rebuild    bool
+	)
+
+	short, long := desc.Command(cmd.DescKeySearch)
+	c := &cobra.Command{
+		Use:     cmd.UseSearch,
+		Short:   short,
+		Long:    long,
+		Example: desc.Example(cmd.DescKeySearch),
+		Args:    cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return Run(cmd, strings.Join(args, token.Space),
+				limit, jsonOutput, rebuild)
+		},
+	}
+
+	flagbind.IntFlag(c, &limit,
+		cFlag.Limit, cfgSearch.DefaultLimit, flag.DescKeySearchLimit,
+	)
+	flagbind.BoolFlag(c, &jsonOutput,
+		cFlag.JSON, flag.DescKeySearchJSON,
+	)
+	flagbind.BoolFlag(c, &rebuild,
+		cFlag.Rebuild, flag.DescKeySearchRebuild,
+	)
+
+	return c
+}
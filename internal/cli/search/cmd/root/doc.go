@@ -0,0 +1,12 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package root wires the `ctx search` cobra command.
+//
+// Cmd builds the command (query words as arguments, --limit, --json and
+// --rebuild flags); Run opens or rebuilds the index in [internal/search],
+// runs the query, and hands the hits to [internal/write/search].
+package root
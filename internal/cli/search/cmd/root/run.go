@@ -0,0 +1,64 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package root
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/rc"
+	"github.com/ActiveMemory/ctx/internal/search"
+	writeSearch "github.com/ActiveMemory/ctx/internal/write/search"
+)
+
+// Run executes the search command logic: refresh (or rebuild) the index,
+// rank the query, and render the hits.
+//
+// Parameters:
+//   - cmd: Cobra command for the output stream.
+//   - query: Query text (words, quoted phrases, type: and since:).
+//   - limit: Maximum matches to print; 0 or less prints all.
+//   - jsonOutput: If true, emit a JSON array instead of lines.
+//   - rebuild: If true, discard the stored index first.
+//
+// Returns:
+//   - error: Non-nil if no context directory exists, the index cannot be
+//     read or written, or the query is malformed.
+func Run(
+	cmd *cobra.Command,
+	query string, limit int, jsonOutput, rebuild bool,
+) error {
+	ctxDir, ctxErr := rc.RequireContextDir()
+	if ctxErr != nil {
+		cmd.SilenceUsage = true
+		return ctxErr
+	}
+
+	open := search.Open
+	if rebuild {
+		open = search.Rebuild
+	}
+	ix, openErr := open(ctxDir)
+	if openErr != nil {
+		cmd.SilenceUsage = true
+		return openErr
+	}
+
+	hits, searchErr := ix.Search(query, limit)
+	if searchErr != nil {
+		return searchErr
+	}
+
+	if jsonOutput {
+		return writeSearch.JSON(cmd, hits)
+	}
+	if len(hits) == 0 {
+		writeSearch.None(cmd, query)
+		return nil
+	}
+	writeSearch.Hits(cmd, hits)
+	return nil
+}
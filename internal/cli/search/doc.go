@@ -0,0 +1,22 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package search implements the `ctx search` command: ranked full-text
+// search over the context directory.
+//
+// `ctx search <query...>` ranks decisions, learnings, conventions, tasks,
+// archived entries, journal sessions, and knowledge-base pages with BM25 at
+// entry granularity. Quoted phrases must match exactly; `type:` and `since:`
+// narrow the result set. The index lives in .context/state/ and is refreshed
+// incrementally on every search, so there is no separate build step.
+//
+// The index lives in [internal/search]; this package is the CLI surface and
+// delegates rendering to [internal/write/search].
+//
+// # Subpackages
+//
+//	cmd/root: cobra command wiring (query args, --limit, --json, --rebuild)
+package search
@@ -0,0 +1,22 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package search
+
+import (
+	"github.com/spf13/cobra"
+
+	searchRoot "github.com/ActiveMemory/ctx/internal/cli/search/cmd/root"
+)
+
+// Cmd returns the "ctx search" command, which ranks context entries,
+// journal sessions, and knowledge-base pages against a query.
+//
+// Returns:
+//   - *cobra.Command: The search command.
+func Cmd() *cobra.Command {
+	return searchRoot.Cmd()
+}
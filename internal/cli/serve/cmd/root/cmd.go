@@ -11,31 +11,51 @@ import (
 
 	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
 	"github.com/ActiveMemory/ctx/internal/config/embed/cmd"
+	"github.com/ActiveMemory/ctx/internal/config/embed/flag"
+	cFlag "github.com/ActiveMemory/ctx/internal/config/flag"
+	cfgSite "github.com/ActiveMemory/ctx/internal/config/site"
+	"github.com/ActiveMemory/ctx/internal/flagbind"
 )
 
 // Cmd returns the serve command.
 //
-// Serves a static site locally via zensical. With no argument,
-// serves the journal site at .context/journal-site. With a
-// directory argument, serves that directory if it contains a
-// zensical.toml.
+// Serves a static site locally with the built-in HTTP server.
+// With no argument, serves the journal site at
+// .context/journal-site. With a directory argument, serves
+// that directory. A Markdown tree without rendered HTML is
+// rendered first. --zensical restores the zensical-backed
+// server, which needs a zensical.toml.
 //
 // This command does NOT start a ctx Hub; for that, use
 // `ctx hub start`.
 //
 // Returns:
-//   - *cobra.Command: The serve command
+//   - *cobra.Command: Configured serve command
 func Cmd() *cobra.Command {
+	var (
+		addr        string
+		useZensical bool
+	)
+
 	short, long := desc.Command(cmd.DescKeyServe)
 
-	return &cobra.Command{
+	c := &cobra.Command{
 		Use:     cmd.UseServe,
 		Short:   short,
 		Long:    long,
 		Example: desc.Example(cmd.DescKeyServe),
 		Args:    cobra.MaximumNArgs(1),
-		RunE: func(_ *cobra.Command, args []string) error {
-			return Run(args)
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return Run(cmd, args, addr, useZensical)
 		},
 	}
+
+	flagbind.StringFlagDefault(
+		c, &addr, cFlag.Addr, cfgSite.DefaultAddr, flag.DescKeyServeAddr,
+	)
+	flagbind.BoolFlag(
+		c, &useZensical, cFlag.Zensical, flag.DescKeyServeZensical,
+	)
+
+	return c
 }
@@ -5,43 +5,44 @@
 //                 SPDX-License-Identifier: Apache-2.0
 
 // Package root implements the "ctx serve" command
-// for running a local static site server via
-// zensical.
+// for running a local static site server.
 //
 // # Behavior
 //
-// The command starts a local development server for
-// a zensical-powered static site. With no arguments,
-// it serves the journal site located at
-// .context/journal-site. With a directory argument,
-// it serves that directory instead.
+// The command starts a local HTTP server for a static
+// site. With no arguments, it serves the journal site
+// located at .context/journal-site. With a directory
+// argument, it serves that directory instead.
 //
 // Before starting, the command validates that the
-// target directory exists, is actually a directory
-// (not a file), and contains a zensical.toml
-// configuration file. Missing or invalid targets
-// produce clear error messages.
+// target directory exists and is actually a directory
+// (not a file). The directory may hold a rendered site,
+// a generated journal-site project, or a bare Markdown
+// tree; Markdown that has not been rendered yet is
+// rendered with the built-in renderer first. A
+// directory with none of these produces a clear error.
 //
 // This command does NOT start a ctx Hub server. For
 // Hub functionality, use "ctx hub start" instead.
 //
 // # Flags
 //
-// None. This command takes no flags.
+//	--addr       Listen address (default 127.0.0.1:8000).
+//	--zensical   Delegate to "zensical serve"; the
+//	             directory must hold a zensical.toml.
 //
 // # Output
 //
-// The command delegates to the zensical binary, which
-// handles its own output (server URL, request logs).
-// On startup failure, returns an error identifying
-// the problem (missing directory, missing config,
-// or zensical not found).
+// The command reports pages rendered and the listen
+// address, then serves until interrupted. With
+// --zensical, the zensical binary handles its own
+// output.
 //
 // # Delegation
 //
-// Directory validation is done inline. Site serving
-// is delegated to [execZensical.Run] which locates
-// and executes the zensical binary. The default
-// journal site path is constructed from
-// [rc.ContextDir] and [dir.JournalSite].
+// Site resolution and serving are delegated to
+// [host.Dir]. The zensical path is delegated to
+// [execZensical.Run]. The default journal site path is
+// constructed from [rc.ContextDir] and
+// [dir.JournalSite].
 package root
@@ -10,6 +10,9 @@ import (
 	"os"
 	"path/filepath"
 
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/cli/serve/core/host"
 	"github.com/ActiveMemory/ctx/internal/config/dir"
 	"github.com/ActiveMemory/ctx/internal/config/zensical"
 	"github.com/ActiveMemory/ctx/internal/err/fs"
@@ -20,13 +23,22 @@ import (
 
 // Run handles the serve command.
 //
+// Validates the target directory and hosts it with the
+// built-in server (see [host.Dir]), or hands it to zensical
+// when useZensical is set.
+//
 // Parameters:
-//   - args: Optional directory to serve
+//   - cmd: Cobra command for output
+//   - args: Optional directory argument
+//   - addr: Listen address (host:port)
+//   - useZensical: If true, delegate to zensical serve
 //
 // Returns:
-//   - error: Non-nil if directory is invalid, config is missing,
-//     or zensical is not found
-func Run(args []string) error {
+//   - error: Non-nil if the directory is invalid, holds no
+//     site, or the server fails
+func Run(
+	cmd *cobra.Command, args []string, addr string, useZensical bool,
+) error {
 	var d string
 
 	if len(args) > 0 {
@@ -48,11 +60,14 @@ func Run(args []string) error {
 		return fs.NotDirectory(d)
 	}
 
-	// Check zensical.toml exists
-	tomlPath := filepath.Join(d, zensical.Toml)
-	if _, statErr = os.Stat(tomlPath); os.IsNotExist(statErr) {
-		return errSite.NoConfig(d)
+	if useZensical {
+		// Check zensical.toml exists
+		tomlPath := filepath.Join(d, zensical.Toml)
+		if _, statErr = os.Stat(tomlPath); os.IsNotExist(statErr) {
+			return errSite.NoConfig(d)
+		}
+		return execZensical.Run(d, zensical.CmdServe)
 	}
 
-	return execZensical.Run(d, zensical.CmdServe)
+	return host.Dir(cmd, d, addr)
 }
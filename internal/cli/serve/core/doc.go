@@ -0,0 +1,19 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package core contains business logic for the serve
+// command.
+//
+// This package delegates its work to the host
+// subpackage. It does not export functions directly.
+//
+// # Hosting (host/)
+//
+// The host subpackage provides [host.Dir], which finds
+// the rendered site inside a directory, renders Markdown
+// trees that have no HTML yet, and serves the result
+// until interrupted.
+package core
@@ -0,0 +1,18 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package host resolves what `ctx serve` should host inside
+// a directory and serves it with the built-in renderer and
+// HTTP server.
+//
+// [Dir] accepts three shapes of directory: a rendered site
+// with an index.html, a generated journal-site project
+// (zensical.toml + docs/ + optional site/), and a bare
+// Markdown tree with an index.md. Markdown that has not been
+// rendered yet is rendered through
+// [github.com/ActiveMemory/ctx/internal/site.Build] before
+// serving, so no external site builder is required.
+package host
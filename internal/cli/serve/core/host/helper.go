@@ -0,0 +1,63 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package host
+
+import (
+	"net"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	renderSite "github.com/ActiveMemory/ctx/internal/site"
+	writeSite "github.com/ActiveMemory/ctx/internal/write/site"
+)
+
+// exists reports whether a regular file exists.
+//
+// Parameters:
+//   - p: file path
+//
+// Returns:
+//   - bool: true when p is an existing regular file
+func exists(p string) bool {
+	info, statErr := os.Stat(p)
+	return statErr == nil && info.Mode().IsRegular()
+}
+
+// build renders a Markdown tree with directory navigation.
+//
+// Parameters:
+//   - cmd: Cobra command for output
+//   - src: Markdown source root
+//   - dst: HTML output directory
+//   - title: site title
+//
+// Returns:
+//   - error: Non-nil if rendering fails
+func build(cmd *cobra.Command, src, dst, title string) error {
+	pages, buildErr := renderSite.Build(src, dst, title, nil)
+	if buildErr != nil {
+		return buildErr
+	}
+	writeSite.Built(cmd, pages, dst)
+	return nil
+}
+
+// serve hosts a rendered site until interrupted.
+//
+// Parameters:
+//   - cmd: Cobra command for output
+//   - siteDir: rendered site root
+//   - addr: listen address
+//
+// Returns:
+//   - error: Non-nil if the server cannot start
+func serve(cmd *cobra.Command, siteDir, addr string) error {
+	return renderSite.Serve(siteDir, addr, func(bound net.Addr) {
+		writeSite.Serving(cmd, siteDir, bound)
+	})
+}
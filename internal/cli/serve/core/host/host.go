@@ -0,0 +1,70 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package host
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/config/dir"
+	"github.com/ActiveMemory/ctx/internal/config/file"
+	cfgSite "github.com/ActiveMemory/ctx/internal/config/site"
+	"github.com/ActiveMemory/ctx/internal/err/fs"
+	errSite "github.com/ActiveMemory/ctx/internal/err/site"
+)
+
+// Dir serves a directory with the built-in HTTP server until
+// interrupted.
+//
+// The directory is resolved in order: a rendered site
+// (index.html) is served as-is; a generated project with a
+// rendered site/ subdirectory serves that; a generated
+// project with only docs/index.md is rendered into site/
+// first; a bare Markdown tree with index.md is rendered into
+// a temporary directory that is removed on exit.
+//
+// Parameters:
+//   - cmd: Cobra command for output
+//   - d: directory to serve
+//   - addr: listen address (host:port)
+//
+// Returns:
+//   - error: Non-nil if d holds no site, rendering fails, or
+//     the server cannot start
+func Dir(cmd *cobra.Command, d, addr string) error {
+	title := filepath.Base(filepath.Clean(d))
+	siteDir := filepath.Join(d, cfgSite.Dir)
+	docsDir := filepath.Join(d, dir.JournalDocs)
+	switch {
+	case exists(filepath.Join(d, cfgSite.IndexHTML)):
+		return serve(cmd, d, addr)
+	case exists(filepath.Join(siteDir, cfgSite.IndexHTML)):
+		return serve(cmd, siteDir, addr)
+	case exists(filepath.Join(docsDir, file.Index)):
+		if buildErr := build(cmd, docsDir, siteDir, title); buildErr != nil {
+			return buildErr
+		}
+		return serve(cmd, siteDir, addr)
+	case exists(filepath.Join(d, file.Index)):
+		tmp, tmpErr := os.MkdirTemp("", cfgSite.TempPattern)
+		if tmpErr != nil {
+			return fs.Mkdir(tmp, tmpErr)
+		}
+		defer func() {
+			// Acceptable discard: the temporary render is
+			// disposable; a leftover directory is harmless.
+			_ = os.RemoveAll(tmp)
+		}()
+		if buildErr := build(cmd, d, tmp, title); buildErr != nil {
+			return buildErr
+		}
+		return serve(cmd, tmp, addr)
+	}
+	return errSite.NotFound(d)
+}
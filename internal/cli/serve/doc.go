@@ -8,9 +8,10 @@
 // serving static sites locally.
 //
 // The serve command starts a local HTTP server that hosts
-// the journal site or any specified directory. It uses
-// zensical as the static site engine and opens the
-// default browser to the served URL on startup.
+// the journal site or any specified directory. Markdown
+// trees are rendered with the built-in Go renderer, so no
+// external site builder is needed; --zensical delegates to
+// zensical instead.
 //
 // # Use Cases
 //
@@ -22,6 +23,8 @@
 //
 // # Subpackages
 //
-//   - cmd/root: cobra command definition, HTTP server
-//     setup, and browser launch
+//   - cmd/root: cobra command definition, flags, and
+//     directory validation
+//   - core/host: site resolution, rendering, and the
+//     HTTP server
 package serve
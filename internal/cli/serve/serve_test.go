@@ -61,7 +61,9 @@ func TestCmd_AcceptsArgs(t *testing.T) {
 }
 
 func TestRunServe_DirNotFound(t *testing.T) {
-	err := serveRoot.Run([]string{"/tmp/nonexistent-dir-ctx-test-xyz"})
+	err := serveRoot.Run(
+		Cmd(), []string{"/tmp/nonexistent-dir-ctx-test-xyz"}, "", false,
+	)
 	if err == nil {
 		t.Fatal("expected error for nonexistent directory")
 	}
@@ -78,7 +80,7 @@ func TestRunServe_NotADirectory(t *testing.T) {
 	defer func() { _ = os.Remove(tmpFile.Name()) }()
 	_ = tmpFile.Close()
 
-	serveErr := serveRoot.Run([]string{tmpFile.Name()})
+	serveErr := serveRoot.Run(Cmd(), []string{tmpFile.Name()}, "", false)
 	if serveErr == nil {
 		t.Fatal("expected error for non-directory path")
 	}
@@ -94,7 +96,7 @@ func TestRunServe_NoSiteConfig(t *testing.T) {
 	}
 	defer func() { _ = os.RemoveAll(tmpDir) }()
 
-	serveErr := serveRoot.Run([]string{tmpDir})
+	serveErr := serveRoot.Run(Cmd(), []string{tmpDir}, "", true)
 	if serveErr == nil {
 		t.Fatal("expected error for missing zensical.toml")
 	}
@@ -103,6 +105,29 @@ func TestRunServe_NoSiteConfig(t *testing.T) {
 	}
 }
 
+func TestRunServe_NoSite(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	serveErr := serveRoot.Run(Cmd(), []string{tmpDir}, "", false)
+	if serveErr == nil {
+		t.Fatal("expected error for a directory without a site")
+	}
+	if !strings.Contains(serveErr.Error(), "no site found") {
+		t.Errorf("unexpected error: %v", serveErr)
+	}
+}
+
+func TestCmd_Flags(t *testing.T) {
+	cmd := Cmd()
+	addr := cmd.Flags().Lookup("addr")
+	if addr == nil || addr.DefValue != "127.0.0.1:8000" {
+		t.Errorf("--addr flag = %v, want default 127.0.0.1:8000", addr)
+	}
+	if cmd.Flags().Lookup("zensical") == nil {
+		t.Error("--zensical flag missing")
+	}
+}
+
 func TestRunServe_ZensicalNotFound(t *testing.T) {
 	tmpDir, err := os.MkdirTemp("", "ctx-serve-test-*")
 	if err != nil {
@@ -119,7 +144,7 @@ func TestRunServe_ZensicalNotFound(t *testing.T) {
 	// Ensure zensical is not in PATH
 	t.Setenv("PATH", "")
 
-	serveErr := serveRoot.Run([]string{tmpDir})
+	serveErr := serveRoot.Run(Cmd(), []string{tmpDir}, "", true)
 	if serveErr == nil {
 		t.Fatal("expected error for missing zensical binary")
 	}
@@ -145,7 +170,7 @@ func TestRunServe_DefaultDir(t *testing.T) {
 	rc.Reset()
 	t.Cleanup(rc.Reset)
 
-	err := serveRoot.Run([]string{})
+	err := serveRoot.Run(Cmd(), []string{}, "", false)
 	if err == nil {
 		t.Fatal("expected error when default dir doesn't exist")
 	}
@@ -227,7 +252,7 @@ func TestRunServe_WithMockZensical(t *testing.T) {
 	origPath := os.Getenv("PATH")
 	t.Setenv("PATH", binDir+":"+origPath)
 
-	serveErr := serveRoot.Run([]string{tmpDir})
+	serveErr := serveRoot.Run(Cmd(), []string{tmpDir}, "", true)
 	if serveErr != nil {
 		t.Errorf("unexpected error: %v", serveErr)
 	}
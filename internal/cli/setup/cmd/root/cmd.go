@@ -10,6 +10,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	coreBackend "github.com/ActiveMemory/ctx/internal/cli/setup/core/backend"
 	"github.com/ActiveMemory/ctx/internal/config/cli"
 	"github.com/ActiveMemory/ctx/internal/config/embed/cmd"
 	"github.com/ActiveMemory/ctx/internal/config/embed/flag"
@@ -22,14 +23,20 @@ import (
 // The command outputs configuration snippets and instructions for integrating
 // Context with various AI coding tools like Claude Code, Cursor, Aider, etc.
 //
+// With --backend it instead writes an AI backend entry into .ctxrc
+// and takes no tool argument.
+//
 // Flags:
 //   - --write, -w: Write the configuration file instead of printing
+//   - --backend: Configure an AI backend (with --endpoint,
+//     --api-key-env, --model, --timeout)
 //
 // Returns:
 //   - *cobra.Command: Configured setup command that
 //     accepts a tool name argument
 func Cmd() *cobra.Command {
 	var write bool
+	var opts coreBackend.Opts
 
 	short, long := desc.Command(cmd.DescKeySetup)
 	c := &cobra.Command{
@@ -38,8 +45,16 @@ func Cmd() *cobra.Command {
 		Annotations: map[string]string{cli.AnnotationSkipInit: cli.AnnotationTrue},
 		Long:        long,
 		Example:     desc.Example(cmd.DescKeySetup),
-		Args:        cobra.ExactArgs(1),
+		Args: func(cmd *cobra.Command, args []string) error {
+			if opts.Name != "" {
+				return cobra.NoArgs(cmd, args)
+			}
+			return cobra.ExactArgs(1)(cmd, args)
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.Name != "" {
+				return coreBackend.Deploy(cmd, opts)
+			}
 			return Run(cmd, args, write)
 		},
 	}
@@ -48,6 +63,17 @@ func Cmd() *cobra.Command {
 		cFlag.Write, cFlag.ShortWrite,
 		flag.DescKeySetupWrite,
 	)
+	flagbind.StringFlag(c, &opts.Name, cFlag.Backend, flag.DescKeySetupBackend)
+	flagbind.StringFlag(c, &opts.Endpoint,
+		cFlag.Endpoint, flag.DescKeySetupEndpoint,
+	)
+	flagbind.StringFlag(c, &opts.APIKeyEnv,
+		cFlag.APIKeyEnv, flag.DescKeySetupAPIKeyEnv,
+	)
+	flagbind.StringFlag(c, &opts.Model, cFlag.Model, flag.DescKeySetupModel)
+	flagbind.StringFlag(c, &opts.Timeout,
+		cFlag.Timeout, flag.DescKeySetupTimeout,
+	)
 
 	return c
 }
@@ -0,0 +1,99 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package backend
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	engine "github.com/ActiveMemory/ctx/internal/backend"
+	cfgBackend "github.com/ActiveMemory/ctx/internal/config/backend"
+	"github.com/ActiveMemory/ctx/internal/config/file"
+	"github.com/ActiveMemory/ctx/internal/entity"
+	errBackend "github.com/ActiveMemory/ctx/internal/err/backend"
+	"github.com/ActiveMemory/ctx/internal/rc"
+	writeSetup "github.com/ActiveMemory/ctx/internal/write/setup"
+)
+
+// Deploy writes one backend entry into the project's .ctxrc.
+//
+// Parameters:
+//   - cmd: cobra command for output
+//   - opts: the --backend flag values
+//
+// Returns:
+//   - error: no context directory, unknown backend type, malformed
+//     .ctxrc, an invalid entry, or a write failure
+func Deploy(cmd *cobra.Command, opts Opts) error {
+	contextDir, ctxErr := rc.RequireContextDir()
+	if ctxErr != nil {
+		cmd.SilenceUsage = true
+		return ctxErr
+	}
+	path := filepath.Join(filepath.Dir(contextDir), file.CtxRC)
+	name := strings.TrimSpace(opts.Name)
+
+	doc, loadErr := loadDoc(path)
+	if loadErr != nil {
+		return loadErr
+	}
+	backends := child(doc.Content[0], cfgBackend.KeyBackends)
+	entry := child(backends, name)
+	set(entry, cfgBackend.KeyEndpoint, opts.Endpoint)
+	set(entry, cfgBackend.KeyAPIKeyEnv, opts.APIKeyEnv)
+	set(entry, cfgBackend.KeyTimeout, opts.Timeout)
+	set(entry, cfgBackend.KeyModel, opts.Model)
+
+	var e entity.BackendEntry
+	if decodeErr := entry.Decode(&e); decodeErr != nil {
+		return errBackend.ParseConfig(path, decodeErr)
+	}
+	if e.APIKeyEnv == "" {
+		e.APIKeyEnv = cfgBackend.APIKeyEnv[name]
+	}
+	cfg, resolveErr := engine.Resolve(name, e)
+	if resolveErr != nil {
+		return resolveErr
+	}
+
+	// Make the resolved endpoint and credential variable explicit so
+	// the file documents what the AI commands will use.
+	set(entry, cfgBackend.KeyEndpoint, cfg.Endpoint)
+	set(entry, cfgBackend.KeyAPIKeyEnv, cfg.APIKeyEnv)
+	madeDefault := false
+	if value(backends, cfgBackend.KeyDefault) == "" {
+		set(backends, cfgBackend.KeyDefault, name)
+		madeDefault = true
+	}
+
+	if saveErr := saveDoc(path, doc); saveErr != nil {
+		return saveErr
+	}
+
+	writeSetup.BackendConfigured(cmd, name, cfg.Endpoint, path)
+	if madeDefault {
+		writeSetup.BackendDefault(cmd, name)
+	}
+	baseURL := cfg.Endpoint + cfgBackend.PathVersion
+	current := os.Getenv(cfgBackend.EnvOpenAIBaseURL)
+	if current != "" && current != baseURL {
+		writeSetup.BackendEnvConflict(cmd, cfgBackend.EnvOpenAIBaseURL, current)
+	} else {
+		writeSetup.BackendEnvHint(
+			cmd, cfgBackend.EnvOpenAIBaseURL,
+			cfg.Endpoint, cfgBackend.PathVersion,
+		)
+	}
+	if cfg.APIKeyEnv != "" && os.Getenv(cfg.APIKeyEnv) == "" {
+		writeSetup.BackendKeyUnset(cmd, cfg.APIKeyEnv)
+	}
+	writeSetup.BackendNext(cmd, name)
+	return nil
+}
@@ -0,0 +1,139 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package backend
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/testutil/testctx"
+)
+
+// newProject declares a temp project with the given .ctxrc body
+// ("" for no file) and returns the .ctxrc path.
+func newProject(t *testing.T, body string) string {
+	t.Helper()
+	root := t.TempDir()
+	if err := os.MkdirAll(testctx.Declare(t, root), 0o750); err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(root, ".ctxrc")
+	if body != "" {
+		if err := os.WriteFile(path, []byte(body), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return path
+}
+
+func deploy(t *testing.T, opts Opts) (string, error) {
+	t.Helper()
+	var out bytes.Buffer
+	c := &cobra.Command{}
+	c.SetOut(&out)
+	c.SetErr(&out)
+	err := Deploy(c, opts)
+	return out.String(), err
+}
+
+func read(t *testing.T, path string) string {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(data)
+}
+
+func TestDeploy_NewFile(t *testing.T) {
+	path := newProject(t, "")
+	t.Setenv("OPENAI_BASE_URL", "")
+
+	out, err := deploy(t, Opts{Name: "vllm", Model: "gpt-oss"})
+	if err != nil {
+		t.Fatalf("Deploy: %v", err)
+	}
+	got := read(t, path)
+	for _, want := range []string{
+		"backends:", "  vllm:", "    endpoint: http://localhost:8000",
+		"    default_model: gpt-oss", "  default: vllm",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf(".ctxrc missing %q:\n%s", want, got)
+		}
+	}
+	if !strings.Contains(out, "OPENAI_BASE_URL=http://localhost:8000/v1") {
+		t.Errorf("missing base URL hint:\n%s", out)
+	}
+}
+
+func TestDeploy_MergesAndKeepsDefault(t *testing.T) {
+	path := newProject(t,
+		"# project settings\ntoken_budget: 4000\n"+
+			"backends:\n  default: vllm\n  vllm: {}\n")
+	t.Setenv("OPENAI_BASE_URL", "http://elsewhere/v1")
+	t.Setenv("OPENAI_API_KEY", "")
+
+	out, err := deploy(t, Opts{Name: "openai", Timeout: "60s"})
+	if err != nil {
+		t.Fatalf("Deploy: %v", err)
+	}
+	got := read(t, path)
+	for _, want := range []string{
+		"# project settings", "token_budget: 4000", "default: vllm",
+		"endpoint: https://api.openai.com", "api_key_env: OPENAI_API_KEY",
+		"timeout: 60s",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf(".ctxrc missing %q:\n%s", want, got)
+		}
+	}
+	if strings.Contains(out, "backends.default") {
+		t.Errorf("existing default must be kept:\n%s", out)
+	}
+	if !strings.Contains(out, "already set to http://elsewhere/v1") {
+		t.Errorf("missing env conflict warning:\n%s", out)
+	}
+	if !strings.Contains(out, "Export OPENAI_API_KEY") {
+		t.Errorf("missing key reminder:\n%s", out)
+	}
+}
+
+func TestDeploy_RefusesInvalidEntry(t *testing.T) {
+	body := "token_budget: 4000\n"
+	path := newProject(t, body)
+
+	tests := []struct {
+		name string
+		opts Opts
+		want string
+	}{
+		{"unknown type", Opts{Name: "gpt"}, "unknown backend"},
+		{"bad endpoint", Opts{Name: "vllm", Endpoint: "gpu:8000"},
+			"must be an http:// or https:// URL"},
+		{"bad timeout", Opts{Name: "vllm", Timeout: "-1s"},
+			"backends.vllm.timeout"},
+		{"generic without endpoint", Opts{Name: "openai-compatible"},
+			"backends.openai-compatible.endpoint is required"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := deploy(t, tt.opts)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("Deploy error = %v, want %q", err, tt.want)
+			}
+			if got := read(t, path); got != body {
+				t.Errorf(".ctxrc changed on failure:\n%s", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,21 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package backend implements ctx setup --backend: it writes one AI
+// backend entry into the project's .ctxrc backends table.
+//
+// [Deploy] merges the flags into backends.<name> (endpoint,
+// api_key_env, timeout, default_model), fills the type's default
+// endpoint and credential variable, validates the entry the same
+// way AI commands do, and sets backends.default when none is set.
+// The file is edited as a YAML node tree, so other keys and
+// comments survive.
+//
+// The API key is never written: only the name of the environment
+// variable that holds it. The OPENAI_BASE_URL hint for downstream
+// tools is printed, never exported, and a conflicting value already
+// in the environment is reported and left alone.
+package backend
@@ -0,0 +1,150 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package backend
+
+import (
+	"bytes"
+	"errors"
+	"os"
+
+	"gopkg.in/yaml.v3"
+
+	cfgBackend "github.com/ActiveMemory/ctx/internal/config/backend"
+	"github.com/ActiveMemory/ctx/internal/config/fs"
+	errBackend "github.com/ActiveMemory/ctx/internal/err/backend"
+	ctxIo "github.com/ActiveMemory/ctx/internal/io"
+)
+
+// loadDoc reads .ctxrc as a YAML document whose root is a mapping.
+// A missing or empty file yields an empty document.
+//
+// Parameters:
+//   - path: the .ctxrc path
+//
+// Returns:
+//   - *yaml.Node: the document node
+//   - error: on read failure or a root that is not a mapping
+func loadDoc(path string) (*yaml.Node, error) {
+	doc := &yaml.Node{
+		Kind:    yaml.DocumentNode,
+		Content: []*yaml.Node{{Kind: yaml.MappingNode}},
+	}
+	data, readErr := ctxIo.SafeReadUserFile(path)
+	if readErr != nil {
+		if errors.Is(readErr, os.ErrNotExist) {
+			return doc, nil
+		}
+		return nil, errBackend.ParseConfig(path, readErr)
+	}
+	var parsed yaml.Node
+	if parseErr := yaml.Unmarshal(data, &parsed); parseErr != nil {
+		return nil, errBackend.ParseConfig(path, parseErr)
+	}
+	if len(parsed.Content) == 0 {
+		return doc, nil
+	}
+	if parsed.Content[0].Kind != yaml.MappingNode {
+		return nil, errBackend.NotMapping(path)
+	}
+	return &parsed, nil
+}
+
+// saveDoc writes the document back with the repo's YAML indent.
+//
+// Parameters:
+//   - path: the .ctxrc path
+//   - doc: the document node
+//
+// Returns:
+//   - error: on encode or write failure
+func saveDoc(path string, doc *yaml.Node) error {
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(cfgBackend.YAMLIndent)
+	if encodeErr := enc.Encode(doc); encodeErr != nil {
+		return errBackend.WriteFile(path, encodeErr)
+	}
+	if closeErr := enc.Close(); closeErr != nil {
+		return errBackend.WriteFile(path, closeErr)
+	}
+	if writeErr := ctxIo.SafeWriteFile(
+		path, buf.Bytes(), fs.PermFile,
+	); writeErr != nil {
+		return errBackend.WriteFile(path, writeErr)
+	}
+	return nil
+}
+
+// child returns the mapping under key, creating it (or replacing a
+// non-mapping value) when needed. Flow style is cleared so added
+// keys render one per line.
+//
+// Parameters:
+//   - m: the parent mapping
+//   - key: the child key
+//
+// Returns:
+//   - *yaml.Node: the child mapping
+func child(m *yaml.Node, key string) *yaml.Node {
+	for i := 0; i+1 < len(m.Content); i += 2 {
+		if m.Content[i].Value != key {
+			continue
+		}
+		v := m.Content[i+1]
+		if v.Kind != yaml.MappingNode {
+			*v = yaml.Node{Kind: yaml.MappingNode}
+		}
+		v.Style = 0
+		return v
+	}
+	k := &yaml.Node{}
+	k.SetString(key)
+	v := &yaml.Node{Kind: yaml.MappingNode}
+	m.Content = append(m.Content, k, v)
+	return v
+}
+
+// value returns the scalar under key, or "" when absent.
+//
+// Parameters:
+//   - m: the mapping
+//   - key: the key
+//
+// Returns:
+//   - string: the scalar value
+func value(m *yaml.Node, key string) string {
+	for i := 0; i+1 < len(m.Content); i += 2 {
+		if m.Content[i].Value == key {
+			return m.Content[i+1].Value
+		}
+	}
+	return ""
+}
+
+// set assigns a scalar under key; an empty val leaves the mapping
+// unchanged.
+//
+// Parameters:
+//   - m: the mapping
+//   - key: the key
+//   - val: the value
+func set(m *yaml.Node, key, val string) {
+	if val == "" {
+		return
+	}
+	for i := 0; i+1 < len(m.Content); i += 2 {
+		if m.Content[i].Value == key {
+			m.Content[i+1].SetString(val)
+			return
+		}
+	}
+	k := &yaml.Node{}
+	k.SetString(key)
+	v := &yaml.Node{}
+	v.SetString(val)
+	m.Content = append(m.Content, k, v)
+}
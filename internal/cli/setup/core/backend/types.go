@@ -0,0 +1,25 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package backend
+
+// Opts holds the ctx setup --backend flag values.
+//
+// Fields:
+//   - Name: Backend type to configure
+//   - Endpoint: Base URL override ("" keeps the entry's or the
+//     type default)
+//   - APIKeyEnv: Credential environment variable ("" keeps the
+//     entry's or the type default)
+//   - Model: Default model ("" keeps the entry's)
+//   - Timeout: Per-request timeout ("" keeps the entry's)
+type Opts struct {
+	Name      string
+	Endpoint  string
+	APIKeyEnv string
+	Model     string
+	Timeout   string
+}
@@ -14,8 +14,10 @@ import (
 	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
 	"github.com/ActiveMemory/ctx/internal/config/dir"
 	"github.com/ActiveMemory/ctx/internal/config/embed/cmd"
+	cfgSkill "github.com/ActiveMemory/ctx/internal/config/skill"
 	"github.com/ActiveMemory/ctx/internal/rc"
 	"github.com/ActiveMemory/ctx/internal/skill"
+	"github.com/ActiveMemory/ctx/internal/txn"
 	writeSkill "github.com/ActiveMemory/ctx/internal/write/skill"
 )
 
@@ -38,14 +40,16 @@ func Cmd() *cobra.Command {
 	}
 }
 
-// Run installs a skill from the given source directory.
+// Run installs a skill from the given source directory and
+// records it in skills.lock.
 //
 // Parameters:
 //   - c: The cobra command for output
 //   - source: Path to the source directory containing SKILL.md
 //
 // Returns:
-//   - error: nil on success, or a skill installation error
+//   - error: nil on success, or a skill installation or lockfile
+//     error
 func Run(c *cobra.Command, source string) error {
 	ctxDir, ctxErr := rc.RequireContextDir()
 	if ctxErr != nil {
@@ -53,12 +57,29 @@ func Run(c *cobra.Command, source string) error {
 		return ctxErr
 	}
 	skillsDir := filepath.Join(ctxDir, dir.Skills)
+	lockPath := filepath.Join(ctxDir, cfgSkill.LockFile)
 
-	sk, err := skill.Install(source, skillsDir)
-	if err != nil {
-		return err
-	}
+	return txn.Do(ctxDir, func() error {
+		lock, readErr := skill.ReadLock(lockPath)
+		if readErr != nil {
+			return readErr
+		}
+
+		sk, err := skill.Install(source, skillsDir)
+		if err != nil {
+			return err
+		}
+
+		if trackErr := skill.Track(
+			lock, sk, source, filepath.Dir(ctxDir),
+		); trackErr != nil {
+			return trackErr
+		}
+		if writeErr := skill.WriteLock(lockPath, lock); writeErr != nil {
+			return writeErr
+		}
 
-	writeSkill.Installed(c, sk.Name, sk.Dir)
-	return nil
+		writeSkill.Installed(c, sk.Name, sk.Dir)
+		return nil
+	})
 }
@@ -22,6 +22,14 @@
 // skill becomes immediately available for use in
 // agent sessions.
 //
+// Each install is recorded in .context/skills.lock:
+// the source (relative to the project root when it
+// lies inside the project), the frontmatter version,
+// the commit and origin of the git checkout holding
+// an outside source, and the SHA-256 of the installed
+// files. The source is always a local directory, so
+// installing works offline.
+//
 // # Flags
 //
 // None. This command takes no flags.
@@ -37,7 +45,9 @@
 //
 // Skill installation logic is handled by
 // [skill.Install], which parses the manifest and
-// copies files. The skills directory path is
+// copies files. [skill.Track] records the lock entry,
+// written by [skill.WriteLock] under the context
+// directory's write lock ([txn.Do]). The skills directory path is
 // constructed from [rc.ContextDir] and [dir.Skills].
 // Output is routed through [writeSkill.Installed].
 package install
@@ -14,8 +14,10 @@ import (
 	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
 	"github.com/ActiveMemory/ctx/internal/config/dir"
 	"github.com/ActiveMemory/ctx/internal/config/embed/cmd"
+	cfgSkill "github.com/ActiveMemory/ctx/internal/config/skill"
 	"github.com/ActiveMemory/ctx/internal/rc"
 	"github.com/ActiveMemory/ctx/internal/skill"
+	"github.com/ActiveMemory/ctx/internal/txn"
 	writeSkill "github.com/ActiveMemory/ctx/internal/write/skill"
 )
 
@@ -38,14 +40,15 @@ func Cmd() *cobra.Command {
 	}
 }
 
-// Run removes an installed skill by name.
+// Run removes an installed skill by name and drops its
+// skills.lock entry.
 //
 // Parameters:
 //   - c: The cobra command for output
 //   - name: The skill name to remove
 //
 // Returns:
-//   - error: nil on success, or a skill removal error
+//   - error: nil on success, or a skill removal or lockfile error
 func Run(c *cobra.Command, name string) error {
 	ctxDir, ctxErr := rc.RequireContextDir()
 	if ctxErr != nil {
@@ -53,11 +56,27 @@ func Run(c *cobra.Command, name string) error {
 		return ctxErr
 	}
 	skillsDir := filepath.Join(ctxDir, dir.Skills)
+	lockPath := filepath.Join(ctxDir, cfgSkill.LockFile)
 
-	if err := skill.Remove(skillsDir, name); err != nil {
-		return err
-	}
+	return txn.Do(ctxDir, func() error {
+		lock, readErr := skill.ReadLock(lockPath)
+		if readErr != nil {
+			return readErr
+		}
+
+		if err := skill.Remove(skillsDir, name); err != nil {
+			return err
+		}
+
+		locked := len(lock.Skills)
+		skill.Untrack(lock, name)
+		if len(lock.Skills) != locked {
+			if writeErr := skill.WriteLock(lockPath, lock); writeErr != nil {
+				return writeErr
+			}
+		}
 
-	writeSkill.Removed(c, name)
-	return nil
+		writeSkill.Removed(c, name)
+		return nil
+	})
 }
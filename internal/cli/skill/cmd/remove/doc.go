@@ -16,6 +16,9 @@
 // deletes the entire directory along with all
 // contained files.
 //
+// The skill's entry in .context/skills.lock, if any,
+// is dropped as well.
+//
 // If the named skill does not exist, the command
 // returns an error. Removal is immediate and
 // permanent with no confirmation prompt.
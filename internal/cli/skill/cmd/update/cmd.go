@@ -0,0 +1,102 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package update
+
+import (
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	"github.com/ActiveMemory/ctx/internal/config/dir"
+	"github.com/ActiveMemory/ctx/internal/config/embed/cmd"
+	"github.com/ActiveMemory/ctx/internal/config/embed/flag"
+	cFlag "github.com/ActiveMemory/ctx/internal/config/flag"
+	cfgSkill "github.com/ActiveMemory/ctx/internal/config/skill"
+	"github.com/ActiveMemory/ctx/internal/flagbind"
+	"github.com/ActiveMemory/ctx/internal/rc"
+	"github.com/ActiveMemory/ctx/internal/skill"
+	"github.com/ActiveMemory/ctx/internal/txn"
+	writeSkill "github.com/ActiveMemory/ctx/internal/write/skill"
+)
+
+// Cmd returns the "ctx skill update" subcommand.
+//
+// Returns:
+//   - *cobra.Command: Configured update subcommand
+func Cmd() *cobra.Command {
+	var force bool
+
+	short, long := desc.Command(cmd.DescKeySkillUpdate)
+
+	c := &cobra.Command{
+		Use:     cmd.UseSkillUpdate,
+		Short:   short,
+		Long:    long,
+		Example: desc.Example(cmd.DescKeySkillUpdate),
+		Args:    cobra.ArbitraryArgs,
+		RunE: func(c *cobra.Command, args []string) error {
+			return Run(c, args, force)
+		},
+	}
+
+	flagbind.BoolFlag(c, &force, cFlag.Force, flag.DescKeySkillUpdateForce)
+
+	return c
+}
+
+// Run reinstalls locked skills whose source changed and rewrites
+// skills.lock.
+//
+// Parameters:
+//   - c: The cobra command for output
+//   - names: Skills to update; empty updates every locked skill
+//   - force: Overwrite skills modified after install
+//
+// Returns:
+//   - error: nil on success, or a lockfile or reinstall error
+func Run(c *cobra.Command, names []string, force bool) error {
+	ctxDir, ctxErr := rc.RequireContextDir()
+	if ctxErr != nil {
+		c.SilenceUsage = true
+		return ctxErr
+	}
+	skillsDir := filepath.Join(ctxDir, dir.Skills)
+	lockPath := filepath.Join(ctxDir, cfgSkill.LockFile)
+
+	return txn.Do(ctxDir, func() error {
+		lock, readErr := skill.ReadLock(lockPath)
+		if readErr != nil {
+			return readErr
+		}
+		if len(lock.Skills) == 0 {
+			writeSkill.NoLock(c)
+			return nil
+		}
+
+		statuses, updateErr := skill.Update(
+			skillsDir, filepath.Dir(ctxDir), lock, names, force,
+		)
+		if updateErr != nil {
+			c.SilenceUsage = true
+			return updateErr
+		}
+		if writeErr := skill.WriteLock(lockPath, lock); writeErr != nil {
+			return writeErr
+		}
+
+		updated := 0
+		for _, s := range statuses {
+			writeSkill.Status(c, s.State, s.Name, s.Detail)
+			if s.State == cfgSkill.StateUpdated {
+				updated++
+			}
+		}
+		writeSkill.Updated(c, updated, len(statuses))
+		return nil
+	})
+}
@@ -0,0 +1,45 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package update implements the "ctx skill update"
+// subcommand for refreshing installed skills from the
+// sources recorded in skills.lock.
+//
+// # Behavior
+//
+// The command reads .context/skills.lock and, for each
+// locked skill (or only the named ones), hashes the
+// recorded source directory. A skill whose source
+// changed is removed and reinstalled, and its lock
+// entry is refreshed (hash, version, commit, time).
+// Sources are local directories, so updating from a
+// git checkout means pulling it first; no network
+// access is needed.
+//
+// A skill whose installed copy was edited after
+// install is skipped so local changes are not lost,
+// unless --force is given. A skill whose source no
+// longer exists is reported and left alone. Naming a
+// skill that is not in the lock is an error.
+//
+// # Flags
+//
+//	--force   Overwrite skills modified after install.
+//
+// # Output
+//
+// One line per skill with its outcome (updated,
+// up-to-date, skipped, no-source), the old and new
+// hash for updated skills, and a summary count.
+//
+// # Delegation
+//
+// Reading, updating, and writing the lock are handled
+// by [skill.ReadLock], [skill.Update], and
+// [skill.WriteLock], under the context directory's
+// write lock ([txn.Do]). Output is routed through
+// [writeSkill.Status] and [writeSkill.Updated].
+package update
@@ -0,0 +1,90 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package verify
+
+import (
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	"github.com/ActiveMemory/ctx/internal/config/dir"
+	"github.com/ActiveMemory/ctx/internal/config/embed/cmd"
+	cfgSkill "github.com/ActiveMemory/ctx/internal/config/skill"
+	errSkill "github.com/ActiveMemory/ctx/internal/err/skill"
+	"github.com/ActiveMemory/ctx/internal/rc"
+	"github.com/ActiveMemory/ctx/internal/skill"
+	writeSkill "github.com/ActiveMemory/ctx/internal/write/skill"
+)
+
+// Cmd returns the "ctx skill verify" subcommand.
+//
+// Returns:
+//   - *cobra.Command: Configured verify subcommand
+func Cmd() *cobra.Command {
+	short, long := desc.Command(cmd.DescKeySkillVerify)
+
+	return &cobra.Command{
+		Use:     cmd.UseSkillVerify,
+		Short:   short,
+		Long:    long,
+		Example: desc.Example(cmd.DescKeySkillVerify),
+		Args:    cobra.NoArgs,
+		RunE: func(c *cobra.Command, _ []string) error {
+			return Run(c)
+		},
+	}
+}
+
+// Run checks installed skills against skills.lock.
+//
+// Parameters:
+//   - c: The cobra command for output
+//
+// Returns:
+//   - error: non-nil when a locked skill is modified or missing,
+//     or the lock or skills cannot be read
+func Run(c *cobra.Command) error {
+	ctxDir, ctxErr := rc.RequireContextDir()
+	if ctxErr != nil {
+		c.SilenceUsage = true
+		return ctxErr
+	}
+	skillsDir := filepath.Join(ctxDir, dir.Skills)
+
+	lock, readErr := skill.ReadLock(
+		filepath.Join(ctxDir, cfgSkill.LockFile),
+	)
+	if readErr != nil {
+		return readErr
+	}
+
+	statuses, verifyErr := skill.Verify(skillsDir, lock)
+	if verifyErr != nil {
+		return verifyErr
+	}
+	if len(statuses) == 0 {
+		writeSkill.NoLock(c)
+		return nil
+	}
+
+	problems := 0
+	for _, s := range statuses {
+		writeSkill.Status(c, s.State, s.Name, s.Detail)
+		if s.State == cfgSkill.StateModified ||
+			s.State == cfgSkill.StateMissing {
+			problems++
+		}
+	}
+	writeSkill.Verified(c, len(statuses))
+
+	if problems > 0 {
+		c.SilenceUsage = true
+		return errSkill.Mismatch(problems)
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package verify implements the "ctx skill verify"
+// subcommand for checking installed skills against
+// skills.lock.
+//
+// # Behavior
+//
+// The command hashes every skill listed in
+// .context/skills.lock and compares it with the
+// recorded SHA-256. Each locked skill is reported as
+// ok, modified (edited after install), or missing.
+// Installed skills without a lock entry are reported
+// as untracked; they do not fail verification, since
+// skills installed before the lockfile existed have
+// no entry until reinstalled.
+//
+// Sources are not consulted, so verification works
+// offline and is cheap enough for CI.
+//
+// # Flags
+//
+// None. This command takes no flags.
+//
+// # Output
+//
+// One line per skill with its state, then a summary
+// count. The command exits with an error when any
+// locked skill is modified or missing.
+//
+// # Delegation
+//
+// The lock is read by [skill.ReadLock] and compared by
+// [skill.Verify]. Output is routed through
+// [writeSkill.Status] and [writeSkill.Verified].
+package verify
@@ -21,10 +21,25 @@
 //   - list: display all installed skills with their
 //     trigger descriptions
 //   - remove: delete a skill by name from the project
+//   - update: reinstall skills whose recorded source
+//     changed since install
+//   - verify: check installed skills against the
+//     hashes in skills.lock
+//
+// # The Lockfile
+//
+// Install, update, and remove maintain
+// .context/skills.lock, which records each skill's
+// source, version, git commit, and SHA-256 so a team
+// can reproduce and check the same skill set. Drift
+// detection warns when an installed skill no longer
+// matches its lock entry.
 //
 // # Subpackages
 //
 //	cmd/install: skill installation logic
 //	cmd/list: skill enumeration and display
 //	cmd/remove: skill deletion
+//	cmd/update: refresh from recorded sources
+//	cmd/verify: lockfile integrity check
 package skill
@@ -13,6 +13,8 @@ import (
 	"github.com/ActiveMemory/ctx/internal/cli/skill/cmd/install"
 	"github.com/ActiveMemory/ctx/internal/cli/skill/cmd/list"
 	"github.com/ActiveMemory/ctx/internal/cli/skill/cmd/remove"
+	"github.com/ActiveMemory/ctx/internal/cli/skill/cmd/update"
+	"github.com/ActiveMemory/ctx/internal/cli/skill/cmd/verify"
 	"github.com/ActiveMemory/ctx/internal/config/embed/cmd"
 )
 
@@ -25,5 +27,7 @@ func Cmd() *cobra.Command {
 		install.Cmd(),
 		list.Cmd(),
 		remove.Cmd(),
+		update.Cmd(),
+		verify.Cmd(),
 	)
 }
@@ -9,12 +9,14 @@
 //
 // # JSON Output
 //
-// [PersistStatusJSON] encodes the context as an Output
-// struct containing directory path, file count, total
-// tokens, total size, and per-file status entries.
-// When verbose mode is enabled, each file entry
-// includes a content preview. The output is written
-// as indented JSON to the command's output stream.
+// [StatusOutput] builds an Output struct containing
+// directory path, file count, total tokens, total
+// size, and per-file status entries. When verbose mode
+// is enabled, each file entry includes a content
+// preview. [PersistStatusJSON]
+// writes it as indented JSON to the command's output
+// stream; the MCP ctx_status tool returns the same
+// struct as its structured result.
 //
 // # Text Output
 //
@@ -24,20 +24,18 @@ import (
 	"github.com/ActiveMemory/ctx/internal/write/status"
 )
 
-// PersistStatusJSON writes context status as JSON to the command output.
+// StatusOutput builds the machine-readable status report shared by
+// `ctx status --json` and the MCP ctx_status tool.
 //
 // When verbose is true, includes content previews for each file.
 //
 // Parameters:
-//   - cmd: Cobra command for output stream
-//   - ctx: Loaded context to display
+//   - ctx: Loaded context to report
 //   - verbose: If true, include file content previews
 //
 // Returns:
-//   - error: Non-nil if JSON encoding fails
-func PersistStatusJSON(
-	cmd *cobra.Command, ctx *entity.Context, verbose bool,
-) error {
+//   - Output: Status report ready for JSON encoding
+func StatusOutput(ctx *entity.Context, verbose bool) Output {
 	output := Output{
 		ContextDir:  ctx.Dir,
 		TotalFiles:  len(ctx.Files),
@@ -60,10 +58,26 @@ func PersistStatusJSON(
 		}
 		output.Files = append(output.Files, fs)
 	}
+	return output
+}
 
+// PersistStatusJSON writes context status as JSON to the command output.
+//
+// When verbose is true, includes content previews for each file.
+//
+// Parameters:
+//   - cmd: Cobra command for output stream
+//   - ctx: Loaded context to display
+//   - verbose: If true, include file content previews
+//
+// Returns:
+//   - error: Non-nil if JSON encoding fails
+func PersistStatusJSON(
+	cmd *cobra.Command, ctx *entity.Context, verbose bool,
+) error {
 	enc := json.NewEncoder(cmd.OutOrStdout())
 	enc.SetIndent("", token.Indent2)
-	return enc.Encode(output)
+	return enc.Encode(StatusOutput(ctx, verbose))
 }
 
 // PersistStatusText writes context status as formatted text to the
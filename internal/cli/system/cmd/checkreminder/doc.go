@@ -12,8 +12,11 @@
 // # What It Does
 //
 // The hook loads all stored reminders from the reminder
-// store, filters to those whose "after" date is today
-// or earlier, and emits a nudge box listing each due
+// store, filters to those due today or earlier (a
+// recurring reminder by its next occurrence after its
+// last dismissal), skips snoozed reminders and those
+// scoped to another git branch, and emits a nudge box
+// listing each due
 // reminder with its ID and message. A dismiss hint is
 // appended so the agent knows how to clear reminders
 // after acting on them.
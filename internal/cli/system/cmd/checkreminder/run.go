@@ -14,6 +14,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	"github.com/ActiveMemory/ctx/internal/cli/remind/core/schedule"
 	remindStore "github.com/ActiveMemory/ctx/internal/cli/remind/core/store"
 	coreCheck "github.com/ActiveMemory/ctx/internal/cli/system/core/check"
 	"github.com/ActiveMemory/ctx/internal/cli/system/core/nudge"
@@ -22,7 +23,6 @@ import (
 	"github.com/ActiveMemory/ctx/internal/config/embed/text"
 	"github.com/ActiveMemory/ctx/internal/config/hook"
 	"github.com/ActiveMemory/ctx/internal/config/reminder"
-	cfgTime "github.com/ActiveMemory/ctx/internal/config/time"
 	"github.com/ActiveMemory/ctx/internal/config/token"
 	"github.com/ActiveMemory/ctx/internal/config/warn"
 	logWarn "github.com/ActiveMemory/ctx/internal/log/warn"
@@ -31,8 +31,10 @@ import (
 // Run executes the check-reminders hook logic.
 //
 // Reads hook input from stdin, loads pending reminders, filters to those
-// that are due today or earlier, then emits a relay box with provenance
-// (session, branch, commit) and the reminder list. Provenance is always
+// that are due today or earlier (next occurrence for recurring ones,
+// skipping snoozed reminders and those scoped to another branch), then
+// emits a relay box with provenance (session, branch, commit) and the
+// reminder list. Provenance is always
 // emitted even when no reminders are due. Non-fatal on all errors.
 //
 // Parameters:
@@ -62,14 +64,7 @@ func Run(cmd *cobra.Command, stdin *os.File) error {
 		return nil
 	}
 
-	today := time.Now().Format(cfgTime.DateFormat)
-	var due []remindStore.Reminder
-	for _, r := range reminders {
-		if r.After == nil || *r.After <= today {
-			due = append(due, r)
-		}
-	}
-
+	due := schedule.Due(reminders, time.Now())
 	if len(due) == 0 {
 		return nil
 	}
@@ -79,7 +79,7 @@ func Sync(_ string) string {
 	}
 
 	client, dialErr := hub.NewClient(
-		cfg.HubAddr, cfg.Token,
+		cfg.HubAddr, cfg.Token, cfg.TLS,
 	)
 	if dialErr != nil {
 		logWarn.Warn(cfgWarn.HubSyncDial, cfg.HubAddr, dialErr)
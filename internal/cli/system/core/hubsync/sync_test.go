@@ -88,7 +88,12 @@ func startHub(t *testing.T, store *hub.Store) (string, string) {
 	if tokErr != nil {
 		t.Fatal(tokErr)
 	}
-	srv := hub.NewServer(store, adminTok)
+	srv, srvErr := hub.NewServer(
+		store, adminTok, hub.TLSConfig{},
+	)
+	if srvErr != nil {
+		t.Fatal(srvErr)
+	}
 	lis, lisErr := net.Listen("tcp", "127.0.0.1:0")
 	if lisErr != nil {
 		t.Fatal(lisErr)
@@ -97,7 +102,7 @@ func startHub(t *testing.T, store *hub.Store) (string, string) {
 	t.Cleanup(srv.GracefulStop)
 
 	addr := lis.Addr().String()
-	client, dialErr := hub.NewClient(addr, "")
+	client, dialErr := hub.NewClient(addr, "", hub.TLSConfig{})
 	if dialErr != nil {
 		t.Fatal(dialErr)
 	}
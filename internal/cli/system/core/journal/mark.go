@@ -7,6 +7,7 @@
 package journal
 
 import (
+	"path/filepath"
 	"strings"
 
 	"github.com/ActiveMemory/ctx/internal/config/journal"
@@ -14,6 +15,7 @@ import (
 	ctxResolve "github.com/ActiveMemory/ctx/internal/context/resolve"
 	errJournal "github.com/ActiveMemory/ctx/internal/err/journal"
 	"github.com/ActiveMemory/ctx/internal/journal/state"
+	"github.com/ActiveMemory/ctx/internal/txn"
 )
 
 // CheckStage reads the current value of a processing stage for a file.
@@ -72,20 +74,24 @@ func MarkStage(filename, stage string) error {
 	if dirErr != nil {
 		return dirErr
 	}
-	jState, loadErr := state.Load(journalDir)
-	if loadErr != nil {
-		return errJournal.LoadStateFailed(loadErr)
-	}
+	// Load, mark, and save under one lock so a concurrent marker's
+	// change is not dropped.
+	return txn.Do(filepath.Dir(journalDir), func() error {
+		jState, loadErr := state.Load(journalDir)
+		if loadErr != nil {
+			return errJournal.LoadStateFailed(loadErr)
+		}
 
-	if ok := jState.Mark(filename, stage); !ok {
-		return errJournal.UnknownStage(
-			stage, strings.Join(state.ValidStages, token.CommaSpace),
-		)
-	}
+		if ok := jState.Mark(filename, stage); !ok {
+			return errJournal.UnknownStage(
+				stage, strings.Join(state.ValidStages, token.CommaSpace),
+			)
+		}
 
-	if saveErr := jState.Save(journalDir); saveErr != nil {
-		return errJournal.SaveStateFailed(saveErr)
-	}
+		if saveErr := jState.Save(journalDir); saveErr != nil {
+			return errJournal.SaveStateFailed(saveErr)
+		}
 
-	return nil
+		return nil
+	})
 }
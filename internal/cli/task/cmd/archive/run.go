@@ -11,6 +11,8 @@ import (
 
 	coreArchive "github.com/ActiveMemory/ctx/internal/cli/task/core/archive"
 	"github.com/ActiveMemory/ctx/internal/config/token"
+	"github.com/ActiveMemory/ctx/internal/rc"
+	"github.com/ActiveMemory/ctx/internal/txn"
 	writeArchive "github.com/ActiveMemory/ctx/internal/write/archive"
 )
 
@@ -27,35 +29,46 @@ import (
 // Returns:
 //   - error: Non-nil if TASKS.md doesn't exist or file operations fail
 func Run(cmd *cobra.Command, dryRun bool) error {
-	r, planErr := coreArchive.Plan()
-	if planErr != nil {
-		return planErr
+	ctxDir, ctxErr := rc.ContextDir()
+	if ctxErr != nil {
+		return ctxErr
 	}
+	// Plan and execute under one lock so tasks added or completed
+	// by another writer in between are neither lost nor archived
+	// from a stale plan.
+	return txn.Do(ctxDir, func() error {
+		r, planErr := coreArchive.Plan()
+		if planErr != nil {
+			return planErr
+		}
 
-	for _, name := range r.SkippedNames {
-		writeArchive.Skipping(cmd, name)
-	}
+		for _, name := range r.SkippedNames {
+			writeArchive.Skipping(cmd, name)
+		}
 
-	if len(r.Archivable) == 0 {
-		if len(r.SkippedNames) > 0 {
-			writeArchive.SkipIncomplete(cmd, len(r.SkippedNames))
-		} else {
-			writeArchive.NoCompleted(cmd)
+		if len(r.Archivable) == 0 {
+			if len(r.SkippedNames) > 0 {
+				writeArchive.SkipIncomplete(cmd, len(r.SkippedNames))
+			} else {
+				writeArchive.NoCompleted(cmd)
+			}
+			return nil
 		}
-		return nil
-	}
 
-	if dryRun {
-		writeArchive.DryRun(cmd, len(r.Archivable), r.PendingCount,
-			r.Content, token.Separator)
-		return nil
-	}
+		if dryRun {
+			writeArchive.DryRun(cmd, len(r.Archivable), r.PendingCount,
+				r.Content, token.Separator)
+			return nil
+		}
 
-	archivePath, execErr := coreArchive.Execute(r)
-	if execErr != nil {
-		return execErr
-	}
+		archivePath, execErr := coreArchive.Execute(r)
+		if execErr != nil {
+			return execErr
+		}
 
-	writeArchive.Success(cmd, len(r.Archivable), archivePath, r.PendingCount)
-	return nil
+		writeArchive.Success(
+			cmd, len(r.Archivable), archivePath, r.PendingCount,
+		)
+		return nil
+	})
 }
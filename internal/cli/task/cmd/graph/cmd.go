@@ -0,0 +1,53 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package graph
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	"github.com/ActiveMemory/ctx/internal/config/embed/cmd"
+	"github.com/ActiveMemory/ctx/internal/config/embed/flag"
+	cFlag "github.com/ActiveMemory/ctx/internal/config/flag"
+	cfgTask "github.com/ActiveMemory/ctx/internal/config/task"
+	"github.com/ActiveMemory/ctx/internal/flagbind"
+)
+
+// Cmd returns the `task graph` subcommand.
+//
+// The graph command prints the task dependency graph declared
+// by #depends and #blocked-by annotations in TASKS.md.
+//
+// Flags:
+//   - --format: dot (default) or mermaid
+//
+// Returns:
+//   - *cobra.Command: Configured graph subcommand
+func Cmd() *cobra.Command {
+	var format string
+
+	short, long := desc.Command(cmd.DescKeyTaskGraph)
+
+	c := &cobra.Command{
+		Use:     cmd.UseTaskGraph,
+		Short:   short,
+		Long:    long,
+		Example: desc.Example(cmd.DescKeyTaskGraph),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return Run(cmd, format)
+		},
+	}
+
+	flagbind.StringFlagDefault(
+		c, &format,
+		cFlag.Format, cfgTask.GraphDOT,
+		flag.DescKeyTaskGraphFormat,
+	)
+
+	return c
+}
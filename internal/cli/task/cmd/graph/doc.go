@@ -0,0 +1,21 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package graph implements the "ctx task graph" cobra
+// subcommand.
+//
+// It reads the #id, #depends and #blocked-by annotations
+// in TASKS.md and prints the dependency graph for Graphviz
+// or Mermaid. Rendering lives in task/core/graph.
+//
+// # Usage
+//
+//	ctx task graph [--format dot|mermaid]
+//
+// # Flags
+//
+//	--format   dot (default) or mermaid.
+package graph
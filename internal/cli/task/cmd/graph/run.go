@@ -0,0 +1,54 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package graph
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	coreGraph "github.com/ActiveMemory/ctx/internal/cli/task/core/graph"
+	"github.com/ActiveMemory/ctx/internal/cli/task/core/path"
+	"github.com/ActiveMemory/ctx/internal/config/token"
+	errTask "github.com/ActiveMemory/ctx/internal/err/task"
+	"github.com/ActiveMemory/ctx/internal/io"
+	writeTask "github.com/ActiveMemory/ctx/internal/write/task"
+)
+
+// Run executes the graph subcommand logic.
+//
+// Parameters:
+//   - cmd: Cobra command for output
+//   - format: output format (dot or mermaid)
+//
+// Returns:
+//   - error: Non-nil if TASKS.md cannot be read or the format
+//     is unknown
+func Run(cmd *cobra.Command, format string) error {
+	tasksPath, pathErr := path.File()
+	if pathErr != nil {
+		cmd.SilenceUsage = true
+		return pathErr
+	}
+
+	content, readErr := io.SafeReadUserFile(filepath.Clean(tasksPath))
+	if readErr != nil {
+		cmd.SilenceUsage = true
+		return errTask.FileRead(readErr)
+	}
+
+	source, renderErr := coreGraph.Render(
+		strings.Split(string(content), token.NewlineLF), format,
+	)
+	if renderErr != nil {
+		return renderErr
+	}
+
+	writeTask.Graph(cmd, source)
+	return nil
+}
@@ -72,7 +72,8 @@ func Plan() (Result, error) {
 	return r, nil
 }
 
-// Execute writes the archive file and updates TASKS.md.
+// Execute writes the archive file and updates TASKS.md. The caller
+// holds the write lock from Plan through Execute.
 //
 // Parameters:
 //   - r: Result from Plan
@@ -94,7 +95,7 @@ func Execute(r Result) (string, error) {
 	if pathErr != nil {
 		return "", pathErr
 	}
-	if updateErr := io.SafeWriteFile(
+	if updateErr := io.SafeWriteFileAtomic(
 		tasksPath, []byte(r.NewTasksBody), fs.PermFile,
 	); updateErr != nil {
 		return "", errTask.FileWrite(updateErr)
@@ -19,6 +19,8 @@
 //     done by number or text search
 //   - count: counts pending top-level tasks, excluding
 //     subtasks
+//   - graph: renders #depends and #blocked-by annotations
+//     as a DOT or Mermaid dependency graph
 //   - path: resolves the absolute paths to TASKS.md
 //     and the archive directory
 //
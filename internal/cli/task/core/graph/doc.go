@@ -0,0 +1,23 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package graph renders the TASKS.md dependency graph for
+// ctx task graph, as Graphviz DOT or a Mermaid flowchart.
+//
+// # What Is Drawn
+//
+// Every pending top-level task is a node, plus each
+// completed task a shown task still references; other
+// completed tasks are history and are left out. Edges run
+// from prerequisite to dependent, for both #depends and
+// #blocked-by. A #blocked-by value that names no task is an
+// external blocker and gets a node of its own. A #depends
+// on a task no longer in the file (archived) draws nothing.
+//
+// Completed tasks are dashed so it is easy to see which
+// edges still hold work back. Parsing, ID resolution and
+// cycle detection come from internal/task.
+package graph
@@ -0,0 +1,78 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package graph
+
+import (
+	"strings"
+
+	errTask "github.com/ActiveMemory/ctx/internal/err/task"
+	"github.com/ActiveMemory/ctx/internal/io"
+	"github.com/ActiveMemory/ctx/internal/task"
+)
+
+// Render draws the dependency graph of TASKS.md.
+//
+// Parameters:
+//   - lines: TASKS.md split into lines
+//   - format: cfgTask.GraphDOT or cfgTask.GraphMermaid
+//
+// Returns:
+//   - string: the graph source, ready to print
+//   - error: non-nil for an unknown format
+func Render(lines []string, format string) (string, error) {
+	st, ok := styles[format]
+	if !ok {
+		return "", errTask.GraphFormat(format)
+	}
+
+	nodes := task.Nodes(lines)
+	index := task.Index(nodes)
+	shown := visible(nodes, index)
+
+	var decl, edges strings.Builder
+	decl.WriteString(st.open)
+	for i, n := range nodes {
+		if !shown[i] {
+			continue
+		}
+		tmpl := st.task
+		if n.Done {
+			tmpl = st.done
+		}
+		io.SafeFprintf(&decl, tmpl, name(i), st.escape.Replace(label(n)))
+	}
+
+	external := map[string]string{}
+	for i, n := range nodes {
+		if !shown[i] {
+			continue
+		}
+		for _, j := range task.Prereqs(n, index) {
+			if shown[j] {
+				io.SafeFprintf(&edges, st.edge, name(j), name(i))
+			}
+		}
+		for _, ref := range n.BlockedBy {
+			if _, known := index[ref]; known {
+				continue
+			}
+			from, seen := external[ref]
+			if !seen {
+				from = blockerName(len(external))
+				external[ref] = from
+				io.SafeFprintf(&decl, st.blocker,
+					from, st.escape.Replace(ref),
+				)
+			}
+			io.SafeFprintf(&edges, st.edge, from, name(i))
+		}
+	}
+
+	decl.WriteString(edges.String())
+	decl.WriteString(st.close)
+	return decl.String(), nil
+}
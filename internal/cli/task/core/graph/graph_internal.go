@@ -0,0 +1,107 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package graph
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ActiveMemory/ctx/internal/assets/tpl"
+	cfgTask "github.com/ActiveMemory/ctx/internal/config/task"
+	"github.com/ActiveMemory/ctx/internal/config/token"
+	"github.com/ActiveMemory/ctx/internal/task"
+)
+
+// styles maps each --format value to its templates.
+var styles = map[string]style{
+	cfgTask.GraphDOT: {
+		open:    tpl.GraphDOTOpen,
+		close:   tpl.GraphDOTClose,
+		task:    tpl.GraphDOTTask,
+		done:    tpl.GraphDOTDone,
+		blocker: tpl.GraphDOTBlocker,
+		edge:    tpl.GraphDOTEdge,
+		escape:  cfgTask.DOTEscape,
+	},
+	cfgTask.GraphMermaid: {
+		open:    tpl.GraphMermaidOpen,
+		close:   tpl.GraphMermaidClose,
+		task:    tpl.GraphMermaidTask,
+		done:    tpl.GraphMermaidDone,
+		blocker: tpl.GraphMermaidBlocker,
+		edge:    tpl.GraphMermaidEdge,
+		escape:  cfgTask.MermaidEscape,
+	},
+}
+
+// visible marks the nodes worth drawing: every pending task
+// and every completed task a pending one waits on.
+//
+// Parameters:
+//   - nodes: all tasks in TASKS.md
+//   - index: result of task.Index for nodes
+//
+// Returns:
+//   - []bool: parallel to nodes
+func visible(nodes []task.Node, index map[string]int) []bool {
+	shown := make([]bool, len(nodes))
+	for i, n := range nodes {
+		if n.Done {
+			continue
+		}
+		shown[i] = true
+		for _, j := range task.Prereqs(n, index) {
+			shown[j] = true
+		}
+	}
+	return shown
+}
+
+// label is the task text without its #tags, followed by the
+// explicit #id when there is one.
+//
+// Parameters:
+//   - n: the task
+//
+// Returns:
+//   - string: unescaped node label
+func label(n task.Node) string {
+	var words []string
+	for _, w := range strings.Fields(n.Content) {
+		if !strings.HasPrefix(w, token.Hash) {
+			words = append(words, w)
+		}
+	}
+	text := strings.Join(words, token.Space)
+	if n.ID == n.Key {
+		return text
+	}
+	return fmt.Sprintf(tpl.GraphLabelID, text, n.ID)
+}
+
+// name is the generated node name of the i-th task.
+//
+// Parameters:
+//   - i: position of the task in TASKS.md order
+//
+// Returns:
+//   - string: node name safe in both formats
+func name(i int) string {
+	return fmt.Sprintf(tpl.GraphTaskName, i+1)
+}
+
+// blockerName is the generated node name of the i-th
+// external blocker.
+//
+// Parameters:
+//   - i: position of the blocker in first-seen order
+//
+// Returns:
+//   - string: node name safe in both formats
+func blockerName(i int) string {
+	return fmt.Sprintf(tpl.GraphBlockerName, i+1)
+}
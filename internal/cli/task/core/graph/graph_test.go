@@ -0,0 +1,71 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package graph
+
+import (
+	"strings"
+	"testing"
+)
+
+var sample = []string{
+	"# Tasks",
+	"- [ ] Build API #id:api #depends:db #priority:high",
+	`- [ ] Ship "v2" #depends:api #blocked-by:legal`,
+	"- [x] Design schema #id:db",
+	"- [x] Old chore",
+}
+
+func TestRenderDOT(t *testing.T) {
+	got, err := Render(sample, "dot")
+	if err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+	want := strings.Join([]string{
+		"digraph tasks {",
+		"  rankdir=LR;",
+		"  node [shape=box];",
+		`  t1 [label="Build API (api)"];`,
+		`  t2 [label="Ship \"v2\""];`,
+		`  t3 [label="Design schema (db)", style=dashed, fontcolor=gray];`,
+		`  x1 [label="legal", shape=note];`,
+		"  t3 -> t1;",
+		"  t1 -> t2;",
+		"  x1 -> t2;",
+		"}",
+		"",
+	}, "\n")
+	if got != want {
+		t.Errorf("Render(dot) =\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestRenderMermaid(t *testing.T) {
+	got, err := Render(sample, "mermaid")
+	if err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+	for _, line := range []string{
+		"flowchart LR",
+		`  t2["Ship #quot;v2#quot;"]`,
+		`  t3["Design schema (db)"]:::done`,
+		`  x1>"legal"]`,
+		"  t3 --> t1",
+	} {
+		if !strings.Contains(got, line+"\n") {
+			t.Errorf("Render(mermaid) missing %q:\n%s", line, got)
+		}
+	}
+	if strings.Contains(got, "Old chore") {
+		t.Errorf("unreferenced completed task drawn:\n%s", got)
+	}
+}
+
+func TestRenderUnknownFormat(t *testing.T) {
+	if _, err := Render(sample, "svg"); err == nil {
+		t.Fatal("Render(svg) succeeded, want error")
+	}
+}
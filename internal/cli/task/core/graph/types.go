@@ -0,0 +1,26 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package graph
+
+import "strings"
+
+// style is the set of templates that draws one graph format.
+//
+// Fields:
+//   - open, close: text before and after the graph
+//   - task, done, blocker: node declarations (name, label)
+//   - edge: prerequisite-to-dependent edge (name, name)
+//   - escape: makes a label safe inside the node template
+type style struct {
+	open    string
+	close   string
+	task    string
+	done    string
+	blocker string
+	edge    string
+	escape  *strings.Replacer
+}
@@ -57,6 +57,8 @@
 //
 // # Concurrency
 //
-// Filesystem-bound and stateless. Single-process
-// assumption.
+// Filesystem-bound and stateless. Adds, completions, and
+// archives read and rewrite TASKS.md under the context
+// directory's write lock (internal/txn), so concurrent agents
+// never drop each other's changes.
 package task
@@ -13,6 +13,7 @@ import (
 	"github.com/ActiveMemory/ctx/internal/cli/task/cmd/add"
 	"github.com/ActiveMemory/ctx/internal/cli/task/cmd/archive"
 	"github.com/ActiveMemory/ctx/internal/cli/task/cmd/complete"
+	"github.com/ActiveMemory/ctx/internal/cli/task/cmd/graph"
 	"github.com/ActiveMemory/ctx/internal/cli/task/cmd/snapshot"
 	"github.com/ActiveMemory/ctx/internal/config/embed/cmd"
 )
@@ -24,6 +25,7 @@ import (
 //   - add: Add a new task entry to TASKS.md
 //   - complete: Mark a task as completed
 //   - archive: Move completed tasks out of TASKS.md
+//   - graph: Render task dependencies as DOT or Mermaid
 //   - snapshot: Create point-in-time backup
 //
 // Returns:
@@ -33,6 +35,7 @@ func Cmd() *cobra.Command {
 		add.Cmd(),
 		archive.Cmd(),
 		complete.Cmd(),
+		graph.Cmd(),
 		snapshot.Cmd(),
 	)
 }
@@ -7,10 +7,15 @@
 package list
 
 import (
+	"strconv"
+	"strings"
+	"time"
+
 	"github.com/spf13/cobra"
 
 	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
 	"github.com/ActiveMemory/ctx/internal/config/embed/cmd"
+	"github.com/ActiveMemory/ctx/internal/config/token"
 	cfgTrigger "github.com/ActiveMemory/ctx/internal/config/trigger"
 	"github.com/ActiveMemory/ctx/internal/rc"
 	"github.com/ActiveMemory/ctx/internal/trigger"
@@ -22,11 +27,12 @@ import (
 // Returns:
 //   - *cobra.Command: Configured list subcommand
 func Cmd() *cobra.Command {
-	short, _ := desc.Command(cmd.DescKeyTriggerList)
+	short, long := desc.Command(cmd.DescKeyTriggerList)
 
 	return &cobra.Command{
 		Use:     cmd.UseTriggerList,
 		Short:   short,
+		Long:    long,
 		Example: desc.Example(cmd.DescKeyTriggerList),
 		Args:    cobra.NoArgs,
 		RunE: func(c *cobra.Command, _ []string) error {
@@ -36,7 +42,8 @@ func Cmd() *cobra.Command {
 }
 
 // Run lists all hooks grouped by hook type with name, enabled/disabled
-// status, and file path.
+// status, and file path, followed by any hooks.yaml matchers,
+// timeout, and priority.
 //
 // Parameters:
 //   - c: The cobra command for output
@@ -48,6 +55,7 @@ func Run(c *cobra.Command) error {
 
 	all, err := trigger.Discover(hooksDir)
 	if err != nil {
+		c.SilenceUsage = true
 		return err
 	}
 
@@ -65,6 +73,27 @@ func Run(c *cobra.Command) error {
 				status = cfgTrigger.StatusDisabled
 			}
 			writeTrigger.Entry(c, h.Name, status, h.Path)
+			spec := h.Spec
+			if len(spec.Tools) > 0 {
+				writeTrigger.Matcher(c, cfgTrigger.LabelTools,
+					strings.Join(spec.Tools, token.CommaSpace))
+			}
+			if len(spec.Paths) > 0 {
+				writeTrigger.Matcher(c, cfgTrigger.LabelPaths,
+					strings.Join(spec.Paths, token.CommaSpace))
+			}
+			if len(spec.SessionTools) > 0 {
+				writeTrigger.Matcher(c, cfgTrigger.LabelSessionTools,
+					strings.Join(spec.SessionTools, token.CommaSpace))
+			}
+			if spec.Timeout > 0 {
+				writeTrigger.Matcher(c, cfgTrigger.LabelTimeout,
+					(time.Duration(spec.Timeout) * time.Second).String())
+			}
+			if spec.Priority != 0 {
+				writeTrigger.Matcher(c, cfgTrigger.LabelPriority,
+					strconv.Itoa(spec.Priority))
+			}
 			total++
 		}
 		writeTrigger.BlankLine(c)
@@ -31,6 +31,10 @@
 //   - For each type that has scripts, prints a type
 //     header followed by one line per script showing
 //     name, status (enabled/disabled), and path.
+//   - Under each script, prints the matchers, timeout,
+//     and priority declared for it in hooks.yaml. A
+//     listed tools or paths matcher fails for events
+//     that carry no tool name or path.
 //   - Prints a total count at the end.
 //   - If no scripts exist, prints a "no hooks found"
 //     message.
@@ -41,8 +45,10 @@
 // headers, per-script status lines, and a summary
 // count. Example:
 //
-//	pre-tool-use:
+//	[pre-tool-use]
 //	  my-hook  enabled  .context/hooks/pre-tool-use/my-hook.sh
+//	      tools: write_file, edit_*
+//	      paths: internal/crypto/**
 //
 // # Delegation
 //
@@ -99,6 +99,7 @@ func Run(c *cobra.Command, hookType, toolName, path string) error {
 		Session: trigger.HookSession{
 			ID:    cfgTrigger.MockSessionID,
 			Model: cfgTrigger.MockModel,
+			Tool:  rc.Tool(),
 		},
 		Timestamp:  time.Now().UTC().Format(time.RFC3339),
 		CtxVersion: cfgTrigger.MockVersion,
@@ -114,6 +115,7 @@ func Run(c *cobra.Command, hookType, toolName, path string) error {
 
 	agg, err := trigger.RunAll(hooksDir, ht, input, timeout)
 	if err != nil {
+		c.SilenceUsage = true
 		return err
 	}
 
@@ -0,0 +1,101 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package aider
+
+import "github.com/ActiveMemory/ctx/internal/config/file"
+
+// History files Aider writes to the repository root.
+const (
+	// FileChatHistory is the default chat transcript file name.
+	FileChatHistory = ".aider.chat.history" + file.ExtMarkdown
+	// FileInputHistory is the default prompt history file name.
+	FileInputHistory = ".aider.input.history"
+	// EnvChatHistory overrides the chat transcript location.
+	EnvChatHistory = "AIDER_CHAT_HISTORY_FILE"
+	// EnvInputHistory overrides the prompt history location.
+	EnvInputHistory = "AIDER_INPUT_HISTORY_FILE"
+)
+
+// Chat history markup.
+const (
+	// MarkerStart opens every session, followed by the local start
+	// time in [TimeFormat].
+	MarkerStart = "# aider chat started at "
+	// TimeFormat is the layout of the session start time.
+	TimeFormat = "2006-01-02 15:04:05"
+	// PrefixUser starts each line of a user prompt.
+	PrefixUser = "####"
+	// PrefixNotice starts each line of Aider's own output (a
+	// Markdown blockquote).
+	PrefixNotice = ">"
+	// BlankInput is how Aider records an empty prompt.
+	BlankInput = "<blank>"
+	// LineBreak is the trailing Markdown hard break Aider appends
+	// to prompt and notice lines.
+	LineBreak = "  "
+)
+
+// Prompt history markup.
+const (
+	// InputTimePrefix starts the timestamp line of each prompt.
+	InputTimePrefix = "# "
+	// InputTimeFormat is the layout of a prompt timestamp.
+	InputTimeFormat = "2006-01-02 15:04:05.999999"
+	// InputLinePrefix starts each line of a prompt.
+	InputLinePrefix = "+"
+)
+
+// Notices Aider prints as blockquotes.
+const (
+	// NoticeMainModel reports the main model at startup.
+	NoticeMainModel = "Main model: "
+	// NoticeModel is the pre-0.40 form of [NoticeMainModel].
+	NoticeModel = "Model: "
+	// ModelSeparator ends the model name in a model notice.
+	ModelSeparator = " with "
+	// NoticeAdded opens a file-added notice.
+	NoticeAdded = "Added "
+	// NoticeAddedSuffix closes a file-added notice.
+	NoticeAddedSuffix = " to the chat"
+	// NoticeApplied opens an edit-applied notice.
+	NoticeApplied = "Applied edit to "
+	// NoticeCommit opens a commit notice, followed by the short
+	// hash and the commit message.
+	NoticeCommit = "Commit "
+)
+
+// SEARCH/REPLACE edit block markers.
+const (
+	// EditSearch opens the text to replace.
+	EditSearch = "<<<<<<< SEARCH"
+	// EditDivider separates the old text from the new.
+	EditDivider = "======="
+	// EditReplace closes the block.
+	EditReplace = ">>>>>>> REPLACE"
+)
+
+// Tool names recorded for Aider actions.
+const (
+	// ToolAdd records a file added to the chat.
+	ToolAdd = "Add"
+)
+
+// Token count suffixes and their multipliers.
+const (
+	// SuffixKilo marks thousands in a token count.
+	SuffixKilo = "k"
+	// SuffixMega marks millions in a token count.
+	SuffixMega = "m"
+	// Kilo is the multiplier for [SuffixKilo].
+	Kilo = 1_000
+	// Mega is the multiplier for [SuffixMega].
+	Mega = 1_000_000
+)
+
+// IDLen is the length of the hex session ID derived from the
+// project directory and session start time.
+const IDLen = 32
@@ -0,0 +1,38 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package aider centralizes constants for importing Aider
+// chat history into the journal.
+//
+// Aider appends every session to a Markdown chat history
+// file in the repository root and every prompt, with a
+// timestamp, to .aider.input.history. Neither file has a
+// machine-readable schema; the importer recognizes the
+// Markdown conventions Aider writes.
+//
+// # Files
+//
+//   - [FileChatHistory] / [EnvChatHistory]: the chat
+//     transcript and its override
+//   - [FileInputHistory] / [EnvInputHistory]: the prompt
+//     history and its override
+//
+// # Markup
+//
+// [MarkerStart] opens a session; [PrefixUser] marks
+// prompt lines; [PrefixNotice] marks Aider's own output.
+// The Notice* constants identify the notices the importer
+// turns into structure: the model, files added to the
+// chat, applied edits, and commits. [EditSearch],
+// [EditDivider], and [EditReplace] delimit
+// SEARCH/REPLACE edit blocks in replies.
+//
+// # Token Counts
+//
+// Aider reports usage as "Tokens: 2.3k sent, 150
+// received"; [SuffixKilo] and [SuffixMega] scale the
+// abbreviated counts.
+package aider
@@ -0,0 +1,61 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package align
+
+// State files under .context/state/.
+const (
+	// FileLedger records every session already scanned, the
+	// disposition of every reviewed candidate, and running totals,
+	// so re-runs do not re-propose.
+	FileLedger = "align-ledger.json"
+	// FileReview holds the candidates awaiting accept or reject.
+	FileReview = "align-review.json"
+)
+
+// Queue names the review queue in its errors and in the
+// "ctx align review" hint.
+const Queue = "align"
+
+// LedgerVersion is the schema version written to the ledger.
+const LedgerVersion = 1
+
+// Provenance is the branch and commit fallback recorded on entries
+// persisted by ctx align accept when the session carries none and
+// HEAD cannot be read.
+const Provenance = "align"
+
+// IDFormat is the candidate ID format: the session ID prefix and
+// the position of the correcting message in the session (e.g.
+// "1a2b3c4d-42").
+const IDFormat = "%s-%d"
+
+// SessionIDLen is the number of session ID characters kept in a
+// candidate ID.
+const SessionIDLen = 8
+
+// MaxText is the rune limit for the quoted assumption and intent;
+// longer text is clipped with an ellipsis.
+const MaxText = 200
+
+// Signal labels recorded on a candidate to explain why it was
+// proposed.
+const (
+	// SignalInterrupt marks a user interrupt of an assistant turn.
+	SignalInterrupt = "interrupt"
+	// SignalRejected marks a tool use the user rejected.
+	SignalRejected = "rejected-tool"
+	// SignalPhrase marks a user turn that opens with correction
+	// wording ("no,", "stop", "that's not what I meant").
+	SignalPhrase = "phrase"
+	// SignalRule marks restated intent worded as a standing rule,
+	// which makes the candidate a convention.
+	SignalRule = "rule"
+)
+
+// ToolSep separates tool names in an assumption that names the
+// tools the interrupted turn called.
+const ToolSep = ", "
@@ -0,0 +1,16 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package align holds configuration constants for ctx align, the
+// deterministic pass that finds course corrections in AI session
+// transcripts and proposes them as LEARNINGS.md and
+// CONVENTIONS.md entries: state file names, candidate ID layout,
+// text limits, signal labels, and candidate dispositions.
+//
+// These are structural constants only — no logic. The engine lives
+// in internal/align; the CLI in internal/cli/align. The correction
+// and rule patterns live in internal/config/regex.
+package align
@@ -31,6 +31,7 @@ const (
 	DirPermissions                = "permissions"
 	DirProject                    = "project"
 	DirSchema                     = "schema"
+	DirSite                       = "site"
 	DirWhy                        = "why"
 )
 
@@ -59,6 +60,8 @@ const (
 	FileDenyTxt               = "deny.txt"
 	FileExamplesYAML          = "examples.yaml"
 	FileExtraCSS              = "extra.css"
+	FileSiteCSS               = "style.css"
+	FileSiteJS                = "search.js"
 	FileFlagsYAML             = "flags.yaml"
 	FileMakefileCtx           = "Makefile.ctx"
 	FilePluginJSON            = "plugin.json"
@@ -97,6 +100,8 @@ var (
 		DirIntegrationsCopilotCLI, "instructions-context.md")
 	PathMessageRegistry = path.Join(DirHooksMessages, FileRegistryYAML)
 	PathExtraCSS        = path.Join(DirJournal, FileExtraCSS)
+	PathSiteCSS         = path.Join(DirSite, FileSiteCSS)
+	PathSiteJS          = path.Join(DirSite, FileSiteJS)
 	PathMakefileCtx     = path.Join(DirProject, FileMakefileCtx)
 	PathAllowTxt        = path.Join(DirPermissions, FileAllowTxt)
 	PathDenyTxt         = path.Join(DirPermissions, FileDenyTxt)
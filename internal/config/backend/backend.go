@@ -0,0 +1,127 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package backend
+
+import "time"
+
+// Registered backend types. A .ctxrc backends entry is keyed by one
+// of these names.
+const (
+	// TypeVLLM is the canonical local backend.
+	TypeVLLM = "vllm"
+	// TypeOpenAI is the OpenAI cloud API.
+	TypeOpenAI = "openai"
+	// TypeOllama is a local Ollama server.
+	TypeOllama = "ollama"
+	// TypeLMStudio is a local LM Studio server.
+	TypeLMStudio = "lmstudio"
+	// TypeGeneric is any other OpenAI-compatible server; it has no
+	// default endpoint.
+	TypeGeneric = "openai-compatible"
+)
+
+// Types lists the registered backend types in display order.
+var Types = []string{
+	TypeVLLM, TypeOpenAI, TypeOllama, TypeLMStudio, TypeGeneric,
+}
+
+// Endpoint is the default base URL per backend type. TypeGeneric
+// has none: its endpoint must be configured.
+var Endpoint = map[string]string{
+	TypeVLLM:     "http://localhost:8000",
+	TypeOpenAI:   "https://api.openai.com",
+	TypeOllama:   "http://localhost:11434",
+	TypeLMStudio: "http://localhost:1234",
+	TypeGeneric:  "",
+}
+
+// APIKeyEnv is the default credential variable per backend type.
+// Local servers run without auth by default.
+var APIKeyEnv = map[string]string{
+	TypeVLLM:     "",
+	TypeOpenAI:   "OPENAI_API_KEY",
+	TypeOllama:   "",
+	TypeLMStudio: "",
+	TypeGeneric:  "",
+}
+
+// DefaultTimeout bounds one backend request when the backend entry
+// sets no timeout.
+const DefaultTimeout = 30 * time.Second
+
+// OpenAI-compatible wire contract.
+const (
+	// PathVersion is the API version prefix; a configured endpoint
+	// ending in it is normalized to the bare base URL.
+	PathVersion = "/v1"
+	// PathModels lists models (the reachability check).
+	PathModels = "/v1/models"
+	// PathChat is the chat completions endpoint.
+	PathChat = "/v1/chat/completions"
+	// HeaderAuthorization carries the bearer credential.
+	HeaderAuthorization = "Authorization"
+	// HeaderContentType carries the request MIME type.
+	HeaderContentType = "Content-Type"
+	// BearerFormat formats the Authorization header value.
+	BearerFormat = "Bearer %s"
+	// RoleSystem is the chat role of the instruction message.
+	RoleSystem = "system"
+	// RoleUser is the chat role of the input message.
+	RoleUser = "user"
+	// FormatJSONSchema is the response_format type for
+	// schema-constrained output.
+	FormatJSONSchema = "json_schema"
+	// MaxErrorBody caps how much of an upstream error body is
+	// relayed in an error message.
+	MaxErrorBody = 2048
+	// MaxResponseBody caps how much of a reply body is read
+	// (8 MiB); a longer reply fails rather than exhausting
+	// memory.
+	MaxResponseBody = 8 << 20
+)
+
+// .ctxrc keys, named in validation errors and written by
+// ctx setup --backend.
+const (
+	// KeyBackends is the top-level backends table.
+	KeyBackends = "backends"
+	// KeyDefault selects the backend used when --backend is omitted.
+	KeyDefault = "default"
+	// KeyEndpoint is the backend base URL.
+	KeyEndpoint = "endpoint"
+	// KeyAPIKeyEnv names the credential environment variable.
+	KeyAPIKeyEnv = "api_key_env"
+	// KeyTimeout is the per-request timeout (Go duration).
+	KeyTimeout = "timeout"
+	// KeyModel is the model used when a command names none.
+	KeyModel = "default_model"
+)
+
+// YAMLIndent is the indent width used when rewriting .ctxrc.
+const YAMLIndent = 2
+
+// Environment variables downstream AI tools read for an
+// OpenAI-compatible base URL. ctx setup --backend prints them as a
+// hint and warns when the current value differs; it never sets them.
+const (
+	// EnvOpenAIBaseURL is read by OpenAI SDK based tools.
+	EnvOpenAIBaseURL = "OPENAI_BASE_URL"
+)
+
+// Proposal queue. AI commands never edit .context/*.md; they write
+// proposals here for human review.
+const (
+	// DirProposals is the queue directory under .context/state/.
+	DirProposals = "proposals"
+	// FileMemoryClassify is the proposal file format for
+	// ctx ai classify, stamped with a compact UTC timestamp.
+	FileMemoryClassify = "memory-classify-%s.json"
+	// KindMemoryClassify tags a ctx ai classify proposal file.
+	KindMemoryClassify = "memory-classify"
+	// JSONIndent is the indent unit for proposal files.
+	JSONIndent = "  "
+)
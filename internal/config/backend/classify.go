@@ -0,0 +1,41 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package backend
+
+import (
+	"github.com/ActiveMemory/ctx/internal/config/entry"
+	cfgMemory "github.com/ActiveMemory/ctx/internal/config/memory"
+)
+
+// Memory classification contract for ctx ai classify. The backend
+// must answer with JSON matching SchemaClassify; servers that
+// support response_format json_schema enforce it while decoding.
+const (
+	// SchemaClassifyName names the schema in the request.
+	SchemaClassifyName = "memory_classification"
+	// SchemaClassify constrains the answer to one target from the
+	// context-file kinds (or skip) plus a one-line reason.
+	SchemaClassify = `{
+  "type": "object",
+  "additionalProperties": false,
+  "required": ["target", "reason"],
+  "properties": {
+    "target": {
+      "type": "string",
+      "enum": ["decision", "learning", "convention", "task", "skip"]
+    },
+    "reason": {"type": "string"}
+  }
+}`
+)
+
+// ClassifyTargets is the answer enum of SchemaClassify, checked
+// again on the client since not every server enforces the schema.
+var ClassifyTargets = []string{
+	entry.Decision, entry.Learning, entry.Convention, entry.Task,
+	cfgMemory.TargetSkip,
+}
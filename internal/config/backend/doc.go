@@ -0,0 +1,16 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package backend holds configuration constants for the optional
+// OpenAI-compatible AI backend layer: the registered backend types
+// and their default endpoints and credential variables, the HTTP
+// paths and headers of the OpenAI wire contract, and the proposal
+// queue the AI commands write into.
+//
+// These are structural constants only — no logic. The registry and
+// client live in internal/backend; the commands in internal/cli/ai
+// and internal/cli/setup. See specs/ctx-ai-backend.md.
+package backend
@@ -0,0 +1,74 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package codex
+
+// Codex CLI home directory and session store.
+const (
+	// DirHome is the default Codex CLI config directory name.
+	DirHome = ".codex"
+	// EnvHome is the environment variable to override the config dir.
+	EnvHome = "CODEX_HOME"
+	// DirSessions is the session subdirectory under the Codex home.
+	DirSessions = "sessions"
+	// FilePrefix starts every rollout transcript file name.
+	FilePrefix = "rollout-"
+)
+
+// Rollout line types.
+const (
+	// LineSessionMeta opens a rollout with the session metadata.
+	LineSessionMeta = "session_meta"
+	// LineResponseItem carries one model input or output item.
+	LineResponseItem = "response_item"
+	// LineEventMsg carries a UI event such as a token count.
+	LineEventMsg = "event_msg"
+	// LineTurnContext records per-turn settings such as the model.
+	LineTurnContext = "turn_context"
+)
+
+// Response item types.
+const (
+	// ItemMessage is a user or assistant message.
+	ItemMessage = "message"
+	// ItemReasoning is a reasoning summary.
+	ItemReasoning = "reasoning"
+	// ItemFunctionCall is a tool invocation with JSON arguments.
+	ItemFunctionCall = "function_call"
+	// ItemFunctionCallOutput is the result of a function call.
+	ItemFunctionCallOutput = "function_call_output"
+	// ItemCustomToolCall is a tool invocation with free-form input.
+	ItemCustomToolCall = "custom_tool_call"
+	// ItemCustomToolCallOutput is the result of a custom tool call.
+	ItemCustomToolCallOutput = "custom_tool_call_output"
+)
+
+// Event message types.
+const (
+	// EventTokenCount reports cumulative and last-call token usage.
+	EventTokenCount = "token_count"
+)
+
+// ContextPrefixes start the user-role messages Codex injects
+// (environment, instructions) rather than ones the user typed.
+var ContextPrefixes = []string{
+	"<environment_context>",
+	"<user_instructions>",
+	"# AGENTS.md instructions",
+}
+
+// Scanner buffer sizes.
+const (
+	// ScanBufInit is the initial scanner buffer size (64KB).
+	ScanBufInit = 64 * 1024
+	// ScanBufMax is the maximum scanner buffer size (16MB).
+	// Function call outputs can embed whole files.
+	ScanBufMax = 16 * 1024 * 1024
+)
+
+// ExitCodePrefix opens the plain-text form of a shell tool output,
+// followed by the command's exit code.
+const ExitCodePrefix = "Exit code: "
@@ -0,0 +1,41 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package codex centralizes constants for importing
+// OpenAI Codex CLI sessions into the journal.
+//
+// Codex CLI writes one "rollout" transcript per session
+// to ~/.codex/sessions/YYYY/MM/DD/rollout-*.jsonl (or
+// under $CODEX_HOME). Each line is an envelope with a
+// timestamp, a type, and a payload.
+//
+// # Storage Paths
+//
+//   - [DirHome] / [EnvHome]: the Codex home directory
+//     and its override
+//   - [DirSessions]: the session tree under the home
+//   - [FilePrefix]: the rollout file name prefix
+//
+// # Line and Item Types
+//
+// [LineSessionMeta], [LineResponseItem], [LineEventMsg],
+// and [LineTurnContext] name the envelope types. The
+// Item* constants name response item payloads (messages,
+// reasoning, tool calls and their outputs);
+// [EventTokenCount] names the token usage event.
+//
+// # Injected Context
+//
+// [ContextPrefixes] identify user-role messages that
+// Codex injects (environment context, AGENTS
+// instructions) so the importer does not count them as
+// user turns.
+//
+// # Scanner Buffer Sizes
+//
+//   - [ScanBufInit]: 64KB initial buffer
+//   - [ScanBufMax]: 16MB ceiling per line
+package codex
@@ -40,6 +40,12 @@
 //     executable permission bit
 //   - IssueStaleSyncFile: a synced tool-native file
 //     that is out of date versus its source
+//   - IssueTaskCycle: TASKS.md tasks that depend on
+//     each other in a loop
+//   - IssueSkillModified: an installed skill edited
+//     since it was recorded in skills.lock
+//   - IssueSkillMissing: a locked skill that is not
+//     installed
 //
 // # Status Types
 //
@@ -56,7 +62,8 @@
 // CheckConstitution, CheckRequiredFiles, CheckFileAge,
 // CheckEntryCount, CheckMissingPackages,
 // CheckTemplateHeaders, CheckSteeringTools,
-// CheckHookPerms, CheckSyncStaleness, and CheckRCTool.
+// CheckHookPerms, CheckSyncStaleness, CheckRCTool,
+// CheckTaskDeps, and CheckSkillLock.
 //
 // # Constitution Rules
 //
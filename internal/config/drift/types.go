@@ -45,6 +45,15 @@ const (
 	// IssueStaleSyncFile indicates a synced tool-native
 	// file that is out of date compared to its source.
 	IssueStaleSyncFile IssueType = "stale_sync_file"
+	// IssueTaskCycle indicates TASKS.md tasks whose #depends
+	// or #blocked-by annotations form a cycle.
+	IssueTaskCycle IssueType = "task_cycle"
+	// IssueSkillModified indicates an installed skill whose
+	// contents no longer match its skills.lock hash.
+	IssueSkillModified IssueType = "skill_modified"
+	// IssueSkillMissing indicates a skill listed in
+	// skills.lock that is not installed.
+	IssueSkillMissing IssueType = "skill_missing"
 )
 
 // StatusType represents the overall status of a drift
@@ -102,6 +111,12 @@ const (
 	// CheckRCTool validates the .ctxrc tool field against
 	// supported identifiers.
 	CheckRCTool CheckName = "rc_tool_field"
+	// CheckTaskDeps checks TASKS.md dependency annotations
+	// for cycles.
+	CheckTaskDeps CheckName = "task_dependencies"
+	// CheckSkillLock compares installed skills against
+	// skills.lock.
+	CheckSkillLock CheckName = "skill_lock"
 )
 
 // Constitution rule names referenced in drift violations.
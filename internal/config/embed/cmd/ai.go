@@ -0,0 +1,30 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+// Use strings for the ai command and its subcommands.
+const (
+	// UseAI is the cobra Use string for the ai command.
+	UseAI = "ai"
+	// UseAIPing is the cobra Use string for the ai ping subcommand.
+	UseAIPing = "ping"
+	// UseAIClassify is the cobra Use string for the ai classify
+	// subcommand.
+	UseAIClassify = "classify"
+)
+
+// DescKeys for the ai command and its subcommands.
+const (
+	// DescKeyAI is the description key for the ai command.
+	DescKeyAI = "ai"
+	// DescKeyAIPing is the description key for the ai ping
+	// subcommand.
+	DescKeyAIPing = "ai.ping"
+	// DescKeyAIClassify is the description key for the ai classify
+	// subcommand.
+	DescKeyAIClassify = "ai.classify"
+)
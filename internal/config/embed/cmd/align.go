@@ -0,0 +1,28 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+// Use strings for the align command and its subcommands.
+const (
+	// UseAlign is the cobra Use string for the align command.
+	UseAlign = "align"
+)
+
+// DescKeys for the align command and its subcommands.
+const (
+	// DescKeyAlign is the description key for the align command.
+	DescKeyAlign = "align"
+	// DescKeyAlignReview is the description key for the align review
+	// subcommand.
+	DescKeyAlignReview = "align.review"
+	// DescKeyAlignAccept is the description key for the align accept
+	// subcommand.
+	DescKeyAlignAccept = "align.accept"
+	// DescKeyAlignReject is the description key for the align reject
+	// subcommand.
+	DescKeyAlignReject = "align.reject"
+)
@@ -33,7 +33,7 @@ const (
 	// UseGuide is the cobra Use string for the guide command.
 	UseGuide = "guide"
 	// UseSetup is the cobra Use string for the setup command.
-	UseSetup = "setup <tool>"
+	UseSetup = "setup [<tool> | --backend <name>]"
 	// UseInit is the cobra Use string for the init command.
 	UseInit = "init"
 	// UseIndex is the cobra Use string for the index command.
@@ -58,6 +58,8 @@ const (
 	UseRemind = "remind [TEXT]"
 	// UseResume is the cobra Use string for the resume command.
 	UseResume = "resume"
+	// UseSearch is the cobra Use string for the search command.
+	UseSearch = "search <query...>"
 	// UseServe is the cobra Use string for the serve command.
 	UseServe = "serve [directory]"
 	// UseStatus is the cobra Use string for the status command.
@@ -110,6 +112,8 @@ const (
 	DescKeyLoad = "load"
 	// DescKeyLoop is the description key for the loop command.
 	DescKeyLoop = "loop"
+	// DescKeySearch is the description key for the search command.
+	DescKeySearch = "search"
 	// DescKeyServe is the description key for the serve command.
 	DescKeyServe = "serve"
 	// DescKeyStatus is the description key for the status command.
@@ -18,6 +18,10 @@ const (
 	UseConnectionSync = "sync"
 	// UseConnectionPublish is the Use string for publish.
 	UseConnectionPublish = "publish"
+	// UseConnectionRetract is the Use string for retract.
+	UseConnectionRetract = "retract <entry-id>"
+	// UseConnectionSearch is the Use string for search.
+	UseConnectionSearch = "search [words...]"
 	// UseConnectionListen is the Use string for listen.
 	UseConnectionListen = "listen"
 	// UseConnectionStatus is the Use string for status.
@@ -33,6 +37,10 @@ const (
 	DescKeyConnectionSync = "connection.sync"
 	// DescKeyConnectionPublish is the desc key for publish.
 	DescKeyConnectionPublish = "connection.publish"
+	// DescKeyConnectionRetract is the desc key for retract.
+	DescKeyConnectionRetract = "connection.retract"
+	// DescKeyConnectionSearch is the desc key for search.
+	DescKeyConnectionSearch = "connection.search"
 	// DescKeyConnectionListen is the desc key for listen.
 	DescKeyConnectionListen = "connection.listen"
 	// DescKeyConnectionStatus is the desc key for status.
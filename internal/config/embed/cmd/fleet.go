@@ -0,0 +1,49 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+// Use strings for the fleet command and its subcommands.
+const (
+	// UseFleet is the cobra Use string for the fleet command.
+	UseFleet = "fleet"
+	// UseFleetAgents is the cobra Use string for the fleet agents
+	// subcommand.
+	UseFleetAgents = "agents"
+	// UseFleetStatus is the cobra Use string for the fleet status
+	// subcommand.
+	UseFleetStatus = "status"
+	// UseFleetClaim is the cobra Use string for the fleet claim
+	// subcommand.
+	UseFleetClaim = "claim <task>"
+	// UseFleetAssign is the cobra Use string for the fleet assign
+	// subcommand.
+	UseFleetAssign = "assign <task>"
+	// UseFleetRelease is the cobra Use string for the fleet
+	// release subcommand.
+	UseFleetRelease = "release <task>"
+)
+
+// DescKeys for the fleet command and its subcommands.
+const (
+	// DescKeyFleet is the description key for the fleet command.
+	DescKeyFleet = "fleet"
+	// DescKeyFleetAgents is the description key for the fleet
+	// agents subcommand.
+	DescKeyFleetAgents = "fleet.agents"
+	// DescKeyFleetStatus is the description key for the fleet
+	// status subcommand.
+	DescKeyFleetStatus = "fleet.status"
+	// DescKeyFleetClaim is the description key for the fleet claim
+	// subcommand.
+	DescKeyFleetClaim = "fleet.claim"
+	// DescKeyFleetAssign is the description key for the fleet
+	// assign subcommand.
+	DescKeyFleetAssign = "fleet.assign"
+	// DescKeyFleetRelease is the description key for the fleet
+	// release subcommand.
+	DescKeyFleetRelease = "fleet.release"
+)
@@ -22,6 +22,12 @@ const (
 	UseHubStepdown = "stepdown"
 	// UseHubRevoke is the Use string for hub revoke.
 	UseHubRevoke = "revoke <client-id>"
+	// UseHubPolicy is the Use string for hub policy.
+	UseHubPolicy = "policy"
+	// UseHubPolicyShow is the Use string for hub policy show.
+	UseHubPolicyShow = "show <client-id>"
+	// UseHubPolicySet is the Use string for hub policy set.
+	UseHubPolicySet = "set <client-id>"
 
 	// DescKeyHub is the desc key for the hub command.
 	DescKeyHub = "hub"
@@ -37,4 +43,10 @@ const (
 	DescKeyHubStepdown = "hub.stepdown"
 	// DescKeyHubRevoke is the desc key for hub revoke.
 	DescKeyHubRevoke = "hub.revoke"
+	// DescKeyHubPolicy is the desc key for hub policy.
+	DescKeyHubPolicy = "hub.policy"
+	// DescKeyHubPolicyShow is the desc key for hub policy show.
+	DescKeyHubPolicyShow = "hub.policy.show"
+	// DescKeyHubPolicySet is the desc key for hub policy set.
+	DescKeyHubPolicySet = "hub.policy.set"
 )
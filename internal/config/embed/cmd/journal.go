@@ -12,10 +12,15 @@ const (
 	UseJournal = "journal"
 	// UseJournalObsidian is the cobra Use string for the journal obsidian command.
 	UseJournalObsidian = "obsidian"
+	// UseJournalRedact is the cobra Use string for the journal redact
+	// command.
+	UseJournalRedact = "redact [pattern...]"
 	// UseJournalSite is the cobra Use string for the journal site command.
 	UseJournalSite = "site"
 	// UseJournalSource is the cobra Use string for the journal source command.
 	UseJournalSource = "source"
+	// UseJournalStats is the cobra Use string for the journal stats command.
+	UseJournalStats = "stats"
 )
 
 // DescKeys for journal subcommands.
@@ -25,8 +30,13 @@ const (
 	// DescKeyJournalObsidian is the description key for the journal obsidian
 	// command.
 	DescKeyJournalObsidian = "journal.obsidian"
+	// DescKeyJournalRedact is the description key for the journal redact
+	// command.
+	DescKeyJournalRedact = "journal.redact"
 	// DescKeyJournalSite is the description key for the journal site command.
 	DescKeyJournalSite = "journal.site"
 	// DescKeyJournalSource is the description key for the journal source command.
 	DescKeyJournalSource = "journal.source"
+	// DescKeyJournalStats is the description key for the journal stats command.
+	DescKeyJournalStats = "journal.stats"
 )
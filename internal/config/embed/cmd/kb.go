@@ -20,6 +20,8 @@ const (
 	UseKBNote = "note \"<text>\""
 	// UseKBReindex is the cobra use string for `ctx kb reindex`.
 	UseKBReindex = "reindex"
+	// UseKBSite is the cobra use string for `ctx kb site`.
+	UseKBSite = "site"
 	// UseKBSiteReview is the cobra use string for
 	// `ctx kb site-review`.
 	UseKBSiteReview = "site-review"
@@ -47,6 +49,8 @@ const (
 	// DescKeyKBReindex is the description key for
 	// `ctx kb reindex`.
 	DescKeyKBReindex = "kb.reindex"
+	// DescKeyKBSite is the description key for `ctx kb site`.
+	DescKeyKBSite = "kb.site"
 	// DescKeyKBSiteReview is the description key for
 	// `ctx kb site-review`.
 	DescKeyKBSiteReview = "kb.site-review"
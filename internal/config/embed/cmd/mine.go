@@ -0,0 +1,28 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+// Use strings for the mine command and its subcommands.
+const (
+	// UseMine is the cobra Use string for the mine command.
+	UseMine = "mine"
+)
+
+// DescKeys for the mine command and its subcommands.
+const (
+	// DescKeyMine is the description key for the mine command.
+	DescKeyMine = "mine"
+	// DescKeyMineReview is the description key for the mine review
+	// subcommand.
+	DescKeyMineReview = "mine.review"
+	// DescKeyMineAccept is the description key for the mine accept
+	// subcommand.
+	DescKeyMineAccept = "mine.accept"
+	// DescKeyMineReject is the description key for the mine reject
+	// subcommand.
+	DescKeyMineReject = "mine.reject"
+)
@@ -0,0 +1,21 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+// Use strings for the review-queue subcommands ctx mine and ctx
+// align share.
+const (
+	// UseQueueReview is the cobra Use string for the review
+	// subcommand.
+	UseQueueReview = "review"
+	// UseQueueAccept is the cobra Use string for the accept
+	// subcommand (takes candidate id arguments).
+	UseQueueAccept = "accept [id...]"
+	// UseQueueReject is the cobra Use string for the reject
+	// subcommand (takes candidate id arguments).
+	UseQueueReject = "reject [id...]"
+)
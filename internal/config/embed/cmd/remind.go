@@ -22,6 +22,8 @@ const (
 	// UseRemindListAlias is the cobra Use string for the remind list alias
 	// command.
 	UseRemindListAlias = "ls"
+	// UseRemindSnooze is the cobra Use string for the remind snooze command.
+	UseRemindSnooze = "snooze ID DURATION"
 )
 
 // DescKeys for remind subcommands.
@@ -36,4 +38,6 @@ const (
 	DescKeyRemindList = "remind.list"
 	// DescKeyRemindNormalize is the description key for remind normalize.
 	DescKeyRemindNormalize = "remind.normalize"
+	// DescKeyRemindSnooze is the description key for the remind snooze command.
+	DescKeyRemindSnooze = "remind.snooze"
 )
@@ -16,6 +16,10 @@ const (
 	UseSkillList = "list"
 	// UseSkillRemove is the cobra Use string for the skill remove command.
 	UseSkillRemove = "remove <name>"
+	// UseSkillUpdate is the cobra Use string for the skill update command.
+	UseSkillUpdate = "update [name...]"
+	// UseSkillVerify is the cobra Use string for the skill verify command.
+	UseSkillVerify = "verify"
 )
 
 // DescKeys for skill subcommands.
@@ -28,4 +32,8 @@ const (
 	DescKeySkillList = "skill.list"
 	// DescKeySkillRemove is the description key for the skill remove command.
 	DescKeySkillRemove = "skill.remove"
+	// DescKeySkillUpdate is the description key for the skill update command.
+	DescKeySkillUpdate = "skill.update"
+	// DescKeySkillVerify is the description key for the skill verify command.
+	DescKeySkillVerify = "skill.verify"
 )
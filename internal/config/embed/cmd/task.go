@@ -12,6 +12,8 @@ const (
 	UseTaskAdd = "add [content]"
 	// UseTaskArchive is the cobra Use string for the task archive command.
 	UseTaskArchive = "archive"
+	// UseTaskGraph is the cobra Use string for the task graph command.
+	UseTaskGraph = "graph"
 	// UseTaskSnapshot is the cobra Use string for the task snapshot command.
 	UseTaskSnapshot = "snapshot [name]"
 )
@@ -24,6 +26,8 @@ const (
 	DescKeyTaskAdd = "task.add"
 	// DescKeyTaskArchive is the description key for the task archive command.
 	DescKeyTaskArchive = "task.archive"
+	// DescKeyTaskGraph is the description key for the task graph command.
+	DescKeyTaskGraph = "task.graph"
 	// DescKeyTaskSnapshot is the description key for the task snapshot command.
 	DescKeyTaskSnapshot = "task.snapshot"
 )
@@ -0,0 +1,36 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package flag
+
+// DescKeys for ai command flags.
+const (
+	// DescKeyAIBackend is the description key for the ai --backend
+	// flag.
+	DescKeyAIBackend = "ai.backend"
+	// DescKeyAIClassifyAll is the description key for the ai
+	// classify --all flag that also sends rule-classified entries.
+	DescKeyAIClassifyAll = "ai.classify.all"
+)
+
+// DescKeys for ctx setup --backend flags.
+const (
+	// DescKeySetupBackend is the description key for the setup
+	// --backend flag.
+	DescKeySetupBackend = "setup.backend"
+	// DescKeySetupEndpoint is the description key for the setup
+	// --endpoint flag.
+	DescKeySetupEndpoint = "setup.endpoint"
+	// DescKeySetupAPIKeyEnv is the description key for the setup
+	// --api-key-env flag.
+	DescKeySetupAPIKeyEnv = "setup.api-key-env"
+	// DescKeySetupModel is the description key for the setup
+	// --model flag.
+	DescKeySetupModel = "setup.model"
+	// DescKeySetupTimeout is the description key for the setup
+	// --timeout flag.
+	DescKeySetupTimeout = "setup.timeout"
+)
@@ -0,0 +1,26 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package flag
+
+// DescKeys for align command flags.
+const (
+	// DescKeyAlignSince is the description key for the align --since
+	// flag.
+	DescKeyAlignSince = "align.since"
+	// DescKeyAlignUntil is the description key for the align --until
+	// flag.
+	DescKeyAlignUntil = "align.until"
+	// DescKeyAlignAllProjects is the description key for the align
+	// --all-projects flag.
+	DescKeyAlignAllProjects = "align.all-projects"
+	// DescKeyAlignForce is the description key for the align --force
+	// flag that re-scans sessions already in the ledger.
+	DescKeyAlignForce = "align.force"
+	// DescKeyAlignAll is the description key for the align accept
+	// and reject --all flag.
+	DescKeyAlignAll = "align.all"
+)
@@ -10,4 +10,34 @@ package flag
 const (
 	// DescKeyConnectionToken is the text key for connection register --token.
 	DescKeyConnectionToken = "connection.token"
+	// DescKeyConnectionReason is the text key for connection
+	// retract and publish --reason.
+	DescKeyConnectionReason = "connection.reason"
+	// DescKeyConnectionSupersedes is the text key for
+	// connection publish --supersedes.
+	DescKeyConnectionSupersedes = "connection.supersedes"
+	// DescKeyConnectionSearchType is the text key for
+	// connection search --type.
+	DescKeyConnectionSearchType = "connection.search.type"
+	// DescKeyConnectionSearchOrigin is the text key for
+	// connection search --origin.
+	DescKeyConnectionSearchOrigin = "connection.search.origin"
+	// DescKeyConnectionSearchSince is the text key for
+	// connection search --since.
+	DescKeyConnectionSearchSince = "connection.search.since"
+	// DescKeyConnectionSearchUntil is the text key for
+	// connection search --until.
+	DescKeyConnectionSearchUntil = "connection.search.until"
+	// DescKeyConnectionSearchLimit is the text key for
+	// connection search --limit.
+	DescKeyConnectionSearchLimit = "connection.search.limit"
+	// DescKeyConnectionTLSCA is the text key for connection
+	// register --tls-ca.
+	DescKeyConnectionTLSCA = "connection.tls-ca"
+	// DescKeyConnectionTLSCert is the text key for connection
+	// register --tls-cert.
+	DescKeyConnectionTLSCert = "connection.tls-cert"
+	// DescKeyConnectionTLSKey is the text key for connection
+	// register --tls-key.
+	DescKeyConnectionTLSKey = "connection.tls-key"
 )
@@ -0,0 +1,26 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package flag
+
+// DescKeys for fleet command flags.
+const (
+	// DescKeyFleetClaimAgent is the description key for the fleet
+	// claim --agent flag.
+	DescKeyFleetClaimAgent = "fleet.claim.agent"
+	// DescKeyFleetClaimLease is the description key for the fleet
+	// claim --lease flag.
+	DescKeyFleetClaimLease = "fleet.claim.lease"
+	// DescKeyFleetAssignAgent is the description key for the fleet
+	// assign --agent flag.
+	DescKeyFleetAssignAgent = "fleet.assign.agent"
+	// DescKeyFleetAssignLease is the description key for the fleet
+	// assign --lease flag.
+	DescKeyFleetAssignLease = "fleet.assign.lease"
+	// DescKeyFleetReleaseAgent is the description key for the
+	// fleet release --agent flag.
+	DescKeyFleetReleaseAgent = "fleet.release.agent"
+)
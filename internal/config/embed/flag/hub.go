@@ -16,8 +16,31 @@ const (
 	DescKeyHubStartDaemon = "hub.start.daemon"
 	// DescKeyHubStartPeers is the text key for hub start --peers.
 	DescKeyHubStartPeers = "hub.start.peers"
+	// DescKeyHubStartTLSCert is the text key for hub start --tls-cert.
+	DescKeyHubStartTLSCert = "hub.start.tls-cert"
+	// DescKeyHubStartTLSKey is the text key for hub start --tls-key.
+	DescKeyHubStartTLSKey = "hub.start.tls-key"
+	// DescKeyHubStartTLSCA is the text key for hub start --tls-ca.
+	DescKeyHubStartTLSCA = "hub.start.tls-ca"
+	// DescKeyHubStartAdminAddr is the text key for hub start
+	// --admin-addr.
+	DescKeyHubStartAdminAddr = "hub.start.admin-addr"
 	// DescKeyHubStopDataDir is the text key for hub stop --data-dir.
 	DescKeyHubStopDataDir = "hub.stop.data-dir"
 	// DescKeyHubRevokeAuth is the text key for hub revoke --token.
 	DescKeyHubRevokeAuth = "hub.revoke.token"
+	// DescKeyHubPolicyAuth is the text key for hub policy --token.
+	DescKeyHubPolicyAuth = "hub.policy.token"
+	// DescKeyHubPolicyReadOnly is the text key for hub policy set
+	// --read-only.
+	DescKeyHubPolicyReadOnly = "hub.policy.read-only"
+	// DescKeyHubPolicyPublish is the text key for hub policy set
+	// --publish.
+	DescKeyHubPolicyPublish = "hub.policy.publish"
+	// DescKeyHubPolicyReadType is the text key for hub policy set
+	// --read-type.
+	DescKeyHubPolicyReadType = "hub.policy.read-type"
+	// DescKeyHubPolicyReadOrigin is the text key for hub policy
+	// set --read-origin.
+	DescKeyHubPolicyReadOrigin = "hub.policy.read-origin"
 )
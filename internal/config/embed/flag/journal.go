@@ -20,6 +20,9 @@ const (
 	// DescKeyJournalSiteServe is the description key for the journal site serve
 	// flag.
 	DescKeyJournalSiteServe = "journal.site.serve"
+	// DescKeyJournalSiteZensical is the description key for the journal site
+	// zensical flag.
+	DescKeyJournalSiteZensical = "journal.site.zensical"
 )
 
 // DescKeys for journal source flags.
@@ -52,3 +55,35 @@ const (
 	// until flag.
 	DescKeyJournalSourceUntil = "journal.source.until"
 )
+
+// DescKeys for journal redact flags.
+const (
+	// DescKeyJournalRedactCheck is the description key for the journal
+	// redact check flag.
+	DescKeyJournalRedactCheck = "journal.redact.check"
+)
+
+// DescKeys for journal stats flags.
+const (
+	// DescKeyJournalStatsAllProjects is the description key for the journal
+	// stats all projects flag.
+	DescKeyJournalStatsAllProjects = "journal.stats.all-projects"
+	// DescKeyJournalStatsJSON is the description key for the journal stats
+	// json flag.
+	DescKeyJournalStatsJSON = "journal.stats.json"
+	// DescKeyJournalStatsMarkdown is the description key for the journal
+	// stats markdown flag.
+	DescKeyJournalStatsMarkdown = "journal.stats.markdown"
+	// DescKeyJournalStatsProject is the description key for the journal stats
+	// project flag.
+	DescKeyJournalStatsProject = "journal.stats.project"
+	// DescKeyJournalStatsSince is the description key for the journal stats
+	// since flag.
+	DescKeyJournalStatsSince = "journal.stats.since"
+	// DescKeyJournalStatsTool is the description key for the journal stats
+	// tool flag.
+	DescKeyJournalStatsTool = "journal.stats.tool"
+	// DescKeyJournalStatsUntil is the description key for the journal stats
+	// until flag.
+	DescKeyJournalStatsUntil = "journal.stats.until"
+)
@@ -0,0 +1,16 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package flag
+
+// DescKeys for kb command flags.
+const (
+	// DescKeyKBSiteOutput is the description key for the kb site output
+	// flag.
+	DescKeyKBSiteOutput = "kb.site.output"
+	// DescKeyKBSiteServe is the description key for the kb site serve flag.
+	DescKeyKBSiteServe = "kb.site.serve"
+)
@@ -0,0 +1,18 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package flag
+
+// DescKeys for the mcp command group flags.
+const (
+	// DescKeyMcpServeHTTP is the text key for mcp serve --http.
+	DescKeyMcpServeHTTP = "mcp.serve.http"
+	// DescKeyMcpServeToken is the text key for mcp serve --token.
+	DescKeyMcpServeToken = "mcp.serve.token"
+	// DescKeyMcpServeSessionTimeout is the text key for
+	// mcp serve --session-timeout.
+	DescKeyMcpServeSessionTimeout = "mcp.serve.session-timeout"
+)
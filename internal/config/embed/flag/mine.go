@@ -0,0 +1,26 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package flag
+
+// DescKeys for mine command flags.
+const (
+	// DescKeyMineSince is the description key for the mine --since
+	// flag.
+	DescKeyMineSince = "mine.since"
+	// DescKeyMineUntil is the description key for the mine --until
+	// flag.
+	DescKeyMineUntil = "mine.until"
+	// DescKeyMineLimit is the description key for the mine --limit
+	// flag.
+	DescKeyMineLimit = "mine.limit"
+	// DescKeyMineForce is the description key for the mine --force
+	// flag that re-mines commits already in the ledger.
+	DescKeyMineForce = "mine.force"
+	// DescKeyMineAll is the description key for the mine accept and
+	// reject --all flag.
+	DescKeyMineAll = "mine.all"
+)
@@ -10,9 +10,22 @@ package flag
 const (
 	// DescKeyRemindAddAfter is the description key for the remind add after flag.
 	DescKeyRemindAddAfter = "remind.add.after"
+	// DescKeyRemindAddBranch is the description key for the remind add
+	// branch flag.
+	DescKeyRemindAddBranch = "remind.add.branch"
+	// DescKeyRemindAddEvery is the description key for the remind add every
+	// flag.
+	DescKeyRemindAddEvery = "remind.add.every"
 	// DescKeyRemindAfter is the description key for the remind after flag.
 	DescKeyRemindAfter = "remind.after"
+	// DescKeyRemindBranch is the description key for the remind branch flag.
+	DescKeyRemindBranch = "remind.branch"
 	// DescKeyRemindDismissAll is the description key for the remind dismiss all
 	// flag.
 	DescKeyRemindDismissAll = "remind.dismiss.all"
+	// DescKeyRemindDismissStop is the description key for the remind dismiss
+	// stop flag.
+	DescKeyRemindDismissStop = "remind.dismiss.stop"
+	// DescKeyRemindEvery is the description key for the remind every flag.
+	DescKeyRemindEvery = "remind.every"
 )
@@ -0,0 +1,20 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package flag
+
+// DescKeys for search command flags.
+const (
+	// DescKeySearchJSON is the description key for the search
+	// json flag.
+	DescKeySearchJSON = "search.json"
+	// DescKeySearchLimit is the description key for the search
+	// limit flag.
+	DescKeySearchLimit = "search.limit"
+	// DescKeySearchRebuild is the description key for the
+	// search rebuild flag.
+	DescKeySearchRebuild = "search.rebuild"
+)
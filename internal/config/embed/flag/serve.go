@@ -6,9 +6,15 @@
 
 package flag
 
-// Serve command flags currently have no overridable descriptions;
-// ctx serve only takes a positional [directory] argument.
+// DescKeys for serve command flags.
 //
 // Hub server flags (port, data-dir, daemon, peers) live in hub.go
 // because they belong to `ctx hub start` / `ctx hub stop`, not
 // `ctx serve`.
+const (
+	// DescKeyServeAddr is the description key for the serve addr flag.
+	DescKeyServeAddr = "serve.addr"
+	// DescKeyServeZensical is the description key for the serve zensical
+	// flag.
+	DescKeyServeZensical = "serve.zensical"
+)
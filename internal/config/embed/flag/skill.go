@@ -0,0 +1,14 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package flag
+
+// DescKeys for skill command flags.
+const (
+	// DescKeySkillUpdateForce is the description key for the skill update
+	// force flag.
+	DescKeySkillUpdateForce = "skill.update.force"
+)
@@ -0,0 +1,52 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package text
+
+// DescKeys for the ctx ai classify prompt.
+const (
+	// DescKeyAIClassifySystem is the text key for the system
+	// instruction sent with each MEMORY.md entry.
+	DescKeyAIClassifySystem = "ai.classify-system"
+)
+
+// DescKeys for ctx ai user-facing write output.
+const (
+	// DescKeyWriteAIPing is the text key for a successful
+	// reachability check.
+	DescKeyWriteAIPing = "write.ai-ping"
+	// DescKeyWriteAIClassifyEntry is the text key for one proposed
+	// classification.
+	DescKeyWriteAIClassifyEntry = "write.ai-classify-entry"
+	// DescKeyWriteAIClassifyNone is the text key for a run with no
+	// entries to send.
+	DescKeyWriteAIClassifyNone = "write.ai-classify-none"
+	// DescKeyWriteAIClassifySummary is the text key for the
+	// post-run summary naming the proposal file.
+	DescKeyWriteAIClassifySummary = "write.ai-classify-summary"
+)
+
+// DescKeys for ctx setup --backend output.
+const (
+	// DescKeyWriteSetupBackend is the text key for the configured
+	// backend confirmation.
+	DescKeyWriteSetupBackend = "write.setup-backend"
+	// DescKeyWriteSetupBackendDefault is the text key for the
+	// backends.default confirmation.
+	DescKeyWriteSetupBackendDefault = "write.setup-backend-default"
+	// DescKeyWriteSetupBackendEnvHint is the text key for the
+	// downstream-tool base URL hint.
+	DescKeyWriteSetupBackendEnvHint = "write.setup-backend-env-hint"
+	// DescKeyWriteSetupBackendEnvConflict is the text key for the
+	// warning when the base URL variable is already set elsewhere.
+	DescKeyWriteSetupBackendEnvConflict = "write.setup-backend-env-conflict"
+	// DescKeyWriteSetupBackendKeyUnset is the text key for the
+	// reminder to export the API key variable.
+	DescKeyWriteSetupBackendKeyUnset = "write.setup-backend-key-unset"
+	// DescKeyWriteSetupBackendNext is the text key for the
+	// follow-up ping suggestion.
+	DescKeyWriteSetupBackendNext = "write.setup-backend-next"
+)
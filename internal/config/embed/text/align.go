@@ -0,0 +1,60 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package text
+
+// DescKeys for the fields ctx align fills on a candidate entry.
+const (
+	// DescKeyAlignContext is the text key for a candidate's
+	// context, citing the session and the agent's assumption.
+	DescKeyAlignContext = "align.context"
+	// DescKeyAlignLesson is the text key for a learning lesson
+	// built from the user's restated intent.
+	DescKeyAlignLesson = "align.lesson"
+	// DescKeyAlignApplication is the text key for a learning
+	// application.
+	DescKeyAlignApplication = "align.application"
+	// DescKeyAlignTools is the text key for an assumption taken
+	// from the tools an interrupted turn called.
+	DescKeyAlignTools = "align.tools"
+	// DescKeyAlignUnknown is the text key for an assumption when no
+	// assistant turn precedes the correction.
+	DescKeyAlignUnknown = "align.unknown"
+)
+
+// DescKeys for ctx align user-facing write output.
+const (
+	// DescKeyWriteAlignSummary is the text key for the post-run
+	// summary when candidates were proposed.
+	DescKeyWriteAlignSummary = "write.align-summary"
+	// DescKeyWriteAlignNothing is the text key for the post-run
+	// summary when nothing new was found.
+	DescKeyWriteAlignNothing = "write.align-nothing"
+	// DescKeyWriteAlignReviewNone is the text key for the
+	// no-pending-candidates review message.
+	DescKeyWriteAlignReviewNone = "write.align-review-none"
+	// DescKeyWriteAlignReviewHeader is the text key for the review
+	// header with the pending count and review file.
+	DescKeyWriteAlignReviewHeader = "write.align-review-header"
+	// DescKeyWriteAlignReviewItem is the text key for one
+	// candidate's id, kind, and title line.
+	DescKeyWriteAlignReviewItem = "write.align-review-item"
+	// DescKeyWriteAlignReviewSource is the text key for one
+	// candidate's source session line.
+	DescKeyWriteAlignReviewSource = "write.align-review-source"
+	// DescKeyWriteAlignReviewAssumption is the text key for one
+	// candidate's diverging-assumption line.
+	DescKeyWriteAlignReviewAssumption = "write.align-review-assumption"
+	// DescKeyWriteAlignReviewSignals is the text key for one
+	// candidate's signals line.
+	DescKeyWriteAlignReviewSignals = "write.align-review-signals"
+	// DescKeyWriteAlignAccepted is the text key for an accepted
+	// candidate.
+	DescKeyWriteAlignAccepted = "write.align-accepted"
+	// DescKeyWriteAlignRejected is the text key for a rejected
+	// candidate.
+	DescKeyWriteAlignRejected = "write.align-rejected"
+)
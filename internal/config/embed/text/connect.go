@@ -20,6 +20,18 @@ const (
 	// DescKeyWriteConnectPublished is the format string for
 	// publish entry count.
 	DescKeyWriteConnectPublished = "write.connect-published"
+	// DescKeyWriteConnectRetracted is the format string for
+	// a published retraction.
+	DescKeyWriteConnectRetracted = "write.connect-retracted"
+	// DescKeyWriteConnectSearchHit is the format string for
+	// one hub search match.
+	DescKeyWriteConnectSearchHit = "write.connect-search-hit"
+	// DescKeyWriteConnectSearchNone is the message shown when
+	// a hub search matches nothing.
+	DescKeyWriteConnectSearchNone = "write.connect-search-none"
+	// DescKeyWriteConnectSearchMore is the format string for
+	// the hint that a hub search hit its limit.
+	DescKeyWriteConnectSearchMore = "write.connect-search-more"
 	// DescKeyWriteConnectListening is the message shown when
 	// entering listen mode.
 	DescKeyWriteConnectListening = "write.connect-listening"
@@ -38,6 +50,12 @@ const (
 	// DescKeyWriteConnectHubSync is the format string for
 	// hub sync status messages.
 	DescKeyWriteConnectHubSync = "write.connect-hub-sync"
+	// DescKeyWriteConnectFingerprintHub is the format string
+	// for the pinned hub certificate fingerprint.
+	DescKeyWriteConnectFingerprintHub = "write.connect-fingerprint-hub"
+	// DescKeyWriteConnectFingerprintClient is the format
+	// string for the client certificate fingerprint.
+	DescKeyWriteConnectFingerprintClient = "write.connect-fingerprint-client"
 )
 
 // DescKeys for agent section headings.
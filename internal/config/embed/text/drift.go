@@ -120,6 +120,21 @@ const (
 	// DescKeyDriftStaleSyncFile is the text key for drift stale sync file
 	// messages.
 	DescKeyDriftStaleSyncFile = "drift.stale-sync-file"
+	// DescKeyDriftTaskCycle is the text key for a task
+	// dependency cycle found in TASKS.md.
+	DescKeyDriftTaskCycle = "drift.task-cycle"
+	// DescKeyDriftCheckTaskDeps is the text key for drift check
+	// task dependencies messages.
+	DescKeyDriftCheckTaskDeps = "drift.check-task-deps"
+	// DescKeyDriftSkillModified is the text key for an installed
+	// skill that no longer matches skills.lock.
+	DescKeyDriftSkillModified = "drift.skill-modified"
+	// DescKeyDriftSkillMissing is the text key for a locked skill
+	// that is not installed.
+	DescKeyDriftSkillMissing = "drift.skill-missing"
+	// DescKeyDriftCheckSkillLock is the text key for drift check
+	// skill lock messages.
+	DescKeyDriftCheckSkillLock = "drift.check-skill-lock"
 	// DescKeyDriftToolSuffix is the text key for drift tool suffix messages.
 	DescKeyDriftToolSuffix = "drift.tool-suffix"
 	// DescKeyVersionDriftRelayMessage is the text key for version drift relay
@@ -0,0 +1,79 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package text
+
+// DescKeys for AI backend errors.
+const (
+	// DescKeyErrBackendNotConfigured is the text key for AI commands
+	// run with no backend configured.
+	DescKeyErrBackendNotConfigured = "err.backend.not-configured"
+	// DescKeyErrBackendAmbiguous is the text key for several
+	// backends with no selector.
+	DescKeyErrBackendAmbiguous = "err.backend.ambiguous"
+	// DescKeyErrBackendNotFound is the text key for a selected
+	// backend with no .ctxrc entry.
+	DescKeyErrBackendNotFound = "err.backend.not-found"
+	// DescKeyErrBackendUnknownDefault is the text key for a
+	// backends.default naming no entry.
+	DescKeyErrBackendUnknownDefault = "err.backend.unknown-default"
+	// DescKeyErrBackendUnknownType is the text key for an
+	// unregistered backend name.
+	DescKeyErrBackendUnknownType = "err.backend.unknown-type"
+	// DescKeyErrBackendMissingKey is the text key for a required
+	// backend key that is unset.
+	DescKeyErrBackendMissingKey = "err.backend.missing-key"
+	// DescKeyErrBackendInvalidEndpoint is the text key for an
+	// endpoint that is not an http(s) URL.
+	DescKeyErrBackendInvalidEndpoint = "err.backend.invalid-endpoint"
+	// DescKeyErrBackendInvalidTimeout is the text key for a timeout
+	// that is not a positive duration.
+	DescKeyErrBackendInvalidTimeout = "err.backend.invalid-timeout"
+	// DescKeyErrBackendUnreachable is the text key for transport
+	// failures.
+	DescKeyErrBackendUnreachable = "err.backend.unreachable"
+	// DescKeyErrBackendTimeout is the text key for timed-out
+	// requests.
+	DescKeyErrBackendTimeout = "err.backend.timeout"
+	// DescKeyErrBackendAuth is the text key for upstream 401/403.
+	DescKeyErrBackendAuth = "err.backend.auth"
+	// DescKeyErrBackendModel is the text key for an upstream 404 on
+	// a completion.
+	DescKeyErrBackendModel = "err.backend.model"
+	// DescKeyErrBackendUpstream is the text key for other non-2xx
+	// responses.
+	DescKeyErrBackendUpstream = "err.backend.upstream"
+	// DescKeyErrBackendRequest is the text key for request build
+	// failures.
+	DescKeyErrBackendRequest = "err.backend.request"
+	// DescKeyErrBackendDecode is the text key for undecodable
+	// responses.
+	DescKeyErrBackendDecode = "err.backend.decode"
+	// DescKeyErrBackendTooLarge is the text key for a reply
+	// body over the read cap.
+	DescKeyErrBackendTooLarge = "err.backend.too-large"
+	// DescKeyErrBackendNoModel is the text key for a backend with
+	// no configured or listed model.
+	DescKeyErrBackendNoModel = "err.backend.no-model"
+	// DescKeyErrBackendEmptyReply is the text key for a completion
+	// with no choices.
+	DescKeyErrBackendEmptyReply = "err.backend.empty-reply"
+	// DescKeyErrBackendAnswer is the text key for a structured
+	// answer that does not match its schema.
+	DescKeyErrBackendAnswer = "err.backend.answer"
+	// DescKeyErrBackendParseConfig is the text key for a .ctxrc
+	// that cannot be parsed for editing.
+	DescKeyErrBackendParseConfig = "err.backend.parse-config"
+	// DescKeyErrBackendWrite is the text key for .ctxrc and
+	// proposal write failures.
+	DescKeyErrBackendWrite = "err.backend.write"
+	// DescKeyErrBackendAuthHint is the text key for the credential
+	// hint when no api_key_env is configured.
+	DescKeyErrBackendAuthHint = "err.backend.auth-hint"
+	// DescKeyErrBackendNotMapping is the text key for a .ctxrc
+	// whose top level is not a mapping.
+	DescKeyErrBackendNotMapping = "err.backend.not-mapping"
+)
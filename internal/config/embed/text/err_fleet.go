@@ -0,0 +1,41 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package text
+
+// DescKeys for ctx fleet errors.
+const (
+	// DescKeyErrFleetNoAgent is the text key for a lease command
+	// run without an agent ID.
+	DescKeyErrFleetNoAgent = "err.fleet.no-agent"
+	// DescKeyErrFleetUnknownAgent is the text key for an agent ID
+	// missing from fleet.yaml.
+	DescKeyErrFleetUnknownAgent = "err.fleet.unknown-agent"
+	// DescKeyErrFleetNotConnected is the text key for a fleet
+	// command run without a hub connection.
+	DescKeyErrFleetNotConnected = "err.fleet.not-connected"
+	// DescKeyErrFleetTaskNotFound is the text key for a task
+	// query that matches nothing.
+	DescKeyErrFleetTaskNotFound = "err.fleet.task-not-found"
+	// DescKeyErrFleetTaskAmbiguous is the text key for a task
+	// query that matches several tasks.
+	DescKeyErrFleetTaskAmbiguous = "err.fleet.task-ambiguous"
+	// DescKeyErrFleetInvalidLease is the text key for an
+	// out-of-range --lease.
+	DescKeyErrFleetInvalidLease = "err.fleet.invalid-lease"
+	// DescKeyErrFleetParseProfile is the text key for an
+	// unreadable fleet.yaml.
+	DescKeyErrFleetParseProfile = "err.fleet.parse-profile"
+	// DescKeyErrFleetAgentID is the text key for a profile
+	// without an id.
+	DescKeyErrFleetAgentID = "err.fleet.agent-id"
+	// DescKeyErrFleetDuplicateAgent is the text key for an agent
+	// ID listed twice.
+	DescKeyErrFleetDuplicateAgent = "err.fleet.duplicate-agent"
+	// DescKeyErrFleetCostTier is the text key for an unknown
+	// cost tier.
+	DescKeyErrFleetCostTier = "err.fleet.cost-tier"
+)
@@ -25,6 +25,15 @@ const (
 	// DescKeyErrHookInvalidType is the text key for err hook invalid type
 	// messages.
 	DescKeyErrHookInvalidType = "err.hook.invalid-type"
+	// DescKeyErrHookManifest is the text key for a hooks.yaml that
+	// cannot be read or parsed.
+	DescKeyErrHookManifest = "err.hook.manifest"
+	// DescKeyErrHookManifestTimeout is the text key for a
+	// per-hook timeout in hooks.yaml outside the allowed range.
+	DescKeyErrHookManifestTimeout = "err.hook.manifest-timeout"
+	// DescKeyErrHookManifestKey is the text key for a hooks.yaml
+	// key that is not "<type>/<name>".
+	DescKeyErrHookManifestKey = "err.hook.manifest-key"
 	// DescKeyErrHookMarshalInput is the text key for err hook marshal input
 	// messages.
 	DescKeyErrHookMarshalInput = "err.hook.marshal-input"
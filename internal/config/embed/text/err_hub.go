@@ -26,4 +26,22 @@ const (
 	// DescKeyErrHubInvalidPeerAction is the text key for
 	// unrecognized peer action errors.
 	DescKeyErrHubInvalidPeerAction = "err.hub.invalid-peer-action"
+	// DescKeyErrHubTLSKeyPair is the text key for a
+	// certificate/key pair that failed to load.
+	DescKeyErrHubTLSKeyPair = "err.hub.tls-key-pair"
+	// DescKeyErrHubTLSIncomplete is the text key for a
+	// certificate supplied without its key, or vice versa.
+	DescKeyErrHubTLSIncomplete = "err.hub.tls-incomplete"
+	// DescKeyErrHubTLSServerCert is the text key for a
+	// server CA bundle supplied without a server certificate.
+	DescKeyErrHubTLSServerCert = "err.hub.tls-server-cert"
+	// DescKeyErrHubTLSCABundle is the text key for a CA
+	// bundle with no parsable certificates.
+	DescKeyErrHubTLSCABundle = "err.hub.tls-ca-bundle"
+	// DescKeyErrHubTLSNoPeerCert is the text key for a TLS
+	// handshake in which the hub presented no certificate.
+	DescKeyErrHubTLSNoPeerCert = "err.hub.tls-no-peer-cert"
+	// DescKeyErrHubFingerprintMismatch is the text key for a
+	// hub certificate that does not match the pinned one.
+	DescKeyErrHubFingerprintMismatch = "err.hub.fingerprint-mismatch"
 )
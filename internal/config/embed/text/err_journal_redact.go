@@ -0,0 +1,17 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package text
+
+// DescKeys for journal redaction errors.
+const (
+	// DescKeyErrJournalRedactRule is the text key for a project
+	// redaction rule whose pattern does not compile.
+	DescKeyErrJournalRedactRule = "err.journal.redact.rule"
+	// DescKeyErrJournalRedactFound is the text key for secrets
+	// found by redact --check.
+	DescKeyErrJournalRedactFound = "err.journal.redact.found"
+)
@@ -0,0 +1,13 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package text
+
+// DescKeys for ctx mine errors.
+const (
+	// DescKeyErrMineLog is the text key for git log failures.
+	DescKeyErrMineLog = "err.mine.log"
+)
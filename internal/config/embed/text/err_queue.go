@@ -0,0 +1,29 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package text
+
+// DescKeys for review-queue errors shared by ctx mine and ctx align.
+const (
+	// DescKeyErrQueueRead is the text key for state-file read
+	// failures.
+	DescKeyErrQueueRead = "err.queue.read"
+	// DescKeyErrQueueDecode is the text key for state-file decode
+	// failures.
+	DescKeyErrQueueDecode = "err.queue.decode"
+	// DescKeyErrQueueEncode is the text key for state-file encode
+	// failures.
+	DescKeyErrQueueEncode = "err.queue.encode"
+	// DescKeyErrQueueWrite is the text key for state-file write
+	// failures.
+	DescKeyErrQueueWrite = "err.queue.write"
+	// DescKeyErrQueueNotFound is the text key for an unknown
+	// candidate ID.
+	DescKeyErrQueueNotFound = "err.queue.not-found"
+	// DescKeyErrQueueNoSelection is the text key for accept or
+	// reject with no IDs and no --all.
+	DescKeyErrQueueNoSelection = "err.queue.no-selection"
+)
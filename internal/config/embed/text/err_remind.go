@@ -20,4 +20,10 @@ const (
 	// DescKeyErrReminderNotFound is the text key for err reminder not found
 	// messages.
 	DescKeyErrReminderNotFound = "err.reminder.reminder-not-found"
+	// DescKeyErrReminderInvalidRule is the text key for an --every
+	// value that is neither a shorthand nor a supported RRULE.
+	DescKeyErrReminderInvalidRule = "err.reminder.invalid-rule"
+	// DescKeyErrReminderInvalidSnooze is the text key for a snooze
+	// length that cannot be parsed.
+	DescKeyErrReminderInvalidSnooze = "err.reminder.invalid-snooze"
 )
@@ -0,0 +1,20 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package text
+
+// DescKeys for context search errors.
+const (
+	// DescKeyErrSearchNoTerms is the text key for a search
+	// query without any word to rank by.
+	DescKeyErrSearchNoTerms = "err.search.no-terms"
+	// DescKeyErrSearchReadIndex is the text key for a failed
+	// search index read.
+	DescKeyErrSearchReadIndex = "err.search.read-index"
+	// DescKeyErrSearchWriteIndex is the text key for a failed
+	// search index write.
+	DescKeyErrSearchWriteIndex = "err.search.write-index"
+)
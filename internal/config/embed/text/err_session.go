@@ -41,4 +41,12 @@ const (
 	// DescKeyErrSiteNoSiteConfig is the text key for err site no site config
 	// messages.
 	DescKeyErrSiteNoSiteConfig = "err.site.no-site-config"
+	// DescKeyErrSiteNotFound is the text key for err site not found
+	// messages.
+	DescKeyErrSiteNotFound = "err.site.not-found"
+	// DescKeyErrSiteRenderPage is the text key for err site render page
+	// messages.
+	DescKeyErrSiteRenderPage = "err.site.render-page"
+	// DescKeyErrSiteServe is the text key for err site serve messages.
+	DescKeyErrSiteServe = "err.site.serve"
 )
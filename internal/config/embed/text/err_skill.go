@@ -13,6 +13,9 @@ const (
 	DescKeyErrSkillCreateDest = "err.skill.create-dest"
 	// DescKeyErrSkillInstall is the text key for err skill install messages.
 	DescKeyErrSkillInstall = "err.skill.install"
+	// DescKeyErrSkillDigest is the text key for a failure to hash a
+	// skill directory.
+	DescKeyErrSkillDigest = "err.skill.digest"
 	// DescKeyErrSkillInvalidManifest is the text key for err skill invalid
 	// manifest messages.
 	DescKeyErrSkillInvalidManifest = "err.skill.invalid-manifest"
@@ -23,11 +26,26 @@ const (
 	DescKeyErrSkillList = "err.skill.skill-list"
 	// DescKeyErrSkillLoad is the text key for err skill load messages.
 	DescKeyErrSkillLoad = "err.skill.load"
+	// DescKeyErrSkillLockParse is the text key for a skills.lock
+	// parse failure.
+	DescKeyErrSkillLockParse = "err.skill.lock-parse"
+	// DescKeyErrSkillLockRead is the text key for a skills.lock read
+	// failure.
+	DescKeyErrSkillLockRead = "err.skill.lock-read"
+	// DescKeyErrSkillLockWrite is the text key for a skills.lock
+	// write failure.
+	DescKeyErrSkillLockWrite = "err.skill.lock-write"
+	// DescKeyErrSkillMismatch is the text key for skills that do not
+	// match skills.lock.
+	DescKeyErrSkillMismatch = "err.skill.mismatch"
 	// DescKeyErrSkillMissingName is the text key for err skill missing name
 	// messages.
 	DescKeyErrSkillMissingName = "err.skill.missing-name"
 	// DescKeyErrSkillNotFound is the text key for err skill not found messages.
 	DescKeyErrSkillNotFound = "err.skill.not-found"
+	// DescKeyErrSkillNotLocked is the text key for a skill without a
+	// skills.lock entry.
+	DescKeyErrSkillNotLocked = "err.skill.not-locked"
 	// DescKeyErrSkillNotValidDir is the text key for err skill not valid dir
 	// messages.
 	DescKeyErrSkillNotValidDir = "err.skill.not-valid-dir"
@@ -32,4 +32,7 @@ const (
 	DescKeyErrTaskMultipleMatches = "err.task.task-multiple-matches"
 	// DescKeyErrTaskNotFound is the text key for err task not found messages.
 	DescKeyErrTaskNotFound = "err.task.task-not-found"
+	// DescKeyErrTaskGraphFormat is the text key for an unknown
+	// ctx task graph output format.
+	DescKeyErrTaskGraphFormat = "err.task.graph-format"
 )
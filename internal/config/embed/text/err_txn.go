@@ -0,0 +1,17 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package text
+
+// DescKeys for .context write-transaction errors.
+const (
+	// DescKeyErrTxnLock is the text key for a lock file that could
+	// not be created or recorded.
+	DescKeyErrTxnLock = "err.txn.lock"
+	// DescKeyErrTxnTimeout is the text key for a lock still held
+	// by a live writer after the wait.
+	DescKeyErrTxnTimeout = "err.txn.timeout"
+)
@@ -0,0 +1,49 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package text
+
+// DescKeys for ctx fleet user-facing write output.
+const (
+	// DescKeyWriteFleetAgent is the text key for one agent
+	// profile line.
+	DescKeyWriteFleetAgent = "write.fleet-agent"
+	// DescKeyWriteFleetAgentCapabilities is the text key for an
+	// agent's capabilities line.
+	DescKeyWriteFleetAgentCapabilities = "write.fleet-agent-capabilities"
+	// DescKeyWriteFleetNoAgents is the text key for an empty or
+	// missing fleet.yaml.
+	DescKeyWriteFleetNoAgents = "write.fleet-no-agents"
+	// DescKeyWriteFleetUnknown is the text key for a profile
+	// field left unset.
+	DescKeyWriteFleetUnknown = "write.fleet-unknown"
+	// DescKeyWriteFleetStatusHeld is the text key for a pending
+	// task someone holds.
+	DescKeyWriteFleetStatusHeld = "write.fleet-status-held"
+	// DescKeyWriteFleetStatusFree is the text key for a pending
+	// task nobody holds.
+	DescKeyWriteFleetStatusFree = "write.fleet-status-free"
+	// DescKeyWriteFleetStatusStale is the text key for a live
+	// lease whose task is no longer pending.
+	DescKeyWriteFleetStatusStale = "write.fleet-status-stale"
+	// DescKeyWriteFleetStatusNone is the text key for a TASKS.md
+	// with nothing pending.
+	DescKeyWriteFleetStatusNone = "write.fleet-status-none"
+	// DescKeyWriteFleetClaimed is the text key for the claimed
+	// lease label.
+	DescKeyWriteFleetClaimed = "write.fleet-claimed"
+	// DescKeyWriteFleetAssigned is the text key for the assigned
+	// lease label.
+	DescKeyWriteFleetAssigned = "write.fleet-assigned"
+	// DescKeyWriteFleetClaim is the text key for a granted claim.
+	DescKeyWriteFleetClaim = "write.fleet-claim"
+	// DescKeyWriteFleetAssign is the text key for a granted
+	// assignment.
+	DescKeyWriteFleetAssign = "write.fleet-assign"
+	// DescKeyWriteFleetRelease is the text key for a released
+	// lease.
+	DescKeyWriteFleetRelease = "write.fleet-release"
+)
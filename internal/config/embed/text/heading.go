@@ -49,6 +49,9 @@ const (
 	// DescKeyHeadingRecentSessions is the text key for heading recent sessions
 	// messages.
 	DescKeyHeadingRecentSessions = "heading.recent-sessions"
+	// DescKeyHeadingSessionStats is the text key for the session stats
+	// page heading.
+	DescKeyHeadingSessionStats = "heading.session-stats"
 )
 
 // Headings, column headers, and navigation labels (headings.yaml).
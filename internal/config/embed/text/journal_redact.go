@@ -0,0 +1,26 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package text
+
+// DescKeys for journal redaction output.
+const (
+	// DescKeyWriteJournalRedactImported is the text key for the
+	// per-entry redaction count printed during import.
+	DescKeyWriteJournalRedactImported = "write.journal-redact-imported"
+	// DescKeyWriteJournalRedactFinding is the text key for one
+	// secret found by redact --check.
+	DescKeyWriteJournalRedactFinding = "write.journal-redact-finding"
+	// DescKeyWriteJournalRedactClean is the text key for a
+	// redact --check run that found nothing.
+	DescKeyWriteJournalRedactClean = "write.journal-redact-clean"
+	// DescKeyWriteJournalRedactEntry is the text key for an entry
+	// rewritten by redact.
+	DescKeyWriteJournalRedactEntry = "write.journal-redact-entry"
+	// DescKeyWriteJournalRedactSummary is the text key for the
+	// totals of a redact run.
+	DescKeyWriteJournalRedactSummary = "write.journal-redact-summary"
+)
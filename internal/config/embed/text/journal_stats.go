@@ -0,0 +1,28 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package text
+
+// DescKeys for journal stats output.
+const (
+	// DescKeyWriteJournalStatsSummary is the text key for the sessions,
+	// turns, and average length line.
+	DescKeyWriteJournalStatsSummary = "write.journal-stats-summary"
+	// DescKeyWriteJournalStatsTokens is the text key for the token spend
+	// line.
+	DescKeyWriteJournalStatsTokens = "write.journal-stats-tokens"
+	// DescKeyWriteJournalStatsTools is the text key for the tool call
+	// totals line.
+	DescKeyWriteJournalStatsTools = "write.journal-stats-tools"
+	// DescKeyWriteJournalStatsSection is the text key for a breakdown
+	// heading.
+	DescKeyWriteJournalStatsSection = "write.journal-stats-section"
+	// DescKeyWriteJournalStatsRow is the text key for one breakdown row.
+	DescKeyWriteJournalStatsRow = "write.journal-stats-row"
+	// DescKeyWriteJournalStatsToolRow is the text key for one tool call
+	// row.
+	DescKeyWriteJournalStatsToolRow = "write.journal-stats-tool-row"
+)
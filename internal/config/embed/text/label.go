@@ -16,6 +16,38 @@ const (
 	DescKeyLabelFiles = "label.files"
 	// DescKeyLabelTypes is the text key for label types messages.
 	DescKeyLabelTypes = "label.types"
+	// DescKeyLabelStats is the text key for the session stats navigation
+	// label.
+	DescKeyLabelStats = "label.stats"
+)
+
+// DescKeys for session stats sections and columns.
+const (
+	// DescKeyLabelStatsByDay is the text key for the per-day section.
+	DescKeyLabelStatsByDay = "label.stats-by-day"
+	// DescKeyLabelStatsByWeek is the text key for the per-week section.
+	DescKeyLabelStatsByWeek = "label.stats-by-week"
+	// DescKeyLabelStatsByModel is the text key for the per-model section.
+	DescKeyLabelStatsByModel = "label.stats-by-model"
+	// DescKeyLabelStatsByTool is the text key for the per-AI-tool section.
+	DescKeyLabelStatsByTool = "label.stats-by-tool"
+	// DescKeyLabelStatsByBranch is the text key for the per-branch section.
+	DescKeyLabelStatsByBranch = "label.stats-by-branch"
+	// DescKeyLabelStatsToolCalls is the text key for the tool call section.
+	DescKeyLabelStatsToolCalls = "label.stats-tool-calls"
+	// DescKeyLabelStatsColDay is the text key for the day column.
+	DescKeyLabelStatsColDay = "label.stats-col-day"
+	// DescKeyLabelStatsColWeek is the text key for the week column.
+	DescKeyLabelStatsColWeek = "label.stats-col-week"
+	// DescKeyLabelStatsColModel is the text key for the model column.
+	DescKeyLabelStatsColModel = "label.stats-col-model"
+	// DescKeyLabelStatsColTool is the text key for the AI tool column.
+	DescKeyLabelStatsColTool = "label.stats-col-tool"
+	// DescKeyLabelStatsColBranch is the text key for the branch column.
+	DescKeyLabelStatsColBranch = "label.stats-col-branch"
+	// DescKeyLabelStatsUnrecorded is the text key shown for sessions that
+	// did not record a model or branch.
+	DescKeyLabelStatsUnrecorded = "label.stats-unrecorded"
 )
 
 // DescKeys for UI emphasis labels.
@@ -24,6 +24,8 @@ const (
 	DescKeyLabelMetaBranch = "label.meta-branch"
 	// DescKeyLabelMetaModel is the text key for label meta model messages.
 	DescKeyLabelMetaModel = "label.meta-model"
+	// DescKeyLabelMetaCommits is the text key for label meta commits messages.
+	DescKeyLabelMetaCommits = "label.meta-commits"
 	// DescKeyLabelMetaTurns is the text key for label meta turns messages.
 	DescKeyLabelMetaTurns = "label.meta-turns"
 	// DescKeyLabelMetaTokens is the text key for label meta tokens messages.
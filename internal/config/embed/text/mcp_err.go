@@ -22,6 +22,12 @@ const (
 	// DescKeyMCPErrUnknownResource is the text key for mcp err unknown resource
 	// messages.
 	DescKeyMCPErrUnknownResource = "mcp.err-unknown-resource"
+	// DescKeyMCPErrResourceNotFound is the text key for a
+	// templated resource URI that resolves to nothing.
+	DescKeyMCPErrResourceNotFound = "mcp.err-resource-not-found"
+	// DescKeyMCPErrResourceAmbiguous is the text key for a
+	// templated resource URI that matches several resources.
+	DescKeyMCPErrResourceAmbiguous = "mcp.err-resource-ambiguous"
 	// DescKeyMCPErrUnknownTool is the text key for mcp err unknown tool messages.
 	DescKeyMCPErrUnknownTool = "mcp.err-unknown-tool"
 	// DescKeyMCPErrFailedMarshal is the text key for mcp err failed marshal
@@ -46,4 +52,7 @@ const (
 	// DescKeyMCPErrUnknownEntryType is the text key for mcp
 	// err unknown entry type messages.
 	DescKeyMCPErrUnknownEntryType = "mcp.err-unknown-entry-type"
+	// DescKeyMCPErrGenerateToken is the text key for a failed
+	// MCP bearer token or session ID generation.
+	DescKeyMCPErrGenerateToken = "mcp.err-generate-token"
 )
@@ -26,4 +26,16 @@ const (
 	DescKeyMCPResPlaybook = "mcp.res-playbook"
 	// DescKeyMCPResAgent is the text key for mcp res agent messages.
 	DescKeyMCPResAgent = "mcp.res-agent"
+	// DescKeyMCPResTplDecision is the text key for the decision
+	// resource template description.
+	DescKeyMCPResTplDecision = "mcp.res-tpl-decision"
+	// DescKeyMCPResTplLearning is the text key for the learning
+	// resource template description.
+	DescKeyMCPResTplLearning = "mcp.res-tpl-learning"
+	// DescKeyMCPResTplTheme is the text key for the theme
+	// resource template description.
+	DescKeyMCPResTplTheme = "mcp.res-tpl-theme"
+	// DescKeyMCPResTplJournal is the text key for the journal
+	// resource template description.
+	DescKeyMCPResTplJournal = "mcp.res-tpl-journal"
 )
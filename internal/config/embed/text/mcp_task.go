@@ -21,3 +21,29 @@ const (
 	// DescKeyMCPCheckTaskHint is the text key for mcp check task hint messages.
 	DescKeyMCPCheckTaskHint = "mcp.check-task-hint"
 )
+
+// DescKeys for fleet-aware ctx_next output.
+const (
+	// DescKeyMCPNextTaskHeld is the text key for a next task the
+	// calling agent already holds.
+	DescKeyMCPNextTaskHeld = "mcp.next-task-held"
+	// DescKeyMCPNextSkipped is the text key for the count of
+	// tasks passed over because other agents hold them.
+	DescKeyMCPNextSkipped = "mcp.next-skipped"
+	// DescKeyMCPNextAllHeld is the text key for every pending
+	// task being held by other agents.
+	DescKeyMCPNextAllHeld = "mcp.next-all-held"
+	// DescKeyMCPNextLeasesUnavailable is the text key for a hub
+	// lease lookup that failed.
+	DescKeyMCPNextLeasesUnavailable = "mcp.next-leases-unavailable"
+)
+
+// DescKeys for dependency-aware ctx_next output.
+const (
+	// DescKeyMCPNextBlocked is the text key for the count of
+	// tasks passed over because their dependencies are unmet.
+	DescKeyMCPNextBlocked = "mcp.next-blocked"
+	// DescKeyMCPNextAllBlocked is the text key for no pending
+	// task being ready because of dependencies (and leases).
+	DescKeyMCPNextAllBlocked = "mcp.next-all-blocked"
+)
@@ -90,6 +90,27 @@ const (
 	DescKeyMCPToolSteeringGetDesc = "mcp.tool-steering-get-desc"
 	// DescKeyMCPToolSearchDesc is the text key for mcp tool search desc messages.
 	DescKeyMCPToolSearchDesc = "mcp.tool-search-desc"
+	// DescKeyMCPToolHubSearchDesc is the text key for the
+	// ctx_hub_search tool description.
+	DescKeyMCPToolHubSearchDesc = "mcp.tool-hub-search-desc"
+	// DescKeyMCPToolPropHubQuery is the text key for the
+	// ctx_hub_search query property.
+	DescKeyMCPToolPropHubQuery = "mcp.tool-prop-hub-query"
+	// DescKeyMCPToolPropHubType is the text key for the
+	// ctx_hub_search type property.
+	DescKeyMCPToolPropHubType = "mcp.tool-prop-hub-type"
+	// DescKeyMCPToolPropHubOrigin is the text key for the
+	// ctx_hub_search origin property.
+	DescKeyMCPToolPropHubOrigin = "mcp.tool-prop-hub-origin"
+	// DescKeyMCPToolPropHubSince is the text key for the
+	// ctx_hub_search since property.
+	DescKeyMCPToolPropHubSince = "mcp.tool-prop-hub-since"
+	// DescKeyMCPToolPropHubUntil is the text key for the
+	// ctx_hub_search until property.
+	DescKeyMCPToolPropHubUntil = "mcp.tool-prop-hub-until"
+	// DescKeyMCPToolPropHubLimit is the text key for the
+	// ctx_hub_search limit property.
+	DescKeyMCPToolPropHubLimit = "mcp.tool-prop-hub-limit"
 	// DescKeyMCPToolSessionStartDesc is the text key for mcp tool session start
 	// desc messages.
 	DescKeyMCPToolSessionStartDesc = "mcp.tool-session-start-desc"
@@ -101,6 +122,9 @@ const (
 	// DescKeyMCPToolPropSearchQuery is the text key for mcp tool prop search
 	// query messages.
 	DescKeyMCPToolPropSearchQuery = "mcp.tool-prop-search-query"
+	// DescKeyMCPToolPropSearchLimit is the text key for the
+	// ctx_search limit property.
+	DescKeyMCPToolPropSearchLimit = "mcp.tool-prop-search-limit"
 	// DescKeyMCPToolPropSummary is the text key for mcp tool prop summary
 	// messages.
 	DescKeyMCPToolPropSummary = "mcp.tool-prop-summary"
@@ -114,6 +138,18 @@ const (
 	DescKeyMCPSearchHitLine = "mcp.search-hit-line"
 	// DescKeyMCPSearchNoMatch is the text key for mcp search no match messages.
 	DescKeyMCPSearchNoMatch = "mcp.search-no-match"
+	// DescKeyMCPSearchSnippet is the text key for the snippet
+	// line under a ctx_search match.
+	DescKeyMCPSearchSnippet = "mcp.search-snippet"
+	// DescKeyMCPHubSearchHit is the text key for one
+	// ctx_hub_search match.
+	DescKeyMCPHubSearchHit = "mcp.hub-search-hit"
+	// DescKeyMCPHubSearchNone is the text key for a
+	// ctx_hub_search without matches.
+	DescKeyMCPHubSearchNone = "mcp.hub-search-none"
+	// DescKeyMCPHubSearchMore is the text key for the
+	// ctx_hub_search limit hint.
+	DescKeyMCPHubSearchMore = "mcp.hub-search-more"
 )
 
 // DescKeys for MCP session hook output.
@@ -0,0 +1,54 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package text
+
+// DescKeys for the fields ctx mine fills on a candidate entry.
+const (
+	// DescKeyMineContext is the text key for a candidate's context,
+	// citing the source commit.
+	DescKeyMineContext = "mine.context"
+	// DescKeyMineRationale is the text key for a decision rationale
+	// when the commit has no body.
+	DescKeyMineRationale = "mine.rationale"
+	// DescKeyMineConsequence is the text key for a decision
+	// consequence.
+	DescKeyMineConsequence = "mine.consequence"
+	// DescKeyMineApplication is the text key for a learning
+	// application.
+	DescKeyMineApplication = "mine.application"
+)
+
+// DescKeys for ctx mine user-facing write output.
+const (
+	// DescKeyWriteMineSummary is the text key for the post-run
+	// summary when candidates were proposed.
+	DescKeyWriteMineSummary = "write.mine-summary"
+	// DescKeyWriteMineNothing is the text key for the post-run
+	// summary when nothing new was found.
+	DescKeyWriteMineNothing = "write.mine-nothing"
+	// DescKeyWriteMineReviewNone is the text key for the
+	// no-pending-candidates review message.
+	DescKeyWriteMineReviewNone = "write.mine-review-none"
+	// DescKeyWriteMineReviewHeader is the text key for the review
+	// header with the pending count and review file.
+	DescKeyWriteMineReviewHeader = "write.mine-review-header"
+	// DescKeyWriteMineReviewItem is the text key for one candidate's
+	// id, kind, and title line.
+	DescKeyWriteMineReviewItem = "write.mine-review-item"
+	// DescKeyWriteMineReviewSource is the text key for one
+	// candidate's source commit line.
+	DescKeyWriteMineReviewSource = "write.mine-review-source"
+	// DescKeyWriteMineReviewSignals is the text key for one
+	// candidate's signals line.
+	DescKeyWriteMineReviewSignals = "write.mine-review-signals"
+	// DescKeyWriteMineAccepted is the text key for an accepted
+	// candidate.
+	DescKeyWriteMineAccepted = "write.mine-accepted"
+	// DescKeyWriteMineRejected is the text key for a rejected
+	// candidate.
+	DescKeyWriteMineRejected = "write.mine-rejected"
+)
@@ -29,4 +29,24 @@ const (
 	// DescKeyWriteReminderNotDue is the text key for write reminder not due
 	// messages.
 	DescKeyWriteReminderNotDue = "write.reminder-not-due"
+	// DescKeyWriteReminderRescheduled is the text key for a
+	// recurring reminder moved to its next occurrence.
+	DescKeyWriteReminderRescheduled = "write.reminder-rescheduled"
+	// DescKeyWriteReminderSnoozed is the text key for a snoozed
+	// reminder.
+	DescKeyWriteReminderSnoozed = "write.reminder-snoozed"
+)
+
+// DescKeys for reminder schedule annotations, shared by ctx
+// remind list and the ctx_remind MCP tool.
+const (
+	// DescKeyReminderNoteEvery is the text key for a reminder's
+	// recurrence.
+	DescKeyReminderNoteEvery = "reminder.note-every"
+	// DescKeyReminderNoteBranch is the text key for a reminder's
+	// branch scope.
+	DescKeyReminderNoteBranch = "reminder.note-branch"
+	// DescKeyReminderNoteSnoozed is the text key for a reminder
+	// that is snoozed.
+	DescKeyReminderNoteSnoozed = "reminder.note-snoozed"
 )
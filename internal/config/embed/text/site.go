@@ -43,3 +43,17 @@ const (
 	// DescKeySiteWarnNoSummary is the text key for site warn no summary messages.
 	DescKeySiteWarnNoSummary = "site.warn-no-summary"
 )
+
+// DescKeys for the built-in site renderer.
+const (
+	// DescKeySiteBuilt is the text key for site built messages.
+	DescKeySiteBuilt = "site.built"
+	// DescKeySiteServing is the text key for site serving messages.
+	DescKeySiteServing = "site.serving"
+	// DescKeySiteTitleJournal is the text key for the journal site
+	// title.
+	DescKeySiteTitleJournal = "site.title-journal"
+	// DescKeySiteTitleKB is the text key for the knowledge-base site
+	// title.
+	DescKeySiteTitleKB = "site.title-kb"
+)
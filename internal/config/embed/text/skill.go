@@ -27,4 +27,17 @@ const (
 	// DescKeyWriteSkillNoSkills is the message when no skills
 	// are installed.
 	DescKeyWriteSkillNoSkills = "write.skill-no-skills"
+	// DescKeyWriteSkillStatus is the text key for one skill's verify or
+	// update outcome.
+	DescKeyWriteSkillStatus = "write.skill-status"
+	// DescKeyWriteSkillStatusDetail is the text key for the detail
+	// suffix of a skill status line.
+	DescKeyWriteSkillStatusDetail = "write.skill-status-detail"
+	// DescKeyWriteSkillVerified is the text key for the verify summary.
+	DescKeyWriteSkillVerified = "write.skill-verified"
+	// DescKeyWriteSkillUpdated is the text key for the update summary.
+	DescKeyWriteSkillUpdated = "write.skill-updated"
+	// DescKeyWriteSkillNoLock is the message when skills.lock has no
+	// entries to update.
+	DescKeyWriteSkillNoLock = "write.skill-no-lock"
 )
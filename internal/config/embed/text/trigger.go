@@ -14,6 +14,9 @@ const (
 	DescKeyTriggerErrorItem = "trigger.error-item"
 	// DescKeyTriggerSkipWarn is the text key for trigger skip warn messages.
 	DescKeyTriggerSkipWarn = "trigger.skip-warn"
+	// DescKeyTriggerUnmatchedWarn is the text key for a hooks.yaml
+	// entry that matches no trigger script.
+	DescKeyTriggerUnmatchedWarn = "trigger.unmatched-warn"
 )
 
 // DescKeys for write/trigger display output.
@@ -32,6 +35,9 @@ const (
 	DescKeyWriteTriggerTypeHdr = "write.trigger-type-hdr"
 	// DescKeyWriteTriggerEntry is the text key for write trigger entry messages.
 	DescKeyWriteTriggerEntry = "write.trigger-entry"
+	// DescKeyWriteTriggerMatcher is the text key for a hooks.yaml
+	// matcher line under a hook entry.
+	DescKeyWriteTriggerMatcher = "write.trigger-matcher"
 	// DescKeyWriteTriggerCount is the text key for write trigger count messages.
 	DescKeyWriteTriggerCount = "write.trigger-count"
 	// DescKeyWriteTriggerTestHdr is the text key for write trigger test hdr
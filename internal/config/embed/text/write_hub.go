@@ -29,4 +29,22 @@ const (
 	// DescKeyWriteHubRevoked is the text key for the hub client
 	// revocation confirmation.
 	DescKeyWriteHubRevoked = "write.hub-revoked"
+	// DescKeyWriteHubPolicyClient is the text key for the client
+	// line of a hub policy listing.
+	DescKeyWriteHubPolicyClient = "write.hub-policy-client"
+	// DescKeyWriteHubPolicyReadOnly is the text key for the
+	// read-only line of a hub policy listing.
+	DescKeyWriteHubPolicyReadOnly = "write.hub-policy-read-only"
+	// DescKeyWriteHubPolicyPublish is the text key for the
+	// publish line of a hub policy listing.
+	DescKeyWriteHubPolicyPublish = "write.hub-policy-publish"
+	// DescKeyWriteHubPolicyReadTypes is the text key for the
+	// readable-types line of a hub policy listing.
+	DescKeyWriteHubPolicyReadTypes = "write.hub-policy-read-types"
+	// DescKeyWriteHubPolicyReadOrigins is the text key for the
+	// readable-origins line of a hub policy listing.
+	DescKeyWriteHubPolicyReadOrigins = "write.hub-policy-read-origins"
+	// DescKeyWriteHubPolicyAny is the text key shown for an
+	// unrestricted policy list.
+	DescKeyWriteHubPolicyAny = "write.hub-policy-any"
 )
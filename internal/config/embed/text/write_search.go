@@ -0,0 +1,20 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package text
+
+// DescKeys for search output.
+const (
+	// DescKeyWriteSearchHit is the text key for the heading
+	// line of one search match.
+	DescKeyWriteSearchHit = "write.search-hit"
+	// DescKeyWriteSearchSnippet is the text key for the
+	// snippet line under a search match.
+	DescKeyWriteSearchSnippet = "write.search-snippet"
+	// DescKeyWriteSearchNone is the text key for a search
+	// without matches.
+	DescKeyWriteSearchNone = "write.search-none"
+)
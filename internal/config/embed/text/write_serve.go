@@ -11,6 +11,9 @@ const (
 	// DescKeyWriteServeHubStarted is the text key for serve
 	// hub started messages.
 	DescKeyWriteServeHubStarted = "write.serve-hub-started"
+	// DescKeyWriteServeAdminStarted is the text key for serve
+	// admin gateway started messages.
+	DescKeyWriteServeAdminStarted = "write.serve-admin-started"
 	// DescKeyWriteServeAdminToken is the text key for serve
 	// admin token messages.
 	DescKeyWriteServeAdminToken = "write.serve-admin-token"
@@ -20,4 +23,10 @@ const (
 	// DescKeyWriteServeHubStopped is the text key for serve
 	// hub stopped messages.
 	DescKeyWriteServeHubStopped = "write.serve-hub-stopped"
+	// DescKeyWriteServeMCPStarted is the text key for serve
+	// MCP HTTP started messages.
+	DescKeyWriteServeMCPStarted = "write.serve-mcp-started"
+	// DescKeyWriteServeMCPToken is the text key for serve
+	// MCP generated token messages.
+	DescKeyWriteServeMCPToken = "write.serve-mcp-token"
 )
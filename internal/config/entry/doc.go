@@ -32,6 +32,12 @@
 // AllowedTypes is a set of the four real entry types
 // accepted by the hub and add commands.
 //
+// # Fleet Types
+//
+// Claim, Assign, and Release are hub-only entry types
+// that carry task leases between agents (see ctx
+// fleet). FleetTypes is their set and Fleet their list.
+//
 // # Priority Levels
 //
 // Tasks carry a priority: PriorityHigh, PriorityMedium,
@@ -27,6 +27,19 @@ const (
 	Unknown = "unknown"
 )
 
+// Fleet entry types. They travel through the hub like
+// knowledge entries but carry a task lease instead of
+// context, and are never rendered into .context/hub/.
+const (
+	// Claim takes a task lease for the claiming agent.
+	Claim = "claim"
+	// Assign hands a task lease to a named agent,
+	// overriding any current holder.
+	Assign = "assign"
+	// Release gives a held task lease back.
+	Release = "release"
+)
+
 // Plural forms used as labels and resource identifiers.
 const (
 	Decisions = "decisions"
@@ -48,6 +61,18 @@ var AllowedTypes = map[string]bool{
 	Task:       true,
 }
 
+// FleetTypes is the set of fleet entry types accepted by
+// the hub.
+var FleetTypes = map[string]bool{
+	Claim:   true,
+	Assign:  true,
+	Release: true,
+}
+
+// Fleet lists the fleet entry types, for type-filtered
+// hub reads.
+var Fleet = []string{Claim, Assign, Release}
+
 // Priorities lists all valid priority levels for shell completion.
 var Priorities = []string{PriorityHigh, PriorityMedium, PriorityLow}
 
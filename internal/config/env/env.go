@@ -27,6 +27,14 @@ const (
 	// admin token, used as a fallback when --token is not passed
 	// to admin-gated commands like `ctx hub revoke`.
 	HubAdmin = "CTX_HUB_ADMIN_TOKEN"
+	// MCPToken is the environment variable holding the bearer
+	// token for `ctx mcp serve --http`, used as a fallback
+	// when --token is not passed.
+	MCPToken = "CTX_MCP_TOKEN"
+	// Agent is the environment variable naming this agent
+	// for ctx fleet leases and ctx_next, used as a fallback
+	// when --agent is not passed.
+	Agent = "CTX_AGENT"
 )
 
 // Environment toggle values.
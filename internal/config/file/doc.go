@@ -22,6 +22,8 @@
 //   - ExtTxt (".txt"): plain text output
 //   - ExtGo (".go"): Go source files
 //   - ExtJSONL (".jsonl"): event logs, hub entries
+//   - ExtJSON (".json"): session documents (Gemini CLI,
+//     OpenCode)
 //   - ExtYAML (".yaml"): steering files
 //   - ExtSh (".sh"): Unix hook scripts
 //   - ExtPs1 (".ps1"): Windows hook scripts
@@ -36,6 +38,7 @@
 //
 //   - Readme ("README.md"): standard readme
 //   - Index ("index.md"): generated site index
+//   - Stats ("stats.md"): journal site session statistics
 //   - SchemaDrift: schema drift report filename
 //   - Violations: governance violations JSON file
 //
@@ -10,20 +10,22 @@ package file
 const (
 	// ExtMarkdown is the Markdown file extension.
 	ExtMarkdown = ".md"
+	// ExtHTML is the HTML file extension.
+	ExtHTML = ".html"
 	// ExtTxt is the plain text file extension.
 	ExtTxt = ".txt"
 	// ExtGo is the Go source file extension.
 	ExtGo = ".go"
 	// ExtJSONL is the JSON Lines file extension.
 	ExtJSONL = ".jsonl"
+	// ExtJSON is the JSON file extension.
+	ExtJSON = ".json"
 	// ExtYAML is the YAML file extension.
 	ExtYAML = ".yaml"
 	// ExtSh is the shell script file extension.
 	ExtSh = ".sh"
 	// ExtPs1 is the PowerShell script file extension.
 	ExtPs1 = ".ps1"
-	// ExtTmp is the temporary file suffix for atomic writes.
-	ExtTmp = ".tmp"
 	// ExtExample is the suffix for example/template files that are safe
 	// to have in the working directory (e.g., .env.example).
 	ExtExample = ".example"
@@ -48,11 +48,16 @@ const (
 // Shared flag names used across commands.
 const (
 	Action      = "action"
+	Addr        = "addr"
+	AdminAddr   = "admin-addr"
 	After       = "after"
+	Agent       = "agent"
 	All         = "all"
+	APIKeyEnv   = "api-key-env"
 	AllProjects = "all-projects"
 	Append      = "append"
 	Archive     = "archive"
+	Backend     = "backend"
 	BaseURL     = "base-url"
 	Blob        = "blob"
 	Build       = "build"
@@ -65,7 +70,9 @@ const (
 	Days        = "days"
 	Dir         = "dir"
 	DryRun      = "dry-run"
+	Endpoint    = "endpoint"
 	Event       = "event"
+	Every       = "every"
 
 	IncludeHub      = "include-hub"
 	Depth           = "depth"
@@ -74,6 +81,7 @@ const (
 	Reset           = "reset"
 	Full            = "full"
 	Hook            = "hook"
+	HTTP            = "http"
 	JSON            = "json"
 	JSONFile        = "json-file"
 	KeepFrontmatter = "keep-frontmatter"
@@ -81,11 +89,14 @@ const (
 	Label           = "label"
 	Last            = "last"
 	Latest          = "latest"
+	Lease           = "lease"
 	Limit           = "limit"
+	Markdown        = "markdown"
 	Max             = "max"
 	MaxIterations   = "max-iterations"
 	Merge           = "merge"
 	Mode            = "mode"
+	Model           = "model"
 	Note            = "note"
 	Message         = "message"
 	Minimal         = "minimal"
@@ -97,8 +108,15 @@ const (
 	Prepend         = "prepend"
 	Project         = "project"
 	Prompt          = "prompt"
+	Publish         = "publish"
 	Quiet           = "quiet"
 	Raw             = "raw"
+	Origin          = "origin"
+	ReadOnly        = "read-only"
+	ReadOrigin      = "read-origin"
+	ReadType        = "read-type"
+	Reason          = "reason"
+	Rebuild         = "rebuild"
 	Record          = "record"
 	Regenerate      = "regenerate"
 	Scope           = "scope"
@@ -109,8 +127,15 @@ const (
 	Share           = "share"
 	Show            = "show"
 	SessionID       = "session-id"
+	SessionTimeout  = "session-timeout"
 	Skills          = "skills"
+	Stop            = "stop"
+	Supersedes      = "supersedes"
 	Tag             = "tag"
+	Timeout         = "timeout"
+	TLSCA           = "tls-ca"
+	TLSCert         = "tls-cert"
+	TLSKey          = "tls-key"
 	Tool            = "tool"
 	Token           = "token"
 	Type            = "type"
@@ -119,6 +144,7 @@ const (
 	Width           = "width"
 	Write           = "write"
 	Yes             = "yes"
+	Zensical        = "zensical"
 )
 
 // Shorthand letters for shared flags.
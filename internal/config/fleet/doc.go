@@ -0,0 +1,16 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package fleet holds configuration constants for ctx fleet,
+// which shares out TASKS.md items between agents working on one
+// repository: the agent profile file, cost tiers, lease
+// defaults, and the task key length.
+//
+// These are structural constants only — no logic. Lease
+// enforcement lives in internal/hub; the CLI in
+// internal/cli/fleet. The fleet entry types are in
+// internal/config/entry.
+package fleet
@@ -0,0 +1,44 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package fleet
+
+import "time"
+
+// Agent profile file.
+const (
+	// File is the agent profile registry under .context/.
+	File = "fleet.yaml"
+)
+
+// Agent cost tiers, cheapest first.
+const (
+	// CostLow marks a free or cheap agent.
+	CostLow = "low"
+	// CostMedium marks a mid-priced agent.
+	CostMedium = "medium"
+	// CostHigh marks an expensive agent.
+	CostHigh = "high"
+)
+
+// CostTiers is the set of valid agent cost tiers.
+var CostTiers = map[string]bool{
+	CostLow:    true,
+	CostMedium: true,
+	CostHigh:   true,
+}
+
+// Lease and lookup defaults.
+const (
+	// DefaultLease is the lease length when --lease is
+	// omitted.
+	DefaultLease = 30 * time.Minute
+	// LookupTimeout bounds the hub read behind ctx_next
+	// and ctx fleet status.
+	LookupTimeout = 5 * time.Second
+	// KeyLen is the number of hex digits in a task key.
+	KeyLen = 12
+)
@@ -0,0 +1,35 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package gemini centralizes constants for importing
+// Gemini CLI chats into the journal.
+//
+// Gemini CLI records each chat as one JSON document at
+// ~/.gemini/tmp/<project-hash>/chats/session-*.json,
+// where the project hash is the SHA-256 of the project
+// directory. The document holds the session ID, the
+// project hash, and a list of typed messages.
+//
+// # Storage Paths
+//
+//   - [DirHome] / [DirTmp] / [DirChats]: the chat store
+//   - [FilePrefix]: the chat file name prefix
+//
+// # Messages
+//
+// [TypeUser] and [TypeGemini] are the message types the
+// importer keeps; info, warning, and error notices are
+// skipped. A tool call whose status is
+// [ToolStatusError] marks its result as an error.
+//
+// # Project Matching
+//
+// Chats do not record a working directory. The importer
+// hashes the current directory and repository root and
+// adopts whichever matches the chat's project hash;
+// otherwise the first [ShortHashLen] characters of the
+// hash name the project.
+package gemini
@@ -0,0 +1,34 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package gemini
+
+// Gemini CLI home directory and chat store.
+const (
+	// DirHome is the Gemini CLI config directory name.
+	DirHome = ".gemini"
+	// DirTmp holds one directory per project, named by project hash.
+	DirTmp = "tmp"
+	// DirChats holds the recorded chats of a project.
+	DirChats = "chats"
+	// FilePrefix starts every recorded chat file name.
+	FilePrefix = "session-"
+)
+
+// Message types in a recorded chat.
+const (
+	// TypeUser is a user prompt.
+	TypeUser = "user"
+	// TypeGemini is a model reply.
+	TypeGemini = "gemini"
+)
+
+// ToolStatusError is the status of a tool call that failed.
+const ToolStatusError = "error"
+
+// ShortHashLen is the length of the project hash prefix used as the
+// project name when the project directory cannot be resolved.
+const ShortHashLen = 8
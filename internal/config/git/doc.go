@@ -41,7 +41,7 @@
 // # Rev-Parse and Common Flags
 //
 // Rev-parse flags (FlagShort, FlagShowToplevel,
-// FlagGitDir), branch-subcommand flags
+// FlagShowPrefix, FlagGitDir), branch-subcommand flags
 // (FlagShowCurrent), and general flags (FlagCached,
 // FlagChangeDir, FlagNameOnly, FlagOneline, FlagSince,
 // etc.) are defined as named constants.
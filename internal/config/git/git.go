@@ -40,6 +40,7 @@ const (
 const (
 	FlagShort        = "--short"
 	FlagShowToplevel = "--show-toplevel"
+	FlagShowPrefix   = "--show-prefix"
 	FlagGitDir       = "--git-dir"
 )
 
@@ -62,6 +63,7 @@ const (
 	FlagOneline        = "--oneline"
 	FlagRecursive      = "-r"
 	FlagSince          = "--since"
+	FlagUntil          = "--until"
 	FormatAuthor       = "--format=%aN"
 	FormatBody         = "--format=%B"
 	FormatEmpty        = "--format="
@@ -70,6 +72,10 @@ const (
 	FormatHashSubj     = "--format=%H %s"
 	FormatSubject      = "--format=%s"
 	FormatTrailerValue = "--format=%%(trailers:key=%s,valueonly)"
+	// FormatRecord emits one machine-parseable record per commit:
+	// full hash, short hash, author date, author, and raw message,
+	// separated by 0x1f and terminated by 0x1e.
+	FormatRecord = "--format=%H%x1f%h%x1f%as%x1f%aN%x1f%B%x1e"
 	// FlagPathSep is the separator between flags and paths.
 	FlagPathSep = "--"
 	// FlagLastN is the format string for limiting git log
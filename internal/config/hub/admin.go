@@ -0,0 +1,139 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package hub
+
+// Admin HTTP gateway routes. Every route is GET-only.
+const (
+	// RouteAdminStatus serves hub statistics as JSON.
+	RouteAdminStatus = "GET /v1/status"
+	// RouteAdminClients serves the client registry (without
+	// tokens) as JSON.
+	RouteAdminClients = "GET /v1/clients"
+	// RouteAdminEntries serves an entry search as JSON.
+	RouteAdminEntries = "GET /v1/entries"
+	// RouteAdminLeader serves leadership and follower
+	// replication state as JSON.
+	RouteAdminLeader = "GET /v1/leader"
+	// RouteAdminMetrics serves Prometheus text metrics.
+	RouteAdminMetrics = "GET /metrics"
+)
+
+// Admin entry search query parameters.
+const (
+	// QueryText is the case-insensitive content substring.
+	QueryText = "q"
+	// QueryType restricts results to one entry type;
+	// repeatable.
+	QueryType = "type"
+	// QueryOrigin restricts results to one origin; repeatable.
+	QueryOrigin = "origin"
+	// QuerySince returns entries after this sequence.
+	QuerySince = "since"
+	// QueryLimit caps the number of results.
+	QueryLimit = "limit"
+)
+
+// Admin gateway limits and timeouts.
+const (
+	// AdminSearchLimit is the default entry search page.
+	AdminSearchLimit = 100
+	// AdminSearchMax caps the limit query parameter.
+	AdminSearchMax = 1000
+	// AdminReadHeaderTimeout bounds header reads (seconds).
+	AdminReadHeaderTimeout = 10
+	// AdminShutdownTimeout bounds graceful shutdown
+	// (seconds).
+	AdminShutdownTimeout = 5
+)
+
+// Admin gateway roles reported by the leader route.
+const (
+	// AdminRoleStandalone is a hub without a Raft cluster.
+	AdminRoleStandalone = "standalone"
+	// AdminRoleLeader is the Raft leader.
+	AdminRoleLeader = "leader"
+	// AdminRoleFollower is a Raft follower.
+	AdminRoleFollower = "follower"
+)
+
+// Admin gateway HTTP headers.
+const (
+	// HeaderAdminAuthorization carries the admin bearer
+	// token.
+	HeaderAdminAuthorization = "Authorization"
+	// HeaderContentType is the response content type header.
+	HeaderContentType = "Content-Type"
+)
+
+// Admin gateway error bodies.
+const (
+	// ErrAdminUnauthorized is the HTTP error for a missing or
+	// wrong admin bearer token.
+	ErrAdminUnauthorized = "admin token required"
+	// ErrAdminBadQuery is the HTTP error format for a
+	// malformed query parameter.
+	ErrAdminBadQuery = "invalid %s: %q"
+)
+
+// Prometheus text exposition.
+const (
+	// MimeMetrics is the Prometheus text format content type.
+	MimeMetrics = "text/plain; version=0.0.4; charset=utf-8"
+	// FmtMetricHelp formats a HELP line (name, help).
+	FmtMetricHelp = "# HELP %s %s\n"
+	// FmtMetricType formats a TYPE line (name, type).
+	FmtMetricType = "# TYPE %s %s\n"
+	// FmtMetricValue formats an unlabeled sample (name, value).
+	FmtMetricValue = "%s %d\n"
+	// FmtMetricLabeled formats a one-label sample
+	// (name, label, label value, value).
+	FmtMetricLabeled = "%s{%s=%q} %d\n"
+	// MetricGauge is the gauge metric type.
+	MetricGauge = "gauge"
+	// MetricCounter is the counter metric type.
+	MetricCounter = "counter"
+)
+
+// Metric names and help text.
+const (
+	// MetricEntries counts stored entries by type.
+	MetricEntries = "ctx_hub_entries"
+	// HelpEntries describes MetricEntries.
+	HelpEntries = "Entries in the store by type."
+	// MetricSequence is the last assigned sequence.
+	MetricSequence = "ctx_hub_sequence"
+	// HelpSequence describes MetricSequence.
+	HelpSequence = "Last assigned entry sequence."
+	// MetricPublished counts entries accepted since start.
+	MetricPublished = "ctx_hub_published_entries_total"
+	// HelpPublished describes MetricPublished.
+	HelpPublished = "Entries accepted by Publish since start."
+	// MetricListeners is the number of open Listen streams.
+	MetricListeners = "ctx_hub_listeners"
+	// HelpListeners describes MetricListeners.
+	HelpListeners = "Open Listen streams."
+	// MetricDropped counts slow listeners disconnected.
+	MetricDropped = "ctx_hub_listeners_dropped_total"
+	// HelpDropped describes MetricDropped.
+	HelpDropped = "Listen streams dropped for falling behind."
+	// MetricLeader is 1 on the Raft leader or a standalone hub.
+	MetricLeader = "ctx_hub_leader"
+	// HelpLeader describes MetricLeader.
+	HelpLeader = "1 when this node accepts writes."
+	// MetricLag is entries a follower is behind.
+	MetricLag = "ctx_hub_replication_lag_entries"
+	// HelpLag describes MetricLag.
+	HelpLag = "Entries a follower was behind at its last sync."
+	// MetricLastSync is the age of a follower's last sync.
+	MetricLastSync = "ctx_hub_replication_last_sync_seconds"
+	// HelpLastSync describes MetricLastSync.
+	HelpLastSync = "Seconds since a follower last synced."
+	// LabelType is the entry-type label.
+	LabelType = "type"
+	// LabelFollower is the follower project label.
+	LabelFollower = "follower"
+)
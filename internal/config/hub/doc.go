@@ -23,9 +23,11 @@
 //   - ServicePath: the service path prefix for
 //     method descriptors
 //   - MethodRegister, MethodPublish, MethodSync,
-//     MethodListen, MethodStatus: RPC method names
+//     MethodListen, MethodStatus, MethodRevoke,
+//     MethodSnapshot: RPC method names
 //   - PathRegister, PathPublish, PathSync,
-//     PathListen, PathStatus: full method paths
+//     PathListen, PathStatus, PathRevoke,
+//     PathSnapshot: full method paths
 //   - ProtoFile ("hub.proto"): virtual proto file
 //     name in the service descriptor
 //
@@ -62,11 +64,60 @@
 //   - JSONIndent, LockSentinel, SuffixPluralMD:
 //     formatting and naming helpers
 //
+// # Admin Gateway
+//
+//   - RouteAdminStatus, RouteAdminClients,
+//     RouteAdminEntries, RouteAdminLeader,
+//     RouteAdminMetrics: read-only HTTP routes
+//   - QueryText, QueryType, QueryOrigin, QuerySince,
+//     QueryLimit: entry search parameters
+//   - AdminSearchLimit (100), AdminSearchMax (1000):
+//     search page sizes
+//   - AdminRoleStandalone, AdminRoleLeader,
+//     AdminRoleFollower: node roles
+//   - HeaderAdminAuthorization, HeaderContentType:
+//     gateway HTTP headers
+//   - MimeMetrics, FmtMetric*, Metric*, Help*, Label*:
+//     Prometheus text exposition
+//
+// # Search
+//
+//   - MethodSearch, PathSearch: full-text entry search RPC
+//   - SearchLimit (50), SearchMax (500): result counts
+//   - MaxSearchLen (1024): query text cap
+//   - ErrSearchLimit, ErrSearchOversize, ErrSearchRange:
+//     request validation errors
+//
+// # Client Policy
+//
+//   - MethodPolicy, MethodSetPolicy, PathPolicy,
+//     PathSetPolicy: admin RPCs for per-client ACLs
+//   - ErrPolicyReadOnly, ErrPolicyPublishType,
+//     ErrPolicyRawRead, ErrPolicyInvalidType:
+//     enforcement and validation errors
+//
+// # Segments and Snapshots
+//
+//   - DirSegments, FmtSegment: sealed log segments,
+//     named by their first sequence
+//   - FileSnapshot ("snapshot.json"): compacted
+//     store image
+//   - SegmentEntries (4096): entries per segment
+//   - CompactSegments (4): sealed segments that
+//     trigger compaction
+//   - SnapshotChunk (512): entries per Snapshot
+//     stream message
+//   - MaxLineBytes: scanner limit for one log line
+//   - ErrCompacted: raw sync below the snapshot
+//
 // # Raft Cluster Configuration
 //
 //   - RaftDir ("raft"): subdirectory for Raft state
 //   - RaftTransport ("tcp"): transport protocol
 //   - RaftLogDB ("log.db"): BoltDB log file
+//   - RaftMaxPool (3), RaftTimeout (10s): pooled
+//     connections and I/O deadline for the Raft
+//     transport (plain TCP or mutual TLS)
 //
 // # Validation Limits
 //
@@ -77,6 +128,25 @@
 //     control character boundaries
 //   - ClientIDBytes (16): UUID byte length
 //
+// # Correction Kinds
+//
+//   - KindRetract, KindSupersede: entry kinds that
+//     reference an earlier entry ID and carry a
+//     reason instead of being plain knowledge
+//   - MaxReasonLen (1024): reason size cap
+//   - FieldReason: field name in validation errors
+//
+// # Task Leases
+//
+//   - MethodLeases, PathLeases: live-lease query RPC
+//   - FieldTask, FieldAgent: lease field names in
+//     validation errors
+//   - MaxLeaseFieldLen (128): task key and agent cap
+//   - MaxLeaseTTL (one day): longest lease a claim or
+//     assign may request
+//   - ErrLease*, ErrTask*: lease validation and
+//     holder-conflict errors
+//
 // # Error Messages
 //
 // Handler and validation error strings are defined as
@@ -29,6 +29,8 @@ const (
 	MethodStatus = "Status"
 	// MethodRevoke is the Revoke RPC method name.
 	MethodRevoke = "Revoke"
+	// MethodSnapshot is the Snapshot RPC method name.
+	MethodSnapshot = "Snapshot"
 )
 
 // Full gRPC method paths (ServicePath + MethodName).
@@ -45,6 +47,8 @@ const (
 	PathStatus = ServicePath + MethodStatus
 	// PathRevoke is the full gRPC path for Revoke.
 	PathRevoke = ServicePath + MethodRevoke
+	// PathSnapshot is the full gRPC path for Snapshot.
+	PathSnapshot = ServicePath + MethodSnapshot
 )
 
 // Authorization header.
@@ -110,6 +114,11 @@ const (
 	RaftTransport = "tcp"
 	// RaftLogDB is the BoltDB file name for Raft log storage.
 	RaftLogDB = "log.db"
+	// RaftMaxPool is the number of pooled outbound Raft
+	// connections kept per peer.
+	RaftMaxPool = 3
+	// RaftTimeout bounds Raft transport I/O.
+	RaftTimeout = 10 // seconds
 )
 
 // gRPC method descriptor metadata.
@@ -0,0 +1,66 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package hub
+
+// Correction entry kinds. A plain entry has an empty kind;
+// a correction references an earlier entry by ID.
+const (
+	// KindRetract withdraws the referenced entry.
+	KindRetract = "retract"
+	// KindSupersede replaces the referenced entry with the
+	// record's own content.
+	KindSupersede = "supersede"
+)
+
+// Correction field names used in validation messages.
+const (
+	// FieldReason is the JSON field name for a correction
+	// reason.
+	FieldReason = "reason"
+)
+
+// Correction size limits.
+const (
+	// MaxReasonLen caps the reason carried by a correction.
+	MaxReasonLen = 1024
+)
+
+// Correction validation error messages.
+const (
+	// ErrInvalidEntryKind is the gRPC error format for an
+	// unknown entry kind.
+	ErrInvalidEntryKind = "invalid entry kind %q"
+	// ErrEntryRefRequired is the gRPC error format for a
+	// correction without a ref.
+	ErrEntryRefRequired = "%s entry requires ref"
+	// ErrEntryReasonRequired is the gRPC error format for a
+	// correction without a reason.
+	ErrEntryReasonRequired = "%s entry requires reason"
+	// ErrEntryReasonOversize is the gRPC error format for an
+	// oversized reason.
+	ErrEntryReasonOversize = "reason exceeds %d bytes"
+	// ErrEntryReasonControlChar is the gRPC error for a
+	// reason containing control characters.
+	ErrEntryReasonControlChar = "reason contains control character"
+	// ErrEntryRefUnexpected is the gRPC error for ref or
+	// reason on a plain entry.
+	ErrEntryRefUnexpected = "ref and reason require " +
+		"kind retract or supersede"
+	// ErrEntryRefUnknown is the gRPC error format for a ref
+	// that names no stored entry.
+	ErrEntryRefUnknown = "ref %q names no stored entry"
+	// ErrEntryRefType is the gRPC error format for a ref
+	// whose target has a different type.
+	ErrEntryRefType = "ref %q is a %s, not a %s"
+	// ErrEntryRefCorrected is the gRPC error format for a
+	// ref whose target was already retracted or superseded.
+	ErrEntryRefCorrected = "ref %q is already " +
+		"retracted or superseded"
+	// ErrEntryRefRetraction is the gRPC error format for a
+	// ref that names a retraction record.
+	ErrEntryRefRetraction = "ref %q is a retraction record"
+)
@@ -0,0 +1,72 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package hub
+
+// Leases RPC method name and path.
+const (
+	// MethodLeases is the Leases RPC method name.
+	MethodLeases = "Leases"
+	// PathLeases is the full gRPC path for Leases.
+	PathLeases = ServicePath + MethodLeases
+)
+
+// Lease field names used in validation messages.
+const (
+	// FieldTask is the JSON field name for a lease task key.
+	FieldTask = "task"
+	// FieldAgent is the JSON field name for a lease agent.
+	FieldAgent = "agent"
+)
+
+// Lease limits.
+const (
+	// MaxLeaseFieldLen caps the lease task key and agent ID.
+	MaxLeaseFieldLen = 128
+	// MaxLeaseTTL caps a lease's time to live, in seconds
+	// (one day).
+	MaxLeaseTTL = 24 * 60 * 60
+)
+
+// Lease validation and enforcement error messages.
+const (
+	// ErrLeaseUnexpected is the gRPC error for a lease on a
+	// non-fleet entry.
+	ErrLeaseUnexpected = "lease requires type claim, assign, " +
+		"or release"
+	// ErrLeaseKind is the gRPC error format for a correction
+	// aimed at a fleet entry type.
+	ErrLeaseKind = "%s entries cannot be corrected; " +
+		"publish a release instead"
+	// ErrLeaseFieldRequired is the gRPC error format for a
+	// fleet entry missing a lease field.
+	ErrLeaseFieldRequired = "%s entry requires lease.%s"
+	// ErrLeaseFieldOversize is the gRPC error format for an
+	// oversized lease field.
+	ErrLeaseFieldOversize = "lease.%s exceeds %d bytes"
+	// ErrLeaseControlChar is the gRPC error format for a
+	// lease field containing control characters.
+	ErrLeaseControlChar = "lease.%s contains control character"
+	// ErrLeaseTTL is the gRPC error format for a claim or
+	// assign whose TTL is out of range.
+	ErrLeaseTTL = "%s lease ttl must be 1 to %d seconds"
+	// ErrLeaseTTLUnexpected is the gRPC error for a release
+	// carrying a TTL.
+	ErrLeaseTTLUnexpected = "release takes no lease ttl"
+	// ErrTaskHeld is the gRPC error format for a claim on a
+	// task another agent holds.
+	ErrTaskHeld = "task %q is held by %s until %s"
+	// ErrTaskNotHeld is the gRPC error format for releasing
+	// a task nobody holds.
+	ErrTaskNotHeld = "task %q is not held"
+	// ErrTaskHeldOther is the gRPC error format for
+	// releasing a task held by a different agent.
+	ErrTaskHeldOther = "task %q is held by %s, not %s"
+	// ErrTaskHeldClient is the gRPC error format for
+	// renewing or releasing a lease granted to another
+	// client under the same agent ID.
+	ErrTaskHeldClient = "task %q is held by %s from another client"
+)
@@ -0,0 +1,36 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package hub
+
+// Client policy RPC method names and paths.
+const (
+	// MethodPolicy is the Policy RPC method name.
+	MethodPolicy = "Policy"
+	// MethodSetPolicy is the SetPolicy RPC method name.
+	MethodSetPolicy = "SetPolicy"
+	// PathPolicy is the full gRPC path for Policy.
+	PathPolicy = ServicePath + MethodPolicy
+	// PathSetPolicy is the full gRPC path for SetPolicy.
+	PathSetPolicy = ServicePath + MethodSetPolicy
+)
+
+// Client policy enforcement errors.
+const (
+	// ErrPolicyReadOnly is the gRPC error for a publish by a
+	// read-only client.
+	ErrPolicyReadOnly = "client is read-only"
+	// ErrPolicyPublishType is the gRPC error format for a
+	// publish of a type outside the client's policy.
+	ErrPolicyPublishType = "client may not publish %q entries"
+	// ErrPolicyRawRead is the gRPC error for replication
+	// reads by a client whose reads are filtered.
+	ErrPolicyRawRead = "replication requires an unrestricted " +
+		"read policy"
+	// ErrPolicyInvalidType is the gRPC error format for an
+	// unknown entry type in a policy.
+	ErrPolicyInvalidType = "policy names unknown entry type %q"
+)
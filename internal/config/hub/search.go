@@ -0,0 +1,39 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package hub
+
+// Search RPC method name and path.
+const (
+	// MethodSearch is the Search RPC method name.
+	MethodSearch = "Search"
+	// PathSearch is the full gRPC path for Search.
+	PathSearch = ServicePath + MethodSearch
+)
+
+// Search limits.
+const (
+	// SearchLimit is the result count when a request sets
+	// none.
+	SearchLimit = 50
+	// SearchMax caps the result count of one request.
+	SearchMax = 500
+	// MaxSearchLen caps the query text.
+	MaxSearchLen = 1024
+)
+
+// Search validation errors.
+const (
+	// ErrSearchLimit is the gRPC error format for a limit
+	// outside 0..SearchMax.
+	ErrSearchLimit = "limit must be between 0 and %d"
+	// ErrSearchOversize is the gRPC error format for an
+	// oversized query text.
+	ErrSearchOversize = "query exceeds %d bytes"
+	// ErrSearchRange is the gRPC error for an until bound
+	// before the since bound.
+	ErrSearchRange = "until is before since"
+)
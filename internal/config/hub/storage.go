@@ -0,0 +1,47 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package hub
+
+// Log segmentation and snapshot layout.
+const (
+	// DirSegments is the data-dir subdirectory holding
+	// sealed log segments.
+	DirSegments = "segments"
+	// FmtSegment names a sealed segment after the first
+	// sequence it holds, zero-padded so names sort in
+	// sequence order.
+	FmtSegment = "%020d.jsonl"
+	// FileSnapshot is the compacted store image.
+	FileSnapshot = "snapshot.json"
+)
+
+// Log rotation and compaction thresholds.
+const (
+	// SegmentEntries is the number of entries after which
+	// the active entries.jsonl is sealed into a segment.
+	SegmentEntries = 4096
+	// CompactSegments is the number of sealed segments that
+	// triggers a compaction into a new snapshot.
+	CompactSegments = 4
+	// SnapshotChunk is the number of entries per message
+	// on the Snapshot stream, keeping each well under the
+	// gRPC message size limit.
+	SnapshotChunk = 512
+	// MaxLineBytes bounds one JSONL line when loading the
+	// log. Content is capped at MaxContentLen, but JSON
+	// escaping can expand it several times over.
+	MaxLineBytes = 8 * MaxContentLen
+)
+
+// Snapshot RPC errors.
+const (
+	// ErrCompacted is the gRPC error format for a raw sync
+	// that starts before the snapshot. Takes (requested
+	// sequence, snapshot sequence).
+	ErrCompacted = "sequence %d predates snapshot at %d; " +
+		"bootstrap from the Snapshot RPC"
+)
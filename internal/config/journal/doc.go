@@ -39,6 +39,12 @@
 // navigation sidebar caps at MaxRecentSessions (20)
 // with titles truncated to MaxNavTitleLen (40 chars).
 //
+// # Session Statistics
+//
+// ctx journal stats keys its weekly breakdown with
+// WeekKeyFormat (ISO year and week, "2026-W10") and
+// reports failure rates scaled by PercentScale.
+//
 // # Recall Display
 //
 // The recall show/list commands use PreviewMaxTurns,
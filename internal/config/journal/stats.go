@@ -0,0 +1,16 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package journal
+
+// Session statistics constants.
+const (
+	// WeekKeyFormat formats an ISO year and week as a stats key.
+	// Args: ISO year, ISO week.
+	WeekKeyFormat = "%d-W%02d"
+	// PercentScale converts a failure rate to a percentage.
+	PercentScale = 100
+)
@@ -61,4 +61,6 @@
 //     launch arguments.
 //   - [tool]:       tool registration names that map
 //     to ctx CLI subcommands.
+//   - [transport]:  Streamable HTTP endpoint, headers,
+//     event framing, and limits.
 package mcp
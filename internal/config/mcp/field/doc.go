@@ -28,7 +28,9 @@
 //     the task completion nudge.
 //   - [Caller]       : identifies the MCP client
 //     (cursor, vscode, etc.).
-//   - [Limit], [Since]: pagination and date filters
+//   - [Limit], [Since], [Until]: pagination and date
+//     filters
+//   - [Origin]: hub entry publishing project
 //     for ctx_journal_source.
 //   - [SessionID], [Branch], [Commit]: provenance
 //     metadata attached to journal entries.
@@ -26,6 +26,10 @@ const (
 	Limit = "limit"
 	// Since is an ISO date filter for session recall.
 	Since = "since"
+	// Until is an inclusive ISO date upper bound.
+	Until = "until"
+	// Origin is the publishing project of a hub entry.
+	Origin = "origin"
 	// AttrFile is the metadata key on PendingUpdate recording which
 	// context file was written to (e.g., "DECISIONS.md").
 	AttrFile = "file"
@@ -14,6 +14,9 @@ const (
 	Ping = "ping"
 	// ResourceList is the MCP method for listing resources.
 	ResourceList = "resources/list"
+	// ResourceTemplateList is the MCP method for listing
+	// resource templates.
+	ResourceTemplateList = "resources/templates/list"
 	// ResourceRead is the MCP method for reading a resource.
 	ResourceRead = "resources/read"
 	// ResourceSubscribe is the MCP method for subscribing to resource changes.
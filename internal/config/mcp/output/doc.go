@@ -0,0 +1,20 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package output defines the JSON property key names that
+// appear in MCP tool output schemas.
+//
+// Tools that return structuredContent declare an
+// outputSchema describing it. The structured values are
+// the same Go structs `ctx status --json`, `ctx drift
+// --json`, and `ctx search --json` encode, so these names
+// must match the json tags on those structs; the server
+// tests decode each result against its declared schema to
+// catch drift.
+//
+// Input keys live in [internal/config/mcp/field]; a name
+// that is both (for example, query) is taken from there.
+package output
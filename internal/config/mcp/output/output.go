@@ -0,0 +1,111 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package output
+
+// ctx_status output keys.
+const (
+	// ContextDir is the context directory path.
+	ContextDir = "context_dir"
+	// TotalFiles is the number of context files.
+	TotalFiles = "total_files"
+	// TotalTokens is the estimated token total.
+	TotalTokens = "total_tokens"
+	// TotalSize is the total size in bytes.
+	TotalSize = "total_size"
+	// Files is the per-file status list.
+	Files = "files"
+	// Name is a file name.
+	Name = "name"
+	// Tokens is a file's estimated token count.
+	Tokens = "tokens"
+	// Size is a file's size in bytes.
+	Size = "size"
+	// IsEmpty flags a file without meaningful content.
+	IsEmpty = "is_empty"
+	// Summary is a one-line description of a file.
+	Summary = "summary"
+	// ModTime is a file's modification time (RFC3339).
+	ModTime = "mod_time"
+)
+
+// ctx_drift output keys.
+const (
+	// Timestamp is the report time (RFC3339, UTC).
+	Timestamp = "timestamp"
+	// Status is the overall drift status.
+	Status = "status"
+	// Warnings is the list of warning issues.
+	Warnings = "warnings"
+	// Violations is the list of constitution violations.
+	Violations = "violations"
+	// Passed is the list of checks that passed.
+	Passed = "passed"
+	// File is the file an issue was found in.
+	File = "file"
+	// Line is a 1-based line number.
+	Line = "line"
+	// Type is an issue, entry, or document type.
+	Type = "type"
+	// Message is an issue description.
+	Message = "message"
+	// Path is a referenced or matched path.
+	Path = "path"
+	// Rule is the constitution rule an issue violates.
+	Rule = "rule"
+)
+
+// ctx_next output keys.
+const (
+	// Found reports whether a pending task exists.
+	Found = "found"
+	// Index is a task's position among pending tasks.
+	Index = "index"
+	// Task is a task's text.
+	Task = "task"
+	// Held reports that the calling agent holds the task's lease.
+	Held = "held"
+	// Skipped counts pending tasks passed over because other
+	// agents hold them.
+	Skipped = "skipped"
+	// Blocked counts pending tasks passed over because their
+	// dependencies are unmet.
+	Blocked = "blocked"
+)
+
+// ctx_journal_source output keys.
+const (
+	// Sessions is the session list.
+	Sessions = "sessions"
+	// ID is a session identifier.
+	ID = "id"
+	// Start is a session start time (RFC3339).
+	Start = "start"
+	// Project is a session's project name.
+	Project = "project"
+	// DurationSeconds is a session's length in seconds.
+	DurationSeconds = "duration_seconds"
+	// Turns is a session's conversation turn count.
+	Turns = "turns"
+	// FirstMessage is a session's first user message.
+	FirstMessage = "first_message"
+)
+
+// ctx_search output keys.
+const (
+	// Hits is the ranked match list.
+	Hits = "hits"
+	// Key is a matched entry's timestamp.
+	Key = "key"
+	// Title is a matched document's heading.
+	Title = "title"
+	// Date is a matched document's date.
+	Date = "date"
+	// Score is a match's BM25 score.
+	Score = "score"
+	// Snippet is a match's context line.
+	Snippet = "snippet"
+)
@@ -42,6 +42,20 @@
 //   - [Agent]        : the assembled context packet
 //     (output of ctx agent).
 //
+// # Resource Templates
+//
+// Parameterized URIs advertised by resources/templates/list
+// address a single entry, theme file, or journal session
+// instead of a whole file:
+//
+//	ctx://decision/{timestamp}
+//	ctx://learning/{timestamp}
+//	ctx://theme/{kind}/{slug}
+//	ctx://journal/{session-id}
+//
+// The Prefix* constants match incoming URIs; the Template*
+// constants are what clients see.
+//
 // # Why These Are Centralized
 //
 // Resource registration, URI parsing, subscription
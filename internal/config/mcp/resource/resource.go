@@ -27,3 +27,43 @@ const (
 	// Agent is the MCP resource name for the assembled context packet.
 	Agent = "agent"
 )
+
+// Resource template URI prefixes. A templated URI is one of
+// these followed by its parameters.
+const (
+	// PrefixDecision addresses a single decision by timestamp.
+	PrefixDecision = "ctx://decision/"
+	// PrefixLearning addresses a single learning by timestamp.
+	PrefixLearning = "ctx://learning/"
+	// PrefixTheme addresses a progressive-disclosure theme file
+	// by kind and slug.
+	PrefixTheme = "ctx://theme/"
+	// PrefixJournal addresses a journal session by session ID.
+	PrefixJournal = "ctx://journal/"
+)
+
+// Resource templates advertised by resources/templates/list
+// (RFC 6570 level 1).
+const (
+	// TemplateDecision is the URI template for one decision.
+	TemplateDecision = PrefixDecision + "{timestamp}"
+	// TemplateLearning is the URI template for one learning.
+	TemplateLearning = PrefixLearning + "{timestamp}"
+	// TemplateTheme is the URI template for one theme file.
+	TemplateTheme = PrefixTheme + "{kind}/{slug}"
+	// TemplateJournal is the URI template for one journal
+	// session.
+	TemplateJournal = PrefixJournal + "{session-id}"
+)
+
+// Resource template names.
+const (
+	// Decision is the template name for a single decision.
+	Decision = "decision"
+	// Learning is the template name for a single learning.
+	Learning = "learning"
+	// Theme is the template name for a theme file.
+	Theme = "theme"
+	// Journal is the template name for a journal session.
+	Journal = "journal"
+)
@@ -15,6 +15,9 @@
 //   - [ProtocolVersion] ("2024-11-05"): the MCP
 //     protocol version negotiated during the
 //     initialize handshake.
+//   - [ProtocolVersionStreamable] ("2025-03-26"):
+//     echoed to clients that request it, typically
+//     Streamable HTTP clients.
 //
 // # JSON-RPC Error Codes
 //
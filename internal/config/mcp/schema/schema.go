@@ -9,6 +9,17 @@ package schema
 // ProtocolVersion is the MCP protocol version string.
 const ProtocolVersion = "2024-11-05"
 
+// ProtocolVersionStreamable is the MCP revision that
+// introduced the Streamable HTTP transport. It is echoed
+// when a client asks for it; any other request gets
+// [ProtocolVersion].
+const ProtocolVersionStreamable = "2025-03-26"
+
+// ProtocolVersionStructured is the MCP revision that
+// introduced tool output schemas and structured tool
+// results. It is echoed when a client asks for it.
+const ProtocolVersionStructured = "2025-06-18"
+
 // Standard JSON-RPC error codes.
 const (
 	// ErrCodeParse indicates malformed JSON.
@@ -31,4 +42,8 @@ const (
 	Number = "number"
 	// Boolean is the JSON Schema type for booleans.
 	Boolean = "boolean"
+	// Integer is the JSON Schema type for whole numbers.
+	Integer = "integer"
+	// Array is the JSON Schema type for arrays.
+	Array = "array"
 )
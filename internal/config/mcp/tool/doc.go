@@ -43,6 +43,8 @@
 //     a steering file matched to a prompt.
 //   - [Search] ("ctx_search"): full-text search
 //     across context files.
+//   - [HubSearch] ("ctx_hub_search"): searches every
+//     entry on the connected ctx Hub.
 //   - [SessionStart] / [SessionEnd]: hooks that run
 //     at session boundaries.
 //
@@ -34,6 +34,9 @@ const (
 	SteeringGet = "ctx_steering_get"
 	// Search is the MCP tool name for searching context files.
 	Search = "ctx_search"
+	// HubSearch is the MCP tool name for searching ctx Hub
+	// entries.
+	HubSearch = "ctx_hub_search"
 	// SessionStart is the MCP tool name for session start hooks.
 	SessionStart = "ctx_session_start"
 	// SessionEnd is the MCP tool name for session end hooks.
@@ -0,0 +1,38 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package transport defines the constants behind the MCP
+// Streamable HTTP transport started by
+// `ctx mcp serve --http`.
+//
+// Streamable HTTP serves the same JSON-RPC 2.0 messages
+// as stdio over a single HTTP endpoint:
+//
+//   - POST carries one client message; requests are
+//     answered with an application/json body,
+//     notifications with 202 Accepted.
+//   - GET opens a text/event-stream that carries
+//     server-initiated notifications such as
+//     notifications/resources/updated.
+//   - DELETE ends a session.
+//
+// # Key Constants
+//
+//   - [Path]: the single MCP endpoint.
+//   - [HeaderSessionID]: the Mcp-Session-Id header
+//     issued on initialize and required afterwards.
+//   - [MaxSessions], [NotifyBuffer]: per-server and
+//     per-session resource caps.
+//   - [SessionIdleTimeout]: default idle lifetime of a
+//     session before the server closes it.
+//   - Err*: plain-text HTTP error bodies.
+//
+// # Why These Are Centralized
+//
+// Clients and proxies match the endpoint, header names,
+// and event framing byte for byte. Keeping them in one
+// package makes the wire contract reviewable in one place.
+package transport
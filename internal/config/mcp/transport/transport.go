@@ -0,0 +1,109 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package transport
+
+import "time"
+
+// Streamable HTTP endpoint.
+const (
+	// Path is the single MCP endpoint. POST, GET, and
+	// DELETE are routed by method.
+	Path = "/mcp"
+	// Network is the listener network for --http.
+	Network = "tcp"
+	// Allow lists the methods the endpoint accepts, sent
+	// with 405 responses.
+	Allow = "GET, POST, DELETE"
+)
+
+// Streamable HTTP headers.
+const (
+	// HeaderSessionID carries the session ID issued on
+	// initialize.
+	HeaderSessionID = "Mcp-Session-Id"
+	// HeaderAuthorization carries the bearer token.
+	HeaderAuthorization = "Authorization"
+	// BearerPrefix precedes the token in Authorization.
+	BearerPrefix = "Bearer "
+	// HeaderAccept is the request Accept header.
+	HeaderAccept = "Accept"
+	// HeaderAllow lists permitted methods on a 405.
+	HeaderAllow = "Allow"
+	// HeaderContentType is the response Content-Type header.
+	HeaderContentType = "Content-Type"
+	// HeaderCacheControl is the response Cache-Control
+	// header.
+	HeaderCacheControl = "Cache-Control"
+	// NoCache disables caching of the event stream.
+	NoCache = "no-cache"
+	// MimeEventStream is the server-sent events MIME type.
+	MimeEventStream = "text/event-stream"
+)
+
+// Server-sent event framing.
+const (
+	// FmtEvent frames one JSON-RPC message as an SSE
+	// message event.
+	//
+	// Args (in order):
+	//   - data: JSON-encoded message
+	FmtEvent = "event: message\ndata: %s\n\n"
+	// KeepAlive is an SSE comment sent on idle streams so
+	// proxies do not time them out.
+	KeepAlive = ": ping\n\n"
+)
+
+// Streamable HTTP tokens and limits.
+const (
+	// TokenPrefix marks a generated MCP bearer token.
+	TokenPrefix = "ctx_mcp_"
+	// TokenBytes is the random length of a generated token.
+	TokenBytes = 32
+	// SessionIDBytes is the random length of a session ID.
+	SessionIDBytes = 16
+	// MaxSessions caps concurrently open sessions.
+	MaxSessions = 64
+	// SessionIdleTimeout is the default for
+	// --session-timeout: a session with no requests and no
+	// open event stream for this long is closed.
+	SessionIdleTimeout = 30 * time.Minute
+	// NotifyBuffer is the per-session notification queue
+	// length; notifications beyond it are dropped.
+	NotifyBuffer = 64
+	// ReadHeaderTimeout bounds header reads (seconds).
+	ReadHeaderTimeout = 10
+	// KeepAliveInterval is the idle-stream ping interval
+	// (seconds).
+	KeepAliveInterval = 30
+	// ShutdownTimeout bounds graceful shutdown (seconds).
+	ShutdownTimeout = 5
+)
+
+// Streamable HTTP error bodies.
+const (
+	// ErrUnauthorized is the HTTP error for a missing or
+	// wrong bearer token.
+	ErrUnauthorized = "bearer token required"
+	// ErrSessionRequired is the HTTP error for a
+	// non-initialize message without a session ID.
+	ErrSessionRequired = "Mcp-Session-Id header required"
+	// ErrSessionUnknown is the HTTP error for an unknown or
+	// ended session.
+	ErrSessionUnknown = "unknown or ended session"
+	// ErrSessionLimit is the HTTP error when MaxSessions are
+	// already open.
+	ErrSessionLimit = "too many open sessions"
+	// ErrStreamOpen is the HTTP error for a second event
+	// stream on one session.
+	ErrStreamOpen = "session already has an open stream"
+	// ErrNotAcceptable is the HTTP error for a GET that does
+	// not accept text/event-stream.
+	ErrNotAcceptable = "Accept must include text/event-stream"
+	// ErrBody is the HTTP error for an unreadable or
+	// oversized body.
+	ErrBody = "unreadable or oversized request body"
+)
@@ -0,0 +1,16 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package mine holds configuration constants for ctx mine, the
+// deterministic pass that proposes DECISIONS.md and LEARNINGS.md
+// entries from git history: state file names, the git log record
+// layout, conventional-commit type mappings, trailer keys, and
+// candidate dispositions.
+//
+// These are structural constants only — no logic. The engine lives
+// in internal/mine; the CLI in internal/cli/mine. The commit and
+// signal-word patterns live in internal/config/regex.
+package mine
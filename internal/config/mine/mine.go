@@ -0,0 +1,119 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package mine
+
+import (
+	"github.com/ActiveMemory/ctx/internal/config/entry"
+)
+
+// State files under .context/state/.
+const (
+	// FileLedger records every commit already mined, the disposition
+	// of every reviewed candidate, and running totals, so re-runs do
+	// not re-propose.
+	FileLedger = "mine-ledger.json"
+	// FileReview holds the candidates awaiting accept or reject.
+	FileReview = "mine-review.json"
+)
+
+// Queue names the review queue in its errors and in the
+// "ctx mine review" hint.
+const Queue = "mine"
+
+// LedgerVersion is the schema version written to the ledger.
+const LedgerVersion = 1
+
+// DefaultLimit is the default ceiling on commits read per run.
+const DefaultLimit = 100
+
+// Provenance is the session ID recorded on entries persisted by
+// ctx mine accept, and the branch fallback when HEAD is detached.
+const Provenance = "mine"
+
+// Git log record layout produced by git.FormatRecord. Each commit
+// is one record terminated by RecordSep; its fields are separated by
+// FieldSep in the order full hash, short hash, author date
+// (YYYY-MM-DD), author, and raw message.
+const (
+	// RecordSep terminates one commit record.
+	RecordSep = "\x1e"
+	// FieldSep separates the fields of a commit record.
+	FieldSep = "\x1f"
+	// RecordFields is the number of fields in a commit record.
+	RecordFields = 5
+)
+
+// Commit record field positions.
+const (
+	// FieldHash is the full commit hash.
+	FieldHash = iota
+	// FieldShort is the abbreviated commit hash.
+	FieldShort
+	// FieldDate is the author date.
+	FieldDate
+	// FieldAuthor is the author name.
+	FieldAuthor
+	// FieldMessage is the raw commit message.
+	FieldMessage
+)
+
+// Commit trailer keys that classify a commit explicitly. Keys are
+// compared case-insensitively.
+const (
+	// TrailerDecision names a decision; its value becomes the title.
+	TrailerDecision = "decision"
+	// TrailerLearning names a learning; its value becomes the title.
+	TrailerLearning = "learning"
+	// TrailerLesson is an alias of TrailerLearning.
+	TrailerLesson = "lesson"
+	// TrailerBreaking marks a breaking change, which is a decision.
+	TrailerBreaking = "breaking change"
+)
+
+// Signal labels recorded on a candidate to explain why it was
+// proposed.
+const (
+	// SignalType is the format for a conventional-commit type signal.
+	SignalType = "type:%s"
+	// SignalTrailer is the format for a classifying trailer signal.
+	SignalTrailer = "trailer:%s"
+	// SignalWord is the format for a signal-word match.
+	SignalWord = "word:%s"
+	// SignalBreaking marks a "!" or BREAKING CHANGE commit.
+	SignalBreaking = "breaking"
+)
+
+// IDFormat is the candidate ID format: kind prefix and short hash
+// (e.g. "d-1a2b3c4").
+const IDFormat = "%s-%s"
+
+// IDPrefix maps an entry kind to its candidate ID prefix.
+var IDPrefix = map[string]string{
+	entry.Decision: "d",
+	entry.Learning: "l",
+}
+
+// TypeKind maps the conventional-commit types that imply a kind on
+// their own. feat and untyped commits rely on trailers and signal
+// words; the QuietTypes never yield a candidate without a trailer.
+var TypeKind = map[string]string{
+	"fix":      entry.Learning,
+	"perf":     entry.Learning,
+	"revert":   entry.Learning,
+	"refactor": entry.Decision,
+	"build":    entry.Decision,
+}
+
+// QuietTypes are conventional-commit types whose signal words are
+// ignored: their wording rarely reflects a decision or a lesson.
+var QuietTypes = map[string]bool{
+	"docs":  true,
+	"style": true,
+	"test":  true,
+	"chore": true,
+	"ci":    true,
+}
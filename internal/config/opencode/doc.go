@@ -0,0 +1,34 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package opencode centralizes constants for importing
+// OpenCode sessions into the journal.
+//
+// OpenCode keeps its history as small JSON documents
+// under $XDG_DATA_HOME/opencode/storage (default
+// ~/.local/share/opencode/storage):
+//
+//   - session/<projectID>/<sessionID>: session info,
+//     including its directory and parent session
+//   - message/<sessionID>/<messageID>: one message with
+//     role, model, times, and token usage
+//   - part/<messageID>/<partID>: the message's text,
+//     reasoning, and tool call parts
+//   - project/<projectID>: the project worktree
+//
+// # Storage Paths
+//
+// [EnvDataHome], [DirLocal], [DirShare], and [DirApp]
+// locate the data directory; the Dir* constants under it
+// name the store's collections.
+//
+// # Parts
+//
+// [PartText], [PartReasoning], and [PartTool] are the
+// part types the importer maps; step markers, snapshots,
+// and patches are skipped. A tool part whose status is
+// [ToolStatusError] marks its result as an error.
+package opencode
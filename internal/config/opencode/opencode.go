@@ -0,0 +1,43 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package opencode
+
+// OpenCode data directory and storage layout.
+const (
+	// EnvDataHome is the XDG variable that relocates the data dir.
+	EnvDataHome = "XDG_DATA_HOME"
+	// DirLocal is the first component of the default data home,
+	// ~/.local/share.
+	DirLocal = ".local"
+	// DirShare is the second component of the default data home.
+	DirShare = "share"
+	// DirApp is OpenCode's directory under the data home.
+	DirApp = "opencode"
+	// DirStorage is the root of OpenCode's JSON document store.
+	DirStorage = "storage"
+	// DirSession holds session/<projectID>/<sessionID>.json.
+	DirSession = "session"
+	// DirMessage holds message/<sessionID>/<messageID>.json.
+	DirMessage = "message"
+	// DirPart holds part/<messageID>/<partID>.json.
+	DirPart = "part"
+	// DirProject holds project/<projectID>.json.
+	DirProject = "project"
+)
+
+// Message part types.
+const (
+	// PartText is visible message text.
+	PartText = "text"
+	// PartReasoning is model reasoning.
+	PartReasoning = "reasoning"
+	// PartTool is a tool call with its state and output.
+	PartTool = "tool"
+)
+
+// ToolStatusError is the state of a tool call that failed.
+const ToolStatusError = "error"
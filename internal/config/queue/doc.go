@@ -0,0 +1,13 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package queue holds configuration constants for the review queue
+// shared by ctx mine and ctx align: the state-file encoding.
+//
+// These are structural constants only — no logic. The queue lives
+// in internal/queue; the file names belong to each command's own
+// config package.
+package queue
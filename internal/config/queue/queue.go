@@ -0,0 +1,20 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package queue
+
+// JSONIndent is the indent unit for the ledger and review files
+// (both are meant to be read, and the review file edited, by hand).
+const JSONIndent = "  "
+
+// Candidate dispositions recorded in the ledger.
+const (
+	// Accepted means the candidate was written to its context file.
+	Accepted = "accepted"
+	// Rejected means the candidate was discarded; it is not
+	// re-proposed.
+	Rejected = "rejected"
+)
@@ -0,0 +1,39 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package redact centralizes constants for the secret
+// redaction stage of journal import.
+//
+// # Rules
+//
+// Built-in rule names ([RuleAWSAccessKey] through
+// [RuleHighEntropy]) label each placeholder so a reader
+// can tell what was removed. Their patterns live in
+// [internal/config/regex]. Project rules ([Rule]) come
+// from the redact section of .ctxrc.
+//
+// # Placeholders
+//
+// A match is replaced by [PlaceholderFormat] filled with
+// the rule name and the secret's number within the entry:
+// secrets are numbered in order of first appearance, and
+// repeats of one secret share its number. Nothing derived
+// from the secret itself is written, so a placeholder
+// cannot be used to confirm a guessed value.
+//
+// # Entropy
+//
+// Tokens of at least [EntropyMinLen] characters that mix
+// upper case, lower case, and digits are redacted when
+// their Shannon entropy reaches [EntropyThreshold] bits
+// per character.
+//
+// # Versioning
+//
+// [RulesetVersion] names the built-in ruleset. Bump it
+// whenever a built-in rule changes so the journal state
+// shows which entries went through an older ruleset.
+package redact
@@ -0,0 +1,61 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package redact
+
+// Built-in rule names, used in placeholders and findings.
+const (
+	// RuleAWSAccessKey names AWS access key IDs.
+	RuleAWSAccessKey = "aws-access-key"
+	// RuleAWSSecretKey names AWS secret access keys.
+	RuleAWSSecretKey = "aws-secret-key"
+	// RuleGCPAPIKey names Google Cloud API keys.
+	RuleGCPAPIKey = "gcp-api-key"
+	// RuleGitHubToken names GitHub personal and app tokens.
+	RuleGitHubToken = "github-token"
+	// RuleJWT names JSON Web Tokens.
+	RuleJWT = "jwt"
+	// RulePrivateKey names PEM private key blocks.
+	RulePrivateKey = "private-key"
+	// RuleBearer names bearer tokens in authorization headers.
+	RuleBearer = "bearer-token"
+	// RuleEnvSecret names values assigned to secret-looking
+	// environment variables.
+	RuleEnvSecret = "env-secret"
+	// RuleHighEntropy names high-entropy strings.
+	RuleHighEntropy = "high-entropy"
+)
+
+// Placeholder formatting.
+const (
+	// PlaceholderFormat renders a placeholder from the rule
+	// name and the secret's number within the entry.
+	PlaceholderFormat = "[REDACTED:%s:%d]"
+	// HashLen is the number of hex characters of the
+	// settings hash appended to the ruleset version.
+	HashLen = 8
+)
+
+// High-entropy detector thresholds.
+const (
+	// EntropyMinLen is the shortest token the entropy
+	// detector considers.
+	EntropyMinLen = 32
+	// EntropyThreshold is the Shannon entropy, in bits per
+	// character, at or above which a token is redacted.
+	EntropyThreshold = 4.3
+)
+
+// Ruleset versioning.
+const (
+	// RulesetVersion names the built-in ruleset.
+	RulesetVersion = "v1"
+	// VersionSep joins the built-in version and the hash of
+	// the project rules.
+	VersionSep = "+"
+	// FieldSep separates fields hashed into the version.
+	FieldSep = "\x00"
+)
@@ -0,0 +1,18 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package redact
+
+// Rule is a project redaction rule from .ctxrc.
+//
+// Fields:
+//   - Name: label used in placeholders and findings
+//   - Pattern: regular expression; when it has a capture
+//     group, only the first group is redacted
+type Rule struct {
+	Name    string `yaml:"name"`
+	Pattern string `yaml:"pattern"`
+}
@@ -0,0 +1,19 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package regex
+
+import "regexp"
+
+// AiderTokens matches the token report Aider prints after each
+// reply, e.g. "Tokens: 2.3k sent, 150 received.".
+//
+// Groups:
+//   - 1: tokens sent, optionally suffixed k or M
+//   - 2: tokens received, optionally suffixed k or M
+var AiderTokens = regexp.MustCompile(
+	`Tokens: ([\d.,]+[kKmM]?) sent, ([\d.,]+[kKmM]?) received`,
+)
@@ -0,0 +1,46 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package regex
+
+import "regexp"
+
+// Session transcript patterns for ctx align.
+var (
+	// AlignInterrupt matches the marker a tool records when the user
+	// interrupts an assistant turn; any text after it is the user's
+	// restated intent.
+	AlignInterrupt = regexp.MustCompile(
+		`^\s*\[Request interrupted by user(?: for tool use)?\]\s*`)
+	// AlignRejected matches the tool result recorded when the user
+	// rejects a tool use.
+	AlignRejected = regexp.MustCompile(
+		`(?i)^\s*The user doesn'?t want to proceed with this tool use`)
+	// AlignRejectedSaid matches the feedback the user typed while
+	// rejecting a tool use.
+	//
+	// Groups:
+	//   - 1: the feedback
+	AlignRejectedSaid = regexp.MustCompile(
+		`(?is)the user said:\s*(.+)$`)
+	// AlignCorrection matches a user turn that opens by correcting
+	// the agent.
+	AlignCorrection = regexp.MustCompile(
+		`(?i)^\s*(?:no[,.!]|nope\b|stop\b|wait\b|hold on\b` +
+			`|that'?s not (?:what|it|right)\b` +
+			`|not what I (?:meant|asked|wanted)\b` +
+			`|I (?:said|meant|asked for)\b|actually,` +
+			`|don'?t\b|do not\b|undo\b|revert (?:that|this)\b)`)
+	// AlignRule matches intent worded as a standing rule rather
+	// than a one-off instruction.
+	AlignRule = regexp.MustCompile(
+		`(?i)\b(?:always|never|from now on|going forward` +
+			`|by convention|in this (?:project|repo|codebase)` +
+			`|(?:we|I) prefer|prefer\b.+\bover)\b`)
+	// AlignSkip matches user turns a tool generates rather than the
+	// user types: command wrappers and local-command caveats.
+	AlignSkip = regexp.MustCompile(`^\s*(?:<|Caveat:)`)
+)
@@ -58,5 +58,6 @@
 //     dead (unused) or if a referenced variable is missing.
 //
 // New patterns therefore must land here; new call sites
-// reference the variable by name.
+// reference the variable by name. Patterns read from
+// configuration at run time compile through [CompileUser].
 package regex
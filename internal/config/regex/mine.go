@@ -0,0 +1,51 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package regex
+
+import "regexp"
+
+// Commit message patterns for ctx mine.
+var (
+	// MineConventional matches a conventional-commit subject.
+	//
+	// Groups:
+	//   - 1: type (e.g. "fix")
+	//   - 2: scope (may be empty)
+	//   - 3: "!" when the change is breaking (may be empty)
+	//   - 4: description
+	MineConventional = regexp.MustCompile(
+		`^([a-z]+)(?:\(([^)]*)\))?(!)?:\s*(.+)$`)
+	// MineSkip matches subjects that never carry a decision or a
+	// lesson: merges and version bumps.
+	MineSkip = regexp.MustCompile(
+		`(?i)^(?:merge (?:branch|pull request|remote-tracking|tag)\b` +
+			`|(?:\w+(?:\([^)]*\))?!?:\s*)?(?:release|bump|version)\b.*` +
+			`\bv?\d+\.\d+|v?\d+\.\d+\.\d+$)`)
+	// MineTrailer matches one "Key: value" trailer line.
+	//
+	// Groups:
+	//   - 1: key (e.g. "Decision", "BREAKING CHANGE")
+	//   - 2: value
+	MineTrailer = regexp.MustCompile(
+		`^([A-Za-z][A-Za-z0-9-]*|BREAKING CHANGE):\s+(.+)$`)
+	// MineDecisionWord matches wording that signals a decision.
+	MineDecisionWord = regexp.MustCompile(
+		`(?i)\b(?:switch(?:ed)? (?:to|from)|migrat(?:e|ed|ion)` +
+			`|replac(?:e|ed|es)\b.*?\bwith|adopt(?:ed)?|instead of` +
+			`|in favou?r of|deprecat(?:e|ed)|rather than` +
+			`|decid(?:e|ed)|chose|opt(?:ed)? for)\b`)
+	// MineLearningWord matches wording that signals a lesson.
+	MineLearningWord = regexp.MustCompile(
+		`(?i)\b(?:workaround|root cause|regression|race condition` +
+			`|turns out|gotcha|edge case|deadlock|leak(?:s|ed|ing)?` +
+			`|panic(?:s|ked)?|caveat|pitfall|footgun|off-by-one` +
+			`|surprising(?:ly)?)\b`)
+)
+
+// MineConventionalDesc is the submatch index of the description in
+// MineConventional.FindStringSubmatch.
+const MineConventionalDesc = 4
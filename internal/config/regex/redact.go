@@ -0,0 +1,82 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package regex
+
+import "regexp"
+
+// RedactAWSAccessKey matches AWS access key IDs
+// (long-term AKIA and temporary ASIA keys).
+var RedactAWSAccessKey = regexp.MustCompile(
+	`\b(?:AKIA|ASIA)[0-9A-Z]{16}\b`,
+)
+
+// RedactAWSSecretKey matches a 40-character AWS secret
+// access key assigned to an aws_secret_access_key name.
+// Group 1 is the key.
+var RedactAWSSecretKey = regexp.MustCompile(
+	`(?i)aws_?secret_?access_?key["']?\s*[:=]\s*["']?` +
+		`([A-Za-z0-9/+=]{40})`,
+)
+
+// RedactGCPAPIKey matches Google Cloud API keys.
+var RedactGCPAPIKey = regexp.MustCompile(
+	`\bAIza[0-9A-Za-z_\-]{35}`,
+)
+
+// RedactGitHubToken matches GitHub personal access,
+// OAuth, app, and fine-grained tokens.
+var RedactGitHubToken = regexp.MustCompile(
+	`\b(?:gh[pousr]_[A-Za-z0-9]{36}|github_pat_[A-Za-z0-9_]{22,})`,
+)
+
+// RedactJWT matches JSON Web Tokens: three base64url
+// segments, the first two encoding JSON objects.
+var RedactJWT = regexp.MustCompile(
+	`\beyJ[A-Za-z0-9_-]{10,}\.eyJ[A-Za-z0-9_-]{10,}\.` +
+		`[A-Za-z0-9_-]{10,}`,
+)
+
+// RedactPrivateKey matches PEM private key blocks, across
+// lines or with escaped newlines inside JSON strings.
+var RedactPrivateKey = regexp.MustCompile(
+	`-----BEGIN [A-Z0-9 ]*PRIVATE KEY-----[\s\S]*?` +
+		`-----END [A-Z0-9 ]*PRIVATE KEY-----`,
+)
+
+// RedactBearer matches bearer tokens. Group 1 is the
+// token.
+var RedactBearer = regexp.MustCompile(
+	`(?i)\bbearer\s+([A-Za-z0-9._~+/\-]{20,}=*)`,
+)
+
+// RedactEnvSecret matches values assigned to upper-case
+// names containing SECRET, TOKEN, PASSWORD, or API_KEY.
+// Group 1 is the value; variable references and
+// placeholders are not matched.
+var RedactEnvSecret = regexp.MustCompile(
+	`\b[A-Z0-9_]*(?:SECRET|TOKEN|PASSWORD|PASSWD|API_?KEY)` +
+		`[A-Z0-9_]*\s*[:=]\s*["']?([^\s"'\[$\{<]{8,})`,
+)
+
+// RedactEntropyCandidate matches tokens the high-entropy
+// detector scores.
+var RedactEntropyCandidate = regexp.MustCompile(
+	`[A-Za-z0-9+/=_\-]{32,}`,
+)
+
+// RedactPlaceholder matches a placeholder written by
+// redaction. Group 1 is the secret's number within the
+// entry.
+var RedactPlaceholder = regexp.MustCompile(
+	`\[REDACTED:[^:\]\s]+:(\d{1,7})\]`,
+)
+
+// CompileUser compiles a pattern supplied at run time, such as
+// a project redaction rule from .ctxrc. Built-in patterns stay
+// package-level vars in this package; only configuration that
+// cannot be known at build time goes through here.
+var CompileUser = regexp.Compile
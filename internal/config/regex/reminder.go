@@ -0,0 +1,17 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package regex
+
+import "regexp"
+
+// SnoozeDays matches a day- or week-based snooze length such
+// as 3d or 2w, which time.ParseDuration does not accept.
+//
+// Groups:
+//   - 1: count
+//   - 2: unit (d or w)
+var SnoozeDays = regexp.MustCompile(`^(\d+)([dw])$`)
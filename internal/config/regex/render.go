@@ -0,0 +1,106 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package regex
+
+import "regexp"
+
+// Block-level patterns for the built-in Markdown renderer.
+var (
+	// RenderHeading matches an ATX heading, with or without
+	// a closing run of hashes.
+	//
+	// Groups:
+	//   - 1: hash prefix (level)
+	//   - 2: heading text
+	RenderHeading = regexp.MustCompile(
+		`^ {0,3}(#{1,6})(?:[ \t]+(.*?))?(?:[ \t]+#+)?[ \t]*$`)
+
+	// RenderSetext matches a setext heading underline.
+	//
+	// Groups:
+	//   - 1: the underline run ("=" for level 1, "-" for 2)
+	RenderSetext = regexp.MustCompile(`^ {0,3}(=+|-+)[ \t]*$`)
+
+	// RenderRule matches a thematic break (---, ***, ___).
+	RenderRule = regexp.MustCompile(
+		`^ {0,3}(?:(?:-[ \t]*){3,}|(?:\*[ \t]*){3,}|(?:_[ \t]*){3,})$`)
+
+	// RenderListItem matches a bullet or ordered list item.
+	//
+	// Groups:
+	//   - 1: leading indent
+	//   - 2: marker ("-", "*", "+", "1." or "1)")
+	//   - 3: spaces after the marker
+	//   - 4: item text
+	RenderListItem = regexp.MustCompile(
+		`^( {0,3})([-*+]|\d{1,9}[.)])(?:([ \t]+)(.*))?$`)
+
+	// RenderTask matches a task checkbox at the start of a
+	// list item.
+	//
+	// Groups:
+	//   - 1: the box state (" ", "x" or "X")
+	RenderTask = regexp.MustCompile(`^\[([ xX])\][ \t]+`)
+
+	// RenderTableDelim matches a table delimiter row.
+	RenderTableDelim = regexp.MustCompile(
+		`^ *\|? *:?-+:? *(?:\| *:?-+:? *)*\|? *$`)
+
+	// RenderAdmonition matches an admonition opener:
+	// `!!! type "Title"`, or `???`/`???+` for a collapsible
+	// one.
+	//
+	// Groups:
+	//   - 1: marker ("!!!", "???" or "???+")
+	//   - 2: admonition type
+	//   - 3: quoted title (optional)
+	RenderAdmonition = regexp.MustCompile(
+		`^(!!!|\?\?\?\+?)[ \t]+([\w-]+)(?:[ \t]+"(.*)")?[ \t]*$`)
+
+	// RenderTab matches a content tab opener: `=== "Label"`.
+	//
+	// Groups:
+	//   - 1: tab label
+	RenderTab = regexp.MustCompile(`^===\+?[ \t]+"(.*)"[ \t]*$`)
+
+	// RenderHTMLBlock matches a line that opens a raw HTML
+	// block.
+	//
+	// Groups:
+	//   - 1: tag name
+	RenderHTMLBlock = regexp.MustCompile(
+		`^ {0,3}</?([A-Za-z][A-Za-z0-9-]*)(?:[\s/>]|$)`)
+)
+
+// RenderListItemText is the submatch index of the item
+// text in RenderListItem.FindStringSubmatch.
+const RenderListItemText = 4
+
+// Inline patterns for the built-in Markdown renderer. All
+// are anchored: the renderer tries them at its current
+// position.
+var (
+	// RenderEntity matches an HTML character reference.
+	RenderEntity = regexp.MustCompile(
+		`^&(?:#[0-9]{1,7}|#[xX][0-9a-fA-F]{1,6}|[A-Za-z][A-Za-z0-9]{1,31});`)
+
+	// RenderAutolink matches an angle-bracket autolink.
+	//
+	// Groups:
+	//   - 1: the URL
+	RenderAutolink = regexp.MustCompile(
+		`^<((?:https?|ftp|mailto):[^\s<>]*)>`)
+
+	// RenderBareURL matches a bare http(s) URL, leaving
+	// trailing punctuation outside the match.
+	RenderBareURL = regexp.MustCompile(
+		`^https?://[^\s<]*[^\s<.,:;"'!?)\]*_]`)
+
+	// RenderTag matches any HTML tag, for extracting the
+	// plain text of a rendered page.
+	RenderTag = regexp.MustCompile(`<[^>]*>`)
+)
@@ -29,6 +29,16 @@ var Task = regexp.MustCompile(taskPattern)
 // Use with FindAllStringSubmatch on multiline content.
 var TaskMultiline = regexp.MustCompile(`(?m)` + taskPattern)
 
+// TaskAnnotation matches a dependency annotation inside task
+// text: #id:<slug>, #depends:<ids> or #blocked-by:<ids>.
+//
+// Groups:
+//   - 1: annotation name (id, depends, blocked-by)
+//   - 2: value (comma-separated IDs for depends and blocked-by)
+var TaskAnnotation = regexp.MustCompile(
+	`(?:^|\s)#(id|depends|blocked-by):(\S+)`,
+)
+
 // Runtime configuration.
 const (
 	// TaskCompleteReplace is the regex replacement string for marking a task done.
@@ -17,6 +17,15 @@
 // reminder list and inject any due reminders into the
 // agent's next system prompt.
 //
+// # Recurrence and Snooze
+//
+// A reminder may repeat: Every maps the --every
+// shorthands to RRULEs, and the Rule* and Freq*
+// constants name the RFC 5545 subset that is
+// understood (FREQ, INTERVAL, BYDAY, BYMONTHDAY).
+// SnoozeWeek is the week suffix ctx remind snooze
+// accepts, next to day counts and Go durations.
+//
 // # Template Variables
 //
 // VarList ("ReminderList") is the key injected into
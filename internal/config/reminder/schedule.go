@@ -0,0 +1,85 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package reminder
+
+import "time"
+
+// Recurrence shorthands accepted by --every, and the RRULE
+// each stands for.
+var Every = map[string]string{
+	"daily":   "FREQ=DAILY",
+	"weekly":  "FREQ=WEEKLY",
+	"monthly": "FREQ=MONTHLY",
+	"yearly":  "FREQ=YEARLY",
+}
+
+// RRULE (RFC 5545) parts understood by --every. Rules work
+// at day granularity; time-of-day parts are not supported.
+const (
+	// RulePrefix is the optional "RRULE:" prefix.
+	RulePrefix = "RRULE:"
+	// RulePartSep separates rule parts.
+	RulePartSep = ";"
+	// RuleValueSep separates a part's name from its value.
+	RuleValueSep = "="
+	// RuleListSep separates values in BYDAY and BYMONTHDAY.
+	RuleListSep = ","
+	// RuleFreq names the frequency part.
+	RuleFreq = "FREQ"
+	// RuleInterval names the interval part.
+	RuleInterval = "INTERVAL"
+	// RuleByDay names the weekday list part.
+	RuleByDay = "BYDAY"
+	// RuleByMonthDay names the day-of-month list part.
+	RuleByMonthDay = "BYMONTHDAY"
+)
+
+// RRULE frequencies.
+const (
+	// FreqDaily repeats every INTERVAL days.
+	FreqDaily = "DAILY"
+	// FreqWeekly repeats every INTERVAL weeks, on BYDAY.
+	FreqWeekly = "WEEKLY"
+	// FreqMonthly repeats every INTERVAL months, on
+	// BYMONTHDAY.
+	FreqMonthly = "MONTHLY"
+	// FreqYearly repeats every INTERVAL years, on the start
+	// date's month and day.
+	FreqYearly = "YEARLY"
+)
+
+// Weekdays maps RRULE BYDAY codes to weekdays.
+var Weekdays = map[string]time.Weekday{
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+	"SU": time.Sunday,
+}
+
+// Recurrence limits.
+const (
+	// MaxMonthDay bounds BYMONTHDAY (and -MaxMonthDay counts
+	// from the end of the month).
+	MaxMonthDay = 31
+	// MaxInterval bounds INTERVAL, which also scales the
+	// search span, so an absurd interval cannot stall the
+	// next-occurrence search.
+	MaxInterval = 366
+	// SearchYears bounds the search for the next occurrence,
+	// multiplied by INTERVAL; a rule that matches nothing in
+	// that span never fires.
+	SearchYears = 4
+	// DaysPerYear is the day count per search year.
+	DaysPerYear = 366
+)
+
+// SnoozeWeek is the week suffix of a snooze count (1w); a
+// bare day count takes "d" (3d).
+const SnoozeWeek = "w"
@@ -0,0 +1,41 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package search centralizes configuration constants for
+// the ranked full-text index behind ctx search, the
+// ctx_search MCP tool, and ctx agent relevance scoring.
+//
+// # Index File
+//
+//   - File ("search-index.json"): the persisted index
+//     under .context/state/
+//   - Version: schema version; an index written with a
+//     different version is rebuilt from scratch
+//
+// # Corpus
+//
+//   - Dirs: subdirectories of .context/ indexed in
+//     addition to its top-level Markdown files (archive,
+//     theme directories, journal, kb)
+//   - TypeJournal, TypeKB, TypeContext: document types
+//     beyond the entry types in config/entry
+//
+// # Ranking
+//
+//   - K1, B, Smooth: BM25 term-saturation, length
+//     normalization, and IDF smoothing parameters
+//
+// # Query Syntax
+//
+//   - FieldType ("type:"), FieldSince ("since:"): field
+//     prefixes recognized in query text
+//   - Quote: delimiter of an exact phrase
+//
+// # Output
+//
+//   - DefaultLimit, MaxLimit: result counts
+//   - SnippetLen: maximum snippet length in runes
+package search
@@ -0,0 +1,77 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package search
+
+import (
+	"github.com/ActiveMemory/ctx/internal/config/dir"
+	"github.com/ActiveMemory/ctx/internal/config/disclosure"
+	"github.com/ActiveMemory/ctx/internal/config/kb"
+)
+
+// Index file.
+const (
+	// File is the index filename under .context/state/.
+	File = "search-index.json"
+	// Version is the index schema version. An index file
+	// with any other version is discarded and rebuilt.
+	Version = 1
+)
+
+// Dirs lists the .context/ subdirectories indexed in
+// addition to the top-level Markdown files. Each is walked
+// recursively.
+var Dirs = []string{
+	dir.Archive,
+	disclosure.ThemeDirDecision,
+	disclosure.ThemeDirLearning,
+	disclosure.ThemeDirConvention,
+	dir.Journal,
+	kb.KBSubdir,
+}
+
+// Document types beyond the entry types in config/entry.
+const (
+	// TypeJournal marks an imported journal session.
+	TypeJournal = "journal"
+	// TypeKB marks a knowledge-base page.
+	TypeKB = "kb"
+	// TypeContext marks any other context file section.
+	TypeContext = "context"
+)
+
+// BM25 parameters.
+const (
+	// K1 controls term-frequency saturation.
+	K1 = 1.2
+	// B controls document-length normalization.
+	B = 0.75
+	// Smooth is the IDF smoothing added to the matching and
+	// non-matching document counts.
+	Smooth = 0.5
+)
+
+// Query syntax.
+const (
+	// FieldType restricts results to one document type;
+	// repeat it to allow several.
+	FieldType = "type:"
+	// FieldSince restricts results to documents dated on
+	// or after a YYYY-MM-DD date.
+	FieldSince = "since:"
+	// Quote delimits an exact phrase.
+	Quote = '"'
+)
+
+// Output limits.
+const (
+	// DefaultLimit is the result count when none is given.
+	DefaultLimit = 10
+	// MaxLimit caps the result count of one search.
+	MaxLimit = 200
+	// SnippetLen caps a result snippet, in runes.
+	SnippetLen = 160
+)
@@ -9,7 +9,8 @@
 // across multiple tools.
 //
 // ctx supports session transcripts from Claude Code,
-// VS Code Copilot Chat, GitHub Copilot CLI, and raw
+// VS Code Copilot Chat, GitHub Copilot CLI, Aider,
+// OpenAI Codex CLI, Gemini CLI, OpenCode, and raw
 // Markdown files. This package provides the shared
 // vocabulary: tool identifiers, YAML frontmatter
 // field names, tool display keys, and session ID
@@ -20,6 +21,10 @@
 //   - [ToolClaudeCode]: Claude Code JSONL sessions.
 //   - [ToolCopilot]: VS Code Copilot Chat.
 //   - [ToolCopilotCLI]: GitHub Copilot CLI.
+//   - [ToolAider]: Aider chat history.
+//   - [ToolCodex]: OpenAI Codex CLI.
+//   - [ToolGemini]: Gemini CLI.
+//   - [ToolOpenCode]: OpenCode.
 //   - [ToolMarkdown]: plain Markdown transcripts.
 //
 // # Claude Code Tool Names
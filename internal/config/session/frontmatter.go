@@ -43,6 +43,7 @@ const (
 	FmKeyTokensOut  = "tokens_out"
 	FmKeyID         = "session_id"
 	FmKeyEntrypoint = "entrypoint"
+	FmKeyGitRefs    = "git_refs"
 )
 
 // Entrypoint values.
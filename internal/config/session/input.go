@@ -14,6 +14,8 @@ const (
 	ToolInputURL         = "url"
 	ToolInputQuery       = "query"
 	ToolInputDescription = "description"
+	ToolInputOldString   = "old_string"
+	ToolInputNewString   = "new_string"
 )
 
 // Tool display limits.
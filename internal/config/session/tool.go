@@ -14,6 +14,14 @@ const (
 	ToolCopilot = "copilot"
 	// ToolCopilotCLI is the tool identifier for GitHub Copilot CLI sessions.
 	ToolCopilotCLI = "copilot-cli"
+	// ToolAider is the tool identifier for Aider chat history files.
+	ToolAider = "aider"
+	// ToolCodex is the tool identifier for OpenAI Codex CLI sessions.
+	ToolCodex = "codex"
+	// ToolGemini is the tool identifier for Gemini CLI sessions.
+	ToolGemini = "gemini"
+	// ToolOpenCode is the tool identifier for OpenCode sessions.
+	ToolOpenCode = "opencode"
 	// ToolMarkdown is the tool identifier for Markdown session files.
 	ToolMarkdown = "markdown"
 )
@@ -0,0 +1,45 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package site defines constants for the built-in static
+// site renderer behind `ctx journal site --build`,
+// `ctx kb site`, and `ctx serve`.
+//
+// # Output Layout
+//
+//   - [Dir] ("site"): the HTML output directory inside a
+//     generated journal site.
+//   - [AssetDir] ("_ctx"): renderer assets (stylesheet,
+//     search script, search index) inside the output.
+//   - [FileStyle], [FileScript], [FileSearchIndex]: the
+//     asset file names.
+//   - [IndexHTML]: the page a directory URL resolves to.
+//
+// # Rendering
+//
+//   - [IndentWidth], [MaxListIndent]:
+//     Markdown block-structure limits.
+//   - [SearchTextMax]: how much of each page's text goes
+//     into the search index.
+//   - [InlineTags]: raw HTML tags passed through inside
+//     paragraphs; any other tag is escaped.
+//   - [BlockTags]: tags that open a raw HTML block.
+//   - [RawBlockTags]: HTML blocks kept verbatim up to
+//     their closing tag rather than the next blank line.
+//   - [UnsafeSchemes], [BlockedHref]: link destinations
+//     that are never emitted, and what replaces them.
+//
+// # Serving
+//
+//   - [Network], [DefaultAddr]: where `ctx serve`
+//     listens.
+//   - [ReadHeaderTimeout]: request header deadline.
+//
+// # Concurrency
+//
+// All exports are immutable. Safe for any access
+// pattern.
+package site
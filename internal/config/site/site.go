@@ -0,0 +1,146 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package site
+
+import "time"
+
+// Output layout.
+const (
+	// Dir is the HTML output directory inside a generated
+	// journal site (next to docs/ and zensical.toml).
+	Dir = "site"
+	// AssetDir holds the renderer's own assets inside the
+	// output directory. The leading underscore keeps it
+	// clear of page directories.
+	AssetDir = "_ctx"
+	// FileStyle is the site stylesheet.
+	FileStyle = "style.css"
+	// FileScript is the client-side search script.
+	FileScript = "search.js"
+	// FileSearchIndex is the client-side search index.
+	FileSearchIndex = "search.json"
+	// IndexHTML is the page a directory URL resolves to.
+	IndexHTML = "index.html"
+	// TempPattern names the scratch directory `ctx serve`
+	// renders a bare Markdown tree into.
+	TempPattern = "ctx-site-*"
+)
+
+// Rendering limits.
+const (
+	// IndentWidth is the indentation of indented code and of
+	// admonition and tab bodies.
+	IndentWidth = 4
+	// MaxListIndent is the widest gap after a list marker
+	// that still counts as part of the marker; a wider gap
+	// starts indented code inside the item.
+	MaxListIndent = 4
+	// SearchTextMax caps the runes of page text stored per
+	// page in the search index.
+	SearchTextMax = 4000
+)
+
+// Serving.
+const (
+	// Network is the listener network for `ctx serve`.
+	Network = "tcp"
+	// DefaultAddr is where `ctx serve` listens by default.
+	DefaultAddr = "127.0.0.1:8000"
+	// ReadHeaderTimeout bounds how long a client may take
+	// to send request headers.
+	ReadHeaderTimeout = 10 * time.Second
+)
+
+// InlineTags lists the raw HTML tags passed through inside
+// paragraphs. Any other tag-like text is escaped, so a
+// transcript that mentions <system-reminder> renders as
+// text instead of swallowing the page.
+var InlineTags = map[string]bool{
+	"a": true, "abbr": true, "b": true, "br": true,
+	"code": true, "del": true, "em": true, "i": true,
+	"img": true, "ins": true, "kbd": true, "mark": true,
+	"q": true, "s": true, "small": true, "span": true,
+	"strong": true, "sub": true, "sup": true, "time": true,
+	"u": true,
+}
+
+// BlockTags lists the HTML tags that open a raw HTML
+// block when they start a line. The block runs to the
+// next blank line.
+var BlockTags = map[string]bool{
+	"address": true, "article": true, "aside": true,
+	"blockquote": true, "details": true, "div": true,
+	"dl": true, "fieldset": true, "figure": true,
+	"footer": true, "form": true, "h1": true, "h2": true,
+	"h3": true, "h4": true, "h5": true, "h6": true,
+	"header": true, "hr": true, "li": true, "main": true,
+	"nav": true, "ol": true, "p": true, "section": true,
+	"summary": true, "table": true, "tbody": true,
+	"td": true, "tfoot": true, "th": true, "thead": true,
+	"tr": true, "ul": true,
+}
+
+// RawBlockTags lists the HTML blocks kept verbatim up to
+// their closing tag, blank lines included. Script, style,
+// and textarea are deliberately absent: they are escaped
+// like any other unknown tag, so a transcript cannot run
+// code in the rendered site.
+var RawBlockTags = map[string]bool{
+	"pre": true,
+}
+
+// Attrs lists the attributes a passed-through HTML tag may
+// carry. A tag with any other attribute is escaped whole,
+// however the attribute is spelled or separated.
+var Attrs = map[string]bool{
+	"align": true, "alt": true, "cite": true, "class": true,
+	"colspan": true, "datetime": true, "dir": true,
+	"height": true, "href": true, "id": true, "lang": true,
+	"open": true, "rowspan": true, "src": true,
+	"start": true, "title": true, "width": true,
+}
+
+// URLAttrs lists the [Attrs] that hold a URL. Their values
+// may not use an [UnsafeSchemes] scheme.
+var URLAttrs = map[string]bool{
+	"cite": true, "href": true, "src": true,
+}
+
+// UnsafeSchemes lists the URL schemes a link or image
+// destination may not use, since the browser would run or
+// inline them. Matched after folding case and dropping
+// whitespace and control characters.
+var UnsafeSchemes = []string{"javascript:", "vbscript:", "data:"}
+
+// Markup the renderer emits or recognizes.
+const (
+	// TagHead is the table header cell tag.
+	TagHead = "th"
+	// TagData is the table body cell tag.
+	TagData = "td"
+	// AlignLeft, AlignRight, and AlignCenter are table
+	// column alignments.
+	AlignLeft   = "left"
+	AlignRight  = "right"
+	AlignCenter = "center"
+	// CloseTagPrefix opens a closing HTML tag.
+	CloseTagPrefix = "</"
+	// BlockedHref replaces a destination with an
+	// [UnsafeSchemes] scheme.
+	BlockedHref = "#"
+	// AnchorFallback is the heading id used when a heading
+	// has no letters or digits.
+	AnchorFallback = "section"
+	// AnchorDupFormat disambiguates repeated heading ids.
+	// Args: base id, occurrence number.
+	AnchorDupFormat = "%s-%d"
+	// RootUp climbs one directory in a relative link.
+	RootUp = "../"
+	// InlineSpecial lists the bytes that may start inline
+	// markup; text between them is copied escaped in bulk.
+	InlineSpecial = "\\`*_~![<&h \n"
+)
@@ -20,6 +20,11 @@
 //   - [SkillManifest]: the expected filename inside
 //     every skill directory ("SKILL.md"). The loader
 //     rejects directories that lack this file.
+//   - [LockFile]: "skills.lock" in the context
+//     directory, recording each installed skill's
+//     source, version, commit, and SHA-256.
+//   - State* constants: the per-skill outcomes of
+//     ctx skill verify and ctx skill update.
 //
 // # Why Centralized
 //
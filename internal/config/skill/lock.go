@@ -0,0 +1,77 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package skill
+
+// LockFile is the lockfile, inside the context directory, that
+// records where each installed skill came from and its content
+// hash.
+const LockFile = "skills.lock"
+
+// LockVersion is the skills.lock format version written by this
+// build.
+const LockVersion = 1
+
+// SourcesFile, under the context directory's state/, maps each
+// skill installed from outside the project to its source
+// directory on this machine. skills.lock records only portable
+// sources; this file is not committed.
+const SourcesFile = "skill-sources.json"
+
+// RemoteDetail describes a source that is only known by its git
+// checkout. Takes (path in the checkout, short commit, remote).
+const RemoteDetail = "%s at %s in %s"
+
+// Staging layout for ctx skill update, which installs the new copy
+// beside the skills directory and swaps it in only once the
+// install has succeeded.
+const (
+	// StagePattern is the os.MkdirTemp pattern for the staging
+	// directory, created next to the skills directory so the swap
+	// is a rename on one filesystem. Takes the skills directory's
+	// base name.
+	StagePattern = ".%s-update-*"
+	// AsideName is the name the old copy is moved to inside the
+	// staging directory while the new one is swapped in.
+	AsideName = ".previous"
+)
+
+// Skill states reported by ctx skill verify and ctx skill update.
+const (
+	// StateOK means the installed copy matches skills.lock.
+	StateOK = "ok"
+	// StateModified means the installed copy was changed after
+	// it was installed.
+	StateModified = "modified"
+	// StateMissing means a locked skill is not installed.
+	StateMissing = "missing"
+	// StateUntracked means an installed skill has no
+	// skills.lock entry.
+	StateUntracked = "untracked"
+	// StateCurrent means the source has not changed since the
+	// skill was installed.
+	StateCurrent = "up-to-date"
+	// StateUpdated means the skill was reinstalled from its
+	// source.
+	StateUpdated = "updated"
+	// StateSkipped means a locally modified skill was not
+	// overwritten.
+	StateSkipped = "skipped"
+	// StateNoSource means the recorded source no longer exists.
+	StateNoSource = "no-source"
+)
+
+// DigestSep separates a file's slash-separated path from its
+// SHA-256 in the per-file lines a skill digest hashes.
+const DigestSep = "\x00"
+
+// Hash display in ctx skill update output.
+const (
+	// HashShort is the hash prefix length shown.
+	HashShort = 12
+	// HashArrow joins the old and new hash of an update.
+	HashArrow = " -> "
+)
@@ -0,0 +1,15 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package task holds the TASKS.md dependency vocabulary:
+// the annotation names that give a task a stable ID and
+// tie it to the tasks it waits on, the separator between
+// referenced IDs, and the output formats of ctx task graph.
+//
+// These are structural constants only — no logic. Parsing
+// and cycle detection live in internal/task; rendering in
+// internal/cli/task/core/graph.
+package task
@@ -0,0 +1,46 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package task
+
+import "strings"
+
+// Dependency annotations, written inline as #name:value tags.
+const (
+	// AnnotationID names the task's stable ID (#id:auth-api).
+	AnnotationID = "id"
+	// AnnotationDepends lists tasks that must be completed
+	// first (#depends:db-schema,config).
+	AnnotationDepends = "depends"
+	// AnnotationBlockedBy lists blockers: task IDs, or a
+	// free-form name for something outside TASKS.md
+	// (#blocked-by:vendor-api).
+	AnnotationBlockedBy = "blocked-by"
+	// RefSep separates IDs in a #depends or #blocked-by
+	// value.
+	RefSep = ","
+	// CycleSep joins the IDs of a dependency cycle for
+	// display.
+	CycleSep = " -> "
+)
+
+// Graph output formats for ctx task graph.
+const (
+	// GraphDOT renders Graphviz DOT.
+	GraphDOT = "dot"
+	// GraphMermaid renders a Mermaid flowchart.
+	GraphMermaid = "mermaid"
+)
+
+// Label escapes for graph output.
+var (
+	// DOTEscape escapes a label for a double-quoted DOT
+	// string.
+	DOTEscape = strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+	// MermaidEscape escapes a label for a double-quoted
+	// Mermaid node.
+	MermaidEscape = strings.NewReplacer(`"`, "#quot;")
+)
@@ -29,8 +29,9 @@
 // # Duration Constants
 //
 //   - [HoursPerDay] (24), [MinutesPerHour] (60),
-//     [DaysPerWeek] (7): integer constants for
-//     duration arithmetic that avoids magic numbers.
+//     [DaysPerWeek] (7), [MonthsPerYear] (12): integer
+//     constants for duration and calendar arithmetic
+//     that avoids magic numbers.
 //
 // # Date Parsing Helpers
 //
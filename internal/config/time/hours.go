@@ -14,4 +14,6 @@ const (
 	MinutesPerHour = 60
 	// DaysPerWeek is the number of days in a week.
 	DaysPerWeek = 7
+	// MonthsPerYear is the number of months in a year.
+	MonthsPerYear = 12
 )
@@ -36,6 +36,20 @@
 //   - [StatusEnabled], [StatusDisabled]: display
 //     labels for hook list output.
 //
+// # Manifest
+//
+//   - [ManifestFile]: the optional hooks.yaml holding
+//     per-hook matchers, timeouts, and priorities.
+//   - [KeySep], [KeyFormat]: the "<type>/<name>" layout
+//     of a hooks.yaml key; [MaxTimeout] caps its
+//     per-hook timeout.
+//   - [ParamPath]: the input parameter that path
+//     matchers test; [GlobAnyDepth] is the "**"
+//     segment that spans directories.
+//   - [LabelTools], [LabelPaths], [LabelSessionTools],
+//     [LabelTimeout], [LabelPriority]: matcher labels
+//     for hook list output.
+//
 // # Mock Constants
 //
 //   - [MockSessionID], [MockModel], [MockVersion]:
@@ -0,0 +1,48 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package trigger
+
+// Trigger manifest constants.
+const (
+	// ManifestFile is the optional hooks.yaml in the hooks
+	// directory that declares per-hook matchers, timeouts,
+	// and priorities.
+	ManifestFile = "hooks.yaml"
+	// ParamPath is the HookInput parameter holding the file
+	// path that path matchers are tested against.
+	ParamPath = "path"
+	// GlobAnyDepth is the path-matcher segment that matches
+	// zero or more directories.
+	GlobAnyDepth = "**"
+	// KeySep separates the trigger type from the script name in a
+	// hooks.yaml key (e.g. "pre-tool-use/guard"); the same name
+	// may exist under several types.
+	KeySep = "/"
+	// KeyFormat renders a hooks.yaml key from a trigger type and
+	// script name.
+	KeyFormat = "%s/%s"
+)
+
+// MaxTimeout is the largest per-hook timeout, in seconds, that
+// hooks.yaml may set. A hook runs inside the AI tool's event, so a
+// longer timeout would stall the session it guards.
+const MaxTimeout = 600
+
+// Matcher labels for hook list display; they mirror the
+// hooks.yaml keys.
+const (
+	// LabelTools labels the tool-name matchers.
+	LabelTools = "tools"
+	// LabelPaths labels the file-glob matchers.
+	LabelPaths = "paths"
+	// LabelSessionTools labels the session-tool matchers.
+	LabelSessionTools = "session_tools"
+	// LabelTimeout labels the per-hook timeout.
+	LabelTimeout = "timeout"
+	// LabelPriority labels the run-order priority.
+	LabelPriority = "priority"
+)
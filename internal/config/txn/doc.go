@@ -0,0 +1,14 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package txn holds configuration constants for the .context
+// write-transaction layer: the lock file name, how long a writer
+// waits for the lock, how often it polls, and when a held lock is
+// considered stale.
+//
+// These are structural constants only — no logic. The lock and
+// commit helpers live in internal/txn.
+package txn
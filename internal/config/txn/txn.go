@@ -0,0 +1,40 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package txn
+
+import "time"
+
+// Lock file settings.
+const (
+	// FileLock is the advisory lock file under .context/state/
+	// that serializes writers to one context directory.
+	FileLock = "write.lock"
+	// OwnerFormat renders the lock holder recorded in the lock
+	// file: process ID, host name, and acquisition time in Unix
+	// nanoseconds.
+	OwnerFormat = "%d %s %d"
+	// AsideFormat names the file a stale lock is renamed to before
+	// its holder is re-checked and it is removed: the lock path,
+	// then the breaker's process ID and Unix nanoseconds.
+	AsideFormat = "%s.%d.%d"
+)
+
+// Lock timing.
+const (
+	// Wait is how long a writer waits for a held lock before
+	// giving up.
+	Wait = 10 * time.Second
+	// Poll is the interval between acquisition attempts.
+	Poll = 20 * time.Millisecond
+	// StaleAfter is the age past which a lock is broken when its
+	// holder cannot be checked (another host, or an unreadable
+	// lock file). Writes take milliseconds; a lock this old was
+	// left by a process that died mid-write. A holder on this
+	// host is judged by whether its process is alive, never by
+	// age.
+	StaleAfter = 30 * time.Second
+)
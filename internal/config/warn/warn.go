@@ -23,6 +23,13 @@ const (
 	// Rename is the format for file rename failures.
 	Rename = "rename %s: %v"
 
+	// SkillLocalSource is the format for a skill installed from a
+	// directory that skills.lock cannot record portably. Takes
+	// (skill name, source path).
+	SkillLocalSource = "skill %s: %s is outside the project " +
+		"and not in a git checkout with a remote; " +
+		"skills.lock records its hash only"
+
 	// Walk is the format for directory walk failures.
 	Walk = "walk %s: %v"
 
@@ -102,6 +109,11 @@ const (
 	// should not silently drop its sessions from the result.
 	JournalScanDir = "scan journal dir %s: %v"
 
+	// JournalScanFile is the stderr format for a failed parse of a
+	// single session file (such as an Aider chat history) during
+	// journal querying.
+	JournalScanFile = "scan journal file %s: %v"
+
 	// DriftReload is the stderr format for a failed context reload
 	// during the drift post-fix re-check. On failure the prior
 	// context is reused, so the re-displayed report may be stale.
@@ -147,6 +159,23 @@ const (
 	// see replicateOnce.
 	HubReplicateRecv = "hub replicate recv %s: %v"
 
+	// HubReplicateSnapshot is the stderr format for a failed
+	// snapshot bootstrap after the master reported the
+	// follower's cursor as compacted. Takes (masterAddr,
+	// error); the next replication cycle retries.
+	HubReplicateSnapshot = "hub replicate snapshot %s: %v"
+
+	// HubCompact is the stderr format for a failed segment
+	// rotation or compaction after a successful append. The
+	// entries are already durable, so the publish succeeds;
+	// the next append retries the housekeeping.
+	HubCompact = "hub compact: %v"
+
+	// HubAdmin is the stderr format for an admin gateway that
+	// stopped serving. The gRPC hub keeps running. Takes
+	// (error).
+	HubAdmin = "hub admin gateway: %v"
+
 	// StateInitializedProbe is the stderr format for failures
 	// inside [state.Initialized] beyond "no context dir declared."
 	// Hooks bail on false either way, but a visible warning shows
@@ -244,3 +273,19 @@ const (
 	// close errors.
 	ResponseBody = "response body"
 )
+
+// Write-transaction lock warning formats.
+const (
+	// TxnStaleLock is the format for breaking a stale .context
+	// write lock. Takes (path, holder).
+	TxnStaleLock = "txn: breaking stale lock %s (held by %s)"
+
+	// TxnRelease is the format for a failed lock release. Takes
+	// (path, error).
+	TxnRelease = "txn: release %s: %v"
+
+	// TxnRestore is the format for a live lock that was moved
+	// aside while breaking a stale one and could not be put back.
+	// Takes (path, holder).
+	TxnRestore = "txn: could not restore live lock %s (held by %s)"
+)
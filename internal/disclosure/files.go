@@ -0,0 +1,51 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package disclosure
+
+import "path/filepath"
+
+// ThemeFiles lists the theme files behind a knowledge root.
+// Readers that look entries up by identity use it to search
+// past the root once entries have moved into themes.
+//
+// Parameters:
+//   - ctxDir: the context directory
+//   - rootFile: canonical knowledge-file basename
+//     (e.g. DECISIONS.md)
+//
+// Returns:
+//   - []string: full paths of the kind's theme files (nil when
+//     the directory is absent or rootFile is not a knowledge
+//     root)
+//   - error: a read error other than "does not exist"
+func ThemeFiles(ctxDir, rootFile string) ([]string, error) {
+	k, ok := KindFor(rootFile)
+	if !ok {
+		return nil, nil
+	}
+	dir, _ := ThemeDir(k)
+	return themeFiles(filepath.Join(ctxDir, dir))
+}
+
+// NamedThemeDir maps an entry type name ("learning",
+// "decision", "convention") to its theme directory.
+//
+// Parameters:
+//   - name: entry type name, as returned by [Kind.String]
+//
+// Returns:
+//   - string: theme directory relative to the context
+//     directory
+//   - bool: true when name is a knowledge entry type
+func NamedThemeDir(name string) (string, bool) {
+	for _, k := range []Kind{KindLearning, KindDecision, KindConvention} {
+		if k.String() == name {
+			return ThemeDir(k)
+		}
+	}
+	return "", false
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/ActiveMemory/ctx/internal/config/marker"
 	"github.com/ActiveMemory/ctx/internal/config/project"
 	"github.com/ActiveMemory/ctx/internal/config/regex"
+	cfgTask "github.com/ActiveMemory/ctx/internal/config/task"
 	cfgTime "github.com/ActiveMemory/ctx/internal/config/time"
 	"github.com/ActiveMemory/ctx/internal/config/token"
 	"github.com/ActiveMemory/ctx/internal/config/warn"
@@ -30,6 +31,7 @@ import (
 	"github.com/ActiveMemory/ctx/internal/i18n"
 	ctxIo "github.com/ActiveMemory/ctx/internal/io"
 	"github.com/ActiveMemory/ctx/internal/rc"
+	"github.com/ActiveMemory/ctx/internal/task"
 )
 
 // staleAgeExclude lists context files that are expected to be static
@@ -129,6 +131,40 @@ func checkStaleness(ctx *entity.Context, report *Report) {
 	}
 }
 
+// checkTaskCycles warns about TASKS.md tasks whose #depends or
+// #blocked-by annotations form a loop. ctx_next skips every task
+// on such a loop, so the work would never be suggested.
+//
+// Parameters:
+//   - ctx: Loaded context containing TASKS.md
+//   - report: Report to append warnings to (modified in place)
+func checkTaskCycles(ctx *entity.Context, report *Report) {
+	f := ctx.File(cfgCtx.Task)
+	if f == nil {
+		report.Passed = append(report.Passed, cfgDrift.CheckTaskDeps)
+		return
+	}
+
+	nodes := task.Nodes(strings.Split(string(f.Content), token.NewlineLF))
+	index := task.Index(nodes)
+	cycles := task.Cycles(nodes)
+	for _, cycle := range cycles {
+		report.Warnings = append(report.Warnings, Issue{
+			File: f.Name,
+			Line: nodes[index[cycle[0]]].Line,
+			Type: cfgDrift.IssueTaskCycle,
+			Message: fmt.Sprintf(
+				desc.Text(text.DescKeyDriftTaskCycle),
+				strings.Join(cycle, cfgTask.CycleSep),
+			),
+		})
+	}
+
+	if len(cycles) == 0 {
+		report.Passed = append(report.Passed, cfgDrift.CheckTaskDeps)
+	}
+}
+
 // checkConstitution performs heuristic checks for constitution violations.
 //
 // Scans the project root (the parent of the declared context directory)
@@ -13,12 +13,16 @@ import (
 	"slices"
 
 	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	"github.com/ActiveMemory/ctx/internal/config/dir"
 	cfgDrift "github.com/ActiveMemory/ctx/internal/config/drift"
 	"github.com/ActiveMemory/ctx/internal/config/embed/text"
 	"github.com/ActiveMemory/ctx/internal/config/file"
 	"github.com/ActiveMemory/ctx/internal/config/fs"
 	cfgHook "github.com/ActiveMemory/ctx/internal/config/hook"
+	cfgSkill "github.com/ActiveMemory/ctx/internal/config/skill"
+	"github.com/ActiveMemory/ctx/internal/entity"
 	"github.com/ActiveMemory/ctx/internal/rc"
+	"github.com/ActiveMemory/ctx/internal/skill"
 	"github.com/ActiveMemory/ctx/internal/steering"
 	"github.com/ActiveMemory/ctx/internal/trigger"
 )
@@ -199,3 +203,53 @@ func checkRCTool(report *Report) {
 
 	report.Passed = append(report.Passed, cfgDrift.CheckRCTool)
 }
+
+// checkSkillLock warns about skills recorded in skills.lock whose
+// installed copy was modified or removed. Untracked skills are
+// ignored, and a missing or unreadable lock passes silently.
+//
+// Parameters:
+//   - ctx: Loaded context whose directory holds the lock
+//   - report: Report to append warnings to (modified in place)
+func checkSkillLock(ctx *entity.Context, report *Report) {
+	lock, readErr := skill.ReadLock(
+		filepath.Join(ctx.Dir, cfgSkill.LockFile),
+	)
+	if readErr != nil || len(lock.Skills) == 0 {
+		report.Passed = append(report.Passed, cfgDrift.CheckSkillLock)
+		return
+	}
+
+	statuses, verifyErr := skill.Verify(
+		filepath.Join(ctx.Dir, dir.Skills), lock,
+	)
+	if verifyErr != nil {
+		report.Passed = append(report.Passed, cfgDrift.CheckSkillLock)
+		return
+	}
+
+	found := false
+	for _, s := range statuses {
+		issue := Issue{File: cfgSkill.LockFile}
+		switch s.State {
+		case cfgSkill.StateModified:
+			issue.Type = cfgDrift.IssueSkillModified
+			issue.Message = fmt.Sprintf(
+				desc.Text(text.DescKeyDriftSkillModified), s.Name,
+			)
+		case cfgSkill.StateMissing:
+			issue.Type = cfgDrift.IssueSkillMissing
+			issue.Message = fmt.Sprintf(
+				desc.Text(text.DescKeyDriftSkillMissing), s.Name,
+			)
+		default:
+			continue
+		}
+		report.Warnings = append(report.Warnings, issue)
+		found = true
+	}
+
+	if !found {
+		report.Passed = append(report.Passed, cfgDrift.CheckSkillLock)
+	}
+}
@@ -14,6 +14,9 @@ import (
 
 	cfgDrift "github.com/ActiveMemory/ctx/internal/config/drift"
 	cfgHook "github.com/ActiveMemory/ctx/internal/config/hook"
+	cfgSkill "github.com/ActiveMemory/ctx/internal/config/skill"
+	"github.com/ActiveMemory/ctx/internal/entity"
+	"github.com/ActiveMemory/ctx/internal/skill"
 	"github.com/ActiveMemory/ctx/internal/steering"
 	"github.com/ActiveMemory/ctx/internal/testutil/testctx"
 )
@@ -451,3 +454,87 @@ func writeCtxRC(t *testing.T, dir, content string) {
 		t.Fatal(err)
 	}
 }
+
+func TestCheckSkillLock(t *testing.T) {
+	tests := []struct {
+		name         string
+		mutate       func(t *testing.T, skillDir string)
+		wantWarnings int
+		wantType     cfgDrift.IssueType
+		wantPassed   bool
+	}{
+		{
+			name:       "installed skill matches lock",
+			mutate:     func(_ *testing.T, _ string) {},
+			wantPassed: true,
+		},
+		{
+			name: "installed skill edited",
+			mutate: func(t *testing.T, skillDir string) {
+				t.Helper()
+				if err := os.WriteFile(filepath.Join(skillDir, "extra.md"), []byte("edit"), 0o644); err != nil {
+					t.Fatal(err)
+				}
+			},
+			wantWarnings: 1,
+			wantType:     cfgDrift.IssueSkillModified,
+		},
+		{
+			name: "locked skill removed",
+			mutate: func(t *testing.T, skillDir string) {
+				t.Helper()
+				if err := os.RemoveAll(skillDir); err != nil {
+					t.Fatal(err)
+				}
+			},
+			wantWarnings: 1,
+			wantType:     cfgDrift.IssueSkillMissing,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root := t.TempDir()
+			ctxDir := filepath.Join(root, ".context")
+			src := filepath.Join(root, "demo")
+			if err := os.MkdirAll(src, 0o755); err != nil {
+				t.Fatal(err)
+			}
+			manifest := []byte("---\nname: demo\n---\nBody\n")
+			if err := os.WriteFile(filepath.Join(src, cfgSkill.SkillManifest), manifest, 0o644); err != nil {
+				t.Fatal(err)
+			}
+			sk, err := skill.Install(src, filepath.Join(ctxDir, "skills"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			lock := &skill.Lock{}
+			if err := skill.Track(lock, sk, src, root); err != nil {
+				t.Fatal(err)
+			}
+			if err := skill.WriteLock(filepath.Join(ctxDir, cfgSkill.LockFile), lock); err != nil {
+				t.Fatal(err)
+			}
+			tt.mutate(t, sk.Dir)
+
+			report := &Report{
+				Warnings:   []Issue{},
+				Violations: []Issue{},
+				Passed:     []cfgDrift.CheckName{},
+			}
+			checkSkillLock(&entity.Context{Dir: ctxDir}, report)
+
+			if len(report.Warnings) != tt.wantWarnings {
+				t.Fatalf("expected %d warnings, got %+v", tt.wantWarnings, report.Warnings)
+			}
+			for _, w := range report.Warnings {
+				if w.Type != tt.wantType {
+					t.Errorf("expected issue type %q, got %q", tt.wantType, w.Type)
+				}
+			}
+			if passed := checkPassed(report, cfgDrift.CheckSkillLock); passed != tt.wantPassed {
+				t.Errorf("expected passed=%v, got passed=%v", tt.wantPassed, passed)
+			}
+		})
+	}
+}
@@ -29,7 +29,8 @@ func (r *Report) Status() cfgDrift.StatusType {
 // Detect runs all drift detection checks on the given context.
 //
 // Performs multiple validation checks including path references, staleness
-// indicators, constitution compliance, and required file presence.
+// indicators, task dependency cycles, constitution compliance, required
+// file presence, and installed skills against skills.lock.
 //
 // Parameters:
 //   - ctx: Loaded context containing files to check
@@ -49,6 +50,9 @@ func Detect(ctx *entity.Context) *Report {
 	// Check for staleness indicators
 	checkStaleness(ctx, report)
 
+	// Check task dependency annotations for cycles
+	checkTaskCycles(ctx, report)
+
 	// Check constitution rules (basic heuristics)
 	checkConstitution(ctx, report)
 
@@ -79,5 +83,8 @@ func Detect(ctx *entity.Context) *Report {
 	// Check .ctxrc tool field for unsupported tool identifier
 	checkRCTool(report)
 
+	// Check installed skills against skills.lock
+	checkSkillLock(ctx, report)
+
 	return report
 }
@@ -220,6 +220,69 @@ func TestCheckStaleness(t *testing.T) {
 	}
 }
 
+func TestCheckTaskCycles(t *testing.T) {
+	tests := []struct {
+		name         string
+		tasksContent string
+		wantWarnings int
+		wantLine     int
+	}{
+		{
+			name: "acyclic",
+			tasksContent: "# Tasks\n\n" +
+				"- [ ] API #id:api #depends:db\n" +
+				"- [ ] DB #id:db\n",
+			wantWarnings: 0,
+		},
+		{
+			name: "cycle",
+			tasksContent: "# Tasks\n\n" +
+				"- [ ] API #id:api #depends:db\n" +
+				"- [ ] DB #id:db #blocked-by:api\n",
+			wantWarnings: 1,
+			wantLine:     3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := &entity.Context{
+				Dir: ".context",
+				Files: []entity.FileInfo{
+					{
+						Name:    "TASKS.md",
+						Content: []byte(tt.tasksContent),
+					},
+				},
+			}
+
+			report := &Report{
+				Warnings:   []Issue{},
+				Violations: []Issue{},
+				Passed:     []cfgDrift.CheckName{},
+			}
+
+			checkTaskCycles(ctx, report)
+
+			if len(report.Warnings) != tt.wantWarnings {
+				t.Fatalf(
+					"expected %d warnings, got %d",
+					tt.wantWarnings, len(report.Warnings),
+				)
+			}
+			if tt.wantWarnings == 0 {
+				return
+			}
+			w := report.Warnings[0]
+			if w.Type != cfgDrift.IssueTaskCycle ||
+				w.Line != tt.wantLine {
+				t.Errorf("warning = %+v, want task_cycle at line %d",
+					w, tt.wantLine)
+			}
+		})
+	}
+}
+
 func TestCheckRequiredFiles(t *testing.T) {
 	tests := []struct {
 		name         string
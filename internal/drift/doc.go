@@ -73,6 +73,10 @@
 //     `ctx steering sync`).
 //   - **Tool field** ([checkRCTool]): `.ctxrc`'s `tool:`
 //     field must be one of the supported AI tool IDs.
+//   - **Skill lock** ([checkSkillLock]): warns when a skill
+//     recorded in `.context/skills.lock` was modified after
+//     install or is no longer installed. Untracked skills
+//     and a missing lock pass.
 //
 // New checks are added by appending one more `checkX` call in
 // [Detect] and a constant to [config/drift.CheckName].
@@ -0,0 +1,24 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package entity
+
+// BackendEntry is one AI backend definition from the .ctxrc
+// backends table.
+//
+// Fields:
+//   - Endpoint: Base URL (empty uses the type's default endpoint)
+//   - APIKeyEnv: Environment variable holding the API key (empty
+//     means no auth); ctx never stores the key itself
+//   - Timeout: Per-request timeout as a Go duration (default 30s)
+//   - Model: Model used when a command names none (empty uses the
+//     first model the backend lists)
+type BackendEntry struct {
+	Endpoint  string `yaml:"endpoint"`
+	APIKeyEnv string `yaml:"api_key_env"`
+	Timeout   string `yaml:"timeout"`
+	Model     string `yaml:"default_model"`
+}
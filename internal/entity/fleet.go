@@ -0,0 +1,33 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package entity
+
+// FleetProfile is the agent registry in .context/fleet.yaml.
+//
+// Fields:
+//   - Agents: Agents that may claim or be assigned tasks
+type FleetProfile struct {
+	Agents []FleetAgent `yaml:"agents"`
+}
+
+// FleetAgent is one agent's capability profile.
+//
+// Fields:
+//   - ID: Agent identifier used in leases (--agent, CTX_AGENT)
+//   - Tool: Tool the agent runs in (e.g. claude-code, cline)
+//   - ContextWindow: Context window size in tokens (0 = unknown)
+//   - CostTier: Relative cost: low, medium, or high (empty =
+//     unknown)
+//   - Capabilities: Free-form strengths (e.g. architecture,
+//     quick-fix)
+type FleetAgent struct {
+	ID            string   `yaml:"id"`
+	Tool          string   `yaml:"tool,omitempty"`
+	ContextWindow int      `yaml:"context_window,omitempty"`
+	CostTier      string   `yaml:"cost_tier,omitempty"`
+	Capabilities  []string `yaml:"capabilities,omitempty"`
+}
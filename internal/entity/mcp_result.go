@@ -0,0 +1,67 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package entity
+
+// MCPNextTask is the structured result of the ctx_next MCP tool.
+//
+// Fields:
+//   - Found: True when a pending task exists
+//   - Index: 1-based position of the task among pending tasks
+//   - Task: Task text without the checkbox
+//   - Held: True when the calling agent ($CTX_AGENT) holds
+//     the task's fleet lease
+//   - Skipped: Pending tasks passed over because other agents
+//     hold them
+//   - Blocked: Pending tasks passed over because they wait on
+//     unfinished tasks or external blockers
+type MCPNextTask struct {
+	Found   bool   `json:"found"`
+	Index   int    `json:"index,omitempty"`
+	Task    string `json:"task,omitempty"`
+	Held    bool   `json:"held,omitempty"`
+	Skipped int    `json:"skipped,omitempty"`
+	Blocked int    `json:"blocked,omitempty"`
+}
+
+// MCPJournalSession summarizes one AI session for the
+// ctx_journal_source MCP tool.
+//
+// Fields:
+//   - ID: Session identifier
+//   - Start: Start time (RFC3339)
+//   - Project: Project name, when known
+//   - DurationSeconds: Session length in whole seconds
+//   - Turns: Number of conversation turns
+//   - FirstMessage: First user message, when recorded
+type MCPJournalSession struct {
+	ID              string `json:"id"`
+	Start           string `json:"start"`
+	Project         string `json:"project,omitempty"`
+	DurationSeconds int64  `json:"duration_seconds"`
+	Turns           int    `json:"turns"`
+	FirstMessage    string `json:"first_message,omitempty"`
+}
+
+// MCPJournalSessionList is the structured result of the
+// ctx_journal_source MCP tool.
+//
+// Fields:
+//   - Sessions: Matching sessions, newest first
+type MCPJournalSessionList struct {
+	Sessions []MCPJournalSession `json:"sessions"`
+}
+
+// MCPSearchResult is the structured result of the ctx_search
+// MCP tool.
+//
+// Fields:
+//   - Query: Query text as given
+//   - Hits: Ranked matches, best first
+type MCPSearchResult struct {
+	Query string      `json:"query"`
+	Hits  []SearchHit `json:"hits"`
+}
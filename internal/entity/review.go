@@ -0,0 +1,38 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package entity
+
+// ReviewCandidate is implemented by the candidates ctx mine and
+// ctx align hold in their review queues; Key is the ID accept and
+// reject select by.
+type ReviewCandidate interface {
+	Key() string
+}
+
+// ReviewLedger is implemented by the dedup ledger beside a review
+// queue, which remembers every disposed candidate so it is never
+// proposed again.
+type ReviewLedger interface {
+	// Init fills what a fresh or decoded ledger lacks: the current
+	// schema version and non-nil maps.
+	Init()
+	// Dispose records the disposition (accepted or rejected) of
+	// candidate id.
+	Dispose(id, disposition string)
+}
+
+// ReviewQueue names one command's review queue.
+//
+// Fields:
+//   - Name: the owning command, prefixed to error messages
+//   - Review: the review file name under .context/state/
+//   - Ledger: the ledger file name under .context/state/
+type ReviewQueue struct {
+	Name   string
+	Review string
+	Ledger string
+}
@@ -0,0 +1,33 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package entity
+
+// SearchHit is one ranked result from the context search
+// index.
+//
+// Fields:
+//   - Path: file path relative to the context directory,
+//     slash-separated
+//   - Line: 1-based line where the document starts
+//   - Type: document type (decision, learning, convention,
+//     task, journal, kb, context)
+//   - Key: entry timestamp for timestamped entries, empty
+//     otherwise
+//   - Title: heading text of the document
+//   - Date: document date (YYYY-MM-DD), empty when undated
+//   - Score: BM25 score; higher is more relevant
+//   - Snippet: first body line that mentions a query term
+type SearchHit struct {
+	Path    string  `json:"path"`
+	Line    int     `json:"line"`
+	Type    string  `json:"type"`
+	Key     string  `json:"key,omitempty"`
+	Title   string  `json:"title"`
+	Date    string  `json:"date,omitempty"`
+	Score   float64 `json:"score"`
+	Snippet string  `json:"snippet,omitempty"`
+}
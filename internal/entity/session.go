@@ -27,6 +27,8 @@ import "time"
 //   - CWD: Working directory when session started
 //   - Project: Project name (derived from last component of CWD)
 //   - GitBranch: Git branch name if available
+//   - GitRefs: Commits the tool made during the session (short
+//     hashes), if it records them
 //
 // Timing:
 //   - StartTime: When the session started
@@ -54,9 +56,10 @@ type Session struct {
 	Tool       string `json:"tool"`
 	SourceFile string `json:"source_file"`
 
-	CWD       string `json:"cwd,omitempty"`
-	Project   string `json:"project,omitempty"`
-	GitBranch string `json:"git_branch,omitempty"`
+	CWD       string   `json:"cwd,omitempty"`
+	Project   string   `json:"project,omitempty"`
+	GitBranch string   `json:"git_branch,omitempty"`
+	GitRefs   []string `json:"git_refs,omitempty"`
 
 	StartTime time.Time     `json:"start_time"`
 	EndTime   time.Time     `json:"end_time"`
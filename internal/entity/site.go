@@ -0,0 +1,77 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package entity
+
+// NavItem is one entry in the navigation of a rendered
+// static site.
+//
+// Fields:
+//   - Title: link text
+//   - Path: Markdown source path relative to the site
+//     root, slash-separated; empty for a section that only
+//     groups its children
+//   - Children: entries nested under this one
+type NavItem struct {
+	Title    string
+	Path     string
+	Children []NavItem
+}
+
+// RenderedPage is a Markdown page converted to HTML.
+//
+// Fields:
+//   - Title: frontmatter title, else the first level-1
+//     heading, else empty
+//   - HTML: the page body
+//   - Text: the page's plain text, whitespace-collapsed,
+//     for search indexing
+type RenderedPage struct {
+	Title string
+	HTML  string
+	Text  string
+}
+
+// SitePage is the render data for one page of a static
+// site.
+//
+// Fields:
+//   - SiteTitle: site name for the header and window title
+//   - Title: page title (may be empty)
+//   - Root: relative path from the page to the site root
+//     ("" at the root, "../" one level down)
+//   - Home: link target of the site title
+//   - Style: stylesheet link
+//   - Script: search script link
+//   - Index: search index link
+//   - Nav: sidebar navigation
+//   - Body: rendered page HTML
+type SitePage struct {
+	SiteTitle string
+	Title     string
+	Root      string
+	Home      string
+	Style     string
+	Script    string
+	Index     string
+	Nav       []SiteNavLink
+	Body      string
+}
+
+// SiteNavLink is one sidebar entry of a [SitePage].
+//
+// Fields:
+//   - Title: link text
+//   - Href: link target relative to the page; empty for a
+//     section heading without a page
+//   - Current: marks the page being rendered
+//   - Children: entries nested under this one
+type SiteNavLink struct {
+	Title    string
+	Href     string
+	Current  bool
+	Children []SiteNavLink
+}
@@ -0,0 +1,77 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package entity
+
+import "time"
+
+// SessionStats aggregates parsed sessions for ctx journal stats.
+//
+// Fields:
+//   - Totals: every session in one bucket (Key is empty)
+//   - ByDay: one bucket per local start date (YYYY-MM-DD), oldest
+//     first
+//   - ByWeek: one bucket per ISO week (YYYY-Www), oldest first
+//   - ByModel: one bucket per model, most tokens first
+//   - ByTool: one bucket per AI tool, most tokens first
+//   - ByBranch: one bucket per git branch, most tokens first
+//   - ToolCalls: call counts per tool name, most calls first
+type SessionStats struct {
+	Totals    StatsBucket     `json:"totals"`
+	ByDay     []StatsBucket   `json:"by_day"`
+	ByWeek    []StatsBucket   `json:"by_week"`
+	ByModel   []StatsBucket   `json:"by_model"`
+	ByTool    []StatsBucket   `json:"by_tool"`
+	ByBranch  []StatsBucket   `json:"by_branch"`
+	ToolCalls []ToolCallStats `json:"tool_calls"`
+}
+
+// StatsBucket is the aggregate of the sessions sharing one key.
+//
+// Fields:
+//   - Key: the day, week, model, tool, or branch; empty when the
+//     sessions did not record it
+//   - Sessions: number of sessions
+//   - Turns: user turns across the sessions
+//   - TokensIn: input tokens
+//   - TokensOut: output tokens
+//   - Tokens: input plus output tokens
+//   - Duration: summed session length
+//   - AvgDuration: mean session length
+//   - AvgTurns: mean turns per session
+//   - ToolCalls: tool invocations
+//   - ToolErrors: tool results marked as errors
+//   - FailureRate: ToolErrors / ToolCalls (0 without calls)
+//   - ErrorSessions: sessions with at least one error
+type StatsBucket struct {
+	Key           string        `json:"key,omitempty"`
+	Sessions      int           `json:"sessions"`
+	Turns         int           `json:"turns"`
+	TokensIn      int           `json:"tokens_in"`
+	TokensOut     int           `json:"tokens_out"`
+	Tokens        int           `json:"tokens"`
+	Duration      time.Duration `json:"duration"`
+	AvgDuration   time.Duration `json:"avg_duration"`
+	AvgTurns      float64       `json:"avg_turns"`
+	ToolCalls     int           `json:"tool_calls"`
+	ToolErrors    int           `json:"tool_errors"`
+	FailureRate   float64       `json:"failure_rate"`
+	ErrorSessions int           `json:"error_sessions"`
+}
+
+// ToolCallStats counts the invocations of one tool.
+//
+// Fields:
+//   - Name: tool name as recorded by the AI tool (e.g. "Bash")
+//   - Calls: invocations
+//   - Errors: results marked as errors
+//   - FailureRate: Errors / Calls
+type ToolCallStats struct {
+	Name        string  `json:"name"`
+	Calls       int     `json:"calls"`
+	Errors      int     `json:"errors"`
+	FailureRate float64 `json:"failure_rate"`
+}
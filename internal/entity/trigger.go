@@ -34,7 +34,9 @@ type TriggerInput struct {
 // Fields:
 //   - ID: The session identifier
 //   - Model: The active model name for the session
+//   - Tool: The AI tool running the session (claude, cursor, ...)
 type TriggerSession struct {
 	ID    string `json:"id"`
 	Model string `json:"model"`
+	Tool  string `json:"tool,omitempty"`
 }
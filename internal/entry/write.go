@@ -7,28 +7,25 @@
 package entry
 
 import (
-	"os"
 	"path/filepath"
 
-	"github.com/ActiveMemory/ctx/internal/cli/add/core/format"
 	coreAppend "github.com/ActiveMemory/ctx/internal/cli/add/core/insert"
 	"github.com/ActiveMemory/ctx/internal/config/entry"
-	"github.com/ActiveMemory/ctx/internal/config/fs"
 	"github.com/ActiveMemory/ctx/internal/entity"
 	errAdd "github.com/ActiveMemory/ctx/internal/err/add"
-	errFs "github.com/ActiveMemory/ctx/internal/err/fs"
 	"github.com/ActiveMemory/ctx/internal/i18n"
-	"github.com/ActiveMemory/ctx/internal/io"
 	"github.com/ActiveMemory/ctx/internal/rc"
+	"github.com/ActiveMemory/ctx/internal/txn"
 	"github.com/ActiveMemory/ctx/internal/write/theme"
 )
 
 // Write formats and writes an entry to the appropriate context file.
 //
-// Handles the complete write cycle: read existing content,
-// format the entry, append it, and write back. No index is
-// maintained in the file; a table of contents is projected on
-// demand by `ctx index <file>`.
+// Handles the complete write cycle: format the entry, then read
+// existing content, append it, and write back under the context
+// directory's write lock, so concurrent writers never drop each
+// other's entries. No index is maintained in the file; a table of
+// contents is projected on demand by `ctx index <file>`.
 //
 // Parameters:
 //   - params: Params containing type, content, and optional fields
@@ -54,72 +51,29 @@ func Write(params entity.EntryParams) error {
 	}
 	filePath := filepath.Join(contextDir, fileName)
 
-	if _, statErr := os.Stat(filePath); os.IsNotExist(statErr) {
-		return errAdd.FileNotFound(filePath)
-	}
-
-	existing, readErr := io.SafeReadUserFile(filepath.Clean(filePath))
-	if readErr != nil {
-		return errFs.FileRead(filePath, readErr)
-	}
-
 	// `--section Themes` declares a theme rather than adding an entry: the
 	// content is a "<name> — <gist>" spec, not a formatted entry body.
 	if theme.IsTarget(params.Section) {
-		newRoot, themeErr := theme.Apply(
-			contextDir, fileName, string(existing), params.Content,
-		)
-		if themeErr != nil {
-			return themeErr
-		}
-		if writeErr := io.SafeWriteFile(
-			filePath, newRoot, fs.PermFile,
-		); writeErr != nil {
-			return errFs.FileWrite(filePath, writeErr)
-		}
-		return nil
+		return txn.Do(contextDir, func() error {
+			return update(filePath, func(existing []byte) ([]byte, error) {
+				return theme.Apply(
+					contextDir, fileName, string(existing), params.Content,
+				)
+			})
+		})
 	}
 
-	var formatted string
-	switch fType {
-	case entry.Decision:
-		out, fErr := format.Decision(
-			params.Content, params.Context, params.Rationale, params.Consequence,
-		)
-		if fErr != nil {
-			return fErr
-		}
-		formatted = out
-	case entry.Task:
-		formatted = format.Task(
-			params.Content, params.Priority,
-			params.SessionID, params.Branch, params.Commit,
-		)
-	case entry.Learning:
-		out, fErr := format.Learning(
-			params.Content, params.Context, params.Lesson, params.Application,
-		)
-		if fErr != nil {
-			return fErr
-		}
-		formatted = out
-	case entry.Convention:
-		formatted = format.Convention(params.Content)
-	default:
-		return errAdd.UnknownType(fType)
-	}
-
-	newContent := coreAppend.AppendEntry(
-		existing, formatted, fType, params.Section,
-	)
-
-	if writeErr := io.SafeWriteFile(
-		filePath, newContent, fs.PermFile,
-	); writeErr != nil {
-		return errFs.FileWrite(filePath, writeErr)
+	formatted, fmtErr := render(params, fType)
+	if fmtErr != nil {
+		return fmtErr
 	}
-
-	return nil
+	return txn.Do(contextDir, func() error {
+		return update(filePath, func(existing []byte) ([]byte, error) {
+			return coreAppend.AppendEntry(
+				existing, formatted, fType, params.Section,
+			), nil
+		})
+	})
 }
 
 // ValidateAndWrite validates the entry params and writes the entry.
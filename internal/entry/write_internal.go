@@ -0,0 +1,84 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package entry
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/ActiveMemory/ctx/internal/cli/add/core/format"
+	"github.com/ActiveMemory/ctx/internal/config/entry"
+	"github.com/ActiveMemory/ctx/internal/config/fs"
+	"github.com/ActiveMemory/ctx/internal/entity"
+	errAdd "github.com/ActiveMemory/ctx/internal/err/add"
+	errFs "github.com/ActiveMemory/ctx/internal/err/fs"
+	"github.com/ActiveMemory/ctx/internal/io"
+)
+
+// render formats an entry body for its context file.
+//
+// Parameters:
+//   - params: the entry content and optional fields
+//   - fType: the folded entry type
+//
+// Returns:
+//   - string: the formatted entry
+//   - error: non-nil if the type is unknown or a required field
+//     is missing
+func render(params entity.EntryParams, fType string) (string, error) {
+	switch fType {
+	case entry.Decision:
+		return format.Decision(
+			params.Content, params.Context, params.Rationale, params.Consequence,
+		)
+	case entry.Task:
+		return format.Task(
+			params.Content, params.Priority,
+			params.SessionID, params.Branch, params.Commit,
+		), nil
+	case entry.Learning:
+		return format.Learning(
+			params.Content, params.Context, params.Lesson, params.Application,
+		)
+	case entry.Convention:
+		return format.Convention(params.Content), nil
+	default:
+		return "", errAdd.UnknownType(fType)
+	}
+}
+
+// update reads a context file, applies change, and commits the
+// result atomically. The caller holds the write lock.
+//
+// Parameters:
+//   - filePath: the context file; it must already exist
+//   - change: computes the new content from the current content
+//
+// Returns:
+//   - error: non-nil if the file is missing, unreadable, or
+//     unwritable, or change fails
+func update(
+	filePath string, change func(existing []byte) ([]byte, error),
+) error {
+	if _, statErr := os.Stat(filePath); os.IsNotExist(statErr) {
+		return errAdd.FileNotFound(filePath)
+	}
+	existing, readErr := io.SafeReadUserFile(filepath.Clean(filePath))
+	if readErr != nil {
+		return errFs.FileRead(filePath, readErr)
+	}
+	newContent, changeErr := change(existing)
+	if changeErr != nil {
+		return changeErr
+	}
+	if writeErr := io.SafeWriteFileAtomic(
+		filePath, newContent, fs.PermFile,
+	); writeErr != nil {
+		return errFs.FileWrite(filePath, writeErr)
+	}
+	return nil
+}
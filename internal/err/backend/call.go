@@ -0,0 +1,161 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package backend
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	"github.com/ActiveMemory/ctx/internal/config/embed/text"
+)
+
+// Unreachable wraps a transport failure reaching the backend.
+//
+// Parameters:
+//   - name: the backend
+//   - endpoint: the configured endpoint
+//   - cause: the transport error
+//
+// Returns:
+//   - error: "backend <name> unreachable at <endpoint>: <cause>; ..."
+func Unreachable(name, endpoint string, cause error) error {
+	return fmt.Errorf(
+		desc.Text(text.DescKeyErrBackendUnreachable),
+		name, endpoint, cause, name,
+	)
+}
+
+// Timeout returns an error when a request exceeds the backend's
+// timeout.
+//
+// Parameters:
+//   - name: the backend
+//   - d: the timeout that elapsed
+//
+// Returns:
+//   - error: "backend <name> timed out after <d>; tune ..."
+func Timeout(name string, d time.Duration) error {
+	return fmt.Errorf(desc.Text(text.DescKeyErrBackendTimeout), name, d, name)
+}
+
+// Auth relays an upstream 401 or 403.
+//
+// Parameters:
+//   - name: the backend
+//   - status: the HTTP status line
+//   - body: the upstream body, verbatim
+//   - hint: where the credential comes from
+//
+// Returns:
+//   - error: "backend <name> rejected the credential (<status>): ..."
+func Auth(name, status, body, hint string) error {
+	return fmt.Errorf(
+		desc.Text(text.DescKeyErrBackendAuth), name, status, body, hint,
+	)
+}
+
+// Model relays an upstream 404 on a completion, which means the
+// model selection was rejected.
+//
+// Parameters:
+//   - name: the backend
+//   - status: the HTTP status line
+//   - body: the upstream body, verbatim
+//
+// Returns:
+//   - error: "backend <name> rejected the model selection ..."
+func Model(name, status, body string) error {
+	return fmt.Errorf(desc.Text(text.DescKeyErrBackendModel), name, status, body)
+}
+
+// Upstream relays any other non-2xx response.
+//
+// Parameters:
+//   - name: the backend
+//   - status: the HTTP status line
+//   - body: the upstream body, verbatim
+//
+// Returns:
+//   - error: "backend <name> returned <status>: <body>"
+func Upstream(name, status, body string) error {
+	return fmt.Errorf(
+		desc.Text(text.DescKeyErrBackendUpstream), name, status, body,
+	)
+}
+
+// Request wraps a failure to build or encode a backend request.
+//
+// Parameters:
+//   - name: the backend
+//   - cause: the underlying error
+//
+// Returns:
+//   - error: "backend <name>: build request: <cause>"
+func Request(name string, cause error) error {
+	return fmt.Errorf(desc.Text(text.DescKeyErrBackendRequest), name, cause)
+}
+
+// Decode wraps a response body that is not the expected JSON.
+//
+// Parameters:
+//   - name: the backend
+//   - cause: the decode error
+//
+// Returns:
+//   - error: "backend <name>: decode response: <cause>"
+func Decode(name string, cause error) error {
+	return fmt.Errorf(desc.Text(text.DescKeyErrBackendDecode), name, cause)
+}
+
+// TooLarge reports a reply body longer than the read cap.
+//
+// Parameters:
+//   - name: the backend
+//   - limit: the cap in bytes
+//
+// Returns:
+//   - error: "backend <name>: response exceeds <limit> bytes"
+func TooLarge(name string, limit int) error {
+	return fmt.Errorf(desc.Text(text.DescKeyErrBackendTooLarge), name, limit)
+}
+
+// NoModel returns an error when no model is configured and the
+// backend lists none.
+//
+// Parameters:
+//   - name: the backend
+//
+// Returns:
+//   - error: "backend <name> lists no models; set ..."
+func NoModel(name string) error {
+	return fmt.Errorf(desc.Text(text.DescKeyErrBackendNoModel), name, name)
+}
+
+// EmptyReply returns an error when a completion has no choices.
+//
+// Parameters:
+//   - name: the backend
+//
+// Returns:
+//   - error: "backend <name> returned no choices"
+func EmptyReply(name string) error {
+	return fmt.Errorf(desc.Text(text.DescKeyErrBackendEmptyReply), name)
+}
+
+// Answer returns an error when a structured answer does not match
+// the requested schema.
+//
+// Parameters:
+//   - name: the backend
+//   - reason: what was wrong with the answer
+//
+// Returns:
+//   - error: "backend <name> answer does not match the schema: ..."
+func Answer(name, reason string) error {
+	return fmt.Errorf(desc.Text(text.DescKeyErrBackendAnswer), name, reason)
+}
@@ -0,0 +1,16 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package backend defines the typed error constructors for the AI
+// backend layer: backend selection and .ctxrc validation failures,
+// fail-closed transport and upstream errors (unreachable, timeout,
+// auth, model, other HTTP status), malformed answers, and proposal
+// and .ctxrc write failures.
+//
+// Upstream error bodies are relayed verbatim (capped), never
+// retried or masked. Messages are sourced from the YAML text
+// registry via [internal/assets/read/desc].
+package backend
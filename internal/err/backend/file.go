@@ -0,0 +1,50 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package backend
+
+import (
+	"fmt"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	"github.com/ActiveMemory/ctx/internal/config/embed/text"
+)
+
+// ParseConfig wraps a .ctxrc that cannot be parsed for editing.
+//
+// Parameters:
+//   - path: the .ctxrc path
+//   - cause: the YAML error
+//
+// Returns:
+//   - error: "parse <path>: <cause>"
+func ParseConfig(path string, cause error) error {
+	return fmt.Errorf(desc.Text(text.DescKeyErrBackendParseConfig), path, cause)
+}
+
+// WriteFile wraps a failure to write .ctxrc or a proposal file.
+//
+// Parameters:
+//   - path: the file being written
+//   - cause: the underlying error
+//
+// Returns:
+//   - error: "write <path>: <cause>"
+func WriteFile(path string, cause error) error {
+	return fmt.Errorf(desc.Text(text.DescKeyErrBackendWrite), path, cause)
+}
+
+// NotMapping returns an error when .ctxrc has a top level that is
+// not a YAML mapping, so no backends table can be added.
+//
+// Parameters:
+//   - path: the .ctxrc path
+//
+// Returns:
+//   - error: "parse <path>: top level must be a mapping"
+func NotMapping(path string) error {
+	return fmt.Errorf(desc.Text(text.DescKeyErrBackendNotMapping), path)
+}
@@ -0,0 +1,116 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package backend
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	"github.com/ActiveMemory/ctx/internal/config/embed/text"
+)
+
+// NotConfigured returns the fail-closed error for an AI command run
+// with no backends table in .ctxrc.
+//
+// Returns:
+//   - error: "no backend configured; run `ctx setup --backend <name>`"
+func NotConfigured() error {
+	return errors.New(desc.Text(text.DescKeyErrBackendNotConfigured))
+}
+
+// Ambiguous returns an error when several backends are configured
+// and neither --backend nor backends.default picks one.
+//
+// Returns:
+//   - error: "multiple backends configured; pass --backend ..."
+func Ambiguous() error {
+	return errors.New(desc.Text(text.DescKeyErrBackendAmbiguous))
+}
+
+// NotFound returns an error when the selected backend has no entry
+// in .ctxrc.
+//
+// Parameters:
+//   - name: the selected backend
+//
+// Returns:
+//   - error: "backend <name> is not configured in .ctxrc; ..."
+func NotFound(name string) error {
+	return fmt.Errorf(
+		desc.Text(text.DescKeyErrBackendNotFound), name, name,
+	)
+}
+
+// UnknownDefault returns an error when backends.default names a
+// backend with no entry.
+//
+// Parameters:
+//   - name: the configured default
+//
+// Returns:
+//   - error: "backends.default <name> names no configured backend"
+func UnknownDefault(name string) error {
+	return fmt.Errorf(desc.Text(text.DescKeyErrBackendUnknownDefault), name)
+}
+
+// UnknownType returns an error for a backend name that is not a
+// registered type.
+//
+// Parameters:
+//   - name: the offending name
+//   - supported: the registered types, comma-separated
+//
+// Returns:
+//   - error: "unknown backend <name> (supported: <supported>)"
+func UnknownType(name, supported string) error {
+	return fmt.Errorf(
+		desc.Text(text.DescKeyErrBackendUnknownType), name, supported,
+	)
+}
+
+// MissingKey returns an error when a required backend key is unset.
+//
+// Parameters:
+//   - name: the backend
+//   - key: the missing key
+//
+// Returns:
+//   - error: "backends.<name>.<key> is required"
+func MissingKey(name, key string) error {
+	return fmt.Errorf(desc.Text(text.DescKeyErrBackendMissingKey), name, key)
+}
+
+// InvalidEndpoint returns an error when an endpoint is not an
+// http:// or https:// URL.
+//
+// Parameters:
+//   - name: the backend
+//   - endpoint: the offending value
+//
+// Returns:
+//   - error: "backends.<name>.endpoint <endpoint> must be ..."
+func InvalidEndpoint(name, endpoint string) error {
+	return fmt.Errorf(
+		desc.Text(text.DescKeyErrBackendInvalidEndpoint), name, endpoint,
+	)
+}
+
+// InvalidTimeout wraps a timeout that does not parse as a positive
+// Go duration.
+//
+// Parameters:
+//   - name: the backend
+//   - value: the offending value
+//
+// Returns:
+//   - error: "backends.<name>.timeout <value> is not a positive ..."
+func InvalidTimeout(name, value string) error {
+	return fmt.Errorf(
+		desc.Text(text.DescKeyErrBackendInvalidTimeout), name, value,
+	)
+}
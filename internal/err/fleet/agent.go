@@ -0,0 +1,95 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package fleet
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	"github.com/ActiveMemory/ctx/internal/config/embed/text"
+)
+
+// NoAgent returns an error when a lease command cannot tell
+// which agent it acts for.
+//
+// Returns:
+//   - error: "no agent ID; pass --agent or set CTX_AGENT"
+func NoAgent() error {
+	return errors.New(desc.Text(text.DescKeyErrFleetNoAgent))
+}
+
+// UnknownAgent returns an error when fleet.yaml lists agents
+// but not the one named.
+//
+// Parameters:
+//   - id: the agent ID given
+//   - path: fleet.yaml path
+//
+// Returns:
+//   - error: "agent <id> is not listed in <path>"
+func UnknownAgent(id, path string) error {
+	return fmt.Errorf(
+		desc.Text(text.DescKeyErrFleetUnknownAgent), id, path,
+	)
+}
+
+// ParseProfile wraps a fleet.yaml read or decode failure.
+//
+// Parameters:
+//   - path: fleet.yaml path
+//   - cause: the underlying error
+//
+// Returns:
+//   - error: "parse <path>: <cause>"
+func ParseProfile(path string, cause error) error {
+	return fmt.Errorf(
+		desc.Text(text.DescKeyErrFleetParseProfile), path, cause,
+	)
+}
+
+// AgentID returns an error for a profile without an id.
+//
+// Parameters:
+//   - path: fleet.yaml path
+//   - n: 1-based position of the profile
+//
+// Returns:
+//   - error: "<path>: agent #<n> has no id"
+func AgentID(path string, n int) error {
+	return fmt.Errorf(desc.Text(text.DescKeyErrFleetAgentID), path, n)
+}
+
+// DuplicateAgent returns an error for an agent ID listed
+// twice.
+//
+// Parameters:
+//   - path: fleet.yaml path
+//   - id: the repeated ID
+//
+// Returns:
+//   - error: "<path>: agent <id> is listed twice"
+func DuplicateAgent(path, id string) error {
+	return fmt.Errorf(
+		desc.Text(text.DescKeyErrFleetDuplicateAgent), path, id,
+	)
+}
+
+// CostTier returns an error for an unknown cost tier.
+//
+// Parameters:
+//   - path: fleet.yaml path
+//   - id: the agent ID
+//   - tier: the configured tier
+//
+// Returns:
+//   - error: "<path>: agent <id> has cost_tier <tier>; ..."
+func CostTier(path, id, tier string) error {
+	return fmt.Errorf(
+		desc.Text(text.DescKeyErrFleetCostTier), path, id, tier,
+	)
+}
@@ -0,0 +1,16 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package fleet defines the typed error constructors for ctx
+// fleet: agent identity and fleet.yaml profile problems, task
+// lookup failures, out-of-range leases, and a missing hub
+// connection.
+//
+// Lease conflicts (a task held by another agent) are reported
+// by the hub as gRPC status errors and relayed verbatim.
+// Messages are sourced from the YAML text registry via
+// [internal/assets/read/desc].
+package fleet
@@ -0,0 +1,64 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package fleet
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	"github.com/ActiveMemory/ctx/internal/config/embed/text"
+)
+
+// NotConnected returns an error when a lease command runs in a
+// project with no hub connection.
+//
+// Returns:
+//   - error: "not connected to a hub; run `ctx connection
+//     register` first"
+func NotConnected() error {
+	return errors.New(desc.Text(text.DescKeyErrFleetNotConnected))
+}
+
+// TaskNotFound returns an error when a task query matches no
+// pending task.
+//
+// Parameters:
+//   - query: the task number, key, or text given
+//
+// Returns:
+//   - error: "no pending task matches <query>"
+func TaskNotFound(query string) error {
+	return fmt.Errorf(desc.Text(text.DescKeyErrFleetTaskNotFound), query)
+}
+
+// TaskAmbiguous returns an error when a text query matches
+// several pending tasks.
+//
+// Parameters:
+//   - query: the task text given
+//
+// Returns:
+//   - error: "<query> matches more than one pending task; ..."
+func TaskAmbiguous(query string) error {
+	return fmt.Errorf(
+		desc.Text(text.DescKeyErrFleetTaskAmbiguous), query,
+	)
+}
+
+// InvalidLease returns an error for a --lease outside the
+// range the hub accepts.
+//
+// Parameters:
+//   - d: the requested lease
+//
+// Returns:
+//   - error: "invalid --lease <d>: use a duration from 1s to 24h"
+func InvalidLease(d time.Duration) error {
+	return fmt.Errorf(desc.Text(text.DescKeyErrFleetInvalidLease), d)
+}
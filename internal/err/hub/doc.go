@@ -11,7 +11,7 @@
 //
 // # Domain
 //
-// Errors fall into three categories:
+// Errors fall into four categories:
 //
 //   - **Token generation**: the hub failed to
 //     generate a cryptographic token for peer
@@ -23,12 +23,21 @@
 //     registered with the hub, or a peer action
 //     is unrecognized. Constructors:
 //     [DuplicateProject], [InvalidPeerAction].
+//   - **Transport security**: certificate material
+//     is missing, incomplete, or unparsable, or the
+//     hub's certificate no longer matches the
+//     fingerprint pinned at registration.
+//     Constructors: [TLSKeyPair],
+//     [TLSKeyPairIncomplete], [TLSServerCertRequired],
+//     [TLSCABundle], [TLSNoPeerCert],
+//     [FingerprintMismatch].
 //
 // # Wrapping Strategy
 //
 // [GenerateToken] and [InternalErr] wrap their
 // cause with fmt.Errorf %w so callers can inspect
-// the underlying crypto/rand or server error.
+// the underlying crypto/rand or server error;
+// [TLSKeyPair] does the same for crypto/tls.
 // [DuplicateProject] and [InvalidPeerAction]
 // return plain formatted errors. All user-facing
 // text is resolved through
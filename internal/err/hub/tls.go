@@ -0,0 +1,94 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package hub
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	"github.com/ActiveMemory/ctx/internal/config/embed/text"
+)
+
+// TLSKeyPair wraps a failure to load a certificate and
+// private key pair.
+//
+// Parameters:
+//   - cause: the underlying crypto/tls error
+//
+// Returns:
+//   - error: "load TLS key pair: <cause>"
+func TLSKeyPair(cause error) error {
+	return fmt.Errorf(
+		desc.Text(text.DescKeyErrHubTLSKeyPair), cause,
+	)
+}
+
+// TLSKeyPairIncomplete returns an error when only one half
+// of a certificate/key pair was supplied.
+//
+// Returns:
+//   - error: guidance that cert and key go together
+func TLSKeyPairIncomplete() error {
+	return errors.New(
+		desc.Text(text.DescKeyErrHubTLSIncomplete),
+	)
+}
+
+// TLSServerCertRequired returns an error when server-side
+// TLS was requested (a CA bundle was given) without the
+// server's own certificate and key.
+//
+// Returns:
+//   - error: guidance to pass --tls-cert and --tls-key
+func TLSServerCertRequired() error {
+	return errors.New(
+		desc.Text(text.DescKeyErrHubTLSServerCert),
+	)
+}
+
+// TLSCABundle returns an error when a CA bundle file holds
+// no usable PEM certificates.
+//
+// Parameters:
+//   - path: the CA bundle path
+//
+// Returns:
+//   - error: "no certificates found in CA bundle <path>"
+func TLSCABundle(path string) error {
+	return fmt.Errorf(
+		desc.Text(text.DescKeyErrHubTLSCABundle), path,
+	)
+}
+
+// TLSNoPeerCert returns an error when the hub completed a
+// TLS handshake without presenting a certificate.
+//
+// Returns:
+//   - error: "hub presented no certificate"
+func TLSNoPeerCert() error {
+	return errors.New(
+		desc.Text(text.DescKeyErrHubTLSNoPeerCert),
+	)
+}
+
+// FingerprintMismatch returns an error when the hub's leaf
+// certificate does not match the fingerprint pinned at
+// registration.
+//
+// Parameters:
+//   - got: fingerprint presented by the hub
+//   - pinned: fingerprint stored in the connection state
+//
+// Returns:
+//   - error: mismatch with re-registration guidance
+func FingerprintMismatch(got, pinned string) error {
+	return fmt.Errorf(
+		desc.Text(text.DescKeyErrHubFingerprintMismatch),
+		got, pinned,
+	)
+}
@@ -26,7 +26,8 @@
 //
 // Constructors: [LoadState], [SaveState],
 // [LoadStateErr], [LoadStateFailed],
-// [SaveStateFailed], [NoDir].
+// [SaveStateFailed], [NoDir], [RedactRule],
+// [SecretsFound].
 //
 // # Concurrency
 //
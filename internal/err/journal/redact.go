@@ -0,0 +1,44 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package journal
+
+import (
+	"fmt"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	"github.com/ActiveMemory/ctx/internal/config/embed/text"
+)
+
+// RedactRule wraps a project redaction rule whose pattern does
+// not compile.
+//
+// Parameters:
+//   - name: rule name from .ctxrc
+//   - cause: the compile error
+//
+// Returns:
+//   - error: "redact rule <name>: <cause>"
+func RedactRule(name string, cause error) error {
+	return fmt.Errorf(
+		desc.Text(text.DescKeyErrJournalRedactRule), name, cause,
+	)
+}
+
+// SecretsFound reports that `ctx journal redact --check` found
+// unredacted secrets.
+//
+// Parameters:
+//   - count: number of secrets found
+//   - files: number of entries containing them
+//
+// Returns:
+//   - error: "<count> secret(s) found in <files> journal entries"
+func SecretsFound(count, files int) error {
+	return fmt.Errorf(
+		desc.Text(text.DescKeyErrJournalRedactFound), count, files,
+	)
+}
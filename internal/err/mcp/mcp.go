@@ -81,3 +81,17 @@ func InputTooLong(field string, maxLen int) error {
 		field, maxLen,
 	)
 }
+
+// GenerateToken wraps a failure to generate an MCP bearer
+// token or session ID.
+//
+// Parameters:
+//   - cause: the underlying error from crypto/rand
+//
+// Returns:
+//   - error: "generate token: <cause>"
+func GenerateToken(cause error) error {
+	return fmt.Errorf(
+		desc.Text(text.DescKeyMCPErrGenerateToken), cause,
+	)
+}
@@ -0,0 +1,15 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package mine defines the typed error constructors returned by
+// [internal/mine]: git log failures. Review-queue failures come
+// from [internal/err/queue].
+//
+// Messages are sourced from the YAML text registry via
+// [internal/assets/read/desc], keyed by DescKey constants in
+// [internal/config/embed/text]. Constructors that take a cause wrap
+// it via %w.
+package mine
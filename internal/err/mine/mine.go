@@ -0,0 +1,25 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package mine
+
+import (
+	"fmt"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	"github.com/ActiveMemory/ctx/internal/config/embed/text"
+)
+
+// Log wraps a failure to read git history.
+//
+// Parameters:
+//   - cause: the underlying git error
+//
+// Returns:
+//   - error: "mine: git log: <cause>"
+func Log(cause error) error {
+	return fmt.Errorf(desc.Text(text.DescKeyErrMineLog), cause)
+}
@@ -0,0 +1,17 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package queue defines the typed error constructors returned by
+// [internal/queue]: ledger and review-file persistence failures,
+// and unknown or missing candidate selections. Every constructor
+// takes the owning command's name (mine, align) so messages stay
+// specific to it.
+//
+// Messages are sourced from the YAML text registry via
+// [internal/assets/read/desc], keyed by DescKey constants in
+// [internal/config/embed/text]. Constructors that take a cause wrap
+// it via %w.
+package queue
@@ -0,0 +1,101 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package queue
+
+import (
+	"fmt"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	"github.com/ActiveMemory/ctx/internal/config/embed/text"
+)
+
+// Read wraps a failure to read a queue state file.
+//
+// Parameters:
+//   - name: the owning command (mine, align)
+//   - path: the ledger or review file
+//   - cause: the underlying read error
+//
+// Returns:
+//   - error: "<name>: read <path>: <cause>"
+func Read(name, path string, cause error) error {
+	return fmt.Errorf(
+		desc.Text(text.DescKeyErrQueueRead), name, path, cause,
+	)
+}
+
+// Decode wraps a failure to decode a queue state file.
+//
+// Parameters:
+//   - name: the owning command (mine, align)
+//   - path: the ledger or review file
+//   - cause: the underlying JSON error
+//
+// Returns:
+//   - error: "<name>: decode <path>: <cause>"
+func Decode(name, path string, cause error) error {
+	return fmt.Errorf(
+		desc.Text(text.DescKeyErrQueueDecode), name, path, cause,
+	)
+}
+
+// Encode wraps a failure to encode a queue state file.
+//
+// Parameters:
+//   - name: the owning command (mine, align)
+//   - path: the ledger or review file
+//   - cause: the underlying JSON error
+//
+// Returns:
+//   - error: "<name>: encode <path>: <cause>"
+func Encode(name, path string, cause error) error {
+	return fmt.Errorf(
+		desc.Text(text.DescKeyErrQueueEncode), name, path, cause,
+	)
+}
+
+// Write wraps a failure to write a queue state file or create its
+// directory.
+//
+// Parameters:
+//   - name: the owning command (mine, align)
+//   - path: the file or directory being written
+//   - cause: the underlying write error
+//
+// Returns:
+//   - error: "<name>: write <path>: <cause>"
+func Write(name, path string, cause error) error {
+	return fmt.Errorf(
+		desc.Text(text.DescKeyErrQueueWrite), name, path, cause,
+	)
+}
+
+// NotFound returns an error when a candidate ID is not pending.
+//
+// Parameters:
+//   - name: the owning command (mine, align)
+//   - id: the requested candidate ID
+//
+// Returns:
+//   - error: "<name>: no pending candidate <id>"
+func NotFound(name, id string) error {
+	return fmt.Errorf(
+		desc.Text(text.DescKeyErrQueueNotFound), name, id, name,
+	)
+}
+
+// NoSelection returns an error when accept or reject names no
+// candidates and --all is not set.
+//
+// Parameters:
+//   - name: the owning command (mine, align)
+//
+// Returns:
+//   - error: "<name>: name candidate ids or pass --all"
+func NoSelection(name string) error {
+	return fmt.Errorf(desc.Text(text.DescKeyErrQueueNoSelection), name)
+}
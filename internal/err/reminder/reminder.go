@@ -60,3 +60,31 @@ func NotFound(id int) error {
 func IDRequired() error {
 	return errors.New(desc.Text(text.DescKeyErrReminderIDRequired))
 }
+
+// InvalidRule returns an error for an --every value that is
+// neither a shorthand nor a supported RRULE.
+//
+// Parameters:
+//   - spec: the rejected recurrence.
+//
+// Returns:
+//   - error: "invalid recurrence <spec>: use daily, weekly, ..."
+func InvalidRule(spec string) error {
+	return fmt.Errorf(
+		desc.Text(text.DescKeyErrReminderInvalidRule), spec,
+	)
+}
+
+// InvalidSnooze returns an error for a snooze length that is
+// not a duration, a day or week count, or a date.
+//
+// Parameters:
+//   - spec: the rejected snooze length.
+//
+// Returns:
+//   - error: "invalid snooze <spec>: use a duration such as ..."
+func InvalidSnooze(spec string) error {
+	return fmt.Errorf(
+		desc.Text(text.DescKeyErrReminderInvalidSnooze), spec,
+	)
+}
@@ -0,0 +1,29 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package search defines the typed error constructors
+// for the context search index behind `ctx search`,
+// `ctx_search`, and `ctx agent` relevance scoring.
+//
+// # Domain
+//
+//   - **Query validation**: the query has no word to
+//     rank by. Constructor: [NoTerms].
+//   - **Index IO**: reading or writing the index file
+//     failed. Constructors: [ReadIndex], [WriteIndex].
+//
+// # Wrapping Strategy
+//
+// IO constructors wrap their cause with fmt.Errorf %w
+// so callers can inspect the underlying error.
+// [NoTerms] returns a plain errors.New value. All
+// user-facing text is resolved through
+// [internal/assets/read/desc].
+//
+// # Concurrency
+//
+// Pure constructors. Concurrent callers never race.
+package search
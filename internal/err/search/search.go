@@ -0,0 +1,54 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package search
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	"github.com/ActiveMemory/ctx/internal/config/embed/text"
+)
+
+// NoTerms reports a query without any word to rank by,
+// such as one made only of field filters or stop words.
+//
+// Returns:
+//   - error: "search query needs at least one word"
+func NoTerms() error {
+	return errors.New(desc.Text(text.DescKeyErrSearchNoTerms))
+}
+
+// ReadIndex wraps a failure to read or decode the index
+// file.
+//
+// Parameters:
+//   - path: index file path
+//   - cause: the underlying error
+//
+// Returns:
+//   - error: "read search index <path>: <cause>"
+func ReadIndex(path string, cause error) error {
+	return fmt.Errorf(
+		desc.Text(text.DescKeyErrSearchReadIndex), path, cause,
+	)
+}
+
+// WriteIndex wraps a failure to encode or write the index
+// file.
+//
+// Parameters:
+//   - path: index file path
+//   - cause: the underlying error
+//
+// Returns:
+//   - error: "write search index <path>: <cause>"
+func WriteIndex(path string, cause error) error {
+	return fmt.Errorf(
+		desc.Text(text.DescKeyErrSearchWriteIndex), path, cause,
+	)
+}
@@ -45,3 +45,41 @@ func MarshalFeed(cause error) error {
 func ZensicalNotFound() error {
 	return errors.New(desc.Text(text.DescKeyErrSiteZensicalNotFound))
 }
+
+// NotFound returns an error when a directory holds neither a
+// rendered site nor a Markdown tree to render.
+//
+// Parameters:
+//   - dir: the directory that was searched
+//
+// Returns:
+//   - error: "no site found in <dir>: ..."
+func NotFound(dir string) error {
+	return fmt.Errorf(desc.Text(text.DescKeyErrSiteNotFound), dir)
+}
+
+// RenderPage wraps a failure to lay out a rendered page.
+//
+// Parameters:
+//   - path: the Markdown source of the page
+//   - cause: the underlying template error
+//
+// Returns:
+//   - error: "cannot render <path>: <cause>"
+func RenderPage(path string, cause error) error {
+	return fmt.Errorf(
+		desc.Text(text.DescKeyErrSiteRenderPage), path, cause,
+	)
+}
+
+// Serve wraps a failure to start or run the site server.
+//
+// Parameters:
+//   - addr: the listen address
+//   - cause: the underlying network error
+//
+// Returns:
+//   - error: "cannot serve on <addr>: <cause>"
+func Serve(addr string, cause error) error {
+	return fmt.Errorf(desc.Text(text.DescKeyErrSiteServe), addr, cause)
+}
@@ -202,3 +202,87 @@ func LoadQuoted(name string, cause error) error {
 		desc.Text(text.DescKeyErrSkillSkillLoad), name, cause,
 	)
 }
+
+// LockRead wraps a failure to read skills.lock.
+//
+// Parameters:
+//   - path: the lockfile path
+//   - cause: the underlying error
+//
+// Returns:
+//   - error: "skill: read <path>: <cause>"
+func LockRead(path string, cause error) error {
+	return fmt.Errorf(
+		desc.Text(text.DescKeyErrSkillLockRead), path, cause,
+	)
+}
+
+// LockParse wraps a failure to parse skills.lock.
+//
+// Parameters:
+//   - path: the lockfile path
+//   - cause: the underlying error
+//
+// Returns:
+//   - error: "skill: parse <path>: <cause>"
+func LockParse(path string, cause error) error {
+	return fmt.Errorf(
+		desc.Text(text.DescKeyErrSkillLockParse), path, cause,
+	)
+}
+
+// LockWrite wraps a failure to write skills.lock.
+//
+// Parameters:
+//   - path: the lockfile path
+//   - cause: the underlying error
+//
+// Returns:
+//   - error: "skill: write <path>: <cause>"
+func LockWrite(path string, cause error) error {
+	return fmt.Errorf(
+		desc.Text(text.DescKeyErrSkillLockWrite), path, cause,
+	)
+}
+
+// Digest wraps a failure to hash a skill directory.
+//
+// Parameters:
+//   - dir: the directory being hashed
+//   - cause: the underlying error
+//
+// Returns:
+//   - error: "skill: hash <dir>: <cause>"
+func Digest(dir string, cause error) error {
+	return fmt.Errorf(
+		desc.Text(text.DescKeyErrSkillDigest), dir, cause,
+	)
+}
+
+// NotLocked returns an error when a skill has no skills.lock
+// entry.
+//
+// Parameters:
+//   - name: the skill name
+//
+// Returns:
+//   - error: "skill <name> is not in skills.lock"
+func NotLocked(name string) error {
+	return fmt.Errorf(
+		desc.Text(text.DescKeyErrSkillNotLocked), name,
+	)
+}
+
+// Mismatch returns an error when ctx skill verify finds skills
+// that do not match skills.lock.
+//
+// Parameters:
+//   - count: number of mismatched skills
+//
+// Returns:
+//   - error: "skill: <count> skill(s) do not match skills.lock"
+func Mismatch(count int) error {
+	return fmt.Errorf(
+		desc.Text(text.DescKeyErrSkillMismatch), count,
+	)
+}
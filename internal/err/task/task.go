@@ -110,3 +110,17 @@ func SnapshotWrite(cause error) error {
 		desc.Text(text.DescKeyErrTaskSnapshotWrite), cause,
 	)
 }
+
+// GraphFormat returns an error for an unknown ctx task graph
+// output format.
+//
+// Parameters:
+//   - format: the rejected --format value.
+//
+// Returns:
+//   - error: "unknown graph format <format>: use dot or mermaid"
+func GraphFormat(format string) error {
+	return fmt.Errorf(
+		desc.Text(text.DescKeyErrTaskGraphFormat), format,
+	)
+}
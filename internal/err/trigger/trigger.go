@@ -109,6 +109,52 @@ func InvalidType(hookType, valid string) error {
 	)
 }
 
+// Manifest wraps a failure to read or parse hooks.yaml.
+//
+// Parameters:
+//   - path: the manifest path
+//   - cause: the underlying read or YAML error
+//
+// Returns:
+//   - error: "hook manifest <path>: <cause>"
+func Manifest(path string, cause error) error {
+	return fmt.Errorf(
+		desc.Text(text.DescKeyErrHookManifest), path, cause,
+	)
+}
+
+// ManifestTimeout returns an error for a hooks.yaml entry whose
+// timeout is negative or above the cap.
+//
+// Parameters:
+//   - key: the hooks.yaml key
+//   - limit: the largest allowed timeout, in seconds
+//
+// Returns:
+//   - error: "hook manifest: <key> timeout must be between 0 and
+//     <limit> seconds"
+func ManifestTimeout(key string, limit int) error {
+	return fmt.Errorf(
+		desc.Text(text.DescKeyErrHookManifestTimeout), key, limit,
+	)
+}
+
+// ManifestKey returns an error for a hooks.yaml key that does not
+// name a trigger type and a script.
+//
+// Parameters:
+//   - key: the hooks.yaml key
+//   - valid: comma-separated list of valid types
+//
+// Returns:
+//   - error: "hook manifest: <key> is not <type>/<name>; valid
+//     types: <valid>"
+func ManifestKey(key, valid string) error {
+	return fmt.Errorf(
+		desc.Text(text.DescKeyErrHookManifestKey), key, valid,
+	)
+}
+
 // MarshalInput wraps a hook input marshal failure.
 //
 // Parameters:
@@ -0,0 +1,12 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package txn provides error constructors for the .context
+// write-transaction layer: a lock file that cannot be created or
+// recorded, and a lock still held by a live writer after the
+// wait. The timeout names the holder so a user can tell a busy
+// agent from an abandoned lock.
+package txn
@@ -0,0 +1,43 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package txn
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	"github.com/ActiveMemory/ctx/internal/config/embed/text"
+)
+
+// Lock wraps a failure to create or record the lock file.
+//
+// Parameters:
+//   - path: the lock file
+//   - cause: the underlying filesystem error
+//
+// Returns:
+//   - error: "lock <path>: <cause>"
+func Lock(path string, cause error) error {
+	return fmt.Errorf(desc.Text(text.DescKeyErrTxnLock), path, cause)
+}
+
+// Timeout reports a lock still held by a live writer after the
+// wait.
+//
+// Parameters:
+//   - path: the lock file
+//   - holder: the recorded holder (PID, host, acquisition time)
+//   - waited: how long the caller waited
+//
+// Returns:
+//   - error: names the lock, its holder, and the wait
+func Timeout(path, holder string, waited time.Duration) error {
+	return fmt.Errorf(
+		desc.Text(text.DescKeyErrTxnTimeout), path, holder, waited,
+	)
+}
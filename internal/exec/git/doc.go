@@ -42,9 +42,12 @@
 // # HEAD Queries
 //
 // ShortHead returns the abbreviated commit hash for
-// HEAD. CurrentBranch returns the current branch
+// HEAD. Head returns the full HEAD hash of the
+// repository containing a given directory (empty on
+// error). CurrentBranch returns the current branch
 // name (empty if detached or on error).
 //
 //	hash := git.ShortHead()
+//	commit := git.Head("/path/to/checkout")
 //	branch := git.CurrentBranch()
 package git
@@ -13,6 +13,7 @@ import (
 	"time"
 
 	cfgGit "github.com/ActiveMemory/ctx/internal/config/git"
+	"github.com/ActiveMemory/ctx/internal/config/token"
 	errGit "github.com/ActiveMemory/ctx/internal/err/git"
 )
 
@@ -83,6 +84,27 @@ func Root() (string, error) {
 	return strings.TrimSpace(string(out)), nil
 }
 
+// Prefix returns the path of dir inside the repository that
+// contains it, slash-separated and empty at the repository root.
+//
+// Parameters:
+//   - dir: directory path to query
+//
+// Returns:
+//   - string: dir relative to the repository root
+//   - error: non-nil if git is not found or dir is not in a repo
+func Prefix(dir string) (string, error) {
+	out, runErr := Run(
+		cfgGit.FlagChangeDir, dir, cfgGit.RevParse, cfgGit.FlagShowPrefix,
+	)
+	if runErr != nil {
+		return "", errGit.NotInRepo(runErr)
+	}
+	return strings.TrimSuffix(
+		strings.TrimSpace(string(out)), token.Slash,
+	), nil
+}
+
 // RemoteURL returns the origin remote URL for a directory.
 // Returns an empty string on any error (best-effort).
 //
@@ -105,6 +127,25 @@ func RemoteURL(dir string) string {
 	return strings.TrimSpace(string(out))
 }
 
+// Head returns the full commit hash checked out in the repository
+// containing dir. Returns an empty string on any error
+// (best-effort).
+//
+// Parameters:
+//   - dir: directory path to query
+//
+// Returns:
+//   - string: commit hash, or empty string on any error
+func Head(dir string) string {
+	out, runErr := Run(
+		cfgGit.FlagChangeDir, dir, cfgGit.RevParse, cfgGit.RefHead,
+	)
+	if runErr != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(out))
+}
+
 // LogSince runs git log with a --since filter derived from t.
 //
 // Parameters:
@@ -35,8 +35,8 @@
 //     register string flags with non-empty defaults.
 //   - [StringFlagShort] registers a no-pointer string
 //     flag with shorthand.
-//   - [StringArrayFlagP] registers repeatable string
-//     flags (--tag x --tag y).
+//   - [StringArrayFlag], [StringArrayFlagP] register
+//     repeatable string flags (--tag x --tag y).
 //   - [PersistentBoolFlag] registers a persistent bool
 //     flag inherited by children.
 //   - [LastJSON] registers the --last/--json pair for
@@ -179,6 +179,20 @@ func StringArrayFlagP(
 	c.Flags().StringArrayVarP(p, name, short, nil, desc.Flag(descKey))
 }
 
+// StringArrayFlag registers a repeatable string flag with no
+// shorthand: --type x --type y.
+//
+// Parameters:
+//   - c: Cobra command to register on
+//   - p: Pointer to the string slice variable
+//   - name: Flag name constant
+//   - descKey: YAML DescKey for the flag description
+func StringArrayFlag(
+	c *cobra.Command, p *[]string, name, descKey string,
+) {
+	c.Flags().StringArrayVar(p, name, nil, desc.Flag(descKey))
+}
+
 // BoolFlagNoPtr registers a boolean flag with no
 // shorthand and no pointer, defaulting to false.
 // Use when the value is retrieved via
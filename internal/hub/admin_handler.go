@@ -0,0 +1,234 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package hub
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	cfgHTTP "github.com/ActiveMemory/ctx/internal/config/http"
+	cfgHub "github.com/ActiveMemory/ctx/internal/config/hub"
+	"github.com/ActiveMemory/ctx/internal/i18n"
+)
+
+// adminMux builds the admin gateway's routes.
+//
+// Returns:
+//   - *http.ServeMux: router with the JSON and metrics
+//     routes registered
+func (s *Server) adminMux() *http.ServeMux {
+	mux := http.NewServeMux()
+	mux.HandleFunc(
+		cfgHub.RouteAdminStatus, s.requireAdmin(s.adminStatus),
+	)
+	mux.HandleFunc(
+		cfgHub.RouteAdminClients, s.requireAdmin(s.adminClients),
+	)
+	mux.HandleFunc(
+		cfgHub.RouteAdminEntries, s.requireAdmin(s.adminEntries),
+	)
+	mux.HandleFunc(
+		cfgHub.RouteAdminLeader, s.requireAdmin(s.adminLeader),
+	)
+	mux.HandleFunc(cfgHub.RouteAdminMetrics, s.adminMetrics)
+	return mux
+}
+
+// requireAdmin wraps a handler with admin bearer token
+// auth, compared in constant time.
+//
+// Parameters:
+//   - next: handler to run once authenticated
+//
+// Returns:
+//   - http.HandlerFunc: handler answering 401 on a
+//     missing or wrong token
+func (s *Server) requireAdmin(
+	next http.HandlerFunc,
+) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		token := strings.TrimPrefix(
+			r.Header.Get(cfgHub.HeaderAdminAuthorization),
+			cfgHub.BearerPrefix,
+		)
+		if token == "" || subtle.ConstantTimeCompare(
+			[]byte(token), []byte(s.adminToken),
+		) != 1 {
+			http.Error(
+				w, cfgHub.ErrAdminUnauthorized,
+				http.StatusUnauthorized,
+			)
+			return
+		}
+		next(w, r)
+	}
+}
+
+// adminStatus serves the status document.
+//
+// Parameters:
+//   - w: response writer
+//   - r: request (unused)
+func (s *Server) adminStatus(w http.ResponseWriter, _ *http.Request) {
+	total, byType, byProject := s.store.Stats()
+	_, seq := s.store.lastSequence()
+	role, leader := s.role()
+	writeJSON(w, AdminStatus{
+		Role:             role,
+		Leader:           leader,
+		Sequence:         seq,
+		SnapshotSequence: s.store.compacted(),
+		TotalEntries:     total,
+		Published:        s.published.Load(),
+		Listeners:        s.listeners.count(),
+		DroppedListeners: s.listeners.droppedCount(),
+		EntriesByType:    byType,
+		EntriesByProject: byProject,
+	})
+}
+
+// adminClients serves the client registry without tokens.
+//
+// Parameters:
+//   - w: response writer
+//   - r: request (unused)
+func (s *Server) adminClients(
+	w http.ResponseWriter, _ *http.Request,
+) {
+	clients := s.store.Clients()
+	out := make([]AdminClient, len(clients))
+	for i := range clients {
+		out[i] = AdminClient{
+			ID:          clients[i].ID,
+			ProjectName: clients[i].ProjectName,
+			Policy:      clients[i].Policy,
+		}
+	}
+	writeJSON(w, out)
+}
+
+// adminEntries serves a corrections-aware entry search.
+//
+// Parameters:
+//   - w: response writer
+//   - r: request with search query parameters
+func (s *Server) adminEntries(
+	w http.ResponseWriter, r *http.Request,
+) {
+	q := r.URL.Query()
+	since, sinceOK := queryUint(q, cfgHub.QuerySince, 0)
+	if !sinceOK {
+		badQuery(w, q, cfgHub.QuerySince)
+		return
+	}
+	limit, limitOK := queryUint(
+		q, cfgHub.QueryLimit, cfgHub.AdminSearchLimit,
+	)
+	if !limitOK || limit == 0 || limit > cfgHub.AdminSearchMax {
+		badQuery(w, q, cfgHub.QueryLimit)
+		return
+	}
+
+	origins := make(map[string]bool)
+	for _, o := range q[cfgHub.QueryOrigin] {
+		origins[o] = true
+	}
+	text := i18n.Fold(q.Get(cfgHub.QueryText))
+
+	out := make([]Entry, 0)
+	for _, e := range s.store.Query(q[cfgHub.QueryType], since) {
+		if len(origins) > 0 && !origins[e.Origin] {
+			continue
+		}
+		if text != "" &&
+			!strings.Contains(i18n.Fold(e.Content), text) {
+			continue
+		}
+		out = append(out, e)
+		if uint64(len(out)) == limit {
+			break
+		}
+	}
+	writeJSON(w, out)
+}
+
+// adminLeader serves leadership and follower lag.
+//
+// Parameters:
+//   - w: response writer
+//   - r: request (unused)
+func (s *Server) adminLeader(w http.ResponseWriter, _ *http.Request) {
+	role, leader := s.role()
+	writeJSON(w, AdminLeader{
+		Role:      role,
+		Leader:    leader,
+		Followers: s.followers.list(),
+	})
+}
+
+// role reports this node's place in the cluster.
+//
+// Returns:
+//   - string: standalone, leader, or follower
+//   - string: Raft leader address (empty when standalone)
+func (s *Server) role() (string, string) {
+	if s.cluster == nil {
+		return cfgHub.AdminRoleStandalone, ""
+	}
+	if s.cluster.IsLeader() {
+		return cfgHub.AdminRoleLeader, s.cluster.LeaderAddr()
+	}
+	return cfgHub.AdminRoleFollower, s.cluster.LeaderAddr()
+}
+
+// queryUint parses an optional unsigned query parameter.
+//
+// Parameters:
+//   - q: request query values
+//   - key: parameter name
+//   - def: value when the parameter is absent
+//
+// Returns:
+//   - uint64: parsed or default value
+//   - bool: false when the value is not a non-negative
+//     integer
+func queryUint(q url.Values, key string, def uint64) (uint64, bool) {
+	raw := q.Get(key)
+	if raw == "" {
+		return def, true
+	}
+	n, parseErr := strconv.ParseUint(raw, 10, 64)
+	return n, parseErr == nil
+}
+
+// badQuery answers 400 for a malformed query parameter.
+//
+// Parameters:
+//   - w: response writer
+//   - q: request query values
+//   - key: offending parameter name
+func badQuery(w http.ResponseWriter, q url.Values, key string) {
+	http.Error(w, fmt.Sprintf(
+		cfgHub.ErrAdminBadQuery, key, q.Get(key),
+	), http.StatusBadRequest)
+}
+
+// writeJSON encodes v as the response body.
+//
+// Parameters:
+//   - w: response writer
+//   - v: value to encode
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set(cfgHub.HeaderContentType, cfgHTTP.MimeJSON)
+	// Acceptable discard: the client hung up mid-response.
+	_ = json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,231 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package hub
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+// adminGet issues a GET to the admin gateway and returns
+// the status code and body.
+func adminGet(
+	t *testing.T, base, path, token string,
+) (int, string) {
+	t.Helper()
+	req, reqErr := http.NewRequest(
+		http.MethodGet, base+path, nil,
+	)
+	if reqErr != nil {
+		t.Fatal(reqErr)
+	}
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	resp, getErr := http.DefaultClient.Do(req)
+	if getErr != nil {
+		t.Fatal(getErr)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	body, readErr := io.ReadAll(resp.Body)
+	if readErr != nil {
+		t.Fatal(readErr)
+	}
+	return resp.StatusCode, string(body)
+}
+
+// startAdmin serves srv's gRPC API and admin gateway on
+// random ports and returns both addresses.
+func startAdmin(t *testing.T, srv *Server) (string, string) {
+	t.Helper()
+	lis := listenRandom(t)
+	adminLis := listenRandom(t)
+	go func() { _ = srv.Serve(lis) }()
+	go func() { _ = srv.ServeAdmin(adminLis) }()
+	t.Cleanup(srv.GracefulStop)
+	return lis.Addr().String(), "http://" + adminLis.Addr().String()
+}
+
+func TestAdmin_RequiresToken(t *testing.T) {
+	srv, _ := newCorrectionServer(t)
+	_, base := startAdmin(t, srv)
+
+	for _, tok := range []string{"", "wrong"} {
+		code, _ := adminGet(t, base, "/v1/status", tok)
+		if code != http.StatusUnauthorized {
+			t.Errorf("token %q: code = %d, want 401", tok, code)
+		}
+	}
+	if code, _ := adminGet(t, base, "/metrics", ""); code != 200 {
+		t.Errorf("/metrics without token: code = %d", code)
+	}
+}
+
+func TestAdmin_StatusAndClients(t *testing.T) {
+	srv, store := newCorrectionServer(t)
+	addr, base := startAdmin(t, srv)
+	token := registerClient(t, addr, "adm")
+	if pubErr := publishOne(srv, PublishEntry{
+		ID: "a", Type: "decision", Content: "x",
+	}); pubErr != nil {
+		t.Fatal(pubErr)
+	}
+
+	code, body := adminGet(t, base, "/v1/status", "adm")
+	if code != http.StatusOK {
+		t.Fatalf("status code = %d: %s", code, body)
+	}
+	var st AdminStatus
+	if decErr := json.Unmarshal([]byte(body), &st); decErr != nil {
+		t.Fatal(decErr)
+	}
+	if st.Role != "standalone" || st.Sequence != 1 ||
+		st.Published != 1 || st.EntriesByType["decision"] != 1 {
+		t.Errorf("status = %+v", st)
+	}
+
+	_, body = adminGet(t, base, "/v1/clients", "adm")
+	if strings.Contains(body, token) {
+		t.Fatal("client list leaks the client token")
+	}
+	var clients []AdminClient
+	if decErr := json.Unmarshal([]byte(body), &clients); decErr != nil {
+		t.Fatal(decErr)
+	}
+	if len(clients) != len(store.Clients()) || len(clients) != 1 {
+		t.Errorf("clients = %+v", clients)
+	}
+}
+
+func TestAdmin_EntrySearch(t *testing.T) {
+	srv, _ := newCorrectionServer(t)
+	_, base := startAdmin(t, srv)
+	for _, pe := range []PublishEntry{
+		{ID: "a", Type: "decision", Content: "Use Postgres"},
+		{ID: "b", Type: "learning", Content: "postgres vacuum"},
+		{ID: "c", Type: "decision", Content: "Use Go"},
+	} {
+		if pubErr := publishOne(srv, pe); pubErr != nil {
+			t.Fatal(pubErr)
+		}
+	}
+
+	tests := []struct {
+		name  string
+		query string
+		want  []string
+	}{
+		{"all", "", []string{"a", "b", "c"}},
+		{"text", "?q=POSTGRES", []string{"a", "b"}},
+		{"type", "?type=decision", []string{"a", "c"}},
+		{"since", "?since=2", []string{"c"}},
+		{"limit", "?limit=1", []string{"a"}},
+		{"origin", "?origin=beta", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, body := adminGet(
+				t, base, "/v1/entries"+tt.query, "adm",
+			)
+			if code != http.StatusOK {
+				t.Fatalf("code = %d: %s", code, body)
+			}
+			var got []Entry
+			if decErr := json.Unmarshal(
+				[]byte(body), &got,
+			); decErr != nil {
+				t.Fatal(decErr)
+			}
+			if strings.Join(ids(got), ",") !=
+				strings.Join(tt.want, ",") {
+				t.Errorf("got %v, want %v", ids(got), tt.want)
+			}
+		})
+	}
+
+	for _, q := range []string{"?limit=0", "?limit=x", "?since=-1"} {
+		code, _ := adminGet(t, base, "/v1/entries"+q, "adm")
+		if code != http.StatusBadRequest {
+			t.Errorf("%s: code = %d, want 400", q, code)
+		}
+	}
+}
+
+func TestAdmin_FollowerLagAndMetrics(t *testing.T) {
+	srv, store := newCorrectionServer(t)
+	addr, base := startAdmin(t, srv)
+	appendEach(t, store,
+		Entry{ID: "a", Type: "decision"},
+		Entry{ID: "b", Type: "learning"},
+	)
+	token := registerClient(t, addr, "adm")
+
+	follower, storeErr := NewStore(t.TempDir())
+	if storeErr != nil {
+		t.Fatal(storeErr)
+	}
+	replicateOnce(testCtx(), addr, follower, token, TLSConfig{})
+
+	_, body := adminGet(t, base, "/v1/leader", "adm")
+	var lead AdminLeader
+	if decErr := json.Unmarshal([]byte(body), &lead); decErr != nil {
+		t.Fatal(decErr)
+	}
+	if len(lead.Followers) != 1 ||
+		lead.Followers[0].ProjectName != "replicate-test" ||
+		lead.Followers[0].Lag != 2 {
+		t.Fatalf("leader = %+v", lead)
+	}
+
+	_, metrics := adminGet(t, base, "/metrics", "")
+	for _, want := range []string{
+		`ctx_hub_entries{type="decision"} 1`,
+		`ctx_hub_entries{type="learning"} 1`,
+		"ctx_hub_sequence 2",
+		"ctx_hub_listeners_dropped_total 0",
+		"ctx_hub_leader 1",
+		`ctx_hub_replication_lag_entries{follower="replicate-test"} 2`,
+		"# TYPE ctx_hub_published_entries_total counter",
+	} {
+		if !strings.Contains(metrics, want) {
+			t.Errorf("metrics missing %q:\n%s", want, metrics)
+		}
+	}
+}
+
+func TestAdmin_StopRacesServe(t *testing.T) {
+	srv, _ := newCorrectionServer(t)
+	adminLis := listenRandom(t)
+	done := make(chan error, 1)
+	go func() { done <- srv.ServeAdmin(adminLis) }()
+	srv.GracefulStop()
+
+	select {
+	case serveErr := <-done:
+		if serveErr != nil {
+			t.Fatalf("ServeAdmin = %v, want nil", serveErr)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("admin gateway still serving after GracefulStop")
+	}
+}
+
+func TestAdmin_ServeAfterStop(t *testing.T) {
+	srv, _ := newCorrectionServer(t)
+	srv.GracefulStop()
+	adminLis := listenRandom(t)
+	if serveErr := srv.ServeAdmin(adminLis); serveErr != nil {
+		t.Fatalf("ServeAdmin after stop = %v, want nil", serveErr)
+	}
+	if _, acceptErr := adminLis.Accept(); acceptErr == nil {
+		t.Error("listener still open after ServeAdmin on a stopped hub")
+	}
+}
@@ -33,3 +33,16 @@ func GenerateAdminToken() (string, error) {
 func GenerateClientToken() (string, error) {
 	return generateToken(cfgHub.ClientTokenPrefix)
 }
+
+// GenerateEntryID creates a new random entry ID.
+//
+// Clients stamp one on every entry they publish; the hub
+// rejects entries without an ID because corrections and
+// rendered files refer back to entries by it.
+//
+// Returns:
+//   - string: hex-encoded 16-byte random ID
+//   - error: non-nil if crypto/rand fails
+func GenerateEntryID() (string, error) {
+	return generateClientID()
+}
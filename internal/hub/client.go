@@ -8,33 +8,34 @@ package hub
 
 import (
 	"context"
+	"time"
 
+	cfgEntry "github.com/ActiveMemory/ctx/internal/config/entry"
 	cfgHub "github.com/ActiveMemory/ctx/internal/config/hub"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
 )
 
 // NewClient creates a hub client connected to the given address.
 //
+// The zero [TLSConfig] dials in plaintext; any certificate
+// material switches the connection to TLS (mutual TLS when
+// a client key pair is set).
+//
 // Parameters:
 //   - addr: hub gRPC address (host:port)
 //   - token: bearer token for authenticated RPCs
+//   - tlsCfg: transport security settings
 //
 // Returns:
 //   - *Client: connected client
 //   - error: non-nil if connection fails
 func NewClient(
-	addr string, token string,
+	addr string, token string, tlsCfg TLSConfig,
 ) (*Client, error) {
-	conn, dialErr := grpc.NewClient(
-		addr,
-		grpc.WithTransportCredentials(
-			insecure.NewCredentials(),
-		),
-		grpc.WithDefaultCallOptions(
-			grpc.CallContentSubtype(codecName),
-		),
-	)
+	conn, dialErr := dial(addr, tlsCfg)
 	if dialErr != nil {
 		return nil, dialErr
 	}
@@ -43,6 +44,10 @@ func NewClient(
 
 // Register calls the Register RPC with the admin token.
 //
+// Over TLS, the hub's leaf certificate fingerprint is
+// captured and exposed via [Client.Fingerprint] so the
+// caller can pin it in the connection state.
+//
 // Parameters:
 //   - ctx: context for the call
 //   - adminToken: admin token from hub startup
@@ -57,6 +62,7 @@ func (c *Client) Register(
 	projectName string,
 ) (*RegisterResponse, error) {
 	resp := &RegisterResponse{}
+	p := &peer.Peer{}
 	callErr := c.conn.Invoke(
 		ctx,
 		cfgHub.PathRegister,
@@ -65,10 +71,24 @@ func (c *Client) Register(
 			ProjectName: projectName,
 		},
 		resp,
+		grpc.Peer(p),
 	)
+	if callErr == nil {
+		c.fingerprint = peerFingerprint(p)
+	}
 	return resp, callErr
 }
 
+// Fingerprint returns the hex SHA-256 of the hub's leaf
+// certificate observed by the last successful Register.
+//
+// Returns:
+//   - string: fingerprint, or empty over plaintext or
+//     before Register
+func (c *Client) Fingerprint() string {
+	return c.fingerprint
+}
+
 // Revoke calls the Revoke RPC with the admin token,
 // invalidating the given client's token on the hub.
 //
@@ -96,6 +116,68 @@ func (c *Client) Revoke(
 	)
 }
 
+// Policy reads a client's access policy. Admin-gated like
+// Revoke.
+//
+// Parameters:
+//   - ctx: context for the call
+//   - adminToken: admin token from hub startup
+//   - clientID: ID of the client
+//
+// Returns:
+//   - *PolicyResponse: the client's project and policy
+//   - error: non-nil if auth fails or the client is unknown
+func (c *Client) Policy(
+	ctx context.Context,
+	adminToken string,
+	clientID string,
+) (*PolicyResponse, error) {
+	resp := &PolicyResponse{}
+	callErr := c.conn.Invoke(
+		ctx,
+		cfgHub.PathPolicy,
+		&PolicyRequest{
+			AdminToken: adminToken,
+			ClientID:   clientID,
+		},
+		resp,
+	)
+	return resp, callErr
+}
+
+// SetPolicy replaces a client's access policy. Admin-gated
+// like Revoke.
+//
+// Parameters:
+//   - ctx: context for the call
+//   - adminToken: admin token from hub startup
+//   - clientID: ID of the client
+//   - policy: new policy; replaces the old one entirely
+//
+// Returns:
+//   - *PolicyResponse: the client's policy after the update
+//   - error: non-nil if auth or validation fails, or the
+//     client is unknown
+func (c *Client) SetPolicy(
+	ctx context.Context,
+	adminToken string,
+	clientID string,
+	policy Policy,
+) (*PolicyResponse, error) {
+	resp := &PolicyResponse{}
+	callErr := c.conn.Invoke(
+		ctx,
+		cfgHub.PathSetPolicy,
+		&SetPolicyRequest{
+			AdminToken: adminToken,
+			ClientID:   clientID,
+			Policy:     policy,
+		},
+		resp,
+	)
+	return resp, callErr
+}
+
 // Publish calls the Publish RPC.
 //
 // Parameters:
@@ -239,6 +321,81 @@ func (c *Client) Status(
 	return resp, callErr
 }
 
+// Search runs a full-text search on the hub.
+//
+// Parameters:
+//   - ctx: context for the call
+//   - req: text, type, origin, and time range filters
+//
+// Returns:
+//   - *SearchResponse: matches, newest first
+//   - error: non-nil if the RPC fails
+func (c *Client) Search(
+	ctx context.Context, req *SearchRequest,
+) (*SearchResponse, error) {
+	resp := &SearchResponse{}
+	callErr := c.conn.Invoke(
+		c.authedCtx(ctx),
+		cfgHub.PathSearch,
+		req,
+		resp,
+	)
+	return resp, callErr
+}
+
+// Leases returns the task leases in force, keyed by task
+// key. A hub that predates the Leases RPC answers
+// Unimplemented; the fleet log is then synced and replayed
+// instead.
+//
+// Parameters:
+//   - ctx: context for the call
+//
+// Returns:
+//   - map[string]EntryMsg: task key to the claim or assign
+//     that holds it
+//   - error: non-nil if the RPC fails
+func (c *Client) Leases(ctx context.Context) (map[string]EntryMsg, error) {
+	resp := &LeasesResponse{}
+	callErr := c.conn.Invoke(
+		c.authedCtx(ctx), cfgHub.PathLeases, &LeasesRequest{}, resp,
+	)
+	if status.Code(callErr) == codes.Unimplemented {
+		entries, syncErr := c.Sync(ctx, cfgEntry.Fleet, 0)
+		if syncErr != nil {
+			return nil, syncErr
+		}
+		return Leases(entries, time.Now()), nil
+	}
+	if callErr != nil {
+		return nil, callErr
+	}
+	held := make(map[string]EntryMsg, len(resp.Leases))
+	for _, e := range resp.Leases {
+		held[e.Lease.Task] = e
+	}
+	return held, nil
+}
+
+// Snapshot fetches the hub's compacted snapshot image.
+//
+// A client can seed its local copy from the snapshot and
+// then Sync from its Sequence instead of replaying the
+// log from zero. Sequence is 0 when the hub has never
+// compacted.
+//
+// Parameters:
+//   - ctx: context for the call
+//
+// Returns:
+//   - *Snapshot: snapshot entries, tombstones, and sequence
+//   - error: non-nil if the stream fails
+func (c *Client) Snapshot(
+	ctx context.Context,
+) (*Snapshot, error) {
+	return recvSnapshot(c.authedCtx(ctx), c.conn)
+}
+
 // Close closes the underlying gRPC connection.
 //
 // Returns:
@@ -10,7 +10,6 @@ import (
 	"net"
 	"os"
 	"path/filepath"
-	"time"
 
 	"github.com/hashicorp/raft"
 	raftboltdb "github.com/hashicorp/raft-boltdb/v2"
@@ -32,6 +31,11 @@ import (
 //   - bindAddr: address for Raft communication
 //   - dataDir: directory for Raft state
 //   - peers: other cluster nodes (empty = single node)
+//   - tlsCfg: transport security for peer traffic; the
+//     zero value keeps plain TCP. With a CA bundle every
+//     peer authenticates with a certificate signed by it,
+//     so the node certificate must be valid for both
+//     server and client authentication
 //
 // Returns:
 //   - *Cluster: initialized Raft cluster node
@@ -41,6 +45,7 @@ func NewCluster(
 	bindAddr string,
 	dataDir string,
 	peers []string,
+	tlsCfg TLSConfig,
 ) (*Cluster, error) {
 	raftDir := filepath.Join(dataDir, cfgHub.RaftDir)
 	if mkErr := io.SafeMkdirAll(
@@ -60,10 +65,7 @@ func NewCluster(
 		return nil, resolveErr
 	}
 
-	transport, transErr := raft.NewTCPTransport(
-		bindAddr, addr, 3,
-		10*time.Second, os.Stderr,
-	)
+	transport, transErr := newTransport(bindAddr, addr, tlsCfg)
 	if transErr != nil {
 		return nil, transErr
 	}
@@ -0,0 +1,189 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package hub
+
+import (
+	"os"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	cfgHub "github.com/ActiveMemory/ctx/internal/config/hub"
+)
+
+// appendEach appends entries one call at a time.
+func appendEach(t *testing.T, s *Store, entries ...Entry) {
+	t.Helper()
+	for _, e := range entries {
+		if _, appendErr := s.Append([]Entry{e}); appendErr != nil {
+			t.Fatalf("append %s: %v", e.ID, appendErr)
+		}
+	}
+}
+
+func TestStore_RotatesAndCompacts(t *testing.T) {
+	dir := t.TempDir()
+	store, storeErr := NewStore(dir)
+	if storeErr != nil {
+		t.Fatal(storeErr)
+	}
+	store.segmentEntries, store.compactSegments = 2, 2
+
+	appendEach(t, store,
+		Entry{ID: "a", Type: "task"},
+		Entry{ID: "b", Type: "task"},
+		Entry{ID: "c", Type: "task"},
+	)
+	segs, listErr := segmentFiles(dir)
+	if listErr != nil || len(segs) != 1 {
+		t.Fatalf("segments after rotation = %v (%v)", segs, listErr)
+	}
+
+	appendEach(t, store, Entry{ID: "d", Type: "task"})
+	if _, statErr := os.Stat(snapshotPath(dir)); statErr != nil {
+		t.Fatalf("snapshot missing after compaction: %v", statErr)
+	}
+	if segs, _ = segmentFiles(dir); len(segs) != 0 {
+		t.Errorf("segments after compaction = %v", segs)
+	}
+	appendEach(t, store, Entry{ID: "e", Type: "task"})
+
+	reopened, reopenErr := NewStore(dir)
+	if reopenErr != nil {
+		t.Fatal(reopenErr)
+	}
+	got := reopened.Log(nil, 0)
+	if len(got) != 5 {
+		t.Fatalf("Log after restart = %v, want 5 entries", ids(got))
+	}
+	for i := range got {
+		if got[i].Sequence != uint64(i+1) {
+			t.Errorf("entry %s seq = %d, want %d",
+				got[i].ID, got[i].Sequence, i+1)
+		}
+	}
+	if tail := reopened.Query(nil, 3); len(tail) != 2 {
+		t.Errorf("Query since 3 = %v, want [d e]", ids(tail))
+	}
+}
+
+func TestStore_CompactDropsCorrected(t *testing.T) {
+	dir := t.TempDir()
+	store, storeErr := NewStore(dir)
+	if storeErr != nil {
+		t.Fatal(storeErr)
+	}
+	srv, srvErr := NewServer(store, "adm", TLSConfig{})
+	if srvErr != nil {
+		t.Fatal(srvErr)
+	}
+	for _, pe := range []PublishEntry{
+		{ID: "a", Type: "decision", Content: "Use MySQL"},
+		{ID: "b", Type: "decision", Content: "Use Go"},
+		{
+			ID: "r", Kind: cfgHub.KindRetract,
+			Ref: "a", Reason: "posted by mistake",
+		},
+	} {
+		if pubErr := publishOne(srv, pe); pubErr != nil {
+			t.Fatalf("publish %s: %v", pe.ID, pubErr)
+		}
+	}
+	if compactErr := store.Compact(); compactErr != nil {
+		t.Fatal(compactErr)
+	}
+
+	reopened, reopenErr := NewStore(dir)
+	if reopenErr != nil {
+		t.Fatal(reopenErr)
+	}
+	if raw := reopened.Log(nil, 0); len(raw) != 2 {
+		t.Errorf("Log = %v, want [b r]", ids(raw))
+	}
+	if fresh := ids(reopened.Query(nil, 0)); len(fresh) != 1 ||
+		fresh[0] != "b" {
+		t.Errorf("fresh Query = %v, want [b]", fresh)
+	}
+	// A reader that held "a" before compaction still gets
+	// the retraction.
+	if held := reopened.Query(nil, 1); len(held) != 2 {
+		t.Errorf("Query since 1 = %v, want [b r]", ids(held))
+	}
+	if _, found, done := reopened.ref("a"); !found || !done {
+		t.Errorf("ref(a) = found %v, corrected %v", found, done)
+	}
+	if _, lastSeq := reopened.lastSequence(); lastSeq != 3 {
+		t.Errorf("lastSequence = %d, want 3", lastSeq)
+	}
+}
+
+func TestSyncEntries_RawBeforeSnapshotIsOutOfRange(t *testing.T) {
+	srv, store := newCorrectionServer(t)
+	appendEach(t, store, Entry{ID: "a", Type: "task"})
+	if compactErr := store.Compact(); compactErr != nil {
+		t.Fatal(compactErr)
+	}
+
+	syncErr := srv.syncEntries(
+		&SyncRequest{Raw: true}, &Policy{},
+		func(*EntryMsg) error { return nil },
+	)
+	if status.Code(syncErr) != codes.OutOfRange {
+		t.Fatalf("raw sync from 0 = %v, want OutOfRange", syncErr)
+	}
+	if readErr := srv.syncEntries(
+		&SyncRequest{}, &Policy{},
+		func(*EntryMsg) error { return nil },
+	); readErr != nil {
+		t.Errorf("reader sync from 0: %v", readErr)
+	}
+}
+
+func TestReplicateOnce_BootstrapsFromSnapshot(t *testing.T) {
+	master, addr, adminTok := startMaster(t)
+	appendEach(t, master,
+		Entry{ID: "a", Type: "decision"},
+		Entry{ID: "b", Type: "decision"},
+		Entry{
+			ID: "r", Type: "decision",
+			Kind: cfgHub.KindRetract, Ref: "a", Reason: "r",
+		},
+	)
+	if compactErr := master.Compact(); compactErr != nil {
+		t.Fatal(compactErr)
+	}
+	appendEach(t, master, Entry{ID: "c", Type: "decision"})
+	token := registerClient(t, addr, adminTok)
+
+	follower, storeErr := NewStore(t.TempDir())
+	if storeErr != nil {
+		t.Fatal(storeErr)
+	}
+	replicateOnce(testCtx(), addr, follower, token, TLSConfig{})
+	if _, lastSeq := follower.lastSequence(); lastSeq != 3 {
+		t.Fatalf("after bootstrap lastSequence = %d, want 3", lastSeq)
+	}
+	replicateOnce(testCtx(), addr, follower, token, TLSConfig{})
+
+	want := ids(master.Log(nil, 0))
+	got := ids(follower.Log(nil, 0))
+	if len(got) != len(want) {
+		t.Fatalf("follower log = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("follower log = %v, want %v", got, want)
+		}
+	}
+	if _, lastSeq := follower.lastSequence(); lastSeq != 4 {
+		t.Errorf("lastSequence = %d, want 4", lastSeq)
+	}
+	if fresh := follower.Query(nil, 0); len(fresh) != 2 {
+		t.Errorf("follower Query = %v, want [b c]", ids(fresh))
+	}
+}
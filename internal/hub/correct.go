@@ -0,0 +1,105 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package hub
+
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	cfgEntry "github.com/ActiveMemory/ctx/internal/config/entry"
+	cfgHub "github.com/ActiveMemory/ctx/internal/config/hub"
+)
+
+// resolveRefs checks every correction in a publish batch
+// against the store and the entries earlier in the same
+// batch. A retraction without a Type is stamped with its
+// target's type so type-filtered readers receive it
+// alongside the entry it withdraws.
+//
+// Parameters:
+//   - entries: validated batch; Type may be filled in
+//
+// Returns:
+//   - error: InvalidArgument for an unknown, mistyped,
+//     fleet, or retraction target; FailedPrecondition when
+//     the target was already corrected
+func (s *Server) resolveRefs(entries []PublishEntry) error {
+	pending := make(map[string]PublishEntry, len(entries))
+	done := make(map[string]bool)
+	for i := range entries {
+		pe := &entries[i]
+		if pe.Kind != "" {
+			if refErr := s.resolveRef(
+				pe, pending, done,
+			); refErr != nil {
+				return refErr
+			}
+			done[pe.Ref] = true
+		}
+		pending[pe.ID] = *pe
+	}
+	return nil
+}
+
+// resolveRef validates a single correction's target.
+//
+// Parameters:
+//   - pe: correction to check; Type may be filled in
+//   - pending: earlier entries of the batch by ID
+//   - done: targets already corrected earlier in the batch
+//
+// Returns:
+//   - error: status error describing the rejected ref
+func (s *Server) resolveRef(
+	pe *PublishEntry,
+	pending map[string]PublishEntry,
+	done map[string]bool,
+) error {
+	kind, typ, corrected := "", "", done[pe.Ref]
+	if prior, ok := pending[pe.Ref]; ok {
+		kind, typ = prior.Kind, prior.Type
+	} else {
+		stored, found, was := s.store.ref(pe.Ref)
+		if !found {
+			return status.Errorf(
+				codes.InvalidArgument,
+				cfgHub.ErrEntryRefUnknown, pe.Ref,
+			)
+		}
+		kind, typ = stored.Kind, stored.Type
+		corrected = corrected || was
+	}
+
+	if cfgEntry.FleetTypes[typ] {
+		return status.Errorf(
+			codes.InvalidArgument, cfgHub.ErrLeaseKind, typ,
+		)
+	}
+	if kind == cfgHub.KindRetract {
+		return status.Errorf(
+			codes.InvalidArgument,
+			cfgHub.ErrEntryRefRetraction, pe.Ref,
+		)
+	}
+	if corrected {
+		return status.Errorf(
+			codes.FailedPrecondition,
+			cfgHub.ErrEntryRefCorrected, pe.Ref,
+		)
+	}
+	if pe.Type == "" {
+		pe.Type = typ
+		return nil
+	}
+	if pe.Type != typ {
+		return status.Errorf(
+			codes.InvalidArgument,
+			cfgHub.ErrEntryRefType, pe.Ref, typ, pe.Type,
+		)
+	}
+	return nil
+}
@@ -0,0 +1,323 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package hub
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	cfgHub "github.com/ActiveMemory/ctx/internal/config/hub"
+)
+
+// newCorrectionServer returns a server over a fresh store,
+// driven through its handlers without a listener.
+func newCorrectionServer(t *testing.T) (*Server, *Store) {
+	t.Helper()
+	store, storeErr := NewStore(t.TempDir())
+	if storeErr != nil {
+		t.Fatal(storeErr)
+	}
+	srv, srvErr := NewServer(store, "adm", TLSConfig{})
+	if srvErr != nil {
+		t.Fatal(srvErr)
+	}
+	return srv, store
+}
+
+// publishOne publishes a single entry through the handler.
+func publishOne(srv *Server, pe PublishEntry) error {
+	pe.Origin = "alpha"
+	pe.Timestamp = time.Now().Unix()
+	_, pubErr := srv.publish(
+		context.Background(), &Policy{}, "",
+		&PublishRequest{Entries: []PublishEntry{pe}},
+	)
+	return pubErr
+}
+
+// ids returns the entry IDs in order.
+func ids(entries []Entry) []string {
+	out := make([]string, len(entries))
+	for i := range entries {
+		out[i] = entries[i].ID
+	}
+	return out
+}
+
+func TestCorrection_RetractHidesFromFreshQuery(t *testing.T) {
+	srv, store := newCorrectionServer(t)
+	for _, pe := range []PublishEntry{
+		{ID: "a", Type: "decision", Content: "Use MySQL"},
+		{ID: "b", Type: "decision", Content: "Use Go"},
+		{
+			ID: "r", Kind: cfgHub.KindRetract,
+			Ref: "a", Reason: "posted by mistake",
+		},
+	} {
+		if pubErr := publishOne(srv, pe); pubErr != nil {
+			t.Fatalf("publish %s: %v", pe.ID, pubErr)
+		}
+	}
+
+	fresh := ids(store.Query(nil, 0))
+	if len(fresh) != 1 || fresh[0] != "b" {
+		t.Fatalf("fresh Query = %v, want [b]", fresh)
+	}
+
+	// A reader that already holds "a" must get the
+	// retraction so it can mark its copy.
+	later := store.Query(nil, 2)
+	if len(later) != 1 || later[0].ID != "r" {
+		t.Fatalf("Query since 2 = %v, want [r]", ids(later))
+	}
+	if later[0].Type != "decision" {
+		t.Errorf("retraction type = %q, want stamped decision",
+			later[0].Type)
+	}
+
+	// Type-filtered readers receive the retraction with
+	// its target.
+	if got := store.Query([]string{"decision"}, 2); len(got) != 1 {
+		t.Errorf("filtered Query since 2 = %v", ids(got))
+	}
+
+	if raw := store.Log(nil, 0); len(raw) != 3 {
+		t.Errorf("Log = %v, want all 3 entries", ids(raw))
+	}
+}
+
+func TestCorrection_SupersedeReturnsReplacement(t *testing.T) {
+	srv, store := newCorrectionServer(t)
+	if pubErr := publishOne(srv, PublishEntry{
+		ID: "a", Type: "convention", Content: "Tabs",
+	}); pubErr != nil {
+		t.Fatal(pubErr)
+	}
+	if pubErr := publishOne(srv, PublishEntry{
+		ID: "s", Type: "convention", Content: "gofmt",
+		Kind: cfgHub.KindSupersede, Ref: "a",
+		Reason: "let the formatter decide",
+	}); pubErr != nil {
+		t.Fatal(pubErr)
+	}
+
+	fresh := store.Query(nil, 0)
+	if len(fresh) != 1 || fresh[0].ID != "s" {
+		t.Fatalf("fresh Query = %v, want [s]", ids(fresh))
+	}
+	if fresh[0].Ref != "a" || fresh[0].Reason == "" {
+		t.Errorf("supersede lost ref or reason: %+v", fresh[0])
+	}
+}
+
+func TestCorrection_Rejections(t *testing.T) {
+	srv, _ := newCorrectionServer(t)
+	if pubErr := publishOne(srv, PublishEntry{
+		ID: "a", Type: "decision", Content: "x",
+	}); pubErr != nil {
+		t.Fatal(pubErr)
+	}
+	if pubErr := publishOne(srv, PublishEntry{
+		ID: "r", Kind: cfgHub.KindRetract,
+		Ref: "a", Reason: "wrong",
+	}); pubErr != nil {
+		t.Fatal(pubErr)
+	}
+
+	tests := []struct {
+		name string
+		pe   PublishEntry
+		code codes.Code
+	}{
+		{"unknown kind", PublishEntry{
+			ID: "k", Type: "decision",
+			Kind: "delete", Ref: "a", Reason: "r",
+		}, codes.InvalidArgument},
+		{"missing reason", PublishEntry{
+			ID: "m", Kind: cfgHub.KindRetract, Ref: "a",
+		}, codes.InvalidArgument},
+		{"reason on plain entry", PublishEntry{
+			ID: "p", Type: "decision", Reason: "why",
+		}, codes.InvalidArgument},
+		{"reason with newline", PublishEntry{
+			ID: "n", Type: "decision",
+			Kind: cfgHub.KindSupersede,
+			Ref:  "a", Reason: "one\ntwo",
+		}, codes.InvalidArgument},
+		{"unknown ref", PublishEntry{
+			ID: "u", Kind: cfgHub.KindRetract,
+			Ref: "nope", Reason: "r",
+		}, codes.InvalidArgument},
+		{"already retracted", PublishEntry{
+			ID: "d", Kind: cfgHub.KindRetract,
+			Ref: "a", Reason: "again",
+		}, codes.FailedPrecondition},
+		{"retracting a retraction", PublishEntry{
+			ID: "rr", Kind: cfgHub.KindRetract,
+			Ref: "r", Reason: "undo",
+		}, codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pubErr := publishOne(srv, tt.pe)
+			if got := status.Code(pubErr); got != tt.code {
+				t.Fatalf("code = %v (%v), want %v",
+					got, pubErr, tt.code)
+			}
+		})
+	}
+}
+
+func TestCorrection_TypeMismatchRejected(t *testing.T) {
+	srv, _ := newCorrectionServer(t)
+	if pubErr := publishOne(srv, PublishEntry{
+		ID: "a", Type: "decision", Content: "x",
+	}); pubErr != nil {
+		t.Fatal(pubErr)
+	}
+	pubErr := publishOne(srv, PublishEntry{
+		ID: "s", Type: "learning", Content: "y",
+		Kind: cfgHub.KindSupersede, Ref: "a", Reason: "r",
+	})
+	if status.Code(pubErr) != codes.InvalidArgument {
+		t.Fatalf("want InvalidArgument, got %v", pubErr)
+	}
+}
+
+func TestCorrection_SameBatchRef(t *testing.T) {
+	srv, store := newCorrectionServer(t)
+	now := time.Now().Unix()
+	_, pubErr := srv.publish(context.Background(), &Policy{}, "",
+		&PublishRequest{Entries: []PublishEntry{
+			{
+				ID: "a", Type: "task", Content: "x",
+				Origin: "alpha", Timestamp: now,
+			},
+			{
+				ID: "r", Kind: cfgHub.KindRetract, Ref: "a",
+				Reason: "dup", Origin: "alpha", Timestamp: now,
+			},
+		}},
+	)
+	if pubErr != nil {
+		t.Fatal(pubErr)
+	}
+	if got := store.Query(nil, 0); len(got) != 0 {
+		t.Errorf("fresh Query = %v, want empty", ids(got))
+	}
+}
+
+func TestCorrection_SurvivesRestart(t *testing.T) {
+	dir := t.TempDir()
+	store, storeErr := NewStore(dir)
+	if storeErr != nil {
+		t.Fatal(storeErr)
+	}
+	if _, appendErr := store.Append([]Entry{
+		{ID: "a", Type: "decision"},
+		{
+			ID: "r", Type: "decision",
+			Kind: cfgHub.KindRetract, Ref: "a", Reason: "r",
+		},
+	}); appendErr != nil {
+		t.Fatal(appendErr)
+	}
+
+	reopened, reopenErr := NewStore(dir)
+	if reopenErr != nil {
+		t.Fatal(reopenErr)
+	}
+	if got := reopened.Query(nil, 0); len(got) != 0 {
+		t.Errorf("Query after restart = %v", ids(got))
+	}
+	if _, found, done := reopened.ref("a"); !found || !done {
+		t.Errorf("ref(a) = found %v, corrected %v", found, done)
+	}
+}
+
+func TestCorrection_ReplicationKeepsSequences(t *testing.T) {
+	master, addr, adminTok := startMaster(t)
+	if _, appendErr := master.Append([]Entry{
+		{ID: "a", Type: "decision"},
+		{ID: "b", Type: "decision"},
+		{
+			ID: "r", Type: "decision",
+			Kind: cfgHub.KindRetract, Ref: "a", Reason: "r",
+		},
+	}); appendErr != nil {
+		t.Fatal(appendErr)
+	}
+	token := registerClient(t, addr, adminTok)
+
+	follower, storeErr := NewStore(t.TempDir())
+	if storeErr != nil {
+		t.Fatal(storeErr)
+	}
+	replicateOnce(testCtx(), addr, follower, token, TLSConfig{})
+
+	raw := follower.Log(nil, 0)
+	if len(raw) != 3 {
+		t.Fatalf("follower log = %v, want 3 entries", ids(raw))
+	}
+	for i := range raw {
+		if raw[i].Sequence != uint64(i+1) {
+			t.Errorf("entry %s seq = %d, want %d",
+				raw[i].ID, raw[i].Sequence, i+1)
+		}
+	}
+	if got := follower.Query(nil, 0); len(got) != 1 {
+		t.Errorf("follower Query = %v, want [b]", ids(got))
+	}
+}
+
+func TestCorrection_ConcurrentCorrectionsOneWins(t *testing.T) {
+	srv, store := newCorrectionServer(t)
+	if pubErr := publishOne(srv, PublishEntry{
+		ID: "a", Type: "decision", Content: "Use MySQL",
+	}); pubErr != nil {
+		t.Fatal(pubErr)
+	}
+
+	const racers = 8
+	errs := make(chan error, racers)
+	for i := range racers {
+		go func() {
+			errs <- publishOne(srv, PublishEntry{
+				ID:   "r" + string(rune('0'+i)),
+				Kind: cfgHub.KindRetract, Ref: "a", Reason: "dup",
+			})
+		}()
+	}
+	accepted := 0
+	for range racers {
+		err := <-errs
+		switch status.Code(err) {
+		case codes.OK:
+			accepted++
+		case codes.FailedPrecondition:
+		default:
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if accepted != 1 {
+		t.Fatalf("accepted %d corrections of one entry, want 1", accepted)
+	}
+
+	// The store itself refuses a correction that slipped
+	// past the handler's unlocked check.
+	_, grantErr := store.grant([]Entry{{
+		ID: "late", Type: "decision", Kind: cfgHub.KindRetract,
+		Ref: "a", Timestamp: time.Now(),
+	}}, time.Now())
+	if status.Code(grantErr) != codes.FailedPrecondition {
+		t.Fatalf("grant of a second correction = %v", grantErr)
+	}
+}
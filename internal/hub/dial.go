@@ -0,0 +1,43 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package hub
+
+import (
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// dial opens a gRPC connection to a hub using the JSON
+// codec. Plaintext when cfg carries no certificate
+// material, TLS otherwise.
+//
+// Parameters:
+//   - addr: hub gRPC address (host:port)
+//   - cfg: transport security settings
+//
+// Returns:
+//   - *grpc.ClientConn: lazily-connecting client conn
+//   - error: non-nil if TLS material fails to load or the
+//     target is malformed
+func dial(addr string, cfg TLSConfig) (*grpc.ClientConn, error) {
+	creds := insecure.NewCredentials()
+	if tlsEnabled(cfg) {
+		tlsCfg, tlsErr := clientTLS(cfg)
+		if tlsErr != nil {
+			return nil, tlsErr
+		}
+		creds = credentials.NewTLS(tlsCfg)
+	}
+	return grpc.NewClient(
+		addr,
+		grpc.WithTransportCredentials(creds),
+		grpc.WithDefaultCallOptions(
+			grpc.CallContentSubtype(codecName),
+		),
+	)
+}
@@ -17,7 +17,7 @@
 //   - Storage ([Store]): append-only JSONL with
 //     sequence numbers and per-client tokens.
 //   - Transport ([Server]): gRPC Register / Publish
-//     / Sync / Listen / Status RPCs.
+//     / Sync / Listen / Search / Leases / Status RPCs.
 //   - Cluster ([Cluster]): HashiCorp Raft for leader
 //     election only (see Raft-Lite below).
 //   - Client ([Client]): connection registration,
@@ -43,6 +43,35 @@
 // Sequence numbers make replication and resume
 // strictly idempotent.
 //
+// The log is segmented: entries.jsonl is the active
+// segment, sealed into segments/<first-sequence>.jsonl
+// once it holds SegmentEntries lines. When enough
+// segments are sealed the store writes snapshot.json,
+// which keeps every entry not retracted or superseded
+// plus tombstones for the ones it dropped, and removes
+// the segments it covers. Startup loads the snapshot,
+// then the segments, then the active file; reads find
+// their start by binary search on sequence.
+//
+// A raw Sync (replication) whose cursor predates the
+// snapshot fails with OutOfRange; the follower fetches
+// the Snapshot RPC, installs it with [Store.Restore],
+// and syncs the tail on the next cycle. New clients
+// need nothing special: Sync from 0 serves the
+// corrections-aware view, which compaction preserves.
+//
+// # Corrections
+//
+// Nothing in the log is ever rewritten. A mistaken or
+// outdated entry is withdrawn by appending a
+// correction: an [Entry] whose Kind is retract or
+// supersede, whose Ref names the earlier entry, and
+// which carries a Reason. [Store.Query] (what Sync and
+// Listen serve) omits corrected entries, and a
+// retraction whose target is in the same window;
+// [Store.Log] returns the log verbatim so replication
+// keeps sequence parity with the leader.
+//
 // # Raft-Lite
 //
 // The package embeds HashiCorp Raft for leader
@@ -53,10 +82,25 @@
 //
 // # Trust Model
 //
-// Every holder of a client token is trusted. Origin
-// is self-asserted; there is no per-user attribution.
-// The hub serves single-developer and small-team
-// shapes, not public multi-tenant deployments.
+// Every holder of a client token is trusted within its
+// [Policy]. The admin sets a policy per client: which
+// types it may publish, which types and origins it may
+// read, and whether it is read-only. Publish rejects
+// disallowed entries with PermissionDenied; Sync and
+// Listen filter silently; raw reads require an
+// unfiltered policy. Origin is self-asserted; there is
+// no per-user attribution. The hub serves
+// single-developer and small-team shapes, not public
+// multi-tenant deployments.
+//
+// # Admin Gateway
+//
+// [Server.ServeAdmin] serves a read-only HTTP view of
+// the hub: status, clients (without tokens), entry
+// search, and leadership as JSON behind the admin
+// token, plus unauthenticated Prometheus metrics.
+// Raw Sync requests record each follower's cursor so
+// the gateway can report replication lag.
 //
 // # Concurrency
 //
@@ -70,4 +114,12 @@
 // Client-side connection state is encrypted at rest
 // via AES-256-GCM using the same per-machine key
 // that protects [internal/pad].
+//
+// On the wire, [TLSConfig] switches gRPC and Raft
+// traffic to TLS; a CA bundle on the server makes
+// it mutual. Clients pin the SHA-256 of the hub's
+// leaf certificate ([Client.Fingerprint]) at
+// registration and refuse any other on later dials.
+// Failover peers each hold their own leaf, so
+// [TLSConfig].PeerFingerprints pins them per address.
 package hub
@@ -12,9 +12,6 @@ import (
 	cfgHub "github.com/ActiveMemory/ctx/internal/config/hub"
 	cfgWarn "github.com/ActiveMemory/ctx/internal/config/warn"
 	logWarn "github.com/ActiveMemory/ctx/internal/log/warn"
-
-	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 )
 
 // newFailoverClient creates a client that tries peers in
@@ -24,24 +21,19 @@ import (
 // Parameters:
 //   - peers: ordered list of hub addresses
 //   - bearerToken: token for authenticated RPCs
+//   - tlsCfg: transport security settings shared by
+//     every peer; a PeerFingerprints entry replaces the
+//     pin for its address
 //
 // Returns:
 //   - *Client: connected client to the first reachable peer
 //   - error: non-nil if no peer is reachable
 func newFailoverClient(
-	peers []string, bearerToken string,
+	peers []string, bearerToken string, tlsCfg TLSConfig,
 ) (*Client, error) {
 	var lastErr error
 	for _, addr := range peers {
-		conn, dialErr := grpc.NewClient(
-			addr,
-			grpc.WithTransportCredentials(
-				insecure.NewCredentials(),
-			),
-			grpc.WithDefaultCallOptions(
-				grpc.CallContentSubtype(codecName),
-			),
-		)
+		conn, dialErr := dial(addr, peerTLS(tlsCfg, addr))
 		if dialErr != nil {
 			lastErr = dialErr
 			continue
@@ -77,3 +69,20 @@ func newFailoverClient(
 	}
 	return nil, lastErr
 }
+
+// peerTLS returns the TLS settings for one failover peer:
+// its own pinned fingerprint when one is recorded, the
+// shared pin otherwise.
+//
+// Parameters:
+//   - cfg: shared transport security settings
+//   - addr: peer address
+//
+// Returns:
+//   - TLSConfig: settings with the peer's pin applied
+func peerTLS(cfg TLSConfig, addr string) TLSConfig {
+	if pin, ok := cfg.PeerFingerprints[addr]; ok {
+		cfg.Fingerprint = pin
+	}
+	return cfg
+}
@@ -24,7 +24,12 @@ func TestFailoverClient_FirstPeerWorks(t *testing.T) {
 	if storeErr != nil {
 		t.Fatal(storeErr)
 	}
-	srv := NewServer(store, adminTok)
+	srv, srvErr := NewServer(
+		store, adminTok, TLSConfig{},
+	)
+	if srvErr != nil {
+		t.Fatal(srvErr)
+	}
 	lis := listenRandom(t)
 	go func() { _ = srv.Serve(lis) }()
 	t.Cleanup(func() { srv.GracefulStop() })
@@ -32,7 +37,7 @@ func TestFailoverClient_FirstPeerWorks(t *testing.T) {
 	addr := lis.Addr().String()
 
 	// Register a client on the second server.
-	regClient, dialErr := NewClient(addr, "")
+	regClient, dialErr := NewClient(addr, "", TLSConfig{})
 	if dialErr != nil {
 		t.Fatal(dialErr)
 	}
@@ -46,7 +51,7 @@ func TestFailoverClient_FirstPeerWorks(t *testing.T) {
 
 	// Failover client with the reachable peer first.
 	client, foErr := newFailoverClient(
-		[]string{addr}, resp.ClientToken,
+		[]string{addr}, resp.ClientToken, TLSConfig{},
 	)
 	if foErr != nil {
 		t.Fatalf("newFailoverClient: %v", foErr)
@@ -70,14 +75,19 @@ func TestFailoverClient_SkipsBadPeer(t *testing.T) {
 
 	dir := t.TempDir()
 	store, _ := NewStore(dir)
-	srv := NewServer(store, adminTok)
+	srv, srvErr := NewServer(
+		store, adminTok, TLSConfig{},
+	)
+	if srvErr != nil {
+		t.Fatal(srvErr)
+	}
 	lis := listenRandom(t)
 	go func() { _ = srv.Serve(lis) }()
 	t.Cleanup(func() { srv.GracefulStop() })
 
 	addr := lis.Addr().String()
 
-	regClient, _ := NewClient(addr, "")
+	regClient, _ := NewClient(addr, "", TLSConfig{})
 	resp, _ := regClient.Register(
 		testCtx(), adminTok, "skip-proj",
 	)
@@ -86,7 +96,7 @@ func TestFailoverClient_SkipsBadPeer(t *testing.T) {
 	// First peer is unreachable, second is good.
 	client, foErr := newFailoverClient(
 		[]string{"127.0.0.1:1", addr},
-		resp.ClientToken,
+		resp.ClientToken, TLSConfig{},
 	)
 	if foErr != nil {
 		t.Fatalf("expected fallback to work: %v", foErr)
@@ -99,7 +109,7 @@ func TestFailoverClient_SkipsBadPeer(t *testing.T) {
 func TestFailoverClient_AllBad(t *testing.T) {
 	_, foErr := newFailoverClient(
 		[]string{"127.0.0.1:1", "127.0.0.1:2"},
-		"bad-token",
+		"bad-token", TLSConfig{},
 	)
 	if foErr == nil {
 		t.Fatal("expected error when all peers bad")
@@ -128,6 +138,7 @@ func TestFailoverClient_FailsFastOnAuthError(t *testing.T) {
 	_, foErr := newFailoverClient(
 		[]string{addr, "127.0.0.1:1"},
 		"bogus-token-that-the-server-will-reject",
+		TLSConfig{},
 	)
 	if foErr == nil {
 		t.Fatal("expected auth error on first peer; got nil")
@@ -67,6 +67,17 @@ func (f *fanOut) broadcast(entries []Entry) {
 	}
 }
 
+// droppedCount returns how many slow listeners were
+// disconnected since start.
+//
+// Returns:
+//   - uint64: dropped listener count
+func (f *fanOut) droppedCount() uint64 {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.dropped
+}
+
 // count returns the number of active listeners.
 //
 // Returns:
@@ -0,0 +1,26 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package hub
+
+// CertFingerprint returns the hex SHA-256 of the leaf
+// certificate in cfg's key pair, the same digest
+// [Client.Fingerprint] reports for the hub side.
+//
+// Parameters:
+//   - cfg: transport security settings naming the pair
+//
+// Returns:
+//   - string: fingerprint, or empty when no pair is set
+//   - error: non-nil if the pair is incomplete or fails
+//     to load
+func CertFingerprint(cfg TLSConfig) (string, error) {
+	certs, pairErr := keyPair(cfg)
+	if pairErr != nil || certs == nil {
+		return "", pairErr
+	}
+	return fingerprint(certs[0].Certificate[0]), nil
+}
@@ -0,0 +1,61 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package hub
+
+import (
+	"sort"
+	"time"
+)
+
+// newFollowerSet creates an empty follower tracker.
+//
+// Returns:
+//   - *followerSet: tracker with no followers
+func newFollowerSet() *followerSet {
+	return &followerSet{byClient: make(map[string]Follower)}
+}
+
+// record notes a raw Sync from a replicating client.
+//
+// Parameters:
+//   - c: authenticated follower
+//   - since: cursor the follower sent
+//   - head: this hub's last sequence when the sync arrived
+func (f *followerSet) record(c *ClientInfo, since, head uint64) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var lag uint64
+	if head > since {
+		lag = head - since
+	}
+	f.byClient[c.ID] = Follower{
+		ClientID:    c.ID,
+		ProjectName: c.ProjectName,
+		Sequence:    since,
+		Lag:         lag,
+		LastSync:    time.Now().UTC(),
+	}
+}
+
+// list returns the known followers ordered by project.
+//
+// Returns:
+//   - []Follower: copy of the follower states
+func (f *followerSet) list() []Follower {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	out := make([]Follower, 0, len(f.byClient))
+	for _, fl := range f.byClient {
+		out = append(out, fl)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].ProjectName < out[j].ProjectName
+	})
+	return out
+}
@@ -58,6 +58,22 @@ func serviceDesc(s *Server) *grpc.ServiceDesc {
 				MethodName: cfgHub.MethodRevoke,
 				Handler:    makeRevokeHandler(s),
 			},
+			{
+				MethodName: cfgHub.MethodSearch,
+				Handler:    makeSearchHandler(s),
+			},
+			{
+				MethodName: cfgHub.MethodLeases,
+				Handler:    makeLeasesHandler(s),
+			},
+			{
+				MethodName: cfgHub.MethodPolicy,
+				Handler:    makePolicyHandler(s),
+			},
+			{
+				MethodName: cfgHub.MethodSetPolicy,
+				Handler:    makeSetPolicyHandler(s),
+			},
 		},
 		Streams: []grpc.StreamDesc{
 			{
@@ -70,6 +86,11 @@ func serviceDesc(s *Server) *grpc.ServiceDesc {
 				Handler:       makeListenHandler(s),
 				ServerStreams: true,
 			},
+			{
+				StreamName:    cfgHub.MethodSnapshot,
+				Handler:       makeSnapshotHandler(s),
+				ServerStreams: true,
+			},
 		},
 		Metadata: cfgHub.ProtoFile,
 	}
@@ -128,6 +149,50 @@ func makeRevokeHandler(s *Server) grpc.MethodHandler {
 	}
 }
 
+// makePolicyHandler creates the Policy handler.
+// Policy uses admin token auth, not bearer.
+//
+// Parameters:
+//   - s: hub server for request dispatch
+//
+// Returns:
+//   - grpc.MethodHandler: unary handler for Policy RPC
+func makePolicyHandler(s *Server) grpc.MethodHandler {
+	return func(
+		_ any, ctx context.Context,
+		dec func(any) error,
+		_ grpc.UnaryServerInterceptor,
+	) (any, error) {
+		req := &PolicyRequest{}
+		if decErr := dec(req); decErr != nil {
+			return nil, decErr
+		}
+		return s.policy(ctx, req)
+	}
+}
+
+// makeSetPolicyHandler creates the SetPolicy handler.
+// SetPolicy uses admin token auth, not bearer.
+//
+// Parameters:
+//   - s: hub server for request dispatch
+//
+// Returns:
+//   - grpc.MethodHandler: unary handler for SetPolicy RPC
+func makeSetPolicyHandler(s *Server) grpc.MethodHandler {
+	return func(
+		_ any, ctx context.Context,
+		dec func(any) error,
+		_ grpc.UnaryServerInterceptor,
+	) (any, error) {
+		req := &SetPolicyRequest{}
+		if decErr := dec(req); decErr != nil {
+			return nil, decErr
+		}
+		return s.setPolicy(ctx, req)
+	}
+}
+
 // makePublishHandler creates the Publish handler.
 //
 // Parameters:
@@ -141,16 +206,15 @@ func makePublishHandler(s *Server) grpc.MethodHandler {
 		dec func(any) error,
 		_ grpc.UnaryServerInterceptor,
 	) (any, error) {
-		if authErr := validateBearer(
-			ctx, s.store,
-		); authErr != nil {
+		client, authErr := authenticate(ctx, s.store)
+		if authErr != nil {
 			return nil, authErr
 		}
 		req := &PublishRequest{}
 		if decErr := dec(req); decErr != nil {
 			return nil, decErr
 		}
-		return s.publish(ctx, req)
+		return s.publish(ctx, &client.Policy, client.ID, req)
 	}
 }
 
@@ -167,12 +231,61 @@ func makeStatusHandler(s *Server) grpc.MethodHandler {
 		_ func(any) error,
 		_ grpc.UnaryServerInterceptor,
 	) (any, error) {
-		if authErr := validateBearer(
-			ctx, s.store,
-		); authErr != nil {
+		client, authErr := authenticate(ctx, s.store)
+		if authErr != nil {
 			return nil, authErr
 		}
-		return s.hubStatus(ctx)
+		return s.hubStatus(ctx, &client.Policy)
+	}
+}
+
+// makeSearchHandler creates the Search handler.
+//
+// Parameters:
+//   - s: hub server for request dispatch
+//
+// Returns:
+//   - grpc.MethodHandler: unary handler for Search RPC
+func makeSearchHandler(s *Server) grpc.MethodHandler {
+	return func(
+		_ any, ctx context.Context,
+		dec func(any) error,
+		_ grpc.UnaryServerInterceptor,
+	) (any, error) {
+		client, authErr := authenticate(ctx, s.store)
+		if authErr != nil {
+			return nil, authErr
+		}
+		req := &SearchRequest{}
+		if decErr := dec(req); decErr != nil {
+			return nil, decErr
+		}
+		return s.search(ctx, &client.Policy, req)
+	}
+}
+
+// makeLeasesHandler creates the Leases handler.
+//
+// Parameters:
+//   - s: hub server for request dispatch
+//
+// Returns:
+//   - grpc.MethodHandler: unary handler for Leases RPC
+func makeLeasesHandler(s *Server) grpc.MethodHandler {
+	return func(
+		_ any, ctx context.Context,
+		dec func(any) error,
+		_ grpc.UnaryServerInterceptor,
+	) (any, error) {
+		client, authErr := authenticate(ctx, s.store)
+		if authErr != nil {
+			return nil, authErr
+		}
+		req := &LeasesRequest{}
+		if decErr := dec(req); decErr != nil {
+			return nil, decErr
+		}
+		return s.leases(&client.Policy), nil
 	}
 }
 
@@ -187,17 +300,20 @@ func makeSyncHandler(
 	s *Server,
 ) func(any, grpc.ServerStream) error {
 	return func(_ any, ss grpc.ServerStream) error {
-		if authErr := validateBearer(
-			ss.Context(), s.store,
-		); authErr != nil {
+		client, authErr := authenticate(ss.Context(), s.store)
+		if authErr != nil {
 			return authErr
 		}
 		req := &SyncRequest{}
 		if recvErr := ss.RecvMsg(req); recvErr != nil {
 			return recvErr
 		}
+		if req.Raw {
+			_, head := s.store.lastSequence()
+			s.followers.record(&client, req.SinceSequence, head)
+		}
 		return s.syncEntries(
-			req, func(m *EntryMsg) error {
+			req, &client.Policy, func(m *EntryMsg) error {
 				return ss.SendMsg(m)
 			},
 		)
@@ -215,9 +331,8 @@ func makeListenHandler(
 	s *Server,
 ) func(any, grpc.ServerStream) error {
 	return func(_ any, ss grpc.ServerStream) error {
-		if authErr := validateBearer(
-			ss.Context(), s.store,
-		); authErr != nil {
+		client, authErr := authenticate(ss.Context(), s.store)
+		if authErr != nil {
 			return authErr
 		}
 		req := &ListenRequest{}
@@ -225,9 +340,36 @@ func makeListenHandler(
 			return recvErr
 		}
 		return s.listenEntries(
-			req, func(m *EntryMsg) error {
+			req, client.ID, func(m *EntryMsg) error {
 				return ss.SendMsg(m)
 			}, ss.Context(),
 		)
 	}
 }
+
+// makeSnapshotHandler creates the Snapshot stream handler.
+//
+// Parameters:
+//   - s: hub server for request dispatch
+//
+// Returns:
+//   - func(any, grpc.ServerStream) error: stream handler
+func makeSnapshotHandler(
+	s *Server,
+) func(any, grpc.ServerStream) error {
+	return func(_ any, ss grpc.ServerStream) error {
+		client, authErr := authenticate(ss.Context(), s.store)
+		if authErr != nil {
+			return authErr
+		}
+		req := &SnapshotRequest{}
+		if recvErr := ss.RecvMsg(req); recvErr != nil {
+			return recvErr
+		}
+		return s.snapshotEntries(
+			&client.Policy, func(c *SnapshotChunk) error {
+				return ss.SendMsg(c)
+			},
+		)
+	}
+}
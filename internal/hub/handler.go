@@ -13,6 +13,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	cfgEntry "github.com/ActiveMemory/ctx/internal/config/entry"
 	cfgHub "github.com/ActiveMemory/ctx/internal/config/hub"
 	errHub "github.com/ActiveMemory/ctx/internal/err/hub"
 )
@@ -84,17 +85,10 @@ func (s *Server) register(
 func (s *Server) revoke(
 	_ context.Context, req *RevokeRequest,
 ) (*RevokeResponse, error) {
-	if req.AdminToken != s.adminToken {
-		return nil, status.Error(
-			codes.PermissionDenied,
-			cfgHub.ErrInvalidAdminToken,
-		)
-	}
-	if req.ClientID == "" {
-		return nil, status.Error(
-			codes.InvalidArgument,
-			cfgHub.ErrClientIDRequired,
-		)
+	if adminErr := s.checkAdmin(
+		req.AdminToken, req.ClientID,
+	); adminErr != nil {
+		return nil, adminErr
 	}
 
 	if revErr := s.store.RevokeClient(req.ClientID); revErr != nil {
@@ -106,17 +100,103 @@ func (s *Server) revoke(
 	return &RevokeResponse{}, nil
 }
 
+// policy handles the Policy RPC.
+//
+// Parameters:
+//   - ctx: request context (unused)
+//   - req: policy request with admin token and client ID
+//
+// Returns:
+//   - *PolicyResponse: the client's current policy
+//   - error: PermissionDenied on bad admin token,
+//     InvalidArgument on empty client ID, NotFound on
+//     unknown client
+func (s *Server) policy(
+	_ context.Context, req *PolicyRequest,
+) (*PolicyResponse, error) {
+	if adminErr := s.checkAdmin(
+		req.AdminToken, req.ClientID,
+	); adminErr != nil {
+		return nil, adminErr
+	}
+	client, getErr := s.store.Client(req.ClientID)
+	if getErr != nil {
+		return nil, status.Error(codes.NotFound, getErr.Error())
+	}
+	return policyResponse(&client), nil
+}
+
+// setPolicy handles the SetPolicy RPC.
+//
+// Parameters:
+//   - ctx: request context (unused)
+//   - req: request with admin token, client ID, and policy
+//
+// Returns:
+//   - *PolicyResponse: the client's policy after the update
+//   - error: PermissionDenied on bad admin token,
+//     InvalidArgument on empty client ID or unknown entry
+//     type, NotFound on unknown client
+func (s *Server) setPolicy(
+	_ context.Context, req *SetPolicyRequest,
+) (*PolicyResponse, error) {
+	if adminErr := s.checkAdmin(
+		req.AdminToken, req.ClientID,
+	); adminErr != nil {
+		return nil, adminErr
+	}
+	if valErr := req.Policy.validate(); valErr != nil {
+		return nil, valErr
+	}
+	client, setErr := s.store.SetPolicy(req.ClientID, req.Policy)
+	if setErr != nil {
+		return nil, status.Error(codes.NotFound, setErr.Error())
+	}
+	return policyResponse(&client), nil
+}
+
+// checkAdmin validates the admin token and client ID that
+// every client-management RPC carries.
+//
+// Parameters:
+//   - adminToken: token presented by the caller
+//   - clientID: target client ID
+//
+// Returns:
+//   - error: PermissionDenied on bad admin token,
+//     InvalidArgument on empty client ID
+func (s *Server) checkAdmin(adminToken, clientID string) error {
+	if adminToken != s.adminToken {
+		return status.Error(
+			codes.PermissionDenied,
+			cfgHub.ErrInvalidAdminToken,
+		)
+	}
+	if clientID == "" {
+		return status.Error(
+			codes.InvalidArgument,
+			cfgHub.ErrClientIDRequired,
+		)
+	}
+	return nil
+}
+
 // publish handles the Publish RPC.
 //
 // Parameters:
 //   - ctx: request context (unused)
+//   - pol: caller's access policy
+//   - clientID: caller's registered client ID, stamped on
+//     fleet entries as Lease.Client
 //   - req: publish request with entries
 //
 // Returns:
 //   - *PublishResponse: assigned sequence numbers
-//   - error: non-nil if validation or append fails
+//   - error: non-nil if validation, the policy, a task
+//     lease, or append fails
 func (s *Server) publish(
-	_ context.Context, req *PublishRequest,
+	_ context.Context, pol *Policy, clientID string,
+	req *PublishRequest,
 ) (*PublishResponse, error) {
 	if len(req.Entries) == 0 {
 		return &PublishResponse{}, nil
@@ -127,6 +207,12 @@ func (s *Server) publish(
 			return nil, valErr
 		}
 	}
+	if refErr := s.resolveRefs(req.Entries); refErr != nil {
+		return nil, refErr
+	}
+	if polErr := pol.checkPublish(req.Entries); polErr != nil {
+		return nil, polErr
+	}
 
 	entries := make([]Entry, len(req.Entries))
 	for i, pe := range req.Entries {
@@ -137,17 +223,29 @@ func (s *Server) publish(
 			Origin:    pe.Origin,
 			Meta:      pe.Meta,
 			Timestamp: time.Unix(pe.Timestamp, 0),
+			Kind:      pe.Kind,
+			Ref:       pe.Ref,
+			Reason:    pe.Reason,
+			Lease:     pe.Lease,
+		}
+		if cfgEntry.FleetTypes[pe.Type] {
+			entries[i].Lease.Client = clientID
 		}
 	}
 
-	seqs, appendErr := s.store.Append(entries)
-	if appendErr != nil {
-		return nil, errHub.InternalErr(appendErr)
+	seqs, grantErr := s.store.grant(entries, time.Now())
+	if grantErr != nil {
+		// Lease conflicts are already status errors.
+		if _, isStatus := status.FromError(grantErr); isStatus {
+			return nil, grantErr
+		}
+		return nil, errHub.InternalErr(grantErr)
 	}
 
 	for i := range entries {
 		entries[i].Sequence = seqs[i]
 	}
+	s.published.Add(uint64(len(entries)))
 	s.listeners.broadcast(entries)
 
 	return &PublishResponse{Sequences: seqs}, nil
@@ -155,19 +253,42 @@ func (s *Server) publish(
 
 // syncEntries handles the Sync RPC (server-streaming).
 //
+// Readers get the corrections-aware view; a Raw request
+// (replication) gets the log verbatim. A raw cursor that
+// predates the snapshot cannot be served verbatim, since
+// compaction dropped corrected entries; the follower is
+// told to bootstrap from the Snapshot RPC instead.
+//
 // Parameters:
 //   - req: sync request with type filter and sequence
+//   - pol: caller's access policy
 //   - send: callback to send each entry to the client
 //
 // Returns:
-//   - error: non-nil if send fails
+//   - error: PermissionDenied for a raw read by a filtered
+//     client, OutOfRange for a compacted raw cursor, or
+//     non-nil if send fails
 func (s *Server) syncEntries(
-	req *SyncRequest, send func(*EntryMsg) error,
+	req *SyncRequest, pol *Policy, send func(*EntryMsg) error,
 ) error {
-	results := s.store.Query(
-		req.Types, req.SinceSequence,
-	)
+	read := s.store.Query
+	if req.Raw {
+		if polErr := pol.checkRaw(); polErr != nil {
+			return polErr
+		}
+		if base := s.store.compacted(); req.SinceSequence < base {
+			return status.Errorf(
+				codes.OutOfRange, cfgHub.ErrCompacted,
+				req.SinceSequence, base,
+			)
+		}
+		read = s.store.Log
+	}
+	results := read(req.Types, req.SinceSequence)
 	for i := range results {
+		if !pol.readable(&results[i]) {
+			continue
+		}
 		if sendErr := send(
 			entryToMsg(&results[i]),
 		); sendErr != nil {
@@ -177,24 +298,146 @@ func (s *Server) syncEntries(
 	return nil
 }
 
+// search handles the Search RPC.
+//
+// Parameters:
+//   - ctx: request context (unused)
+//   - pol: caller's access policy; filters results like
+//     Sync
+//   - req: search filters
+//
+// Returns:
+//   - *SearchResponse: matches, newest first
+//   - error: InvalidArgument for an out-of-range limit,
+//     oversized text, or inverted time range
+func (s *Server) search(
+	_ context.Context, pol *Policy, req *SearchRequest,
+) (*SearchResponse, error) {
+	if req.Limit < 0 || req.Limit > cfgHub.SearchMax {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			cfgHub.ErrSearchLimit, cfgHub.SearchMax,
+		)
+	}
+	if len(req.Text) > cfgHub.MaxSearchLen {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			cfgHub.ErrSearchOversize, cfgHub.MaxSearchLen,
+		)
+	}
+	if req.Since > 0 && req.Until > 0 && req.Until < req.Since {
+		return nil, status.Error(
+			codes.InvalidArgument, cfgHub.ErrSearchRange,
+		)
+	}
+	if req.Limit == 0 {
+		req.Limit = cfgHub.SearchLimit
+	}
+
+	found, more := s.store.Search(req, pol.readable)
+	resp := &SearchResponse{
+		Entries: make([]EntryMsg, len(found)),
+		More:    more,
+	}
+	for i := range found {
+		resp.Entries[i] = *entryToMsg(&found[i])
+	}
+	return resp, nil
+}
+
+// leases handles the Leases RPC: the claim and assign
+// records in force, read from the store's lease table
+// instead of replaying the fleet log.
+//
+// Parameters:
+//   - pol: caller's access policy
+//
+// Returns:
+//   - *LeasesResponse: live leases the caller may read
+func (s *Server) leases(pol *Policy) *LeasesResponse {
+	live := s.store.liveLeases(time.Now(), pol.readable)
+	resp := &LeasesResponse{Leases: make([]EntryMsg, len(live))}
+	for i := range live {
+		resp.Leases[i] = *entryToMsg(&live[i])
+	}
+	return resp
+}
+
+// snapshotEntries handles the Snapshot RPC
+// (server-streaming). The image is sent in chunks of
+// SnapshotChunk entries; the first chunk carries the
+// sequence and tombstones. An uncompacted store sends one
+// empty chunk at sequence 0.
+//
+// Parameters:
+//   - pol: caller's access policy
+//   - send: callback to send each chunk to the client
+//
+// Returns:
+//   - error: PermissionDenied for a filtered client, or
+//     non-nil if send fails
+func (s *Server) snapshotEntries(
+	pol *Policy, send func(*SnapshotChunk) error,
+) error {
+	if polErr := pol.checkRaw(); polErr != nil {
+		return polErr
+	}
+	snap := s.store.image()
+	chunk := &SnapshotChunk{
+		Sequence:   snap.Sequence,
+		Tombstones: snap.Tombstones,
+	}
+	for i := range snap.Entries {
+		chunk.Entries = append(
+			chunk.Entries, *entryToMsg(&snap.Entries[i]),
+		)
+		if len(chunk.Entries) < cfgHub.SnapshotChunk {
+			continue
+		}
+		if sendErr := send(chunk); sendErr != nil {
+			return sendErr
+		}
+		chunk = &SnapshotChunk{}
+	}
+	if len(chunk.Entries) > 0 || len(snap.Entries) == 0 {
+		return send(chunk)
+	}
+	return nil
+}
+
 // listenEntries handles the Listen RPC (long-lived stream).
 //
+// The caller's policy is read from the registry again for
+// every broadcast batch, so a SetPolicy that narrows or
+// revokes read access takes effect on streams already open.
+// A client revoked mid-stream has its stream ended.
+//
 // Parameters:
 //   - req: listen request with type filter and sequence
+//   - clientID: ID of the authenticated caller
 //   - send: callback to send each entry to the client
 //   - ctx: context for cancellation
 //
 // Returns:
-//   - error: non-nil if send fails
+//   - error: Unauthenticated once the client is revoked,
+//     or non-nil if send fails
 func (s *Server) listenEntries(
 	req *ListenRequest,
+	clientID string,
 	send func(*EntryMsg) error,
 	ctx context.Context,
 ) error {
+	pol, polErr := s.currentPolicy(clientID)
+	if polErr != nil {
+		return polErr
+	}
 	results := s.store.Query(
 		req.Types, req.SinceSequence,
 	)
 	for i := range results {
+		if !pol.readable(&results[i]) {
+			continue
+		}
 		if sendErr := send(
 			entryToMsg(&results[i]),
 		); sendErr != nil {
@@ -215,11 +458,17 @@ func (s *Server) listenEntries(
 		case <-ctx.Done():
 			return nil
 		case entries := <-ch:
+			if pol, polErr = s.currentPolicy(clientID); polErr != nil {
+				return polErr
+			}
 			for i := range entries {
 				if len(typeSet) > 0 &&
 					!typeSet[entries[i].Type] {
 					continue
 				}
+				if !pol.readable(&entries[i]) {
+					continue
+				}
 				if sendErr := send(
 					entryToMsg(&entries[i]),
 				); sendErr != nil {
@@ -232,16 +481,22 @@ func (s *Server) listenEntries(
 
 // hubStatus handles the Status RPC.
 //
+// Counts cover only the entries the caller's policy lets it
+// read, the same check Sync and Listen apply, so a filtered
+// client cannot learn which other projects publish or how
+// much.
+//
 // Parameters:
 //   - ctx: request context (unused)
+//   - pol: the caller's read policy
 //
 // Returns:
 //   - *StatusResponse: hub statistics
 //   - error: always nil
 func (s *Server) hubStatus(
-	_ context.Context,
+	_ context.Context, pol *Policy,
 ) (*StatusResponse, error) {
-	total, byType, byProject := s.store.Stats()
+	total, byType, byProject := s.store.stats(pol.readable)
 	return &StatusResponse{
 		TotalEntries:     total,
 		ConnectedClients: s.listeners.count(),
@@ -249,3 +504,22 @@ func (s *Server) hubStatus(
 		EntriesByProject: byProject,
 	}, nil
 }
+
+// currentPolicy returns a client's policy as stored now.
+//
+// Parameters:
+//   - clientID: ID of the client
+//
+// Returns:
+//   - *Policy: the client's current policy
+//   - error: Unauthenticated when the client is no longer
+//     registered
+func (s *Server) currentPolicy(clientID string) (*Policy, error) {
+	client, getErr := s.store.Client(clientID)
+	if getErr != nil {
+		return nil, status.Error(
+			codes.Unauthenticated, cfgHub.ErrInvalidToken,
+		)
+	}
+	return &client.Policy, nil
+}
@@ -214,7 +214,12 @@ func TestIntegration_ClientLib(t *testing.T) {
 	if storeErr != nil {
 		t.Fatal(storeErr)
 	}
-	srv := NewServer(store, adminTok)
+	srv, srvErr := NewServer(
+		store, adminTok, TLSConfig{},
+	)
+	if srvErr != nil {
+		t.Fatal(srvErr)
+	}
 	lis, lisErr := net.Listen("tcp", "127.0.0.1:0")
 	if lisErr != nil {
 		t.Fatal(lisErr)
@@ -225,7 +230,7 @@ func TestIntegration_ClientLib(t *testing.T) {
 	addr := lis.Addr().String()
 
 	// Register via Client lib.
-	client, dialErr := NewClient(addr, "")
+	client, dialErr := NewClient(addr, "", TLSConfig{})
 	if dialErr != nil {
 		t.Fatal(dialErr)
 	}
@@ -241,7 +246,7 @@ func TestIntegration_ClientLib(t *testing.T) {
 	// Reconnect with the client token.
 	_ = client.Close()
 	client2, dial2Err := NewClient(
-		addr, regResp.ClientToken,
+		addr, regResp.ClientToken, TLSConfig{},
 	)
 	if dial2Err != nil {
 		t.Fatal(dial2Err)
@@ -0,0 +1,37 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package hub
+
+import "time"
+
+// Leases replays fleet entries in sequence order and
+// returns the claim and assign records still in force at
+// now, keyed by task key. The hub accepted each entry only
+// if it respected the leases before it, so replaying the
+// log reproduces the hub's own view.
+//
+// Parameters:
+//   - entries: fleet entries from Sync, in sequence order
+//   - now: time to judge expiry against
+//
+// Returns:
+//   - map[string]EntryMsg: task key to its live lease
+//     record
+func Leases(entries []EntryMsg, now time.Time) map[string]EntryMsg {
+	held := make(map[string]Entry)
+	for i := range entries {
+		e := msgToEntry(&entries[i])
+		applyLease(held, &e)
+	}
+	live := make(map[string]EntryMsg, len(held))
+	for task, e := range held {
+		if e.Lease.Expires > now.Unix() {
+			live[task] = *entryToMsg(&e)
+		}
+	}
+	return live
+}
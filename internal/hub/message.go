@@ -6,6 +6,8 @@
 
 package hub
 
+import "time"
+
 // entryToMsg converts a store Entry to a wire EntryMsg.
 //
 // Parameters:
@@ -22,5 +24,47 @@ func entryToMsg(e *Entry) *EntryMsg {
 		Meta:      e.Meta,
 		Timestamp: e.Timestamp.Unix(),
 		Sequence:  e.Sequence,
+		Kind:      e.Kind,
+		Ref:       e.Ref,
+		Reason:    e.Reason,
+		Lease:     e.Lease,
+	}
+}
+
+// msgToEntry converts a wire EntryMsg to a store Entry.
+//
+// Parameters:
+//   - m: wire-format entry
+//
+// Returns:
+//   - Entry: store entry with the wire sequence kept
+func msgToEntry(m *EntryMsg) Entry {
+	return Entry{
+		ID:        m.ID,
+		Type:      m.Type,
+		Content:   m.Content,
+		Origin:    m.Origin,
+		Meta:      m.Meta,
+		Timestamp: time.Unix(m.Timestamp, 0),
+		Sequence:  m.Sequence,
+		Kind:      m.Kind,
+		Ref:       m.Ref,
+		Reason:    m.Reason,
+		Lease:     m.Lease,
+	}
+}
+
+// policyResponse builds the Policy RPC reply for a client.
+//
+// Parameters:
+//   - c: client to describe
+//
+// Returns:
+//   - *PolicyResponse: client ID, project, and policy
+func policyResponse(c *ClientInfo) *PolicyResponse {
+	return &PolicyResponse{
+		ClientID:    c.ID,
+		ProjectName: c.ProjectName,
+		Policy:      c.Policy,
 	}
 }
@@ -0,0 +1,102 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package hub
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"time"
+
+	cfgHub "github.com/ActiveMemory/ctx/internal/config/hub"
+)
+
+// adminMetrics serves Prometheus text-format metrics.
+// Unauthenticated: every sample is an aggregate count.
+//
+// Parameters:
+//   - w: response writer
+//   - r: request (unused)
+func (s *Server) adminMetrics(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set(cfgHub.HeaderContentType, cfgHub.MimeMetrics)
+
+	_, byType, _ := s.store.Stats()
+	types := make([]string, 0, len(byType))
+	for t := range byType {
+		types = append(types, t)
+	}
+	sort.Strings(types)
+	metricHeader(w, cfgHub.MetricEntries, cfgHub.HelpEntries,
+		cfgHub.MetricGauge)
+	for _, t := range types {
+		_, _ = fmt.Fprintf(w, cfgHub.FmtMetricLabeled,
+			cfgHub.MetricEntries, cfgHub.LabelType, t, byType[t])
+	}
+
+	_, seq := s.store.lastSequence()
+	metric(w, cfgHub.MetricSequence, cfgHub.HelpSequence,
+		cfgHub.MetricGauge, seq)
+	metric(w, cfgHub.MetricPublished, cfgHub.HelpPublished,
+		cfgHub.MetricCounter, s.published.Load())
+	metric(w, cfgHub.MetricListeners, cfgHub.HelpListeners,
+		cfgHub.MetricGauge, uint64(s.listeners.count()))
+	metric(w, cfgHub.MetricDropped, cfgHub.HelpDropped,
+		cfgHub.MetricCounter, s.listeners.droppedCount())
+
+	var leader uint64
+	if role, _ := s.role(); role != cfgHub.AdminRoleFollower {
+		leader = 1
+	}
+	metric(w, cfgHub.MetricLeader, cfgHub.HelpLeader,
+		cfgHub.MetricGauge, leader)
+
+	followers := s.followers.list()
+	if len(followers) == 0 {
+		return
+	}
+	metricHeader(w, cfgHub.MetricLag, cfgHub.HelpLag,
+		cfgHub.MetricGauge)
+	for _, f := range followers {
+		_, _ = fmt.Fprintf(w, cfgHub.FmtMetricLabeled,
+			cfgHub.MetricLag, cfgHub.LabelFollower,
+			f.ProjectName, f.Lag)
+	}
+	metricHeader(w, cfgHub.MetricLastSync, cfgHub.HelpLastSync,
+		cfgHub.MetricGauge)
+	for _, f := range followers {
+		age := uint64(time.Since(f.LastSync) / time.Second)
+		_, _ = fmt.Fprintf(w, cfgHub.FmtMetricLabeled,
+			cfgHub.MetricLastSync, cfgHub.LabelFollower,
+			f.ProjectName, age)
+	}
+}
+
+// metricHeader writes the HELP and TYPE lines of a metric.
+//
+// Parameters:
+//   - w: response body
+//   - name: metric name
+//   - help: one-line description
+//   - kind: gauge or counter
+func metricHeader(w io.Writer, name, help, kind string) {
+	_, _ = fmt.Fprintf(w, cfgHub.FmtMetricHelp, name, help)
+	_, _ = fmt.Fprintf(w, cfgHub.FmtMetricType, name, kind)
+}
+
+// metric writes an unlabeled metric with its header.
+//
+// Parameters:
+//   - w: response body
+//   - name: metric name
+//   - help: one-line description
+//   - kind: gauge or counter
+//   - value: sample value
+func metric(w io.Writer, name, help, kind string, value uint64) {
+	metricHeader(w, name, help, kind)
+	_, _ = fmt.Fprintf(w, cfgHub.FmtMetricValue, name, value)
+}
@@ -10,9 +10,12 @@ import (
 	"bufio"
 	"bytes"
 	"encoding/json"
+	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 
+	"github.com/ActiveMemory/ctx/internal/config/file"
 	"github.com/ActiveMemory/ctx/internal/config/fs"
 	cfgHub "github.com/ActiveMemory/ctx/internal/config/hub"
 	"github.com/ActiveMemory/ctx/internal/io"
@@ -114,34 +117,84 @@ func saveJSONAtomic(path string, src any) error {
 	return io.SafeWriteFileAtomic(path, data, fs.PermFile)
 }
 
-// appendFile appends data to a file, creating it if needed.
+// segmentsPath returns the directory of sealed segments.
 //
 // Parameters:
-//   - path: file path to append to
-//   - data: bytes to append
+//   - dir: hub data directory
 //
 // Returns:
-//   - error: non-nil if read or write fails
-func appendFile(path string, data []byte) error {
-	existing, readErr := io.SafeReadUserFile(path)
-	if readErr != nil && !os.IsNotExist(readErr) {
-		return readErr
-	}
-	return io.SafeWriteFile(
-		path, append(existing, data...), fs.PermFile,
+//   - string: absolute path to the segments directory
+func segmentsPath(dir string) string {
+	return filepath.Join(dir, cfgHub.DirSegments)
+}
+
+// segmentPath returns the path of the sealed segment that
+// starts at the given sequence.
+//
+// Parameters:
+//   - dir: hub data directory
+//   - first: first sequence held by the segment
+//
+// Returns:
+//   - string: absolute path to the segment JSONL file
+func segmentPath(dir string, first uint64) string {
+	return filepath.Join(
+		segmentsPath(dir), fmt.Sprintf(cfgHub.FmtSegment, first),
 	)
 }
 
-// loadEntries reads the JSONL entry log into the slice.
+// snapshotPath returns the full path to the snapshot file.
+//
+// Parameters:
+//   - dir: hub data directory
+//
+// Returns:
+//   - string: absolute path to snapshot JSON file
+func snapshotPath(dir string) string {
+	return filepath.Join(dir, cfgHub.FileSnapshot)
+}
+
+// segmentFiles lists sealed segment paths in sequence order.
+// Segment names are zero-padded, so lexical order is
+// sequence order.
 //
 // Parameters:
 //   - dir: hub data directory
+//
+// Returns:
+//   - []string: segment paths, oldest first
+//   - error: non-nil if the directory cannot be read
+func segmentFiles(dir string) ([]string, error) {
+	ents, readErr := os.ReadDir(segmentsPath(dir))
+	if os.IsNotExist(readErr) {
+		return nil, nil
+	}
+	if readErr != nil {
+		return nil, readErr
+	}
+	var paths []string
+	for _, de := range ents {
+		if de.IsDir() || filepath.Ext(de.Name()) != file.ExtJSONL {
+			continue
+		}
+		paths = append(paths, filepath.Join(
+			segmentsPath(dir), de.Name(),
+		))
+	}
+	sort.Strings(paths)
+	return paths, nil
+}
+
+// loadEntries reads a JSONL entry log into the slice.
+//
+// Parameters:
+//   - path: JSONL file to read
 //   - dst: slice to append loaded entries into
 //
 // Returns:
 //   - error: non-nil if read or unmarshal fails
-func loadEntries(dir string, dst *[]Entry) error {
-	data, readErr := io.SafeReadUserFile(entriesPath(dir))
+func loadEntries(path string, dst *[]Entry) error {
+	data, readErr := io.SafeReadUserFile(path)
 	if os.IsNotExist(readErr) {
 		return nil
 	}
@@ -153,6 +206,7 @@ func loadEntries(dir string, dst *[]Entry) error {
 	}
 
 	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(nil, cfgHub.MaxLineBytes)
 	for scanner.Scan() {
 		var e Entry
 		if decErr := json.Unmarshal(
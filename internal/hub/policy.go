@@ -0,0 +1,103 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package hub
+
+import (
+	"slices"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	cfgEntry "github.com/ActiveMemory/ctx/internal/config/entry"
+	cfgHub "github.com/ActiveMemory/ctx/internal/config/hub"
+)
+
+// checkPublish rejects a publish batch the policy does not
+// allow. Runs after resolveRefs so retractions carry their
+// stamped type.
+//
+// Parameters:
+//   - p: caller's policy
+//   - entries: resolved batch
+//
+// Returns:
+//   - error: PermissionDenied on the first disallowed entry
+func (p *Policy) checkPublish(entries []PublishEntry) error {
+	if p.ReadOnly {
+		return status.Error(
+			codes.PermissionDenied, cfgHub.ErrPolicyReadOnly,
+		)
+	}
+	if len(p.Publish) == 0 {
+		return nil
+	}
+	for i := range entries {
+		if !slices.Contains(p.Publish, entries[i].Type) {
+			return status.Errorf(
+				codes.PermissionDenied,
+				cfgHub.ErrPolicyPublishType, entries[i].Type,
+			)
+		}
+	}
+	return nil
+}
+
+// filtered reports whether the policy hides any entries
+// from reads.
+//
+// Returns:
+//   - bool: true when Types or Origins is set
+func (p *Policy) filtered() bool {
+	return len(p.Types) > 0 || len(p.Origins) > 0
+}
+
+// readable reports whether the policy lets the client
+// receive e.
+//
+// Parameters:
+//   - e: candidate entry
+//
+// Returns:
+//   - bool: true when e's type and origin are allowed
+func (p *Policy) readable(e *Entry) bool {
+	if len(p.Types) > 0 && !slices.Contains(p.Types, e.Type) {
+		return false
+	}
+	return len(p.Origins) == 0 ||
+		slices.Contains(p.Origins, e.Origin)
+}
+
+// checkRaw rejects reads that must see the whole log
+// (replication, snapshots) from a filtered client.
+//
+// Returns:
+//   - error: PermissionDenied when reads are filtered
+func (p *Policy) checkRaw() error {
+	if p.filtered() {
+		return status.Error(
+			codes.PermissionDenied, cfgHub.ErrPolicyRawRead,
+		)
+	}
+	return nil
+}
+
+// validate checks that every type the policy names is a
+// known entry type.
+//
+// Returns:
+//   - error: InvalidArgument naming the first unknown type
+func (p *Policy) validate() error {
+	for _, typ := range slices.Concat(p.Publish, p.Types) {
+		if !cfgEntry.AllowedTypes[typ] && !cfgEntry.FleetTypes[typ] {
+			return status.Errorf(
+				codes.InvalidArgument,
+				cfgHub.ErrPolicyInvalidType, typ,
+			)
+		}
+	}
+	return nil
+}
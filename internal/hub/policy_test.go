@@ -0,0 +1,299 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package hub
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	cfgHub "github.com/ActiveMemory/ctx/internal/config/hub"
+)
+
+// publishAs publishes one entry under the given policy.
+func publishAs(srv *Server, pol Policy, pe PublishEntry) error {
+	if pe.Origin == "" {
+		pe.Origin = "alpha"
+	}
+	pe.Timestamp = time.Now().Unix()
+	_, pubErr := srv.publish(
+		context.Background(), &pol, "",
+		&PublishRequest{Entries: []PublishEntry{pe}},
+	)
+	return pubErr
+}
+
+// syncAs collects the IDs a Sync from 0 returns under the
+// given policy.
+func syncAs(t *testing.T, srv *Server, pol Policy) []string {
+	t.Helper()
+	var got []string
+	if syncErr := srv.syncEntries(
+		&SyncRequest{}, &pol, func(m *EntryMsg) error {
+			got = append(got, m.ID)
+			return nil
+		},
+	); syncErr != nil {
+		t.Fatal(syncErr)
+	}
+	return got
+}
+
+func TestPolicy_Publish(t *testing.T) {
+	srv, _ := newCorrectionServer(t)
+	if pubErr := publishAs(srv, Policy{}, PublishEntry{
+		ID: "a", Type: "decision", Content: "x",
+	}); pubErr != nil {
+		t.Fatal(pubErr)
+	}
+
+	tests := []struct {
+		name string
+		pol  Policy
+		pe   PublishEntry
+		code codes.Code
+	}{
+		{"read-only", Policy{ReadOnly: true}, PublishEntry{
+			ID: "b", Type: "learning", Content: "y",
+		}, codes.PermissionDenied},
+		{"type not allowed", Policy{Publish: []string{"learning"}},
+			PublishEntry{ID: "c", Type: "decision", Content: "y"},
+			codes.PermissionDenied},
+		{"type allowed", Policy{Publish: []string{"learning"}},
+			PublishEntry{ID: "d", Type: "learning", Content: "y"},
+			codes.OK},
+		{"retraction checked against stamped type",
+			Policy{Publish: []string{"learning"}}, PublishEntry{
+				ID: "r", Kind: cfgHub.KindRetract,
+				Ref: "a", Reason: "wrong",
+			}, codes.PermissionDenied},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pubErr := publishAs(srv, tt.pol, tt.pe)
+			if got := status.Code(pubErr); got != tt.code {
+				t.Fatalf("code = %v (%v), want %v",
+					got, pubErr, tt.code)
+			}
+		})
+	}
+}
+
+func TestPolicy_SyncFilters(t *testing.T) {
+	srv, _ := newCorrectionServer(t)
+	for _, pe := range []PublishEntry{
+		{ID: "a", Type: "decision", Content: "x", Origin: "alpha"},
+		{ID: "b", Type: "learning", Content: "y", Origin: "alpha"},
+		{ID: "c", Type: "decision", Content: "z", Origin: "beta"},
+	} {
+		if pubErr := publishAs(srv, Policy{}, pe); pubErr != nil {
+			t.Fatal(pubErr)
+		}
+	}
+
+	if got := syncAs(t, srv, Policy{
+		Types: []string{"decision"},
+	}); len(got) != 2 || got[0] != "a" || got[1] != "c" {
+		t.Errorf("types=decision -> %v, want [a c]", got)
+	}
+	if got := syncAs(t, srv, Policy{
+		Origins: []string{"beta"},
+	}); len(got) != 1 || got[0] != "c" {
+		t.Errorf("origins=beta -> %v, want [c]", got)
+	}
+
+	rawErr := srv.syncEntries(
+		&SyncRequest{Raw: true},
+		&Policy{Origins: []string{"beta"}},
+		func(*EntryMsg) error { return nil },
+	)
+	if status.Code(rawErr) != codes.PermissionDenied {
+		t.Errorf("filtered raw sync = %v, want PermissionDenied",
+			rawErr)
+	}
+}
+
+func TestPolicy_StatusFilters(t *testing.T) {
+	srv, _ := newCorrectionServer(t)
+	for _, pe := range []PublishEntry{
+		{ID: "a", Type: "decision", Content: "x", Origin: "alpha"},
+		{ID: "b", Type: "learning", Content: "y", Origin: "alpha"},
+		{ID: "c", Type: "decision", Content: "z", Origin: "beta"},
+	} {
+		if pubErr := publishAs(srv, Policy{}, pe); pubErr != nil {
+			t.Fatal(pubErr)
+		}
+	}
+
+	resp, statusErr := srv.hubStatus(
+		context.Background(), &Policy{Origins: []string{"beta"}},
+	)
+	if statusErr != nil {
+		t.Fatal(statusErr)
+	}
+	if resp.TotalEntries != 1 || len(resp.EntriesByProject) != 1 ||
+		resp.EntriesByProject["beta"] != 1 ||
+		resp.EntriesByType["learning"] != 0 {
+		t.Errorf("origins=beta -> %+v, want only beta's entry", resp)
+	}
+
+	resp, statusErr = srv.hubStatus(context.Background(), &Policy{})
+	if statusErr != nil {
+		t.Fatal(statusErr)
+	}
+	if resp.TotalEntries != 3 || resp.EntriesByProject["alpha"] != 2 {
+		t.Errorf("unfiltered -> %+v, want every entry", resp)
+	}
+}
+
+func TestPolicy_SetPolicy(t *testing.T) {
+	dir := t.TempDir()
+	store, storeErr := NewStore(dir)
+	if storeErr != nil {
+		t.Fatal(storeErr)
+	}
+	srv, srvErr := NewServer(store, "adm", TLSConfig{})
+	if srvErr != nil {
+		t.Fatal(srvErr)
+	}
+	if regErr := store.RegisterClient(ClientInfo{
+		ID: "c1", ProjectName: "ci", Token: "tok",
+	}); regErr != nil {
+		t.Fatal(regErr)
+	}
+
+	ctx := context.Background()
+	want := Policy{ReadOnly: true, Types: []string{"decision"}}
+	resp, setErr := srv.setPolicy(ctx, &SetPolicyRequest{
+		AdminToken: "adm", ClientID: "c1", Policy: want,
+	})
+	if setErr != nil {
+		t.Fatal(setErr)
+	}
+	if resp.ProjectName != "ci" || !resp.Policy.ReadOnly {
+		t.Errorf("setPolicy response = %+v", resp)
+	}
+
+	reopened, reopenErr := NewStore(dir)
+	if reopenErr != nil {
+		t.Fatal(reopenErr)
+	}
+	client := reopened.ValidateToken("tok")
+	if client == nil || !client.Policy.ReadOnly ||
+		len(client.Policy.Types) != 1 {
+		t.Fatalf("policy after restart = %+v", client)
+	}
+
+	tests := []struct {
+		name string
+		req  *SetPolicyRequest
+		code codes.Code
+	}{
+		{"bad admin token", &SetPolicyRequest{
+			AdminToken: "nope", ClientID: "c1",
+		}, codes.PermissionDenied},
+		{"unknown client", &SetPolicyRequest{
+			AdminToken: "adm", ClientID: "c9",
+		}, codes.NotFound},
+		{"unknown type", &SetPolicyRequest{
+			AdminToken: "adm", ClientID: "c1",
+			Policy: Policy{Publish: []string{"memo"}},
+		}, codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, callErr := srv.setPolicy(ctx, tt.req)
+			if got := status.Code(callErr); got != tt.code {
+				t.Fatalf("code = %v (%v), want %v",
+					got, callErr, tt.code)
+			}
+		})
+	}
+
+	got, getErr := srv.policy(ctx, &PolicyRequest{
+		AdminToken: "adm", ClientID: "c1",
+	})
+	if getErr != nil || !got.Policy.ReadOnly {
+		t.Errorf("policy = %+v, %v", got, getErr)
+	}
+}
+
+func TestPolicy_ListenFollowsPolicyChanges(t *testing.T) {
+	srv, store := newCorrectionServer(t)
+	if regErr := store.RegisterClient(ClientInfo{
+		ID: "c1", ProjectName: "ci", Token: "tok",
+	}); regErr != nil {
+		t.Fatal(regErr)
+	}
+
+	got := make(chan string, 8)
+	done := make(chan error, 1)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		done <- srv.listenEntries(
+			&ListenRequest{}, "c1", func(m *EntryMsg) error {
+				got <- m.ID
+				return nil
+			}, ctx,
+		)
+	}()
+	for srv.listeners.count() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+
+	publish := func(id, typ string) {
+		t.Helper()
+		if pubErr := publishAs(srv, Policy{}, PublishEntry{
+			ID: id, Type: typ, Content: id,
+		}); pubErr != nil {
+			t.Fatal(pubErr)
+		}
+	}
+	next := func() string {
+		t.Helper()
+		select {
+		case id := <-got:
+			return id
+		case <-time.After(5 * time.Second):
+			t.Fatal("no entry received")
+			return ""
+		}
+	}
+
+	publish("d1", "decision")
+	if id := next(); id != "d1" {
+		t.Fatalf("first entry = %q, want d1", id)
+	}
+
+	if _, setErr := store.SetPolicy(
+		"c1", Policy{Types: []string{"learning"}},
+	); setErr != nil {
+		t.Fatal(setErr)
+	}
+	publish("d2", "decision")
+	publish("l1", "learning")
+	if id := next(); id != "l1" {
+		t.Fatalf("after narrowing, got %q, want l1 (d2 filtered)", id)
+	}
+
+	if revokeErr := store.RevokeClient("c1"); revokeErr != nil {
+		t.Fatal(revokeErr)
+	}
+	publish("l2", "learning")
+	select {
+	case listenErr := <-done:
+		if status.Code(listenErr) != codes.Unauthenticated {
+			t.Fatalf("listen after revoke = %v", listenErr)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("stream not ended after revoke")
+	}
+}
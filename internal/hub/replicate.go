@@ -17,7 +17,8 @@ import (
 	logWarn "github.com/ActiveMemory/ctx/internal/log/warn"
 
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // Reserved for cluster mode: startReplication will be
@@ -34,11 +35,13 @@ var _ = startReplication
 //   - masterAddr: gRPC address of the master hub
 //   - store: local store to write replicated entries
 //   - clientToken: bearer token for auth
+//   - tlsCfg: transport security for the master link
 func startReplication(
 	ctx context.Context,
 	masterAddr string,
 	store *Store,
 	clientToken string,
+	tlsCfg TLSConfig,
 ) {
 	for {
 		select {
@@ -48,7 +51,7 @@ func startReplication(
 		}
 
 		replicateOnce(
-			ctx, masterAddr, store, clientToken,
+			ctx, masterAddr, store, clientToken, tlsCfg,
 		)
 
 		select {
@@ -61,27 +64,24 @@ func startReplication(
 
 // replicateOnce connects to the master, syncs all entries
 // since the local store's last sequence, and appends them.
+// When the master has compacted past that sequence, the
+// follower bootstraps from the master's snapshot instead
+// and picks up the tail on the next cycle.
 //
 // Parameters:
 //   - ctx: context for cancellation
 //   - masterAddr: gRPC address of the master hub
 //   - store: local store to write replicated entries
 //   - clientToken: bearer token for auth
+//   - tlsCfg: transport security for the master link
 func replicateOnce(
 	ctx context.Context,
 	masterAddr string,
 	store *Store,
 	clientToken string,
+	tlsCfg TLSConfig,
 ) {
-	conn, dialErr := grpc.NewClient(
-		masterAddr,
-		grpc.WithTransportCredentials(
-			insecure.NewCredentials(),
-		),
-		grpc.WithDefaultCallOptions(
-			grpc.CallContentSubtype(codecName),
-		),
-	)
+	conn, dialErr := dial(masterAddr, tlsCfg)
 	if dialErr != nil {
 		logWarn.Warn(cfgWarn.HubReplicateDial, masterAddr, dialErr)
 		return
@@ -107,6 +107,7 @@ func replicateOnce(
 
 	if sendErr := stream.SendMsg(&SyncRequest{
 		SinceSequence: lastSeq,
+		Raw:           true,
 	}); sendErr != nil {
 		logWarn.Warn(cfgWarn.HubReplicateSend, masterAddr, sendErr)
 		return
@@ -128,6 +129,17 @@ func replicateOnce(
 			// is still suppressed here — the lenient polarity this
 			// warn-suppression path wants, opposite to eof's
 			// strict clean-end checks in client.go.
+			if status.Code(recvErr) == codes.OutOfRange {
+				if bootErr := bootstrap(
+					authed, conn, store,
+				); bootErr != nil {
+					logWarn.Warn(
+						cfgWarn.HubReplicateSnapshot,
+						masterAddr, bootErr,
+					)
+				}
+				return
+			}
 			if !errors.Is(recvErr, stdio.EOF) && ctx.Err() == nil {
 				logWarn.Warn(
 					cfgWarn.HubReplicateRecv, masterAddr, recvErr,
@@ -135,15 +147,7 @@ func replicateOnce(
 			}
 			return
 		}
-		entry := Entry{
-			ID:        msg.ID,
-			Type:      msg.Type,
-			Content:   msg.Content,
-			Origin:    msg.Origin,
-			Meta:      msg.Meta,
-			Timestamp: time.Unix(msg.Timestamp, 0),
-			Sequence:  msg.Sequence,
-		}
+		entry := msgToEntry(msg)
 		if _, appendErr := store.Append([]Entry{entry}); appendErr != nil {
 			logWarn.Warn(cfgWarn.HubReplicateAppend, appendErr)
 		}
@@ -41,7 +41,12 @@ func startMaster(t *testing.T) (*Store, string, string) {
 	if tokErr != nil {
 		t.Fatal(tokErr)
 	}
-	srv := NewServer(store, adminTok)
+	srv, srvErr := NewServer(
+		store, adminTok, TLSConfig{},
+	)
+	if srvErr != nil {
+		t.Fatal(srvErr)
+	}
 	lis := listenRandom(t)
 	go func() { _ = srv.Serve(lis) }()
 	t.Cleanup(srv.GracefulStop)
@@ -51,7 +56,7 @@ func startMaster(t *testing.T) (*Store, string, string) {
 // registerClient registers a project and returns its token.
 func registerClient(t *testing.T, addr, adminTok string) string {
 	t.Helper()
-	client, dialErr := NewClient(addr, "")
+	client, dialErr := NewClient(addr, "", TLSConfig{})
 	if dialErr != nil {
 		t.Fatal(dialErr)
 	}
@@ -98,7 +103,7 @@ func TestReplicateOnce_WarnsOnDialError(t *testing.T) {
 	// a control character fails URL parsing at construction —
 	// the one eager failure mode, and exactly what a corrupted
 	// peer config would produce.
-	replicateOnce(testCtx(), "\x00", follower, "tok")
+	replicateOnce(testCtx(), "\x00", follower, "tok", TLSConfig{})
 
 	if !strings.Contains(buf.String(), "hub replicate dial") {
 		t.Errorf("missing dial warning, got: %q", buf.String())
@@ -125,7 +130,7 @@ func TestReplicateOnce_WarnsOnTransportError(t *testing.T) {
 		t.Fatal(closeErr)
 	}
 
-	replicateOnce(testCtx(), addr, follower, "tok")
+	replicateOnce(testCtx(), addr, follower, "tok", TLSConfig{})
 
 	if !strings.Contains(buf.String(), "hub replicate") {
 		t.Errorf(
@@ -145,7 +150,7 @@ func TestReplicateOnce_CleanReplicationDoesNotWarn(t *testing.T) {
 	}
 	buf := captureWarnings(t)
 
-	replicateOnce(testCtx(), addr, follower, token)
+	replicateOnce(testCtx(), addr, follower, token, TLSConfig{})
 
 	if buf.Len() != 0 {
 		t.Errorf(
@@ -188,7 +193,7 @@ func TestReplicateOnce_KeepsConsumingAfterAppendError(t *testing.T) {
 	})
 	buf := captureWarnings(t)
 
-	replicateOnce(testCtx(), addr, follower, token)
+	replicateOnce(testCtx(), addr, follower, token, TLSConfig{})
 
 	// Both entries must have been attempted: an append failure
 	// is warned per entry and must not abort the stream.
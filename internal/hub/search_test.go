@@ -0,0 +1,220 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package hub
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	cfgHub "github.com/ActiveMemory/ctx/internal/config/hub"
+)
+
+// day is a fixed timestamp base for range filters.
+var day = time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+
+// seedSearch appends a small mixed log to store.
+func seedSearch(t *testing.T, store *Store) {
+	t.Helper()
+	appendEach(t, store,
+		Entry{
+			ID: "a", Type: "decision", Origin: "alpha",
+			Content:   "Retry hub publishes with backoff",
+			Timestamp: day,
+		},
+		Entry{
+			ID: "b", Type: "learning", Origin: "beta",
+			Content:   "Retries hide flaky DNS",
+			Timestamp: day.AddDate(0, 0, 1),
+		},
+		Entry{
+			ID: "c", Type: "decision", Origin: "beta",
+			Content:   "retry: never on 4xx",
+			Timestamp: day.AddDate(0, 0, 2),
+		},
+		Entry{
+			ID: "d", Type: "convention", Origin: "alpha",
+			Content:   "Use gofmt",
+			Timestamp: day.AddDate(0, 0, 3),
+		},
+	)
+}
+
+// searchIDs runs a search through the handler and returns
+// the matching IDs.
+func searchIDs(
+	t *testing.T, srv *Server, pol Policy, req SearchRequest,
+) []string {
+	t.Helper()
+	resp, searchErr := srv.search(context.Background(), &pol, &req)
+	if searchErr != nil {
+		t.Fatal(searchErr)
+	}
+	out := make([]string, len(resp.Entries))
+	for i := range resp.Entries {
+		out[i] = resp.Entries[i].ID
+	}
+	return out
+}
+
+func TestSearch_Filters(t *testing.T) {
+	srv, store := newCorrectionServer(t)
+	seedSearch(t, store)
+
+	tests := []struct {
+		name string
+		req  SearchRequest
+		want string
+	}{
+		{"empty returns newest first", SearchRequest{}, "d,c,b,a"},
+		{"word", SearchRequest{Text: "RETRY"}, "c,a"},
+		{"all words", SearchRequest{Text: "retry backoff"}, "a"},
+		{"unknown word", SearchRequest{Text: "retry zebra"}, ""},
+		{"type", SearchRequest{
+			Text: "retry", Types: []string{"decision"},
+		}, "c,a"},
+		{"origin", SearchRequest{
+			Origins: []string{"beta"},
+		}, "c,b"},
+		{"since", SearchRequest{
+			Since: day.AddDate(0, 0, 2).Unix(),
+		}, "d,c"},
+		{"until", SearchRequest{
+			Until: day.AddDate(0, 0, 1).Unix(),
+		}, "b,a"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := strings.Join(
+				searchIDs(t, srv, Policy{}, tt.req), ",",
+			)
+			if got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSearch_CorrectionsAndPolicy(t *testing.T) {
+	srv, store := newCorrectionServer(t)
+	seedSearch(t, store)
+	if pubErr := publishOne(srv, PublishEntry{
+		ID: "s", Type: "decision", Content: "Retry twice, then fail",
+		Kind: cfgHub.KindSupersede, Ref: "c", Reason: "too strict",
+	}); pubErr != nil {
+		t.Fatal(pubErr)
+	}
+	if pubErr := publishOne(srv, PublishEntry{
+		ID: "r", Kind: cfgHub.KindRetract, Ref: "a",
+		Reason: "wrong",
+	}); pubErr != nil {
+		t.Fatal(pubErr)
+	}
+
+	got := searchIDs(t, srv, Policy{}, SearchRequest{Text: "retry"})
+	if strings.Join(got, ",") != "s" {
+		t.Errorf("corrections-aware search = %v, want [s]", got)
+	}
+
+	pol := Policy{Types: []string{"learning"}}
+	got = searchIDs(t, srv, pol, SearchRequest{})
+	if strings.Join(got, ",") != "b" {
+		t.Errorf("policy-filtered search = %v, want [b]", got)
+	}
+}
+
+func TestSearch_LimitAndValidation(t *testing.T) {
+	srv, store := newCorrectionServer(t)
+	seedSearch(t, store)
+
+	resp, searchErr := srv.search(
+		context.Background(), &Policy{}, &SearchRequest{Limit: 2},
+	)
+	if searchErr != nil {
+		t.Fatal(searchErr)
+	}
+	if len(resp.Entries) != 2 || !resp.More {
+		t.Errorf("limit 2: %d entries, more %v",
+			len(resp.Entries), resp.More)
+	}
+
+	for name, req := range map[string]SearchRequest{
+		"negative limit": {Limit: -1},
+		"limit too big":  {Limit: cfgHub.SearchMax + 1},
+		"oversized text": {
+			Text: strings.Repeat("x", cfgHub.MaxSearchLen+1),
+		},
+		"inverted range": {Since: 20, Until: 10},
+	} {
+		_, reqErr := srv.search(context.Background(), &Policy{}, &req)
+		if status.Code(reqErr) != codes.InvalidArgument {
+			t.Errorf("%s: got %v, want InvalidArgument",
+				name, reqErr)
+		}
+	}
+}
+
+func TestSearch_IndexSurvivesCompactionAndRestart(t *testing.T) {
+	dir := t.TempDir()
+	store, storeErr := NewStore(dir)
+	if storeErr != nil {
+		t.Fatal(storeErr)
+	}
+	seedSearch(t, store)
+	if compactErr := store.Compact(); compactErr != nil {
+		t.Fatal(compactErr)
+	}
+	appendEach(t, store, Entry{
+		ID: "e", Type: "learning", Content: "Retry storms", Timestamp: day,
+	})
+
+	keepAll := func(*Entry) bool { return true }
+	for _, s := range []*Store{store, reopen(t, dir)} {
+		got, _ := s.Search(
+			&SearchRequest{Text: "retry", Limit: 10}, keepAll,
+		)
+		if strings.Join(ids(got), ",") != "e,c,a" {
+			t.Errorf("search after compaction = %v", ids(got))
+		}
+	}
+}
+
+func TestSearch_ClientRoundTrip(t *testing.T) {
+	master, addr, adminTok := startMaster(t)
+	seedSearch(t, master)
+	token := registerClient(t, addr, adminTok)
+
+	client, dialErr := NewClient(addr, token, TLSConfig{})
+	if dialErr != nil {
+		t.Fatal(dialErr)
+	}
+	defer func() { _ = client.Close() }()
+
+	resp, searchErr := client.Search(testCtx(), &SearchRequest{
+		Text: "gofmt",
+	})
+	if searchErr != nil {
+		t.Fatal(searchErr)
+	}
+	if len(resp.Entries) != 1 || resp.Entries[0].ID != "d" {
+		t.Errorf("client search = %+v", resp.Entries)
+	}
+}
+
+// reopen loads a fresh Store from dir.
+func reopen(t *testing.T, dir string) *Store {
+	t.Helper()
+	s, openErr := NewStore(dir)
+	if openErr != nil {
+		t.Fatal(openErr)
+	}
+	return s
+}
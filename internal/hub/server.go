@@ -7,31 +7,60 @@
 package hub
 
 import (
+	"context"
+	"errors"
 	"net"
+	"net/http"
+	"time"
+
+	cfgHub "github.com/ActiveMemory/ctx/internal/config/hub"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 )
 
 // NewServer creates a hub server backed by the given store.
 //
+// The zero [TLSConfig] serves plaintext gRPC. A certificate
+// and key switch the listener to TLS; adding a CA bundle
+// requires every client to present a certificate signed
+// by it (mutual TLS).
+//
 // Parameters:
 //   - store: append-only storage backend
 //   - adminToken: token required for Register RPC
+//   - tlsCfg: transport security settings
 //
 // Returns:
 //   - *Server: configured server (call Serve to start)
-func NewServer(store *Store, adminToken string) *Server {
+//   - error: non-nil if certificate material fails to load
+func NewServer(
+	store *Store, adminToken string, tlsCfg TLSConfig,
+) (*Server, error) {
 	s := &Server{
 		store:      store,
 		adminToken: adminToken,
 		listeners:  newFanOut(),
+		followers:  newFollowerSet(),
 	}
 
-	gs := grpc.NewServer()
+	var opts []grpc.ServerOption
+	if tlsEnabled(tlsCfg) {
+		srvTLS, tlsErr := serverTLS(tlsCfg)
+		if tlsErr != nil {
+			return nil, tlsErr
+		}
+		opts = append(opts, grpc.Creds(
+			credentials.NewTLS(srvTLS),
+		))
+		s.tls = srvTLS
+	}
+
+	gs := grpc.NewServer(opts...)
 	registerService(gs, s)
 	s.grpc = gs
 
-	return s
+	return s, nil
 }
 
 // Serve starts the gRPC server on the given listener.
@@ -45,6 +74,57 @@ func (s *Server) Serve(lis net.Listener) error {
 	return s.grpc.Serve(lis)
 }
 
+// ServeAdmin starts the read-only HTTP admin gateway on the
+// given listener. Blocks until the gateway stops.
+//
+// JSON routes under /v1 (status, clients, entries, leader)
+// require the admin token as a bearer token; /metrics
+// serves Prometheus text without auth, since it carries
+// only counts. When the hub runs TLS, the gateway uses the
+// same certificate and, with a CA bundle, the same client
+// certificate requirement.
+//
+// Parameters:
+//   - lis: network listener for the gateway
+//
+// The gateway is registered with the server before it
+// starts serving, so a concurrent GracefulStop always
+// shuts it down; after GracefulStop the listener is closed
+// without serving.
+//
+// Returns:
+//   - error: nil after GracefulStop, otherwise the serve
+//     failure
+func (s *Server) ServeAdmin(lis net.Listener) error {
+	srv := &http.Server{
+		Handler: s.adminMux(),
+		ReadHeaderTimeout: cfgHub.AdminReadHeaderTimeout *
+			time.Second,
+		TLSConfig: s.tls,
+	}
+	s.adminMu.Lock()
+	if s.stopped {
+		s.adminMu.Unlock()
+		// Acceptable discard: the server is already stopped;
+		// the listener is only released, never served.
+		_ = lis.Close()
+		return nil
+	}
+	s.admin = srv
+	s.adminMu.Unlock()
+
+	var serveErr error
+	if s.tls != nil {
+		serveErr = srv.ServeTLS(lis, "", "")
+	} else {
+		serveErr = srv.Serve(lis)
+	}
+	if errors.Is(serveErr, http.ErrServerClosed) {
+		return nil
+	}
+	return serveErr
+}
+
 // SetCluster attaches a Raft cluster to the server for
 // leadership awareness.
 //
@@ -61,5 +141,18 @@ func (s *Server) GracefulStop() {
 		// graceful stop is not actionable by the caller.
 		_ = s.cluster.Shutdown()
 	}
+	s.adminMu.Lock()
+	s.stopped = true
+	admin := s.admin
+	s.adminMu.Unlock()
+	if admin != nil {
+		ctx, cancel := context.WithTimeout(
+			context.Background(),
+			cfgHub.AdminShutdownTimeout*time.Second,
+		)
+		defer cancel()
+		// Acceptable discard: best-effort teardown, as above.
+		_ = admin.Shutdown(ctx)
+	}
 	s.grpc.GracefulStop()
 }
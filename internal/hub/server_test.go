@@ -34,7 +34,12 @@ func startTestServer(
 		t.Fatal(err)
 	}
 
-	srv := NewServer(store, adminTok)
+	srv, srvErr := NewServer(
+		store, adminTok, TLSConfig{},
+	)
+	if srvErr != nil {
+		t.Fatal(srvErr)
+	}
 	lis, lisErr := net.Listen("tcp", "127.0.0.1:0")
 	if lisErr != nil {
 		t.Fatal(lisErr)
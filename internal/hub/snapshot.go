@@ -0,0 +1,88 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package hub
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	cfgHub "github.com/ActiveMemory/ctx/internal/config/hub"
+)
+
+// recvSnapshot runs the Snapshot RPC on conn and reassembles
+// the streamed chunks.
+//
+// Parameters:
+//   - ctx: context carrying bearer metadata
+//   - conn: connection to the hub
+//
+// Returns:
+//   - *Snapshot: reassembled snapshot image
+//   - error: non-nil if the stream fails
+func recvSnapshot(
+	ctx context.Context, conn *grpc.ClientConn,
+) (*Snapshot, error) {
+	stream, streamErr := conn.NewStream(
+		ctx,
+		&grpc.StreamDesc{ServerStreams: true},
+		cfgHub.PathSnapshot,
+	)
+	if streamErr != nil {
+		return nil, streamErr
+	}
+	if sendErr := stream.SendMsg(
+		&SnapshotRequest{},
+	); sendErr != nil {
+		return nil, sendErr
+	}
+	if closeErr := stream.CloseSend(); closeErr != nil {
+		return nil, closeErr
+	}
+
+	snap := &Snapshot{}
+	for first := true; ; first = false {
+		chunk := &SnapshotChunk{}
+		if recvErr := stream.RecvMsg(chunk); recvErr != nil {
+			if eof(recvErr) {
+				return snap, nil
+			}
+			return nil, recvErr
+		}
+		if first {
+			snap.Sequence = chunk.Sequence
+			snap.Tombstones = chunk.Tombstones
+		}
+		for i := range chunk.Entries {
+			snap.Entries = append(
+				snap.Entries, msgToEntry(&chunk.Entries[i]),
+			)
+		}
+	}
+}
+
+// bootstrap replaces the local store with the master's
+// snapshot. Called when the master reports the follower's
+// cursor as compacted; the next replication cycle syncs
+// the tail after the snapshot.
+//
+// Parameters:
+//   - ctx: context carrying bearer metadata
+//   - conn: connection to the master hub
+//   - store: local store to restore into
+//
+// Returns:
+//   - error: non-nil if the fetch or restore fails
+func bootstrap(
+	ctx context.Context, conn *grpc.ClientConn, store *Store,
+) error {
+	snap, fetchErr := recvSnapshot(ctx, conn)
+	if fetchErr != nil {
+		return fetchErr
+	}
+	return store.Restore(snap)
+}
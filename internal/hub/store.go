@@ -8,10 +8,10 @@ package hub
 
 import (
 	"crypto/subtle"
-	"encoding/json"
+	"os"
 
 	"github.com/ActiveMemory/ctx/internal/config/fs"
-	"github.com/ActiveMemory/ctx/internal/config/token"
+	cfgHub "github.com/ActiveMemory/ctx/internal/config/hub"
 	errHub "github.com/ActiveMemory/ctx/internal/err/hub"
 	"github.com/ActiveMemory/ctx/internal/io"
 )
@@ -19,8 +19,9 @@ import (
 // NewStore creates or opens a Store in the given directory.
 //
 // On first run, creates the directory and initializes empty
-// data files. On subsequent runs, loads existing entries,
-// clients, and metadata.
+// data files. On subsequent runs, loads clients, metadata,
+// and the entry log: the latest snapshot, then any sealed
+// segments, then the active file.
 //
 // Parameters:
 //   - dir: directory path for data files
@@ -34,8 +35,10 @@ func NewStore(dir string) (*Store, error) {
 	}
 
 	s := &Store{
-		dir:      dir,
-		tokenIdx: make(map[string]int),
+		dir:             dir,
+		tokenIdx:        make(map[string]int),
+		segmentEntries:  cfgHub.SegmentEntries,
+		compactSegments: cfgHub.CompactSegments,
 	}
 
 	if loadErr := loadJSON(metaPath(dir), &s.meta); loadErr != nil {
@@ -46,7 +49,7 @@ func NewStore(dir string) (*Store, error) {
 	); loadErr != nil {
 		return nil, loadErr
 	}
-	if loadErr := loadEntries(dir, &s.entries); loadErr != nil {
+	if loadErr := s.load(); loadErr != nil {
 		return nil, loadErr
 	}
 
@@ -61,7 +64,10 @@ func NewStore(dir string) (*Store, error) {
 // Append adds entries to the store, assigning sequence numbers.
 //
 // Each entry gets the next monotonic sequence number. Entries
-// are appended to the JSONL file and metadata is updated.
+// are appended to the active JSONL file and metadata is
+// updated. Once the active file reaches the segment
+// threshold it is sealed, and enough sealed segments are
+// compacted into a snapshot.
 //
 // Parameters:
 //   - entries: entries to append (Sequence is overwritten)
@@ -72,40 +78,76 @@ func NewStore(dir string) (*Store, error) {
 func (s *Store) Append(entries []Entry) ([]uint64, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
+	return s.appendLocked(entries)
+}
 
-	sequences := make([]uint64, len(entries))
-	var lines []byte
+// Compact seals the active file and writes a snapshot of
+// the whole log, dropping retracted and superseded entries.
+//
+// Correction records survive so readers holding a dropped
+// entry still learn it was withdrawn. Appends compact on
+// their own once enough segments are sealed; Compact forces
+// one, for example before a backup.
+//
+// Returns:
+//   - error: non-nil if the snapshot cannot be written
+func (s *Store) Compact() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
 
-	for i := range entries {
-		s.meta.SequenceCounter++
-		entries[i].Sequence = s.meta.SequenceCounter
-		sequences[i] = s.meta.SequenceCounter
+	return s.compact()
+}
 
-		b, marshalErr := json.Marshal(entries[i])
-		if marshalErr != nil {
-			return nil, marshalErr
-		}
-		lines = append(lines, b...)
-		lines = append(lines, token.NewlineLF...)
-		s.entries = append(s.entries, entries[i])
-	}
+// Restore replaces the whole log with a snapshot fetched
+// from the leader.
+//
+// Used by a follower whose cursor predates the leader's
+// snapshot. The snapshot is written first, then the local
+// segments and active file are removed and the sequence
+// counter jumps to the snapshot's, so the next raw Sync
+// resumes exactly where the snapshot ends.
+//
+// Parameters:
+//   - snap: snapshot image to install
+//
+// Returns:
+//   - error: non-nil if any file operation fails
+func (s *Store) Restore(snap *Snapshot) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
 
-	if appendErr := appendFile(
-		entriesPath(s.dir), lines,
-	); appendErr != nil {
-		return nil, appendErr
+	if saveErr := saveJSONAtomic(
+		snapshotPath(s.dir), snap,
+	); saveErr != nil {
+		return saveErr
 	}
-
+	if dropErr := s.dropSegments(); dropErr != nil {
+		return dropErr
+	}
+	if rmErr := os.Remove(
+		entriesPath(s.dir),
+	); rmErr != nil && !os.IsNotExist(rmErr) {
+		return rmErr
+	}
+	s.meta.SequenceCounter = snap.Sequence
 	if saveErr := saveJSON(
 		metaPath(s.dir), s.meta,
 	); saveErr != nil {
-		return nil, saveErr
+		return saveErr
 	}
-
-	return sequences, nil
+	s.install(snap)
+	return nil
 }
 
-// Query returns entries matching types since a sequence.
+// Query returns entries matching types since a sequence,
+// honoring corrections.
+//
+// Entries that were retracted or superseded are omitted.
+// A retraction is omitted too when its target falls in
+// the same window, so a reader starting fresh sees
+// neither; a reader that already holds the target gets
+// the retraction and can mark its copy. Supersede records
+// are always returned because they carry the replacement.
 //
 // Parameters:
 //   - types: entry types to include (empty = all types)
@@ -116,25 +158,72 @@ func (s *Store) Append(entries []Entry) ([]uint64, error) {
 func (s *Store) Query(
 	types []string, sinceSequence uint64,
 ) []Entry {
+	return s.query(types, sinceSequence, false)
+}
+
+// Log returns entries matching types since a sequence
+// exactly as appended, corrected entries included.
+//
+// Replication reads through this view so followers
+// assign the same sequence numbers as the leader.
+//
+// Parameters:
+//   - types: entry types to include (empty = all types)
+//   - sinceSequence: entries with sequence > this value
+//
+// Returns:
+//   - []Entry: matching entries in sequence order
+func (s *Store) Log(
+	types []string, sinceSequence uint64,
+) []Entry {
+	return s.query(types, sinceSequence, true)
+}
+
+// Search returns the newest entries matching a search
+// request, using the term index when the request has text.
+//
+// Every word of the text must appear in an entry's
+// content; matching is case-insensitive and on whole
+// words. Results follow the corrections-aware view of
+// Query: retracted and superseded entries and retraction
+// records are left out.
+//
+// Parameters:
+//   - req: search filters; Limit must already be resolved
+//   - keep: extra per-entry filter, e.g. the caller's
+//     read policy
+//
+// Returns:
+//   - []Entry: up to req.Limit matches, newest first
+//   - bool: true when more entries matched past the limit
+func (s *Store) Search(
+	req *SearchRequest, keep func(*Entry) bool,
+) ([]Entry, bool) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	typeSet := make(map[string]bool, len(types))
-	for _, t := range types {
-		typeSet[t] = true
+	var positions []int
+	if terms := searchTerms(req.Text); len(terms) > 0 {
+		positions = s.candidates(terms)
+	} else {
+		positions = make([]int, len(s.entries))
+		for i := range positions {
+			positions[i] = i
+		}
 	}
 
-	var result []Entry
-	for _, e := range s.entries {
-		if e.Sequence <= sinceSequence {
+	var out []Entry
+	for i := len(positions) - 1; i >= 0; i-- {
+		e := &s.entries[positions[i]]
+		if !s.matches(e, req) || !keep(e) {
 			continue
 		}
-		if len(typeSet) > 0 && !typeSet[e.Type] {
-			continue
+		if len(out) == req.Limit {
+			return out, true
 		}
-		result = append(result, e)
+		out = append(out, *e)
 	}
-	return result
+	return out, false
 }
 
 // RegisterClient adds a client to the registry.
@@ -202,6 +291,77 @@ func (s *Store) RevokeClient(id string) error {
 	return errHub.UnknownClient(id)
 }
 
+// Client returns a registered client by ID.
+//
+// Parameters:
+//   - id: ID of the client
+//
+// Returns:
+//   - ClientInfo: copy of the client
+//   - error: errHub.UnknownClient if no client has the ID
+func (s *Store) Client(id string) (ClientInfo, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i := range s.clients {
+		if s.clients[i].ID == id {
+			return s.clients[i], nil
+		}
+	}
+	return ClientInfo{}, errHub.UnknownClient(id)
+}
+
+// Clients returns every registered client.
+//
+// Returns:
+//   - []ClientInfo: copy of the registry in registration
+//     order, tokens included; callers strip them before
+//     exposing the list
+func (s *Store) Clients() []ClientInfo {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	out := make([]ClientInfo, len(s.clients))
+	copy(out, s.clients)
+	return out
+}
+
+// SetPolicy replaces a client's access policy.
+//
+// The registry file is rewritten atomically, like
+// RevokeClient, and the new policy applies to the next
+// RPC the client makes. Open Listen streams pick it up at
+// their next broadcast batch.
+//
+// Parameters:
+//   - id: ID of the client
+//   - p: new policy
+//
+// Returns:
+//   - ClientInfo: copy of the updated client
+//   - error: errHub.UnknownClient if no client has the ID,
+//     or a persistence error if the rewrite fails
+func (s *Store) SetPolicy(id string, p Policy) (ClientInfo, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i := range s.clients {
+		if s.clients[i].ID != id {
+			continue
+		}
+		prev := s.clients[i].Policy
+		s.clients[i].Policy = p
+		if saveErr := saveJSONAtomic(
+			clientsPath(s.dir), s.clients,
+		); saveErr != nil {
+			s.clients[i].Policy = prev
+			return ClientInfo{}, saveErr
+		}
+		return s.clients[i], nil
+	}
+	return ClientInfo{}, errHub.UnknownClient(id)
+}
+
 // ValidateToken checks if a token matches a registered
 // client using constant-time comparison.
 //
@@ -209,7 +369,8 @@ func (s *Store) RevokeClient(id string) error {
 //   - bearerToken: bearer token to validate
 //
 // Returns:
-//   - *ClientInfo: matching client, or nil if not found
+//   - *ClientInfo: copy of the matching client, or nil if
+//     not found
 func (s *Store) ValidateToken(bearerToken string) *ClientInfo {
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -224,7 +385,8 @@ func (s *Store) ValidateToken(bearerToken string) *ClientInfo {
 	) != 1 {
 		return nil
 	}
-	return &s.clients[idx]
+	client := s.clients[idx]
+	return &client
 }
 
 // Stats returns current hub statistics.
@@ -236,16 +398,5 @@ func (s *Store) ValidateToken(bearerToken string) *ClientInfo {
 func (s *Store) Stats() (
 	uint64, map[string]uint64, map[string]uint64,
 ) {
-	s.mu.Lock()
-	defer s.mu.Unlock()
-
-	byType := make(map[string]uint64)
-	byProject := make(map[string]uint64)
-
-	for _, e := range s.entries {
-		byType[e.Type]++
-		byProject[e.Origin]++
-	}
-
-	return uint64(len(s.entries)), byType, byProject
+	return s.stats(nil)
 }
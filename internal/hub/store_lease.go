@@ -0,0 +1,239 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package hub
+
+import (
+	"encoding/json"
+	"slices"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	cfgEntry "github.com/ActiveMemory/ctx/internal/config/entry"
+	"github.com/ActiveMemory/ctx/internal/config/fs"
+	cfgHub "github.com/ActiveMemory/ctx/internal/config/hub"
+	"github.com/ActiveMemory/ctx/internal/config/token"
+	"github.com/ActiveMemory/ctx/internal/io"
+)
+
+// grant appends a publish batch after checking its fleet
+// entries against the current task leases and its
+// corrections against the targets already corrected, all
+// under one lock so two agents racing for a task, or two
+// publishers correcting the same entry, cannot both win.
+// Claims and assigns are stamped with an expiry from now.
+//
+// Parameters:
+//   - entries: validated batch (Sequence and, on fleet
+//     entries, Lease.Expires are overwritten)
+//   - now: acceptance time
+//
+// Returns:
+//   - []uint64: assigned sequence numbers
+//   - error: FailedPrecondition when a lease conflicts or
+//     a correction's target was already corrected, or
+//     non-nil if file operations fail
+func (s *Store) grant(
+	entries []Entry, now time.Time,
+) ([]uint64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	held := make(map[string]Entry, len(s.leases))
+	for task, e := range s.leases {
+		held[task] = e
+	}
+	for i := range entries {
+		e := &entries[i]
+		if refErr := s.refOpenLocked(e); refErr != nil {
+			return nil, refErr
+		}
+		if !cfgEntry.FleetTypes[e.Type] {
+			continue
+		}
+		if leaseErr := checkLease(held, e, now); leaseErr != nil {
+			return nil, leaseErr
+		}
+		if e.Type != cfgEntry.Release {
+			e.Lease.Expires = now.Unix() + e.Lease.TTL
+		}
+		applyLease(held, e)
+	}
+	return s.appendLocked(entries)
+}
+
+// appendLocked writes entries to the active file, assigns
+// their sequence numbers, and indexes them. Caller must
+// hold s.mu.
+//
+// Parameters:
+//   - entries: entries to append (Sequence is overwritten)
+//
+// Returns:
+//   - []uint64: assigned sequence numbers
+//   - error: non-nil if file operations fail
+func (s *Store) appendLocked(entries []Entry) ([]uint64, error) {
+	sequences := make([]uint64, len(entries))
+	var lines []byte
+
+	for i := range entries {
+		s.meta.SequenceCounter++
+		entries[i].Sequence = s.meta.SequenceCounter
+		sequences[i] = s.meta.SequenceCounter
+
+		b, marshalErr := json.Marshal(entries[i])
+		if marshalErr != nil {
+			return nil, marshalErr
+		}
+		lines = append(lines, b...)
+		lines = append(lines, token.NewlineLF...)
+		s.entries = append(s.entries, entries[i])
+		s.indexEntry(len(s.entries) - 1)
+	}
+
+	if appendErr := io.AppendBytes(
+		entriesPath(s.dir), lines, fs.PermFile,
+	); appendErr != nil {
+		return nil, appendErr
+	}
+	s.active += len(entries)
+
+	if saveErr := saveJSON(
+		metaPath(s.dir), s.meta,
+	); saveErr != nil {
+		return nil, saveErr
+	}
+
+	s.housekeep()
+	return sequences, nil
+}
+
+// checkLease decides whether a fleet entry may be
+// accepted given the leases in force. A claim fails while
+// another agent holds an unexpired lease; renewing one's
+// own claim is allowed. An assign always succeeds: it is
+// the host's manual override. A release must come from the
+// current holder of a live lease.
+//
+// Lease.Agent is self-asserted: agents sharing a client
+// token are trusted to name themselves. "The holder" is
+// therefore the agent ID on the client the lease was
+// granted to: a renewal or release naming the right agent
+// from a different client is refused. Leases granted
+// before the hub stamped Lease.Client match any client.
+//
+// Parameters:
+//   - held: task key to granting entry
+//   - e: fleet entry to check
+//   - now: acceptance time
+//
+// Returns:
+//   - error: FailedPrecondition describing the conflict
+func checkLease(held map[string]Entry, e *Entry, now time.Time) error {
+	cur, ok := held[e.Lease.Task]
+	live := ok && cur.Lease.Expires > now.Unix()
+	switch e.Type {
+	case cfgEntry.Claim:
+		if live && cur.Lease.Agent == e.Lease.Agent &&
+			!sameClient(&cur, e) {
+			return status.Errorf(
+				codes.FailedPrecondition, cfgHub.ErrTaskHeldClient,
+				e.Lease.Task, cur.Lease.Agent,
+			)
+		}
+		if live && cur.Lease.Agent != e.Lease.Agent {
+			return status.Errorf(
+				codes.FailedPrecondition, cfgHub.ErrTaskHeld,
+				e.Lease.Task, cur.Lease.Agent,
+				time.Unix(cur.Lease.Expires, 0).UTC().Format(
+					time.RFC3339,
+				),
+			)
+		}
+	case cfgEntry.Release:
+		if !live {
+			return status.Errorf(
+				codes.FailedPrecondition,
+				cfgHub.ErrTaskNotHeld, e.Lease.Task,
+			)
+		}
+		if cur.Lease.Agent != e.Lease.Agent {
+			return status.Errorf(
+				codes.FailedPrecondition,
+				cfgHub.ErrTaskHeldOther, e.Lease.Task,
+				cur.Lease.Agent, e.Lease.Agent,
+			)
+		}
+		if !sameClient(&cur, e) {
+			return status.Errorf(
+				codes.FailedPrecondition, cfgHub.ErrTaskHeldClient,
+				e.Lease.Task, cur.Lease.Agent,
+			)
+		}
+	}
+	return nil
+}
+
+// sameClient reports whether a fleet entry comes from the
+// client a lease was granted to. A lease without a stamped
+// client predates client binding and matches any client.
+//
+// Parameters:
+//   - cur: the granting claim or assign
+//   - e: the fleet entry being checked
+//
+// Returns:
+//   - bool: true when e may act on cur's lease
+func sameClient(cur, e *Entry) bool {
+	return cur.Lease.Client == "" || cur.Lease.Client == e.Lease.Client
+}
+
+// liveLeases returns the claim and assign records in
+// force at now that keep accepts, sorted by task key.
+//
+// Parameters:
+//   - now: time to judge expiry against
+//   - keep: read filter (the caller's policy)
+//
+// Returns:
+//   - []Entry: live lease records
+func (s *Store) liveLeases(
+	now time.Time, keep func(*Entry) bool,
+) []Entry {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var live []Entry
+	for _, e := range s.leases {
+		if e.Lease.Expires > now.Unix() && keep(&e) {
+			live = append(live, e)
+		}
+	}
+	slices.SortFunc(live, func(a, b Entry) int {
+		return strings.Compare(a.Lease.Task, b.Lease.Task)
+	})
+	return live
+}
+
+// applyLease folds one entry into a lease table: claims
+// and assigns take the task, releases free it, and every
+// other entry leaves the table alone. Expired leases are
+// kept; readers compare Expires against their clock.
+//
+// Parameters:
+//   - held: task key to granting entry; updated in place
+//   - e: entry to fold in
+func applyLease(held map[string]Entry, e *Entry) {
+	switch e.Type {
+	case cfgEntry.Claim, cfgEntry.Assign:
+		held[e.Lease.Task] = *e
+	case cfgEntry.Release:
+		delete(held, e.Lease.Task)
+	}
+}
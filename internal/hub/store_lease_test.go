@@ -0,0 +1,321 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package hub
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	cfgHub "github.com/ActiveMemory/ctx/internal/config/hub"
+)
+
+// fleetEntry builds a claim, assign, or release entry for
+// task t by agent a with the given lease TTL in seconds.
+func fleetEntry(typ, task, agent string, ttl int64) Entry {
+	return Entry{
+		ID:        typ + "-" + task + "-" + agent,
+		Type:      typ,
+		Content:   "Implement " + task,
+		Origin:    agent,
+		Timestamp: time.Now(),
+		Lease:     Lease{Task: task, Agent: agent, TTL: ttl},
+	}
+}
+
+// mustGrant fails the test unless the batch is accepted.
+func mustGrant(t *testing.T, s *Store, now time.Time, e ...Entry) {
+	t.Helper()
+	if _, err := s.grant(e, now); err != nil {
+		t.Fatalf("grant %s: %v", e[0].Type, err)
+	}
+}
+
+// wantPrecondition fails the test unless err is a
+// FailedPrecondition status.
+func wantPrecondition(t *testing.T, err error) {
+	t.Helper()
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("got %v, want FailedPrecondition", err)
+	}
+}
+
+func TestGrant_ClaimConflict(t *testing.T) {
+	s, err := NewStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+	mustGrant(t, s, now, fleetEntry("claim", "k1", "alpha", 60))
+
+	_, conflictErr := s.grant(
+		[]Entry{fleetEntry("claim", "k1", "beta", 60)}, now,
+	)
+	wantPrecondition(t, conflictErr)
+
+	// Renewing one's own claim is allowed.
+	mustGrant(t, s, now.Add(30*time.Second),
+		fleetEntry("claim", "k1", "alpha", 60))
+	if got := s.leases["k1"].Lease.Expires; got != now.Unix()+90 {
+		t.Errorf("renewed expiry = %d, want %d", got, now.Unix()+90)
+	}
+}
+
+func TestGrant_ClaimAfterExpiry(t *testing.T) {
+	s, err := NewStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+	mustGrant(t, s, now, fleetEntry("claim", "k1", "alpha", 60))
+	mustGrant(t, s, now.Add(61*time.Second),
+		fleetEntry("claim", "k1", "beta", 60))
+	if got := s.leases["k1"].Lease.Agent; got != "beta" {
+		t.Errorf("holder = %q, want beta", got)
+	}
+}
+
+func TestGrant_AssignOverrides(t *testing.T) {
+	s, err := NewStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+	mustGrant(t, s, now, fleetEntry("claim", "k1", "alpha", 60))
+	mustGrant(t, s, now, fleetEntry("assign", "k1", "beta", 60))
+	if got := s.leases["k1"].Lease.Agent; got != "beta" {
+		t.Errorf("holder = %q, want beta", got)
+	}
+}
+
+func TestGrant_Release(t *testing.T) {
+	s, err := NewStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+
+	_, unheldErr := s.grant(
+		[]Entry{fleetEntry("release", "k1", "alpha", 0)}, now,
+	)
+	wantPrecondition(t, unheldErr)
+
+	mustGrant(t, s, now, fleetEntry("claim", "k1", "alpha", 60))
+	_, otherErr := s.grant(
+		[]Entry{fleetEntry("release", "k1", "beta", 0)}, now,
+	)
+	wantPrecondition(t, otherErr)
+
+	mustGrant(t, s, now, fleetEntry("release", "k1", "alpha", 0))
+	if _, ok := s.leases["k1"]; ok {
+		t.Error("lease still held after release")
+	}
+	mustGrant(t, s, now, fleetEntry("claim", "k1", "beta", 60))
+}
+
+func TestGrant_BatchIsAtomic(t *testing.T) {
+	s, err := NewStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+	_, batchErr := s.grant([]Entry{
+		fleetEntry("claim", "k1", "alpha", 60),
+		fleetEntry("claim", "k1", "beta", 60),
+	}, now)
+	wantPrecondition(t, batchErr)
+	if total, _, _ := s.Stats(); total != 0 {
+		t.Errorf("rejected batch stored %d entries", total)
+	}
+	if len(s.leases) != 0 {
+		t.Errorf("rejected batch left leases: %v", s.leases)
+	}
+}
+
+func TestGrant_LeasesSurviveRestart(t *testing.T) {
+	dir := t.TempDir()
+	s, err := NewStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+	mustGrant(t, s, now, fleetEntry("claim", "k1", "alpha", 3600))
+
+	reopened, reopenErr := NewStore(dir)
+	if reopenErr != nil {
+		t.Fatal(reopenErr)
+	}
+	_, conflictErr := reopened.grant(
+		[]Entry{fleetEntry("claim", "k1", "beta", 60)}, now,
+	)
+	wantPrecondition(t, conflictErr)
+}
+
+func TestLeases_Replay(t *testing.T) {
+	now := time.Now()
+	claim := func(task, agent string, expires int64) EntryMsg {
+		return EntryMsg{Type: "claim", Lease: Lease{
+			Task: task, Agent: agent, Expires: expires,
+		}}
+	}
+	entries := []EntryMsg{
+		claim("live", "alpha", now.Unix()+60),
+		claim("stale", "alpha", now.Unix()-1),
+		claim("freed", "alpha", now.Unix()+60),
+		{Type: "release", Lease: Lease{Task: "freed", Agent: "alpha"}},
+	}
+
+	got := Leases(entries, now)
+	if len(got) != 1 {
+		t.Fatalf("got %d leases, want 1: %v", len(got), got)
+	}
+	if got["live"].Lease.Agent != "alpha" {
+		t.Errorf("live lease = %+v", got["live"])
+	}
+}
+
+func TestPublish_LeaseRejections(t *testing.T) {
+	srv, _ := newCorrectionServer(t)
+	claim := Lease{Task: "k1", Agent: "alpha", TTL: 60}
+	if pubErr := publishOne(srv, PublishEntry{
+		ID: "c", Type: "claim", Content: "x", Lease: claim,
+	}); pubErr != nil {
+		t.Fatal(pubErr)
+	}
+
+	tests := []struct {
+		name string
+		pe   PublishEntry
+		code codes.Code
+	}{
+		{"lease on plain entry", PublishEntry{
+			ID: "p", Type: "decision", Content: "x", Lease: claim,
+		}, codes.InvalidArgument},
+		{"missing agent", PublishEntry{
+			ID: "a", Type: "claim", Content: "x",
+			Lease: Lease{Task: "k2", TTL: 60},
+		}, codes.InvalidArgument},
+		{"zero ttl", PublishEntry{
+			ID: "z", Type: "claim", Content: "x",
+			Lease: Lease{Task: "k2", Agent: "alpha"},
+		}, codes.InvalidArgument},
+		{"ttl over a day", PublishEntry{
+			ID: "d", Type: "assign", Content: "x",
+			Lease: Lease{Task: "k2", Agent: "alpha", TTL: 86401},
+		}, codes.InvalidArgument},
+		{"release with ttl", PublishEntry{
+			ID: "r", Type: "release", Content: "x", Lease: claim,
+		}, codes.InvalidArgument},
+		{"agent with newline", PublishEntry{
+			ID: "n", Type: "claim", Content: "x",
+			Lease: Lease{Task: "k2", Agent: "a\nb", TTL: 60},
+		}, codes.InvalidArgument},
+		{"retracting a claim", PublishEntry{
+			ID: "rc", Kind: cfgHub.KindRetract,
+			Ref: "c", Reason: "oops",
+		}, codes.InvalidArgument},
+		{"claim held by another", PublishEntry{
+			ID: "o", Type: "claim", Content: "x",
+			Lease: Lease{Task: "k1", Agent: "beta", TTL: 60},
+		}, codes.FailedPrecondition},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pubErr := publishOne(srv, tt.pe)
+			if got := status.Code(pubErr); got != tt.code {
+				t.Fatalf("code = %v (%v), want %v",
+					got, pubErr, tt.code)
+			}
+		})
+	}
+}
+
+func TestGrant_BindsClient(t *testing.T) {
+	s, err := NewStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+	from := func(typ, task, client string, ttl int64) Entry {
+		e := fleetEntry(typ, task, "alpha", ttl)
+		e.Lease.Client = client
+		return e
+	}
+	mustGrant(t, s, now, from("claim", "k1", "c1", 60))
+
+	// The same agent name from another client neither
+	// renews nor releases the lease.
+	_, renewErr := s.grant([]Entry{from("claim", "k1", "c2", 60)}, now)
+	wantPrecondition(t, renewErr)
+	_, releaseErr := s.grant([]Entry{from("release", "k1", "c2", 0)}, now)
+	wantPrecondition(t, releaseErr)
+	mustGrant(t, s, now, from("release", "k1", "c1", 0))
+
+	// A lease granted before client binding matches any client.
+	mustGrant(t, s, now, from("claim", "k2", "", 60))
+	mustGrant(t, s, now, from("release", "k2", "c2", 0))
+}
+
+func TestLeasesRPC(t *testing.T) {
+	srv, _ := newCorrectionServer(t)
+	addr, _ := startAdmin(t, srv)
+	dial := func(project string) *Client {
+		c, dialErr := NewClient(addr, "", TLSConfig{})
+		if dialErr != nil {
+			t.Fatal(dialErr)
+		}
+		reg, regErr := c.Register(testCtx(), "adm", project)
+		if regErr != nil {
+			t.Fatal(regErr)
+		}
+		// Acceptable discard: test teardown.
+		_ = c.Close()
+		c, dialErr = NewClient(addr, reg.ClientToken, TLSConfig{})
+		if dialErr != nil {
+			t.Fatal(dialErr)
+		}
+		t.Cleanup(func() { _ = c.Close() })
+		return c
+	}
+	one := dial("one")
+	two := dial("two")
+
+	claim := func(task string) PublishEntry {
+		return PublishEntry{
+			ID: "c-" + task, Type: "claim", Content: "x",
+			Origin: "alpha", Timestamp: time.Now().Unix(),
+			Lease: Lease{
+				Task: task, Agent: "alpha", TTL: 60, Client: "forged",
+			},
+		}
+	}
+	if _, pubErr := one.Publish(
+		testCtx(), []PublishEntry{claim("k1"), claim("k2")},
+	); pubErr != nil {
+		t.Fatal(pubErr)
+	}
+	_, stealErr := two.Publish(testCtx(), []PublishEntry{{
+		ID: "r-k1", Type: "release", Content: "x",
+		Origin: "alpha", Timestamp: time.Now().Unix(),
+		Lease: Lease{Task: "k1", Agent: "alpha"},
+	}})
+	wantPrecondition(t, stealErr)
+
+	held, leaseErr := two.Leases(testCtx())
+	if leaseErr != nil {
+		t.Fatal(leaseErr)
+	}
+	if len(held) != 2 {
+		t.Fatalf("Leases = %v, want k1 and k2", held)
+	}
+	got := held["k1"].Lease
+	if got.Agent != "alpha" || got.Client == "" || got.Client == "forged" {
+		t.Errorf("k1 lease = %+v, want alpha on a hub-stamped client", got)
+	}
+}
@@ -0,0 +1,140 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package hub
+
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	cfgHub "github.com/ActiveMemory/ctx/internal/config/hub"
+)
+
+// indexEntry records the entry at pos in the ID, search,
+// correction, and lease indexes. Caller must hold s.mu.
+//
+// Parameters:
+//   - pos: position of the entry in s.entries
+func (s *Store) indexEntry(pos int) {
+	e := s.entries[pos]
+	s.idIdx[e.ID] = pos
+	for _, term := range searchTerms(e.Content) {
+		s.terms[term] = append(s.terms[term], pos)
+	}
+	if e.Kind != "" && e.Ref != "" {
+		s.corrected[e.Ref] = e
+	}
+	applyLease(s.leases, &e)
+}
+
+// query filters the entry log by type and sequence. Caller
+// must not hold s.mu.
+//
+// Parameters:
+//   - types: entry types to include (empty = all types)
+//   - sinceSequence: entries with sequence > this value
+//   - raw: true to skip correction handling
+//
+// Returns:
+//   - []Entry: matching entries in sequence order
+func (s *Store) query(
+	types []string, sinceSequence uint64, raw bool,
+) []Entry {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	typeSet := make(map[string]bool, len(types))
+	for _, t := range types {
+		typeSet[t] = true
+	}
+
+	var result []Entry
+	for _, e := range s.entries[s.search(sinceSequence):] {
+		if len(typeSet) > 0 && !typeSet[e.Type] {
+			continue
+		}
+		if !raw && s.hidden(&e, sinceSequence) {
+			continue
+		}
+		result = append(result, e)
+	}
+	return result
+}
+
+// hidden reports whether a corrections-aware read that
+// starts after sinceSequence should omit e. Caller must
+// hold s.mu.
+//
+// Parameters:
+//   - e: candidate entry, already inside the window
+//   - sinceSequence: start of the read window
+//
+// Returns:
+//   - bool: true when e was corrected, or e retracts an
+//     entry (live or tombstoned) inside the same window
+func (s *Store) hidden(e *Entry, sinceSequence uint64) bool {
+	if _, ok := s.corrected[e.ID]; ok {
+		return true
+	}
+	if e.Kind != cfgHub.KindRetract {
+		return false
+	}
+	if pos, ok := s.idIdx[e.Ref]; ok {
+		return s.entries[pos].Sequence > sinceSequence
+	}
+	seq, ok := s.tombstones[e.Ref]
+	return ok && seq > sinceSequence
+}
+
+// ref looks up the target of a correction.
+//
+// Parameters:
+//   - id: entry ID named by a correction's Ref
+//
+// Returns:
+//   - Entry: the stored target (zero when absent)
+//   - bool: true if an entry with that ID exists, or
+//     existed before compaction dropped it
+//   - bool: true if that entry was already retracted or
+//     superseded
+func (s *Store) ref(id string) (Entry, bool, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	pos, ok := s.idIdx[id]
+	if !ok {
+		// Compaction only drops corrected entries.
+		_, dropped := s.tombstones[id]
+		return Entry{ID: id}, dropped, dropped
+	}
+	_, done := s.corrected[id]
+	return s.entries[pos], true, done
+}
+
+// refOpenLocked re-checks, under the store lock, that a
+// correction's target has not been retracted or superseded
+// since the handler resolved it. Caller must hold s.mu.
+//
+// Parameters:
+//   - e: entry about to be appended
+//
+// Returns:
+//   - error: FailedPrecondition when e corrects an entry
+//     that is already corrected; nil otherwise
+func (s *Store) refOpenLocked(e *Entry) error {
+	if e.Kind == "" {
+		return nil
+	}
+	_, done := s.corrected[e.Ref]
+	_, dropped := s.tombstones[e.Ref]
+	if !done && !dropped {
+		return nil
+	}
+	return status.Errorf(
+		codes.FailedPrecondition,
+		cfgHub.ErrEntryRefCorrected, e.Ref,
+	)
+}
@@ -0,0 +1,122 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package hub
+
+import (
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/ActiveMemory/ctx/internal/i18n"
+)
+
+// searchTerms splits text into case-folded words for the
+// search index. Runs of letters and digits form a word;
+// everything else separates words.
+//
+// Parameters:
+//   - text: entry content or query text
+//
+// Returns:
+//   - []string: distinct words in first-seen order
+func searchTerms(text string) []string {
+	words := strings.FieldsFunc(i18n.Fold(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	seen := make(map[string]bool, len(words))
+	out := words[:0]
+	for _, w := range words {
+		if !seen[w] {
+			seen[w] = true
+			out = append(out, w)
+		}
+	}
+	return out
+}
+
+// candidates intersects the posting lists of every term.
+// Caller must hold s.mu.
+//
+// Parameters:
+//   - terms: query words; must be non-empty
+//
+// Returns:
+//   - []int: ascending positions of entries containing
+//     every term (empty when any term is unknown)
+func (s *Store) candidates(terms []string) []int {
+	lists := make([][]int, 0, len(terms))
+	for _, t := range terms {
+		list, ok := s.terms[t]
+		if !ok {
+			return nil
+		}
+		lists = append(lists, list)
+	}
+	// Start from the rarest term so the intersection only
+	// shrinks.
+	slices.SortFunc(lists, func(a, b []int) int {
+		return len(a) - len(b)
+	})
+	out := lists[0]
+	for _, list := range lists[1:] {
+		out = intersect(out, list)
+	}
+	return out
+}
+
+// intersect merges two ascending position lists.
+//
+// Parameters:
+//   - a: ascending positions
+//   - b: ascending positions
+//
+// Returns:
+//   - []int: positions present in both, ascending
+func intersect(a, b []int) []int {
+	var out []int
+	for i, j := 0, 0; i < len(a) && j < len(b); {
+		switch {
+		case a[i] < b[j]:
+			i++
+		case a[i] > b[j]:
+			j++
+		default:
+			out = append(out, a[i])
+			i++
+			j++
+		}
+	}
+	return out
+}
+
+// matches reports whether e passes a search request's
+// filters. Corrected entries and retraction records are
+// excluded, as in a fresh Query. Caller must hold s.mu.
+//
+// Parameters:
+//   - e: candidate entry
+//   - req: search filters
+//
+// Returns:
+//   - bool: true when e belongs in the results
+func (s *Store) matches(e *Entry, req *SearchRequest) bool {
+	if len(req.Types) > 0 && !slices.Contains(req.Types, e.Type) {
+		return false
+	}
+	if len(req.Origins) > 0 &&
+		!slices.Contains(req.Origins, e.Origin) {
+		return false
+	}
+	ts := e.Timestamp.Unix()
+	if req.Since > 0 && ts < req.Since {
+		return false
+	}
+	if req.Until > 0 && ts > req.Until {
+		return false
+	}
+	return !s.hidden(e, 0)
+}
@@ -0,0 +1,252 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package hub
+
+import (
+	"os"
+	"sort"
+	"time"
+
+	"github.com/ActiveMemory/ctx/internal/config/fs"
+	cfgWarn "github.com/ActiveMemory/ctx/internal/config/warn"
+	"github.com/ActiveMemory/ctx/internal/io"
+	logWarn "github.com/ActiveMemory/ctx/internal/log/warn"
+)
+
+// load reads the snapshot, the sealed segments, and the
+// active file, in that order. Entries the snapshot already
+// covers are skipped, so a crash between writing a snapshot
+// and removing the segments it replaced loses nothing.
+// Caller must hold s.mu or own s exclusively.
+//
+// Returns:
+//   - error: non-nil if any file cannot be read or parsed
+func (s *Store) load() error {
+	var snap Snapshot
+	if loadErr := loadJSON(
+		snapshotPath(s.dir), &snap,
+	); loadErr != nil {
+		return loadErr
+	}
+
+	segs, listErr := segmentFiles(s.dir)
+	if listErr != nil {
+		return listErr
+	}
+	var sealed, active []Entry
+	for _, path := range segs {
+		if loadErr := loadEntries(path, &sealed); loadErr != nil {
+			return loadErr
+		}
+	}
+	if loadErr := loadEntries(
+		entriesPath(s.dir), &active,
+	); loadErr != nil {
+		return loadErr
+	}
+
+	s.install(&snap)
+	s.sealed = len(segs)
+	for _, e := range sealed {
+		if e.Sequence > s.base {
+			s.entries = append(s.entries, e)
+		}
+	}
+	for _, e := range active {
+		if e.Sequence > s.base {
+			s.entries = append(s.entries, e)
+			s.active++
+		}
+	}
+	for i := len(snap.Entries); i < len(s.entries); i++ {
+		s.indexEntry(i)
+	}
+	// A crash between the log append and the meta save
+	// leaves the counter behind; never reissue a sequence.
+	last := s.base
+	if n := len(s.entries); n > 0 && s.entries[n-1].Sequence > last {
+		last = s.entries[n-1].Sequence
+	}
+	if s.meta.SequenceCounter < last {
+		s.meta.SequenceCounter = last
+	}
+	return nil
+}
+
+// install replaces the in-memory log with a snapshot image
+// and rebuilds the indexes. Caller must hold s.mu.
+//
+// Parameters:
+//   - snap: snapshot to install
+func (s *Store) install(snap *Snapshot) {
+	s.base = snap.Sequence
+	s.entries = append([]Entry(nil), snap.Entries...)
+	s.tombstones = make(map[string]uint64, len(snap.Tombstones))
+	for id, seq := range snap.Tombstones {
+		s.tombstones[id] = seq
+	}
+	s.idIdx = make(map[string]int, len(s.entries))
+	s.terms = make(map[string][]int)
+	s.corrected = make(map[string]Entry)
+	s.leases = make(map[string]Entry)
+	for i := range s.entries {
+		s.indexEntry(i)
+	}
+	s.active, s.sealed = 0, 0
+}
+
+// housekeep rotates the active file once it reaches the
+// segment threshold and compacts once enough segments are
+// sealed. Failures are warned, not returned: the appended
+// entries are already durable, and the next append
+// retries. Caller must hold s.mu.
+func (s *Store) housekeep() {
+	if s.active < s.segmentEntries {
+		return
+	}
+	if rotateErr := s.rotate(); rotateErr != nil {
+		logWarn.Warn(cfgWarn.HubCompact, rotateErr)
+		return
+	}
+	if s.sealed < s.compactSegments {
+		return
+	}
+	if compactErr := s.compact(); compactErr != nil {
+		logWarn.Warn(cfgWarn.HubCompact, compactErr)
+	}
+}
+
+// rotate seals the active file into a segment named after
+// its first sequence. A no-op when the active file holds
+// nothing new. Caller must hold s.mu.
+//
+// Returns:
+//   - error: non-nil if the segment cannot be created
+func (s *Store) rotate() error {
+	if s.active == 0 {
+		return nil
+	}
+	if mkErr := io.SafeMkdirAll(
+		segmentsPath(s.dir), fs.PermKeyDir,
+	); mkErr != nil {
+		return mkErr
+	}
+	first := s.entries[len(s.entries)-s.active].Sequence
+	if renameErr := io.SafeRename(
+		entriesPath(s.dir), segmentPath(s.dir, first),
+	); renameErr != nil {
+		return renameErr
+	}
+	s.active = 0
+	s.sealed++
+	return nil
+}
+
+// compact seals the active file, writes a snapshot of
+// everything up to the current sequence, and removes the
+// sealed segments it replaces. Corrected entries are
+// dropped and kept as tombstones. Caller must hold s.mu.
+//
+// Returns:
+//   - error: non-nil if rotation, the snapshot write, or a
+//     segment removal fails
+func (s *Store) compact() error {
+	if rotateErr := s.rotate(); rotateErr != nil {
+		return rotateErr
+	}
+	snap := Snapshot{
+		Sequence:   s.meta.SequenceCounter,
+		CreatedAt:  time.Now().UTC(),
+		Tombstones: make(map[string]uint64, len(s.tombstones)),
+	}
+	for id, seq := range s.tombstones {
+		snap.Tombstones[id] = seq
+	}
+	for _, e := range s.entries {
+		if _, done := s.corrected[e.ID]; done {
+			snap.Tombstones[e.ID] = e.Sequence
+			continue
+		}
+		snap.Entries = append(snap.Entries, e)
+	}
+	if saveErr := saveJSONAtomic(
+		snapshotPath(s.dir), snap,
+	); saveErr != nil {
+		return saveErr
+	}
+	s.install(&snap)
+	return s.dropSegments()
+}
+
+// dropSegments removes every sealed segment. Only safe once
+// a snapshot covering them is on disk. Caller must hold
+// s.mu.
+//
+// Returns:
+//   - error: first removal failure; load skips leftovers
+func (s *Store) dropSegments() error {
+	segs, listErr := segmentFiles(s.dir)
+	if listErr != nil {
+		return listErr
+	}
+	for _, path := range segs {
+		if rmErr := os.Remove(path); rmErr != nil {
+			return rmErr
+		}
+	}
+	return nil
+}
+
+// image returns the compacted prefix of the log for the
+// Snapshot RPC: entries the snapshot covers plus its
+// tombstones.
+//
+// Returns:
+//   - Snapshot: copy of the snapshot image; Sequence is 0
+//     when the store was never compacted
+func (s *Store) image() Snapshot {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	snap := Snapshot{
+		Sequence:   s.base,
+		Tombstones: make(map[string]uint64, len(s.tombstones)),
+	}
+	for id, seq := range s.tombstones {
+		snap.Tombstones[id] = seq
+	}
+	end := s.search(s.base)
+	snap.Entries = append([]Entry(nil), s.entries[:end]...)
+	return snap
+}
+
+// compacted returns the sequence the snapshot covers.
+//
+// Returns:
+//   - uint64: snapshot sequence, 0 when never compacted
+func (s *Store) compacted() uint64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.base
+}
+
+// search returns the position of the first entry with a
+// sequence above sinceSequence. Entries are kept in
+// sequence order, so this is a binary search. Caller must
+// hold s.mu.
+//
+// Parameters:
+//   - sinceSequence: exclusive lower bound
+//
+// Returns:
+//   - int: index into s.entries (len when none qualify)
+func (s *Store) search(sinceSequence uint64) int {
+	return sort.Search(len(s.entries), func(i int) bool {
+		return s.entries[i].Sequence > sinceSequence
+	})
+}
@@ -13,12 +13,12 @@ var _ = (*Store).lastSequence
 // lastSequence returns the highest sequence in the store.
 //
 // Returns:
-//   - bool: true if at least one entry exists
+//   - bool: true if at least one entry was ever appended
 //   - uint64: highest sequence number, or 0 if empty
 func (s *Store) lastSequence() (bool, uint64) {
-	all := s.Query(nil, 0)
-	if len(all) == 0 {
-		return false, 0
-	}
-	return true, all[len(all)-1].Sequence
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	seq := s.meta.SequenceCounter
+	return seq > 0, seq
 }
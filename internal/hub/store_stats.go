@@ -0,0 +1,39 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package hub
+
+// stats counts the entries keep accepts.
+//
+// Parameters:
+//   - keep: entry filter; nil counts every entry
+//
+// Returns:
+//   - uint64: number of entries counted
+//   - map[string]uint64: count per type
+//   - map[string]uint64: count per origin project
+func (s *Store) stats(keep func(*Entry) bool) (
+	uint64, map[string]uint64, map[string]uint64,
+) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var total uint64
+	byType := make(map[string]uint64)
+	byProject := make(map[string]uint64)
+
+	for i := range s.entries {
+		e := &s.entries[i]
+		if keep != nil && !keep(e) {
+			continue
+		}
+		total++
+		byType[e.Type]++
+		byProject[e.Origin]++
+	}
+
+	return total, byType, byProject
+}
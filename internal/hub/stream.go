@@ -0,0 +1,59 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package hub
+
+import (
+	"crypto/tls"
+	"net"
+	"time"
+
+	"github.com/hashicorp/raft"
+
+	cfgHub "github.com/ActiveMemory/ctx/internal/config/hub"
+)
+
+// tlsStreamLayer is a Raft stream layer that carries peer
+// traffic over TLS instead of plain TCP. It satisfies
+// [raft.StreamLayer]: the embedded listener accepts
+// inbound peers, Dial opens outbound ones.
+//
+// Fields:
+//   - Listener: TLS listener accepting inbound peers
+//   - advertise: address reported to the other peers
+//   - dialCfg: TLS configuration for outbound peers
+type tlsStreamLayer struct {
+	net.Listener
+	advertise net.Addr
+	dialCfg   *tls.Config
+}
+
+// Dial opens a TLS connection to another Raft peer.
+//
+// Parameters:
+//   - address: peer Raft address (host:port)
+//   - timeout: connect and handshake deadline
+//
+// Returns:
+//   - net.Conn: established TLS connection
+//   - error: non-nil if the dial or handshake fails
+func (l *tlsStreamLayer) Dial(
+	address raft.ServerAddress, timeout time.Duration,
+) (net.Conn, error) {
+	dialer := &net.Dialer{Timeout: timeout}
+	return tls.DialWithDialer(
+		dialer, cfgHub.RaftTransport,
+		string(address), l.dialCfg,
+	)
+}
+
+// Addr returns the address advertised to other peers.
+//
+// Returns:
+//   - net.Addr: advertise address
+func (l *tlsStreamLayer) Addr() net.Addr {
+	return l.advertise
+}
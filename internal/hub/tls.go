@@ -0,0 +1,201 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package hub
+
+import (
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+
+	errHub "github.com/ActiveMemory/ctx/internal/err/hub"
+	"github.com/ActiveMemory/ctx/internal/io"
+)
+
+// tlsEnabled reports whether cfg asks for TLS at all.
+//
+// Parameters:
+//   - cfg: transport security settings
+//
+// Returns:
+//   - bool: true when any certificate material is set
+func tlsEnabled(cfg TLSConfig) bool {
+	return cfg.CertFile != "" ||
+		cfg.KeyFile != "" ||
+		cfg.CAFile != ""
+}
+
+// keyPair loads the certificate/key pair named by cfg.
+//
+// Parameters:
+//   - cfg: transport security settings
+//
+// Returns:
+//   - []tls.Certificate: the loaded pair, or nil when
+//     neither file is set
+//   - error: non-nil when only one file is set or the
+//     pair fails to load
+func keyPair(cfg TLSConfig) ([]tls.Certificate, error) {
+	if cfg.CertFile == "" && cfg.KeyFile == "" {
+		return nil, nil
+	}
+	if cfg.CertFile == "" || cfg.KeyFile == "" {
+		return nil, errHub.TLSKeyPairIncomplete()
+	}
+	cert, loadErr := tls.LoadX509KeyPair(
+		cfg.CertFile, cfg.KeyFile,
+	)
+	if loadErr != nil {
+		return nil, errHub.TLSKeyPair(loadErr)
+	}
+	return []tls.Certificate{cert}, nil
+}
+
+// caPool reads a PEM CA bundle into a certificate pool.
+//
+// Parameters:
+//   - path: CA bundle path
+//
+// Returns:
+//   - *x509.CertPool: pool holding every bundle certificate
+//   - error: non-nil if the file is unreadable or holds
+//     no certificates
+func caPool(path string) (*x509.CertPool, error) {
+	data, readErr := io.SafeReadUserFile(path)
+	if readErr != nil {
+		return nil, readErr
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(data) {
+		return nil, errHub.TLSCABundle(path)
+	}
+	return pool, nil
+}
+
+// serverTLS builds the TLS configuration a hub listener
+// presents. A CA bundle switches on mutual TLS: peers
+// without a certificate signed by it are rejected during
+// the handshake, before any RPC runs.
+//
+// Parameters:
+//   - cfg: transport security settings
+//
+// Returns:
+//   - *tls.Config: listener configuration
+//   - error: non-nil if certificate material is missing
+//     or unreadable
+func serverTLS(cfg TLSConfig) (*tls.Config, error) {
+	certs, pairErr := keyPair(cfg)
+	if pairErr != nil {
+		return nil, pairErr
+	}
+	if certs == nil {
+		return nil, errHub.TLSServerCertRequired()
+	}
+	tlsCfg := &tls.Config{
+		Certificates: certs,
+		MinVersion:   tls.VersionTLS12,
+	}
+	if cfg.CAFile == "" {
+		return tlsCfg, nil
+	}
+	pool, poolErr := caPool(cfg.CAFile)
+	if poolErr != nil {
+		return nil, poolErr
+	}
+	tlsCfg.ClientCAs = pool
+	tlsCfg.ClientAuth = tls.RequireAndVerifyClientCert
+	return tlsCfg, nil
+}
+
+// clientTLS builds the TLS configuration used to dial a
+// hub or Raft peer. The CA bundle replaces the system
+// roots when set; the key pair is presented for mutual
+// TLS; a fingerprint pins the peer's leaf certificate on
+// top of normal chain verification.
+//
+// Parameters:
+//   - cfg: transport security settings
+//
+// Returns:
+//   - *tls.Config: dialer configuration
+//   - error: non-nil if certificate material is unreadable
+func clientTLS(cfg TLSConfig) (*tls.Config, error) {
+	certs, pairErr := keyPair(cfg)
+	if pairErr != nil {
+		return nil, pairErr
+	}
+	tlsCfg := &tls.Config{
+		Certificates: certs,
+		MinVersion:   tls.VersionTLS12,
+	}
+	if cfg.CAFile != "" {
+		pool, poolErr := caPool(cfg.CAFile)
+		if poolErr != nil {
+			return nil, poolErr
+		}
+		tlsCfg.RootCAs = pool
+	}
+	if cfg.Fingerprint != "" {
+		tlsCfg.VerifyConnection = pinVerifier(cfg.Fingerprint)
+	}
+	return tlsCfg, nil
+}
+
+// pinVerifier returns a handshake hook that rejects any
+// peer whose leaf certificate differs from the pinned one.
+//
+// Parameters:
+//   - pinned: hex SHA-256 of the expected leaf certificate
+//
+// Returns:
+//   - func(tls.ConnectionState) error: VerifyConnection hook
+func pinVerifier(
+	pinned string,
+) func(tls.ConnectionState) error {
+	return func(state tls.ConnectionState) error {
+		if len(state.PeerCertificates) == 0 {
+			return errHub.TLSNoPeerCert()
+		}
+		got := fingerprint(state.PeerCertificates[0].Raw)
+		if got != pinned {
+			return errHub.FingerprintMismatch(got, pinned)
+		}
+		return nil
+	}
+}
+
+// fingerprint returns the hex SHA-256 of a DER certificate.
+//
+// Parameters:
+//   - der: raw certificate bytes
+//
+// Returns:
+//   - string: lowercase hex digest
+func fingerprint(der []byte) string {
+	sum := sha256.Sum256(der)
+	return hex.EncodeToString(sum[:])
+}
+
+// peerFingerprint extracts the leaf certificate
+// fingerprint from a completed RPC's peer information.
+//
+// Parameters:
+//   - p: peer captured via grpc.Peer
+//
+// Returns:
+//   - string: hex SHA-256, or empty over plaintext
+func peerFingerprint(p *peer.Peer) string {
+	info, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(info.State.PeerCertificates) == 0 {
+		return ""
+	}
+	return fingerprint(info.State.PeerCertificates[0].Raw)
+}
@@ -0,0 +1,304 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package hub
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// testPKI is a throwaway CA plus leaf certificates on disk.
+type testPKI struct {
+	caFile     string
+	serverCert string
+	serverKey  string
+	peerCert   string
+	peerKey    string
+	clientCert string
+	clientKey  string
+}
+
+// newTestPKI writes a CA, a 127.0.0.1 server leaf, and a
+// client leaf into a temp directory.
+func newTestPKI(t *testing.T) testPKI {
+	t.Helper()
+	dir := t.TempDir()
+
+	caKey, caKeyErr := ecdsa.GenerateKey(
+		elliptic.P256(), rand.Reader,
+	)
+	if caKeyErr != nil {
+		t.Fatal(caKeyErr)
+	}
+	caTmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	caDER, caErr := x509.CreateCertificate(
+		rand.Reader, caTmpl, caTmpl, &caKey.PublicKey, caKey,
+	)
+	if caErr != nil {
+		t.Fatal(caErr)
+	}
+	caCert, parseErr := x509.ParseCertificate(caDER)
+	if parseErr != nil {
+		t.Fatal(parseErr)
+	}
+
+	pki := testPKI{caFile: filepath.Join(dir, "ca.pem")}
+	writePEM(t, pki.caFile, "CERTIFICATE", caDER)
+
+	leaf := func(name string, serial int64) (string, string) {
+		key, keyErr := ecdsa.GenerateKey(
+			elliptic.P256(), rand.Reader,
+		)
+		if keyErr != nil {
+			t.Fatal(keyErr)
+		}
+		tmpl := &x509.Certificate{
+			SerialNumber: big.NewInt(serial),
+			Subject:      pkix.Name{CommonName: name},
+			NotBefore:    time.Now().Add(-time.Hour),
+			NotAfter:     time.Now().Add(time.Hour),
+			KeyUsage:     x509.KeyUsageDigitalSignature,
+			ExtKeyUsage: []x509.ExtKeyUsage{
+				x509.ExtKeyUsageServerAuth,
+				x509.ExtKeyUsageClientAuth,
+			},
+			IPAddresses: []net.IP{net.ParseIP("127.0.0.1")},
+		}
+		der, certErr := x509.CreateCertificate(
+			rand.Reader, tmpl, caCert, &key.PublicKey, caKey,
+		)
+		if certErr != nil {
+			t.Fatal(certErr)
+		}
+		keyDER, marshalErr := x509.MarshalECPrivateKey(key)
+		if marshalErr != nil {
+			t.Fatal(marshalErr)
+		}
+		certPath := filepath.Join(dir, name+".pem")
+		keyPath := filepath.Join(dir, name+"-key.pem")
+		writePEM(t, certPath, "CERTIFICATE", der)
+		writePEM(t, keyPath, "EC PRIVATE KEY", keyDER)
+		return certPath, keyPath
+	}
+	pki.serverCert, pki.serverKey = leaf("server", 2)
+	pki.clientCert, pki.clientKey = leaf("client", 3)
+	pki.peerCert, pki.peerKey = leaf("peer", 4)
+	return pki
+}
+
+// writePEM encodes der as a single PEM block at path.
+func writePEM(t *testing.T, path, blockType string, der []byte) {
+	t.Helper()
+	data := pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der})
+	if writeErr := os.WriteFile(path, data, 0o600); writeErr != nil {
+		t.Fatal(writeErr)
+	}
+}
+
+// startTLSServer runs a hub with the given server-side TLS
+// settings and returns its address and admin token.
+func startTLSServer(t *testing.T, cfg TLSConfig) string {
+	t.Helper()
+	store, storeErr := NewStore(t.TempDir())
+	if storeErr != nil {
+		t.Fatal(storeErr)
+	}
+	srv, srvErr := NewServer(store, "adm", cfg)
+	if srvErr != nil {
+		t.Fatal(srvErr)
+	}
+	lis := listenRandom(t)
+	go func() { _ = srv.Serve(lis) }()
+	t.Cleanup(srv.GracefulStop)
+	return lis.Addr().String()
+}
+
+func TestTLS_RegisterPinsFingerprint(t *testing.T) {
+	pki := newTestPKI(t)
+	addr := startTLSServer(t, TLSConfig{
+		CertFile: pki.serverCert, KeyFile: pki.serverKey,
+	})
+
+	client, dialErr := NewClient(
+		addr, "", TLSConfig{CAFile: pki.caFile},
+	)
+	if dialErr != nil {
+		t.Fatal(dialErr)
+	}
+	defer func() { _ = client.Close() }()
+
+	resp, regErr := client.Register(testCtx(), "adm", "tls-proj")
+	if regErr != nil {
+		t.Fatalf("Register over TLS: %v", regErr)
+	}
+	want, fpErr := CertFingerprint(TLSConfig{
+		CertFile: pki.serverCert, KeyFile: pki.serverKey,
+	})
+	if fpErr != nil {
+		t.Fatal(fpErr)
+	}
+	if client.Fingerprint() != want {
+		t.Fatalf("fingerprint = %q, want %q",
+			client.Fingerprint(), want)
+	}
+
+	pinned, pinErr := NewClient(addr, resp.ClientToken, TLSConfig{
+		CAFile: pki.caFile, Fingerprint: want,
+	})
+	if pinErr != nil {
+		t.Fatal(pinErr)
+	}
+	defer func() { _ = pinned.Close() }()
+	if _, statusErr := pinned.Status(testCtx()); statusErr != nil {
+		t.Fatalf("Status with matching pin: %v", statusErr)
+	}
+}
+
+func TestTLS_PinMismatchRejected(t *testing.T) {
+	pki := newTestPKI(t)
+	addr := startTLSServer(t, TLSConfig{
+		CertFile: pki.serverCert, KeyFile: pki.serverKey,
+	})
+
+	client, dialErr := NewClient(addr, "", TLSConfig{
+		CAFile: pki.caFile, Fingerprint: strings.Repeat("0", 64),
+	})
+	if dialErr != nil {
+		t.Fatal(dialErr)
+	}
+	defer func() { _ = client.Close() }()
+
+	_, regErr := client.Register(testCtx(), "adm", "pin-proj")
+	if regErr == nil || !strings.Contains(regErr.Error(), "fingerprint") {
+		t.Fatalf("want fingerprint mismatch, got %v", regErr)
+	}
+}
+
+func TestTLS_MutualRequiresClientCert(t *testing.T) {
+	pki := newTestPKI(t)
+	addr := startTLSServer(t, TLSConfig{
+		CertFile: pki.serverCert,
+		KeyFile:  pki.serverKey,
+		CAFile:   pki.caFile,
+	})
+
+	anon, dialErr := NewClient(addr, "", TLSConfig{CAFile: pki.caFile})
+	if dialErr != nil {
+		t.Fatal(dialErr)
+	}
+	defer func() { _ = anon.Close() }()
+	if _, regErr := anon.Register(testCtx(), "adm", "anon"); regErr == nil {
+		t.Fatal("want handshake failure without client cert")
+	}
+
+	mutual, mDialErr := NewClient(addr, "", TLSConfig{
+		CAFile:   pki.caFile,
+		CertFile: pki.clientCert,
+		KeyFile:  pki.clientKey,
+	})
+	if mDialErr != nil {
+		t.Fatal(mDialErr)
+	}
+	defer func() { _ = mutual.Close() }()
+	if _, regErr := mutual.Register(testCtx(), "adm", "mtls"); regErr != nil {
+		t.Fatalf("Register over mutual TLS: %v", regErr)
+	}
+}
+
+func TestTLS_PlaintextClientRejected(t *testing.T) {
+	pki := newTestPKI(t)
+	addr := startTLSServer(t, TLSConfig{
+		CertFile: pki.serverCert, KeyFile: pki.serverKey,
+	})
+
+	plain, dialErr := NewClient(addr, "", TLSConfig{})
+	if dialErr != nil {
+		t.Fatal(dialErr)
+	}
+	defer func() { _ = plain.Close() }()
+	if _, regErr := plain.Register(testCtx(), "adm", "p"); regErr == nil {
+		t.Fatal("plaintext client must not reach a TLS hub")
+	}
+}
+
+func TestTLS_IncompleteKeyPair(t *testing.T) {
+	pki := newTestPKI(t)
+	store, storeErr := NewStore(t.TempDir())
+	if storeErr != nil {
+		t.Fatal(storeErr)
+	}
+	if _, srvErr := NewServer(store, "adm", TLSConfig{
+		CertFile: pki.serverCert,
+	}); srvErr == nil {
+		t.Fatal("want error for cert without key")
+	}
+	if _, srvErr := NewServer(store, "adm", TLSConfig{
+		CAFile: pki.caFile,
+	}); srvErr == nil {
+		t.Fatal("want error for CA without server cert")
+	}
+}
+
+func TestTLS_FailoverPinsEachPeer(t *testing.T) {
+	pki := newTestPKI(t)
+	leader := TLSConfig{CertFile: pki.serverCert, KeyFile: pki.serverKey}
+	follower := TLSConfig{CertFile: pki.peerCert, KeyFile: pki.peerKey}
+	followerAddr := startTLSServer(t, follower)
+
+	reg, dialErr := NewClient(
+		followerAddr, "", TLSConfig{CAFile: pki.caFile},
+	)
+	if dialErr != nil {
+		t.Fatal(dialErr)
+	}
+	resp, regErr := reg.Register(testCtx(), "adm", "peer-proj")
+	if regErr != nil {
+		t.Fatal(regErr)
+	}
+	_ = reg.Close()
+
+	leaderFP, leaderErr := CertFingerprint(leader)
+	followerFP, followerErr := CertFingerprint(follower)
+	if leaderErr != nil || followerErr != nil {
+		t.Fatal(leaderErr, followerErr)
+	}
+
+	shared := TLSConfig{CAFile: pki.caFile, Fingerprint: leaderFP}
+	if _, foErr := newFailoverClient(
+		[]string{followerAddr}, resp.ClientToken, shared,
+	); foErr == nil {
+		t.Fatal("want the leader pin to reject the follower")
+	}
+
+	shared.PeerFingerprints = map[string]string{followerAddr: followerFP}
+	client, foErr := newFailoverClient(
+		[]string{"127.0.0.1:1", followerAddr}, resp.ClientToken, shared,
+	)
+	if foErr != nil {
+		t.Fatalf("failover with per-peer pin: %v", foErr)
+	}
+	_ = client.Close()
+}
@@ -0,0 +1,65 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package hub
+
+import (
+	"crypto/tls"
+	"net"
+	"os"
+	"time"
+
+	"github.com/hashicorp/raft"
+
+	cfgHub "github.com/ActiveMemory/ctx/internal/config/hub"
+)
+
+// newTransport builds the Raft network transport: plain
+// TCP for the zero [TLSConfig], a TLS stream layer
+// otherwise.
+//
+// Parameters:
+//   - bindAddr: address to listen on for peer traffic
+//   - advertise: address reported to other peers
+//   - tlsCfg: transport security settings
+//
+// Returns:
+//   - *raft.NetworkTransport: ready transport
+//   - error: non-nil if the listener or TLS setup fails
+func newTransport(
+	bindAddr string, advertise net.Addr, tlsCfg TLSConfig,
+) (*raft.NetworkTransport, error) {
+	timeout := cfgHub.RaftTimeout * time.Second
+	if !tlsEnabled(tlsCfg) {
+		return raft.NewTCPTransport(
+			bindAddr, advertise, cfgHub.RaftMaxPool,
+			timeout, os.Stderr,
+		)
+	}
+
+	listenCfg, srvErr := serverTLS(tlsCfg)
+	if srvErr != nil {
+		return nil, srvErr
+	}
+	dialCfg, cliErr := clientTLS(tlsCfg)
+	if cliErr != nil {
+		return nil, cliErr
+	}
+	lis, lisErr := tls.Listen(
+		cfgHub.RaftTransport, bindAddr, listenCfg,
+	)
+	if lisErr != nil {
+		return nil, lisErr
+	}
+	layer := &tlsStreamLayer{
+		Listener:  lis,
+		advertise: advertise,
+		dialCfg:   dialCfg,
+	}
+	return raft.NewNetworkTransport(
+		layer, cfgHub.RaftMaxPool, timeout, os.Stderr,
+	), nil
+}
@@ -7,8 +7,11 @@
 package hub
 
 import (
+	"crypto/tls"
 	"encoding/json"
+	"net/http"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	cfgHub "github.com/ActiveMemory/ctx/internal/config/hub"
@@ -21,7 +24,9 @@ import (
 // Every published piece of context is an Entry. Entries are
 // append-only: once published, never modified or deleted.
 // Each entry gets a monotonically increasing sequence number
-// assigned by the hub.
+// assigned by the hub. Withdrawing or replacing knowledge is
+// itself an entry: a correction whose Kind is retract or
+// supersede and whose Ref names the earlier entry.
 //
 // Fields:
 //   - ID: UUID, globally unique
@@ -35,6 +40,13 @@ import (
 //   - Meta: client-advisory hints. NOT authoritative
 //     attribution. See [EntryMeta] and the decision record
 //     at .context/DECISIONS.md [2026-04-11-180000].
+//   - Kind: empty for plain entries; retract or supersede
+//     for corrections. A supersede carries the replacement
+//     in Content.
+//   - Ref: ID of the entry a correction applies to
+//   - Reason: why the correction was made
+//   - Lease: task lease carried by claim, assign, and
+//     release entries
 type Entry struct {
 	ID        string    `json:"id"`
 	Type      string    `json:"type"`
@@ -43,6 +55,42 @@ type Entry struct {
 	Timestamp time.Time `json:"timestamp"`
 	Sequence  uint64    `json:"sequence"`
 	Meta      EntryMeta `json:"meta"`
+	Kind      string    `json:"kind,omitempty"`
+	Ref       string    `json:"ref,omitempty"`
+	Reason    string    `json:"reason,omitempty"`
+	Lease     Lease     `json:"lease,omitzero"`
+}
+
+// Lease is the task lease carried by fleet entries
+// (claim, assign, release). A claim or assign grants
+// Agent the task until Expires; a release gives it back.
+// The hub enforces that only one agent holds a task at a
+// time and stamps Expires from its own clock, so agents
+// never compare leases across skewed clocks.
+//
+// Agent is self-asserted, like Origin: several agents may
+// share one client token, so the hub cannot check the
+// name. It does bind each lease to the client that was
+// granted it (Client), so only that client can renew or
+// release it; agents behind one token are still told apart
+// only by their word.
+//
+// Fields:
+//   - Task: stable task key derived from the task text
+//   - Agent: agent ID the lease is for
+//   - TTL: requested lease length in seconds (claim and
+//     assign only)
+//   - Expires: Unix epoch seconds when the lease lapses;
+//     stamped by the hub on acceptance
+//   - Client: registered client ID that published the
+//     entry; stamped by the hub, any client value is
+//     replaced
+type Lease struct {
+	Task    string `json:"task,omitempty"`
+	Agent   string `json:"agent,omitempty"`
+	TTL     int64  `json:"ttl,omitempty"`
+	Expires int64  `json:"expires,omitempty"`
+	Client  string `json:"client,omitempty"`
 }
 
 // EntryMeta holds client-advisory metadata attached to a
@@ -83,10 +131,65 @@ type EntryMeta struct {
 //   - ID: unique client identifier (UUID)
 //   - ProjectName: name of the project this client represents
 //   - Token: bearer token for authenticating RPCs
+//   - Policy: admin-managed access policy (zero = full
+//     access)
 type ClientInfo struct {
 	ID          string `json:"id"`
 	ProjectName string `json:"project_name"`
 	Token       string `json:"token"`
+	Policy      Policy `json:"policy,omitzero"`
+}
+
+// Policy restricts what a client may publish and read. The
+// zero value allows everything, so clients registered
+// before policies existed keep full access.
+//
+// Fields:
+//   - ReadOnly: reject every publish (CI bots, dashboards)
+//   - Publish: entry types the client may publish (empty =
+//     all types)
+//   - Types: entry types the client may sync or listen to
+//     (empty = all types)
+//   - Origins: origin projects the client may sync or
+//     listen to (empty = all origins)
+type Policy struct {
+	ReadOnly bool     `json:"read_only,omitempty"`
+	Publish  []string `json:"publish,omitempty"`
+	Types    []string `json:"types,omitempty"`
+	Origins  []string `json:"origins,omitempty"`
+}
+
+// TLSConfig names the PEM material for a TLS or mutual-TLS
+// hub transport. The zero value means plaintext.
+//
+// The same shape serves both ends of a connection:
+//
+//   - Server ([NewServer], [NewCluster]): CertFile and
+//     KeyFile are the hub's own certificate. A non-empty
+//     CAFile turns on mutual TLS: every client (and every
+//     Raft peer) must present a certificate signed by it.
+//   - Client ([NewClient]): CAFile verifies the hub's
+//     certificate (system roots when empty). CertFile and
+//     KeyFile, when set, are the client certificate for
+//     mutual TLS. Fingerprint, when set, pins the hub's
+//     leaf certificate by SHA-256. PeerFingerprints pins
+//     each failover peer to its own leaf, since every
+//     cluster member holds a different certificate.
+//
+// Fields:
+//   - CertFile: PEM certificate path
+//   - KeyFile: PEM private key path
+//   - CAFile: PEM CA bundle path
+//   - Fingerprint: hex SHA-256 of the pinned hub leaf
+//     certificate (client side only)
+//   - PeerFingerprints: per-address pins that override
+//     Fingerprint for that peer (client side only)
+type TLSConfig struct {
+	CertFile         string            `json:"cert_file,omitempty"`
+	KeyFile          string            `json:"key_file,omitempty"`
+	CAFile           string            `json:"ca_file,omitempty"`
+	Fingerprint      string            `json:"fingerprint,omitempty"`
+	PeerFingerprints map[string]string `json:"peer_fingerprints,omitempty"`
 }
 
 // Meta holds hub-level metadata persisted alongside the log.
@@ -102,7 +205,10 @@ type Meta struct {
 // Store is an append-only JSONL storage backend for entries.
 //
 // All writes are serialized via a mutex. Entries are appended
-// to a single JSONL file. Client registry and metadata are
+// to the active JSONL file, which is sealed into a numbered
+// segment once it grows past a threshold. Enough sealed
+// segments are compacted into a snapshot that drops
+// corrected entries. Client registry and metadata are
 // stored as separate JSON files.
 //
 // Fields:
@@ -112,19 +218,61 @@ type Meta struct {
 //   - clients: registered client tokens
 //   - tokenIdx: token-to-client index for O(1) lookup
 //   - entries: in-memory cache of all entries (append-only)
+//   - idIdx: entry-ID-to-position index for ref lookup
+//   - terms: search term to ascending entry positions
+//   - corrected: entry ID to the correction that retracted
+//     or superseded it
+//   - tombstones: IDs of corrected entries dropped by
+//     compaction, with their sequence numbers
+//   - leases: task key to the claim or assign that last
+//     granted it; released tasks are absent
+//   - base: sequence covered by the snapshot (0 = none)
+//   - active: entries in the active JSONL file
+//   - sealed: sealed segments not yet compacted
+//   - segmentEntries: rotation threshold for the active file
+//   - compactSegments: sealed segments that trigger
+//     compaction
 type Store struct {
-	dir      string
-	mu       sync.Mutex
-	meta     Meta
-	clients  []ClientInfo
-	tokenIdx map[string]int
-	entries  []Entry
+	dir             string
+	mu              sync.Mutex
+	meta            Meta
+	clients         []ClientInfo
+	tokenIdx        map[string]int
+	entries         []Entry
+	idIdx           map[string]int
+	terms           map[string][]int
+	corrected       map[string]Entry
+	leases          map[string]Entry
+	tombstones      map[string]uint64
+	base            uint64
+	active          int
+	sealed          int
+	segmentEntries  int
+	compactSegments int
+}
+
+// Snapshot is a compacted image of the entry log up to a
+// sequence. Corrected entries are dropped and remembered as
+// tombstones so later corrections and readers still resolve
+// them; correction records themselves are kept.
+//
+// Fields:
+//   - Sequence: highest sequence the snapshot covers
+//   - CreatedAt: when the snapshot was taken
+//   - Entries: surviving entries in sequence order
+//   - Tombstones: dropped entry ID to its sequence
+type Snapshot struct {
+	Sequence   uint64            `json:"sequence"`
+	CreatedAt  time.Time         `json:"created_at"`
+	Entries    []Entry           `json:"entries"`
+	Tombstones map[string]uint64 `json:"tombstones,omitempty"`
 }
 
 // Server is the ctx Hub gRPC server.
 //
 // It implements Register, Publish, Sync, Listen, and Status
-// RPCs backed by an append-only [Store].
+// RPCs backed by an append-only [Store], plus an optional
+// read-only HTTP admin gateway ([Server.ServeAdmin]).
 //
 // Fields:
 //   - store: append-only storage backend
@@ -132,12 +280,105 @@ type Store struct {
 //   - grpc: underlying gRPC server
 //   - listeners: fan-out broadcaster for Listen streams
 //   - cluster: optional Raft cluster for HA
+//   - tls: server TLS settings, shared with the admin
+//     gateway (nil = plaintext)
+//   - adminMu: guards admin and stopped, which ServeAdmin
+//     and GracefulStop touch from different goroutines
+//   - admin: admin HTTP server, once ServeAdmin runs
+//   - stopped: GracefulStop has run; a later ServeAdmin
+//     closes its listener instead of serving
+//   - published: entries accepted by Publish since start
+//   - followers: replication cursors seen on raw Sync
 type Server struct {
 	store      *Store
 	adminToken string
 	grpc       *grpc.Server
 	listeners  *fanOut
 	cluster    *Cluster
+	tls        *tls.Config
+	adminMu    sync.Mutex
+	admin      *http.Server
+	stopped    bool
+	published  atomic.Uint64
+	followers  *followerSet
+}
+
+// followerSet records the last raw Sync of each
+// replicating client, for lag reporting.
+//
+// Fields:
+//   - mu: guards byClient
+//   - byClient: client ID to its last replication state
+type followerSet struct {
+	mu       sync.Mutex
+	byClient map[string]Follower
+}
+
+// Follower is a replicating client as seen by this hub.
+//
+// Fields:
+//   - ClientID: follower's client ID
+//   - ProjectName: follower's registered project
+//   - Sequence: cursor the follower sent on its last sync
+//   - Lag: entries the follower was behind at that sync
+//   - LastSync: when that sync arrived
+type Follower struct {
+	ClientID    string    `json:"client_id"`
+	ProjectName string    `json:"project_name"`
+	Sequence    uint64    `json:"sequence"`
+	Lag         uint64    `json:"lag"`
+	LastSync    time.Time `json:"last_sync"`
+}
+
+// AdminStatus is the admin gateway's status document.
+//
+// Fields:
+//   - Role: standalone, leader, or follower
+//   - Leader: Raft leader address (cluster mode only)
+//   - Sequence: last assigned entry sequence
+//   - SnapshotSequence: sequence the snapshot covers
+//   - TotalEntries: entries held in the store
+//   - Published: entries accepted since start
+//   - Listeners: open Listen streams
+//   - DroppedListeners: Listen streams dropped for lag
+//   - EntriesByType: entry count per type
+//   - EntriesByProject: entry count per origin project
+type AdminStatus struct {
+	Role             string            `json:"role"`
+	Leader           string            `json:"leader,omitempty"`
+	Sequence         uint64            `json:"sequence"`
+	SnapshotSequence uint64            `json:"snapshot_sequence"`
+	TotalEntries     uint64            `json:"total_entries"`
+	Published        uint64            `json:"published_entries"`
+	Listeners        uint32            `json:"listeners"`
+	DroppedListeners uint64            `json:"dropped_listeners"`
+	EntriesByType    map[string]uint64 `json:"entries_by_type"`
+	EntriesByProject map[string]uint64 `json:"entries_by_project"`
+}
+
+// AdminClient is one registered client as listed by the
+// admin gateway. The token is never exposed.
+//
+// Fields:
+//   - ID: client identifier
+//   - ProjectName: registered project
+//   - Policy: the client's access policy
+type AdminClient struct {
+	ID          string `json:"id"`
+	ProjectName string `json:"project_name"`
+	Policy      Policy `json:"policy"`
+}
+
+// AdminLeader is the admin gateway's leadership document.
+//
+// Fields:
+//   - Role: standalone, leader, or follower
+//   - Leader: Raft leader address (cluster mode only)
+//   - Followers: replicating clients seen by this node
+type AdminLeader struct {
+	Role      string     `json:"role"`
+	Leader    string     `json:"leader,omitempty"`
+	Followers []Follower `json:"followers"`
 }
 
 // fanOut manages real-time entry broadcast to listeners.
@@ -182,6 +423,41 @@ type RevokeRequest struct {
 	ClientID   string `json:"client_id"`
 }
 
+// PolicyRequest is the input for the Policy RPC.
+//
+// Fields:
+//   - AdminToken: admin token from server startup
+//   - ClientID: ID of the client whose policy to read
+type PolicyRequest struct {
+	AdminToken string `json:"admin_token"`
+	ClientID   string `json:"client_id"`
+}
+
+// SetPolicyRequest is the input for the SetPolicy RPC.
+//
+// Fields:
+//   - AdminToken: admin token from server startup
+//   - ClientID: ID of the client whose policy to replace
+//   - Policy: new policy; replaces the old one entirely
+type SetPolicyRequest struct {
+	AdminToken string `json:"admin_token"`
+	ClientID   string `json:"client_id"`
+	Policy     Policy `json:"policy"`
+}
+
+// PolicyResponse is the output of the Policy and SetPolicy
+// RPCs.
+//
+// Fields:
+//   - ClientID: client the policy belongs to
+//   - ProjectName: that client's project
+//   - Policy: the client's current policy
+type PolicyResponse struct {
+	ClientID    string `json:"client_id"`
+	ProjectName string `json:"project_name"`
+	Policy      Policy `json:"policy"`
+}
+
 // RevokeResponse is the output of the Revoke RPC. It carries
 // no fields; a nil error signals the client was revoked.
 type RevokeResponse struct{}
@@ -210,6 +486,13 @@ type PublishRequest struct {
 //     verbatim (subject to validateEntryMeta size and
 //     character limits), never promoted to
 //     authoritative attribution.
+//   - Kind: empty, retract, or supersede
+//   - Ref: ID of the corrected entry (corrections only).
+//     A retraction may leave Type empty; the hub stamps
+//     the target's type.
+//   - Reason: required on corrections
+//   - Lease: required on claim, assign, and release;
+//     Expires is ignored and stamped by the hub
 type PublishEntry struct {
 	ID        string    `json:"id"`
 	Type      string    `json:"type"`
@@ -217,6 +500,10 @@ type PublishEntry struct {
 	Origin    string    `json:"origin"`
 	Timestamp int64     `json:"timestamp"`
 	Meta      EntryMeta `json:"meta"`
+	Kind      string    `json:"kind,omitempty"`
+	Ref       string    `json:"ref,omitempty"`
+	Reason    string    `json:"reason,omitempty"`
+	Lease     Lease     `json:"lease,omitzero"`
 }
 
 // PublishResponse is the output of the Publish RPC.
@@ -227,14 +514,81 @@ type PublishResponse struct {
 	Sequences []uint64 `json:"sequences"`
 }
 
+// SnapshotRequest is the input for the Snapshot RPC.
+type SnapshotRequest struct{}
+
+// SnapshotChunk is one message of the Snapshot RPC
+// (server-streaming). The first chunk carries Sequence and
+// Tombstones; every chunk carries a slice of Entries.
+//
+// Fields:
+//   - Sequence: highest sequence the snapshot covers
+//   - Tombstones: dropped entry ID to its sequence
+//   - Entries: surviving entries in sequence order
+type SnapshotChunk struct {
+	Sequence   uint64            `json:"sequence,omitempty"`
+	Tombstones map[string]uint64 `json:"tombstones,omitempty"`
+	Entries    []EntryMsg        `json:"entries"`
+}
+
+// SearchRequest is the input for the Search RPC. Every
+// filter is optional; an empty request returns the newest
+// entries.
+//
+// Fields:
+//   - Text: words that must all appear in the content
+//     (case-insensitive, whole words)
+//   - Types: entry types to include (empty = all)
+//   - Origins: origin projects to include (empty = all)
+//   - Since: earliest entry timestamp, Unix seconds
+//     (0 = unbounded)
+//   - Until: latest entry timestamp, Unix seconds
+//     (0 = unbounded)
+//   - Limit: maximum results (0 = SearchLimit)
+type SearchRequest struct {
+	Text    string   `json:"text,omitempty"`
+	Types   []string `json:"types,omitempty"`
+	Origins []string `json:"origins,omitempty"`
+	Since   int64    `json:"since,omitempty"`
+	Until   int64    `json:"until,omitempty"`
+	Limit   int      `json:"limit,omitempty"`
+}
+
+// SearchResponse is the output of the Search RPC.
+//
+// Fields:
+//   - Entries: matching entries, newest first
+//   - More: true when further entries matched past Limit
+type SearchResponse struct {
+	Entries []EntryMsg `json:"entries"`
+	More    bool       `json:"more,omitempty"`
+}
+
+// LeasesRequest is the input for the Leases RPC. It has
+// no fields; the caller's policy decides what it sees.
+type LeasesRequest struct{}
+
+// LeasesResponse is the output of the Leases RPC.
+//
+// Fields:
+//   - Leases: claim and assign records in force, one per
+//     task, sorted by task key
+type LeasesResponse struct {
+	Leases []EntryMsg `json:"leases"`
+}
+
 // SyncRequest is the input for the Sync RPC.
 //
 // Fields:
 //   - Types: entry types to sync (empty = all)
 //   - SinceSequence: return entries after this sequence
+//   - Raw: stream the log verbatim, including entries
+//     that were retracted or superseded. Replication
+//     sets it so followers keep sequence parity.
 type SyncRequest struct {
 	Types         []string `json:"types"`
 	SinceSequence uint64   `json:"since_sequence"`
+	Raw           bool     `json:"raw,omitempty"`
 }
 
 // ListenRequest is the input for the Listen RPC.
@@ -258,6 +612,10 @@ type ListenRequest struct {
 //   - Timestamp: Unix epoch seconds
 //   - Sequence: hub-assigned sequence
 //   - Meta: client-advisory hints forwarded to readers
+//   - Kind: empty, retract, or supersede
+//   - Ref: ID of the corrected entry
+//   - Reason: why the correction was made
+//   - Lease: task lease of a fleet entry
 type EntryMsg struct {
 	ID        string    `json:"id"`
 	Type      string    `json:"type"`
@@ -266,6 +624,10 @@ type EntryMsg struct {
 	Timestamp int64     `json:"timestamp"`
 	Sequence  uint64    `json:"sequence"`
 	Meta      EntryMeta `json:"meta"`
+	Kind      string    `json:"kind,omitempty"`
+	Ref       string    `json:"ref,omitempty"`
+	Reason    string    `json:"reason,omitempty"`
+	Lease     Lease     `json:"lease,omitzero"`
 }
 
 // StatusResponse is the output of the Status RPC.
@@ -287,9 +649,13 @@ type StatusResponse struct {
 // Fields:
 //   - conn: underlying gRPC connection
 //   - token: bearer token for authenticated RPCs
+//   - fingerprint: hex SHA-256 of the hub's leaf
+//     certificate as seen by the last Register call
+//     (empty over plaintext)
 type Client struct {
-	conn  *grpc.ClientConn
-	token string
+	conn        *grpc.ClientConn
+	token       string
+	fingerprint string
 }
 
 // Cluster wraps a Raft node for leader election only.
@@ -19,37 +19,39 @@ import (
 // bearerPrefix is stripped from the authorization header.
 const bearerPrefix = cfgHub.BearerPrefix
 
-// validateBearer extracts and validates the bearer token
-// from gRPC metadata.
+// authenticate validates the bearer token and returns the
+// client it belongs to, so handlers can apply its policy.
 //
 // Parameters:
 //   - ctx: request context with gRPC metadata
 //   - store: store for token validation
 //
 // Returns:
+//   - ClientInfo: copy of the authenticated client
 //   - error: non-nil if token is missing or invalid
-func validateBearer(
+func authenticate(
 	ctx context.Context, store *Store,
-) error {
+) (ClientInfo, error) {
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
-		return status.Error(
+		return ClientInfo{}, status.Error(
 			codes.Unauthenticated, cfgHub.ErrMissingMetadata,
 		)
 	}
 
 	vals := md.Get(cfgHub.HeaderAuthorization)
 	if len(vals) == 0 {
-		return status.Error(
+		return ClientInfo{}, status.Error(
 			codes.Unauthenticated, cfgHub.ErrMissingToken,
 		)
 	}
 
 	token := strings.TrimPrefix(vals[0], bearerPrefix)
-	if store.ValidateToken(token) == nil {
-		return status.Error(
+	client := store.ValidateToken(token)
+	if client == nil {
+		return ClientInfo{}, status.Error(
 			codes.Unauthenticated, cfgHub.ErrInvalidToken,
 		)
 	}
-	return nil
+	return *client, nil
 }
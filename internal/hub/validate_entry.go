@@ -18,7 +18,8 @@ import (
 
 // validateEntry checks a PublishEntry for required fields
 // and enforces size limits, including the Meta
-// sub-struct.
+// sub-struct and the correction fields. A retraction may
+// omit Type; the handler stamps it from the target.
 //
 // Parameters:
 //   - pe: entry to validate
@@ -31,7 +32,10 @@ func validateEntry(pe PublishEntry) error {
 			codes.InvalidArgument, cfgHub.ErrEntryIDRequired,
 		)
 	}
-	if !cfgEntry.AllowedTypes[pe.Type] {
+	untyped := pe.Kind == cfgHub.KindRetract && pe.Type == ""
+	known := cfgEntry.AllowedTypes[pe.Type] ||
+		cfgEntry.FleetTypes[pe.Type]
+	if !untyped && !known {
 		return status.Errorf(
 			codes.InvalidArgument,
 			cfgHub.ErrInvalidEntryType, pe.Type,
@@ -49,9 +53,143 @@ func validateEntry(pe PublishEntry) error {
 			cfgHub.ErrEntryContentOversize,
 		)
 	}
+	if kindErr := validateEntryKind(pe); kindErr != nil {
+		return kindErr
+	}
+	if leaseErr := validateLease(pe); leaseErr != nil {
+		return leaseErr
+	}
 	return validateEntryMeta(pe.Meta)
 }
 
+// validateLease checks the lease of a fleet entry in
+// isolation: task and agent present, single-line, and
+// capped; a TTL in range on claims and assigns and none on
+// releases. Plain entries must carry no lease, and fleet
+// entries cannot be corrections. Whether the lease
+// conflicts with a holder is checked by the store.
+//
+// Parameters:
+//   - pe: entry to check
+//
+// Returns:
+//   - error: InvalidArgument status on the first problem
+func validateLease(pe PublishEntry) error {
+	if !cfgEntry.FleetTypes[pe.Type] {
+		if pe.Lease != (Lease{}) {
+			return status.Error(
+				codes.InvalidArgument, cfgHub.ErrLeaseUnexpected,
+			)
+		}
+		return nil
+	}
+	if pe.Kind != "" {
+		return status.Errorf(
+			codes.InvalidArgument, cfgHub.ErrLeaseKind, pe.Type,
+		)
+	}
+	fields := []struct {
+		name  string
+		value string
+	}{
+		{cfgHub.FieldTask, pe.Lease.Task},
+		{cfgHub.FieldAgent, pe.Lease.Agent},
+	}
+	for _, f := range fields {
+		if f.value == "" {
+			return status.Errorf(
+				codes.InvalidArgument,
+				cfgHub.ErrLeaseFieldRequired, pe.Type, f.name,
+			)
+		}
+		if len(f.value) > cfgHub.MaxLeaseFieldLen {
+			return status.Errorf(
+				codes.InvalidArgument,
+				cfgHub.ErrLeaseFieldOversize,
+				f.name, cfgHub.MaxLeaseFieldLen,
+			)
+		}
+		if metaCharCheck(f.name, f.value) != nil {
+			return status.Errorf(
+				codes.InvalidArgument,
+				cfgHub.ErrLeaseControlChar, f.name,
+			)
+		}
+	}
+	if pe.Type == cfgEntry.Release {
+		if pe.Lease.TTL != 0 {
+			return status.Error(
+				codes.InvalidArgument,
+				cfgHub.ErrLeaseTTLUnexpected,
+			)
+		}
+		return nil
+	}
+	if pe.Lease.TTL < 1 || pe.Lease.TTL > cfgHub.MaxLeaseTTL {
+		return status.Errorf(
+			codes.InvalidArgument,
+			cfgHub.ErrLeaseTTL, pe.Type, cfgHub.MaxLeaseTTL,
+		)
+	}
+	return nil
+}
+
+// validateEntryKind checks the correction fields of a
+// PublishEntry in isolation: a known kind, a ref and a
+// single-line reason on corrections, and neither on
+// plain entries. Whether the ref resolves is checked
+// against the store by the publish handler.
+//
+// Parameters:
+//   - pe: entry to check
+//
+// Returns:
+//   - error: InvalidArgument status on the first problem
+func validateEntryKind(pe PublishEntry) error {
+	switch pe.Kind {
+	case "":
+		if pe.Ref != "" || pe.Reason != "" {
+			return status.Error(
+				codes.InvalidArgument,
+				cfgHub.ErrEntryRefUnexpected,
+			)
+		}
+		return nil
+	case cfgHub.KindRetract, cfgHub.KindSupersede:
+	default:
+		return status.Errorf(
+			codes.InvalidArgument,
+			cfgHub.ErrInvalidEntryKind, pe.Kind,
+		)
+	}
+	if pe.Ref == "" {
+		return status.Errorf(
+			codes.InvalidArgument,
+			cfgHub.ErrEntryRefRequired, pe.Kind,
+		)
+	}
+	if pe.Reason == "" {
+		return status.Errorf(
+			codes.InvalidArgument,
+			cfgHub.ErrEntryReasonRequired, pe.Kind,
+		)
+	}
+	if len(pe.Reason) > cfgHub.MaxReasonLen {
+		return status.Errorf(
+			codes.InvalidArgument,
+			cfgHub.ErrEntryReasonOversize,
+			cfgHub.MaxReasonLen,
+		)
+	}
+	if metaCharCheck(cfgHub.FieldReason, pe.Reason) != nil {
+		return status.Error(
+			codes.InvalidArgument,
+			cfgHub.ErrEntryReasonControlChar,
+		)
+	}
+	return nil
+}
+
 // validateEntryMeta enforces size and character
 // restrictions on client-advisory metadata.
 //
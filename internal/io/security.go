@@ -389,3 +389,36 @@ func SafePost(
 	// scheme validated above
 	return client.Post(rawURL, contentType, bytes.NewReader(body))
 }
+
+// SafeDo sends a prepared HTTP request with the same protections as
+// SafePost (scheme validation, redirect cap, caller timeout). Use it
+// when the request needs headers beyond a content type, such as an
+// Authorization header for a user-configured AI backend.
+//
+// Parameters:
+//   - req: the request to send; its URL must come from a trusted,
+//     user-configured origin
+//   - timeout: per-request timeout (includes redirect hops)
+//
+// Returns:
+//   - *http.Response: the HTTP response (caller must close Body)
+//   - error: on scheme validation failure, redirect cap, or HTTP error
+func SafeDo(req *http.Request, timeout time.Duration) (*http.Response, error) {
+	if schemeErr := validateHTTPScheme(req.URL.String()); schemeErr != nil {
+		return nil, schemeErr
+	}
+
+	client := &http.Client{
+		Timeout: timeout,
+		CheckRedirect: func(_ *http.Request, via []*http.Request) error {
+			if len(via) >= maxRedirects {
+				return errHTTP.TooManyRedirects()
+			}
+			return nil
+		},
+	}
+
+	//nolint:gosec // URL originates from the user's .ctxrc; scheme
+	// validated above
+	return client.Do(req)
+}
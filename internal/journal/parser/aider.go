@@ -0,0 +1,166 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package parser
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"strings"
+
+	cfgAider "github.com/ActiveMemory/ctx/internal/config/aider"
+	"github.com/ActiveMemory/ctx/internal/config/file"
+	"github.com/ActiveMemory/ctx/internal/config/parser"
+	"github.com/ActiveMemory/ctx/internal/config/session"
+	cfgWarn "github.com/ActiveMemory/ctx/internal/config/warn"
+	"github.com/ActiveMemory/ctx/internal/entity"
+	errParser "github.com/ActiveMemory/ctx/internal/err/parser"
+	execGit "github.com/ActiveMemory/ctx/internal/exec/git"
+	"github.com/ActiveMemory/ctx/internal/io"
+	"github.com/ActiveMemory/ctx/internal/log/warn"
+)
+
+// NewAider creates a new Aider chat history parser.
+//
+// Returns:
+//   - *Aider: a new parser instance
+func NewAider() *Aider {
+	return &Aider{}
+}
+
+// Tool returns the tool identifier for this parser.
+//
+// Returns:
+//   - string: the Aider tool identifier
+func (p *Aider) Tool() string {
+	return session.ToolAider
+}
+
+// Matches returns true if the file appears to be an Aider chat history.
+//
+// The default history file name always matches. Any other Markdown
+// file matches when one of its first lines is a session start marker,
+// which covers histories written with --chat-history-file.
+//
+// Parameters:
+//   - path: file path to check
+//
+// Returns:
+//   - bool: true if the file is an Aider chat history
+func (p *Aider) Matches(path string) bool {
+	if filepath.Base(path) == cfgAider.FileChatHistory {
+		return true
+	}
+	if !strings.HasSuffix(path, file.ExtMarkdown) {
+		return false
+	}
+
+	f, openErr := io.SafeOpenUserFile(path)
+	if openErr != nil {
+		return false
+	}
+	defer func() {
+		if closeErr := f.Close(); closeErr != nil {
+			warn.Warn(cfgWarn.Close, path, closeErr)
+		}
+	}()
+
+	scanner := bufio.NewScanner(f)
+	for i := 0; i < parser.LinesToPeek && scanner.Scan(); i++ {
+		if strings.HasPrefix(scanner.Text(), cfgAider.MarkerStart) {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseFile reads an Aider chat history and returns one session per
+// "# aider chat started at" marker.
+//
+// Sessions without a prompt (Aider started and quit) are dropped.
+// The prompt history next to the file, when present, supplies
+// per-prompt timestamps.
+//
+// Parameters:
+//   - path: path to the chat history file
+//
+// Returns:
+//   - []*entity.Session: the sessions in file order
+//   - error: non-nil if the file cannot be read
+func (p *Aider) ParseFile(path string) ([]*entity.Session, error) {
+	content, readErr := io.SafeReadUserFile(filepath.Clean(path))
+	if readErr != nil {
+		return nil, errParser.ReadFile(readErr)
+	}
+
+	cwd := filepath.Dir(path)
+	if abs, absErr := filepath.Abs(cwd); absErr == nil {
+		cwd = abs
+	}
+	inputs := readAiderInputs(aiderInputPath(cwd))
+
+	var sessions []*entity.Session
+	for _, chunk := range splitAider(string(content)) {
+		if s := p.buildSession(chunk, inputs, cwd, path); s != nil {
+			sessions = append(sessions, s)
+		}
+	}
+	return sessions, nil
+}
+
+// ParseLine is not applicable for Aider histories (sessions span many
+// lines of Markdown).
+//
+// Parameters:
+//   - line: Ignored
+//
+// Returns:
+//   - nil, "", nil always
+func (p *Aider) ParseLine(_ []byte) (*entity.Message, string, error) {
+	return nil, "", nil
+}
+
+// AiderHistoryFiles returns the Aider chat history files for the
+// current project: $AIDER_CHAT_HISTORY_FILE when set, and the default
+// history file in the working directory and the repository root.
+//
+// Returns:
+//   - []string: paths to existing history files, without duplicates
+func AiderHistoryFiles() []string {
+	var candidates []string
+	if env := os.Getenv(cfgAider.EnvChatHistory); env != "" {
+		candidates = append(candidates, env)
+	}
+	if cwd, cwdErr := os.Getwd(); cwdErr == nil {
+		candidates = append(
+			candidates, filepath.Join(cwd, cfgAider.FileChatHistory),
+		)
+	}
+	if root, rootErr := execGit.Root(); rootErr == nil {
+		candidates = append(
+			candidates, filepath.Join(root, cfgAider.FileChatHistory),
+		)
+	}
+
+	seen := make(map[string]bool)
+	var files []string
+	for _, c := range candidates {
+		c = filepath.Clean(c)
+		if seen[c] {
+			continue
+		}
+		seen[c] = true
+		if info, statErr := io.SafeStat(c); statErr == nil &&
+			info.Mode().IsRegular() {
+			files = append(files, c)
+		}
+	}
+	return files
+}
+
+// Ensure Aider implements Session.
+var _ Session = (*Aider)(nil)
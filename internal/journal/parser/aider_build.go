@@ -0,0 +1,452 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package parser
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"math"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	cfgAider "github.com/ActiveMemory/ctx/internal/config/aider"
+	"github.com/ActiveMemory/ctx/internal/config/claude"
+	"github.com/ActiveMemory/ctx/internal/config/regex"
+	"github.com/ActiveMemory/ctx/internal/config/session"
+	"github.com/ActiveMemory/ctx/internal/config/token"
+	"github.com/ActiveMemory/ctx/internal/entity"
+	"github.com/ActiveMemory/ctx/internal/i18n"
+	"github.com/ActiveMemory/ctx/internal/io"
+)
+
+// buildSession converts one chunk of an Aider chat history into a
+// Session entity.
+//
+// Consecutive "####" lines form one user message. Everything after it
+// until the next prompt (reply text and Aider's notices) forms one
+// assistant message: SEARCH/REPLACE blocks and "Applied edit to"
+// notices become Edit tool uses, "Added ... to the chat" notices
+// become Add tool uses, token reports set the message's token counts,
+// and "Commit <hash>" notices are collected as the session's git refs.
+//
+// Parameters:
+//   - chunk: the session's lines and start time
+//   - inputs: prompts from the input history, oldest first
+//   - cwd: absolute directory of the history file
+//   - sourcePath: path to the history file
+//
+// Returns:
+//   - *entity.Session: the built session, or nil if it has no prompt
+func (p *Aider) buildSession(
+	chunk aiderChunk, inputs []aiderInput, cwd, sourcePath string,
+) *entity.Session {
+	sum := sha256.Sum256([]byte(
+		cwd + token.NewlineLF + chunk.Start.Format(cfgAider.TimeFormat),
+	))
+	sess := &entity.Session{
+		ID:         hex.EncodeToString(sum[:])[:cfgAider.IDLen],
+		Tool:       session.ToolAider,
+		SourceFile: sourcePath,
+		CWD:        cwd,
+		Project:    filepath.Base(cwd),
+		StartTime:  chunk.Start,
+		EndTime:    chunk.Start,
+	}
+
+	var msgs []*entity.Message
+	var user, reply *entity.Message
+	var replyLines, applied []string
+
+	// closeReply turns the collected reply lines into text and edit
+	// tool uses.
+	closeReply := func() {
+		if reply == nil {
+			return
+		}
+		reply.Text = strings.TrimSpace(
+			strings.Join(replyLines, token.NewlineLF),
+		)
+		reply.ToolUses = append(reply.ToolUses, aiderEdits(replyLines)...)
+		for _, name := range applied {
+			if !aiderEdited(reply.ToolUses, name) {
+				reply.ToolUses = append(
+					reply.ToolUses, aiderToolUse(session.ToolEdit, name, nil),
+				)
+			}
+		}
+		reply, replyLines, applied = nil, nil, nil
+	}
+	assistant := func() *entity.Message {
+		if reply == nil {
+			ts := chunk.Start
+			if user != nil {
+				ts = user.Timestamp
+			}
+			reply = &entity.Message{Timestamp: ts, Role: claude.RoleAssistant}
+			msgs = append(msgs, reply)
+		}
+		return reply
+	}
+
+	for _, line := range chunk.Lines {
+		if text, ok := strings.CutPrefix(line, cfgAider.PrefixUser); ok {
+			text = aiderTrim(text)
+			if text == cfgAider.BlankInput {
+				continue
+			}
+			if user != nil && reply == nil {
+				user.Text += token.NewlineLF + text
+				continue
+			}
+			closeReply()
+			user = &entity.Message{
+				Timestamp: chunk.Start, Role: claude.RoleUser, Text: text,
+			}
+			msgs = append(msgs, user)
+			continue
+		}
+
+		notice, ok := strings.CutPrefix(line, cfgAider.PrefixNotice)
+		if !ok {
+			if reply != nil || strings.TrimSpace(line) != "" {
+				assistant()
+				replyLines = append(replyLines, line)
+			}
+			continue
+		}
+
+		notice = strings.TrimSpace(notice)
+		switch {
+		case strings.HasPrefix(notice, cfgAider.NoticeMainModel),
+			strings.HasPrefix(notice, cfgAider.NoticeModel):
+			if sess.Model == "" {
+				_, model, _ := strings.Cut(notice, token.Colon)
+				model, _, _ = strings.Cut(
+					strings.TrimSpace(model), cfgAider.ModelSeparator,
+				)
+				sess.Model = model
+			}
+		case strings.HasPrefix(notice, cfgAider.NoticeAdded) &&
+			strings.Contains(notice, cfgAider.NoticeAddedSuffix):
+			name := strings.TrimPrefix(notice, cfgAider.NoticeAdded)
+			name, _, _ = strings.Cut(name, cfgAider.NoticeAddedSuffix)
+			msg := assistant()
+			msg.ToolUses = append(
+				msg.ToolUses, aiderToolUse(cfgAider.ToolAdd, name, nil),
+			)
+		case strings.HasPrefix(notice, cfgAider.NoticeApplied):
+			assistant()
+			applied = append(applied, strings.TrimSpace(
+				strings.TrimPrefix(notice, cfgAider.NoticeApplied),
+			))
+		case strings.HasPrefix(notice, cfgAider.NoticeCommit):
+			fields := strings.Fields(notice)
+			if len(fields) > 1 && !slices.Contains(sess.GitRefs, fields[1]) {
+				sess.GitRefs = append(sess.GitRefs, fields[1])
+			}
+		default:
+			if m := regex.AiderTokens.FindStringSubmatch(notice); m != nil {
+				msg := assistant()
+				msg.TokensIn += aiderCount(m[1])
+				msg.TokensOut += aiderCount(m[2])
+			}
+		}
+	}
+	closeReply()
+
+	aiderStamp(msgs, inputs, chunk.Start, chunk.Next)
+
+	for _, msg := range msgs {
+		sess.Messages = append(sess.Messages, *msg)
+		sess.TotalTokensIn += msg.TokensIn
+		sess.TotalTokensOut += msg.TokensOut
+		if msg.Timestamp.After(sess.EndTime) {
+			sess.EndTime = msg.Timestamp
+		}
+		if !msg.BelongsToUser() {
+			continue
+		}
+		sess.TurnCount++
+		if sess.FirstUserMsg == "" {
+			preview := msg.Text
+			if len(preview) > session.PreviewMaxLen {
+				preview = preview[:session.PreviewMaxLen] + token.Ellipsis
+			}
+			sess.FirstUserMsg = preview
+		}
+	}
+	if sess.TurnCount == 0 {
+		return nil
+	}
+	sess.TotalTokens = sess.TotalTokensIn + sess.TotalTokensOut
+	sess.Duration = sess.EndTime.Sub(sess.StartTime)
+
+	return sess
+}
+
+// splitAider cuts a chat history into sessions at the start markers.
+// Text before the first marker, and markers whose time does not
+// parse, are ignored.
+//
+// Parameters:
+//   - content: the whole history file
+//
+// Returns:
+//   - []aiderChunk: sessions in file order, each knowing the start of
+//     the next (zero for the last)
+func splitAider(content string) []aiderChunk {
+	var chunks []aiderChunk
+	content = strings.ReplaceAll(content, token.NewlineCRLF, token.NewlineLF)
+	for _, line := range strings.Split(content, token.NewlineLF) {
+		if stamp, ok := strings.CutPrefix(line, cfgAider.MarkerStart); ok {
+			start, parseErr := time.ParseInLocation(
+				cfgAider.TimeFormat, strings.TrimSpace(stamp), time.Local,
+			)
+			if parseErr == nil {
+				if n := len(chunks); n > 0 {
+					chunks[n-1].Next = start
+				}
+				chunks = append(chunks, aiderChunk{Start: start})
+				continue
+			}
+		}
+		if n := len(chunks); n > 0 {
+			chunks[n-1].Lines = append(chunks[n-1].Lines, line)
+		}
+	}
+	return chunks
+}
+
+// aiderInputPath returns the prompt history that belongs with a chat
+// history: $AIDER_INPUT_HISTORY_FILE when set, else the default file
+// in the same directory.
+//
+// Parameters:
+//   - dir: directory of the chat history file
+//
+// Returns:
+//   - string: path to the prompt history (may not exist)
+func aiderInputPath(dir string) string {
+	if env := os.Getenv(cfgAider.EnvInputHistory); env != "" {
+		return env
+	}
+	return filepath.Join(dir, cfgAider.FileInputHistory)
+}
+
+// aiderStamp gives user messages the time Aider recorded for the
+// matching prompt, and each reply the time of its prompt. Prompts are
+// matched in order by text within the session's time range; messages
+// without a match keep their current timestamp.
+//
+// Parameters:
+//   - msgs: the session's messages in order
+//   - inputs: prompts from the input history, oldest first
+//   - start: session start time
+//   - next: start of the following session (zero for none)
+func aiderStamp(
+	msgs []*entity.Message, inputs []aiderInput, start, next time.Time,
+) {
+	pos := 0
+	var last time.Time
+	for _, msg := range msgs {
+		if !msg.BelongsToUser() {
+			if !last.IsZero() {
+				msg.Timestamp = last
+			}
+			continue
+		}
+		for i := pos; i < len(inputs); i++ {
+			in := inputs[i]
+			if in.Time.Before(start) {
+				continue
+			}
+			if !next.IsZero() && !in.Time.Before(next) {
+				break
+			}
+			if in.Text == msg.Text {
+				msg.Timestamp = in.Time
+				last = in.Time
+				pos = i + 1
+				break
+			}
+		}
+	}
+}
+
+// aiderEdits extracts SEARCH/REPLACE blocks from reply lines as Edit
+// tool uses. The file name is the last non-blank, non-fence line
+// before the block.
+//
+// Parameters:
+//   - lines: reply lines
+//
+// Returns:
+//   - []entity.ToolUse: one Edit per block, in order
+func aiderEdits(lines []string) []entity.ToolUse {
+	var uses []entity.ToolUse
+	for i := 0; i < len(lines); i++ {
+		if strings.TrimSpace(lines[i]) != cfgAider.EditSearch {
+			continue
+		}
+		name := ""
+		for j := i - 1; j >= 0; j-- {
+			prev := strings.TrimSpace(lines[j])
+			if prev == "" || regex.CodeFenceLine.MatchString(prev) {
+				continue
+			}
+			name = prev
+			break
+		}
+
+		var search, replace []string
+		j := i + 1
+		for ; j < len(lines) &&
+			strings.TrimSpace(lines[j]) != cfgAider.EditDivider; j++ {
+			search = append(search, lines[j])
+		}
+		for j++; j < len(lines) &&
+			strings.TrimSpace(lines[j]) != cfgAider.EditReplace; j++ {
+			replace = append(replace, lines[j])
+		}
+		i = j
+
+		uses = append(uses, aiderToolUse(session.ToolEdit, name, map[string]string{
+			session.ToolInputOldString: strings.Join(search, token.NewlineLF),
+			session.ToolInputNewString: strings.Join(replace, token.NewlineLF),
+		}))
+	}
+	return uses
+}
+
+// aiderToolUse builds a tool use whose input names a file.
+//
+// Parameters:
+//   - name: tool name
+//   - path: the file the tool acted on
+//   - extra: additional input fields (may be nil)
+//
+// Returns:
+//   - entity.ToolUse: the tool use with JSON input
+func aiderToolUse(
+	name, path string, extra map[string]string,
+) entity.ToolUse {
+	input := map[string]string{session.ToolInputFilePath: path}
+	for k, v := range extra {
+		input[k] = v
+	}
+	// Acceptable discard: a map of strings always marshals.
+	data, _ := json.Marshal(input)
+	return entity.ToolUse{Name: name, Input: string(data)}
+}
+
+// aiderEdited reports whether the tool uses already hold an Edit of
+// the named file.
+//
+// Parameters:
+//   - uses: tool uses of a reply
+//   - path: file name from an "Applied edit to" notice
+//
+// Returns:
+//   - bool: true when an Edit of path is present
+func aiderEdited(uses []entity.ToolUse, path string) bool {
+	for _, u := range uses {
+		if u.Name != session.ToolEdit {
+			continue
+		}
+		var input map[string]string
+		if json.Unmarshal([]byte(u.Input), &input) == nil &&
+			input[session.ToolInputFilePath] == path {
+			return true
+		}
+	}
+	return false
+}
+
+// aiderTrim strips the space after a line prefix and the trailing
+// Markdown hard break.
+//
+// Parameters:
+//   - text: line text after its prefix
+//
+// Returns:
+//   - string: the bare text
+func aiderTrim(text string) string {
+	text = strings.TrimSuffix(text, cfgAider.LineBreak)
+	return strings.TrimPrefix(text, token.Space)
+}
+
+// aiderCount parses a token count as Aider prints it: "150",
+// "1,234", "2.3k", or "1.1M".
+//
+// Parameters:
+//   - text: the printed count
+//
+// Returns:
+//   - int: the count (0 when it does not parse)
+func aiderCount(text string) int {
+	text = i18n.Fold(strings.ReplaceAll(text, token.Comma, ""))
+	scale := 1.0
+	if rest, ok := strings.CutSuffix(text, cfgAider.SuffixKilo); ok {
+		text, scale = rest, cfgAider.Kilo
+	} else if rest, ok := strings.CutSuffix(text, cfgAider.SuffixMega); ok {
+		text, scale = rest, cfgAider.Mega
+	}
+	n, parseErr := strconv.ParseFloat(text, 64)
+	if parseErr != nil {
+		return 0
+	}
+	return int(math.Round(n * scale))
+}
+
+// readAiderInputs reads the prompt history. Each prompt is a
+// "# <timestamp>" line followed by its lines, each prefixed with
+// "+". A missing or unreadable file yields no prompts.
+//
+// Parameters:
+//   - path: path to the prompt history
+//
+// Returns:
+//   - []aiderInput: prompts in file order
+func readAiderInputs(path string) []aiderInput {
+	data, readErr := io.SafeReadUserFile(path)
+	if readErr != nil {
+		return nil
+	}
+	content := strings.ReplaceAll(
+		string(data), token.NewlineCRLF, token.NewlineLF,
+	)
+
+	var inputs []aiderInput
+	for _, line := range strings.Split(content, token.NewlineLF) {
+		if stamp, ok := strings.CutPrefix(
+			line, cfgAider.InputTimePrefix,
+		); ok {
+			t, parseErr := time.ParseInLocation(
+				cfgAider.InputTimeFormat, strings.TrimSpace(stamp), time.Local,
+			)
+			if parseErr == nil {
+				inputs = append(inputs, aiderInput{Time: t})
+			}
+			continue
+		}
+		text, ok := strings.CutPrefix(line, cfgAider.InputLinePrefix)
+		n := len(inputs)
+		if !ok || n == 0 {
+			continue
+		}
+		if inputs[n-1].Text != "" {
+			inputs[n-1].Text += token.NewlineLF
+		}
+		inputs[n-1].Text += text
+	}
+	return inputs
+}
@@ -0,0 +1,23 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package parser
+
+import "time"
+
+// aiderChunk is the text of one session in an Aider chat history
+// file: the lines between two "# aider chat started at" markers.
+type aiderChunk struct {
+	Start time.Time
+	Next  time.Time
+	Lines []string
+}
+
+// aiderInput is one prompt from .aider.input.history.
+type aiderInput struct {
+	Time time.Time
+	Text string
+}
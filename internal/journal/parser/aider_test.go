@@ -0,0 +1,139 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package parser
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+const aiderFixture = "testdata/aider/.aider.chat.history.md"
+
+func TestAiderParser_Matches(t *testing.T) {
+	p := NewAider()
+	if !p.Matches(aiderFixture) {
+		t.Error("default history file not matched")
+	}
+
+	dir := t.TempDir()
+	custom := filepath.Join(dir, "history.md")
+	content := "\n# aider chat started at 2026-03-04 14:00:00\n"
+	if err := os.WriteFile(custom, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if !p.Matches(custom) {
+		t.Error("custom-named history not matched")
+	}
+
+	notes := filepath.Join(dir, "notes.md")
+	if err := os.WriteFile(notes, []byte("# Notes\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if p.Matches(notes) {
+		t.Error("plain Markdown matched as Aider")
+	}
+}
+
+func TestAiderParser_ParseFile(t *testing.T) {
+	sessions, err := NewAider().ParseFile(aiderFixture)
+	if err != nil {
+		t.Fatalf("ParseFile failed: %v", err)
+	}
+	// The 16:30 session has no prompt and is dropped.
+	if len(sessions) != 2 {
+		t.Fatalf("expected 2 sessions, got %d", len(sessions))
+	}
+
+	s := sessions[0]
+	if s.Tool != "aider" || s.Project != "aider" || s.Model != "gpt-4o" {
+		t.Errorf("Tool = %q, Project = %q, Model = %q",
+			s.Tool, s.Project, s.Model)
+	}
+	if len(s.ID) != 32 || s.ID == sessions[1].ID {
+		t.Errorf("IDs = %q, %q", s.ID, sessions[1].ID)
+	}
+	start := time.Date(2026, 3, 4, 14, 0, 0, 0, time.Local)
+	if !s.StartTime.Equal(start) {
+		t.Errorf("StartTime = %v", s.StartTime)
+	}
+	if s.Duration != 90*time.Second+500*time.Millisecond {
+		t.Errorf("Duration = %v", s.Duration)
+	}
+	if s.TurnCount != 2 || len(s.Messages) != 4 {
+		t.Fatalf("TurnCount = %d, messages = %d",
+			s.TurnCount, len(s.Messages))
+	}
+	if s.FirstUserMsg != "/add internal/greet/greet.go" {
+		t.Errorf("FirstUserMsg = %q", s.FirstUserMsg)
+	}
+
+	added := s.Messages[1]
+	if len(added.ToolUses) != 1 || added.ToolUses[0].Name != "Add" {
+		t.Errorf("/add reply tool uses = %+v", added.ToolUses)
+	}
+
+	prompt := s.Messages[2]
+	if prompt.Text != "make Hello take a name\nand greet it" {
+		t.Errorf("multi-line prompt = %q", prompt.Text)
+	}
+	wantTime := time.Date(2026, 3, 4, 14, 1, 30, 5e8, time.Local)
+	if !prompt.Timestamp.Equal(wantTime) {
+		t.Errorf("prompt timestamp = %v, want %v",
+			prompt.Timestamp, wantTime)
+	}
+
+	reply := s.Messages[3]
+	if reply.Role != "assistant" || reply.Text == "" {
+		t.Fatalf("reply = %+v", reply)
+	}
+	if len(reply.ToolUses) != 1 || reply.ToolUses[0].Name != "Edit" {
+		t.Fatalf("reply tool uses = %+v", reply.ToolUses)
+	}
+	var input map[string]string
+	if err := json.Unmarshal(
+		[]byte(reply.ToolUses[0].Input), &input,
+	); err != nil {
+		t.Fatal(err)
+	}
+	if input["file_path"] != "internal/greet/greet.go" ||
+		input["new_string"] == "" || input["old_string"] == "" {
+		t.Errorf("edit input = %v", input)
+	}
+	if reply.TokensIn != 2300 || reply.TokensOut != 150 {
+		t.Errorf("tokens = %d/%d", reply.TokensIn, reply.TokensOut)
+	}
+	if len(s.GitRefs) != 1 || s.GitRefs[0] != "1a2b3c4" {
+		t.Errorf("GitRefs = %v", s.GitRefs)
+	}
+
+	// Whole-file edits are recovered from the "Applied edit" notice.
+	last := sessions[1]
+	if last.TotalTokensIn != 1200 || last.TotalTokensOut != 40 {
+		t.Errorf("tokens = %d/%d", last.TotalTokensIn, last.TotalTokensOut)
+	}
+	edit := last.Messages[1].ToolUses
+	if len(edit) != 1 || edit[0].Name != "Edit" {
+		t.Errorf("applied edit tool uses = %+v", edit)
+	}
+	if len(last.GitRefs) != 1 || last.GitRefs[0] != "9f8e7d6" {
+		t.Errorf("GitRefs = %v", last.GitRefs)
+	}
+}
+
+func TestAiderCount(t *testing.T) {
+	tests := map[string]int{
+		"150": 150, "1,234": 1234, "2.3k": 2300, "1.1M": 1100000, "x": 0,
+	}
+	for in, want := range tests {
+		if got := aiderCount(in); got != want {
+			t.Errorf("aiderCount(%q) = %d, want %d", in, got, want)
+		}
+	}
+}
@@ -0,0 +1,176 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package parser
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+
+	cfgCodex "github.com/ActiveMemory/ctx/internal/config/codex"
+	"github.com/ActiveMemory/ctx/internal/config/file"
+	"github.com/ActiveMemory/ctx/internal/config/session"
+	cfgWarn "github.com/ActiveMemory/ctx/internal/config/warn"
+	"github.com/ActiveMemory/ctx/internal/entity"
+	errParser "github.com/ActiveMemory/ctx/internal/err/parser"
+	"github.com/ActiveMemory/ctx/internal/io"
+	"github.com/ActiveMemory/ctx/internal/log/warn"
+)
+
+// NewCodex creates a new Codex CLI session parser.
+//
+// Returns:
+//   - *Codex: a new parser instance
+func NewCodex() *Codex {
+	return &Codex{}
+}
+
+// Tool returns the tool identifier for this parser.
+//
+// Returns:
+//   - string: the Codex tool identifier
+func (p *Codex) Tool() string {
+	return session.ToolCodex
+}
+
+// Matches returns true if the file appears to be a Codex CLI rollout.
+//
+// Checks for a .jsonl file named rollout-* whose first line is a
+// session_meta envelope. The directory is not checked, so rollouts
+// copied out of the Codex home still match.
+//
+// Parameters:
+//   - path: file path to check
+//
+// Returns:
+//   - bool: true if the file is a Codex rollout
+func (p *Codex) Matches(path string) bool {
+	if !strings.HasSuffix(path, file.ExtJSONL) ||
+		!strings.HasPrefix(filepath.Base(path), cfgCodex.FilePrefix) {
+		return false
+	}
+
+	f, openErr := io.SafeOpenUserFile(path)
+	if openErr != nil {
+		return false
+	}
+	defer func() {
+		if closeErr := f.Close(); closeErr != nil {
+			warn.Warn(cfgWarn.Close, path, closeErr)
+		}
+	}()
+
+	scanner := bufio.NewScanner(f)
+	buf := make([]byte, 0, cfgCodex.ScanBufInit)
+	scanner.Buffer(buf, cfgCodex.ScanBufMax)
+
+	if !scanner.Scan() {
+		return false
+	}
+
+	var line codexRawLine
+	if unmarshalErr := json.Unmarshal(
+		scanner.Bytes(), &line,
+	); unmarshalErr != nil {
+		return false
+	}
+	return line.Type == cfgCodex.LineSessionMeta
+}
+
+// ParseFile reads a Codex rollout file and returns its session.
+//
+// Each rollout holds exactly one session. Lines that do not decode
+// are skipped so a partially written rollout still imports.
+//
+// Parameters:
+//   - path: path to the rollout file
+//
+// Returns:
+//   - []*entity.Session: the parsed session (at most one)
+//   - error: non-nil if the file cannot be opened or read
+func (p *Codex) ParseFile(path string) ([]*entity.Session, error) {
+	f, openErr := io.SafeOpenUserFile(path)
+	if openErr != nil {
+		return nil, errParser.OpenFile(openErr)
+	}
+	defer func() {
+		if closeErr := f.Close(); closeErr != nil {
+			warn.Warn(cfgWarn.Close, path, closeErr)
+		}
+	}()
+
+	scanner := bufio.NewScanner(f)
+	buf := make([]byte, 0, cfgCodex.ScanBufInit)
+	scanner.Buffer(buf, cfgCodex.ScanBufMax)
+
+	var lines []codexRawLine
+	for scanner.Scan() {
+		lineBytes := scanner.Bytes()
+		if len(lineBytes) == 0 {
+			continue
+		}
+		var line codexRawLine
+		if unmarshalErr := json.Unmarshal(
+			lineBytes, &line,
+		); unmarshalErr != nil {
+			continue
+		}
+		lines = append(lines, line)
+	}
+
+	if scanErr := scanner.Err(); scanErr != nil {
+		return nil, errParser.ScanFile(scanErr)
+	}
+
+	result := p.buildSession(lines, path)
+	if result == nil {
+		return nil, nil
+	}
+	return []*entity.Session{result}, nil
+}
+
+// ParseLine is not meaningful for Codex rollouts since each file
+// represents a complete session. Returns nil for all lines.
+//
+// Parameters:
+//   - line: the raw line bytes (unused)
+//
+// Returns:
+//   - *entity.Message: always nil
+//   - string: always empty
+//   - error: always nil
+func (p *Codex) ParseLine(_ []byte) (*entity.Message, string, error) {
+	return nil, "", nil
+}
+
+// CodexSessionDirs returns the directories where Codex CLI rollouts
+// may be stored: ~/.codex/sessions, or $CODEX_HOME/sessions when
+// the env var is set.
+//
+// Returns:
+//   - []string: paths to session directories found on the system
+func CodexSessionDirs() []string {
+	codexHome := os.Getenv(cfgCodex.EnvHome)
+	if codexHome == "" {
+		home, homeErr := os.UserHomeDir()
+		if homeErr != nil {
+			return nil
+		}
+		codexHome = filepath.Join(home, cfgCodex.DirHome)
+	}
+
+	dir := filepath.Join(codexHome, cfgCodex.DirSessions)
+	if info, statErr := io.SafeStat(dir); statErr == nil && info.IsDir() {
+		return []string{dir}
+	}
+	return nil
+}
+
+// Ensure Codex implements Session.
+var _ Session = (*Codex)(nil)
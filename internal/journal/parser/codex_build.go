@@ -0,0 +1,279 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package parser
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ActiveMemory/ctx/internal/config/claude"
+	cfgCodex "github.com/ActiveMemory/ctx/internal/config/codex"
+	"github.com/ActiveMemory/ctx/internal/config/file"
+	"github.com/ActiveMemory/ctx/internal/config/session"
+	"github.com/ActiveMemory/ctx/internal/config/token"
+	"github.com/ActiveMemory/ctx/internal/entity"
+)
+
+// buildSession converts the lines of a Codex rollout into a Session
+// entity.
+//
+// User messages open a turn. Everything the model produces until the
+// next user message (reasoning, tool calls, tool outputs, replies)
+// is folded into one assistant message. Token usage comes from
+// token_count events: the last-call usage is charged to the open
+// assistant message and the final running total becomes the session
+// total.
+//
+// Parameters:
+//   - lines: decoded rollout lines in file order
+//   - sourcePath: path to the rollout file
+//
+// Returns:
+//   - *entity.Session: the built session, or nil if it has no
+//     messages
+func (p *Codex) buildSession(
+	lines []codexRawLine, sourcePath string,
+) *entity.Session {
+	sess := &entity.Session{
+		ID: filepath.Base(
+			strings.TrimSuffix(sourcePath, file.ExtJSONL),
+		),
+		Tool:       session.ToolCodex,
+		SourceFile: sourcePath,
+	}
+
+	var msgs []*entity.Message
+	var reply *entity.Message
+	calls := make(map[string]*entity.Message)
+	var total *codexRawUsage
+
+	// assistant returns the open assistant message, starting one
+	// when the last message was the user's.
+	assistant := func(ts time.Time) *entity.Message {
+		if reply == nil {
+			reply = &entity.Message{
+				Timestamp: ts, Role: claude.RoleAssistant,
+			}
+			msgs = append(msgs, reply)
+		}
+		return reply
+	}
+
+	for _, line := range lines {
+		if !line.Timestamp.IsZero() {
+			if sess.StartTime.IsZero() {
+				sess.StartTime = line.Timestamp
+			}
+			sess.EndTime = line.Timestamp
+		}
+
+		switch line.Type {
+		case cfgCodex.LineSessionMeta:
+			var meta codexRawMeta
+			if json.Unmarshal(line.Payload, &meta) != nil {
+				continue
+			}
+			if meta.ID != "" {
+				sess.ID = meta.ID
+			}
+			if meta.CWD != "" {
+				sess.CWD = meta.CWD
+				sess.Project = filepath.Base(meta.CWD)
+			}
+			if meta.Git != nil {
+				sess.GitBranch = meta.Git.Branch
+			}
+			sess.Entrypoint = meta.Originator
+
+		case cfgCodex.LineTurnContext:
+			var tc codexRawTurnContext
+			if json.Unmarshal(line.Payload, &tc) != nil {
+				continue
+			}
+			if sess.Model == "" {
+				sess.Model = tc.Model
+			}
+			if sess.CWD == "" && tc.CWD != "" {
+				sess.CWD = tc.CWD
+				sess.Project = filepath.Base(tc.CWD)
+			}
+
+		case cfgCodex.LineEventMsg:
+			var ev codexRawEvent
+			if json.Unmarshal(line.Payload, &ev) != nil ||
+				ev.Type != cfgCodex.EventTokenCount || ev.Info == nil {
+				continue
+			}
+			total = &ev.Info.Total
+			if reply != nil {
+				reply.TokensIn += ev.Info.Last.InputTokens
+				reply.TokensOut += ev.Info.Last.OutputTokens
+			}
+
+		case cfgCodex.LineResponseItem:
+			var item codexRawItem
+			if json.Unmarshal(line.Payload, &item) != nil {
+				continue
+			}
+			switch item.Type {
+			case cfgCodex.ItemMessage:
+				text := codexText(item.Content)
+				switch item.Role {
+				case claude.RoleUser:
+					if text == "" || codexInjected(text) {
+						continue
+					}
+					msgs = append(msgs, &entity.Message{
+						Timestamp: line.Timestamp,
+						Role:      claude.RoleUser,
+						Text:      text,
+					})
+					reply = nil
+				case claude.RoleAssistant:
+					msg := assistant(line.Timestamp)
+					msg.Text = appendLine(msg.Text, text)
+				}
+
+			case cfgCodex.ItemReasoning:
+				if text := codexText(item.Summary); text != "" {
+					msg := assistant(line.Timestamp)
+					msg.Thinking = appendLine(msg.Thinking, text)
+				}
+
+			case cfgCodex.ItemFunctionCall, cfgCodex.ItemCustomToolCall:
+				input := item.Arguments
+				if item.Type == cfgCodex.ItemCustomToolCall {
+					input = item.Input
+				}
+				msg := assistant(line.Timestamp)
+				msg.ToolUses = append(msg.ToolUses, entity.ToolUse{
+					ID: item.CallID, Name: item.Name, Input: input,
+				})
+				calls[item.CallID] = msg
+
+			case cfgCodex.ItemFunctionCallOutput,
+				cfgCodex.ItemCustomToolCallOutput:
+				msg, ok := calls[item.CallID]
+				if !ok {
+					msg = assistant(line.Timestamp)
+				}
+				result := codexToolResult(item)
+				msg.ToolResults = append(msg.ToolResults, result)
+				if result.IsError {
+					sess.HasErrors = true
+				}
+			}
+		}
+	}
+
+	if len(msgs) == 0 {
+		return nil
+	}
+
+	for _, msg := range msgs {
+		sess.Messages = append(sess.Messages, *msg)
+		sess.TotalTokensIn += msg.TokensIn
+		sess.TotalTokensOut += msg.TokensOut
+		if !msg.BelongsToUser() {
+			continue
+		}
+		sess.TurnCount++
+		if sess.FirstUserMsg == "" {
+			preview := msg.Text
+			if len(preview) > session.PreviewMaxLen {
+				preview = preview[:session.PreviewMaxLen] + token.Ellipsis
+			}
+			sess.FirstUserMsg = preview
+		}
+	}
+
+	// The running total also counts calls made before any reply
+	// was open, so it wins over the per-message sum.
+	if total != nil {
+		sess.TotalTokensIn = total.InputTokens
+		sess.TotalTokensOut = total.OutputTokens
+	}
+	sess.TotalTokens = sess.TotalTokensIn + sess.TotalTokensOut
+	sess.Duration = sess.EndTime.Sub(sess.StartTime)
+
+	return sess
+}
+
+// codexText joins the non-empty text blocks of a message or
+// reasoning summary.
+//
+// Parameters:
+//   - blocks: content or summary blocks
+//
+// Returns:
+//   - string: the blocks' text separated by newlines
+func codexText(blocks []codexRawContent) string {
+	var text string
+	for _, b := range blocks {
+		text = appendLine(text, strings.TrimSpace(b.Text))
+	}
+	return text
+}
+
+// codexInjected reports whether a user-role message is context Codex
+// injected rather than text the user typed.
+//
+// Parameters:
+//   - text: the message text
+//
+// Returns:
+//   - bool: true for environment context and instruction blocks
+func codexInjected(text string) bool {
+	for _, prefix := range cfgCodex.ContextPrefixes {
+		if strings.HasPrefix(text, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// codexToolResult converts a tool call output item into a ToolResult.
+//
+// Shell-style outputs are either a JSON document with the command
+// output and its exit code, or plain text opening with
+// "Exit code: N". A non-zero exit code marks the result as an error.
+// Other output is kept verbatim.
+//
+// Parameters:
+//   - item: a function_call_output or custom_tool_call_output item
+//
+// Returns:
+//   - entity.ToolResult: the result, keyed by the item's call ID
+func codexToolResult(item codexRawItem) entity.ToolResult {
+	result := entity.ToolResult{ToolUseID: item.CallID}
+
+	var content string
+	if json.Unmarshal(item.Output, &content) != nil {
+		content = string(item.Output)
+	}
+	result.Content = content
+
+	var doc codexRawFunctionOutput
+	if json.Unmarshal([]byte(content), &doc) == nil &&
+		doc.Metadata.ExitCode != nil {
+		result.Content = doc.Output
+		result.IsError = *doc.Metadata.ExitCode != 0
+		return result
+	}
+
+	if rest, ok := strings.CutPrefix(
+		content, cfgCodex.ExitCodePrefix,
+	); ok {
+		line, _, _ := strings.Cut(rest, token.NewlineLF)
+		code, atoiErr := strconv.Atoi(strings.TrimSpace(line))
+		result.IsError = atoiErr == nil && code != 0
+	}
+	return result
+}
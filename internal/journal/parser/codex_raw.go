@@ -0,0 +1,91 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package parser
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// codexRawLine is one JSONL line of a Codex CLI rollout file.
+// The payload shape depends on Type and is decoded lazily.
+type codexRawLine struct {
+	Timestamp time.Time       `json:"timestamp"`
+	Type      string          `json:"type"`
+	Payload   json.RawMessage `json:"payload"`
+}
+
+// codexRawMeta is the session_meta payload that opens a rollout.
+type codexRawMeta struct {
+	ID         string       `json:"id"`
+	Timestamp  time.Time    `json:"timestamp"`
+	CWD        string       `json:"cwd"`
+	Originator string       `json:"originator"`
+	Git        *codexRawGit `json:"git,omitempty"`
+}
+
+// codexRawGit is the repository state recorded in session_meta.
+type codexRawGit struct {
+	Branch string `json:"branch"`
+}
+
+// codexRawTurnContext is the turn_context payload.
+type codexRawTurnContext struct {
+	CWD   string `json:"cwd"`
+	Model string `json:"model"`
+}
+
+// codexRawItem is a response_item payload: a message,
+// reasoning summary, tool call, or tool call output.
+type codexRawItem struct {
+	Type      string            `json:"type"`
+	Role      string            `json:"role,omitempty"`
+	Content   []codexRawContent `json:"content,omitempty"`
+	Summary   []codexRawContent `json:"summary,omitempty"`
+	Name      string            `json:"name,omitempty"`
+	Arguments string            `json:"arguments,omitempty"`
+	Input     string            `json:"input,omitempty"`
+	CallID    string            `json:"call_id,omitempty"`
+	Output    json.RawMessage   `json:"output,omitempty"`
+}
+
+// codexRawContent is one text block of a message or reasoning
+// summary (input_text, output_text, or summary_text).
+type codexRawContent struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+// codexRawFunctionOutput is the JSON document Codex stores as
+// the output string of shell-style function calls.
+type codexRawFunctionOutput struct {
+	Output   string `json:"output"`
+	Metadata struct {
+		ExitCode *int `json:"exit_code"`
+	} `json:"metadata"`
+}
+
+// codexRawEvent is an event_msg payload. Only token_count
+// events are read.
+type codexRawEvent struct {
+	Type string             `json:"type"`
+	Info *codexRawTokenInfo `json:"info,omitempty"`
+}
+
+// codexRawTokenInfo holds the usage reported by a token_count
+// event: the running total and the usage of the last model call.
+type codexRawTokenInfo struct {
+	Total codexRawUsage `json:"total_token_usage"`
+	Last  codexRawUsage `json:"last_token_usage"`
+}
+
+// codexRawUsage is a token usage breakdown.
+type codexRawUsage struct {
+	InputTokens  int `json:"input_tokens"`
+	OutputTokens int `json:"output_tokens"`
+	TotalTokens  int `json:"total_tokens"`
+}
@@ -0,0 +1,151 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package parser
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+const codexFixture = "testdata/codex/" +
+	"rollout-2026-03-02T09-15-00-0199a1b2-c3d4-7e5f-8a9b-0c1d2e3f4a5b.jsonl"
+
+func TestCodexParser_Matches(t *testing.T) {
+	p := NewCodex()
+	if !p.Matches(codexFixture) {
+		t.Error("fixture rollout not matched")
+	}
+
+	dir := t.TempDir()
+	claude := filepath.Join(dir, "rollout-claude.jsonl")
+	content := `{"uuid":"m1","sessionId":"s1","type":"user","timestamp":"2026-01-20T10:00:00Z","message":{"role":"user","content":"hi"}}`
+	if err := os.WriteFile(claude, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if p.Matches(claude) {
+		t.Error("Claude Code transcript matched as Codex")
+	}
+
+	data, err := os.ReadFile(codexFixture)
+	if err != nil {
+		t.Fatal(err)
+	}
+	renamed := filepath.Join(dir, "session.jsonl")
+	if err := os.WriteFile(renamed, data, 0600); err != nil {
+		t.Fatal(err)
+	}
+	if p.Matches(renamed) {
+		t.Error("file without the rollout- prefix matched")
+	}
+}
+
+func TestCodexParser_ParseFile(t *testing.T) {
+	sessions, err := NewCodex().ParseFile(codexFixture)
+	if err != nil {
+		t.Fatalf("ParseFile failed: %v", err)
+	}
+	if len(sessions) != 1 {
+		t.Fatalf("expected 1 session, got %d", len(sessions))
+	}
+	s := sessions[0]
+
+	if s.ID != "0199a1b2-c3d4-7e5f-8a9b-0c1d2e3f4a5b" {
+		t.Errorf("ID = %q", s.ID)
+	}
+	if s.Tool != "codex" {
+		t.Errorf("Tool = %q", s.Tool)
+	}
+	if s.CWD != "/home/dev/WORKSPACE/ctx" || s.Project != "ctx" {
+		t.Errorf("CWD = %q, Project = %q", s.CWD, s.Project)
+	}
+	if s.GitBranch != "main" || s.Model != "gpt-5-codex" {
+		t.Errorf("GitBranch = %q, Model = %q", s.GitBranch, s.Model)
+	}
+	if s.Entrypoint != "codex_cli_rs" {
+		t.Errorf("Entrypoint = %q", s.Entrypoint)
+	}
+	if s.Duration != 62*time.Second {
+		t.Errorf("Duration = %v", s.Duration)
+	}
+
+	// The injected environment context is not a user turn.
+	if s.TurnCount != 2 || len(s.Messages) != 4 {
+		t.Fatalf("TurnCount = %d, messages = %d",
+			s.TurnCount, len(s.Messages))
+	}
+	if s.FirstUserMsg != "Why does the drift check fail on CI?" {
+		t.Errorf("FirstUserMsg = %q", s.FirstUserMsg)
+	}
+
+	reply := s.Messages[1]
+	if reply.Role != "assistant" {
+		t.Fatalf("message 1 role = %q", reply.Role)
+	}
+	if reply.Thinking != "**Inspecting the drift command**" {
+		t.Errorf("Thinking = %q", reply.Thinking)
+	}
+	if reply.Text == "" {
+		t.Error("assistant text not captured")
+	}
+	if len(reply.ToolUses) != 2 ||
+		reply.ToolUses[0].Name != "shell" ||
+		reply.ToolUses[1].Name != "apply_patch" {
+		t.Fatalf("ToolUses = %+v", reply.ToolUses)
+	}
+	if len(reply.ToolResults) != 2 {
+		t.Fatalf("ToolResults = %+v", reply.ToolResults)
+	}
+	drift := reply.ToolResults[0]
+	if drift.ToolUseID != "call_drift" || !drift.IsError ||
+		drift.Content != "stale path: docs/old.md\n" {
+		t.Errorf("shell result = %+v", drift)
+	}
+	if reply.ToolResults[1].IsError {
+		t.Error("successful patch marked as error")
+	}
+	if !s.HasErrors {
+		t.Error("HasErrors not set")
+	}
+
+	if reply.TokensIn != 2600 || reply.TokensOut != 150 {
+		t.Errorf("message tokens = %d/%d", reply.TokensIn, reply.TokensOut)
+	}
+	if s.TotalTokensIn != 2600 || s.TotalTokensOut != 150 ||
+		s.TotalTokens != 2750 {
+		t.Errorf("session tokens = %d/%d/%d",
+			s.TotalTokensIn, s.TotalTokensOut, s.TotalTokens)
+	}
+}
+
+func TestParseFile_AutoDetectCodex(t *testing.T) {
+	sessions, err := ParseFile(codexFixture)
+	if err != nil {
+		t.Fatalf("ParseFile (auto-detect) failed: %v", err)
+	}
+	if len(sessions) != 1 || sessions[0].Tool != "codex" {
+		t.Errorf("expected one codex session, got %+v", sessions)
+	}
+}
+
+func TestCodexSessionDirs(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("CODEX_HOME", home)
+	if dirs := CodexSessionDirs(); len(dirs) != 0 {
+		t.Errorf("missing sessions dir reported: %v", dirs)
+	}
+
+	sessions := filepath.Join(home, "sessions")
+	if err := os.MkdirAll(sessions, 0700); err != nil {
+		t.Fatal(err)
+	}
+	dirs := CodexSessionDirs()
+	if len(dirs) != 1 || dirs[0] != sessions {
+		t.Errorf("CodexSessionDirs() = %v, want [%s]", dirs, sessions)
+	}
+}
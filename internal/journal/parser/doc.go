@@ -21,6 +21,21 @@
 //     the workspace state directory.
 //   - **Copilot CLI** writes a different, JSON-with-metadata layout
 //     under its own home tree.
+//   - **Codex** (OpenAI Codex CLI) writes one "rollout" JSONL file
+//     per session under `~/.codex/sessions/YYYY/MM/DD/`; each line
+//     is a typed envelope (session_meta, response_item, event_msg,
+//     turn_context).
+//   - **Aider** appends every session to a Markdown chat history in
+//     the repository root; prompts are `####` lines and Aider's own
+//     notices are blockquotes. The sibling input history supplies
+//     per-prompt timestamps.
+//   - **Gemini** (Gemini CLI) saves each chat as one JSON document
+//     under `~/.gemini/tmp/<project-hash>/chats/`. The chat records
+//     no working directory; the parser recovers it by hashing the
+//     current directory and its git root against the project hash.
+//   - **OpenCode** spreads each session over a tree of JSON
+//     documents in `~/.local/share/opencode/storage/`: session info,
+//     one document per message, and one per message part.
 //   - **MarkdownSession** is the round-trip format ctx itself
 //     produces when an enriched journal entry is *re-imported*; it
 //     parses the YAML frontmatter + body that
@@ -46,9 +61,10 @@
 //     so callers can surface them to the user.
 //
 // Tool-specific constructors ([NewClaudeCode], [NewCopilot],
-// [NewCopilotCLI], [NewMarkdownSession]) are exported for callers
-// that need to operate on a known format directly (tests, format
-// converters, the schema validator).
+// [NewCopilotCLI], [NewCodex], [NewAider], [NewGemini], [NewOpenCode],
+// [NewMarkdownSession]) are exported for callers that need to operate
+// on a known format directly (tests, format converters, the schema
+// validator).
 //
 // # Dispatch Mechanism
 //
@@ -59,7 +75,7 @@
 // parser whether it `Matches(path)`. Implementations may check
 // extension, directory shape, or peek at the first line; order in
 // the slice matters when a file could plausibly match more than one
-// (in practice, the four formats are disjoint).
+// (in practice, the formats are disjoint).
 //
 // **Adding a new tool**: implement the four interface methods on a
 // new type, then append a constructor call to `registeredParsers`
@@ -70,7 +86,8 @@
 // Every parser yields `*entity.Session` values populated with:
 //
 //   - identity: ID, Slug, Tool, SourceFile
-//   - context: CWD, Project (basename of CWD), GitBranch
+//   - context: CWD, Project (basename of CWD), GitBranch, GitRefs
+//     (commits the tool made, when it records them)
 //   - timing: StartTime, EndTime, Duration
 //   - content: a flat []Message in chronological order
 //   - rollups: TurnCount, FirstUserMsg (preview, truncated at
@@ -0,0 +1,114 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package parser
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ActiveMemory/ctx/internal/config/file"
+	cfgGemini "github.com/ActiveMemory/ctx/internal/config/gemini"
+	"github.com/ActiveMemory/ctx/internal/config/session"
+	"github.com/ActiveMemory/ctx/internal/entity"
+	errParser "github.com/ActiveMemory/ctx/internal/err/parser"
+	"github.com/ActiveMemory/ctx/internal/io"
+)
+
+// NewGemini creates a new Gemini CLI chat parser.
+//
+// Returns:
+//   - *Gemini: a new parser instance
+func NewGemini() *Gemini {
+	return &Gemini{}
+}
+
+// Tool returns the tool identifier for this parser.
+//
+// Returns:
+//   - string: the Gemini CLI tool identifier
+func (p *Gemini) Tool() string {
+	return session.ToolGemini
+}
+
+// Matches returns true if the file appears to be a Gemini CLI chat.
+//
+// Checks for a .json file named session-* in a chats directory, the
+// layout Gemini CLI uses under ~/.gemini/tmp/<project-hash>/.
+//
+// Parameters:
+//   - path: file path to check
+//
+// Returns:
+//   - bool: true if the file is a recorded Gemini CLI chat
+func (p *Gemini) Matches(path string) bool {
+	return strings.HasSuffix(path, file.ExtJSON) &&
+		strings.HasPrefix(filepath.Base(path), cfgGemini.FilePrefix) &&
+		filepath.Base(filepath.Dir(path)) == cfgGemini.DirChats
+}
+
+// ParseFile reads a Gemini CLI chat and returns its session.
+//
+// Parameters:
+//   - path: path to the chat file
+//
+// Returns:
+//   - []*entity.Session: the parsed session (at most one)
+//   - error: non-nil if the file cannot be read or is not JSON
+func (p *Gemini) ParseFile(path string) ([]*entity.Session, error) {
+	data, readErr := io.SafeReadUserFile(filepath.Clean(path))
+	if readErr != nil {
+		return nil, errParser.ReadFile(readErr)
+	}
+
+	var chat geminiRawChat
+	if unmarshalErr := json.Unmarshal(data, &chat); unmarshalErr != nil {
+		return nil, errParser.Unmarshal(unmarshalErr)
+	}
+
+	result := p.buildSession(chat, path)
+	if result == nil {
+		return nil, nil
+	}
+	return []*entity.Session{result}, nil
+}
+
+// ParseLine is not meaningful for Gemini CLI chats since each file
+// is one JSON document. Returns nil for all lines.
+//
+// Parameters:
+//   - line: the raw line bytes (unused)
+//
+// Returns:
+//   - *entity.Message: always nil
+//   - string: always empty
+//   - error: always nil
+func (p *Gemini) ParseLine(_ []byte) (*entity.Message, string, error) {
+	return nil, "", nil
+}
+
+// GeminiSessionDirs returns the directories where Gemini CLI chats
+// may be stored: ~/.gemini/tmp, which holds one directory per
+// project hash.
+//
+// Returns:
+//   - []string: paths to session directories found on the system
+func GeminiSessionDirs() []string {
+	home, homeErr := os.UserHomeDir()
+	if homeErr != nil {
+		return nil
+	}
+	dir := filepath.Join(home, cfgGemini.DirHome, cfgGemini.DirTmp)
+	if info, statErr := io.SafeStat(dir); statErr == nil && info.IsDir() {
+		return []string{dir}
+	}
+	return nil
+}
+
+// Ensure Gemini implements Session.
+var _ Session = (*Gemini)(nil)
@@ -0,0 +1,223 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package parser
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ActiveMemory/ctx/internal/config/claude"
+	cfgGemini "github.com/ActiveMemory/ctx/internal/config/gemini"
+	"github.com/ActiveMemory/ctx/internal/config/session"
+	"github.com/ActiveMemory/ctx/internal/config/token"
+	"github.com/ActiveMemory/ctx/internal/entity"
+	execGit "github.com/ActiveMemory/ctx/internal/exec/git"
+)
+
+// buildSession converts a recorded Gemini CLI chat into a Session
+// entity.
+//
+// User prompts and model replies become messages; info, warning,
+// and error notices are dropped. Each reply carries its thoughts as
+// thinking, its tool calls with their results, and its token usage
+// (thought tokens count as output).
+//
+// Parameters:
+//   - chat: the decoded chat
+//   - sourcePath: path to the chat file
+//
+// Returns:
+//   - *entity.Session: the built session, or nil if the chat has no
+//     messages
+func (p *Gemini) buildSession(
+	chat geminiRawChat, sourcePath string,
+) *entity.Session {
+	sess := &entity.Session{
+		ID:         chat.SessionID,
+		Tool:       session.ToolGemini,
+		SourceFile: sourcePath,
+		StartTime:  chat.StartTime,
+		EndTime:    chat.LastUpdated,
+	}
+	if sess.ID == "" {
+		sess.ID = strings.TrimSuffix(
+			filepath.Base(sourcePath), filepath.Ext(sourcePath),
+		)
+	}
+	if dir := geminiProjectDir(chat.ProjectHash); dir != "" {
+		sess.CWD = dir
+		sess.Project = filepath.Base(dir)
+	} else if len(chat.ProjectHash) >= cfgGemini.ShortHashLen {
+		sess.Project = chat.ProjectHash[:cfgGemini.ShortHashLen]
+	}
+
+	for _, raw := range chat.Messages {
+		var role string
+		switch raw.Type {
+		case cfgGemini.TypeUser:
+			role = claude.RoleUser
+		case cfgGemini.TypeGemini:
+			role = claude.RoleAssistant
+		default:
+			continue
+		}
+
+		msg := entity.Message{
+			ID:        raw.ID,
+			Timestamp: raw.Timestamp,
+			Role:      role,
+			Text:      geminiText(raw.Content),
+		}
+		for _, th := range raw.Thoughts {
+			thought := strings.TrimSpace(
+				th.Subject + token.NewlineLF + th.Description,
+			)
+			msg.Thinking = appendLine(msg.Thinking, thought)
+		}
+		if raw.Tokens != nil {
+			msg.TokensIn = raw.Tokens.Input
+			msg.TokensOut = raw.Tokens.Output + raw.Tokens.Thoughts
+		}
+		for _, call := range raw.ToolCalls {
+			msg.ToolUses = append(msg.ToolUses, entity.ToolUse{
+				ID: call.ID, Name: call.Name, Input: string(call.Args),
+			})
+			result := entity.ToolResult{
+				ToolUseID: call.ID,
+				Content:   geminiResult(call),
+				IsError:   call.Status == cfgGemini.ToolStatusError,
+			}
+			msg.ToolResults = append(msg.ToolResults, result)
+			if result.IsError {
+				sess.HasErrors = true
+			}
+		}
+
+		if sess.Model == "" {
+			sess.Model = raw.Model
+		}
+		if !raw.Timestamp.IsZero() {
+			if sess.StartTime.IsZero() ||
+				raw.Timestamp.Before(sess.StartTime) {
+				sess.StartTime = raw.Timestamp
+			}
+			if raw.Timestamp.After(sess.EndTime) {
+				sess.EndTime = raw.Timestamp
+			}
+		}
+		sess.TotalTokensIn += msg.TokensIn
+		sess.TotalTokensOut += msg.TokensOut
+		if msg.BelongsToUser() {
+			sess.TurnCount++
+			if sess.FirstUserMsg == "" && msg.Text != "" {
+				preview := msg.Text
+				if len(preview) > session.PreviewMaxLen {
+					preview = preview[:session.PreviewMaxLen] +
+						token.Ellipsis
+				}
+				sess.FirstUserMsg = preview
+			}
+		}
+		sess.Messages = append(sess.Messages, msg)
+	}
+
+	if len(sess.Messages) == 0 {
+		return nil
+	}
+	sess.TotalTokens = sess.TotalTokensIn + sess.TotalTokensOut
+	sess.Duration = sess.EndTime.Sub(sess.StartTime)
+	return sess
+}
+
+// geminiProjectDir resolves a chat's project hash to a directory by
+// hashing the current directory and the repository root, the
+// directories Gemini CLI hashes when it starts.
+//
+// Parameters:
+//   - hash: the chat's project hash (hex SHA-256)
+//
+// Returns:
+//   - string: the matching directory, or "" when neither matches
+func geminiProjectDir(hash string) string {
+	if hash == "" {
+		return ""
+	}
+	var candidates []string
+	if cwd, cwdErr := os.Getwd(); cwdErr == nil {
+		candidates = append(candidates, cwd)
+	}
+	if root, rootErr := execGit.Root(); rootErr == nil {
+		candidates = append(candidates, root)
+	}
+	for _, dir := range candidates {
+		sum := sha256.Sum256([]byte(dir))
+		if hex.EncodeToString(sum[:]) == hash {
+			return dir
+		}
+	}
+	return ""
+}
+
+// geminiText extracts message text from content that is either a
+// string or a list of parts.
+//
+// Parameters:
+//   - content: the raw content field
+//
+// Returns:
+//   - string: the text, parts joined by newlines
+func geminiText(content json.RawMessage) string {
+	if len(content) == 0 {
+		return ""
+	}
+	var text string
+	if json.Unmarshal(content, &text) == nil {
+		return strings.TrimSpace(text)
+	}
+	var parts []geminiRawPart
+	if json.Unmarshal(content, &parts) != nil {
+		return ""
+	}
+	for _, part := range parts {
+		text = appendLine(text, strings.TrimSpace(part.Text))
+	}
+	return text
+}
+
+// geminiResult extracts the readable output of a tool call: the
+// display string when there is one, else the function response
+// output or error, else the raw result.
+//
+// Parameters:
+//   - call: the tool call
+//
+// Returns:
+//   - string: the result content
+func geminiResult(call geminiRawToolCall) string {
+	var display string
+	if json.Unmarshal(call.ResultDisplay, &display) == nil &&
+		display != "" {
+		return display
+	}
+	var parts []geminiRawResultPart
+	if json.Unmarshal(call.Result, &parts) == nil {
+		var out string
+		for _, part := range parts {
+			resp := part.FunctionResponse.Response
+			out = appendLine(out, resp.Output)
+			out = appendLine(out, resp.Error)
+		}
+		if out != "" {
+			return out
+		}
+	}
+	return string(call.Result)
+}
@@ -0,0 +1,73 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package parser
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// geminiRawChat is a chat recorded by Gemini CLI.
+type geminiRawChat struct {
+	SessionID   string             `json:"sessionId"`
+	ProjectHash string             `json:"projectHash"`
+	StartTime   time.Time          `json:"startTime"`
+	LastUpdated time.Time          `json:"lastUpdated"`
+	Messages    []geminiRawMessage `json:"messages"`
+}
+
+// geminiRawMessage is one message of a recorded chat. Content is a
+// string in most versions and a list of parts in some.
+type geminiRawMessage struct {
+	ID        string              `json:"id"`
+	Timestamp time.Time           `json:"timestamp"`
+	Type      string              `json:"type"`
+	Content   json.RawMessage     `json:"content"`
+	Model     string              `json:"model,omitempty"`
+	Thoughts  []geminiRawThought  `json:"thoughts,omitempty"`
+	Tokens    *geminiRawTokens    `json:"tokens,omitempty"`
+	ToolCalls []geminiRawToolCall `json:"toolCalls,omitempty"`
+}
+
+// geminiRawPart is a content part; only text parts are read.
+type geminiRawPart struct {
+	Text string `json:"text"`
+}
+
+// geminiRawThought is one reasoning step of a reply.
+type geminiRawThought struct {
+	Subject     string `json:"subject"`
+	Description string `json:"description"`
+}
+
+// geminiRawTokens is the token usage of a reply.
+type geminiRawTokens struct {
+	Input    int `json:"input"`
+	Output   int `json:"output"`
+	Thoughts int `json:"thoughts"`
+}
+
+// geminiRawToolCall is a tool call made during a reply, with its
+// result.
+type geminiRawToolCall struct {
+	ID            string          `json:"id"`
+	Name          string          `json:"name"`
+	Args          json.RawMessage `json:"args"`
+	Result        json.RawMessage `json:"result"`
+	ResultDisplay json.RawMessage `json:"resultDisplay"`
+	Status        string          `json:"status"`
+}
+
+// geminiRawResultPart is a function response part of a tool result.
+type geminiRawResultPart struct {
+	FunctionResponse struct {
+		Response struct {
+			Output string `json:"output"`
+			Error  string `json:"error"`
+		} `json:"response"`
+	} `json:"functionResponse"`
+}
@@ -0,0 +1,120 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package parser
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+const geminiFixture = "testdata/gemini/" +
+	"a7bda1e6550e2e2660a55bd5f0d1278cc8c470ae067dc7d955e3dca67f533201/" +
+	"chats/session-2026-03-05T10-00-5f2c9a1e.json"
+
+func TestGeminiParser_Matches(t *testing.T) {
+	p := NewGemini()
+	if !p.Matches(geminiFixture) {
+		t.Error("chat file not matched")
+	}
+	if p.Matches("testdata/gemini/abc/logs.json") {
+		t.Error("logs file matched")
+	}
+	if p.Matches("testdata/gemini/abc/chats/notes.json") {
+		t.Error("non-session file matched")
+	}
+}
+
+func TestGeminiParser_ParseFile(t *testing.T) {
+	sessions, err := NewGemini().ParseFile(geminiFixture)
+	if err != nil {
+		t.Fatalf("ParseFile failed: %v", err)
+	}
+	if len(sessions) != 1 {
+		t.Fatalf("expected 1 session, got %d", len(sessions))
+	}
+
+	s := sessions[0]
+	if s.ID != "5f2c9a1e-7b3d-4c8e-9a21-0d6e4f8b1c37" || s.Tool != "gemini" {
+		t.Errorf("ID = %q, Tool = %q", s.ID, s.Tool)
+	}
+	if s.Model != "gemini-2.5-pro" || s.Project != "a7bda1e6" {
+		t.Errorf("Model = %q, Project = %q", s.Model, s.Project)
+	}
+	if s.Duration != 150*time.Second {
+		t.Errorf("Duration = %v", s.Duration)
+	}
+	// The info notice is dropped.
+	if s.TurnCount != 2 || len(s.Messages) != 4 {
+		t.Fatalf("TurnCount = %d, messages = %d",
+			s.TurnCount, len(s.Messages))
+	}
+	if s.FirstUserMsg != "Add a farewell function next to Greet" {
+		t.Errorf("FirstUserMsg = %q", s.FirstUserMsg)
+	}
+	if s.Messages[2].Text != "Now add a test" {
+		t.Errorf("parts content = %q", s.Messages[2].Text)
+	}
+	// Thought tokens count as output.
+	if s.TotalTokensIn != 2700 || s.TotalTokensOut != 180 {
+		t.Errorf("tokens = %d/%d", s.TotalTokensIn, s.TotalTokensOut)
+	}
+
+	reply := s.Messages[1]
+	if reply.Thinking == "" {
+		t.Error("thoughts not recorded")
+	}
+	if len(reply.ToolUses) != 2 || reply.ToolUses[0].Name != "read_file" {
+		t.Fatalf("tool uses = %+v", reply.ToolUses)
+	}
+	results := reply.ToolResults
+	if len(results) != 2 || results[0].Content != "package greet" {
+		t.Fatalf("tool results = %+v", results)
+	}
+	if !results[1].IsError ||
+		results[1].Content != "Failed to edit: old_string not found" {
+		t.Errorf("error result = %+v", results[1])
+	}
+	if !s.HasErrors {
+		t.Error("HasErrors not set")
+	}
+}
+
+func TestGeminiParser_ProjectDir(t *testing.T) {
+	dir := t.TempDir()
+	t.Chdir(dir)
+	cwd, cwdErr := os.Getwd()
+	if cwdErr != nil {
+		t.Fatal(cwdErr)
+	}
+	sum := sha256.Sum256([]byte(cwd))
+	hash := hex.EncodeToString(sum[:])
+
+	chats := filepath.Join(dir, hash, "chats")
+	if err := os.MkdirAll(chats, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(chats, "session-2026-03-05T11-00-0a1b2c3d.json")
+	content := `{"sessionId": "s1", "projectHash": "` + hash + `",
+		"messages": [{"type": "user", "content": "hi",
+		"timestamp": "2026-03-05T11:00:00Z"}]}`
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	sessions, err := NewGemini().ParseFile(path)
+	if err != nil || len(sessions) != 1 {
+		t.Fatalf("ParseFile = %v, %v", sessions, err)
+	}
+	if sessions[0].CWD != cwd || sessions[0].Project != filepath.Base(cwd) {
+		t.Errorf("CWD = %q, Project = %q",
+			sessions[0].CWD, sessions[0].Project)
+	}
+}
@@ -0,0 +1,133 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package parser
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ActiveMemory/ctx/internal/config/file"
+	cfgOpenCode "github.com/ActiveMemory/ctx/internal/config/opencode"
+	"github.com/ActiveMemory/ctx/internal/config/session"
+	"github.com/ActiveMemory/ctx/internal/entity"
+	errParser "github.com/ActiveMemory/ctx/internal/err/parser"
+	"github.com/ActiveMemory/ctx/internal/io"
+)
+
+// NewOpenCode creates a new OpenCode session parser.
+//
+// Returns:
+//   - *OpenCode: a new parser instance
+func NewOpenCode() *OpenCode {
+	return &OpenCode{}
+}
+
+// Tool returns the tool identifier for this parser.
+//
+// Returns:
+//   - string: the OpenCode tool identifier
+func (p *OpenCode) Tool() string {
+	return session.ToolOpenCode
+}
+
+// Matches returns true if the file is an OpenCode session info
+// document: a .json file at storage/session/<projectID>/.
+//
+// Parameters:
+//   - path: file path to check
+//
+// Returns:
+//   - bool: true if the file is an OpenCode session
+func (p *OpenCode) Matches(path string) bool {
+	if !strings.HasSuffix(path, file.ExtJSON) {
+		return false
+	}
+	sessionDir := filepath.Dir(filepath.Dir(path))
+	return filepath.Base(sessionDir) == cfgOpenCode.DirSession &&
+		filepath.Base(filepath.Dir(sessionDir)) == cfgOpenCode.DirStorage
+}
+
+// ParseFile reads an OpenCode session with its messages and parts.
+//
+// Child sessions (spawned by a subagent tool call) are skipped, as
+// Claude Code sidechains are: their work is summarized in the
+// parent's tool result.
+//
+// Parameters:
+//   - path: path to the session info document
+//
+// Returns:
+//   - []*entity.Session: the parsed session (at most one)
+//   - error: non-nil if the session info cannot be read or decoded
+func (p *OpenCode) ParseFile(path string) ([]*entity.Session, error) {
+	data, readErr := io.SafeReadUserFile(filepath.Clean(path))
+	if readErr != nil {
+		return nil, errParser.ReadFile(readErr)
+	}
+
+	var info openCodeRawSession
+	if unmarshalErr := json.Unmarshal(data, &info); unmarshalErr != nil {
+		return nil, errParser.Unmarshal(unmarshalErr)
+	}
+	if info.ID == "" || info.ParentID != "" {
+		return nil, nil
+	}
+
+	storage := filepath.Dir(filepath.Dir(filepath.Dir(path)))
+	result := p.buildSession(info, storage, path)
+	if result == nil {
+		return nil, nil
+	}
+	return []*entity.Session{result}, nil
+}
+
+// ParseLine is not meaningful for OpenCode sessions since each
+// session is a tree of JSON documents. Returns nil for all lines.
+//
+// Parameters:
+//   - line: the raw line bytes (unused)
+//
+// Returns:
+//   - *entity.Message: always nil
+//   - string: always empty
+//   - error: always nil
+func (p *OpenCode) ParseLine(_ []byte) (*entity.Message, string, error) {
+	return nil, "", nil
+}
+
+// OpenCodeSessionDirs returns the directories where OpenCode session
+// info documents may be stored: opencode/storage/session under
+// $XDG_DATA_HOME, or under ~/.local/share when it is unset.
+//
+// Returns:
+//   - []string: paths to session directories found on the system
+func OpenCodeSessionDirs() []string {
+	dataHome := os.Getenv(cfgOpenCode.EnvDataHome)
+	if dataHome == "" {
+		home, homeErr := os.UserHomeDir()
+		if homeErr != nil {
+			return nil
+		}
+		dataHome = filepath.Join(
+			home, cfgOpenCode.DirLocal, cfgOpenCode.DirShare,
+		)
+	}
+
+	dir := filepath.Join(
+		dataHome, cfgOpenCode.DirApp,
+		cfgOpenCode.DirStorage, cfgOpenCode.DirSession,
+	)
+	if info, statErr := io.SafeStat(dir); statErr == nil && info.IsDir() {
+		return []string{dir}
+	}
+	return nil
+}
+
+// Ensure OpenCode implements Session.
+var _ Session = (*OpenCode)(nil)
@@ -0,0 +1,223 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package parser
+
+import (
+	"cmp"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/ActiveMemory/ctx/internal/config/claude"
+	"github.com/ActiveMemory/ctx/internal/config/file"
+	cfgOpenCode "github.com/ActiveMemory/ctx/internal/config/opencode"
+	"github.com/ActiveMemory/ctx/internal/config/session"
+	"github.com/ActiveMemory/ctx/internal/config/token"
+	"github.com/ActiveMemory/ctx/internal/entity"
+	"github.com/ActiveMemory/ctx/internal/io"
+)
+
+// buildSession assembles an OpenCode session from its info document
+// and the message and part documents in the store.
+//
+// Text parts become message text, reasoning parts become thinking,
+// and tool parts become a tool use plus, once finished, its result.
+// Synthetic text parts (injected by OpenCode, not typed or generated)
+// are skipped. Reasoning tokens count as output.
+//
+// Parameters:
+//   - info: the session info document
+//   - storage: the store root (the directory holding session/)
+//   - sourcePath: path to the session info document
+//
+// Returns:
+//   - *entity.Session: the built session, or nil if it has no
+//     messages
+func (p *OpenCode) buildSession(
+	info openCodeRawSession, storage, sourcePath string,
+) *entity.Session {
+	sess := &entity.Session{
+		ID:         info.ID,
+		Tool:       session.ToolOpenCode,
+		SourceFile: sourcePath,
+		CWD:        info.Directory,
+		StartTime:  time.UnixMilli(info.Time.Created),
+	}
+	if sess.CWD == "" && info.ProjectID != "" {
+		var project openCodeRawProject
+		if readOpenCodeDoc(filepath.Join(
+			storage, cfgOpenCode.DirProject, info.ProjectID+file.ExtJSON,
+		), &project) {
+			sess.CWD = project.Worktree
+		}
+	}
+
+	raws := readOpenCodeDocs[openCodeRawMessage](
+		filepath.Join(storage, cfgOpenCode.DirMessage, info.ID),
+	)
+	slices.SortFunc(raws, func(a, b openCodeRawMessage) int {
+		if c := cmp.Compare(a.Time.Created, b.Time.Created); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.ID, b.ID)
+	})
+
+	sess.EndTime = sess.StartTime
+	for _, raw := range raws {
+		if raw.Role != claude.RoleUser && raw.Role != claude.RoleAssistant {
+			continue
+		}
+		msg := entity.Message{
+			ID:         raw.ID,
+			Timestamp:  time.UnixMilli(raw.Time.Created),
+			Role:       raw.Role,
+			IsApiError: raw.Error != nil,
+		}
+		if raw.Tokens != nil {
+			msg.TokensIn = raw.Tokens.Input
+			msg.TokensOut = raw.Tokens.Output + raw.Tokens.Reasoning
+		}
+		if sess.Model == "" {
+			sess.Model = raw.ModelID
+		}
+		if sess.CWD == "" && raw.Path != nil {
+			sess.CWD = raw.Path.CWD
+		}
+
+		parts := readOpenCodeDocs[openCodeRawPart](
+			filepath.Join(storage, cfgOpenCode.DirPart, raw.ID),
+		)
+		slices.SortFunc(parts, func(a, b openCodeRawPart) int {
+			return cmp.Compare(a.ID, b.ID)
+		})
+		for _, part := range parts {
+			switch part.Type {
+			case cfgOpenCode.PartText:
+				if !part.Synthetic {
+					msg.Text = appendLine(
+						msg.Text, strings.TrimSpace(part.Text),
+					)
+				}
+			case cfgOpenCode.PartReasoning:
+				msg.Thinking = appendLine(
+					msg.Thinking, strings.TrimSpace(part.Text),
+				)
+			case cfgOpenCode.PartTool:
+				p.addToolPart(&msg, part)
+			}
+		}
+
+		for _, tr := range msg.ToolResults {
+			if tr.IsError {
+				sess.HasErrors = true
+			}
+		}
+		if end := time.UnixMilli(
+			max(raw.Time.Created, raw.Time.Completed),
+		); end.After(sess.EndTime) {
+			sess.EndTime = end
+		}
+		sess.TotalTokensIn += msg.TokensIn
+		sess.TotalTokensOut += msg.TokensOut
+		if msg.BelongsToUser() {
+			sess.TurnCount++
+			if sess.FirstUserMsg == "" && msg.Text != "" {
+				preview := msg.Text
+				if len(preview) > session.PreviewMaxLen {
+					preview = preview[:session.PreviewMaxLen] +
+						token.Ellipsis
+				}
+				sess.FirstUserMsg = preview
+			}
+		}
+		sess.Messages = append(sess.Messages, msg)
+	}
+
+	if len(sess.Messages) == 0 {
+		return nil
+	}
+	if sess.CWD != "" {
+		sess.Project = filepath.Base(sess.CWD)
+	}
+	sess.TotalTokens = sess.TotalTokensIn + sess.TotalTokensOut
+	sess.Duration = sess.EndTime.Sub(sess.StartTime)
+	return sess
+}
+
+// addToolPart records a tool part as a tool use and, when the call
+// has finished, its result.
+//
+// Parameters:
+//   - msg: the message the part belongs to
+//   - part: a tool part
+func (p *OpenCode) addToolPart(msg *entity.Message, part openCodeRawPart) {
+	use := entity.ToolUse{ID: part.CallID, Name: part.Tool}
+	if part.State == nil {
+		msg.ToolUses = append(msg.ToolUses, use)
+		return
+	}
+	use.Input = string(part.State.Input)
+	msg.ToolUses = append(msg.ToolUses, use)
+
+	if part.State.Output == "" && part.State.Error == "" {
+		return
+	}
+	isError := part.State.Status == cfgOpenCode.ToolStatusError
+	content := part.State.Output
+	if isError && part.State.Error != "" {
+		content = part.State.Error
+	}
+	msg.ToolResults = append(msg.ToolResults, entity.ToolResult{
+		ToolUseID: part.CallID, Content: content, IsError: isError,
+	})
+}
+
+// readOpenCodeDoc decodes one JSON document from the store.
+//
+// Parameters:
+//   - path: document path
+//   - v: destination
+//
+// Returns:
+//   - bool: true when the document was read and decoded
+func readOpenCodeDoc(path string, v any) bool {
+	data, readErr := io.SafeReadUserFile(path)
+	if readErr != nil {
+		return false
+	}
+	return json.Unmarshal(data, v) == nil
+}
+
+// readOpenCodeDocs decodes every JSON document in a store directory.
+// Documents that cannot be read or decoded are skipped, as is a
+// missing directory.
+//
+// Parameters:
+//   - dir: store directory
+//
+// Returns:
+//   - []T: the decoded documents in directory order
+func readOpenCodeDocs[T any](dir string) []T {
+	entries, readErr := os.ReadDir(dir)
+	if readErr != nil {
+		return nil
+	}
+	var docs []T
+	for _, e := range entries {
+		if e.IsDir() || !strings.HasSuffix(e.Name(), file.ExtJSON) {
+			continue
+		}
+		var doc T
+		if readOpenCodeDoc(filepath.Join(dir, e.Name()), &doc) {
+			docs = append(docs, doc)
+		}
+	}
+	return docs
+}
@@ -0,0 +1,76 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package parser
+
+import "encoding/json"
+
+// openCodeRawSession is an OpenCode session info document.
+type openCodeRawSession struct {
+	ID        string          `json:"id"`
+	ProjectID string          `json:"projectID"`
+	Directory string          `json:"directory"`
+	ParentID  string          `json:"parentID,omitempty"`
+	Time      openCodeRawTime `json:"time"`
+}
+
+// openCodeRawTime holds Unix millisecond timestamps.
+type openCodeRawTime struct {
+	Created   int64 `json:"created"`
+	Completed int64 `json:"completed,omitempty"`
+}
+
+// openCodeRawProject is an OpenCode project document.
+type openCodeRawProject struct {
+	Worktree string `json:"worktree"`
+}
+
+// openCodeRawMessage is an OpenCode message document. Its content
+// lives in separate part documents.
+type openCodeRawMessage struct {
+	ID      string            `json:"id"`
+	Role    string            `json:"role"`
+	Time    openCodeRawTime   `json:"time"`
+	ModelID string            `json:"modelID,omitempty"`
+	Tokens  *openCodeRawUsage `json:"tokens,omitempty"`
+	Path    *struct {
+		CWD string `json:"cwd"`
+	} `json:"path,omitempty"`
+	Error *openCodeRawError `json:"error,omitempty"`
+}
+
+// openCodeRawError is the error recorded on an assistant message
+// whose provider call failed.
+type openCodeRawError struct {
+	Name string `json:"name"`
+}
+
+// openCodeRawUsage is the token usage of an assistant message.
+type openCodeRawUsage struct {
+	Input     int `json:"input"`
+	Output    int `json:"output"`
+	Reasoning int `json:"reasoning"`
+}
+
+// openCodeRawPart is one part of a message: text, reasoning, a tool
+// call, or a marker the importer skips.
+type openCodeRawPart struct {
+	ID        string            `json:"id"`
+	Type      string            `json:"type"`
+	Text      string            `json:"text,omitempty"`
+	Synthetic bool              `json:"synthetic,omitempty"`
+	Tool      string            `json:"tool,omitempty"`
+	CallID    string            `json:"callID,omitempty"`
+	State     *openCodeRawState `json:"state,omitempty"`
+}
+
+// openCodeRawState is the state of a tool call part.
+type openCodeRawState struct {
+	Status string          `json:"status"`
+	Input  json.RawMessage `json:"input,omitempty"`
+	Output string          `json:"output,omitempty"`
+	Error  string          `json:"error,omitempty"`
+}
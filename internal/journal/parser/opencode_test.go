@@ -0,0 +1,90 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package parser
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+const openCodeStore = "testdata/opencode/storage"
+
+var openCodeSession = filepath.Join(openCodeStore, "session",
+	"4b1f0e2d9c8a7b6f5e4d3c2b1a09f8e7d6c5b4a3", "ses_01parent.json")
+
+func TestOpenCodeParser_Matches(t *testing.T) {
+	p := NewOpenCode()
+	if !p.Matches(openCodeSession) {
+		t.Error("session info not matched")
+	}
+	message := filepath.Join(
+		openCodeStore, "message", "ses_01parent", "msg_01user.json",
+	)
+	if p.Matches(message) {
+		t.Error("message document matched")
+	}
+}
+
+func TestOpenCodeParser_ParseFile(t *testing.T) {
+	sessions, err := NewOpenCode().ParseFile(openCodeSession)
+	if err != nil {
+		t.Fatalf("ParseFile failed: %v", err)
+	}
+	if len(sessions) != 1 {
+		t.Fatalf("expected 1 session, got %d", len(sessions))
+	}
+
+	s := sessions[0]
+	if s.ID != "ses_01parent" || s.Tool != "opencode" ||
+		s.Model != "claude-sonnet-4" {
+		t.Errorf("ID = %q, Tool = %q, Model = %q", s.ID, s.Tool, s.Model)
+	}
+	// The directory is empty; the project worktree fills it in.
+	if s.CWD != "/home/dev/greet" || s.Project != "greet" {
+		t.Errorf("CWD = %q, Project = %q", s.CWD, s.Project)
+	}
+	if s.Duration != 90*time.Second {
+		t.Errorf("Duration = %v", s.Duration)
+	}
+	if s.TurnCount != 1 || len(s.Messages) != 3 {
+		t.Fatalf("TurnCount = %d, messages = %d",
+			s.TurnCount, len(s.Messages))
+	}
+	// The synthetic part is skipped.
+	if s.Messages[0].Text != "Add a farewell function next to Greet" {
+		t.Errorf("user text = %q", s.Messages[0].Text)
+	}
+	if s.TotalTokensIn != 1900 || s.TotalTokensOut != 100 {
+		t.Errorf("tokens = %d/%d", s.TotalTokensIn, s.TotalTokensOut)
+	}
+
+	reply := s.Messages[1]
+	if reply.Thinking == "" ||
+		reply.Text != "I added Farewell; the tests still fail." {
+		t.Errorf("reply = %q / %q", reply.Thinking, reply.Text)
+	}
+	if len(reply.ToolUses) != 2 || reply.ToolUses[0].Name != "edit" {
+		t.Fatalf("tool uses = %+v", reply.ToolUses)
+	}
+	results := reply.ToolResults
+	if len(results) != 2 || results[0].Content != "Edit applied" ||
+		!results[1].IsError || results[1].Content != "exit status 1" {
+		t.Errorf("tool results = %+v", results)
+	}
+	if !s.HasErrors || !s.Messages[2].IsApiError {
+		t.Error("errors not recorded")
+	}
+}
+
+func TestOpenCodeParser_SkipsChildSessions(t *testing.T) {
+	child := filepath.Join(filepath.Dir(openCodeSession), "ses_02child.json")
+	sessions, err := NewOpenCode().ParseFile(child)
+	if err != nil || len(sessions) != 0 {
+		t.Errorf("child session = %v, %v", sessions, err)
+	}
+}
@@ -23,6 +23,10 @@ var registeredParsers = []Session{
 	NewClaudeCode(),
 	NewCopilot(),
 	NewCopilotCLI(),
+	NewCodex(),
+	NewAider(),
+	NewGemini(),
+	NewOpenCode(),
 	NewMarkdownSession(),
 }
 
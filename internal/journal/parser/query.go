@@ -20,9 +20,12 @@ import (
 // findSessionsWithFilter scans common locations and additional directories
 // for session files, applying an optional filter.
 //
-// It checks ~/.claude/projects/ (Claude Code default) and any additional
-// directories provided. Results are deduplicated by session ID and sorted
-// by start time (newest first).
+// It checks ~/.claude/projects/ (Claude Code default), the Copilot,
+// Codex CLI, Gemini CLI, and OpenCode session stores, the current
+// project's Aider chat history, and any additional directories
+// provided.
+// Results are deduplicated by session ID and sorted by start time
+// (newest first).
 //
 // Parameters:
 //   - filter: Optional function to filter sessions (nil includes all)
@@ -72,6 +75,38 @@ func findSessionsWithFilter(
 		scanOnce(sessionDir)
 	}
 
+	// Check Codex CLI rollouts (~/.codex/sessions/ or $CODEX_HOME)
+	for _, sessionDir := range CodexSessionDirs() {
+		scanOnce(sessionDir)
+	}
+
+	// Check Gemini CLI chats (~/.gemini/tmp/<project-hash>/chats/)
+	for _, sessionDir := range GeminiSessionDirs() {
+		scanOnce(sessionDir)
+	}
+
+	// Check OpenCode sessions (~/.local/share/opencode/storage/session/)
+	for _, sessionDir := range OpenCodeSessionDirs() {
+		scanOnce(sessionDir)
+	}
+
+	// Check the current project's Aider chat history
+	for _, historyFile := range AiderHistoryFiles() {
+		resolved, symlinkErr := filepath.EvalSymlinks(historyFile)
+		if symlinkErr != nil {
+			resolved = historyFile
+		}
+		if scannedDirs[resolved] {
+			continue
+		}
+		scannedDirs[resolved] = true
+		sessions, parseErr := NewAider().ParseFile(resolved)
+		if parseErr != nil {
+			logWarn.Warn(cfgWarn.JournalScanFile, resolved, parseErr)
+		}
+		allSessions = append(allSessions, sessions...)
+	}
+
 	// Check .context/sessions/ in the current working directory
 	if cwd, cwdErr := os.Getwd(); cwdErr == nil {
 		scanOnce(filepath.Join(cwd, dir.Context, dir.Sessions))
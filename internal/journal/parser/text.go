@@ -0,0 +1,27 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package parser
+
+import "github.com/ActiveMemory/ctx/internal/config/token"
+
+// appendLine appends text on a new line, skipping empty text.
+//
+// Parameters:
+//   - existing: text accumulated so far
+//   - text: text to add
+//
+// Returns:
+//   - string: the combined text
+func appendLine(existing, text string) string {
+	if text == "" {
+		return existing
+	}
+	if existing == "" {
+		return text
+	}
+	return existing + token.NewlineLF + text
+}
@@ -32,6 +32,41 @@ type Copilot struct{}
 // JSONL-formatted messages similar to Claude Code's format.
 type CopilotCLI struct{}
 
+// Aider parses Aider chat history files.
+//
+// Aider appends every session to a Markdown chat history file in the
+// repository root, opening each with "# aider chat started at". Prompts
+// are "####" lines, Aider's own notices are blockquotes, and replies
+// are plain Markdown. The sibling .aider.input.history file supplies
+// per-prompt timestamps when present.
+type Aider struct{}
+
+// Codex parses OpenAI Codex CLI rollout files.
+//
+// Codex CLI stores one session per JSONL file under
+// ~/.codex/sessions/YYYY/MM/DD/rollout-*.jsonl (or $CODEX_HOME/sessions/).
+// Each line is an envelope whose payload is session metadata, a turn
+// context, a response item (message, reasoning, tool call or output),
+// or an event such as a token count.
+type Codex struct{}
+
+// Gemini parses Gemini CLI chat files.
+//
+// Gemini CLI records each chat as one JSON document at
+// ~/.gemini/tmp/<project-hash>/chats/session-*.json. The project hash is
+// the SHA-256 of the project directory; the chat itself records no
+// working directory.
+type Gemini struct{}
+
+// OpenCode parses OpenCode session stores.
+//
+// OpenCode keeps each session as a tree of JSON documents under
+// ~/.local/share/opencode/storage/: the session info in
+// session/<projectID>/, its messages in message/<sessionID>/, and each
+// message's parts in part/<messageID>/. The parser matches the session
+// info file and reads the rest of the tree from there.
+type OpenCode struct{}
+
 // MarkdownSession parses Markdown session files written by AI agents.
 //
 // This parser handles the tool-agnostic session format used by non-Claude
@@ -0,0 +1,56 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package redact removes secrets from journal entries before
+// they are written to .context/journal/.
+//
+// Session transcripts routinely carry credentials: a pasted
+// .env file, a curl command with an authorization header, a
+// tool result that printed a service-account key. Journal
+// entries are committed and shared, so import runs every
+// entry through a [Redactor] between rendering and writing.
+//
+// # Detectors
+//
+// Built-in rules match AWS access and secret keys, GCP API
+// keys, GitHub tokens, JWTs, PEM private key blocks, bearer
+// tokens, and values assigned to secret-looking environment
+// variables. A high-entropy detector catches opaque tokens
+// the patterns miss. Project rules from the redact section
+// of .ctxrc run after the built-ins; strings listed under
+// allow are never redacted.
+//
+// When a pattern has a capture group, only the first group
+// is replaced, so "Authorization: Bearer <token>" keeps its
+// header name.
+//
+// # Placeholders
+//
+// Each match becomes "[REDACTED:<rule>:<n>]", where n
+// numbers the entry's secrets in order of first appearance.
+// Repeats of one secret share a number, so readers can
+// still tell two occurrences of one key from two different
+// keys, and re-importing an entry is byte-stable. Nothing
+// derived from the secret is written: a hash, even a short
+// one, would let anyone holding the journal confirm a
+// guessed key. Ruleset v1 placeholders carried such a hash;
+// [Redactor.Apply] renumbers them.
+//
+// # Versioning
+//
+// [Redactor.Version] names the ruleset an entry went through:
+// the built-in version, plus a hash of the project rules when
+// there are any. Import records it in the journal state so
+// `ctx journal redact` can find entries processed by an older
+// ruleset.
+//
+// # Public Surface
+//
+//   - [New]: build a redactor from explicit rules.
+//   - [Load]: build the redactor .ctxrc asks for.
+//   - [Redactor.Apply]: replace secrets in text.
+//   - [Redactor.Scan]: report secrets without replacing them.
+package redact
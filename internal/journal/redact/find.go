@@ -0,0 +1,162 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package redact
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"unicode"
+
+	cfgRedact "github.com/ActiveMemory/ctx/internal/config/redact"
+	"github.com/ActiveMemory/ctx/internal/config/regex"
+)
+
+// find locates every secret in text. Rules run in priority
+// order and a match overlapping an earlier one is dropped, so
+// a private key block is reported once rather than once per
+// detector that fires inside it.
+//
+// Parameters:
+//   - text: text to search
+//
+// Returns:
+//   - []span: non-overlapping matches sorted by offset
+func (r *Redactor) find(text string) []span {
+	var spans []span
+	add := func(start, end int, name string) {
+		if start >= end || r.allow[text[start:end]] ||
+			overlaps(spans, start, end) {
+			return
+		}
+		spans = append(spans, span{
+			start: start, end: end, rule: name,
+			key: name + cfgRedact.FieldSep + text[start:end],
+		})
+	}
+
+	for _, ru := range r.rules {
+		for _, m := range ru.re.FindAllStringSubmatchIndex(text, -1) {
+			start, end := m[0], m[1]
+			// Redact only the first group when the pattern has
+			// one, keeping surrounding context such as the
+			// variable name.
+			if len(m) > 2 && m[2] >= 0 {
+				start, end = m[2], m[3]
+			}
+			add(start, end, ru.name)
+		}
+	}
+	if r.entropy {
+		for _, m := range regex.RedactEntropyCandidate.FindAllStringIndex(
+			text, -1,
+		) {
+			if highEntropy(text[m[0]:m[1]]) {
+				add(m[0], m[1], cfgRedact.RuleHighEntropy)
+			}
+		}
+	}
+
+	slices.SortFunc(spans, func(a, b span) int {
+		return a.start - b.start
+	})
+	return spans
+}
+
+// overlaps reports whether a byte range intersects any
+// accepted span.
+//
+// Parameters:
+//   - spans: accepted spans
+//   - start: first byte offset of the candidate
+//   - end: offset one past the candidate's last byte
+//
+// Returns:
+//   - bool: true on any intersection
+func overlaps(spans []span, start, end int) bool {
+	for _, s := range spans {
+		if start < s.end && s.start < end {
+			return true
+		}
+	}
+	return false
+}
+
+// nextNumber returns the first placeholder number not yet
+// used in text, so a second redaction pass over an entry
+// never reuses a number an earlier pass assigned.
+//
+// Parameters:
+//   - text: text that may already hold placeholders
+//
+// Returns:
+//   - int: one past the highest existing number
+func nextNumber(text string) int {
+	next := 1
+	for _, m := range regex.RedactPlaceholder.FindAllStringSubmatch(
+		text, -1,
+	) {
+		// Acceptable discard: the pattern admits only up to
+		// seven digits, which always parse.
+		n, _ := strconv.Atoi(m[1])
+		next = max(next, n+1)
+	}
+	return next
+}
+
+// placeholder renders the replacement for a secret.
+//
+// Parameters:
+//   - name: rule that matched
+//   - n: the secret's number within the entry
+//
+// Returns:
+//   - string: "[REDACTED:<rule>:<n>]"
+func placeholder(name string, n int) string {
+	return fmt.Sprintf(cfgRedact.PlaceholderFormat, name, n)
+}
+
+// highEntropy reports whether a token looks like a random
+// secret: long enough, mixing upper case, lower case, and
+// digits, and with high Shannon entropy. Requiring all three
+// character classes keeps hex digests, paths, and
+// identifiers out.
+//
+// Parameters:
+//   - tok: candidate token
+//
+// Returns:
+//   - bool: true when the token should be redacted
+func highEntropy(tok string) bool {
+	if len(tok) < cfgRedact.EntropyMinLen {
+		return false
+	}
+	var upper, lower, digit bool
+	counts := make(map[rune]int)
+	for _, c := range tok {
+		counts[c]++
+		switch {
+		case unicode.IsUpper(c):
+			upper = true
+		case unicode.IsLower(c):
+			lower = true
+		case unicode.IsDigit(c):
+			digit = true
+		}
+	}
+	if !upper || !lower || !digit {
+		return false
+	}
+	n := float64(len(tok))
+	bits := 0.0
+	for _, k := range counts {
+		p := float64(k) / n
+		bits -= p * math.Log2(p)
+	}
+	return bits >= cfgRedact.EntropyThreshold
+}
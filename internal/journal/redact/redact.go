@@ -0,0 +1,163 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package redact
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	cfgRedact "github.com/ActiveMemory/ctx/internal/config/redact"
+	"github.com/ActiveMemory/ctx/internal/config/regex"
+	"github.com/ActiveMemory/ctx/internal/config/token"
+	errJournal "github.com/ActiveMemory/ctx/internal/err/journal"
+	"github.com/ActiveMemory/ctx/internal/rc"
+)
+
+// New builds a redactor from the built-in rules followed by
+// project rules.
+//
+// Parameters:
+//   - project: project rules, applied after the built-ins
+//   - allow: strings never redacted
+//   - entropy: whether the high-entropy detector runs
+//
+// Returns:
+//   - *Redactor: the redactor
+//   - error: non-nil if a project pattern does not compile
+func New(
+	project []cfgRedact.Rule, allow []string, entropy bool,
+) (*Redactor, error) {
+	r := &Redactor{
+		rules: []rule{
+			{cfgRedact.RulePrivateKey, regex.RedactPrivateKey},
+			{cfgRedact.RuleAWSAccessKey, regex.RedactAWSAccessKey},
+			{cfgRedact.RuleAWSSecretKey, regex.RedactAWSSecretKey},
+			{cfgRedact.RuleGCPAPIKey, regex.RedactGCPAPIKey},
+			{cfgRedact.RuleGitHubToken, regex.RedactGitHubToken},
+			{cfgRedact.RuleJWT, regex.RedactJWT},
+			{cfgRedact.RuleBearer, regex.RedactBearer},
+			{cfgRedact.RuleEnvSecret, regex.RedactEnvSecret},
+		},
+		allow:   make(map[string]bool, len(allow)),
+		entropy: entropy,
+		version: cfgRedact.RulesetVersion,
+	}
+	for _, a := range allow {
+		r.allow[a] = true
+	}
+
+	// The version changes whenever anything that shapes the
+	// output changes, so the state index can tell entries
+	// redacted under different settings apart.
+	var settings []string
+	for _, p := range project {
+		re, compileErr := regex.CompileUser(p.Pattern)
+		if compileErr != nil {
+			return nil, errJournal.RedactRule(p.Name, compileErr)
+		}
+		r.rules = append(r.rules, rule{p.Name, re})
+		settings = append(settings, p.Name, p.Pattern)
+	}
+	settings = append(settings, allow...)
+	if !entropy {
+		settings = append(settings, cfgRedact.RuleHighEntropy)
+	}
+	if len(settings) > 0 {
+		sum := sha256.Sum256(
+			[]byte(strings.Join(settings, cfgRedact.FieldSep)),
+		)
+		r.version += cfgRedact.VersionSep +
+			hex.EncodeToString(sum[:])[:cfgRedact.HashLen]
+	}
+	return r, nil
+}
+
+// Load builds the redactor configured in .ctxrc.
+//
+// Returns:
+//   - *Redactor: the redactor, or nil when redaction is
+//     disabled
+//   - error: non-nil if a project pattern does not compile
+func Load() (*Redactor, error) {
+	if !rc.RedactEnabled() {
+		return nil, nil
+	}
+	return New(rc.RedactRules(), rc.RedactAllow(), rc.RedactEntropy())
+}
+
+// Version returns the ruleset version to record in the
+// journal state.
+//
+// Returns:
+//   - string: built-in version, plus a hash of the project
+//     settings when there are any; "" for a nil redactor
+func (r *Redactor) Version() string {
+	if r == nil {
+		return ""
+	}
+	return r.version
+}
+
+// Apply replaces every secret in text with its placeholder.
+// Secrets are numbered in order of first appearance,
+// continuing after any placeholder already in text; repeats
+// of one secret share a number.
+//
+// Parameters:
+//   - text: text to redact, typically one journal entry
+//
+// Returns:
+//   - string: redacted text
+//   - int: number of secrets replaced
+func (r *Redactor) Apply(text string) (string, int) {
+	if r == nil {
+		return text, 0
+	}
+	spans := r.find(text)
+	if len(spans) == 0 {
+		return text, 0
+	}
+	next := nextNumber(text)
+	numbers := make(map[string]int)
+	var b strings.Builder
+	last := 0
+	for _, s := range spans {
+		n, seen := numbers[s.key]
+		if !seen {
+			n = next
+			numbers[s.key] = n
+			next++
+		}
+		b.WriteString(text[last:s.start])
+		b.WriteString(placeholder(s.rule, n))
+		last = s.end
+	}
+	b.WriteString(text[last:])
+	return b.String(), len(spans)
+}
+
+// Scan reports the secrets in text without replacing them.
+//
+// Parameters:
+//   - text: text to scan
+//
+// Returns:
+//   - []Finding: secrets in text order
+func (r *Redactor) Scan(text string) []Finding {
+	if r == nil {
+		return nil
+	}
+	var out []Finding
+	line, last := 1, 0
+	for _, s := range r.find(text) {
+		line += strings.Count(text[last:s.start], token.NewlineLF)
+		last = s.start
+		out = append(out, Finding{Line: line, Rule: s.rule})
+	}
+	return out
+}
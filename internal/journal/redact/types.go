@@ -0,0 +1,58 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package redact
+
+import "regexp"
+
+// Redactor replaces secrets in text with stable placeholders.
+// A nil Redactor leaves text unchanged.
+//
+// Fields:
+//   - rules: pattern rules in priority order
+//   - allow: strings never redacted
+//   - entropy: whether the high-entropy detector runs
+//   - version: ruleset version recorded in journal state
+type Redactor struct {
+	rules   []rule
+	allow   map[string]bool
+	entropy bool
+	version string
+}
+
+// Finding is one secret located by [Redactor.Scan].
+//
+// Fields:
+//   - Line: 1-based line where the secret starts
+//   - Rule: name of the rule that matched
+type Finding struct {
+	Line int    `json:"line"`
+	Rule string `json:"rule"`
+}
+
+// rule is a named pattern.
+//
+// Fields:
+//   - name: label used in placeholders and findings
+//   - re: compiled pattern
+type rule struct {
+	name string
+	re   *regexp.Regexp
+}
+
+// span is a byte range of text to redact.
+//
+// Fields:
+//   - start: first byte offset
+//   - end: offset one past the last byte
+//   - rule: name of the rule that matched
+//   - key: identity for numbering; spans with equal keys
+//     share a placeholder number
+type span struct {
+	start, end int
+	rule       string
+	key        string
+}
@@ -10,6 +10,8 @@ import (
 	"encoding/json"
 	"os"
 	"path/filepath"
+	"sort"
+	"strings"
 
 	"github.com/ActiveMemory/ctx/internal/config/file"
 	"github.com/ActiveMemory/ctx/internal/config/fs"
@@ -17,6 +19,7 @@ import (
 	"github.com/ActiveMemory/ctx/internal/config/token"
 	"github.com/ActiveMemory/ctx/internal/format"
 	ctxIo "github.com/ActiveMemory/ctx/internal/io"
+	"github.com/ActiveMemory/ctx/internal/txn"
 )
 
 // CurrentVersion is the schema version for the state file.
@@ -71,13 +74,13 @@ func Load(journalDir string) (*State, error) {
 }
 
 // Save writes the state file atomically (temp + rename) to the journal
-// directory.
+// directory, under the context directory's write lock.
 //
 // Parameters:
 //   - journalDir: path to the journal directory
 //
 // Returns:
-//   - error: non-nil if marshalling or file write fails
+//   - error: non-nil if marshalling, locking, or file write fails
 func (s *State) Save(journalDir string) error {
 	data, marshalErr := json.MarshalIndent(s, "", token.Indent2)
 	if marshalErr != nil {
@@ -86,12 +89,9 @@ func (s *State) Save(journalDir string) error {
 	data = append(data, token.NewlineLF[0])
 
 	path := filepath.Join(journalDir, journal.File)
-	tmp := path + file.ExtTmp
-
-	if writeErr := ctxIo.SafeWriteFile(tmp, data, fs.PermFile); writeErr != nil {
-		return writeErr
-	}
-	return os.Rename(tmp, path)
+	return txn.Do(filepath.Dir(journalDir), func() error {
+		return ctxIo.SafeWriteFileAtomic(path, data, fs.PermFile)
+	})
 }
 
 // MarkImported records that a file was imported.
@@ -235,6 +235,29 @@ func (s *State) SessionSource(id string) (Source, bool) {
 	return src, ok
 }
 
+// MatchSessions resolves a session ID or prefix against
+// the sessions with recorded sources. An exact match wins;
+// otherwise every session whose ID starts with id matches.
+//
+// Parameters:
+//   - id: full session ID or a prefix of one
+//
+// Returns:
+//   - []string: matching full session IDs, sorted
+func (s *State) MatchSessions(id string) []string {
+	if _, ok := s.Sessions[id]; ok {
+		return []string{id}
+	}
+	var matched []string
+	for full := range s.Sessions {
+		if strings.HasPrefix(full, id) {
+			matched = append(matched, full)
+		}
+	}
+	sort.Strings(matched)
+	return matched
+}
+
 // MarkSource records the transcript stats a session was last rendered
 // from. Called after a successful render (or during adoption of an
 // already-imported v1 session) so the next sweep can detect growth.
@@ -281,6 +304,30 @@ func (s *State) SetRenderHash(filename, hash string) {
 	s.Entries[filename] = ff
 }
 
+// Redaction returns the redaction ruleset version a file last went
+// through, or "" if it was never redacted.
+//
+// Parameters:
+//   - filename: journal entry filename
+//
+// Returns:
+//   - string: recorded ruleset version, or "" if absent
+func (s *State) Redaction(filename string) string {
+	return s.Entries[filename].Redaction
+}
+
+// SetRedaction records the redaction ruleset version a file was
+// written with. An empty version clears the record.
+//
+// Parameters:
+//   - filename: journal entry filename
+//   - version: ruleset version from the redactor
+func (s *State) SetRedaction(filename, version string) {
+	ff := s.Entries[filename]
+	ff.Redaction = version
+	s.Entries[filename] = ff
+}
+
 // ClearEnriched removes the enriched date for a file, resetting it to
 // unenriched. Used when --force re-export discards frontmatter.
 //
@@ -183,6 +183,26 @@ func TestMarkSource_NilMap(t *testing.T) {
 	}
 }
 
+func TestMatchSessions(t *testing.T) {
+	s := &State{Version: CurrentVersion, Entries: make(map[string]File)}
+	s.MarkSource("abcd1234-aa", "/t/a.jsonl", 1, 1)
+	s.MarkSource("abcd1234-bb", "/t/b.jsonl", 1, 1)
+	s.MarkSource("abcd1234", "/t/c.jsonl", 1, 1)
+
+	cases := map[string]int{
+		"abcd1234":    1, // exact match beats the shared prefix
+		"abcd1234-a":  1,
+		"abcd1234-":   2,
+		"ffff0000":    0,
+		"abcd1234-aa": 1,
+	}
+	for id, want := range cases {
+		if got := s.MatchSessions(id); len(got) != want {
+			t.Errorf("MatchSessions(%q) = %v, want %d", id, got, want)
+		}
+	}
+}
+
 func TestCountUnenriched(t *testing.T) {
 	dir := t.TempDir()
 
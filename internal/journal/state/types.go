@@ -36,6 +36,9 @@ type State struct {
 //     unedited before splicing fresh transcript into it; a mismatch
 //     means a human edited the file and it must not be clobbered.
 //     Empty in v1 files and for entries never written under v2.
+//   - Redaction: Version of the secret redaction ruleset the entry
+//     last went through. Empty when the entry was written with
+//     redaction disabled or before redaction existed.
 type File struct {
 	Exported       string `json:"exported,omitempty"`
 	Enriched       string `json:"enriched,omitempty"`
@@ -43,6 +46,7 @@ type File struct {
 	FencesVerified string `json:"fences_verified,omitempty"`
 	Locked         string `json:"locked,omitempty"`
 	RenderHash     string `json:"render_hash,omitempty"`
+	Redaction      string `json:"redaction,omitempty"`
 }
 
 // Source records the transcript a session was last rendered from, so
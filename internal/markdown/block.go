@@ -0,0 +1,453 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package markdown
+
+import (
+	"html"
+	"strings"
+	"unicode"
+
+	"github.com/ActiveMemory/ctx/internal/assets/tpl"
+	"github.com/ActiveMemory/ctx/internal/config/marker"
+	"github.com/ActiveMemory/ctx/internal/config/regex"
+	cfgSite "github.com/ActiveMemory/ctx/internal/config/site"
+	"github.com/ActiveMemory/ctx/internal/config/token"
+	"github.com/ActiveMemory/ctx/internal/i18n"
+	ctxIo "github.com/ActiveMemory/ctx/internal/io"
+)
+
+// blocks renders a sequence of lines as block-level HTML.
+//
+// Parameters:
+//   - lines: source lines
+//   - tight: render paragraphs without <p> wrappers (the
+//     content of a tight list item)
+//
+// Returns:
+//   - string: rendered HTML
+func (r *renderer) blocks(lines []string, tight bool) string {
+	var sb strings.Builder
+	for i := 0; i < len(lines); {
+		line := lines[i]
+		switch {
+		case blank(line):
+			i++
+		case isFence(line):
+			i = r.fence(lines, i, &sb)
+		case indent(line) >= cfgSite.IndentWidth:
+			i = r.indented(lines, i, &sb)
+		case regex.RenderHeading.MatchString(line):
+			m := regex.RenderHeading.FindStringSubmatch(line)
+			r.heading(len(m[1]), strings.TrimSpace(m[2]), &sb)
+			i++
+		case regex.RenderRule.MatchString(line):
+			sb.WriteString(tpl.HTMLRule)
+			i++
+		case htmlBlock(line) != "":
+			i = r.rawHTML(lines, i, &sb)
+		case isQuote(line):
+			i = r.quote(lines, i, &sb)
+		case regex.RenderAdmonition.MatchString(line):
+			i = r.admonition(lines, i, &sb)
+		case regex.RenderTab.MatchString(line):
+			i = r.tabs(lines, i, &sb)
+		case regex.RenderListItem.MatchString(line):
+			i = r.list(lines, i, &sb)
+		case isTable(lines, i):
+			i = r.table(lines, i, &sb)
+		default:
+			i = r.paragraph(lines, i, tight, &sb)
+		}
+	}
+	return sb.String()
+}
+
+// isFence reports whether a line opens or closes a fenced
+// code block.
+//
+// Parameters:
+//   - line: source line
+//
+// Returns:
+//   - bool: true for a fence line indented less than a
+//     code block
+func isFence(line string) bool {
+	return indent(line) < cfgSite.IndentWidth &&
+		regex.CodeFenceLine.MatchString(line)
+}
+
+// isQuote reports whether a line is part of a block quote.
+//
+// Parameters:
+//   - line: source line
+//
+// Returns:
+//   - bool: true when the line starts with '>'
+func isQuote(line string) bool {
+	trimmed := strings.TrimLeft(line, token.Space)
+	return indent(line) < cfgSite.IndentWidth &&
+		strings.HasPrefix(trimmed, marker.AngleGT)
+}
+
+// htmlBlock returns the tag of the raw HTML block a line
+// opens.
+//
+// Parameters:
+//   - line: source line
+//
+// Returns:
+//   - string: the folded tag name, the comment opener for
+//     an HTML comment, or empty when the line does not open
+//     an HTML block
+func htmlBlock(line string) string {
+	trimmed := strings.TrimLeft(line, token.Space)
+	if indent(line) >= cfgSite.IndentWidth {
+		return ""
+	}
+	if strings.HasPrefix(trimmed, marker.CommentOpen) {
+		return marker.CommentOpen
+	}
+	m := regex.RenderHTMLBlock.FindStringSubmatch(line)
+	if m == nil {
+		return ""
+	}
+	tag := i18n.Fold(m[1])
+	if cfgSite.BlockTags[tag] || cfgSite.RawBlockTags[tag] {
+		return tag
+	}
+	return ""
+}
+
+// startsBlock reports whether a line begins a block that
+// ends a paragraph or a lazy list continuation.
+//
+// Parameters:
+//   - line: source line
+//
+// Returns:
+//   - bool: true when the line interrupts a paragraph
+func startsBlock(line string) bool {
+	return isFence(line) ||
+		regex.RenderHeading.MatchString(line) ||
+		regex.RenderRule.MatchString(line) ||
+		htmlBlock(line) != "" ||
+		isQuote(line) ||
+		regex.RenderAdmonition.MatchString(line) ||
+		regex.RenderTab.MatchString(line) ||
+		regex.RenderListItem.MatchString(line)
+}
+
+// heading writes a heading and records the page title.
+//
+// Parameters:
+//   - level: heading level (1-6)
+//   - text: heading source text
+//   - sb: output
+func (r *renderer) heading(level int, text string, sb *strings.Builder) {
+	inner := inline(text)
+	if level == 1 && r.title == "" {
+		r.title = plain(inner)
+	}
+	ctxIo.SafeFprintf(sb, tpl.HTMLHeading, level, r.anchor(inner), inner)
+}
+
+// fence renders a fenced code block. An unclosed fence
+// runs to the end of the input.
+//
+// Parameters:
+//   - lines: source lines
+//   - start: index of the opening fence
+//   - sb: output
+//
+// Returns:
+//   - int: index of the first line after the block
+func (r *renderer) fence(
+	lines []string, start int, sb *strings.Builder,
+) int {
+	open := regex.CodeFenceLine.FindStringSubmatch(lines[start])
+	run, pad := open[1], indent(lines[start])
+	lang := ""
+	if info := strings.Fields(open[2]); len(info) > 0 {
+		lang = info[0]
+	}
+
+	var code []string
+	i := start + 1
+	for ; i < len(lines); i++ {
+		m := regex.CodeFenceLine.FindStringSubmatch(lines[i])
+		if m != nil && m[1][0] == run[0] && len(m[1]) >= len(run) &&
+			strings.TrimSpace(m[2]) == "" {
+			i++
+			break
+		}
+		code = append(code, dedent(lines[i], pad))
+	}
+	writeCode(sb, code, lang)
+	return i
+}
+
+// indented renders an indented code block.
+//
+// Parameters:
+//   - lines: source lines
+//   - start: index of the first code line
+//   - sb: output
+//
+// Returns:
+//   - int: index of the first line after the block
+func (r *renderer) indented(
+	lines []string, start int, sb *strings.Builder,
+) int {
+	var code []string
+	i := start
+	for ; i < len(lines); i++ {
+		if !blank(lines[i]) && indent(lines[i]) < cfgSite.IndentWidth {
+			break
+		}
+		code = append(code, dedent(lines[i], cfgSite.IndentWidth))
+	}
+	for len(code) > 0 && blank(code[len(code)-1]) {
+		code = code[:len(code)-1]
+	}
+	writeCode(sb, code, "")
+	return i
+}
+
+// writeCode writes an escaped code block.
+//
+// Parameters:
+//   - sb: output
+//   - code: code lines
+//   - lang: language from the fence info string, or empty
+func writeCode(sb *strings.Builder, code []string, lang string) {
+	body := ""
+	if len(code) > 0 {
+		body = html.EscapeString(
+			strings.Join(code, token.NewlineLF) + token.NewlineLF,
+		)
+	}
+	if lang == "" {
+		ctxIo.SafeFprintf(sb, tpl.HTMLCode, body)
+		return
+	}
+	ctxIo.SafeFprintf(sb, tpl.HTMLCodeLang, html.EscapeString(lang), body)
+}
+
+// rawHTML copies a raw HTML block. Comments and
+// preformatted blocks run to their closing tag; any other
+// block runs to the next blank line. The block is
+// sanitized as a whole, so a tag split across lines is
+// judged like any other (see [sanitize]).
+//
+// Parameters:
+//   - lines: source lines
+//   - start: index of the opening line
+//   - sb: output
+//
+// Returns:
+//   - int: index of the first line after the block
+func (r *renderer) rawHTML(
+	lines []string, start int, sb *strings.Builder,
+) int {
+	tag := htmlBlock(lines[start])
+	closer := ""
+	switch {
+	case tag == marker.CommentOpen:
+		closer = marker.CommentClose
+	case cfgSite.RawBlockTags[tag]:
+		closer = cfgSite.CloseTagPrefix + tag
+	}
+
+	i := start
+	for ; i < len(lines); i++ {
+		if closer == "" && blank(lines[i]) {
+			break
+		}
+		if closer != "" && strings.Contains(i18n.Fold(lines[i]), closer) {
+			i++
+			break
+		}
+	}
+	sb.WriteString(
+		sanitize(strings.Join(lines[start:i], token.NewlineLF)) +
+			token.NewlineLF,
+	)
+	return i
+}
+
+// quote renders a block quote.
+//
+// Parameters:
+//   - lines: source lines
+//   - start: index of the first quoted line
+//   - sb: output
+//
+// Returns:
+//   - int: index of the first line after the quote
+func (r *renderer) quote(
+	lines []string, start int, sb *strings.Builder,
+) int {
+	var inner []string
+	i := start
+	for ; i < len(lines) && isQuote(lines[i]); i++ {
+		text := strings.TrimLeft(lines[i], token.Space)[1:]
+		inner = append(inner, strings.TrimPrefix(text, token.Space))
+	}
+	ctxIo.SafeFprintf(sb, tpl.HTMLBlockquote, r.blocks(inner, false))
+	return i
+}
+
+// body collects an indented body: the lines after an
+// admonition or tab opener that are blank or indented by
+// [cfgSite.IndentWidth].
+//
+// Parameters:
+//   - lines: source lines
+//   - from: index of the first candidate body line
+//
+// Returns:
+//   - []string: the body, dedented, trailing blanks dropped
+//   - int: index of the first line after the body
+func body(lines []string, from int) ([]string, int) {
+	var out []string
+	end := from
+	for i := from; i < len(lines); i++ {
+		if blank(lines[i]) {
+			out = append(out, "")
+			continue
+		}
+		if indent(lines[i]) < cfgSite.IndentWidth {
+			break
+		}
+		out = append(out, dedent(lines[i], cfgSite.IndentWidth))
+		end = i + 1
+	}
+	return out[:end-from], end
+}
+
+// admonition renders a `!!!` or `???` admonition.
+//
+// Parameters:
+//   - lines: source lines
+//   - start: index of the opener
+//   - sb: output
+//
+// Returns:
+//   - int: index of the first line after the admonition
+func (r *renderer) admonition(
+	lines []string, start int, sb *strings.Builder,
+) int {
+	m := regex.RenderAdmonition.FindStringSubmatch(lines[start])
+	kind := i18n.Fold(m[2])
+	title := m[3]
+	if !strings.Contains(lines[start], token.DoubleQuote) {
+		title = capitalize(kind)
+	}
+	content, next := body(lines, start+1)
+	inner := r.blocks(content, false)
+
+	switch {
+	case m[1][0] == '?':
+		open := ""
+		if m[1][len(m[1])-1] == '+' {
+			open = tpl.HTMLOpenAttr
+		}
+		ctxIo.SafeFprintf(sb, tpl.HTMLCollapsible,
+			kind, open, inline(title), inner)
+	case title == "":
+		ctxIo.SafeFprintf(sb, tpl.HTMLAdmonitionBare, kind, inner)
+	default:
+		ctxIo.SafeFprintf(sb, tpl.HTMLAdmonition,
+			kind, inline(title), inner)
+	}
+	return next
+}
+
+// tabs renders a run of `=== "Label"` content tabs.
+//
+// Parameters:
+//   - lines: source lines
+//   - start: index of the first tab opener
+//   - sb: output
+//
+// Returns:
+//   - int: index of the first line after the tab set
+func (r *renderer) tabs(
+	lines []string, start int, sb *strings.Builder,
+) int {
+	var set strings.Builder
+	i := start
+	for i < len(lines) {
+		m := regex.RenderTab.FindStringSubmatch(lines[i])
+		if m == nil {
+			break
+		}
+		content, next := body(lines, i+1)
+		ctxIo.SafeFprintf(&set, tpl.HTMLTab,
+			inline(m[1]), r.blocks(content, false))
+		i = next
+		// Tabs separated only by blank lines form one set.
+		j := i
+		for j < len(lines) && blank(lines[j]) {
+			j++
+		}
+		if j == len(lines) || !regex.RenderTab.MatchString(lines[j]) {
+			break
+		}
+		i = j
+	}
+	ctxIo.SafeFprintf(sb, tpl.HTMLTabs, set.String())
+	return i
+}
+
+// paragraph renders a paragraph, or a setext heading when
+// the paragraph is underlined.
+//
+// Parameters:
+//   - lines: source lines
+//   - start: index of the first line
+//   - tight: omit the <p> wrapper
+//   - sb: output
+//
+// Returns:
+//   - int: index of the first line after the paragraph
+func (r *renderer) paragraph(
+	lines []string, start int, tight bool, sb *strings.Builder,
+) int {
+	para := []string{strings.TrimLeft(lines[start], token.Space)}
+	i := start + 1
+	for ; i < len(lines); i++ {
+		line := lines[i]
+		if blank(line) {
+			break
+		}
+		if m := regex.RenderSetext.FindStringSubmatch(line); m != nil {
+			level := 1
+			if m[1][0] == '-' {
+				level = 2
+			}
+			r.heading(level, strings.TrimSpace(
+				strings.Join(para, token.NewlineLF),
+			), sb)
+			return i + 1
+		}
+		if startsBlock(line) {
+			break
+		}
+		para = append(para, strings.TrimLeft(line, token.Space))
+	}
+
+	inner := inline(strings.TrimRightFunc(
+		strings.Join(para, token.NewlineLF), unicode.IsSpace,
+	))
+	if tight {
+		sb.WriteString(inner + token.NewlineLF)
+		return i
+	}
+	ctxIo.SafeFprintf(sb, tpl.HTMLParagraph, inner)
+	return i
+}
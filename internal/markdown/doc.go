@@ -0,0 +1,44 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package markdown converts the Markdown ctx generates into
+// HTML, with no external toolchain.
+//
+// It is the renderer behind the built-in static site
+// (`ctx journal site --build`, `ctx kb site`, `ctx serve`).
+// It covers what the generated journal and knowledge-base
+// trees use rather than every corner of CommonMark:
+//
+//   - ATX and setext headings, with unique anchor ids.
+//   - Paragraphs, hard line breaks, thematic breaks.
+//   - Fenced and indented code.
+//   - Block quotes; bullet, ordered, and task lists,
+//     nested and tight or loose.
+//   - GFM tables with column alignment.
+//   - MkDocs admonitions (`!!!`, collapsible `???`) and
+//     content tabs (`=== "Label"`).
+//   - Raw HTML blocks such as the <details> and <pre>
+//     blocks the journal pipeline emits.
+//   - Inline code, emphasis, strikethrough, links, images,
+//     autolinks, and bare URLs.
+//
+// Relative links to .md files are rewritten to .html so a
+// rendered tree links to itself. Raw HTML is escaped by
+// default, because session transcripts are full of
+// angle-bracketed text that is not markup. An HTML
+// tokenizer reads each tag, and only a tag whose name is
+// whitelisted (formatting tags inline; block and formatting
+// tags in raw HTML blocks) and whose attributes are all
+// whitelisted is re-emitted, rebuilt from its parsed form.
+// Script, style, textarea, event handlers, and URL
+// attributes using javascript:, vbscript:, or data: are
+// therefore escaped; Markdown link and image destinations
+// using those schemes are replaced by "#".
+//
+// # Public Surface
+//
+//   - [Render]: convert one page.
+package markdown
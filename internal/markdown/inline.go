@@ -0,0 +1,493 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package markdown
+
+import (
+	"html"
+	"net/url"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/ActiveMemory/ctx/internal/assets/tpl"
+	cfgFile "github.com/ActiveMemory/ctx/internal/config/file"
+	"github.com/ActiveMemory/ctx/internal/config/marker"
+	"github.com/ActiveMemory/ctx/internal/config/regex"
+	cfgSite "github.com/ActiveMemory/ctx/internal/config/site"
+	"github.com/ActiveMemory/ctx/internal/config/token"
+	"github.com/ActiveMemory/ctx/internal/i18n"
+	ctxIo "github.com/ActiveMemory/ctx/internal/io"
+)
+
+// inline renders the inline content of a block. Text that
+// is not markup is HTML-escaped.
+//
+// Parameters:
+//   - s: inline source (may span lines)
+//
+// Returns:
+//   - string: rendered HTML
+func inline(s string) string {
+	var sb strings.Builder
+	for i := 0; i < len(s); {
+		if next, ok := markup(s, i, &sb); ok {
+			i = next
+			continue
+		}
+		// Copy plain text up to the next byte that may start
+		// markup. The byte at i is copied even when special,
+		// since no markup matched there.
+		j := i + 1
+		if k := strings.IndexAny(s[j:], cfgSite.InlineSpecial); k >= 0 {
+			j += k
+		} else {
+			j = len(s)
+		}
+		sb.WriteString(html.EscapeString(s[i:j]))
+		i = j
+	}
+	return sb.String()
+}
+
+// markup renders the inline construct starting at s[i],
+// if any.
+//
+// Parameters:
+//   - s: inline source
+//   - i: current position
+//   - sb: output
+//
+// Returns:
+//   - int: position after the construct
+//   - bool: false when nothing starts at s[i]
+func markup(s string, i int, sb *strings.Builder) (int, bool) {
+	rest := s[i:]
+	switch s[i] {
+	case '\\':
+		switch {
+		case len(rest) > 1 && rest[1] == token.NewlineLF[0]:
+			sb.WriteString(tpl.HTMLBreak)
+			return i + 2, true
+		case len(rest) > 1 && rest[1] < utf8.RuneSelf &&
+			(unicode.IsPunct(rune(rest[1])) ||
+				unicode.IsSymbol(rune(rest[1]))):
+			sb.WriteString(html.EscapeString(rest[1:2]))
+			return i + 2, true
+		}
+	case ' ':
+		n := len(rest) - len(strings.TrimLeft(rest, token.Space))
+		if n >= 2 && n < len(rest) && rest[n] == token.NewlineLF[0] {
+			sb.WriteString(tpl.HTMLBreak)
+			return i + n + 1, true
+		}
+	case '`':
+		return codeSpan(s, i, sb), true
+	case '*', '_', '~':
+		return emphasis(s, i, sb), true
+	case '!':
+		if len(rest) > 1 && rest[1] == '[' {
+			return link(s, i+1, true, sb)
+		}
+	case '[':
+		return link(s, i, false, sb)
+	case '<':
+		if m := regex.RenderAutolink.FindStringSubmatch(rest); m != nil {
+			ctxIo.SafeFprintf(sb, tpl.HTMLLink,
+				html.EscapeString(m[1]), html.EscapeString(m[1]))
+			return i + len(m[0]), true
+		}
+		if strings.HasPrefix(rest, marker.CommentOpen) {
+			if end := strings.Index(rest, marker.CommentClose); end >= 0 {
+				end += len(marker.CommentClose)
+				sb.WriteString(rest[:end])
+				return i + end, true
+			}
+		}
+		if tag, n := inlineTag(rest); n > 0 {
+			sb.WriteString(tag)
+			return i + n, true
+		}
+	case '&':
+		if m := regex.RenderEntity.FindString(rest); m != "" {
+			sb.WriteString(m)
+			return i + len(m), true
+		}
+	case 'h':
+		if i > 0 && wordByte(s[i-1]) {
+			return i, false
+		}
+		if m := regex.RenderBareURL.FindString(rest); m != "" {
+			ctxIo.SafeFprintf(sb, tpl.HTMLLink,
+				html.EscapeString(m), html.EscapeString(m))
+			return i + len(m), true
+		}
+	}
+	return i, false
+}
+
+// wordByte reports whether b is an ASCII letter or digit.
+//
+// Parameters:
+//   - b: byte to test
+//
+// Returns:
+//   - bool: true for [A-Za-z0-9]
+func wordByte(b byte) bool {
+	return b >= 'a' && b <= 'z' || b >= 'A' && b <= 'Z' ||
+		b >= '0' && b <= '9'
+}
+
+// runLen counts how many times s[i] repeats from i.
+//
+// Parameters:
+//   - s: source
+//   - i: start of the run
+//
+// Returns:
+//   - int: run length (at least 1)
+func runLen(s string, i int) int {
+	n := 1
+	for i+n < len(s) && s[i+n] == s[i] {
+		n++
+	}
+	return n
+}
+
+// codeSpan renders a code span opened by the backtick run
+// at s[i]. Without a closing run of the same length the
+// backticks are literal.
+//
+// Parameters:
+//   - s: inline source
+//   - i: position of the opening run
+//   - sb: output
+//
+// Returns:
+//   - int: position after the span (or after the run)
+func codeSpan(s string, i int, sb *strings.Builder) int {
+	n := runLen(s, i)
+	for j := i + n; j < len(s); {
+		k := strings.IndexByte(s[j:], '`')
+		if k < 0 {
+			break
+		}
+		j += k
+		m := runLen(s, j)
+		if m != n {
+			j += m
+			continue
+		}
+		code := strings.ReplaceAll(s[i+n:j], token.NewlineLF, token.Space)
+		if len(code) > 2 && code[0] == ' ' && code[len(code)-1] == ' ' &&
+			strings.TrimSpace(code) != "" {
+			code = code[1 : len(code)-1]
+		}
+		ctxIo.SafeFprintf(sb, tpl.HTMLCodeSpan, html.EscapeString(code))
+		return j + n
+	}
+	sb.WriteString(s[i : i+n])
+	return i + n
+}
+
+// emphasis renders **strong**, *em*, __strong__, _em_, or
+// ~~strikethrough~~ opened at s[i]. Underscores only count
+// at word boundaries, so snake_case stays intact. A run
+// that opens nothing is copied literally as a whole.
+//
+// Parameters:
+//   - s: inline source
+//   - i: position of the delimiter run
+//   - sb: output
+//
+// Returns:
+//   - int: position after the construct or the run
+func emphasis(s string, i int, sb *strings.Builder) int {
+	c := s[i]
+	n := runLen(s, i)
+	literal := func() int {
+		sb.WriteString(s[i : i+n])
+		return i + n
+	}
+	if c == '_' && i > 0 && wordByte(s[i-1]) ||
+		i+n >= len(s) || s[i+n] == ' ' || s[i+n] == token.NewlineLF[0] {
+		return literal()
+	}
+
+	type form struct {
+		width int
+		tmpl  string
+	}
+	var forms []form
+	switch {
+	case c == '~' && n == 2:
+		forms = []form{{2, tpl.HTMLDel}}
+	case c == '~':
+		return literal()
+	case n >= 2:
+		forms = []form{{2, tpl.HTMLStrong}, {1, tpl.HTMLEm}}
+	default:
+		forms = []form{{1, tpl.HTMLEm}}
+	}
+
+	for _, f := range forms {
+		if end := closer(s, i+f.width, c, f.width); end >= 0 {
+			ctxIo.SafeFprintf(sb, f.tmpl, inline(s[i+f.width:end]))
+			return end + f.width
+		}
+	}
+	return literal()
+}
+
+// closer finds the delimiter run that closes emphasis: a
+// run of exactly width delimiters (or of at least width
+// for width 2) not preceded by whitespace. Code spans are
+// skipped.
+//
+// Parameters:
+//   - s: inline source
+//   - from: position after the opening run
+//   - c: delimiter byte
+//   - width: delimiter run width to close
+//
+// Returns:
+//   - int: position of the closing run, or -1
+func closer(s string, from int, c byte, width int) int {
+	for j := from; j < len(s); {
+		switch s[j] {
+		case '`':
+			j += runLen(s, j)
+			if k := strings.IndexByte(s[j:], '`'); k >= 0 {
+				j += k + runLen(s, j+k)
+			}
+			continue
+		case c:
+		default:
+			j++
+			continue
+		}
+		m := runLen(s, j)
+		fits := m == width || width == 2 && m > width
+		if fits && j > from && s[j-1] != ' ' && s[j-1] != token.NewlineLF[0] &&
+			(c != '_' || j+m >= len(s) || !wordByte(s[j+m])) {
+			return j + m - width
+		}
+		j += m
+	}
+	return -1
+}
+
+// link renders a link or image opened by the '[' at s[i].
+//
+// Parameters:
+//   - s: inline source
+//   - i: position of '['
+//   - image: true for an image (the '!' is at i-1)
+//   - sb: output
+//
+// Returns:
+//   - int: position after the construct
+//   - bool: false when the brackets are not a link
+func link(
+	s string, i int, image bool, sb *strings.Builder,
+) (int, bool) {
+	end := labelEnd(s, i)
+	if end < 0 || end+1 >= len(s) || s[end+1] != '(' {
+		return i, false
+	}
+	dest, title, after, ok := target(s, end+2)
+	if !ok {
+		return i, false
+	}
+	label := s[i+1 : end]
+	href := html.EscapeString(rewrite(dest))
+	switch {
+	case image:
+		ctxIo.SafeFprintf(sb, tpl.HTMLImage,
+			href, html.EscapeString(plain(inline(label))))
+	case title != "":
+		ctxIo.SafeFprintf(sb, tpl.HTMLLinkTitle,
+			href, html.EscapeString(title), inline(label))
+	default:
+		ctxIo.SafeFprintf(sb, tpl.HTMLLink, href, inline(label))
+	}
+	return after, true
+}
+
+// labelEnd finds the ']' that matches the '[' at s[i],
+// honoring nesting, escapes, and code spans.
+//
+// Parameters:
+//   - s: inline source
+//   - i: position of '['
+//
+// Returns:
+//   - int: position of the matching ']', or -1
+func labelEnd(s string, i int) int {
+	depth := 0
+	for j := i; j < len(s); j++ {
+		switch s[j] {
+		case '\\':
+			j++
+		case '`':
+			n := runLen(s, j)
+			if k := strings.Index(s[j+n:], s[j:j+n]); k >= 0 {
+				j += n + k + n - 1
+			} else {
+				j += n - 1
+			}
+		case '[':
+			depth++
+		case ']':
+			depth--
+			if depth == 0 {
+				return j
+			}
+		}
+	}
+	return -1
+}
+
+// target parses a link destination and optional title
+// after the '(' of a link.
+//
+// Parameters:
+//   - s: inline source
+//   - i: position after '('
+//
+// Returns:
+//   - string: destination
+//   - string: title, or empty
+//   - int: position after the closing ')'
+//   - bool: false when the text is not a link target
+func target(s string, i int) (string, string, int, bool) {
+	skip := func() {
+		for i < len(s) && (s[i] == ' ' || s[i] == token.NewlineLF[0]) {
+			i++
+		}
+	}
+	skip()
+
+	dest := ""
+	if i < len(s) && s[i] == '<' {
+		end := strings.IndexAny(s[i+1:], marker.AngleGT+token.NewlineLF)
+		if end < 0 || s[i+1+end] != '>' {
+			return "", "", i, false
+		}
+		dest = s[i+1 : i+1+end]
+		i += end + 2
+	} else {
+		start, depth := i, 0
+	scan:
+		for ; i < len(s); i++ {
+			switch s[i] {
+			case '\\':
+				i++
+			case '(':
+				depth++
+			case ')':
+				if depth == 0 {
+					break scan
+				}
+				depth--
+			case ' ', token.NewlineLF[0]:
+				break scan
+			}
+		}
+		dest = s[start:min(i, len(s))]
+	}
+	skip()
+
+	title := ""
+	if i < len(s) && (s[i] == '"' || s[i] == '\'') {
+		end := strings.IndexByte(s[i+1:], s[i])
+		if end < 0 {
+			return "", "", i, false
+		}
+		title = s[i+1 : i+1+end]
+		i += end + 2
+		skip()
+	}
+	if i >= len(s) || s[i] != ')' {
+		return "", "", i, false
+	}
+	return dest, title, i + 1, true
+}
+
+// rewrite points a relative link at a Markdown page to the
+// rendered HTML page, and a link to a directory at its
+// index page. Absolute URLs and fragments are unchanged,
+// except that a script or data URL is replaced by
+// [cfgSite.BlockedHref].
+//
+// Parameters:
+//   - dest: link destination
+//
+// Returns:
+//   - string: the destination within the rendered site
+func rewrite(dest string) string {
+	if unsafeScheme(dest) {
+		return cfgSite.BlockedHref
+	}
+	if dest == "" || strings.HasPrefix(dest, token.Hash) ||
+		strings.HasPrefix(dest, token.Slash) {
+		return dest
+	}
+	if u, parseErr := url.Parse(dest); parseErr != nil || u.Scheme != "" {
+		return dest
+	}
+	path, frag, hasFrag := strings.Cut(dest, token.Hash)
+	switch {
+	case strings.HasSuffix(path, cfgFile.ExtMarkdown):
+		path = strings.TrimSuffix(path, cfgFile.ExtMarkdown) +
+			cfgFile.ExtHTML
+	case strings.HasSuffix(path, token.Slash):
+		path += cfgSite.IndexHTML
+	default:
+		return dest
+	}
+	if hasFrag {
+		return path + token.Hash + frag
+	}
+	return path
+}
+
+// unsafeScheme reports whether a destination uses one of
+// [cfgSite.UnsafeSchemes]. Browsers ignore case and skip
+// whitespace and control characters inside a scheme, so
+// those are dropped before comparing.
+//
+// Parameters:
+//   - dest: link destination
+//
+// Returns:
+//   - bool: true when the destination must not be emitted
+func unsafeScheme(dest string) bool {
+	scheme := squash(dest)
+	for _, prefix := range cfgSite.UnsafeSchemes {
+		if strings.HasPrefix(scheme, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// squash folds s and drops whitespace and control
+// characters, which browsers skip inside a URL scheme.
+//
+// Parameters:
+//   - s: text to normalize
+//
+// Returns:
+//   - string: the folded text without spaces or controls
+func squash(s string) string {
+	return i18n.Fold(strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) || unicode.IsControl(r) {
+			return -1
+		}
+		return r
+	}, s))
+}
@@ -0,0 +1,177 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package markdown
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/ActiveMemory/ctx/internal/assets/tpl"
+	"github.com/ActiveMemory/ctx/internal/config/regex"
+	cfgSite "github.com/ActiveMemory/ctx/internal/config/site"
+	"github.com/ActiveMemory/ctx/internal/config/token"
+	ctxIo "github.com/ActiveMemory/ctx/internal/io"
+)
+
+// listKind returns the byte that identifies a list: the
+// bullet character, or the delimiter after an ordered
+// item's number. Items with different kinds belong to
+// different lists.
+//
+// Parameters:
+//   - mark: the item marker ("-", "1.", ...)
+//
+// Returns:
+//   - byte: the list kind
+func listKind(mark string) byte {
+	return mark[len(mark)-1]
+}
+
+// ordered reports whether a marker is an ordered one.
+//
+// Parameters:
+//   - mark: the item marker
+//
+// Returns:
+//   - bool: true for "1." or "1)" style markers
+func ordered(mark string) bool {
+	return mark[0] >= '0' && mark[0] <= '9'
+}
+
+// list renders a bullet or ordered list. The list is loose
+// (paragraphs wrapped in <p>) when a blank line separates
+// two items or two blocks inside an item.
+//
+// Parameters:
+//   - lines: source lines
+//   - start: index of the first item
+//   - sb: output
+//
+// Returns:
+//   - int: index of the first line after the list
+func (r *renderer) list(
+	lines []string, start int, sb *strings.Builder,
+) int {
+	first := regex.RenderListItem.FindStringSubmatch(lines[start])
+	kind := listKind(first[2])
+
+	var items []listItem
+	loose := false
+	i := start
+	for i < len(lines) {
+		m := regex.RenderListItem.FindStringSubmatch(lines[i])
+		if m == nil || listKind(m[2]) != kind ||
+			regex.RenderRule.MatchString(lines[i]) {
+			break
+		}
+		item, next, gapInside := collectItem(lines, i, m)
+		loose = loose || gapInside
+
+		// Blank lines between this item and a sibling make
+		// the whole list loose; before anything else they
+		// just end the list.
+		trailing := 0
+		for len(item.lines) > 1 && blank(item.lines[len(item.lines)-1]) {
+			item.lines = item.lines[:len(item.lines)-1]
+			trailing++
+		}
+		items = append(items, item)
+		i = next
+		if i < len(lines) && trailing > 0 {
+			if sm := regex.RenderListItem.FindStringSubmatch(
+				lines[i],
+			); sm != nil && listKind(sm[2]) == kind {
+				loose = true
+			}
+		}
+	}
+
+	var out strings.Builder
+	for _, item := range items {
+		inner := r.blocks(item.lines, !loose)
+		switch item.task {
+		case ' ':
+			inner = tpl.HTMLTaskOpen + inner
+		case 'x', 'X':
+			inner = tpl.HTMLTaskDone + inner
+		}
+		ctxIo.SafeFprintf(&out, tpl.HTMLItem,
+			strings.TrimSuffix(inner, token.NewlineLF))
+	}
+
+	switch {
+	case !ordered(first[2]):
+		ctxIo.SafeFprintf(sb, tpl.HTMLList, out.String())
+	default:
+		from, parseErr := strconv.Atoi(first[2][:len(first[2])-1])
+		if parseErr != nil || from == 1 {
+			ctxIo.SafeFprintf(sb, tpl.HTMLOrdered, out.String())
+			break
+		}
+		ctxIo.SafeFprintf(sb, tpl.HTMLOrderedFrom, from, out.String())
+	}
+	return i
+}
+
+// collectItem gathers the lines of one list item: its
+// first line, every following line indented to the item's
+// content column, blank lines, and lazy paragraph
+// continuations.
+//
+// Parameters:
+//   - lines: source lines
+//   - start: index of the item's marker line
+//   - m: [regex.RenderListItem] submatches of that line
+//
+// Returns:
+//   - listItem: the item, dedented to its content column
+//   - int: index of the first line after the item
+//   - bool: true when a blank line separates two blocks
+//     inside the item
+func collectItem(
+	lines []string, start int, m []string,
+) (listItem, int, bool) {
+	pad, mark, gap, text := m[1], m[2], m[3], m[regex.RenderListItemText]
+	width := len(gap)
+	switch {
+	case text == "":
+		width = 1
+	case width > cfgSite.MaxListIndent:
+		// A wide gap starts indented code inside the item.
+		text = strings.Repeat(token.Space, width-1) + text
+		width = 1
+	}
+	column := len(pad) + len(mark) + width
+
+	item := listItem{}
+	if tm := regex.RenderTask.FindStringSubmatch(text); tm != nil {
+		item.task = tm[1][0]
+		text = text[len(tm[0]):]
+	}
+	item.lines = []string{text}
+
+	gapInside := false
+	i := start + 1
+	for ; i < len(lines); i++ {
+		line := lines[i]
+		prev := item.lines[len(item.lines)-1]
+		switch {
+		case blank(line):
+			item.lines = append(item.lines, "")
+		case indent(line) >= column:
+			if blank(prev) && len(item.lines) > 1 {
+				gapInside = true
+			}
+			item.lines = append(item.lines, dedent(line, column))
+		case !blank(prev) && !startsBlock(line):
+			item.lines = append(item.lines, strings.TrimLeft(line, token.Space))
+		default:
+			return item, i, gapInside
+		}
+	}
+	return item, i, gapInside
+}
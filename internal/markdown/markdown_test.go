@@ -0,0 +1,267 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package markdown
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ActiveMemory/ctx/internal/i18n"
+)
+
+func TestRender_Blocks(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		want []string
+	}{
+		{
+			name: "atx heading",
+			src:  "## Hello World",
+			want: []string{`<h2 id="hello-world">Hello World</h2>`},
+		},
+		{
+			name: "setext heading",
+			src:  "Title\n=====",
+			want: []string{`<h1 id="title">Title</h1>`},
+		},
+		{
+			name: "duplicate anchors",
+			src:  "# Intro\n\n## Intro",
+			want: []string{`id="intro"`, `id="intro-1"`},
+		},
+		{
+			name: "bullet list with nesting",
+			src:  "- one\n- two\n  - nested",
+			want: []string{
+				"<li>one</li>",
+				"<li>two\n<ul>\n<li>nested</li>\n</ul></li>",
+			},
+		},
+		{
+			name: "task list",
+			src:  "- [x] done\n- [ ] open",
+			want: []string{
+				`<input type="checkbox" checked disabled /> done`,
+				`<input type="checkbox" disabled /> open`,
+			},
+		},
+		{
+			name: "ordered list start",
+			src:  "3. three\n4. four",
+			want: []string{`<ol start="3">`, "<li>four</li>"},
+		},
+		{
+			name: "aligned table with escaped pipe",
+			src:  "| A | B |\n|:--|--:|\n| 1 | a \\| b |",
+			want: []string{
+				`<th style="text-align: left">A</th>`,
+				`<td style="text-align: right">a | b</td>`,
+			},
+		},
+		{
+			name: "admonition",
+			src:  "!!! note \"Heads up\"\n    Inside.",
+			want: []string{
+				`<div class="admonition note">`,
+				`<p class="admonition-title">Heads up</p>`,
+				"<p>Inside.</p>",
+			},
+		},
+		{
+			name: "collapsible admonition",
+			src:  "??? tip\n    Folded.",
+			want: []string{
+				`<details class="admonition tip">`,
+				"<summary>Tip</summary>",
+			},
+		},
+		{
+			name: "fenced code",
+			src:  "```go\nx := 1 < 2\n```",
+			want: []string{
+				`<pre><code class="language-go">x := 1 &lt; 2`,
+			},
+		},
+		{
+			name: "blockquote",
+			src:  "> quoted",
+			want: []string{"<blockquote>\n<p>quoted</p>\n</blockquote>"},
+		}, {
+			name: "raw pre block kept",
+			src:  "<pre>\na  b\n\nc\n</pre>",
+			want: []string{"<pre>\na  b\n\nc\n</pre>"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Render(tt.src).HTML
+			for _, w := range tt.want {
+				if !strings.Contains(got, w) {
+					t.Errorf("HTML missing %q:\n%s", w, got)
+				}
+			}
+		})
+	}
+}
+
+func TestRender_Inline(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		want string
+	}{
+		{"strong", "**bold**", "<strong>bold</strong>"},
+		{"em", "*em*", "<em>em</em>"},
+		{"code span", "`a < b`", "<code>a &lt; b</code>"},
+		{"strikethrough", "~~gone~~", "<del>gone</del>"},
+		{
+			"md link rewritten",
+			"[x](other.md#part)",
+			`<a href="other.html#part">x</a>`,
+		},
+		{
+			"dir link rewritten",
+			"[x](sub/)",
+			`<a href="sub/index.html">x</a>`,
+		},
+		{
+			"external link kept",
+			"[x](https://ctx.ist/a.md)",
+			`<a href="https://ctx.ist/a.md">x</a>`,
+		},
+		{
+			"bare url",
+			"see https://ctx.ist now",
+			`<a href="https://ctx.ist">https://ctx.ist</a>`,
+		},
+		{
+			"unknown tag escaped",
+			"<system-reminder>x</system-reminder>",
+			"&lt;system-reminder&gt;x&lt;/system-reminder&gt;",
+		},
+		{"unmatched emphasis literal", "2 * 3", "<p>2 * 3</p>"},
+		{
+			"javascript link blocked",
+			"[x](javascript:alert(1))",
+			`<a href="#">x</a>`,
+		},
+		{
+			"obfuscated scheme blocked",
+			"[x](<JavaScript\t:alert(1)>)",
+			`<a href="#">x</a>`,
+		},
+		{
+			"vbscript link blocked",
+			"[x](vbscript:msgbox)",
+			`<a href="#">x</a>`,
+		},
+		{
+			"data image blocked",
+			"![x](data:text/html;base64,PHNjcmlwdD4=)",
+			`<img src="#" alt="x" />`,
+		},
+		{
+			"inline tag with handler escaped",
+			`<span onclick="alert(1)">x</span>`,
+			"&lt;span onclick=",
+		},
+		{
+			"inline link tag with script url escaped",
+			`<a href="javascript:alert(1)">x</a>`,
+			"&lt;a href=",
+		},
+		{"safe inline tag kept", "<kbd>Ctrl</kbd>", "<kbd>Ctrl</kbd>"},
+		{
+			"inline tag with slash-separated handler escaped",
+			`<img src="x"/onerror=alert(1)>`,
+			"&lt;img src=",
+		},
+		{
+			"allowed attributes rebuilt",
+			`<abbr title='a "b"'>x</abbr>`,
+			`<abbr title="a &#34;b&#34;">x</abbr>`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Render(tt.src).HTML
+			if !strings.Contains(got, tt.want) {
+				t.Errorf("Render(%q) = %q, want it to contain %q",
+					tt.src, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRender_EscapesScriptBlocks(t *testing.T) {
+	for _, src := range []string{
+		"<script>alert(1)</script>",
+		"<SCRIPT src=x>\n\nalert(1)\n</SCRIPT>",
+		"<style>body{display:none}</style>",
+		"<textarea>\n</div><img src=x onerror=alert(1)>\n</textarea>",
+		"<div>\n<img src=x onerror=alert(1)>\n</div>",
+		"<pre>\n<script>alert(1)</script>\n</pre>",
+		`<img src="x"/onerror=alert(1)>`,
+		"<div>\n<img src=\"x\"/onerror=alert(1)>\n</div>",
+		"<div>\n<svg/onload=alert(1)>\n</div>",
+		"<pre>\n<svg/onload=alert(1)>\n</pre>",
+		"<div>\n<img src=x\nonerror=alert(1)>\n</div>",
+		"<div><a href=\"java&#x09;script:alert(1)\">x</a></div>",
+	} {
+		got := i18n.Fold(Render(src).HTML)
+		for _, bad := range []string{
+			"<script", "<style", "<textarea", "<img", "<svg",
+			"javascript:",
+		} {
+			if strings.Contains(got, bad) {
+				t.Errorf("Render(%q) emitted %s:\n%s", src, bad, got)
+			}
+		}
+	}
+}
+
+func TestRender_Title(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		want string
+	}{
+		{"frontmatter", "---\ntitle: From Meta\n---\n# Heading", "From Meta"},
+		{"first h1", "intro\n\n# The Heading", "The Heading"},
+		{"none", "just text", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Render(tt.src).Title; got != tt.want {
+				t.Errorf("Title = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRender_FrontmatterStripped(t *testing.T) {
+	page := Render("---\ntitle: T\ndate: 2026-01-01\n---\nbody")
+	if strings.Contains(page.HTML, "date:") {
+		t.Errorf("frontmatter leaked into HTML: %q", page.HTML)
+	}
+	if strings.Contains(page.Text, "date:") {
+		t.Errorf("frontmatter leaked into text: %q", page.Text)
+	}
+}
+
+func TestRender_Text(t *testing.T) {
+	page := Render("# Head\n\nSome **bold** words.")
+	if strings.Contains(page.Text, "<") {
+		t.Errorf("Text contains markup: %q", page.Text)
+	}
+	for _, w := range []string{"Head", "bold", "words."} {
+		if !strings.Contains(page.Text, w) {
+			t.Errorf("Text missing %q: %q", w, page.Text)
+		}
+	}
+}
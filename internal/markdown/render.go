@@ -0,0 +1,44 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package markdown
+
+import (
+	"strings"
+
+	"github.com/ActiveMemory/ctx/internal/config/token"
+	"github.com/ActiveMemory/ctx/internal/entity"
+)
+
+// Render converts one Markdown page to HTML.
+//
+// YAML frontmatter is stripped; its title, when present,
+// names the page. Otherwise the first level-1 heading does.
+//
+// Parameters:
+//   - src: Markdown source
+//
+// Returns:
+//   - entity.RenderedPage: body HTML, title, and plain text
+func Render(src string) entity.RenderedPage {
+	src = strings.ReplaceAll(src, token.NewlineCRLF, token.NewlineLF)
+	lines := strings.Split(src, token.NewlineLF)
+	for i, line := range lines {
+		lines[i] = expandTabs(line)
+	}
+	title, body := stripFrontmatter(lines)
+
+	r := &renderer{anchors: make(map[string]int)}
+	out := r.blocks(body, false)
+	if title == "" {
+		title = r.title
+	}
+	return entity.RenderedPage{
+		Title: title,
+		HTML:  out,
+		Text:  pageText(out),
+	}
+}
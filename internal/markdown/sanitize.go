@@ -0,0 +1,156 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package markdown
+
+import (
+	"strings"
+
+	"golang.org/x/net/html"
+
+	"github.com/ActiveMemory/ctx/internal/assets/tpl"
+	"github.com/ActiveMemory/ctx/internal/config/marker"
+	cfgSite "github.com/ActiveMemory/ctx/internal/config/site"
+	ctxIo "github.com/ActiveMemory/ctx/internal/io"
+)
+
+// inlineTag reads the HTML tag at the start of s with an
+// HTML tokenizer. A tag in [cfgSite.InlineTags] whose
+// attributes all pass [rebuild] is re-emitted from its
+// parsed form; anything else is left for the caller to
+// escape.
+//
+// Parameters:
+//   - s: text starting with '<'
+//
+// Returns:
+//   - string: the rebuilt tag
+//   - int: bytes of s the tag spans; 0 when it is not
+//     allowed
+func inlineTag(s string) (string, int) {
+	z := html.NewTokenizer(strings.NewReader(s))
+	if !isTag(z.Next()) {
+		return "", 0
+	}
+	n := len(z.Raw())
+	t := z.Token()
+	if !cfgSite.InlineTags[t.Data] {
+		return "", 0
+	}
+	out, ok := rebuild(t)
+	if !ok {
+		return "", 0
+	}
+	return out, n
+}
+
+// sanitize tokenizes a whole raw HTML block and escapes
+// everything except whitelisted tags, re-emitted by
+// [rebuild], and closed comments. Text keeps its entities
+// but has every '<' escaped, so nothing the tokenizer did
+// not accept as a tag can become one in the browser.
+//
+// Parameters:
+//   - src: the block source, lines joined
+//
+// Returns:
+//   - string: the sanitized block
+func sanitize(src string) string {
+	var sb strings.Builder
+	z := html.NewTokenizer(strings.NewReader(src))
+	done := 0
+	for tt := z.Next(); tt != html.ErrorToken; tt = z.Next() {
+		raw := string(z.Raw())
+		done += len(raw)
+		switch {
+		case isTag(tt):
+			if out, ok := blockTag(z.Token()); ok {
+				sb.WriteString(out)
+				continue
+			}
+			sb.WriteString(html.EscapeString(raw))
+		case tt == html.CommentToken &&
+			strings.HasPrefix(raw, marker.CommentOpen) &&
+			strings.HasSuffix(raw, marker.CommentClose):
+			sb.WriteString(raw)
+		default:
+			sb.WriteString(
+				strings.ReplaceAll(raw, marker.AngleLT, marker.EntityLT),
+			)
+		}
+	}
+	sb.WriteString(html.EscapeString(src[done:]))
+	return sb.String()
+}
+
+// blockTag rebuilds a tag found in a raw HTML block when
+// it is a block, raw block, or inline whitelisted tag.
+//
+// Parameters:
+//   - t: the parsed tag
+//
+// Returns:
+//   - string: the rebuilt tag
+//   - bool: false when the tag must be escaped
+func blockTag(t html.Token) (string, bool) {
+	if !cfgSite.BlockTags[t.Data] && !cfgSite.RawBlockTags[t.Data] &&
+		!cfgSite.InlineTags[t.Data] {
+		return "", false
+	}
+	return rebuild(t)
+}
+
+// rebuild re-emits a parsed tag when every attribute is in
+// [cfgSite.Attrs] and no [cfgSite.URLAttrs] value uses an
+// unsafe scheme. Values are re-escaped, so the output is
+// exactly what was checked, whatever quoting or separators
+// the source used.
+//
+// Parameters:
+//   - t: the parsed tag
+//
+// Returns:
+//   - string: the rebuilt tag
+//   - bool: false when an attribute is not allowed
+func rebuild(t html.Token) (string, bool) {
+	var sb strings.Builder
+	if t.Type == html.EndTagToken {
+		ctxIo.SafeFprintf(&sb, tpl.HTMLTagClose, t.Data)
+		return sb.String(), true
+	}
+	ctxIo.SafeFprintf(&sb, tpl.HTMLTagOpen, t.Data)
+	for _, a := range t.Attr {
+		if a.Namespace != "" || !cfgSite.Attrs[a.Key] ||
+			(cfgSite.URLAttrs[a.Key] && unsafeScheme(a.Val)) {
+			return "", false
+		}
+		if a.Val == "" {
+			ctxIo.SafeFprintf(&sb, tpl.HTMLTagBareAttr, a.Key)
+			continue
+		}
+		ctxIo.SafeFprintf(&sb, tpl.HTMLTagAttr,
+			a.Key, html.EscapeString(a.Val))
+	}
+	if t.Type == html.SelfClosingTagToken {
+		sb.WriteString(tpl.HTMLTagSelfClose)
+	} else {
+		sb.WriteString(tpl.HTMLTagEnd)
+	}
+	return sb.String(), true
+}
+
+// isTag reports whether a token type is a start, end, or
+// self-closing tag.
+//
+// Parameters:
+//   - tt: token type
+//
+// Returns:
+//   - bool: true for tag tokens
+func isTag(tt html.TokenType) bool {
+	return tt == html.StartTagToken || tt == html.EndTagToken ||
+		tt == html.SelfClosingTagToken
+}
@@ -0,0 +1,151 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package markdown
+
+import (
+	"strings"
+
+	"github.com/ActiveMemory/ctx/internal/assets/tpl"
+	"github.com/ActiveMemory/ctx/internal/config/marker"
+	"github.com/ActiveMemory/ctx/internal/config/regex"
+	cfgSite "github.com/ActiveMemory/ctx/internal/config/site"
+	"github.com/ActiveMemory/ctx/internal/config/token"
+	ctxIo "github.com/ActiveMemory/ctx/internal/io"
+)
+
+// isTable reports whether a GFM table starts at a line: a
+// row with pipes followed by a delimiter row.
+//
+// Parameters:
+//   - lines: source lines
+//   - i: index of the candidate header row
+//
+// Returns:
+//   - bool: true when lines[i] heads a table
+func isTable(lines []string, i int) bool {
+	return i+1 < len(lines) &&
+		strings.Contains(lines[i], marker.TablePipe) &&
+		strings.Contains(lines[i+1], marker.TablePipe) &&
+		regex.RenderTableDelim.MatchString(lines[i+1])
+}
+
+// table renders a GFM table. Body rows run until a blank
+// line or a line without a pipe; short rows are padded and
+// long rows cut to the header's width.
+//
+// Parameters:
+//   - lines: source lines
+//   - start: index of the header row
+//   - sb: output
+//
+// Returns:
+//   - int: index of the first line after the table
+func (r *renderer) table(
+	lines []string, start int, sb *strings.Builder,
+) int {
+	head := cells(lines[start])
+	var aligns []string
+	for _, d := range cells(lines[start+1]) {
+		left := strings.HasPrefix(d, token.Colon)
+		right := strings.HasSuffix(d, token.Colon)
+		switch {
+		case left && right:
+			aligns = append(aligns, cfgSite.AlignCenter)
+		case right:
+			aligns = append(aligns, cfgSite.AlignRight)
+		case left:
+			aligns = append(aligns, cfgSite.AlignLeft)
+		default:
+			aligns = append(aligns, "")
+		}
+	}
+
+	var headHTML, bodyHTML strings.Builder
+	writeRow(&headHTML, cfgSite.TagHead, head, aligns, len(head))
+	i := start + 2
+	for ; i < len(lines); i++ {
+		if blank(lines[i]) ||
+			!strings.Contains(lines[i], marker.TablePipe) {
+			break
+		}
+		writeRow(&bodyHTML, cfgSite.TagData, cells(lines[i]),
+			aligns, len(head))
+	}
+	ctxIo.SafeFprintf(sb, tpl.HTMLTable, headHTML.String(),
+		bodyHTML.String())
+	return i
+}
+
+// writeRow writes one table row.
+//
+// Parameters:
+//   - sb: output
+//   - tag: cell tag (th or td)
+//   - row: cell source texts
+//   - aligns: column alignments
+//   - width: number of columns
+func writeRow(
+	sb *strings.Builder, tag string, row, aligns []string, width int,
+) {
+	var cellsHTML strings.Builder
+	for c := 0; c < width; c++ {
+		text := ""
+		if c < len(row) {
+			text = row[c]
+		}
+		align := ""
+		if c < len(aligns) {
+			align = aligns[c]
+		}
+		if align == "" {
+			ctxIo.SafeFprintf(&cellsHTML, tpl.HTMLCell, tag, inline(text))
+			continue
+		}
+		ctxIo.SafeFprintf(&cellsHTML, tpl.HTMLCellAlign,
+			tag, align, inline(text))
+	}
+	ctxIo.SafeFprintf(sb, tpl.HTMLRow, cellsHTML.String())
+}
+
+// cells splits a table row at its unescaped pipes outside
+// code spans. Outer pipes are optional; `\|` becomes a
+// literal pipe.
+//
+// Parameters:
+//   - line: table row
+//
+// Returns:
+//   - []string: trimmed cell texts
+func cells(line string) []string {
+	line = strings.TrimSpace(line)
+	line = strings.TrimPrefix(line, marker.TablePipe)
+	if strings.HasSuffix(line, marker.TablePipe) &&
+		!strings.HasSuffix(line, marker.TablePipeEscaped) {
+		line = line[:len(line)-1]
+	}
+
+	var out []string
+	var cell strings.Builder
+	inCode := false
+	for i := 0; i < len(line); i++ {
+		c := line[i]
+		switch {
+		case c == '\\' && i+1 < len(line) && line[i+1] == '|':
+			cell.WriteByte('|')
+			i++
+			continue
+		case c == '`':
+			inCode = !inCode
+		case c == '|' && !inCode:
+			out = append(out, strings.TrimSpace(cell.String()))
+			cell.Reset()
+			continue
+		}
+		cell.WriteByte(c)
+	}
+	return append(out, strings.TrimSpace(cell.String()))
+}
@@ -0,0 +1,199 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package markdown
+
+import (
+	"fmt"
+	"html"
+	"strings"
+	"unicode"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/ActiveMemory/ctx/internal/config/regex"
+	cfgSite "github.com/ActiveMemory/ctx/internal/config/site"
+	"github.com/ActiveMemory/ctx/internal/config/token"
+	"github.com/ActiveMemory/ctx/internal/config/zensical"
+	"github.com/ActiveMemory/ctx/internal/i18n"
+)
+
+// expandTabs replaces the tabs in a line's leading
+// whitespace with spaces up to the next tab stop, so
+// indentation can be measured in spaces.
+//
+// Parameters:
+//   - line: source line
+//
+// Returns:
+//   - string: the line with leading tabs expanded
+func expandTabs(line string) string {
+	if !strings.Contains(line, token.Tab) {
+		return line
+	}
+	var sb strings.Builder
+	col := 0
+	for i := 0; i < len(line); i++ {
+		switch line[i] {
+		case token.Tab[0]:
+			n := cfgSite.IndentWidth - col%cfgSite.IndentWidth
+			sb.WriteString(strings.Repeat(token.Space, n))
+			col += n
+		case ' ':
+			sb.WriteByte(' ')
+			col++
+		default:
+			sb.WriteString(line[i:])
+			return sb.String()
+		}
+	}
+	return sb.String()
+}
+
+// stripFrontmatter removes a leading YAML frontmatter
+// block. An unterminated block is treated as content.
+//
+// Parameters:
+//   - lines: page lines
+//
+// Returns:
+//   - string: the frontmatter title, or empty
+//   - []string: the lines after the frontmatter
+func stripFrontmatter(lines []string) (string, []string) {
+	if len(lines) == 0 ||
+		strings.TrimSpace(lines[0]) != zensical.MkDocsFrontmatterDelim {
+		return "", lines
+	}
+	for i := 1; i < len(lines); i++ {
+		if strings.TrimSpace(lines[i]) != zensical.MkDocsFrontmatterDelim {
+			continue
+		}
+		var fm frontmatter
+		// Acceptable discard: malformed frontmatter still
+		// delimits the block; the page just has no title
+		// from it.
+		_ = yaml.Unmarshal(
+			[]byte(strings.Join(lines[1:i], token.NewlineLF)), &fm,
+		)
+		return strings.TrimSpace(fm.Title), lines[i+1:]
+	}
+	return "", lines
+}
+
+// blank reports whether a line is empty or whitespace.
+//
+// Parameters:
+//   - line: source line
+//
+// Returns:
+//   - bool: true for a blank line
+func blank(line string) bool {
+	return strings.TrimSpace(line) == ""
+}
+
+// indent counts a line's leading spaces.
+//
+// Parameters:
+//   - line: source line (tabs already expanded)
+//
+// Returns:
+//   - int: number of leading spaces
+func indent(line string) int {
+	return len(line) - len(strings.TrimLeft(line, token.Space))
+}
+
+// dedent removes up to n leading spaces.
+//
+// Parameters:
+//   - line: source line
+//   - n: spaces to remove
+//
+// Returns:
+//   - string: the dedented line
+func dedent(line string, n int) string {
+	return line[min(n, indent(line)):]
+}
+
+// plain strips the tags from rendered HTML and decodes
+// its entities.
+//
+// Parameters:
+//   - h: rendered HTML
+//
+// Returns:
+//   - string: the text, trimmed
+func plain(h string) string {
+	return strings.TrimSpace(
+		html.UnescapeString(regex.RenderTag.ReplaceAllString(h, "")),
+	)
+}
+
+// pageText extracts the searchable text of a rendered
+// page: tags become word breaks and runs of whitespace
+// collapse to one space.
+//
+// Parameters:
+//   - h: rendered page HTML
+//
+// Returns:
+//   - string: the page text
+func pageText(h string) string {
+	text := html.UnescapeString(
+		regex.RenderTag.ReplaceAllString(h, token.Space),
+	)
+	return strings.Join(strings.Fields(text), token.Space)
+}
+
+// anchor returns a unique heading id derived from the
+// heading text: case-folded letters and digits, with runs
+// of anything else reduced to one hyphen.
+//
+// Parameters:
+//   - inner: the heading's rendered HTML
+//
+// Returns:
+//   - string: an id not yet used on the page
+func (r *renderer) anchor(inner string) string {
+	var sb strings.Builder
+	gap := false
+	for _, c := range i18n.Fold(plain(inner)) {
+		if unicode.IsLetter(c) || unicode.IsDigit(c) {
+			if gap && sb.Len() > 0 {
+				sb.WriteString(token.Dash)
+			}
+			sb.WriteRune(c)
+			gap = false
+			continue
+		}
+		gap = true
+	}
+	base := sb.String()
+	if base == "" {
+		base = cfgSite.AnchorFallback
+	}
+	n := r.anchors[base]
+	r.anchors[base]++
+	if n == 0 {
+		return base
+	}
+	return fmt.Sprintf(cfgSite.AnchorDupFormat, base, n)
+}
+
+// capitalize upper-cases the first letter of s.
+//
+// Parameters:
+//   - s: a word
+//
+// Returns:
+//   - string: s with its first rune upper-cased
+func capitalize(s string) string {
+	runes := []rune(s)
+	if len(runes) == 0 {
+		return s
+	}
+	runes[0] = unicode.ToUpper(runes[0])
+	return string(runes)
+}
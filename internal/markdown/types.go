@@ -0,0 +1,32 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package markdown
+
+// renderer carries the per-page state of one [Render] call.
+type renderer struct {
+	// anchors counts the heading ids already used on the
+	// page.
+	anchors map[string]int
+	// title is the plain text of the first level-1 heading.
+	title string
+}
+
+// frontmatter holds the fields read from a page's YAML
+// frontmatter.
+type frontmatter struct {
+	Title string `yaml:"title"`
+}
+
+// listItem is one item of a list block.
+type listItem struct {
+	// lines are the item's content lines, dedented to the
+	// item's content column.
+	lines []string
+	// task is the checkbox state (' ' or 'x'), or 0 when the
+	// item is not a task.
+	task byte
+}
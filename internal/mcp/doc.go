@@ -22,7 +22,9 @@
 //	AI Tool <- stdout <- MCP Server <- ctx internals
 //
 // The server communicates via JSON-RPC 2.0 over
-// stdin/stdout.
+// stdin/stdout, or over the MCP Streamable HTTP transport
+// (POST plus an SSE event stream) when started with
+// `ctx mcp serve --http`.
 //
 // # Resources
 //
@@ -37,6 +39,14 @@
 //	ctx://context/glossary      -> GLOSSARY.md
 //	ctx://context/agent         -> All files assembled
 //
+// Resource templates (resources/templates/list) address a
+// single item:
+//
+//	ctx://decision/{timestamp}  -> One DECISIONS entry
+//	ctx://learning/{timestamp}  -> One LEARNINGS entry
+//	ctx://theme/{kind}/{slug}   -> One theme file
+//	ctx://journal/{session-id}  -> One journal session
+//
 // # Tools
 //
 // Tools expose ctx commands as callable operations:
@@ -68,6 +78,9 @@
 //	server := mcp.New(contextDir, version)
 //	server.Serve()  // blocks on stdin/stdout
 //
+//	httpSrv := mcp.NewHTTP(contextDir, version, token, idle)
+//	httpSrv.Serve(lis)  // many clients, until Shutdown
+//
 // # Design Invariants
 //
 // This implementation preserves all ctx invariants:
@@ -39,6 +39,8 @@
 //     transcripts via [journal/parser].
 //   - **`ctx_search`**:         text search across context
 //     files via [internal/entry].
+//   - **`ctx_hub_search`**:     full-text search across
+//     ctx Hub entries via [coreSearch] ([hub.go]).
 //   - **`ctx_remind`**:         read/dismiss reminders via
 //     [remindStore].
 //   - **`ctx_session_*`**:      `session_start`,
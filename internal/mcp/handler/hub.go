@@ -0,0 +1,68 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package handler
+
+import (
+	"strings"
+	"time"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	coreSearch "github.com/ActiveMemory/ctx/internal/cli/connection/core/search"
+	"github.com/ActiveMemory/ctx/internal/config/embed/text"
+	cfgTime "github.com/ActiveMemory/ctx/internal/config/time"
+	"github.com/ActiveMemory/ctx/internal/entity"
+	"github.com/ActiveMemory/ctx/internal/io"
+)
+
+// HubSearch searches every entry on the connected ctx Hub.
+// The search runs on the hub, so the agent can query
+// org-wide knowledge without syncing it first.
+//
+// Parameters:
+//   - d: runtime dependencies (unused, kept for signature
+//     uniformity)
+//   - query: words that must all appear in an entry
+//   - opts: type, origin, date range, and limit filters
+//
+// Returns:
+//   - string: matching entries, newest first
+//   - error: malformed date, missing connection, or RPC
+//     failure
+func HubSearch(
+	_ *entity.MCPDeps, query string, opts coreSearch.Opts,
+) (string, error) {
+	req, reqErr := coreSearch.Request(query, opts)
+	if reqErr != nil {
+		return "", reqErr
+	}
+	resp, searchErr := coreSearch.Query(req)
+	if searchErr != nil {
+		return "", searchErr
+	}
+	if len(resp.Entries) == 0 {
+		return desc.Text(text.DescKeyMCPHubSearchNone), nil
+	}
+
+	var sb strings.Builder
+	for i := range resp.Entries {
+		e := &resp.Entries[i]
+		io.SafeFprintf(&sb,
+			desc.Text(text.DescKeyMCPHubSearchHit),
+			time.Unix(e.Timestamp, 0).UTC().Format(
+				cfgTime.DateFormat,
+			),
+			e.Type, e.Origin, e.ID, e.Content,
+		)
+	}
+	if resp.More {
+		io.SafeFprintf(&sb,
+			desc.Text(text.DescKeyMCPHubSearchMore),
+			len(resp.Entries),
+		)
+	}
+	return sb.String(), nil
+}
@@ -41,6 +41,7 @@ func SessionStartHooks(_ *entity.MCPDeps) (string, error) {
 	input := &entity.TriggerInput{
 		TriggerType: cfgTrigger.SessionStart,
 		Parameters:  map[string]any{},
+		Session:     entity.TriggerSession{Tool: rc.Tool()},
 		Timestamp:   time.Now().UTC().Format(time.RFC3339),
 	}
 
@@ -91,6 +92,7 @@ func SessionEndHooks(_ *entity.MCPDeps, summary string) (string, error) {
 	input := &entity.TriggerInput{
 		TriggerType: cfgTrigger.SessionEnd,
 		Parameters:  params,
+		Session:     entity.TriggerSession{Tool: rc.Tool()},
 		Timestamp:   time.Now().UTC().Format(time.RFC3339),
 	}
 
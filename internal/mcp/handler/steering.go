@@ -7,11 +7,9 @@
 package handler
 
 import (
-	"bufio"
 	"errors"
 	"fmt"
 	"os"
-	"path/filepath"
 	"strings"
 
 	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
@@ -19,10 +17,10 @@ import (
 	cfgWarn "github.com/ActiveMemory/ctx/internal/config/warn"
 	"github.com/ActiveMemory/ctx/internal/entity"
 	errMcp "github.com/ActiveMemory/ctx/internal/err/mcp"
-	"github.com/ActiveMemory/ctx/internal/i18n"
 	ctxIo "github.com/ActiveMemory/ctx/internal/io"
 	"github.com/ActiveMemory/ctx/internal/log/warn"
 	"github.com/ActiveMemory/ctx/internal/rc"
+	"github.com/ActiveMemory/ctx/internal/search"
 	"github.com/ActiveMemory/ctx/internal/steering"
 )
 
@@ -80,59 +78,57 @@ func SteeringGet(_ *entity.MCPDeps, prompt string) (string, error) {
 	return sb.String(), nil
 }
 
-// Search searches across all .context/ files for the given query.
-// Returns matching excerpts with file paths and line numbers.
+// Search ranks context entries, journal sessions, and
+// knowledge-base pages against a query using the search
+// index under .context/state/, refreshing it first for
+// files that changed since the last search.
 //
 // Parameters:
 //   - d: runtime dependencies carrying the context directory
-//   - query: search text to find in context files
+//   - query: query text (words, quoted phrases, type: and
+//     since: filters)
+//   - limit: maximum matches to return
 //
 // Returns:
-//   - string: formatted search results with paths and line numbers
-//   - error: directory read error
-func Search(d *entity.MCPDeps, query string) (string, error) {
+//   - string: ranked matches with paths, line numbers, types,
+//     scores, and snippets
+//   - entity.MCPSearchResult: the same matches as structured data
+//   - error: index read/write error or malformed query
+func Search(
+	d *entity.MCPDeps, query string, limit int,
+) (string, entity.MCPSearchResult, error) {
+	result := entity.MCPSearchResult{
+		Query: query, Hits: []entity.SearchHit{},
+	}
 	if query == "" {
-		return "", errMcp.QueryRequired()
+		return "", result, errMcp.QueryRequired()
 	}
 
-	entries, readErr := os.ReadDir(d.ContextDir)
-	if readErr != nil {
-		return "", errMcp.SearchRead(d.ContextDir, readErr)
+	ix, openErr := search.Open(d.ContextDir)
+	if openErr != nil {
+		return "", result, errMcp.SearchRead(d.ContextDir, openErr)
 	}
-
-	queryLower := i18n.Fold(query)
-	var sb strings.Builder
-	matches := 0
-
-	for _, e := range entries {
-		if e.IsDir() {
-			continue
-		}
-		path := filepath.Join(d.ContextDir, e.Name())
-		data, err := ctxIo.SafeReadUserFile(path)
-		if err != nil {
-			continue
-		}
-
-		scanner := bufio.NewScanner(strings.NewReader(string(data)))
-		lineNum := 0
-		for scanner.Scan() {
-			lineNum++
-			line := scanner.Text()
-			if strings.Contains(i18n.Fold(line), queryLower) {
-				ctxIo.SafeFprintf(&sb,
-					desc.Text(text.DescKeyMCPSearchHitLine),
-					e.Name(), lineNum, line)
-				matches++
-			}
-		}
+	hits, searchErr := ix.Search(query, limit)
+	if searchErr != nil {
+		return "", result, searchErr
 	}
 
-	if matches == 0 {
+	if len(hits) == 0 {
 		return fmt.Sprintf(
 			desc.Text(text.DescKeyMCPSearchNoMatch),
-			query, d.ContextDir), nil
+			query, d.ContextDir), result, nil
 	}
+	result.Hits = hits
 
-	return sb.String(), nil
+	var sb strings.Builder
+	for _, h := range hits {
+		ctxIo.SafeFprintf(&sb,
+			desc.Text(text.DescKeyMCPSearchHitLine),
+			h.Path, h.Line, h.Type, h.Title, h.Score)
+		if h.Snippet != "" {
+			ctxIo.SafeFprintf(&sb,
+				desc.Text(text.DescKeyMCPSearchSnippet), h.Snippet)
+		}
+	}
+	return sb.String(), result, nil
 }
@@ -8,16 +8,23 @@ package handler
 
 import (
 	"fmt"
+	"os"
 	"strings"
 	"time"
 
 	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	driftOut "github.com/ActiveMemory/ctx/internal/cli/drift/core/out"
+	coreLease "github.com/ActiveMemory/ctx/internal/cli/fleet/core/lease"
+	fleetPending "github.com/ActiveMemory/ctx/internal/cli/fleet/core/pending"
+	"github.com/ActiveMemory/ctx/internal/cli/remind/core/schedule"
 	remindStore "github.com/ActiveMemory/ctx/internal/cli/remind/core/store"
+	statusOut "github.com/ActiveMemory/ctx/internal/cli/status/core/out"
 	taskComplete "github.com/ActiveMemory/ctx/internal/cli/task/core/complete"
 	cfgArchive "github.com/ActiveMemory/ctx/internal/config/archive"
 	cfgCtx "github.com/ActiveMemory/ctx/internal/config/ctx"
 	"github.com/ActiveMemory/ctx/internal/config/embed/text"
 	cfgEntry "github.com/ActiveMemory/ctx/internal/config/entry"
+	"github.com/ActiveMemory/ctx/internal/config/env"
 	cfgFs "github.com/ActiveMemory/ctx/internal/config/fs"
 	"github.com/ActiveMemory/ctx/internal/config/mcp/event"
 	"github.com/ActiveMemory/ctx/internal/config/mcp/field"
@@ -33,6 +40,7 @@ import (
 	"github.com/ActiveMemory/ctx/internal/mcp/handler/task"
 	"github.com/ActiveMemory/ctx/internal/mcp/server/stat"
 	"github.com/ActiveMemory/ctx/internal/tidy"
+	"github.com/ActiveMemory/ctx/internal/txn"
 )
 
 // Status loads context and returns a status summary.
@@ -42,11 +50,12 @@ import (
 //
 // Returns:
 //   - string: formatted status text with file list and token counts
+//   - statusOut.Output: the report `ctx status --json` prints
 //   - error: context load error
-func Status(d *entity.MCPDeps) (string, error) {
+func Status(d *entity.MCPDeps) (string, statusOut.Output, error) {
 	ctx, loadErr := load.Do(d.ContextDir)
 	if loadErr != nil {
-		return "", loadErr
+		return "", statusOut.Output{}, loadErr
 	}
 
 	var sb strings.Builder
@@ -74,7 +83,7 @@ func Status(d *entity.MCPDeps) (string, error) {
 		)
 	}
 
-	return sb.String(), nil
+	return sb.String(), statusOut.StatusOutput(ctx, false), nil
 }
 
 // Add adds an entry to a context file.
@@ -146,11 +155,12 @@ func Complete(d *entity.MCPDeps, query string) (string, error) {
 //
 // Returns:
 //   - string: formatted drift report with violations, warnings, passed
+//   - driftOut.JSONOutput: the report `ctx drift --json` prints
 //   - error: context load error
-func Drift(d *entity.MCPDeps) (string, error) {
+func Drift(d *entity.MCPDeps) (string, driftOut.JSONOutput, error) {
 	ctx, loadErr := load.Do(d.ContextDir)
 	if loadErr != nil {
-		return "", loadErr
+		return "", driftOut.JSONOutput{}, loadErr
 	}
 
 	report := drift.Detect(ctx)
@@ -193,7 +203,7 @@ func Drift(d *entity.MCPDeps) (string, error) {
 		}
 	}
 
-	return sb.String(), nil
+	return sb.String(), driftOut.DriftOutput(report), nil
 }
 
 // Recall queries recent session history.
@@ -205,13 +215,14 @@ func Drift(d *entity.MCPDeps) (string, error) {
 //
 // Returns:
 //   - string: formatted session list with dates, projects, durations
+//   - entity.MCPJournalSessionList: the same sessions as structured data
 //   - error: session discovery error
 func Recall(
 	_ *entity.MCPDeps, limit int, since time.Time,
-) (string, error) {
+) (string, entity.MCPJournalSessionList, error) {
 	sessions, findErr := parser.FindSessions()
 	if findErr != nil {
-		return "", findErr
+		return "", entity.MCPJournalSessionList{}, findErr
 	}
 
 	// Apply since filter.
@@ -231,8 +242,11 @@ func Recall(
 		sessions = sessions[:limit]
 	}
 
+	list := entity.MCPJournalSessionList{
+		Sessions: make([]entity.MCPJournalSession, 0, len(sessions)),
+	}
 	if len(sessions) == 0 {
-		return desc.Text(text.DescKeyMCPNoSessions), nil
+		return desc.Text(text.DescKeyMCPNoSessions), list, nil
 	}
 
 	var sb strings.Builder
@@ -243,6 +257,14 @@ func Recall(
 
 	for i, sess := range sessions {
 		duration := sess.Duration.Round(time.Second)
+		list.Sessions = append(list.Sessions, entity.MCPJournalSession{
+			ID:              sess.ID,
+			Start:           sess.StartTime.Format(time.RFC3339),
+			Project:         sess.Project,
+			DurationSeconds: int64(duration / time.Second),
+			Turns:           sess.TurnCount,
+			FirstMessage:    sess.FirstUserMsg,
+		})
 		_, _ = fmt.Fprintf(
 			&sb,
 			desc.Text(text.DescKeyMCPJournalSourceItemFormat),
@@ -269,7 +291,7 @@ func Recall(
 		}
 	}
 
-	return sb.String(), nil
+	return sb.String(), list, nil
 }
 
 // WatchUpdate applies a structured context-update to .context/ files.
@@ -351,121 +373,179 @@ func WatchUpdate(
 //   - string: summary of moved tasks and cleaned sections
 //   - error: context load or write error
 func Compact(d *entity.MCPDeps, archive bool) (string, error) {
-	ctx, loadErr := load.Do(d.ContextDir)
-	if loadErr != nil {
-		return "", loadErr
-	}
+	// Load, compact, and rewrite under one lock so entries written
+	// by another writer in the meantime are not dropped.
+	var out string
+	lockErr := txn.Do(d.ContextDir, func() error {
+		ctx, loadErr := load.Do(d.ContextDir)
+		if loadErr != nil {
+			return loadErr
+		}
 
-	result := tidy.CompactContext(ctx)
+		result := tidy.CompactContext(ctx)
+		var sb strings.Builder
 
-	// Write TASKS.md changes.
-	if result.TasksFileUpdate != nil {
-		if writeErr := io.SafeWriteFile(
-			result.TasksFileUpdate.Path,
-			result.TasksFileUpdate.Content,
-			cfgFs.PermFile,
-		); writeErr != nil {
-			return "", writeErr
+		// Write TASKS.md changes.
+		if result.TasksFileUpdate != nil {
+			if writeErr := io.SafeWriteFileAtomic(
+				result.TasksFileUpdate.Path,
+				result.TasksFileUpdate.Content,
+				cfgFs.PermFile,
+			); writeErr != nil {
+				return writeErr
+			}
 		}
-	}
 
-	// Write section-cleaned files.
-	for _, fu := range result.SectionFileUpdates {
-		if writeErr := io.SafeWriteFile(
-			fu.Path, fu.Content, cfgFs.PermFile,
-		); writeErr != nil {
-			return "", writeErr
+		// Write section-cleaned files.
+		for _, fu := range result.SectionFileUpdates {
+			if writeErr := io.SafeWriteFileAtomic(
+				fu.Path, fu.Content, cfgFs.PermFile,
+			); writeErr != nil {
+				return writeErr
+			}
 		}
-	}
 
-	// Archive old tasks if requested.
-	var sb strings.Builder
-	if archive && len(result.ArchivableBlocks) > 0 {
-		var archiveContent string
-		for _, block := range result.ArchivableBlocks {
-			archiveContent += block.BlockContent() +
-				token.NewlineLF + token.NewlineLF
+		// Archive old tasks if requested.
+		if archive && len(result.ArchivableBlocks) > 0 {
+			var archiveContent string
+			for _, block := range result.ArchivableBlocks {
+				archiveContent += block.BlockContent() +
+					token.NewlineLF + token.NewlineLF
+			}
+			if _, archiveErr := tidy.WriteArchive(
+				cfgArchive.ScopeTasks,
+				desc.Text(text.DescKeyHeadingArchivedTasks),
+				archiveContent,
+			); archiveErr != nil {
+				_, _ = fmt.Fprintf(
+					&sb,
+					desc.Text(text.DescKeyMCPCompactArchiveWarning)+
+						token.NewlineLF,
+					archiveErr,
+				)
+			}
+		}
+
+		// Build response text.
+		for _, taskText := range result.TasksMoved {
+			io.SafeFprintf(&sb,
+				desc.Text(
+					text.DescKeyMCPCompactMovedFormat)+token.NewlineLF,
+				tidy.TruncateString(taskText, token.TruncateLen),
+			)
 		}
-		if _, archiveErr := tidy.WriteArchive(
-			cfgArchive.ScopeTasks,
-			desc.Text(text.DescKeyHeadingArchivedTasks),
-			archiveContent,
-		); archiveErr != nil {
+		for _, sc := range result.SectionsCleaned {
 			_, _ = fmt.Fprintf(
 				&sb,
-				desc.Text(text.DescKeyMCPCompactArchiveWarning)+
+				desc.Text(text.DescKeyMCPCompactRemovedSectFmt)+
 					token.NewlineLF,
-				archiveErr,
+				sc.Removed, sc.FileName,
 			)
 		}
-	}
 
-	// Build response text.
-	for _, taskText := range result.TasksMoved {
-		io.SafeFprintf(&sb,
-			desc.Text(
-				text.DescKeyMCPCompactMovedFormat)+token.NewlineLF,
-			tidy.TruncateString(taskText, token.TruncateLen),
-		)
-	}
-	for _, sc := range result.SectionsCleaned {
-		_, _ = fmt.Fprintf(
+		if result.TotalChanges() == 0 {
+			out = desc.Text(text.DescKeyMCPCompactClean)
+			return nil
+		}
+
+		io.SafeFprintf(
 			&sb,
-			desc.Text(text.DescKeyMCPCompactRemovedSectFmt)+
-				token.NewlineLF,
-			sc.Removed, sc.FileName,
+			desc.Text(text.DescKeyMCPFormatCompacted),
+			result.TotalChanges(),
 		)
-	}
+		sb.WriteString(desc.Text(text.DescKeyMCPReviewStatus))
 
-	if result.TotalChanges() == 0 {
-		return desc.Text(text.DescKeyMCPCompactClean), nil
+		out = sb.String()
+		return nil
+	})
+	if lockErr != nil {
+		return "", lockErr
 	}
-
-	io.SafeFprintf(
-		&sb,
-		desc.Text(text.DescKeyMCPFormatCompacted),
-		result.TotalChanges(),
-	)
-	sb.WriteString(desc.Text(text.DescKeyMCPReviewStatus))
-
-	return sb.String(), nil
+	return out, nil
 }
 
 // Next suggests the next pending task.
 //
+// Tasks with unmet #depends or #blocked-by annotations are
+// skipped and counted. When the project is connected to a hub, fleet leases are
+// honored: a task the calling agent ($CTX_AGENT) holds is
+// suggested first, and tasks other agents hold are skipped.
+// An unreachable hub does not fail the call; the suggestion
+// carries a note instead.
+//
 // Parameters:
 //   - d: runtime dependencies carrying the context directory
 //
 // Returns:
 //   - string: next pending task or all-complete message
+//   - entity.MCPNextTask: the task as structured data (Found is
+//     false when nothing is pending)
 //   - error: context load error
-func Next(d *entity.MCPDeps) (string, error) {
+func Next(d *entity.MCPDeps) (string, entity.MCPNextTask, error) {
 	ctx, loadErr := load.Do(d.ContextDir)
 	if loadErr != nil {
-		return "", loadErr
+		return "", entity.MCPNextTask{}, loadErr
 	}
 
 	tasksFile := ctx.File(cfgCtx.Task)
 	if tasksFile == nil {
-		return desc.Text(text.DescKeyMCPNoTasks), nil
+		return desc.Text(text.DescKeyMCPNoTasks), entity.MCPNextTask{}, nil
 	}
 
 	lines := strings.Split(string(tasksFile.Content), token.NewlineLF)
 
-	var result string
-	task.ForEachPending(lines, func(pt task.Pending) bool {
-		result = fmt.Sprintf(
-			desc.Text(text.DescKeyMCPNextTaskFormat),
-			pt.Index, pt.Content,
-		)
-		return true // stop after first
-	})
+	held, _, heldErr := coreLease.Held()
+	pick := fleetPending.Pick(
+		fleetPending.Parse(lines), held, os.Getenv(env.Agent),
+	)
+	next := entity.MCPNextTask{
+		Found: pick.Found, Index: pick.Index, Task: pick.Content,
+		Held: pick.Held, Skipped: pick.Skipped, Blocked: pick.Blocked,
+	}
 
-	if result != "" {
-		return result, nil
+	var sb strings.Builder
+	switch {
+	case pick.Held:
+		io.SafeFprintf(&sb, desc.Text(text.DescKeyMCPNextTaskHeld),
+			pick.Index,
+			time.Unix(pick.Expires, 0).Format(cfgTime.DateTimeFmt),
+			pick.Content,
+		)
+	case pick.Found:
+		io.SafeFprintf(&sb, desc.Text(text.DescKeyMCPNextTaskFormat),
+			pick.Index, pick.Content,
+		)
+	case pick.Blocked > 0:
+		io.SafeFprintf(&sb, desc.Text(text.DescKeyMCPNextAllBlocked),
+			pick.Blocked, pick.Skipped,
+		)
+	case pick.Skipped > 0:
+		io.SafeFprintf(&sb, desc.Text(text.DescKeyMCPNextAllHeld),
+			pick.Skipped,
+		)
+	default:
+		sb.WriteString(desc.Text(text.DescKeyMCPAllTasksComplete))
+	}
+	if pick.Found && pick.Skipped > 0 {
+		sb.WriteString(token.NewlineLF)
+		io.SafeFprintf(&sb, desc.Text(text.DescKeyMCPNextSkipped),
+			pick.Skipped,
+		)
+	}
+	if pick.Found && pick.Blocked > 0 {
+		sb.WriteString(token.NewlineLF)
+		io.SafeFprintf(&sb, desc.Text(text.DescKeyMCPNextBlocked),
+			pick.Blocked,
+		)
+	}
+	if heldErr != nil && pick.Found {
+		sb.WriteString(token.NewlineLF)
+		io.SafeFprintf(&sb,
+			desc.Text(text.DescKeyMCPNextLeasesUnavailable), heldErr,
+		)
 	}
 
-	return desc.Text(text.DescKeyMCPAllTasksComplete), nil
+	return sb.String(), next, nil
 }
 
 // CheckTaskCompletion checks if a recent action completed any pending
@@ -603,7 +683,8 @@ func Remind(_ *entity.MCPDeps) (string, error) {
 		return desc.Text(text.DescKeyMCPNoReminders), nil
 	}
 
-	today := time.Now().Format(cfgTime.DateFormat)
+	now := time.Now()
+	today := now.Format(cfgTime.DateFormat)
 	var sb strings.Builder
 	io.SafeFprintf(
 		&sb,
@@ -613,18 +694,16 @@ func Remind(_ *entity.MCPDeps) (string, error) {
 
 	for _, r := range reminders {
 		annotation := ""
-		if r.After != nil {
-			if *r.After > today {
-				annotation = fmt.Sprintf(
-					desc.Text(
-						text.DescKeyMCPFormatReminderNotDue,
-					), *r.After,
-				)
-			}
+		if next := schedule.Next(r); next > today {
+			annotation = fmt.Sprintf(
+				desc.Text(
+					text.DescKeyMCPFormatReminderNotDue,
+				), next,
+			)
 		}
 		io.SafeFprintf(&sb, desc.Text(
 			text.DescKeyMCPFormatReminderItem)+token.NewlineLF,
-			r.ID, r.Message, annotation)
+			r.ID, r.Message, annotation+schedule.Notes(r, now))
 	}
 
 	return sb.String(), nil
@@ -158,6 +158,26 @@ type ResourceListResult struct {
 	Resources []Resource `json:"resources"`
 }
 
+// ResourceTemplate describes a parameterized resource URI.
+//
+// Fields:
+//   - URITemplate: RFC 6570 URI template
+//   - Name: Human-readable name
+//   - Description: What the resolved resource contains
+//   - MimeType: Content type hint
+type ResourceTemplate struct {
+	URITemplate string `json:"uriTemplate"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	MimeType    string `json:"mimeType,omitempty"`
+}
+
+// ResourceTemplateListResult is returned by
+// resources/templates/list.
+type ResourceTemplateListResult struct {
+	ResourceTemplates []ResourceTemplate `json:"resourceTemplates"`
+}
+
 // ReadResourceParams is sent with resources/read.
 type ReadResourceParams struct {
 	URI string `json:"uri"`
@@ -215,15 +235,19 @@ type ToolAnnotations struct {
 //   - Name: Tool identifier
 //   - Description: What the tool does
 //   - InputSchema: JSON Schema for tool arguments
+//   - OutputSchema: JSON Schema for the structured result
+//     (optional; set on tools that return structuredContent)
 //   - Annotations: Optional behavioral hints
 type Tool struct {
-	Name        string           `json:"name"`
-	Description string           `json:"description,omitempty"`
-	InputSchema InputSchema      `json:"inputSchema"`
-	Annotations *ToolAnnotations `json:"annotations,omitempty"`
+	Name         string           `json:"name"`
+	Description  string           `json:"description,omitempty"`
+	InputSchema  InputSchema      `json:"inputSchema"`
+	OutputSchema *InputSchema     `json:"outputSchema,omitempty"`
+	Annotations  *ToolAnnotations `json:"annotations,omitempty"`
 }
 
 // InputSchema describes the JSON Schema for tool inputs.
+// Output schemas share the shape: both are object schemas.
 //
 // Fields:
 //   - Type: Schema type, always "object"
@@ -241,10 +265,18 @@ type InputSchema struct {
 //   - Type: JSON type (string, integer, boolean, etc.)
 //   - Description: Human-readable property description
 //   - Enum: Allowed values (optional)
+//   - Items: Element schema for array properties (optional)
+//   - Properties: Member schemas for object properties
+//     (optional)
+//   - Required: Required members of an object property
+//     (optional)
 type Property struct {
-	Type        string   `json:"type"`
-	Description string   `json:"description,omitempty"`
-	Enum        []string `json:"enum,omitempty"`
+	Type        string              `json:"type"`
+	Description string              `json:"description,omitempty"`
+	Enum        []string            `json:"enum,omitempty"`
+	Items       *Property           `json:"items,omitempty"`
+	Properties  map[string]Property `json:"properties,omitempty"`
+	Required    []string            `json:"required,omitempty"`
 }
 
 // ToolListResult is returned by tools/list.
@@ -276,10 +308,13 @@ type ToolContent struct {
 //
 // Fields:
 //   - Content: Output content pieces
+//   - StructuredContent: Machine-readable result matching the
+//     tool's output schema (optional)
 //   - IsError: Whether the tool invocation failed
 type CallToolResult struct {
-	Content []ToolContent `json:"content"`
-	IsError bool          `json:"isError,omitempty"`
+	Content           []ToolContent `json:"content"`
+	StructuredContent interface{}   `json:"structuredContent,omitempty"`
+	IsError           bool          `json:"isError,omitempty"`
 }
 
 // --- Prompt types ---
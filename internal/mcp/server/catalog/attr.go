@@ -81,3 +81,39 @@ func ToList() proto.ResourceListResult {
 
 	return proto.ResourceListResult{Resources: rr}
 }
+
+// ToTemplateList builds the resources/templates/list result.
+//
+// Returns:
+//   - proto.ResourceTemplateListResult: every parameterized
+//     resource the server resolves
+func ToTemplateList() proto.ResourceTemplateListResult {
+	return proto.ResourceTemplateListResult{
+		ResourceTemplates: []proto.ResourceTemplate{
+			{
+				URITemplate: resource.TemplateDecision,
+				Name:        resource.Decision,
+				MimeType:    mime.Markdown,
+				Description: desc.Text(text.DescKeyMCPResTplDecision),
+			},
+			{
+				URITemplate: resource.TemplateLearning,
+				Name:        resource.Learning,
+				MimeType:    mime.Markdown,
+				Description: desc.Text(text.DescKeyMCPResTplLearning),
+			},
+			{
+				URITemplate: resource.TemplateTheme,
+				Name:        resource.Theme,
+				MimeType:    mime.Markdown,
+				Description: desc.Text(text.DescKeyMCPResTplTheme),
+			},
+			{
+				URITemplate: resource.TemplateJournal,
+				Name:        resource.Journal,
+				MimeType:    mime.Markdown,
+				Description: desc.Text(text.DescKeyMCPResTplJournal),
+			},
+		},
+	}
+}
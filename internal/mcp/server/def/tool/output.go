@@ -0,0 +1,174 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package tool
+
+import (
+	"github.com/ActiveMemory/ctx/internal/config/mcp/field"
+	"github.com/ActiveMemory/ctx/internal/config/mcp/output"
+	"github.com/ActiveMemory/ctx/internal/config/mcp/schema"
+	"github.com/ActiveMemory/ctx/internal/mcp/proto"
+)
+
+// Output schemas describe the structuredContent each tool
+// returns. Property names mirror the json tags of the
+// structs the handlers return; see
+// [internal/config/mcp/output].
+
+// statusOutput describes the ctx_status result: the same
+// object `ctx status --json` prints.
+//
+// Returns:
+//   - *proto.InputSchema: object schema for the status report
+func statusOutput() *proto.InputSchema {
+	file := proto.Property{
+		Type: schema.Object,
+		Properties: map[string]proto.Property{
+			output.Name:    {Type: schema.String},
+			output.Tokens:  {Type: schema.Integer},
+			output.Size:    {Type: schema.Integer},
+			output.IsEmpty: {Type: schema.Boolean},
+			output.Summary: {Type: schema.String},
+			output.ModTime: {Type: schema.String},
+		},
+		Required: []string{
+			output.Name, output.Tokens, output.Size,
+			output.IsEmpty, output.Summary, output.ModTime,
+		},
+	}
+	return &proto.InputSchema{
+		Type: schema.Object,
+		Properties: map[string]proto.Property{
+			output.ContextDir:  {Type: schema.String},
+			output.TotalFiles:  {Type: schema.Integer},
+			output.TotalTokens: {Type: schema.Integer},
+			output.TotalSize:   {Type: schema.Integer},
+			output.Files:       {Type: schema.Array, Items: &file},
+		},
+		Required: []string{
+			output.ContextDir, output.TotalFiles,
+			output.TotalTokens, output.TotalSize, output.Files,
+		},
+	}
+}
+
+// driftOutput describes the ctx_drift result: the same
+// object `ctx drift --json` prints.
+//
+// Returns:
+//   - *proto.InputSchema: object schema for the drift report
+func driftOutput() *proto.InputSchema {
+	issue := proto.Property{
+		Type: schema.Object,
+		Properties: map[string]proto.Property{
+			output.File:    {Type: schema.String},
+			output.Line:    {Type: schema.Integer},
+			output.Type:    {Type: schema.String},
+			output.Message: {Type: schema.String},
+			output.Path:    {Type: schema.String},
+			output.Rule:    {Type: schema.String},
+		},
+		Required: []string{output.File, output.Type, output.Message},
+	}
+	return &proto.InputSchema{
+		Type: schema.Object,
+		Properties: map[string]proto.Property{
+			output.Timestamp:  {Type: schema.String},
+			output.Status:     {Type: schema.String},
+			output.Warnings:   {Type: schema.Array, Items: &issue},
+			output.Violations: {Type: schema.Array, Items: &issue},
+			output.Passed: {
+				Type:  schema.Array,
+				Items: &proto.Property{Type: schema.String},
+			},
+		},
+		Required: []string{
+			output.Timestamp, output.Status, output.Warnings,
+			output.Violations, output.Passed,
+		},
+	}
+}
+
+// nextOutput describes the ctx_next result.
+//
+// Returns:
+//   - *proto.InputSchema: object schema for the next task
+func nextOutput() *proto.InputSchema {
+	return &proto.InputSchema{
+		Type: schema.Object,
+		Properties: map[string]proto.Property{
+			output.Found:   {Type: schema.Boolean},
+			output.Index:   {Type: schema.Integer},
+			output.Task:    {Type: schema.String},
+			output.Held:    {Type: schema.Boolean},
+			output.Skipped: {Type: schema.Integer},
+			output.Blocked: {Type: schema.Integer},
+		},
+		Required: []string{output.Found},
+	}
+}
+
+// journalOutput describes the ctx_journal_source result.
+//
+// Returns:
+//   - *proto.InputSchema: object schema for the session list
+func journalOutput() *proto.InputSchema {
+	session := proto.Property{
+		Type: schema.Object,
+		Properties: map[string]proto.Property{
+			output.ID:              {Type: schema.String},
+			output.Start:           {Type: schema.String},
+			output.Project:         {Type: schema.String},
+			output.DurationSeconds: {Type: schema.Integer},
+			output.Turns:           {Type: schema.Integer},
+			output.FirstMessage:    {Type: schema.String},
+		},
+		Required: []string{
+			output.ID, output.Start,
+			output.DurationSeconds, output.Turns,
+		},
+	}
+	return &proto.InputSchema{
+		Type: schema.Object,
+		Properties: map[string]proto.Property{
+			output.Sessions: {Type: schema.Array, Items: &session},
+		},
+		Required: []string{output.Sessions},
+	}
+}
+
+// searchOutput describes the ctx_search result: the query
+// and the hits `ctx search --json` prints.
+//
+// Returns:
+//   - *proto.InputSchema: object schema for the ranked hits
+func searchOutput() *proto.InputSchema {
+	hit := proto.Property{
+		Type: schema.Object,
+		Properties: map[string]proto.Property{
+			output.Path:    {Type: schema.String},
+			output.Line:    {Type: schema.Integer},
+			output.Type:    {Type: schema.String},
+			output.Key:     {Type: schema.String},
+			output.Title:   {Type: schema.String},
+			output.Date:    {Type: schema.String},
+			output.Score:   {Type: schema.Number},
+			output.Snippet: {Type: schema.String},
+		},
+		Required: []string{
+			output.Path, output.Line, output.Type,
+			output.Title, output.Score,
+		},
+	}
+	return &proto.InputSchema{
+		Type: schema.Object,
+		Properties: map[string]proto.Property{
+			field.Query: {Type: schema.String},
+			output.Hits: {Type: schema.Array, Items: &hit},
+		},
+		Required: []string{field.Query, output.Hits},
+	}
+}
@@ -32,8 +32,9 @@ func Defs() []proto.Tool {
 			Name: cfgMcpTool.Status,
 			Description: desc.Text(
 				text.DescKeyMCPToolStatusDesc),
-			InputSchema: proto.InputSchema{Type: schema.Object},
-			Annotations: &proto.ToolAnnotations{ReadOnlyHint: true},
+			InputSchema:  proto.InputSchema{Type: schema.Object},
+			OutputSchema: statusOutput(),
+			Annotations:  &proto.ToolAnnotations{ReadOnlyHint: true},
 		},
 		{
 			Name: cfgMcpTool.Add,
@@ -94,8 +95,9 @@ func Defs() []proto.Tool {
 			Name: cfgMcpTool.Drift,
 			Description: desc.Text(
 				text.DescKeyMCPToolDriftDesc),
-			InputSchema: proto.InputSchema{Type: schema.Object},
-			Annotations: &proto.ToolAnnotations{ReadOnlyHint: true},
+			InputSchema:  proto.InputSchema{Type: schema.Object},
+			OutputSchema: driftOutput(),
+			Annotations:  &proto.ToolAnnotations{ReadOnlyHint: true},
 		},
 		{
 			Name: cfgMcpTool.JournalSource,
@@ -116,7 +118,8 @@ func Defs() []proto.Tool {
 					},
 				},
 			},
-			Annotations: &proto.ToolAnnotations{ReadOnlyHint: true},
+			OutputSchema: journalOutput(),
+			Annotations:  &proto.ToolAnnotations{ReadOnlyHint: true},
 		},
 		{
 			Name: cfgMcpTool.WatchUpdate,
@@ -161,8 +164,9 @@ func Defs() []proto.Tool {
 			Name: cfgMcpTool.Next,
 			Description: desc.Text(
 				text.DescKeyMCPToolNextDesc),
-			InputSchema: proto.InputSchema{Type: schema.Object},
-			Annotations: &proto.ToolAnnotations{ReadOnlyHint: true},
+			InputSchema:  proto.InputSchema{Type: schema.Object},
+			OutputSchema: nextOutput(),
+			Annotations:  &proto.ToolAnnotations{ReadOnlyHint: true},
 		},
 		{
 			Name: cfgMcpTool.CheckTaskCompletion,
@@ -237,9 +241,60 @@ func Defs() []proto.Tool {
 						Description: desc.Text(
 							text.DescKeyMCPToolPropSearchQuery),
 					},
+					field.Limit: {
+						Type: schema.Number,
+						Description: desc.Text(
+							text.DescKeyMCPToolPropSearchLimit),
+					},
 				},
 				Required: []string{field.Query},
 			},
+			OutputSchema: searchOutput(),
+			Annotations:  &proto.ToolAnnotations{ReadOnlyHint: true},
+		},
+		{
+			Name: cfgMcpTool.HubSearch,
+			Description: desc.Text(
+				text.DescKeyMCPToolHubSearchDesc),
+			InputSchema: proto.InputSchema{
+				Type: schema.Object,
+				Properties: map[string]proto.Property{
+					field.Query: {
+						Type: schema.String,
+						Description: desc.Text(
+							text.DescKeyMCPToolPropHubQuery),
+					},
+					cli.AttrType: {
+						Type: schema.String,
+						Description: desc.Text(
+							text.DescKeyMCPToolPropHubType),
+						Enum: []string{
+							entry.Decision, entry.Learning,
+							entry.Convention, entry.Task,
+						},
+					},
+					field.Origin: {
+						Type: schema.String,
+						Description: desc.Text(
+							text.DescKeyMCPToolPropHubOrigin),
+					},
+					field.Since: {
+						Type: schema.String,
+						Description: desc.Text(
+							text.DescKeyMCPToolPropHubSince),
+					},
+					field.Until: {
+						Type: schema.String,
+						Description: desc.Text(
+							text.DescKeyMCPToolPropHubUntil),
+					},
+					field.Limit: {
+						Type: schema.Number,
+						Description: desc.Text(
+							text.DescKeyMCPToolPropHubLimit),
+					},
+				},
+			},
 			Annotations: &proto.ToolAnnotations{ReadOnlyHint: true},
 		},
 		{
@@ -14,8 +14,8 @@ import (
 )
 
 func TestDefsCount(t *testing.T) {
-	if len(Defs()) != 15 {
-		t.Errorf("tool count = %d, want 15", len(Defs()))
+	if len(Defs()) != 16 {
+		t.Errorf("tool count = %d, want 16", len(Defs()))
 	}
 }
 
@@ -67,6 +67,7 @@ func TestDefsContainsAllConfigTools(t *testing.T) {
 		cfgMcpTool.Remind,
 		cfgMcpTool.SteeringGet,
 		cfgMcpTool.Search,
+		cfgMcpTool.HubSearch,
 		cfgMcpTool.SessionStart,
 		cfgMcpTool.SessionEnd,
 	}
@@ -43,6 +43,8 @@ func Do(
 		return ping.Dispatch(req)
 	case method.ResourceList:
 		return resource.DispatchList(req, resList)
+	case method.ResourceTemplateList:
+		return resource.DispatchTemplateList(req)
 	case method.ResourceRead:
 		return resource.DispatchRead(
 			d.ContextDir, d.TokenBudget, req,
@@ -10,12 +10,17 @@
 // primarily Claude Code, but also any other tool that speaks
 // the same JSON-RPC 2.0 dialect.
 //
-// The server runs over **stdin/stdout** as a sub-process
-// launched by the AI client; it does not bind a network port.
-// Spawn behavior is configured by the client's MCP block (see
+// By default the server runs over **stdin/stdout** as a
+// sub-process launched by the AI client. Spawn behavior is
+// configured by the client's MCP block (see
 // [internal/cli/setup] for what `ctx setup` writes into each
 // tool's config).
 //
+// [HTTPServer] (`ctx mcp serve --http`) serves the same
+// dispatch over the MCP Streamable HTTP transport so many
+// clients, including remote or containerized agents, can
+// share one process. See Streamable HTTP below.
+//
 // # Wire Protocol
 //
 // MCP is JSON-RPC 2.0 with three core verbs ctx implements:
@@ -75,11 +80,34 @@
 // inside the JSON-RPC `result` envelope so they reach the
 // AI without changing the protocol shape.
 //
+// # Streamable HTTP
+//
+// [HTTPServer] exposes a single /mcp endpoint guarded by
+// a bearer token:
+//
+//   - POST initialize (without Mcp-Session-Id) opens a
+//     session and returns its ID in that header. Every
+//     later POST, GET, and DELETE must carry it.
+//   - POST carries one JSON-RPC message; requests are
+//     answered with application/json, notifications
+//     with 202 Accepted.
+//   - GET opens the session's text/event-stream, which
+//     carries notifications/resources/updated for the
+//     session's own subscriptions.
+//   - DELETE ends the session and stops its poller.
+//
+// Each session owns its [entity.MCPSession] and
+// [poll.Poller]; resources, tools, and prompts are shared.
+//
 // # Concurrency
 //
-// One goroutine reads from stdin; one goroutine writes
-// to stdout; tool dispatch runs in the read goroutine
-// to preserve request ordering. Long-running tools
-// (currently none) would need to spawn a goroutine and
-// signal completion through a channel.
+// Over stdio, one goroutine reads from stdin; one
+// goroutine writes to stdout; tool dispatch runs in the
+// read goroutine to preserve request ordering. Long-running
+// tools (currently none) would need to spawn a goroutine
+// and signal completion through a channel.
+//
+// Over HTTP, each request runs on its own goroutine but
+// dispatch is serialized across sessions, so handlers keep
+// the single-threaded contract they were written for.
 package server
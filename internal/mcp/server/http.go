@@ -0,0 +1,156 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"time"
+
+	cfgTransport "github.com/ActiveMemory/ctx/internal/config/mcp/transport"
+	"github.com/ActiveMemory/ctx/internal/mcp/server/catalog"
+	"github.com/ActiveMemory/ctx/internal/rc"
+)
+
+// NewHTTP creates a Streamable HTTP MCP server for the given
+// context directory.
+//
+// Parameters:
+//   - contextDir: path to the .context/ directory
+//   - version: binary version string for the server info response
+//   - token: bearer token clients must present
+//   - idle: how long a session may sit without requests or
+//     an open event stream before it is closed (0 = never)
+//
+// Returns:
+//   - *HTTPServer: a configured server ready to serve
+func NewHTTP(
+	contextDir, version, token string, idle time.Duration,
+) *HTTPServer {
+	catalog.Init()
+	h := &HTTPServer{
+		contextDir:   contextDir,
+		tokenBudget:  rc.TokenBudget(),
+		version:      version,
+		token:        token,
+		resourceList: catalog.ToList(),
+		idle:         idle,
+		sessions:     make(map[string]*session),
+		done:         make(chan struct{}),
+	}
+	if idle > 0 {
+		go h.reap()
+	}
+	return h
+}
+
+// GenerateToken creates a random bearer token for a server
+// started without one.
+//
+// Returns:
+//   - string: "ctx_mcp_" followed by 32 hex-encoded bytes
+//   - error: non-nil if the system random source fails
+func GenerateToken() (string, error) {
+	id, idErr := randomID(cfgTransport.TokenBytes)
+	if idErr != nil {
+		return "", idErr
+	}
+	return cfgTransport.TokenPrefix + id, nil
+}
+
+// Serve accepts Streamable HTTP connections on lis until
+// [HTTPServer.Shutdown] is called.
+//
+// Parameters:
+//   - lis: listener to accept connections on
+//
+// Returns:
+//   - error: non-nil if the listener fails; nil after
+//     Shutdown
+func (h *HTTPServer) Serve(lis net.Listener) error {
+	mux := http.NewServeMux()
+	mux.Handle(cfgTransport.Path, h)
+	h.mu.Lock()
+	select {
+	case <-h.done:
+		h.mu.Unlock()
+		return nil
+	default:
+	}
+	h.srv = &http.Server{
+		Handler: mux,
+		ReadHeaderTimeout: cfgTransport.ReadHeaderTimeout *
+			time.Second,
+	}
+	srv := h.srv
+	h.mu.Unlock()
+
+	serveErr := srv.Serve(lis)
+	if errors.Is(serveErr, http.ErrServerClosed) {
+		return nil
+	}
+	return serveErr
+}
+
+// ServeHTTP authenticates the request and routes it by
+// method: POST carries a client message, GET opens the
+// session's event stream, DELETE ends the session.
+//
+// Parameters:
+//   - w: response writer
+//   - r: incoming request
+func (h *HTTPServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !h.authorized(r) {
+		http.Error(
+			w, cfgTransport.ErrUnauthorized,
+			http.StatusUnauthorized,
+		)
+		return
+	}
+	switch r.Method {
+	case http.MethodPost:
+		h.post(w, r)
+	case http.MethodGet:
+		h.stream(w, r)
+	case http.MethodDelete:
+		h.end(w, r)
+	default:
+		w.Header().Set(cfgTransport.HeaderAllow, cfgTransport.Allow)
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+// Shutdown ends every event stream and session, then stops
+// the HTTP server.
+func (h *HTTPServer) Shutdown() {
+	h.mu.Lock()
+	select {
+	case <-h.done:
+	default:
+		close(h.done)
+	}
+	for id, sess := range h.sessions {
+		sess.poller.Stop()
+		delete(h.sessions, id)
+	}
+	srv := h.srv
+	h.mu.Unlock()
+
+	if srv == nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(
+		context.Background(),
+		cfgTransport.ShutdownTimeout*time.Second,
+	)
+	defer cancel()
+	// Acceptable discard: best-effort teardown; a timed-out
+	// shutdown is not actionable by the caller.
+	_ = srv.Shutdown(ctx)
+}
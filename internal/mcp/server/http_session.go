@@ -0,0 +1,379 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	"github.com/ActiveMemory/ctx/internal/config/embed/text"
+	cfgHTTP "github.com/ActiveMemory/ctx/internal/config/http"
+	"github.com/ActiveMemory/ctx/internal/config/mcp/cfg"
+	"github.com/ActiveMemory/ctx/internal/config/mcp/method"
+	cfgSchema "github.com/ActiveMemory/ctx/internal/config/mcp/schema"
+	cfgTransport "github.com/ActiveMemory/ctx/internal/config/mcp/transport"
+	"github.com/ActiveMemory/ctx/internal/entity"
+	errMcp "github.com/ActiveMemory/ctx/internal/err/mcp"
+	"github.com/ActiveMemory/ctx/internal/mcp/proto"
+	"github.com/ActiveMemory/ctx/internal/mcp/server/dispatch"
+	"github.com/ActiveMemory/ctx/internal/mcp/server/dispatch/poll"
+	"github.com/ActiveMemory/ctx/internal/mcp/server/out"
+	"github.com/ActiveMemory/ctx/internal/mcp/server/parse"
+)
+
+// authorized checks the bearer token in constant time.
+//
+// Parameters:
+//   - r: incoming request
+//
+// Returns:
+//   - bool: true when the request carries the server token
+func (h *HTTPServer) authorized(r *http.Request) bool {
+	token := strings.TrimPrefix(
+		r.Header.Get(cfgTransport.HeaderAuthorization),
+		cfgTransport.BearerPrefix,
+	)
+	return token != "" && subtle.ConstantTimeCompare(
+		[]byte(token), []byte(h.token),
+	) == 1
+}
+
+// post handles one client message. An initialize request
+// without a session ID opens a new session and returns
+// its ID in the Mcp-Session-Id header; every other
+// message must name an open session.
+//
+// Parameters:
+//   - w: response writer
+//   - r: POST request carrying one JSON-RPC message
+func (h *HTTPServer) post(w http.ResponseWriter, r *http.Request) {
+	body, readErr := io.ReadAll(
+		http.MaxBytesReader(w, r.Body, cfg.ScanMaxSize),
+	)
+	if readErr != nil {
+		http.Error(w, cfgTransport.ErrBody, http.StatusBadRequest)
+		return
+	}
+	req, errResp := parse.Request(body)
+	if errResp != nil {
+		writeJSON(w, http.StatusBadRequest, errResp)
+		return
+	}
+
+	if req != nil && req.Method == method.Initialize &&
+		r.Header.Get(cfgTransport.HeaderSessionID) == "" {
+		id, idErr := randomID(cfgTransport.SessionIDBytes)
+		if idErr != nil {
+			http.Error(w, idErr.Error(), http.StatusInternalServerError)
+			return
+		}
+		sess, opened := h.open(id)
+		if !opened {
+			http.Error(
+				w, cfgTransport.ErrSessionLimit,
+				http.StatusServiceUnavailable,
+			)
+			return
+		}
+		w.Header().Set(cfgTransport.HeaderSessionID, id)
+		h.reply(w, sess, *req)
+		return
+	}
+
+	sess, found := h.lookup(w, r)
+	if !found {
+		return
+	}
+	if req == nil {
+		// Notification: no response body.
+		w.WriteHeader(http.StatusAccepted)
+		return
+	}
+	h.reply(w, sess, *req)
+}
+
+// reply dispatches a request for a session and writes the
+// JSON-RPC response.
+//
+// Parameters:
+//   - w: response writer
+//   - sess: session the request belongs to
+//   - req: parsed JSON-RPC request
+func (h *HTTPServer) reply(
+	w http.ResponseWriter, sess *session, req proto.Request,
+) {
+	h.dispatchMu.Lock()
+	resp := dispatch.Do(
+		h.version, sess.deps, h.resourceList, sess.poller, req,
+	)
+	h.dispatchMu.Unlock()
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// stream serves the session's notifications as server-sent
+// events until the client disconnects, the session ends,
+// or the server shuts down.
+//
+// Parameters:
+//   - w: response writer; must support flushing
+//   - r: GET request accepting text/event-stream
+func (h *HTTPServer) stream(w http.ResponseWriter, r *http.Request) {
+	if !strings.Contains(
+		r.Header.Get(cfgTransport.HeaderAccept),
+		cfgTransport.MimeEventStream,
+	) {
+		http.Error(
+			w, cfgTransport.ErrNotAcceptable,
+			http.StatusNotAcceptable,
+		)
+		return
+	}
+	sess, found := h.lookup(w, r)
+	if !found {
+		return
+	}
+	flusher, canFlush := w.(http.Flusher)
+	if !canFlush {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	h.mu.Lock()
+	if sess.streaming {
+		h.mu.Unlock()
+		http.Error(w, cfgTransport.ErrStreamOpen, http.StatusConflict)
+		return
+	}
+	sess.streaming = true
+	h.mu.Unlock()
+	defer func() {
+		h.mu.Lock()
+		sess.streaming = false
+		sess.lastActive = time.Now()
+		h.mu.Unlock()
+	}()
+
+	w.Header().Set(
+		cfgTransport.HeaderContentType, cfgTransport.MimeEventStream,
+	)
+	w.Header().Set(cfgTransport.HeaderCacheControl, cfgTransport.NoCache)
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	ticker := time.NewTicker(
+		cfgTransport.KeepAliveInterval * time.Second,
+	)
+	defer ticker.Stop()
+	for {
+		var frame string
+		select {
+		case <-r.Context().Done():
+			return
+		case <-h.done:
+			return
+		case <-sess.ended:
+			return
+		case <-ticker.C:
+			frame = cfgTransport.KeepAlive
+		case n := <-sess.notes:
+			data, marshalErr := json.Marshal(n)
+			if marshalErr != nil {
+				continue
+			}
+			frame = fmt.Sprintf(cfgTransport.FmtEvent, data)
+		}
+		if _, writeErr := io.WriteString(w, frame); writeErr != nil {
+			return
+		}
+		flusher.Flush()
+	}
+}
+
+// end closes a session and stops its resource poller.
+//
+// Parameters:
+//   - w: response writer
+//   - r: DELETE request naming the session
+func (h *HTTPServer) end(w http.ResponseWriter, r *http.Request) {
+	id := r.Header.Get(cfgTransport.HeaderSessionID)
+	if _, found := h.lookup(w, r); !found {
+		return
+	}
+	h.mu.Lock()
+	if sess, ok := h.sessions[id]; ok {
+		h.dropLocked(id, sess)
+	}
+	h.mu.Unlock()
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// dropLocked stops a session's poller, ends its event
+// stream, and forgets it. The caller holds h.mu.
+//
+// Parameters:
+//   - id: session ID
+//   - sess: the open session registered under id
+func (h *HTTPServer) dropLocked(id string, sess *session) {
+	sess.poller.Stop()
+	close(sess.ended)
+	delete(h.sessions, id)
+}
+
+// reap closes idle sessions until Shutdown. It checks
+// twice per idle timeout, so a session lives at most
+// one and a half timeouts past its last activity.
+func (h *HTTPServer) reap() {
+	ticker := time.NewTicker(h.idle / 2)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-h.done:
+			return
+		case now := <-ticker.C:
+			h.expire(now)
+		}
+	}
+}
+
+// expire closes every session with no open event stream
+// whose last activity is more than the idle timeout
+// before now.
+//
+// Parameters:
+//   - now: reference time for the idle check
+func (h *HTTPServer) expire(now time.Time) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for id, sess := range h.sessions {
+		if sess.streaming || now.Sub(sess.lastActive) < h.idle {
+			continue
+		}
+		h.dropLocked(id, sess)
+	}
+}
+
+// open registers a new session with its own MCP session
+// state and resource poller.
+//
+// Parameters:
+//   - id: session ID to register
+//
+// Returns:
+//   - *session: the new session
+//   - bool: false when MaxSessions are already open
+func (h *HTTPServer) open(id string) (*session, bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if len(h.sessions) >= cfgTransport.MaxSessions {
+		return nil, false
+	}
+	sess := &session{
+		deps: &entity.MCPDeps{
+			ContextDir:  h.contextDir,
+			TokenBudget: h.tokenBudget,
+			Session:     entity.NewMCPSession(),
+		},
+		notes:      make(chan proto.Notification, cfgTransport.NotifyBuffer),
+		ended:      make(chan struct{}),
+		lastActive: time.Now(),
+	}
+	sess.poller = poll.NewPoller(h.contextDir, func(n proto.Notification) {
+		select {
+		case sess.notes <- n:
+		default:
+			// Acceptable drop: no stream is draining the queue.
+			// The client re-reads subscribed resources when it
+			// reconnects.
+		}
+	})
+	h.sessions[id] = sess
+	return sess, true
+}
+
+// lookup resolves the request's session, answering 400 when
+// the header is missing and 404 when the session is unknown
+// or expired. A found session is marked active.
+//
+// Parameters:
+//   - w: response writer for the error reply
+//   - r: request carrying Mcp-Session-Id
+//
+// Returns:
+//   - *session: the open session
+//   - bool: false when an error reply was written
+func (h *HTTPServer) lookup(
+	w http.ResponseWriter, r *http.Request,
+) (*session, bool) {
+	id := r.Header.Get(cfgTransport.HeaderSessionID)
+	if id == "" {
+		http.Error(
+			w, cfgTransport.ErrSessionRequired,
+			http.StatusBadRequest,
+		)
+		return nil, false
+	}
+	h.mu.Lock()
+	sess, ok := h.sessions[id]
+	if ok {
+		sess.lastActive = time.Now()
+	}
+	h.mu.Unlock()
+	if !ok {
+		http.Error(w, cfgTransport.ErrSessionUnknown, http.StatusNotFound)
+		return nil, false
+	}
+	return sess, true
+}
+
+// writeJSON writes v as a JSON response, falling back to a
+// JSON-RPC internal error when v cannot be marshaled.
+//
+// Parameters:
+//   - w: response writer
+//   - code: HTTP status code
+//   - v: value to encode
+func writeJSON(w http.ResponseWriter, code int, v any) {
+	data, marshalErr := json.Marshal(v)
+	if marshalErr != nil {
+		// Acceptable discard: the fallback is a fixed struct
+		// that always marshals.
+		data, _ = json.Marshal(out.ErrResponse(
+			nil, cfgSchema.ErrCodeInternal,
+			desc.Text(text.DescKeyMCPErrFailedMarshal),
+		))
+	}
+	w.Header().Set(cfgTransport.HeaderContentType, cfgHTTP.MimeJSON)
+	w.WriteHeader(code)
+	// Acceptable discard: a failed write means the client
+	// has gone away.
+	_, _ = w.Write(data)
+}
+
+// randomID returns n random bytes, hex-encoded.
+//
+// Parameters:
+//   - n: number of random bytes
+//
+// Returns:
+//   - string: hex-encoded random value
+//   - error: non-nil if the system random source fails
+func randomID(n int) (string, error) {
+	b := make([]byte, n)
+	if _, randErr := rand.Read(b); randErr != nil {
+		return "", errMcp.GenerateToken(randErr)
+	}
+	return hex.EncodeToString(b), nil
+}
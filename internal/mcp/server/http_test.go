@@ -0,0 +1,348 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ActiveMemory/ctx/internal/config/ctx"
+	cfgSchema "github.com/ActiveMemory/ctx/internal/config/mcp/schema"
+	"github.com/ActiveMemory/ctx/internal/mcp/proto"
+)
+
+const testToken = "ctx_mcp_test"
+
+// newHTTPTestServer starts a Streamable HTTP server over a
+// fresh context directory.
+func newHTTPTestServer(t *testing.T) (*HTTPServer, string, string) {
+	t.Helper()
+	_, contextDir := newTestServer(t)
+	h := NewHTTP(contextDir, "test", testToken, 0)
+	ts := httptest.NewServer(h)
+	t.Cleanup(func() {
+		h.Shutdown()
+		ts.Close()
+	})
+	return h, ts.URL, contextDir
+}
+
+// post sends one JSON-RPC message and returns the HTTP
+// response with its body read.
+func post(
+	t *testing.T, url, sessionID, method string, params any, id int,
+) (*http.Response, []byte) {
+	t.Helper()
+	msg := map[string]any{"jsonrpc": "2.0", "method": method}
+	if params != nil {
+		msg["params"] = params
+	}
+	if id > 0 {
+		msg["id"] = id
+	}
+	body, marshalErr := json.Marshal(msg)
+	if marshalErr != nil {
+		t.Fatal(marshalErr)
+	}
+	req, reqErr := http.NewRequest(
+		http.MethodPost, url, bytes.NewReader(body),
+	)
+	if reqErr != nil {
+		t.Fatal(reqErr)
+	}
+	req.Header.Set("Authorization", "Bearer "+testToken)
+	req.Header.Set("Accept", "application/json, text/event-stream")
+	if sessionID != "" {
+		req.Header.Set("Mcp-Session-Id", sessionID)
+	}
+	resp, doErr := http.DefaultClient.Do(req)
+	if doErr != nil {
+		t.Fatal(doErr)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	var buf bytes.Buffer
+	if _, readErr := buf.ReadFrom(resp.Body); readErr != nil {
+		t.Fatal(readErr)
+	}
+	return resp, buf.Bytes()
+}
+
+// initSession opens a session and returns its ID.
+func initSession(t *testing.T, url string) string {
+	t.Helper()
+	resp, body := post(t, url, "", "initialize", proto.InitializeParams{
+		ProtocolVersion: cfgSchema.ProtocolVersionStreamable,
+		ClientInfo:      proto.AppInfo{Name: "test", Version: "1.0"},
+	}, 1)
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("initialize status = %d: %s", resp.StatusCode, body)
+	}
+	id := resp.Header.Get("Mcp-Session-Id")
+	if id == "" {
+		t.Fatal("initialize returned no Mcp-Session-Id")
+	}
+	return id
+}
+
+func TestHTTP_RequiresToken(t *testing.T) {
+	_, url, _ := newHTTPTestServer(t)
+	for _, auth := range []string{"", "Bearer wrong"} {
+		req, _ := http.NewRequest(
+			http.MethodPost, url, strings.NewReader("{}"),
+		)
+		if auth != "" {
+			req.Header.Set("Authorization", auth)
+		}
+		resp, doErr := http.DefaultClient.Do(req)
+		if doErr != nil {
+			t.Fatal(doErr)
+		}
+		_ = resp.Body.Close()
+		if resp.StatusCode != http.StatusUnauthorized {
+			t.Errorf("auth %q: status = %d, want 401",
+				auth, resp.StatusCode)
+		}
+	}
+}
+
+func TestHTTP_SessionLifecycle(t *testing.T) {
+	_, url, _ := newHTTPTestServer(t)
+
+	resp, body := post(t, url, "", "initialize", proto.InitializeParams{
+		ProtocolVersion: cfgSchema.ProtocolVersionStreamable,
+	}, 1)
+	var initResp struct {
+		Result proto.InitializeResult `json:"result"`
+	}
+	if unmarshalErr := json.Unmarshal(body, &initResp); unmarshalErr != nil {
+		t.Fatalf("unmarshal: %v (%s)", unmarshalErr, body)
+	}
+	if got := initResp.Result.ProtocolVersion; got !=
+		cfgSchema.ProtocolVersionStreamable {
+		t.Errorf("protocolVersion = %q, want %q",
+			got, cfgSchema.ProtocolVersionStreamable)
+	}
+	id := resp.Header.Get("Mcp-Session-Id")
+
+	resp, _ = post(t, url, id, "notifications/initialized", nil, 0)
+	if resp.StatusCode != http.StatusAccepted {
+		t.Errorf("notification status = %d, want 202", resp.StatusCode)
+	}
+
+	resp, body = post(t, url, id, "tools/list", nil, 2)
+	if resp.StatusCode != http.StatusOK ||
+		!strings.Contains(string(body), "ctx_status") {
+		t.Fatalf("tools/list = %d %s", resp.StatusCode, body)
+	}
+
+	if resp, _ = post(t, url, "", "tools/list", nil, 3); resp.StatusCode !=
+		http.StatusBadRequest {
+		t.Errorf("no session: status = %d, want 400", resp.StatusCode)
+	}
+	if resp, _ = post(t, url, "nope", "tools/list", nil, 4); resp.StatusCode !=
+		http.StatusNotFound {
+		t.Errorf("unknown session: status = %d, want 404", resp.StatusCode)
+	}
+
+	del, _ := http.NewRequest(http.MethodDelete, url, nil)
+	del.Header.Set("Authorization", "Bearer "+testToken)
+	del.Header.Set("Mcp-Session-Id", id)
+	delResp, delErr := http.DefaultClient.Do(del)
+	if delErr != nil {
+		t.Fatal(delErr)
+	}
+	_ = delResp.Body.Close()
+	if delResp.StatusCode != http.StatusNoContent {
+		t.Errorf("delete status = %d, want 204", delResp.StatusCode)
+	}
+	if resp, _ = post(t, url, id, "tools/list", nil, 5); resp.StatusCode !=
+		http.StatusNotFound {
+		t.Errorf("ended session: status = %d, want 404", resp.StatusCode)
+	}
+}
+
+func TestHTTP_IdleSessionsExpire(t *testing.T) {
+	h, url, _ := newHTTPTestServer(t)
+	h.idle = time.Minute
+	idleID := initSession(t, url)
+	streamID := initSession(t, url)
+
+	h.mu.Lock()
+	idle, streaming := h.sessions[idleID], h.sessions[streamID]
+	streaming.streaming = true
+	h.mu.Unlock()
+
+	h.expire(time.Now().Add(2 * time.Minute))
+
+	select {
+	case <-idle.ended:
+	default:
+		t.Error("idle session not ended")
+	}
+	if resp, _ := post(t, url, idleID, "tools/list", nil, 2); resp.StatusCode !=
+		http.StatusNotFound {
+		t.Errorf("expired session: status = %d, want 404", resp.StatusCode)
+	}
+	h.mu.Lock()
+	_, kept := h.sessions[streamID]
+	h.mu.Unlock()
+	if !kept {
+		t.Error("session with an open stream expired")
+	}
+}
+
+func TestHTTP_ReaperDropsIdleSessions(t *testing.T) {
+	_, contextDir := newTestServer(t)
+	h := NewHTTP(contextDir, "test", testToken, 50*time.Millisecond)
+	ts := httptest.NewServer(h)
+	t.Cleanup(func() {
+		h.Shutdown()
+		ts.Close()
+	})
+	id := initSession(t, ts.URL)
+
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		h.mu.Lock()
+		_, open := h.sessions[id]
+		h.mu.Unlock()
+		if !open {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatal("idle session still open after the reaper ran")
+}
+
+func TestHTTP_ParseErrorIsBadRequest(t *testing.T) {
+	_, url, _ := newHTTPTestServer(t)
+	req, _ := http.NewRequest(
+		http.MethodPost, url, strings.NewReader("{not json"),
+	)
+	req.Header.Set("Authorization", "Bearer "+testToken)
+	resp, doErr := http.DefaultClient.Do(req)
+	if doErr != nil {
+		t.Fatal(doErr)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	var rpc proto.Response
+	if decodeErr := json.NewDecoder(resp.Body).Decode(&rpc); decodeErr != nil {
+		t.Fatal(decodeErr)
+	}
+	if resp.StatusCode != http.StatusBadRequest || rpc.Error == nil ||
+		rpc.Error.Code != cfgSchema.ErrCodeParse {
+		t.Errorf("status %d, error %+v", resp.StatusCode, rpc.Error)
+	}
+}
+
+func TestHTTP_StreamDeliversOwnNotifications(t *testing.T) {
+	h, url, contextDir := newHTTPTestServer(t)
+	watcher := initSession(t, url)
+	other := initSession(t, url)
+
+	resp, body := post(t, url, watcher, "resources/subscribe",
+		proto.SubscribeParams{URI: "ctx://context/tasks"}, 2)
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("subscribe = %d %s", resp.StatusCode, body)
+	}
+
+	get, _ := http.NewRequest(http.MethodGet, url, nil)
+	get.Header.Set("Authorization", "Bearer "+testToken)
+	get.Header.Set("Accept", "text/event-stream")
+	get.Header.Set("Mcp-Session-Id", watcher)
+	stream, streamErr := http.DefaultClient.Do(get)
+	if streamErr != nil {
+		t.Fatal(streamErr)
+	}
+	defer func() { _ = stream.Body.Close() }()
+	if ct := stream.Header.Get("Content-Type"); ct != "text/event-stream" {
+		t.Fatalf("Content-Type = %q", ct)
+	}
+
+	// A second stream on the same session is refused.
+	dup, dupErr := http.DefaultClient.Do(get.Clone(get.Context()))
+	if dupErr != nil {
+		t.Fatal(dupErr)
+	}
+	_ = dup.Body.Close()
+	if dup.StatusCode != http.StatusConflict {
+		t.Errorf("second stream status = %d, want 409", dup.StatusCode)
+	}
+
+	taskFile := filepath.Join(contextDir, ctx.Task)
+	later := time.Now().Add(time.Minute)
+	if chErr := os.Chtimes(taskFile, later, later); chErr != nil {
+		t.Fatal(chErr)
+	}
+	h.mu.Lock()
+	watched, idle := h.sessions[watcher], h.sessions[other]
+	h.mu.Unlock()
+	watched.poller.CheckChanges()
+	idle.poller.CheckChanges()
+
+	lines := make(chan string, 1)
+	go func() {
+		scanner := bufio.NewScanner(stream.Body)
+		for scanner.Scan() {
+			if data, ok := strings.CutPrefix(
+				scanner.Text(), "data: ",
+			); ok {
+				lines <- data
+				return
+			}
+		}
+	}()
+	select {
+	case data := <-lines:
+		if !strings.Contains(data, "notifications/resources/updated") ||
+			!strings.Contains(data, "ctx://context/tasks") {
+			t.Errorf("event data = %s", data)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("no event received")
+	}
+	if len(idle.notes) != 0 {
+		t.Errorf("unsubscribed session queued %d notifications",
+			len(idle.notes))
+	}
+}
+
+func TestHTTP_StreamRequiresEventStreamAccept(t *testing.T) {
+	_, url, _ := newHTTPTestServer(t)
+	id := initSession(t, url)
+	get, _ := http.NewRequest(http.MethodGet, url, nil)
+	get.Header.Set("Authorization", "Bearer "+testToken)
+	get.Header.Set("Accept", "application/json")
+	get.Header.Set("Mcp-Session-Id", id)
+	resp, doErr := http.DefaultClient.Do(get)
+	if doErr != nil {
+		t.Fatal(doErr)
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode != http.StatusNotAcceptable {
+		t.Errorf("status = %d, want 406", resp.StatusCode)
+	}
+}
+
+func TestHTTP_GenerateToken(t *testing.T) {
+	a, aErr := GenerateToken()
+	b, bErr := GenerateToken()
+	if aErr != nil || bErr != nil {
+		t.Fatal(aErr, bErr)
+	}
+	if !strings.HasPrefix(a, "ctx_mcp_") || a == b {
+		t.Errorf("tokens %q, %q", a, b)
+	}
+}
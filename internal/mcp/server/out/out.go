@@ -113,3 +113,48 @@ func Call(
 	text, err := fn()
 	return ToolResult(id, text, err)
 }
+
+// ToolStructured builds a successful tool result that
+// carries structured content alongside the text, for tools
+// that declare an output schema. The text stays for clients
+// that only read content.
+//
+// Parameters:
+//   - id: JSON-RPC request ID
+//   - text: human-readable result text
+//   - data: structured result matching the tool's output
+//     schema
+//
+// Returns:
+//   - *proto.Response: tool result with text and structured
+//     content
+func ToolStructured(
+	id json.RawMessage, text string, data interface{},
+) *proto.Response {
+	return OkResponse(id, proto.CallToolResult{
+		Content: []proto.ToolContent{
+			{Type: mime.ContentTypeText, Text: text},
+		},
+		StructuredContent: data,
+	})
+}
+
+// StructuredResult wraps a handler (string, data, error)
+// return into a proto.Response.
+//
+// Parameters:
+//   - id: JSON-RPC request ID
+//   - text: success text from the handler
+//   - data: structured result from the handler
+//   - err: handler error, nil on success
+//
+// Returns:
+//   - *proto.Response: structured tool result or tool error
+func StructuredResult(
+	id json.RawMessage, text string, data interface{}, err error,
+) *proto.Response {
+	if err != nil {
+		return ToolError(id, err.Error())
+	}
+	return ToolStructured(id, text, data)
+}
@@ -112,3 +112,30 @@ func TestCallError(t *testing.T) {
 		t.Error("expected isError")
 	}
 }
+
+func TestStructuredResult(t *testing.T) {
+	id, _ := json.Marshal(1)
+	resp := StructuredResult(id, "2 files", map[string]int{"files": 2}, nil)
+	raw, _ := json.Marshal(resp.Result)
+	var r struct {
+		Content           []proto.ToolContent `json:"content"`
+		StructuredContent map[string]int      `json:"structuredContent"`
+	}
+	if err := json.Unmarshal(raw, &r); err != nil {
+		t.Fatal(err)
+	}
+	if r.Content[0].Text != "2 files" {
+		t.Errorf("text = %q", r.Content[0].Text)
+	}
+	if r.StructuredContent["files"] != 2 {
+		t.Errorf("structured = %v", r.StructuredContent)
+	}
+
+	resp = StructuredResult(id, "", nil, errors.New("boom"))
+	raw, _ = json.Marshal(resp.Result)
+	var e proto.CallToolResult
+	_ = json.Unmarshal(raw, &e)
+	if !e.IsError || e.StructuredContent != nil {
+		t.Errorf("error result = %+v", e)
+	}
+}
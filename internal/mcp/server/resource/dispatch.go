@@ -35,6 +35,17 @@ func DispatchList(
 	return out.OkResponse(req.ID, list)
 }
 
+// DispatchTemplateList returns the resource templates.
+//
+// Parameters:
+//   - req: the MCP request
+//
+// Returns:
+//   - *proto.Response: resource template list response
+func DispatchTemplateList(req proto.Request) *proto.Response {
+	return out.OkResponse(req.ID, catalog.ToTemplateList())
+}
+
 // DispatchRead loads context and returns the requested resource
 // content.
 //
@@ -58,6 +69,13 @@ func DispatchRead(
 		)
 	}
 
+	// Templated URIs read single files and skip the full load.
+	if resp := readTemplate(
+		req.ID, contextDir, params.URI,
+	); resp != nil {
+		return resp
+	}
+
 	ctx, loadErr := load.Do(contextDir)
 	if loadErr != nil {
 		return out.ErrResponse(req.ID, cfgSchema.ErrCodeInternal,
@@ -0,0 +1,277 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package resource
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	"github.com/ActiveMemory/ctx/internal/assets/tpl"
+	cfgCtx "github.com/ActiveMemory/ctx/internal/config/ctx"
+	"github.com/ActiveMemory/ctx/internal/config/dir"
+	"github.com/ActiveMemory/ctx/internal/config/embed/text"
+	cfgFile "github.com/ActiveMemory/ctx/internal/config/file"
+	cfgJournal "github.com/ActiveMemory/ctx/internal/config/journal"
+	"github.com/ActiveMemory/ctx/internal/config/mcp/cfg"
+	"github.com/ActiveMemory/ctx/internal/config/mcp/mime"
+	"github.com/ActiveMemory/ctx/internal/config/mcp/resource"
+	cfgSchema "github.com/ActiveMemory/ctx/internal/config/mcp/schema"
+	"github.com/ActiveMemory/ctx/internal/config/token"
+	"github.com/ActiveMemory/ctx/internal/disclosure"
+	"github.com/ActiveMemory/ctx/internal/heading"
+	ctxIo "github.com/ActiveMemory/ctx/internal/io"
+	"github.com/ActiveMemory/ctx/internal/journal/state"
+	"github.com/ActiveMemory/ctx/internal/mcp/proto"
+	"github.com/ActiveMemory/ctx/internal/mcp/server/out"
+	"github.com/ActiveMemory/ctx/internal/sanitize"
+)
+
+// readTemplate resolves a URI built from one of the
+// resource templates.
+//
+// Parameters:
+//   - id: JSON-RPC request ID
+//   - contextDir: path to the .context/ directory
+//   - uri: requested resource URI
+//
+// Returns:
+//   - *proto.Response: resource content or not-found error;
+//     nil when uri matches no template
+func readTemplate(
+	id json.RawMessage, contextDir, uri string,
+) *proto.Response {
+	if ts, ok := strings.CutPrefix(uri, resource.PrefixDecision); ok {
+		return readEntry(id, contextDir, cfgCtx.Decision, ts, uri)
+	}
+	if ts, ok := strings.CutPrefix(uri, resource.PrefixLearning); ok {
+		return readEntry(id, contextDir, cfgCtx.Learning, ts, uri)
+	}
+	if rest, ok := strings.CutPrefix(uri, resource.PrefixTheme); ok {
+		return readTheme(id, contextDir, rest, uri)
+	}
+	if sid, ok := strings.CutPrefix(uri, resource.PrefixJournal); ok {
+		return readJournal(id, contextDir, sid, uri)
+	}
+	return nil
+}
+
+// readEntry returns the entry block with the given timestamp,
+// searching the root file first and then the kind's theme
+// files.
+//
+// Parameters:
+//   - id: JSON-RPC request ID
+//   - contextDir: path to the .context/ directory
+//   - rootFile: root file name for the kind
+//   - ts: entry timestamp (YYYY-MM-DD-HHMMSS)
+//   - uri: resource URI for the response
+//
+// Returns:
+//   - *proto.Response: the entry block or not-found error
+func readEntry(
+	id json.RawMessage, contextDir, rootFile, ts, uri string,
+) *proto.Response {
+	themes, themeErr := disclosure.ThemeFiles(contextDir, rootFile)
+	if themeErr != nil {
+		return out.ErrResponse(id, cfgSchema.ErrCodeInternal,
+			themeErr.Error())
+	}
+	paths := append(
+		[]string{filepath.Join(contextDir, rootFile)}, themes...,
+	)
+	for _, path := range paths {
+		data, readErr := ctxIo.SafeReadUserFile(path)
+		if readErr != nil {
+			continue
+		}
+		for _, block := range heading.ParseEntryBlocks(string(data)) {
+			if block.Entry.Timestamp == ts {
+				return markdown(id, uri, block.BlockContent())
+			}
+		}
+	}
+	return notFound(id, uri)
+}
+
+// readTheme returns a theme file addressed as kind/slug. A
+// trailing Markdown extension on the slug is accepted.
+//
+// Parameters:
+//   - id: JSON-RPC request ID
+//   - contextDir: path to the .context/ directory
+//   - rest: URI remainder after the theme prefix
+//   - uri: resource URI for the response
+//
+// Returns:
+//   - *proto.Response: theme file content or not-found error
+func readTheme(
+	id json.RawMessage, contextDir, rest, uri string,
+) *proto.Response {
+	name, slug, ok := strings.Cut(rest, token.Slash)
+	slug = strings.TrimSuffix(slug, cfgFile.ExtMarkdown)
+	if !ok || !plainName(slug) {
+		return notFound(id, uri)
+	}
+	themeDir, known := disclosure.NamedThemeDir(name)
+	if !known {
+		return notFound(id, uri)
+	}
+	data, readErr := ctxIo.SafeReadFile(
+		filepath.Join(contextDir, themeDir), slug+cfgFile.ExtMarkdown,
+	)
+	if readErr != nil {
+		return notFound(id, uri)
+	}
+	return markdown(id, uri, string(data))
+}
+
+// readJournal returns an imported journal session, located
+// through the journal state index. Multi-part sessions are
+// returned as one content item per part, in order.
+//
+// Parameters:
+//   - id: JSON-RPC request ID
+//   - contextDir: path to the .context/ directory
+//   - sessionID: full session ID, or a prefix of at least
+//     the short-ID length that matches exactly one session
+//   - uri: resource URI for the response
+//
+// Returns:
+//   - *proto.Response: journal content, or a not-found or
+//     ambiguous error
+func readJournal(
+	id json.RawMessage, contextDir, sessionID, uri string,
+) *proto.Response {
+	if len(sessionID) < cfgJournal.ShortIDLen || !plainName(sessionID) {
+		return notFound(id, uri)
+	}
+	journalDir := filepath.Join(contextDir, dir.Journal)
+	st, loadErr := state.Load(journalDir)
+	if loadErr != nil {
+		return out.ErrResponse(id, cfgSchema.ErrCodeInternal,
+			loadErr.Error())
+	}
+
+	full := st.MatchSessions(sessionID)
+	if len(full) > 1 {
+		return ambiguous(id, uri, len(full))
+	}
+	if len(full) == 0 {
+		return notFound(id, uri)
+	}
+
+	suffix := token.Dash + full[0][:cfgJournal.ShortIDLen] +
+		cfgFile.ExtMarkdown
+	var bases []string
+	for name := range st.Entries {
+		if strings.HasSuffix(name, suffix) {
+			bases = append(bases,
+				strings.TrimSuffix(name, cfgFile.ExtMarkdown))
+		}
+	}
+	if len(bases) > 1 {
+		return ambiguous(id, uri, len(bases))
+	}
+	if len(bases) == 0 {
+		return notFound(id, uri)
+	}
+	base := bases[0]
+
+	names := []string{base + cfgFile.ExtMarkdown}
+	for part := 2; ; part++ {
+		name := fmt.Sprintf(tpl.RecallPartFilename, base, part)
+		if _, ok := st.Entries[name]; !ok {
+			break
+		}
+		names = append(names, name)
+	}
+
+	contents := make([]proto.ResourceContent, 0, len(names))
+	for _, name := range names {
+		data, readErr := ctxIo.SafeReadFile(journalDir, name)
+		if readErr != nil {
+			continue
+		}
+		contents = append(contents, proto.ResourceContent{
+			URI: uri, MimeType: mime.Markdown, Text: string(data),
+		})
+	}
+	if len(contents) == 0 {
+		return notFound(id, uri)
+	}
+	return out.OkResponse(id, proto.ReadResourceResult{
+		Contents: contents,
+	})
+}
+
+// plainName reports whether s is a single path segment.
+// Reads still go through [ctxIo.SafeReadFile], which enforces
+// containment; this rejects nested paths up front so they
+// report not-found instead of resolving to a different file.
+//
+// Parameters:
+//   - s: URI parameter value
+//
+// Returns:
+//   - bool: true for a non-empty name without separators
+func plainName(s string) bool {
+	return s != "" && filepath.Base(s) == s
+}
+
+// markdown wraps text as a single Markdown resource content.
+//
+// Parameters:
+//   - id: JSON-RPC request ID
+//   - uri: resource URI for the response
+//   - body: resource text
+//
+// Returns:
+//   - *proto.Response: resources/read result
+func markdown(id json.RawMessage, uri, body string) *proto.Response {
+	return out.OkResponse(id, proto.ReadResourceResult{
+		Contents: []proto.ResourceContent{{
+			URI: uri, MimeType: mime.Markdown, Text: body,
+		}},
+	})
+}
+
+// notFound reports a templated URI that resolves to nothing.
+//
+// Parameters:
+//   - id: JSON-RPC request ID
+//   - uri: requested resource URI
+//
+// Returns:
+//   - *proto.Response: invalid-argument error naming the URI
+func notFound(id json.RawMessage, uri string) *proto.Response {
+	return out.ErrResponse(id, cfgSchema.ErrCodeInvalidArg,
+		fmt.Sprintf(
+			desc.Text(text.DescKeyMCPErrResourceNotFound),
+			sanitize.Reflect(uri, cfg.MaxURILen),
+		))
+}
+
+// ambiguous reports a templated URI that matches several
+// resources.
+//
+// Parameters:
+//   - id: JSON-RPC request ID
+//   - uri: requested resource URI
+//   - n: number of matches
+//
+// Returns:
+//   - *proto.Response: invalid-argument error naming the URI
+func ambiguous(id json.RawMessage, uri string, n int) *proto.Response {
+	return out.ErrResponse(id, cfgSchema.ErrCodeInvalidArg,
+		fmt.Sprintf(
+			desc.Text(text.DescKeyMCPErrResourceAmbiguous),
+			sanitize.Reflect(uri, cfg.MaxURILen), n,
+		))
+}
@@ -7,7 +7,6 @@
 package initialize
 
 import (
-	cfgSchema "github.com/ActiveMemory/ctx/internal/config/mcp/schema"
 	"github.com/ActiveMemory/ctx/internal/config/mcp/server"
 	"github.com/ActiveMemory/ctx/internal/mcp/proto"
 	"github.com/ActiveMemory/ctx/internal/mcp/server/out"
@@ -23,7 +22,7 @@ import (
 //   - *proto.Response: server capabilities and protocol version
 func Dispatch(version string, req proto.Request) *proto.Response {
 	return out.OkResponse(req.ID, proto.InitializeResult{
-		ProtocolVersion: cfgSchema.ProtocolVersion,
+		ProtocolVersion: negotiate(req),
 		Capabilities: proto.ServerCaps{
 			Resources: &proto.ResourcesCap{Subscribe: true},
 			Tools:     &proto.ToolsCap{},
@@ -13,8 +13,9 @@
 // method by returning the server's capabilities and
 // version information. The response includes:
 //
-//   - ProtocolVersion: the MCP protocol version
-//     supported by this server.
+//   - ProtocolVersion: the client's requested version
+//     when the server supports it, else the server's
+//     default version.
 //   - Capabilities: resource subscriptions, tools,
 //     and prompts support flags.
 //   - ServerInfo: the server name and version string.
@@ -0,0 +1,38 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package initialize
+
+import (
+	"encoding/json"
+
+	cfgSchema "github.com/ActiveMemory/ctx/internal/config/mcp/schema"
+	"github.com/ActiveMemory/ctx/internal/mcp/proto"
+)
+
+// negotiate picks the protocol version for the handshake.
+// Malformed params fall back to the default rather than
+// failing the handshake.
+//
+// Parameters:
+//   - req: initialize request
+//
+// Returns:
+//   - string: the requested version when supported, else
+//     [cfgSchema.ProtocolVersion]
+func negotiate(req proto.Request) string {
+	var params proto.InitializeParams
+	if len(req.Params) == 0 ||
+		json.Unmarshal(req.Params, &params) != nil {
+		return cfgSchema.ProtocolVersion
+	}
+	switch params.ProtocolVersion {
+	case cfgSchema.ProtocolVersionStreamable,
+		cfgSchema.ProtocolVersionStructured:
+		return params.ProtocolVersion
+	}
+	return cfgSchema.ProtocolVersion
+}
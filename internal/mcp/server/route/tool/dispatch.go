@@ -63,9 +63,8 @@ func DispatchCall(
 
 	switch params.Name {
 	case tool.Status:
-		resp = out.Call(req.ID, func() (string, error) {
-			return handler.Status(d)
-		})
+		t, data, statusErr := handler.Status(d)
+		resp = out.StructuredResult(req.ID, t, data, statusErr)
 		d.Session.RecordContextLoaded()
 	case tool.Add:
 		resp = add(d, req.ID, params.Arguments)
@@ -74,9 +73,8 @@ func DispatchCall(
 		resp = complete(d, req.ID, params.Arguments)
 		d.Session.RecordContextWrite()
 	case tool.Drift:
-		resp = out.Call(req.ID, func() (string, error) {
-			return handler.Drift(d)
-		})
+		t, data, driftErr := handler.Drift(d)
+		resp = out.StructuredResult(req.ID, t, data, driftErr)
 		d.Session.RecordDriftCheck()
 	case tool.JournalSource:
 		resp = journalSource(d, req.ID, params.Arguments)
@@ -87,9 +85,8 @@ func DispatchCall(
 		resp = compact(d, req.ID, params.Arguments)
 		d.Session.RecordContextWrite()
 	case tool.Next:
-		resp = out.Call(req.ID, func() (string, error) {
-			return handler.Next(d)
-		})
+		t, data, nextErr := handler.Next(d)
+		resp = out.StructuredResult(req.ID, t, data, nextErr)
 	case tool.CheckTaskCompletion:
 		resp = checkTaskCompletion(d, req.ID, params.Arguments)
 	case tool.SessionEvent:
@@ -102,6 +99,8 @@ func DispatchCall(
 		resp = steeringGet(d, req.ID, params.Arguments)
 	case tool.Search:
 		resp = search(d, req.ID, params.Arguments)
+	case tool.HubSearch:
+		resp = hubSearch(d, req.ID, params.Arguments)
 	case tool.SessionStart:
 		resp = out.Call(req.ID, func() (string, error) {
 			return handler.SessionStartHooks(d)
@@ -0,0 +1,51 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package tool
+
+import (
+	"encoding/json"
+
+	coreSearch "github.com/ActiveMemory/ctx/internal/cli/connection/core/search"
+	"github.com/ActiveMemory/ctx/internal/config/cli"
+	"github.com/ActiveMemory/ctx/internal/config/mcp/field"
+	"github.com/ActiveMemory/ctx/internal/entity"
+	"github.com/ActiveMemory/ctx/internal/mcp/handler"
+	"github.com/ActiveMemory/ctx/internal/mcp/proto"
+	"github.com/ActiveMemory/ctx/internal/mcp/server/out"
+)
+
+// hubSearch extracts the optional query and filters and
+// delegates to [handler.HubSearch].
+//
+// Parameters:
+//   - d: runtime dependencies
+//   - id: JSON-RPC request ID
+//   - args: MCP tool arguments (query, type, origin, since,
+//     until, limit)
+//
+// Returns:
+//   - *proto.Response: hub matches or error
+func hubSearch(
+	d *entity.MCPDeps, id json.RawMessage,
+	args map[string]interface{},
+) *proto.Response {
+	query, _ := args[field.Query].(string)
+	var opts coreSearch.Opts
+	if typ, _ := args[cli.AttrType].(string); typ != "" {
+		opts.Types = []string{typ}
+	}
+	if origin, _ := args[field.Origin].(string); origin != "" {
+		opts.Origins = []string{origin}
+	}
+	opts.Since, _ = args[field.Since].(string)
+	opts.Until, _ = args[field.Until].(string)
+	if v, ok := args[field.Limit].(float64); ok && v > 0 {
+		opts.Limit = int(v)
+	}
+	t, err := handler.HubSearch(d, query, opts)
+	return out.ToolResult(id, t, err)
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
 	"github.com/ActiveMemory/ctx/internal/config/embed/text"
 	"github.com/ActiveMemory/ctx/internal/config/mcp/field"
+	cfgSearch "github.com/ActiveMemory/ctx/internal/config/search"
 	"github.com/ActiveMemory/ctx/internal/entity"
 	"github.com/ActiveMemory/ctx/internal/mcp/handler"
 	"github.com/ActiveMemory/ctx/internal/mcp/proto"
@@ -37,13 +38,15 @@ func steeringGet(
 	return out.ToolResult(id, t, err)
 }
 
-// search extracts the required query and delegates to
-// [handler.Search].
+// search extracts the required query and optional limit
+// and delegates to [handler.Search]. The limit defaults to
+// [cfgSearch.DefaultLimit] and is capped at
+// [cfgSearch.MaxLimit].
 //
 // Parameters:
 //   - d: runtime dependencies
 //   - id: JSON-RPC request ID
-//   - args: MCP tool arguments (query)
+//   - args: MCP tool arguments (query, limit)
 //
 // Returns:
 //   - *proto.Response: search results or error
@@ -57,8 +60,12 @@ func search(
 			id, desc.Text(text.DescKeyMCPErrQueryRequired),
 		)
 	}
-	t, err := handler.Search(d, query)
-	return out.ToolResult(id, t, err)
+	limit := cfgSearch.DefaultLimit
+	if v, ok := args[field.Limit].(float64); ok && v > 0 {
+		limit = min(int(v), cfgSearch.MaxLimit)
+	}
+	t, data, err := handler.Search(d, query, limit)
+	return out.StructuredResult(id, t, data, err)
 }
 
 // sessionEnd extracts the optional summary and delegates to
@@ -123,8 +123,8 @@ func journalSource(
 		}
 	}
 
-	t, recallErr := handler.Recall(d, limit, since)
-	return out.ToolResult(id, t, recallErr)
+	t, data, recallErr := handler.Recall(d, limit, since)
+	return out.StructuredResult(id, t, data, recallErr)
 }
 
 // watchUpdate extracts MCP args and delegates to
@@ -247,8 +247,8 @@ func TestToolsList(t *testing.T) {
 	if err := json.Unmarshal(raw, &result); err != nil {
 		t.Fatalf("unmarshal: %v", err)
 	}
-	if len(result.Tools) != 15 {
-		t.Errorf("tool count = %d, want 15", len(result.Tools))
+	if len(result.Tools) != 16 {
+		t.Errorf("tool count = %d, want 16", len(result.Tools))
 	}
 	names := make(map[string]bool)
 	for _, tool := range result.Tools {
@@ -772,6 +772,38 @@ func TestToolNext(t *testing.T) {
 	}
 }
 
+func TestToolNextSkipsBlocked(t *testing.T) {
+	srv, contextDir := newTestServer(t)
+
+	tasksContent := "# Tasks\n\n" +
+		"- [ ] Deploy #depends:build\n" +
+		"- [ ] Build #id:build #blocked-by:vendor\n" +
+		"- [ ] Write docs\n"
+	if err := os.WriteFile(
+		filepath.Join(contextDir, ctx.Task),
+		[]byte(tasksContent), 0o644,
+	); err != nil {
+		t.Fatalf("write tasks: %v", err)
+	}
+
+	resp := request(t, srv, "tools/call", proto.CallToolParams{
+		Name: "ctx_next",
+	})
+	if resp.Error != nil {
+		t.Fatalf("unexpected error: %v", resp.Error.Message)
+	}
+	raw, _ := json.Marshal(resp.Result)
+	var result proto.CallToolResult
+	if err := json.Unmarshal(raw, &result); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	text := result.Content[0].Text
+	if !strings.Contains(text, "Write docs") ||
+		!strings.Contains(text, "Skipped 2 pending task(s) waiting") {
+		t.Errorf("expected docs with 2 blocked skipped, got: %s", text)
+	}
+}
+
 func TestToolNextAllComplete(t *testing.T) {
 	srv, contextDir := newTestServer(t)
 
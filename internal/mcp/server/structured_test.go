@@ -0,0 +1,140 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/ActiveMemory/ctx/internal/mcp/proto"
+)
+
+// conforms checks a decoded JSON value against a schema
+// property: its JSON type, required members, and nested
+// members and items.
+func conforms(t *testing.T, where string, v interface{}, p proto.Property) {
+	t.Helper()
+	switch p.Type {
+	case "object":
+		obj, ok := v.(map[string]interface{})
+		if !ok {
+			t.Errorf("%s: got %T, want object", where, v)
+			return
+		}
+		for _, r := range p.Required {
+			if _, has := obj[r]; !has {
+				t.Errorf("%s: missing required %q", where, r)
+			}
+		}
+		for k, sub := range p.Properties {
+			if mv, has := obj[k]; has {
+				conforms(t, where+"."+k, mv, sub)
+			}
+		}
+	case "array":
+		arr, ok := v.([]interface{})
+		if !ok {
+			t.Errorf("%s: got %T, want array", where, v)
+			return
+		}
+		for _, item := range arr {
+			conforms(t, where+"[]", item, *p.Items)
+		}
+	case "string":
+		if _, ok := v.(string); !ok {
+			t.Errorf("%s: got %T, want string", where, v)
+		}
+	case "boolean":
+		if _, ok := v.(bool); !ok {
+			t.Errorf("%s: got %T, want boolean", where, v)
+		}
+	case "integer", "number":
+		n, ok := v.(float64)
+		if !ok {
+			t.Errorf("%s: got %T, want %s", where, v, p.Type)
+		} else if p.Type == "integer" && n != float64(int64(n)) {
+			t.Errorf("%s: %v is not an integer", where, n)
+		}
+	}
+}
+
+func TestToolsStructuredContent(t *testing.T) {
+	srv, _ := newTestServer(t)
+
+	resp := request(t, srv, "tools/list", nil)
+	raw, _ := json.Marshal(resp.Result)
+	var list proto.ToolListResult
+	if err := json.Unmarshal(raw, &list); err != nil {
+		t.Fatalf("unmarshal tools: %v", err)
+	}
+	schemas := make(map[string]*proto.InputSchema)
+	for _, tool := range list.Tools {
+		if tool.OutputSchema != nil {
+			schemas[tool.Name] = tool.OutputSchema
+		}
+	}
+
+	calls := map[string]map[string]interface{}{
+		"ctx_status": nil,
+		"ctx_drift":  nil,
+		"ctx_next":   nil,
+		"ctx_search": {"query": "Rule 1"},
+	}
+	for name, args := range calls {
+		t.Run(name, func(t *testing.T) {
+			schema := schemas[name]
+			if schema == nil {
+				t.Fatalf("%s declares no output schema", name)
+			}
+			resp := request(t, srv, "tools/call", proto.CallToolParams{
+				Name: name, Arguments: args,
+			})
+			raw, _ := json.Marshal(resp.Result)
+			var result struct {
+				Content           []proto.ToolContent `json:"content"`
+				StructuredContent interface{}         `json:"structuredContent"`
+				IsError           bool                `json:"isError"`
+			}
+			if err := json.Unmarshal(raw, &result); err != nil {
+				t.Fatalf("unmarshal: %v", err)
+			}
+			if result.IsError {
+				t.Fatalf("tool error: %s", result.Content[0].Text)
+			}
+			if len(result.Content) == 0 || result.Content[0].Text == "" {
+				t.Error("text content dropped")
+			}
+			conforms(t, name, result.StructuredContent, proto.Property{
+				Type:       schema.Type,
+				Properties: schema.Properties,
+				Required:   schema.Required,
+			})
+		})
+	}
+}
+
+func TestToolNextStructured(t *testing.T) {
+	srv, _ := newTestServer(t)
+	resp := request(t, srv, "tools/call", proto.CallToolParams{
+		Name: "ctx_next",
+	})
+	raw, _ := json.Marshal(resp.Result)
+	var result struct {
+		StructuredContent struct {
+			Found bool   `json:"found"`
+			Index int    `json:"index"`
+			Task  string `json:"task"`
+		} `json:"structuredContent"`
+	}
+	if err := json.Unmarshal(raw, &result); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	got := result.StructuredContent
+	if !got.Found || got.Index != 1 || got.Task != "Build MCP server" {
+		t.Errorf("structured next = %+v", got)
+	}
+}
@@ -0,0 +1,198 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ActiveMemory/ctx/internal/config/ctx"
+	"github.com/ActiveMemory/ctx/internal/journal/state"
+	"github.com/ActiveMemory/ctx/internal/mcp/proto"
+)
+
+// readResource reads uri and returns the content texts, or
+// the error message when the read fails.
+func readResource(t *testing.T, srv *Server, uri string) ([]string, string) {
+	t.Helper()
+	resp := request(t, srv, "resources/read",
+		proto.ReadResourceParams{URI: uri})
+	if resp.Error != nil {
+		return nil, resp.Error.Message
+	}
+	raw, _ := json.Marshal(resp.Result)
+	var result proto.ReadResourceResult
+	if err := json.Unmarshal(raw, &result); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	texts := make([]string, len(result.Contents))
+	for i, c := range result.Contents {
+		if c.URI != uri {
+			t.Errorf("content URI = %q, want %q", c.URI, uri)
+		}
+		texts[i] = c.Text
+	}
+	return texts, ""
+}
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestResourceTemplatesList(t *testing.T) {
+	srv, _ := newTestServer(t)
+	resp := request(t, srv, "resources/templates/list", nil)
+	if resp.Error != nil {
+		t.Fatalf("unexpected error: %v", resp.Error.Message)
+	}
+	raw, _ := json.Marshal(resp.Result)
+	var result proto.ResourceTemplateListResult
+	if err := json.Unmarshal(raw, &result); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	want := map[string]bool{
+		"ctx://decision/{timestamp}": true,
+		"ctx://learning/{timestamp}": true,
+		"ctx://theme/{kind}/{slug}":  true,
+		"ctx://journal/{session-id}": true,
+	}
+	if len(result.ResourceTemplates) != len(want) {
+		t.Fatalf("got %d templates, want %d",
+			len(result.ResourceTemplates), len(want))
+	}
+	for _, rt := range result.ResourceTemplates {
+		if !want[rt.URITemplate] {
+			t.Errorf("unexpected template %q", rt.URITemplate)
+		}
+		if rt.Description == "" {
+			t.Errorf("template %q has no description", rt.URITemplate)
+		}
+	}
+}
+
+func TestResourceTemplateEntries(t *testing.T) {
+	srv, contextDir := newTestServer(t)
+	writeFile(t, filepath.Join(contextDir, ctx.Decision),
+		"# Decisions\n\n"+
+			"## [2026-01-02-030405] Use Postgres\n\nBecause.\n\n"+
+			"## [2026-01-03-030405] Use Go\n\nSpeed.\n")
+	writeFile(t, filepath.Join(contextDir, "learnings", "testing.md"),
+		"# Testing\n\n## [2026-02-01-101010] Flaky clocks\n\nUse fakes.\n")
+
+	texts, errMsg := readResource(t, srv,
+		"ctx://decision/2026-01-03-030405")
+	if errMsg != "" {
+		t.Fatal(errMsg)
+	}
+	if len(texts) != 1 || !strings.HasPrefix(texts[0],
+		"## [2026-01-03-030405] Use Go") ||
+		strings.Contains(texts[0], "Postgres") {
+		t.Errorf("decision = %q", texts)
+	}
+
+	// Entries moved into theme files still resolve.
+	texts, errMsg = readResource(t, srv,
+		"ctx://learning/2026-02-01-101010")
+	if errMsg != "" || !strings.Contains(texts[0], "Use fakes.") {
+		t.Errorf("learning = %q, %q", texts, errMsg)
+	}
+
+	if _, errMsg = readResource(t, srv,
+		"ctx://decision/2099-01-01-000000"); errMsg == "" {
+		t.Error("missing decision resolved")
+	}
+}
+
+func TestResourceTemplateTheme(t *testing.T) {
+	srv, contextDir := newTestServer(t)
+	writeFile(t, filepath.Join(contextDir, "decisions", "storage.md"),
+		"# Storage\n")
+	writeFile(t, filepath.Join(contextDir, "secret.md"), "nope")
+
+	for _, uri := range []string{
+		"ctx://theme/decision/storage",
+		"ctx://theme/decision/storage.md",
+	} {
+		texts, errMsg := readResource(t, srv, uri)
+		if errMsg != "" || texts[0] != "# Storage\n" {
+			t.Errorf("%s = %q, %q", uri, texts, errMsg)
+		}
+	}
+	for _, uri := range []string{
+		"ctx://theme/decision/missing",
+		"ctx://theme/glossary/storage",
+		"ctx://theme/decision/../secret",
+		"ctx://theme/decision",
+	} {
+		if _, errMsg := readResource(t, srv, uri); errMsg == "" {
+			t.Errorf("%s resolved", uri)
+		}
+	}
+}
+
+func TestResourceTemplateJournal(t *testing.T) {
+	srv, contextDir := newTestServer(t)
+	journalDir := filepath.Join(contextDir, "journal")
+	base := "2026-03-01-fix-auth-abcd1234"
+	writeFile(t, filepath.Join(journalDir, base+".md"), "part one")
+	writeFile(t, filepath.Join(journalDir, base+"-p2.md"), "part two")
+	writeFile(t, filepath.Join(journalDir,
+		"2026-03-02-other-ffff0000.md"), "other")
+	st, loadErr := state.Load(journalDir)
+	if loadErr != nil {
+		t.Fatal(loadErr)
+	}
+	st.MarkImported(base + ".md")
+	st.MarkImported(base + "-p2.md")
+	st.MarkImported("2026-03-02-other-ffff0000.md")
+	st.MarkSource("abcd1234-5678-90ab-cdef-000000000000", "a.jsonl", 1, 1)
+	st.MarkSource("ffff0000-1111-2222-3333-444444444444", "o.jsonl", 1, 1)
+	st.MarkSource("ffff0000-9999-2222-3333-444444444444", "p.jsonl", 1, 1)
+	if err := st.Save(journalDir); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, id := range []string{
+		"abcd1234", "abcd1234-5678",
+		"abcd1234-5678-90ab-cdef-000000000000",
+	} {
+		texts, errMsg := readResource(t, srv, "ctx://journal/"+id)
+		if errMsg != "" || len(texts) != 2 ||
+			texts[0] != "part one" || texts[1] != "part two" {
+			t.Errorf("journal %s = %q, %q", id, texts, errMsg)
+		}
+	}
+	for _, id := range []string{
+		"abcd", "00000000", "abcd1234-5678-90ab-cdef-999999999999",
+	} {
+		if _, errMsg := readResource(t, srv,
+			"ctx://journal/"+id); errMsg == "" {
+			t.Errorf("journal %s resolved", id)
+		}
+	}
+
+	// Two sessions share the short ID: the prefix is
+	// ambiguous, the full ID resolves.
+	_, errMsg := readResource(t, srv, "ctx://journal/ffff0000")
+	if !strings.Contains(errMsg, "ambiguous") {
+		t.Errorf("shared prefix: error = %q, want ambiguous", errMsg)
+	}
+	texts, errMsg := readResource(t, srv,
+		"ctx://journal/ffff0000-1111-2222-3333-444444444444")
+	if errMsg != "" || len(texts) != 1 || texts[0] != "other" {
+		t.Errorf("full ID = %q, %q", texts, errMsg)
+	}
+}
@@ -8,6 +8,9 @@ package server
 
 import (
 	"io"
+	"net/http"
+	"sync"
+	"time"
 
 	"github.com/ActiveMemory/ctx/internal/entity"
 	"github.com/ActiveMemory/ctx/internal/mcp/proto"
@@ -41,3 +44,60 @@ type Server struct {
 	poller       *poll.Poller
 	resourceList proto.ResourceListResult // pre-built, immutable after init
 }
+
+// HTTPServer serves the MCP dispatch over the Streamable
+// HTTP transport to any number of concurrent clients.
+//
+// Resources, tools, and prompts are shared; each session
+// owns its [entity.MCPSession] and resource subscriptions,
+// and receives its notifications on its own event stream.
+//
+// Thread-safety: mu guards sessions and their streaming
+// flags and activity times. dispatchMu serializes dispatch across sessions,
+// keeping the single-threaded handler contract the stdio
+// server relies on.
+//
+// Fields:
+//   - contextDir: Path to the .context/ directory
+//   - tokenBudget: Token budget for every session
+//   - version: Binary version for server info response
+//   - token: Bearer token every request must carry
+//   - resourceList: Pre-built resource list (immutable)
+//   - idle: Session idle timeout (0 = never expire)
+//   - dispatchMu: Serializes handler dispatch
+//   - mu: Guards sessions
+//   - sessions: Open sessions by ID
+//   - done: Closed by Shutdown to end event streams
+//   - srv: Underlying HTTP server, set by Serve
+type HTTPServer struct {
+	contextDir   string
+	tokenBudget  int
+	version      string
+	token        string
+	resourceList proto.ResourceListResult
+	idle         time.Duration
+	dispatchMu   sync.Mutex
+	mu           sync.Mutex
+	sessions     map[string]*session
+	done         chan struct{}
+	srv          *http.Server
+}
+
+// session is one Streamable HTTP client.
+//
+// Fields:
+//   - deps: Runtime dependencies with this client's session
+//   - poller: This client's resource subscriptions
+//   - notes: Queued notifications for the event stream
+//   - ended: Closed when the client deletes the session
+//     or it expires
+//   - streaming: True while an event stream is open
+//   - lastActive: Time of the last request or stream close
+type session struct {
+	deps       *entity.MCPDeps
+	poller     *poll.Poller
+	notes      chan proto.Notification
+	ended      chan struct{}
+	streaming  bool
+	lastActive time.Time
+}
@@ -0,0 +1,93 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package mine
+
+import (
+	"fmt"
+	"strings"
+
+	cfgEntry "github.com/ActiveMemory/ctx/internal/config/entry"
+	cfgMine "github.com/ActiveMemory/ctx/internal/config/mine"
+	"github.com/ActiveMemory/ctx/internal/config/regex"
+	"github.com/ActiveMemory/ctx/internal/config/token"
+	cfgTrace "github.com/ActiveMemory/ctx/internal/config/trace"
+	"github.com/ActiveMemory/ctx/internal/i18n"
+)
+
+// Classify proposes at most one decision and one learning for a
+// commit. Merges and version bumps yield nothing. A decision comes
+// from a refactor/build type, a breaking-change marker, a Decision
+// trailer, or decision wording; a learning from a fix/perf/revert
+// type, a Learning or Lesson trailer, or lesson wording. A kind the
+// commit's ctx-context trailer already references is dropped: the
+// commit recorded that entry when it was made.
+//
+// Parameters:
+//   - c: the commit to classify
+//
+// Returns:
+//   - []Candidate: the proposed entries, decision first (nil when
+//     the commit carries no signal)
+func Classify(c Commit) []Candidate {
+	if regex.MineSkip.MatchString(c.Subject) {
+		return nil
+	}
+
+	title, cType := c.Subject, ""
+	breaking := false
+	if m := regex.MineConventional.FindStringSubmatch(c.Subject); m != nil {
+		cType, title = m[1], m[regex.MineConventionalDesc]
+		breaking = m[3] != ""
+	}
+
+	found := map[string]*finding{}
+	if kind, ok := cfgMine.TypeKind[cType]; ok {
+		note(found, kind, fmt.Sprintf(cfgMine.SignalType, cType), "")
+	}
+	if breaking {
+		note(found, cfgEntry.Decision, cfgMine.SignalBreaking, "")
+	}
+
+	captured := map[string]bool{}
+	for _, t := range c.Trailers {
+		signal := fmt.Sprintf(cfgMine.SignalTrailer, t.Key)
+		switch i18n.Fold(t.Key) {
+		case cfgMine.TrailerDecision:
+			note(found, cfgEntry.Decision, signal, t.Value)
+		case cfgMine.TrailerLearning, cfgMine.TrailerLesson:
+			note(found, cfgEntry.Learning, signal, t.Value)
+		case cfgMine.TrailerBreaking:
+			note(found, cfgEntry.Decision, cfgMine.SignalBreaking, "")
+		case cfgTrace.TrailerKey:
+			for _, ref := range strings.Split(t.Value, token.Comma) {
+				kind, _, _ := strings.Cut(
+					strings.TrimSpace(ref), token.Colon,
+				)
+				captured[kind] = true
+			}
+		}
+	}
+
+	if !cfgMine.QuietTypes[cType] {
+		text := c.Subject + token.NewlineLF + c.Body
+		words(found, cfgEntry.Decision, regex.MineDecisionWord, text)
+		words(found, cfgEntry.Learning, regex.MineLearningWord, text)
+	}
+
+	var out []Candidate
+	for _, kind := range []string{cfgEntry.Decision, cfgEntry.Learning} {
+		f, ok := found[kind]
+		if !ok || captured[kind] {
+			continue
+		}
+		if f.title == "" {
+			f.title = title
+		}
+		out = append(out, candidate(c, kind, f))
+	}
+	return out
+}
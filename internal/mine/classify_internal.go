@@ -0,0 +1,120 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package mine
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	"github.com/ActiveMemory/ctx/internal/config/embed/text"
+	cfgEntry "github.com/ActiveMemory/ctx/internal/config/entry"
+	cfgMine "github.com/ActiveMemory/ctx/internal/config/mine"
+	"github.com/ActiveMemory/ctx/internal/config/token"
+	"github.com/ActiveMemory/ctx/internal/i18n"
+)
+
+// note records a signal for kind, creating the finding on first
+// use. A non-empty title (from a classifying trailer) replaces the
+// subject-derived title; the first one wins.
+//
+// Parameters:
+//   - found: findings by kind, updated in place
+//   - kind: the entry kind the signal points at
+//   - signal: the signal label
+//   - title: an explicit title, or empty
+func note(found map[string]*finding, kind, signal, title string) {
+	f, ok := found[kind]
+	if !ok {
+		f = &finding{}
+		found[kind] = f
+	}
+	if f.title == "" {
+		f.title = strings.TrimSpace(title)
+	}
+	if !slices.Contains(f.signals, signal) {
+		f.signals = append(f.signals, signal)
+	}
+}
+
+// words records one signal per distinct match of re in s.
+//
+// Parameters:
+//   - found: findings by kind, updated in place
+//   - kind: the entry kind the wording points at
+//   - re: the signal-word pattern
+//   - s: the text to scan
+func words(
+	found map[string]*finding, kind string, re *regexp.Regexp, s string,
+) {
+	for _, w := range re.FindAllString(s, -1) {
+		note(found, kind, fmt.Sprintf(cfgMine.SignalWord, i18n.Fold(w)), "")
+	}
+}
+
+// candidate fills a Candidate for one finding. The first body
+// paragraph, when present, becomes the rationale or lesson; the
+// remaining fields cite the source commit.
+//
+// Parameters:
+//   - c: the source commit
+//   - kind: the entry kind
+//   - f: the finding for kind
+//
+// Returns:
+//   - Candidate: the proposed entry
+func candidate(c Commit, kind string, f *finding) Candidate {
+	cand := Candidate{
+		ID:    fmt.Sprintf(cfgMine.IDFormat, cfgMine.IDPrefix[kind], c.Short),
+		Kind:  kind,
+		Title: f.title,
+		Context: fmt.Sprintf(
+			desc.Text(text.DescKeyMineContext),
+			c.Short, c.Date, c.Author, c.Subject,
+		),
+		Commit:  c.Short,
+		Date:    c.Date,
+		Author:  c.Author,
+		Signals: f.signals,
+	}
+	summary := firstParagraph(c.Body)
+	if kind == cfgEntry.Decision {
+		cand.Rationale = summary
+		if cand.Rationale == "" {
+			cand.Rationale = fmt.Sprintf(
+				desc.Text(text.DescKeyMineRationale), c.Short,
+			)
+		}
+		cand.Consequence = fmt.Sprintf(
+			desc.Text(text.DescKeyMineConsequence), c.Short,
+		)
+		return cand
+	}
+	cand.Lesson = summary
+	if cand.Lesson == "" {
+		cand.Lesson = f.title
+	}
+	cand.Application = fmt.Sprintf(
+		desc.Text(text.DescKeyMineApplication), c.Short,
+	)
+	return cand
+}
+
+// firstParagraph joins the lines of the first paragraph of body
+// into one line.
+//
+// Parameters:
+//   - body: a commit body
+//
+// Returns:
+//   - string: the first paragraph on one line (empty for no body)
+func firstParagraph(body string) string {
+	para, _, _ := strings.Cut(strings.TrimSpace(body), token.DoubleNewline)
+	return strings.Join(strings.Fields(para), token.Space)
+}
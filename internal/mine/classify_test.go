@@ -0,0 +1,132 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package mine_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/ActiveMemory/ctx/internal/mine"
+)
+
+func TestClassify(t *testing.T) {
+	tests := []struct {
+		name   string
+		commit mine.Commit
+		want   []string
+		title  string
+	}{
+		{
+			name:   "fix is a learning",
+			commit: mine.Commit{Short: "abc1234", Subject: "fix(io): close files"},
+			want:   []string{"l-abc1234"},
+			title:  "close files",
+		},
+		{
+			name:   "refactor is a decision",
+			commit: mine.Commit{Short: "abc1234", Subject: "refactor: split parser"},
+			want:   []string{"d-abc1234"},
+			title:  "split parser",
+		},
+		{
+			name: "feat needs a signal",
+			commit: mine.Commit{
+				Short: "abc1234", Subject: "feat: add export command",
+			},
+		},
+		{
+			name: "signal words on an untyped subject",
+			commit: mine.Commit{
+				Short:   "abc1234",
+				Subject: "Use sqlite instead of bolt",
+				Body:    "The root cause was lock contention.",
+			},
+			want:  []string{"d-abc1234", "l-abc1234"},
+			title: "Use sqlite instead of bolt",
+		},
+		{
+			name: "quiet types ignore wording",
+			commit: mine.Commit{
+				Short: "abc1234", Subject: "docs: describe the race condition",
+			},
+		},
+		{
+			name: "trailer names the decision",
+			commit: mine.Commit{
+				Short:   "abc1234",
+				Subject: "chore: tidy",
+				Trailers: []mine.Trailer{
+					{Key: "Decision", Value: "Keep state in JSON"},
+				},
+			},
+			want:  []string{"d-abc1234"},
+			title: "Keep state in JSON",
+		},
+		{
+			name:   "breaking marker is a decision",
+			commit: mine.Commit{Short: "abc1234", Subject: "feat!: drop v1 API"},
+			want:   []string{"d-abc1234"},
+			title:  "drop v1 API",
+		},
+		{
+			name: "ctx-context trailer suppresses the captured kind",
+			commit: mine.Commit{
+				Short:   "abc1234",
+				Subject: "fix: guard nil map",
+				Trailers: []mine.Trailer{
+					{Key: "ctx-context", Value: "learning:4, task:2"},
+				},
+			},
+		},
+		{
+			name:   "merges are skipped",
+			commit: mine.Commit{Short: "abc1234", Subject: "Merge branch 'fix/x'"},
+		},
+		{
+			name:   "version bumps are skipped",
+			commit: mine.Commit{Short: "abc1234", Subject: "chore(release): v1.2.0"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := mine.Classify(tt.commit)
+			var ids []string
+			for _, c := range got {
+				ids = append(ids, c.ID)
+			}
+			if !reflect.DeepEqual(ids, tt.want) {
+				t.Fatalf("ids = %v, want %v", ids, tt.want)
+			}
+			if len(got) > 0 && got[0].Title != tt.title {
+				t.Errorf("title = %q, want %q", got[0].Title, tt.title)
+			}
+		})
+	}
+}
+
+func TestClassifyFillsEntryFields(t *testing.T) {
+	got := mine.Classify(mine.Commit{
+		Short:   "abc1234",
+		Date:    "2026-01-02",
+		Author:  "Dev",
+		Subject: "fix: retry on EINTR",
+		Body:    "Reads were interrupted by signals\nunder load.",
+	})
+	if len(got) != 1 {
+		t.Fatalf("got %d candidates, want 1", len(got))
+	}
+	c := got[0]
+	if c.Lesson != "Reads were interrupted by signals under load." {
+		t.Errorf("lesson = %q", c.Lesson)
+	}
+	if c.Context == "" || c.Application == "" {
+		t.Errorf("context/application not filled: %+v", c)
+	}
+	if c.Rationale != "" || c.Consequence != "" {
+		t.Errorf("decision fields set on a learning: %+v", c)
+	}
+}
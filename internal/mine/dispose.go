@@ -0,0 +1,76 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package mine
+
+import (
+	cfgMine "github.com/ActiveMemory/ctx/internal/config/mine"
+	cfgQueue "github.com/ActiveMemory/ctx/internal/config/queue"
+	"github.com/ActiveMemory/ctx/internal/entity"
+)
+
+// Params builds the entry parameters for an accepted candidate. The
+// session provenance is "mine" and the commit provenance is the
+// source commit, so the entry stays traceable to its origin.
+//
+// Parameters:
+//   - c: the accepted candidate
+//   - branch: the current branch (empty falls back to "mine")
+//   - contextDir: the context directory to write into
+//
+// Returns:
+//   - entity.EntryParams: parameters for entry.ValidateAndWrite
+func Params(c Candidate, branch, contextDir string) entity.EntryParams {
+	if branch == "" {
+		branch = cfgMine.Provenance
+	}
+	return entity.EntryParams{
+		Type:        c.Kind,
+		Content:     c.Title,
+		Context:     c.Context,
+		Rationale:   c.Rationale,
+		Consequence: c.Consequence,
+		Lesson:      c.Lesson,
+		Application: c.Application,
+		SessionID:   cfgMine.Provenance,
+		Branch:      branch,
+		Commit:      c.Commit,
+		ContextDir:  contextDir,
+	}
+}
+
+// Init sets the current schema version and makes the maps
+// non-nil, so a missing or hand-trimmed ledger is usable.
+func (l *Ledger) Init() {
+	l.Version = cfgMine.LedgerVersion
+	if l.Commits == nil {
+		l.Commits = map[string]string{}
+	}
+	if l.Dispositions == nil {
+		l.Dispositions = map[string]string{}
+	}
+}
+
+// Dispose records a disposition for candidate id. Accepting counts
+// toward the persisted total.
+//
+// Parameters:
+//   - id: the disposed candidate's ID
+//   - disposition: accepted or rejected
+func (l *Ledger) Dispose(id, disposition string) {
+	l.Dispositions[id] = disposition
+	if disposition == cfgQueue.Accepted {
+		l.Stats.TotalPersisted++
+	}
+}
+
+// Key returns the candidate ID, which accept and reject select by.
+//
+// Returns:
+//   - string: the candidate ID
+func (c Candidate) Key() string {
+	return c.ID
+}
@@ -0,0 +1,19 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package mine is the deterministic engine behind ctx mine: it reads
+// git history, classifies each commit by conventional-commit type,
+// classifying trailers (including the ctx-context trailer), and
+// signal words, and turns the result into candidate DECISIONS.md and
+// LEARNINGS.md entries.
+//
+// No model is involved. Candidates land in a review file under
+// .context/state/; nothing reaches a context file until a human
+// accepts it, at which point it goes through entry.ValidateAndWrite
+// like any other entry. A ledger beside the review file records the
+// commits already mined and every disposition, so re-runs never
+// re-propose. See specs/mine.md.
+package mine
@@ -0,0 +1,45 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package mine
+
+import (
+	"fmt"
+
+	cfgGit "github.com/ActiveMemory/ctx/internal/config/git"
+	errMine "github.com/ActiveMemory/ctx/internal/err/mine"
+	execGit "github.com/ActiveMemory/ctx/internal/exec/git"
+)
+
+// Log reads commits from git log, newest first.
+//
+// Parameters:
+//   - since: git date expression for the oldest commit (empty for
+//     no bound)
+//   - until: git date expression for the newest commit (empty for
+//     no bound)
+//   - limit: maximum commits to read (zero or less for no limit)
+//
+// Returns:
+//   - []Commit: the parsed commits
+//   - error: non-nil when git log fails
+func Log(since, until string, limit int) ([]Commit, error) {
+	args := []string{cfgGit.Log, cfgGit.FormatRecord}
+	if since != "" {
+		args = append(args, cfgGit.FlagSince, since)
+	}
+	if until != "" {
+		args = append(args, cfgGit.FlagUntil, until)
+	}
+	if limit > 0 {
+		args = append(args, fmt.Sprintf(cfgGit.FlagLastN, limit))
+	}
+	out, runErr := execGit.Run(args...)
+	if runErr != nil {
+		return nil, errMine.Log(runErr)
+	}
+	return parseLog(string(out)), nil
+}
@@ -0,0 +1,83 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package mine
+
+import (
+	"strings"
+
+	cfgMine "github.com/ActiveMemory/ctx/internal/config/mine"
+	"github.com/ActiveMemory/ctx/internal/config/regex"
+	"github.com/ActiveMemory/ctx/internal/config/token"
+)
+
+// parseLog splits git log output in the FormatRecord layout into
+// commits. Malformed records are skipped.
+//
+// Parameters:
+//   - out: raw git log output
+//
+// Returns:
+//   - []Commit: the parsed commits, in log order
+func parseLog(out string) []Commit {
+	var commits []Commit
+	for _, record := range strings.Split(out, cfgMine.RecordSep) {
+		fields := strings.SplitN(
+			strings.TrimSpace(record), cfgMine.FieldSep,
+			cfgMine.RecordFields,
+		)
+		if len(fields) != cfgMine.RecordFields {
+			continue
+		}
+		subject, body, trailers := splitMessage(
+			fields[cfgMine.FieldMessage],
+		)
+		commits = append(commits, Commit{
+			Hash:     fields[cfgMine.FieldHash],
+			Short:    fields[cfgMine.FieldShort],
+			Date:     fields[cfgMine.FieldDate],
+			Author:   fields[cfgMine.FieldAuthor],
+			Subject:  subject,
+			Body:     body,
+			Trailers: trailers,
+		})
+	}
+	return commits
+}
+
+// splitMessage splits a raw commit message into its subject, body,
+// and trailer block. The last paragraph is the trailer block only
+// when every line in it is a "Key: value" trailer.
+//
+// Parameters:
+//   - msg: the raw commit message
+//
+// Returns:
+//   - string: the subject line
+//   - string: the body, without subject or trailers
+//   - []Trailer: the parsed trailers (nil when there are none)
+func splitMessage(msg string) (string, string, []Trailer) {
+	msg = strings.TrimSpace(msg)
+	subject, rest, _ := strings.Cut(msg, token.NewlineLF)
+	rest = strings.TrimSpace(rest)
+	if rest == "" {
+		return strings.TrimSpace(subject), "", nil
+	}
+
+	body, last := "", rest
+	if i := strings.LastIndex(rest, token.DoubleNewline); i >= 0 {
+		body, last = rest[:i], rest[i+len(token.DoubleNewline):]
+	}
+	var trailers []Trailer
+	for _, line := range strings.Split(last, token.NewlineLF) {
+		m := regex.MineTrailer.FindStringSubmatch(strings.TrimSpace(line))
+		if m == nil {
+			return strings.TrimSpace(subject), rest, nil
+		}
+		trailers = append(trailers, Trailer{Key: m[1], Value: m[2]})
+	}
+	return strings.TrimSpace(subject), strings.TrimSpace(body), trailers
+}
@@ -0,0 +1,32 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package mine
+
+import (
+	"testing"
+)
+
+func TestParseLog(t *testing.T) {
+	out := "h1\x1fs1\x1f2026-01-02\x1fDev\x1ffix: a\n\nWhy it broke.\n\n" +
+		"Decision: Keep it\nctx-context: task:1\n\x1e\n" +
+		"h2\x1fs2\x1f2026-01-03\x1fDev\x1fdocs: b\n\nKey: not a trailer\n" +
+		"because this line is prose\n\x1e\n"
+	commits := parseLog(out)
+	if len(commits) != 2 {
+		t.Fatalf("got %d commits, want 2", len(commits))
+	}
+	c := commits[0]
+	if c.Subject != "fix: a" || c.Body != "Why it broke." {
+		t.Errorf("subject/body = %q/%q", c.Subject, c.Body)
+	}
+	if len(c.Trailers) != 2 || c.Trailers[0].Value != "Keep it" {
+		t.Errorf("trailers = %+v", c.Trailers)
+	}
+	if len(commits[1].Trailers) != 0 || commits[1].Body == "" {
+		t.Errorf("prose paragraph parsed as trailers: %+v", commits[1])
+	}
+}
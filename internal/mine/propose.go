@@ -0,0 +1,96 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package mine
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/ActiveMemory/ctx/internal/config/ctx"
+	errFs "github.com/ActiveMemory/ctx/internal/err/fs"
+	"github.com/ActiveMemory/ctx/internal/heading"
+	ctxIo "github.com/ActiveMemory/ctx/internal/io"
+)
+
+// Propose classifies commits and returns the candidates not already
+// known. A commit already in the ledger is skipped unless force is
+// set; every commit read is recorded in the ledger as mined on
+// today. A candidate is dropped when it was disposed before, is
+// still pending review, or its title already heads an entry in the
+// context files.
+//
+// Parameters:
+//   - commits: the commits to mine
+//   - ledger: the dedup ledger, updated in place
+//   - pending: the candidates already awaiting review
+//   - known: folded titles of existing entries (see KnownTitles)
+//   - force: re-mine commits the ledger already records
+//   - today: the date recorded for newly mined commits
+//
+// Returns:
+//   - int: the number of commits mined
+//   - []Candidate: the new candidates, in commit order
+func Propose(
+	commits []Commit, ledger *Ledger, pending []Candidate,
+	known map[string]bool, force bool, today string,
+) (int, []Candidate) {
+	seen := make(map[string]bool, len(pending))
+	for _, p := range pending {
+		seen[p.ID] = true
+		known[titleKey(p.Title)] = true
+	}
+
+	mined := 0
+	var fresh []Candidate
+	for _, c := range commits {
+		if _, done := ledger.Commits[c.Hash]; done && !force {
+			continue
+		}
+		mined++
+		ledger.Commits[c.Hash] = today
+		for _, cand := range Classify(c) {
+			_, disposed := ledger.Dispositions[cand.ID]
+			key := titleKey(cand.Title)
+			if disposed || seen[cand.ID] || known[key] {
+				continue
+			}
+			seen[cand.ID] = true
+			known[key] = true
+			fresh = append(fresh, cand)
+		}
+	}
+	ledger.Stats.TotalCommitsMined += mined
+	ledger.Stats.TotalFindings += len(fresh)
+	return mined, fresh
+}
+
+// KnownTitles collects the folded titles of the entries already in
+// DECISIONS.md and LEARNINGS.md. A missing file contributes nothing.
+//
+// Parameters:
+//   - contextDir: the context directory
+//
+// Returns:
+//   - map[string]bool: folded entry titles
+//   - error: non-nil when a present file cannot be read
+func KnownTitles(contextDir string) (map[string]bool, error) {
+	known := map[string]bool{}
+	for _, name := range []string{ctx.Decision, ctx.Learning} {
+		path := filepath.Join(contextDir, name)
+		data, readErr := ctxIo.SafeReadUserFile(path)
+		if os.IsNotExist(readErr) {
+			continue
+		}
+		if readErr != nil {
+			return nil, errFs.FileRead(path, readErr)
+		}
+		for _, e := range heading.ParseHeaders(string(data)) {
+			known[titleKey(e.Title)] = true
+		}
+	}
+	return known, nil
+}
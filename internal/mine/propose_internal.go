@@ -0,0 +1,26 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package mine
+
+import (
+	"strings"
+
+	"github.com/ActiveMemory/ctx/internal/config/token"
+	"github.com/ActiveMemory/ctx/internal/i18n"
+)
+
+// titleKey normalizes a title for duplicate detection: folded case
+// and collapsed whitespace.
+//
+// Parameters:
+//   - title: an entry or candidate title
+//
+// Returns:
+//   - string: the comparison key
+func titleKey(title string) string {
+	return i18n.Fold(strings.Join(strings.Fields(title), token.Space))
+}
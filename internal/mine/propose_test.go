@@ -0,0 +1,110 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package mine_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	cfgQueue "github.com/ActiveMemory/ctx/internal/config/queue"
+	"github.com/ActiveMemory/ctx/internal/mine"
+	"github.com/ActiveMemory/ctx/internal/queue"
+)
+
+func TestProposeDedup(t *testing.T) {
+	commits := []mine.Commit{
+		{Hash: "h1", Short: "h1", Subject: "fix: close files"},
+		{Hash: "h2", Short: "h2", Subject: "fix: retry reads"},
+		{Hash: "h3", Short: "h3", Subject: "fix: Close  Files"},
+		{Hash: "h4", Short: "h4", Subject: "fix: known lesson"},
+	}
+	ledger := &mine.Ledger{}
+	if loadErr := queue.LoadLedger(
+		mine.Queue, t.TempDir(), ledger,
+	); loadErr != nil {
+		t.Fatal(loadErr)
+	}
+	ledger.Dispositions["l-h2"] = cfgQueue.Rejected
+	known := map[string]bool{"known lesson": true}
+
+	mined, fresh := mine.Propose(
+		commits, ledger, nil, known, false, "2026-01-02",
+	)
+	if mined != 4 {
+		t.Errorf("mined = %d, want 4", mined)
+	}
+	if len(fresh) != 1 || fresh[0].ID != "l-h1" {
+		t.Fatalf("fresh = %+v, want only l-h1", fresh)
+	}
+
+	mined, fresh = mine.Propose(
+		commits, ledger, fresh, map[string]bool{}, false, "2026-01-03",
+	)
+	if mined != 0 || len(fresh) != 0 {
+		t.Errorf("re-run mined %d, proposed %d; want 0, 0", mined, len(fresh))
+	}
+
+	_, fresh = mine.Propose(
+		commits, ledger, nil, map[string]bool{}, true, "2026-01-03",
+	)
+	if len(fresh) != 2 {
+		t.Errorf("forced re-run proposed %d, want 2 (l-h2 stays rejected)",
+			len(fresh))
+	}
+}
+
+func TestStoreRoundTrip(t *testing.T) {
+	ctxDir := t.TempDir()
+	ledger := &mine.Ledger{}
+	if loadErr := queue.LoadLedger(mine.Queue, ctxDir, ledger); loadErr != nil {
+		t.Fatal(loadErr)
+	}
+	pending := []mine.Candidate{{ID: "d-h1"}, {ID: "l-h2"}}
+	pending = queue.Dispose(ledger, pending, "d-h1", cfgQueue.Accepted)
+	if saveErr := queue.SaveReview(mine.Queue, ctxDir, pending); saveErr != nil {
+		t.Fatal(saveErr)
+	}
+	if saveErr := queue.SaveLedger(mine.Queue, ctxDir, ledger); saveErr != nil {
+		t.Fatal(saveErr)
+	}
+
+	gotLedger := &mine.Ledger{}
+	if ledgerErr := queue.LoadLedger(
+		mine.Queue, ctxDir, gotLedger,
+	); ledgerErr != nil {
+		t.Fatal(ledgerErr)
+	}
+	if gotLedger.Dispositions["d-h1"] != cfgQueue.Accepted ||
+		gotLedger.Stats.TotalPersisted != 1 {
+		t.Errorf("ledger = %+v", gotLedger)
+	}
+	gotReview, reviewErr := queue.LoadReview[mine.Candidate](mine.Queue, ctxDir)
+	if reviewErr != nil {
+		t.Fatal(reviewErr)
+	}
+	if len(gotReview) != 1 || gotReview[0].ID != "l-h2" {
+		t.Errorf("review = %+v, want only l-h2", gotReview)
+	}
+}
+
+func TestKnownTitles(t *testing.T) {
+	ctxDir := t.TempDir()
+	content := "# Decisions\n\n## [2026-01-02-120000] Use JSON state\n"
+	if writeErr := os.WriteFile(
+		filepath.Join(ctxDir, "DECISIONS.md"), []byte(content), 0o600,
+	); writeErr != nil {
+		t.Fatal(writeErr)
+	}
+	known, knownErr := mine.KnownTitles(ctxDir)
+	if knownErr != nil {
+		t.Fatal(knownErr)
+	}
+	if !known["use json state"] {
+		t.Errorf("known = %v, want the decision title", known)
+	}
+}
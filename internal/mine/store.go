@@ -0,0 +1,21 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package mine
+
+import (
+	cfgMine "github.com/ActiveMemory/ctx/internal/config/mine"
+	"github.com/ActiveMemory/ctx/internal/entity"
+)
+
+// Queue is the ctx mine review queue: mine-review.json and
+// mine-ledger.json under .context/state/, read and written
+// through the queue package.
+var Queue = entity.ReviewQueue{
+	Name:   cfgMine.Queue,
+	Review: cfgMine.FileReview,
+	Ledger: cfgMine.FileLedger,
+}
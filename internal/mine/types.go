@@ -0,0 +1,102 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package mine
+
+// Trailer is one "Key: value" line from a commit's trailer block.
+type Trailer struct {
+	// Key is the trailer key as written (e.g. "Decision").
+	Key string
+	// Value is the trailer value.
+	Value string
+}
+
+// Commit is one commit read from git log, split into the parts the
+// classifier looks at.
+type Commit struct {
+	// Hash is the full commit hash.
+	Hash string
+	// Short is the abbreviated commit hash.
+	Short string
+	// Date is the author date (YYYY-MM-DD).
+	Date string
+	// Author is the author name.
+	Author string
+	// Subject is the first line of the message.
+	Subject string
+	// Body is the message after the subject, without the trailer
+	// block.
+	Body string
+	// Trailers is the parsed trailer block, in message order.
+	Trailers []Trailer
+}
+
+// Candidate is one proposed DECISIONS.md or LEARNINGS.md entry,
+// persisted in the review file until it is accepted or rejected.
+// The entry fields may be edited in the review file before accept.
+type Candidate struct {
+	// ID is the stable identifier used by accept and reject: kind
+	// prefix and short hash (e.g. "d-1a2b3c4").
+	ID string `json:"id"`
+	// Kind is the entry type, decision or learning.
+	Kind string `json:"kind"`
+	// Title is the entry title.
+	Title string `json:"title"`
+	// Context is the entry context, citing the source commit.
+	Context string `json:"context"`
+	// Rationale is the decision rationale (decisions only).
+	Rationale string `json:"rationale,omitempty"`
+	// Consequence is the decision consequence (decisions only).
+	Consequence string `json:"consequence,omitempty"`
+	// Lesson is the learning's lesson (learnings only).
+	Lesson string `json:"lesson,omitempty"`
+	// Application is the learning's application (learnings only).
+	Application string `json:"application,omitempty"`
+	// Commit is the short hash of the source commit.
+	Commit string `json:"commit"`
+	// Date is the source commit's author date.
+	Date string `json:"date"`
+	// Author is the source commit's author.
+	Author string `json:"author"`
+	// Signals explains why the commit was proposed (e.g.
+	// "type:fix", "word:root cause").
+	Signals []string `json:"signals"`
+}
+
+// Stats are the ledger's running totals.
+type Stats struct {
+	// TotalCommitsMined counts commits read across all runs.
+	TotalCommitsMined int `json:"total_commits_mined"`
+	// TotalFindings counts candidates proposed across all runs.
+	TotalFindings int `json:"total_findings"`
+	// TotalPersisted counts candidates accepted into context files.
+	TotalPersisted int `json:"total_persisted"`
+}
+
+// Ledger is the dedup record persisted in mine-ledger.json.
+type Ledger struct {
+	// Version is the ledger schema version.
+	Version int `json:"version"`
+	// LastMined is the RFC 3339 time of the last run.
+	LastMined string `json:"last_mined,omitempty"`
+	// Stats are the running totals.
+	Stats Stats `json:"stats"`
+	// Commits maps each mined commit hash to the date it was mined.
+	Commits map[string]string `json:"commits"`
+	// Dispositions maps each reviewed candidate ID to accepted or
+	// rejected; a disposed candidate is never re-proposed, even
+	// under --force.
+	Dispositions map[string]string `json:"dispositions,omitempty"`
+}
+
+// finding accumulates the signals for one kind while a commit is
+// classified.
+type finding struct {
+	// title is an explicit title from a classifying trailer.
+	title string
+	// signals are the signal labels, in discovery order.
+	signals []string
+}
@@ -0,0 +1,17 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package queue is the review queue shared by ctx mine and ctx
+// align: a review file of pending candidates and a ledger beside
+// it, both JSON under .context/state/, plus the accept/reject
+// selection and disposition over the pending candidates.
+//
+// The queue is generic over the candidate type and knows nothing of
+// either command's files: callers describe their queue with an
+// [entity.ReviewQueue] and supply their own ledger type through
+// [entity.ReviewLedger]. A missing file reads as empty; writes are
+// atomic and owner-only.
+package queue
@@ -0,0 +1,163 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package queue
+
+import (
+	"slices"
+
+	"github.com/ActiveMemory/ctx/internal/entity"
+	errQueue "github.com/ActiveMemory/ctx/internal/err/queue"
+)
+
+// ReviewPath returns the location of a queue's review file.
+//
+// Parameters:
+//   - q: the queue
+//   - contextDir: the context directory
+//
+// Returns:
+//   - string: .context/state/<review file>
+func ReviewPath(q entity.ReviewQueue, contextDir string) string {
+	return path(contextDir, q.Review)
+}
+
+// LoadLedger reads a queue's ledger into l. A missing file is not
+// an error: l stays empty (the first run has proposed nothing).
+// Either way l is initialized before returning.
+//
+// Parameters:
+//   - q: the queue
+//   - contextDir: the context directory
+//   - l: the ledger to fill, a pointer
+//
+// Returns:
+//   - error: non-nil on a read failure other than not-exist, or on a
+//     JSON decode failure
+func LoadLedger(
+	q entity.ReviewQueue, contextDir string, l entity.ReviewLedger,
+) error {
+	if loadErr := load(q.Name, path(contextDir, q.Ledger), l); loadErr != nil {
+		return loadErr
+	}
+	l.Init()
+	return nil
+}
+
+// SaveLedger writes a queue's ledger at the current schema version.
+//
+// Parameters:
+//   - q: the queue
+//   - contextDir: the context directory
+//   - l: the ledger to persist
+//
+// Returns:
+//   - error: non-nil on directory creation, encode, or write failure
+func SaveLedger(
+	q entity.ReviewQueue, contextDir string, l entity.ReviewLedger,
+) error {
+	l.Init()
+	return save(q.Name, path(contextDir, q.Ledger), l)
+}
+
+// LoadReview reads the pending candidates from a queue's review
+// file. A missing file yields none.
+//
+// Parameters:
+//   - q: the queue
+//   - contextDir: the context directory
+//
+// Returns:
+//   - []C: the pending candidates
+//   - error: non-nil on a read failure other than not-exist, or on a
+//     JSON decode failure
+func LoadReview[C entity.ReviewCandidate](
+	q entity.ReviewQueue, contextDir string,
+) ([]C, error) {
+	var pending []C
+	if loadErr := load(
+		q.Name, ReviewPath(q, contextDir), &pending,
+	); loadErr != nil {
+		return nil, loadErr
+	}
+	return pending, nil
+}
+
+// SaveReview writes the pending candidates to a queue's review
+// file; an empty queue is written as [] rather than null.
+//
+// Parameters:
+//   - q: the queue
+//   - contextDir: the context directory
+//   - pending: the candidates awaiting review
+//
+// Returns:
+//   - error: non-nil on directory creation, encode, or write failure
+func SaveReview[C entity.ReviewCandidate](
+	q entity.ReviewQueue, contextDir string, pending []C,
+) error {
+	if pending == nil {
+		pending = []C{}
+	}
+	return save(q.Name, ReviewPath(q, contextDir), pending)
+}
+
+// Select returns the pending candidates named by ids, in the order
+// given, or all of them.
+//
+// Parameters:
+//   - q: the queue, for error messages
+//   - pending: the candidates awaiting review
+//   - ids: the candidate IDs to select
+//   - all: select every pending candidate
+//
+// Returns:
+//   - []C: the selected candidates
+//   - error: non-nil when neither ids nor all is given, or when an
+//     ID is not pending
+func Select[C entity.ReviewCandidate](
+	q entity.ReviewQueue, pending []C, ids []string, all bool,
+) ([]C, error) {
+	if len(ids) == 0 && !all {
+		return nil, errQueue.NoSelection(q.Name)
+	}
+	if all {
+		return slices.Clone(pending), nil
+	}
+	byID := make(map[string]C, len(pending))
+	for _, c := range pending {
+		byID[c.Key()] = c
+	}
+	selected := make([]C, 0, len(ids))
+	for _, id := range ids {
+		c, ok := byID[id]
+		if !ok {
+			return nil, errQueue.NotFound(q.Name, id)
+		}
+		selected = append(selected, c)
+	}
+	return selected, nil
+}
+
+// Dispose records a disposition for candidate id in the ledger and
+// returns pending without it.
+//
+// Parameters:
+//   - l: the ledger, updated in place
+//   - pending: the candidates awaiting review, modified in place
+//   - id: the disposed candidate's ID
+//   - disposition: accepted or rejected
+//
+// Returns:
+//   - []C: pending without the disposed candidate
+func Dispose[C entity.ReviewCandidate](
+	l entity.ReviewLedger, pending []C, id, disposition string,
+) []C {
+	l.Dispose(id, disposition)
+	return slices.DeleteFunc(pending, func(c C) bool {
+		return c.Key() == id
+	})
+}
@@ -0,0 +1,85 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package queue
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	cfgDir "github.com/ActiveMemory/ctx/internal/config/dir"
+	cfgFs "github.com/ActiveMemory/ctx/internal/config/fs"
+	cfgQueue "github.com/ActiveMemory/ctx/internal/config/queue"
+	errQueue "github.com/ActiveMemory/ctx/internal/err/queue"
+	ctxIo "github.com/ActiveMemory/ctx/internal/io"
+)
+
+// path returns the location of a queue state file.
+//
+// Parameters:
+//   - contextDir: the context directory
+//   - file: the review or ledger file name
+//
+// Returns:
+//   - string: .context/state/<file>
+func path(contextDir, file string) string {
+	return filepath.Join(contextDir, cfgDir.State, file)
+}
+
+// load decodes the JSON file at path into v. A missing file leaves
+// v untouched, so a ledger keeps the defaults the caller set.
+//
+// Parameters:
+//   - name: the owning command, for error messages
+//   - path: the file to read
+//   - v: the decode target
+//
+// Returns:
+//   - error: non-nil on a read failure other than not-exist, or on a
+//     JSON decode failure
+func load(name, path string, v any) error {
+	data, readErr := ctxIo.SafeReadUserFile(path)
+	if readErr != nil {
+		if os.IsNotExist(readErr) {
+			return nil
+		}
+		return errQueue.Read(name, path, readErr)
+	}
+	if decodeErr := json.Unmarshal(data, v); decodeErr != nil {
+		return errQueue.Decode(name, path, decodeErr)
+	}
+	return nil
+}
+
+// save encodes v as indented JSON and writes it atomically to path,
+// creating the parent directory if needed.
+//
+// Parameters:
+//   - name: the owning command, for error messages
+//   - path: the file to write
+//   - v: the value to encode
+//
+// Returns:
+//   - error: non-nil on directory creation, encode, or write failure
+func save(name, path string, v any) error {
+	dir := filepath.Dir(path)
+	if mkErr := ctxIo.SafeMkdirAll(
+		dir, cfgFs.PermRestrictedDir,
+	); mkErr != nil {
+		return errQueue.Write(name, dir, mkErr)
+	}
+	data, encodeErr := json.MarshalIndent(v, "", cfgQueue.JSONIndent)
+	if encodeErr != nil {
+		return errQueue.Encode(name, path, encodeErr)
+	}
+	if writeErr := ctxIo.SafeWriteFileAtomic(
+		path, data, cfgFs.PermSecret,
+	); writeErr != nil {
+		return errQueue.Write(name, path, writeErr)
+	}
+	return nil
+}
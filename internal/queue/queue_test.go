@@ -0,0 +1,155 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package queue_test
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	cfgQueue "github.com/ActiveMemory/ctx/internal/config/queue"
+	"github.com/ActiveMemory/ctx/internal/entity"
+	"github.com/ActiveMemory/ctx/internal/queue"
+)
+
+type item struct {
+	ID   string `json:"id"`
+	Note string `json:"note"`
+}
+
+func (i item) Key() string { return i.ID }
+
+type ledger struct {
+	Version      int               `json:"version"`
+	Dispositions map[string]string `json:"dispositions"`
+}
+
+func (l *ledger) Init() {
+	l.Version = 2
+	if l.Dispositions == nil {
+		l.Dispositions = map[string]string{}
+	}
+}
+
+func (l *ledger) Dispose(id, disposition string) {
+	l.Dispositions[id] = disposition
+}
+
+var testQueue = entity.ReviewQueue{
+	Name: "test", Review: "test-review.json", Ledger: "test-ledger.json",
+}
+
+func TestReviewRoundTrip(t *testing.T) {
+	ctxDir := t.TempDir()
+
+	pending, loadErr := queue.LoadReview[item](testQueue, ctxDir)
+	if loadErr != nil || len(pending) != 0 {
+		t.Fatalf("missing file = %v, %v; want empty", pending, loadErr)
+	}
+	if saveErr := queue.SaveReview[item](testQueue, ctxDir, nil); saveErr != nil {
+		t.Fatal(saveErr)
+	}
+	data, readErr := os.ReadFile(queue.ReviewPath(testQueue, ctxDir))
+	if readErr != nil {
+		t.Fatal(readErr)
+	}
+	if strings.TrimSpace(string(data)) != "[]" {
+		t.Errorf("empty queue written as %q, want []", data)
+	}
+
+	want := []item{{ID: "a", Note: "one"}, {ID: "b", Note: "two"}}
+	if saveErr := queue.SaveReview(testQueue, ctxDir, want); saveErr != nil {
+		t.Fatal(saveErr)
+	}
+	got, reloadErr := queue.LoadReview[item](testQueue, ctxDir)
+	if reloadErr != nil {
+		t.Fatal(reloadErr)
+	}
+	if len(got) != 2 || got[0] != want[0] || got[1] != want[1] {
+		t.Errorf("reloaded %+v, want %+v", got, want)
+	}
+}
+
+func TestLedgerRoundTrip(t *testing.T) {
+	ctxDir := t.TempDir()
+
+	l := &ledger{}
+	if loadErr := queue.LoadLedger(testQueue, ctxDir, l); loadErr != nil {
+		t.Fatal(loadErr)
+	}
+	if l.Version != 2 || l.Dispositions == nil {
+		t.Fatalf("missing ledger = %+v, want it initialized", l)
+	}
+	pending := queue.Dispose(
+		l, []item{{ID: "a"}, {ID: "b"}}, "a", cfgQueue.Accepted,
+	)
+	if len(pending) != 1 || pending[0].ID != "b" {
+		t.Errorf("Dispose left %+v, want only b", pending)
+	}
+	if saveErr := queue.SaveLedger(testQueue, ctxDir, l); saveErr != nil {
+		t.Fatal(saveErr)
+	}
+	got := &ledger{}
+	if loadErr := queue.LoadLedger(testQueue, ctxDir, got); loadErr != nil {
+		t.Fatal(loadErr)
+	}
+	if got.Dispositions["a"] != cfgQueue.Accepted {
+		t.Errorf("reloaded ledger = %+v, want a accepted", got)
+	}
+}
+
+func TestLoad_DecodeErrorNamesCommand(t *testing.T) {
+	ctxDir := t.TempDir()
+	q := entity.ReviewQueue{Name: "align", Review: "bad.json"}
+	if saveErr := queue.SaveReview(q, ctxDir, []item{}); saveErr != nil {
+		t.Fatal(saveErr)
+	}
+	if writeErr := os.WriteFile(
+		queue.ReviewPath(q, ctxDir), []byte(`"x"`), 0o600,
+	); writeErr != nil {
+		t.Fatal(writeErr)
+	}
+	_, loadErr := queue.LoadReview[item](q, ctxDir)
+	if loadErr == nil || !strings.HasPrefix(loadErr.Error(), "align: ") {
+		t.Errorf("LoadReview = %v, want an align-prefixed decode error",
+			loadErr)
+	}
+}
+
+func TestSelect(t *testing.T) {
+	mine := entity.ReviewQueue{Name: "mine"}
+	pending := []item{{ID: "a"}, {ID: "b"}, {ID: "c"}}
+
+	if _, noneErr := queue.Select(mine, pending, nil, false); noneErr == nil {
+		t.Error("no ids and no --all: want an error")
+	}
+	got, selErr := queue.Select(mine, pending, []string{"c", "a"}, false)
+	if selErr != nil {
+		t.Fatal(selErr)
+	}
+	if len(got) != 2 || got[0].ID != "c" || got[1].ID != "a" {
+		t.Errorf("Select = %+v, want c then a", got)
+	}
+	_, unknownErr := queue.Select(mine, pending, []string{"z"}, false)
+	if unknownErr == nil || !strings.Contains(unknownErr.Error(), "ctx mine review") {
+		t.Errorf("unknown id = %v, want a not-found error", unknownErr)
+	}
+
+	all, allErr := queue.Select(mine, pending, nil, true)
+	if allErr != nil {
+		t.Fatal(allErr)
+	}
+	// Disposing from pending must not disturb the selection.
+	pending = queue.Dispose(&ledger{Dispositions: map[string]string{}},
+		pending, "a", cfgQueue.Rejected)
+	if len(pending) != 2 || pending[0].ID != "b" {
+		t.Errorf("Dispose = %+v, want b, c", pending)
+	}
+	if len(all) != 3 || all[0].ID != "a" || all[2].ID != "c" {
+		t.Errorf("--all selection = %+v, want a, b, c", all)
+	}
+}
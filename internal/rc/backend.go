@@ -0,0 +1,35 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package rc
+
+import "github.com/ActiveMemory/ctx/internal/entity"
+
+// Backends returns the configured AI backend definitions keyed by
+// backend type, or nil when the backends table is absent.
+//
+// Returns:
+//   - map[string]entity.BackendEntry: the backend entries (nil when none)
+func Backends() map[string]entity.BackendEntry {
+	b := RC().Backends
+	if b == nil {
+		return nil
+	}
+	return b.Entries
+}
+
+// BackendDefault returns the backend named by backends.default, or
+// empty when unset.
+//
+// Returns:
+//   - string: the default backend type, or ""
+func BackendDefault() string {
+	b := RC().Backends
+	if b == nil {
+		return ""
+	}
+	return b.Default
+}
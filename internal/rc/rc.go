@@ -24,6 +24,7 @@ import (
 	cfgLoadgate "github.com/ActiveMemory/ctx/internal/config/loadgate"
 	cfgMemory "github.com/ActiveMemory/ctx/internal/config/memory"
 	"github.com/ActiveMemory/ctx/internal/config/parser"
+	cfgRedact "github.com/ActiveMemory/ctx/internal/config/redact"
 	"github.com/ActiveMemory/ctx/internal/config/token"
 	"github.com/ActiveMemory/ctx/internal/crypto"
 	errCtx "github.com/ActiveMemory/ctx/internal/err/context"
@@ -639,6 +640,62 @@ func StatuslineShowCost() bool {
 	return true
 }
 
+// RedactEnabled returns whether journal import redacts secrets.
+//
+// Returns true (default) when the redact section is not configured
+// or when the enabled field is not explicitly set.
+//
+// Returns:
+//   - bool: True if imported entries are redacted
+func RedactEnabled() bool {
+	cfg := RC()
+	if cfg.Redact != nil && cfg.Redact.Enabled != nil {
+		return *cfg.Redact.Enabled
+	}
+	return true
+}
+
+// RedactEntropy returns whether the high-entropy string detector
+// runs during redaction.
+//
+// Returns true (default) when the redact section is not configured
+// or when the entropy field is not explicitly set. Projects whose
+// sessions carry many opaque identifiers can turn it off.
+//
+// Returns:
+//   - bool: True if the entropy detector runs
+func RedactEntropy() bool {
+	cfg := RC()
+	if cfg.Redact != nil && cfg.Redact.Entropy != nil {
+		return *cfg.Redact.Entropy
+	}
+	return true
+}
+
+// RedactRules returns the project redaction rules from .ctxrc.
+//
+// Returns:
+//   - []cfgRedact.Rule: Project rules (nil when none are set)
+func RedactRules() []cfgRedact.Rule {
+	cfg := RC()
+	if cfg.Redact == nil {
+		return nil
+	}
+	return cfg.Redact.Rules
+}
+
+// RedactAllow returns the strings redaction must leave intact.
+//
+// Returns:
+//   - []string: Allowed strings (nil when none are set)
+func RedactAllow() []string {
+	cfg := RC()
+	if cfg.Redact == nil {
+		return nil
+	}
+	return cfg.Redact.Allow
+}
+
 // AutoPruneDays returns the number of days after which session state
 // files are eligible for auto-pruning during context load.
 //
@@ -6,7 +6,11 @@
 
 package rc
 
-import cfgMemory "github.com/ActiveMemory/ctx/internal/config/memory"
+import (
+	cfgMemory "github.com/ActiveMemory/ctx/internal/config/memory"
+	cfgRedact "github.com/ActiveMemory/ctx/internal/config/redact"
+	"github.com/ActiveMemory/ctx/internal/entity"
+)
 
 // CtxRC represents the configuration from the .ctxrc file.
 //
@@ -87,6 +91,9 @@ import cfgMemory "github.com/ActiveMemory/ctx/internal/config/memory"
 //   - RecallListLimit: Default number of sessions listed by
 //     `ctx journal source` when --limit is omitted
 //     (default 20; zero or negative means "unset" → default)
+//   - Redact: Secret redaction for imported journal entries
+//   - Backends: Optional OpenAI-compatible AI backends for
+//     `ctx ai` (absent means AI commands fail closed)
 type CtxRC struct {
 	Profile              string                   `yaml:"profile"`
 	Tool                 string                   `yaml:"tool"`
@@ -126,6 +133,8 @@ type CtxRC struct {
 	ConventionBudgetPct  *float64                 `yaml:"convention_budget_pct"`
 	TitleSlugMaxLen      int                      `yaml:"title_slug_max_len"`
 	RecallListLimit      int                      `yaml:"recall_list_limit"`
+	Redact               *RedactRC                `yaml:"redact"`
+	Backends             *BackendsRC              `yaml:"backends"`
 }
 
 // StatuslineRC holds status line configuration from .ctxrc.
@@ -146,6 +155,40 @@ type StatuslineRC struct {
 	ShowCost *bool `yaml:"show_cost"`
 }
 
+// RedactRC holds the secret redaction configuration from .ctxrc.
+//
+// Journal import replaces secrets found by the built-in detectors
+// and the project rules with stable placeholders before writing.
+//
+// Fields:
+//   - Enabled: Whether import redacts entries (default true).
+//     Pointer type distinguishes unset (nil → true) from explicit
+//     false.
+//   - Entropy: Whether the high-entropy string detector runs
+//     (default true)
+//   - Rules: Project rules applied after the built-in detectors
+//   - Allow: Exact strings never redacted, such as documented
+//     example keys
+type RedactRC struct {
+	Enabled *bool            `yaml:"enabled"`
+	Entropy *bool            `yaml:"entropy"`
+	Rules   []cfgRedact.Rule `yaml:"rules"`
+	Allow   []string         `yaml:"allow"`
+}
+
+// BackendsRC holds the AI backend table from .ctxrc. Each entry is
+// keyed by a registered backend type (vllm, openai, ollama,
+// lmstudio, openai-compatible).
+//
+// Fields:
+//   - Default: Backend used when --backend is omitted; required
+//     only when more than one backend is configured
+//   - Entries: Backend definitions keyed by backend type
+type BackendsRC struct {
+	Default string                         `yaml:"default"`
+	Entries map[string]entity.BackendEntry `yaml:",inline"`
+}
+
 // DreamRC holds the ctx-dream configuration from .ctxrc. The dream is
 // opt-in: nothing runs until Enabled is set true and the cron entry is
 // installed. An empty Executor selects the reference claude -p
@@ -0,0 +1,68 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package search maintains a persistent, ranked full-text
+// index over the context directory. It backs `ctx search`,
+// the `ctx_search` MCP tool, and the relevance half of
+// `ctx agent` entry scoring.
+//
+// # Corpus and Granularity
+//
+// The index covers the top-level Markdown files of
+// `.context/` plus the archive, the disclosure theme
+// directories, imported journal sessions, and the
+// knowledge base ([cfgSearch.Dirs]). Documents are
+// entries, not files: each `##` section is one document,
+// so a timestamped decision or learning ranks on its own.
+// Journal sessions are the exception; one session file is
+// one document.
+//
+// Every document carries a type derived from where it
+// lives (decision, learning, convention, task, journal,
+// kb, or context), its heading as a title, and a date when
+// one is known: the entry timestamp, or the journal
+// filename's date prefix.
+//
+// # Persistence and Refresh
+//
+// [Open] loads `.context/state/search-index.json` and
+// compares each file's modification time and size with
+// the stamp recorded when it was indexed. Only new,
+// changed, and deleted files are re-tokenized; the index
+// is written back (atomically) only when something
+// changed. [Rebuild] discards the stored index first.
+//
+// The file holds the inverted index itself: a posting
+// list of (document, term frequency) pairs per term, plus
+// per-document metadata and length. Document text is not
+// stored; phrase checks and snippets re-read the source
+// file for the few candidates that need them.
+//
+// # Ranking
+//
+// [Index.Search] scores documents with Okapi BM25
+// ([cfgSearch.K1], [cfgSearch.B]) over the query's terms.
+// A document needs at least one query term to rank.
+//
+// # Query Syntax
+//
+//   - words: ranked with BM25; any word may match
+//   - "quoted phrase": the words must appear in order,
+//     adjacent; a document without the phrase is dropped
+//   - type:<t>: only documents of type t; repeat to allow
+//     several
+//   - since:YYYY-MM-DD: only documents dated on or after
+//     the date (undated documents are dropped)
+//
+// Words are case-folded and stop words are ignored, both
+// when indexing and when querying.
+//
+// # Concurrency
+//
+// An [Index] is not safe for concurrent use. Two
+// processes refreshing at once both write a complete
+// index; the last rename wins.
+package search
@@ -0,0 +1,182 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package search
+
+import (
+	"cmp"
+	"math"
+	"slices"
+
+	cfgSearch "github.com/ActiveMemory/ctx/internal/config/search"
+	"github.com/ActiveMemory/ctx/internal/entity"
+	errSearch "github.com/ActiveMemory/ctx/internal/err/search"
+)
+
+// Open loads the index for a context directory and brings
+// it up to date with the files on disk. Only files whose
+// modification time or size changed since they were last
+// indexed are re-read. The index file is rewritten only
+// when something changed.
+//
+// Parameters:
+//   - ctxDir: the context directory
+//
+// Returns:
+//   - *Index: the refreshed index
+//   - error: non-nil if the index file is unreadable, the
+//     context directory cannot be scanned, or the refreshed
+//     index cannot be written
+func Open(ctxDir string) (*Index, error) {
+	ix, loadErr := load(ctxDir)
+	if loadErr != nil {
+		return nil, loadErr
+	}
+	return ix, ix.sync()
+}
+
+// Read loads the index for a context directory and brings
+// it up to date in memory, like [Open], but never writes
+// the index file. Callers that only consult the index use
+// it so that reading context has no side effect on disk;
+// the stored index is refreshed by ctx search.
+//
+// Parameters:
+//   - ctxDir: the context directory
+//
+// Returns:
+//   - *Index: the refreshed index
+//   - error: non-nil if the index file is unreadable or the
+//     context directory cannot be scanned
+func Read(ctxDir string) (*Index, error) {
+	ix, loadErr := load(ctxDir)
+	if loadErr != nil {
+		return nil, loadErr
+	}
+	// Acceptable discard: whether the index changed only
+	// matters to a caller that saves it.
+	_, refreshErr := ix.refresh()
+	if refreshErr != nil {
+		return nil, refreshErr
+	}
+	return ix, nil
+}
+
+// Rebuild discards the stored index and indexes the
+// context directory from scratch.
+//
+// Parameters:
+//   - ctxDir: the context directory
+//
+// Returns:
+//   - *Index: the rebuilt index
+//   - error: non-nil if the context directory cannot be
+//     scanned or the index cannot be written
+func Rebuild(ctxDir string) (*Index, error) {
+	ix := empty(ctxDir)
+	return ix, ix.sync()
+}
+
+// Len returns the number of indexed documents.
+//
+// Returns:
+//   - int: document count
+func (ix *Index) Len() int {
+	return len(ix.docs)
+}
+
+// Search ranks the indexed documents against query text
+// with BM25. Field filters and phrases narrow the result
+// set; the remaining words rank it.
+//
+// Parameters:
+//   - text: query text (see the package documentation for
+//     the syntax)
+//   - limit: maximum results; 0 or less returns every
+//     match
+//
+// Returns:
+//   - []entity.SearchHit: matches, best first
+//   - error: non-nil if the query has no word to rank by or
+//     a since: date is malformed
+func (ix *Index) Search(
+	text string, limit int,
+) ([]entity.SearchHit, error) {
+	q, parseErr := parseQuery(text)
+	if parseErr != nil {
+		return nil, parseErr
+	}
+	if len(q.terms) == 0 {
+		return nil, errSearch.NoTerms()
+	}
+	if len(ix.docs) == 0 {
+		return nil, nil
+	}
+
+	n := float64(len(ix.docs))
+	avg := ix.avgLength()
+	scores := make(map[int]float64)
+	for _, term := range q.terms {
+		list := ix.data.Terms[term]
+		if len(list) == 0 {
+			continue
+		}
+		df := float64(len(list))
+		idf := math.Log(
+			1 + (n-df+cfgSearch.Smooth)/(df+cfgSearch.Smooth),
+		)
+		for _, p := range list {
+			d := ix.doc(p.Doc)
+			if d == nil || !q.allows(d) {
+				continue
+			}
+			tf := float64(p.TF)
+			norm := cfgSearch.K1 * (1 - cfgSearch.B +
+				cfgSearch.B*float64(d.Length)/avg)
+			scores[p.Doc] += idf * tf * (cfgSearch.K1 + 1) / (tf + norm)
+		}
+	}
+
+	ids := make([]int, 0, len(scores))
+	for id := range scores {
+		ids = append(ids, id)
+	}
+	slices.SortFunc(ids, func(a, b int) int {
+		if c := cmp.Compare(scores[b], scores[a]); c != 0 {
+			return c
+		}
+		ra, rb := ix.docs[a], ix.docs[b]
+		if c := cmp.Compare(ra.path, rb.path); c != 0 {
+			return c
+		}
+		return cmp.Compare(ra.pos, rb.pos)
+	})
+
+	src := newSource(ix.ctxDir)
+	var hits []entity.SearchHit
+	for _, id := range ids {
+		ref := ix.docs[id]
+		d := ix.doc(id)
+		lines := src.lines(ref.path, d)
+		if !q.phrasesIn(lines) {
+			continue
+		}
+		hits = append(hits, entity.SearchHit{
+			Path:    ref.path,
+			Line:    d.Line,
+			Type:    d.Type,
+			Key:     d.Key,
+			Title:   d.Title,
+			Date:    d.Date,
+			Score:   scores[id],
+			Snippet: snippet(lines, q.terms),
+		})
+		if limit > 0 && len(hits) == limit {
+			break
+		}
+	}
+	return hits, nil
+}
@@ -0,0 +1,239 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package search
+
+import (
+	"path/filepath"
+	"strings"
+	"unicode"
+
+	cfgSearch "github.com/ActiveMemory/ctx/internal/config/search"
+	"github.com/ActiveMemory/ctx/internal/config/token"
+	"github.com/ActiveMemory/ctx/internal/err/date"
+	"github.com/ActiveMemory/ctx/internal/i18n"
+	ctxIo "github.com/ActiveMemory/ctx/internal/io"
+	"github.com/ActiveMemory/ctx/internal/parse"
+)
+
+// parseQuery splits query text into ranked words, quoted
+// phrases, and field filters. An unterminated quote runs
+// to the end of the text.
+//
+// Parameters:
+//   - text: raw query text
+//
+// Returns:
+//   - query: the parsed query
+//   - error: non-nil if a since: value is not YYYY-MM-DD
+func parseQuery(text string) (query, error) {
+	q := query{types: make(map[string]bool)}
+	seen := make(map[string]bool)
+	addTerms := func(words []string) {
+		for _, w := range words {
+			if !seen[w] {
+				seen[w] = true
+				q.terms = append(q.terms, w)
+			}
+		}
+	}
+
+	for rest := strings.TrimSpace(text); rest != ""; {
+		if rest[0] == cfgSearch.Quote {
+			phrase, after, _ := strings.Cut(
+				rest[1:], string(cfgSearch.Quote),
+			)
+			if words := tokenize(phrase); len(words) > 0 {
+				q.phrases = append(q.phrases, words)
+				addTerms(words)
+			}
+			rest = strings.TrimSpace(after)
+			continue
+		}
+
+		end := strings.IndexFunc(rest, func(r rune) bool {
+			return unicode.IsSpace(r) || r == cfgSearch.Quote
+		})
+		if end < 0 {
+			end = len(rest)
+		}
+		word := rest[:end]
+		rest = strings.TrimSpace(rest[end:])
+
+		folded := i18n.Fold(word)
+		if v, ok := strings.CutPrefix(folded, cfgSearch.FieldType); ok {
+			q.types[v] = true
+			continue
+		}
+		if v, ok := strings.CutPrefix(folded, cfgSearch.FieldSince); ok {
+			if _, dateErr := parse.Date(v); dateErr != nil || v == "" {
+				return query{}, date.InvalidValue(v)
+			}
+			q.since = v
+			continue
+		}
+		addTerms(tokenize(word))
+	}
+	return q, nil
+}
+
+// allows applies the field filters to a document.
+//
+// Parameters:
+//   - d: candidate document
+//
+// Returns:
+//   - bool: true when the document passes type: and
+//     since:
+func (q *query) allows(d *doc) bool {
+	if len(q.types) > 0 && !q.types[d.Type] {
+		return false
+	}
+	// YYYY-MM-DD compares correctly as a string.
+	if q.since != "" && (d.Date == "" || d.Date < q.since) {
+		return false
+	}
+	return true
+}
+
+// phrasesIn reports whether every phrase of the query
+// appears, words adjacent and in order, in the document
+// text.
+//
+// Parameters:
+//   - lines: document lines
+//
+// Returns:
+//   - bool: true when all phrases match (or there are none)
+func (q *query) phrasesIn(lines []string) bool {
+	if len(q.phrases) == 0 {
+		return true
+	}
+	words := tokenize(strings.Join(lines, token.NewlineLF))
+	for _, phrase := range q.phrases {
+		if !contains(words, phrase) {
+			return false
+		}
+	}
+	return true
+}
+
+// contains reports whether phrase occurs as a contiguous
+// run in words.
+//
+// Parameters:
+//   - words: document words
+//   - phrase: phrase words
+//
+// Returns:
+//   - bool: true on a match
+func contains(words, phrase []string) bool {
+	for i := 0; i+len(phrase) <= len(words); i++ {
+		match := true
+		for j, w := range phrase {
+			if words[i+j] != w {
+				match = false
+				break
+			}
+		}
+		if match {
+			return true
+		}
+	}
+	return false
+}
+
+// newSource returns a per-search cache of file lines.
+//
+// Parameters:
+//   - ctxDir: the context directory
+//
+// Returns:
+//   - *source: an empty cache
+func newSource(ctxDir string) *source {
+	return &source{ctxDir: ctxDir, files: make(map[string][]string)}
+}
+
+// lines returns a document's lines, reading its file at
+// most once per search. A file that has shrunk since it
+// was indexed yields the lines that remain.
+//
+// Parameters:
+//   - rel: slash-separated path relative to the context
+//     directory
+//   - d: the document
+//
+// Returns:
+//   - []string: lines Line through End (may be empty)
+func (s *source) lines(rel string, d *doc) []string {
+	all, cached := s.files[rel]
+	if !cached {
+		data, readErr := ctxIo.SafeReadUserFile(
+			filepath.Join(s.ctxDir, filepath.FromSlash(rel)),
+		)
+		if readErr == nil {
+			all = strings.Split(string(data), token.NewlineLF)
+		}
+		s.files[rel] = all
+	}
+	from, to := d.Line-1, min(d.End, len(all))
+	if from < 0 || from >= to {
+		return nil
+	}
+	return all[from:to]
+}
+
+// snippet picks the first body line that mentions a query
+// term, falling back to the first non-blank body line, and
+// trims it to [cfgSearch.SnippetLen] runes.
+//
+// Parameters:
+//   - lines: document lines, heading first
+//   - terms: query words
+//
+// Returns:
+//   - string: one line of context (empty when the body is
+//     blank)
+func snippet(lines []string, terms []string) string {
+	want := make(map[string]bool, len(terms))
+	for _, t := range terms {
+		want[t] = true
+	}
+	fallback := ""
+	for i, line := range lines {
+		text := strings.TrimSpace(line)
+		if text == "" || (i == 0 && strings.HasPrefix(
+			text, token.PrefixHeading,
+		)) {
+			continue
+		}
+		if fallback == "" {
+			fallback = text
+		}
+		for _, w := range tokenize(text) {
+			if want[w] {
+				return clip(text)
+			}
+		}
+	}
+	return clip(fallback)
+}
+
+// clip trims text to [cfgSearch.SnippetLen] runes, marking
+// the cut with an ellipsis.
+//
+// Parameters:
+//   - text: snippet text
+//
+// Returns:
+//   - string: text, shortened when needed
+func clip(text string) string {
+	runes := []rune(text)
+	if len(runes) <= cfgSearch.SnippetLen {
+		return text
+	}
+	return string(runes[:cfgSearch.SnippetLen]) + token.Ellipsis
+}
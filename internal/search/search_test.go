@@ -0,0 +1,264 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package search
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// writeFile creates a file under dir, making parents.
+func writeFile(t *testing.T, dir, rel, content string) {
+	t.Helper()
+	path := filepath.Join(dir, filepath.FromSlash(rel))
+	if mkErr := os.MkdirAll(filepath.Dir(path), 0o755); mkErr != nil {
+		t.Fatal(mkErr)
+	}
+	if writeErr := os.WriteFile(path, []byte(content), 0o644); writeErr != nil {
+		t.Fatal(writeErr)
+	}
+}
+
+// newCorpus builds a context directory covering every
+// indexed location.
+func newCorpus(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	writeFile(t, dir, "DECISIONS.md", `# Decisions
+
+## [2026-03-01-090000] Retry hub publishes with backoff
+
+Publishing retries with exponential backoff and jitter.
+Retry up to five times, then surface the error.
+
+## [2026-01-10-120000] Use JSONL for the hub log
+
+Append-only JSONL keeps the log simple.
+`)
+	writeFile(t, dir, "LEARNINGS.md", `# Learnings
+
+## [2026-02-15-080000] Backoff needs jitter
+
+Without jitter every client retries at the same instant.
+`)
+	writeFile(t, dir, "TASKS.md", `# Tasks
+
+## Phase 1
+
+- [ ] Add a retry budget to the sync loop
+`)
+	writeFile(t, dir, "decisions/hub.md", `# Hub
+
+## [2025-11-02-100000] Hub tokens are bearer tokens
+
+Tokens travel in the authorization header.
+`)
+	writeFile(t, dir, "archive/learnings-consolidated-2025-12-01.md",
+		`# Archived learnings
+
+## [2025-10-01-100000] Old retry notes
+
+The old retry loop never gave up.
+`)
+	writeFile(t, dir, "journal/2026-02-20-hub-retries-abcd1234.md",
+		`# Hub retries session
+
+We talked about retry storms and thundering herds.
+`)
+	writeFile(t, dir, "kb/topics/retries/index.md", `# Retries
+
+Every retry in a distributed system needs a budget.
+`)
+	return dir
+}
+
+func TestSearch_RanksEntries(t *testing.T) {
+	dir := newCorpus(t)
+	ix, openErr := Open(dir)
+	if openErr != nil {
+		t.Fatal(openErr)
+	}
+	hits, searchErr := ix.Search("retry backoff", 0)
+	if searchErr != nil {
+		t.Fatal(searchErr)
+	}
+	if len(hits) == 0 {
+		t.Fatal("no hits")
+	}
+	top := hits[0]
+	if top.Path != "DECISIONS.md" || top.Type != "decision" ||
+		top.Key != "2026-03-01-090000" || top.Line != 3 {
+		t.Errorf("top hit = %+v", top)
+	}
+	if top.Title != "Retry hub publishes with backoff" {
+		t.Errorf("title = %q", top.Title)
+	}
+	if top.Snippet == "" {
+		t.Error("empty snippet")
+	}
+	for i := 1; i < len(hits); i++ {
+		if hits[i].Score > hits[i-1].Score {
+			t.Errorf("hits out of order at %d", i)
+		}
+	}
+
+	seen := make(map[string]string)
+	for _, h := range hits {
+		seen[h.Path] = h.Type
+	}
+	want := map[string]string{
+		"archive/learnings-consolidated-2025-12-01.md": "learning",
+		"journal/2026-02-20-hub-retries-abcd1234.md":   "journal",
+		"kb/topics/retries/index.md":                   "kb",
+		"TASKS.md":                                     "task",
+	}
+	for path, typ := range want {
+		if seen[path] != typ {
+			t.Errorf("%s: type %q, want %q", path, seen[path], typ)
+		}
+	}
+}
+
+func TestSearch_Fields(t *testing.T) {
+	dir := newCorpus(t)
+	ix, openErr := Open(dir)
+	if openErr != nil {
+		t.Fatal(openErr)
+	}
+
+	hits, _ := ix.Search("retry type:learning", 0)
+	if len(hits) != 1 ||
+		hits[0].Path != "archive/learnings-consolidated-2025-12-01.md" {
+		t.Errorf("type:learning = %+v", hits)
+	}
+
+	hits, _ = ix.Search("tokens type:decision", 0)
+	if len(hits) != 1 || hits[0].Path != "decisions/hub.md" {
+		t.Errorf("theme entry = %+v", hits)
+	}
+
+	hits, _ = ix.Search("retry since:2026-02-01", 0)
+	for _, h := range hits {
+		if h.Date < "2026-02-01" {
+			t.Errorf("since: kept %+v", h)
+		}
+	}
+	if len(hits) != 2 {
+		t.Errorf("since: got %d hits, want 2 (decision, journal)",
+			len(hits))
+	}
+
+	if _, err := ix.Search("retry since:yesterday", 0); err == nil {
+		t.Error("malformed since: accepted")
+	}
+	if _, err := ix.Search("type:decision the", 0); err == nil {
+		t.Error("query without words accepted")
+	}
+}
+
+func TestSearch_Phrase(t *testing.T) {
+	dir := newCorpus(t)
+	ix, openErr := Open(dir)
+	if openErr != nil {
+		t.Fatal(openErr)
+	}
+	hits, _ := ix.Search(`"exponential backoff"`, 0)
+	if len(hits) != 1 || hits[0].Key != "2026-03-01-090000" {
+		t.Errorf("phrase = %+v", hits)
+	}
+	hits, _ = ix.Search(`"backoff exponential"`, 0)
+	if len(hits) != 0 {
+		t.Errorf("reversed phrase matched %+v", hits)
+	}
+}
+
+func TestOpen_Incremental(t *testing.T) {
+	dir := newCorpus(t)
+	if _, openErr := Open(dir); openErr != nil {
+		t.Fatal(openErr)
+	}
+	indexFile := filepath.Join(dir, "state", "search-index.json")
+	before, statErr := os.Stat(indexFile)
+	if statErr != nil {
+		t.Fatalf("index not written: %v", statErr)
+	}
+
+	// Nothing changed: the index file is not rewritten.
+	time.Sleep(10 * time.Millisecond)
+	if _, openErr := Open(dir); openErr != nil {
+		t.Fatal(openErr)
+	}
+	after, _ := os.Stat(indexFile)
+	if !after.ModTime().Equal(before.ModTime()) {
+		t.Error("unchanged corpus rewrote the index")
+	}
+
+	writeFile(t, dir, "LEARNINGS.md", `# Learnings
+
+## [2026-04-01-080000] Circuit breakers beat retries
+
+Open the breaker after repeated failures.
+`)
+	later := time.Now().Add(time.Minute)
+	_ = os.Chtimes(filepath.Join(dir, "LEARNINGS.md"), later, later)
+	if removeErr := os.Remove(
+		filepath.Join(dir, "kb/topics/retries/index.md"),
+	); removeErr != nil {
+		t.Fatal(removeErr)
+	}
+
+	ix, openErr := Open(dir)
+	if openErr != nil {
+		t.Fatal(openErr)
+	}
+	if hits, _ := ix.Search("breaker", 0); len(hits) != 1 {
+		t.Errorf("changed file not reindexed: %+v", hits)
+	}
+	if hits, _ := ix.Search("jitter type:learning", 0); len(hits) != 0 {
+		t.Errorf("old content still indexed: %+v", hits)
+	}
+	if hits, _ := ix.Search("budget type:kb", 0); len(hits) != 0 {
+		t.Errorf("deleted file still indexed: %+v", hits)
+	}
+}
+
+func TestRead_DoesNotWrite(t *testing.T) {
+	dir := newCorpus(t)
+	ix, readErr := Read(dir)
+	if readErr != nil {
+		t.Fatal(readErr)
+	}
+	if ix.Len() == 0 {
+		t.Error("Read did not index the corpus")
+	}
+	indexFile := filepath.Join(dir, "state", "search-index.json")
+	if _, statErr := os.Stat(indexFile); !os.IsNotExist(statErr) {
+		t.Errorf("Read wrote the index: %v", statErr)
+	}
+}
+
+func TestOpen_DiscardsForeignIndex(t *testing.T) {
+	dir := newCorpus(t)
+	writeFile(t, dir, "state/search-index.json", `{"version": 99}`)
+	ix, openErr := Open(dir)
+	if openErr != nil {
+		t.Fatal(openErr)
+	}
+	if ix.Len() == 0 {
+		t.Error("foreign index was not rebuilt")
+	}
+	rebuilt, rebuildErr := Rebuild(dir)
+	if rebuildErr != nil {
+		t.Fatal(rebuildErr)
+	}
+	if rebuilt.Len() != ix.Len() {
+		t.Errorf("rebuild has %d docs, open has %d",
+			rebuilt.Len(), ix.Len())
+	}
+}
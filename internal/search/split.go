@@ -0,0 +1,202 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package search
+
+import (
+	"path"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/lookup"
+	"github.com/ActiveMemory/ctx/internal/config/archive"
+	cfgCtx "github.com/ActiveMemory/ctx/internal/config/ctx"
+	"github.com/ActiveMemory/ctx/internal/config/dir"
+	"github.com/ActiveMemory/ctx/internal/config/disclosure"
+	"github.com/ActiveMemory/ctx/internal/config/entry"
+	cfgFile "github.com/ActiveMemory/ctx/internal/config/file"
+	"github.com/ActiveMemory/ctx/internal/config/kb"
+	"github.com/ActiveMemory/ctx/internal/config/regex"
+	cfgSearch "github.com/ActiveMemory/ctx/internal/config/search"
+	cfgTime "github.com/ActiveMemory/ctx/internal/config/time"
+	"github.com/ActiveMemory/ctx/internal/config/token"
+	"github.com/ActiveMemory/ctx/internal/i18n"
+)
+
+// entryLevel is the heading depth that opens a document.
+const entryLevel = 2
+
+// sections cuts a file into documents. Journal sessions
+// are one document; every other file is cut at its `##`
+// headings, with any text before the first heading kept
+// as a document of its own.
+//
+// Parameters:
+//   - rel: slash-separated path relative to the context
+//     directory
+//   - content: file content
+//
+// Returns:
+//   - []section: documents in file order
+func sections(rel, content string) []section {
+	lines := strings.Split(content, token.NewlineLF)
+	typ := docType(rel)
+	title := fileTitle(rel, lines)
+
+	if typ == cfgSearch.TypeJournal {
+		base := path.Base(rel)
+		date := ""
+		if len(base) >= len(cfgTime.DateFormat) {
+			if _, parseErr := time.Parse(
+				cfgTime.DateFormat, base[:len(cfgTime.DateFormat)],
+			); parseErr == nil {
+				date = base[:len(cfgTime.DateFormat)]
+			}
+		}
+		return []section{{
+			doc: doc{
+				Line: 1, End: len(lines), Type: typ,
+				Title: title, Date: date,
+			},
+			text: content,
+		}}
+	}
+
+	var out []section
+	start := 0
+	cur := doc{Line: 1, Type: typ, Title: title}
+	flush := func(end int) {
+		body := strings.Join(lines[start:end], token.NewlineLF)
+		if strings.TrimSpace(body) == "" {
+			return
+		}
+		cur.End = end
+		out = append(out, section{doc: cur, text: body})
+	}
+
+	inFence := false
+	for i, line := range lines {
+		if regex.CodeFenceLine.MatchString(line) {
+			inFence = !inFence
+			continue
+		}
+		if inFence {
+			continue
+		}
+		m := regex.MarkdownHeading.FindStringSubmatch(line)
+		if m == nil || len(m[1]) != entryLevel {
+			continue
+		}
+		flush(i)
+		start = i
+		cur = doc{Line: i + 1, Type: typ, Title: strings.TrimSpace(m[2])}
+		em := regex.EntryHeader.FindStringSubmatch(line)
+		if len(em) == regex.EntryHeaderGroups {
+			cur.Key = em[1] + token.Dash + em[2]
+			cur.Date = em[1]
+			cur.Title = em[3]
+		}
+	}
+	flush(len(lines))
+	return out
+}
+
+// fileTitle returns the file's H1 text, or its name
+// without the extension when it has none.
+//
+// Parameters:
+//   - rel: slash-separated relative path
+//   - lines: file lines
+//
+// Returns:
+//   - string: title for documents without their own
+//     heading
+func fileTitle(rel string, lines []string) string {
+	for _, line := range lines {
+		m := regex.MarkdownHeading.FindStringSubmatch(line)
+		if m != nil && len(m[1]) == 1 {
+			return strings.TrimSpace(m[2])
+		}
+	}
+	return strings.TrimSuffix(path.Base(rel), cfgFile.ExtMarkdown)
+}
+
+// docType classifies a file by where it lives.
+//
+// Parameters:
+//   - rel: slash-separated path relative to the context
+//     directory
+//
+// Returns:
+//   - string: decision, learning, convention, task,
+//     journal, kb, or context
+func docType(rel string) string {
+	top, rest, nested := strings.Cut(rel, token.Slash)
+	if !nested {
+		switch top {
+		case cfgCtx.Decision:
+			return entry.Decision
+		case cfgCtx.Learning:
+			return entry.Learning
+		case cfgCtx.Convention:
+			return entry.Convention
+		case cfgCtx.Task:
+			return entry.Task
+		}
+		return cfgSearch.TypeContext
+	}
+
+	switch top {
+	case disclosure.ThemeDirDecision:
+		return entry.Decision
+	case disclosure.ThemeDirLearning:
+		return entry.Learning
+	case disclosure.ThemeDirConvention:
+		return entry.Convention
+	case dir.Journal:
+		return cfgSearch.TypeJournal
+	case kb.KBSubdir:
+		return cfgSearch.TypeKB
+	case dir.Archive:
+		// Archived entries keep their kind: the archive
+		// names files after the root they came from.
+		switch base := path.Base(rest); {
+		case strings.HasPrefix(base, disclosure.ThemeDirDecision):
+			return entry.Decision
+		case strings.HasPrefix(base, disclosure.ThemeDirLearning):
+			return entry.Learning
+		case strings.HasPrefix(base, disclosure.ThemeDirConvention):
+			return entry.Convention
+		case strings.HasPrefix(base, archive.ScopeTasks):
+			return entry.Task
+		}
+	}
+	return cfgSearch.TypeContext
+}
+
+// tokenize splits text into case-folded words, dropping
+// stop words. Repeated words are kept so callers can count
+// frequencies and check adjacency.
+//
+// Parameters:
+//   - text: text to split
+//
+// Returns:
+//   - []string: words in order
+func tokenize(text string) []string {
+	words := strings.FieldsFunc(i18n.Fold(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	stop := lookup.StopWords()
+	out := words[:0]
+	for _, w := range words {
+		if !stop[w] {
+			out = append(out, w)
+		}
+	}
+	return out
+}
@@ -0,0 +1,356 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package search
+
+import (
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ActiveMemory/ctx/internal/config/dir"
+	cfgFile "github.com/ActiveMemory/ctx/internal/config/file"
+	cfgFs "github.com/ActiveMemory/ctx/internal/config/fs"
+	cfgSearch "github.com/ActiveMemory/ctx/internal/config/search"
+	"github.com/ActiveMemory/ctx/internal/config/token"
+	errSearch "github.com/ActiveMemory/ctx/internal/err/search"
+	ctxIo "github.com/ActiveMemory/ctx/internal/io"
+)
+
+// indexPath returns the index file location.
+//
+// Parameters:
+//   - ctxDir: the context directory
+//
+// Returns:
+//   - string: .context/state/search-index.json
+func indexPath(ctxDir string) string {
+	return filepath.Join(ctxDir, dir.State, cfgSearch.File)
+}
+
+// empty returns an index with nothing indexed, marked
+// dirty so the first sync writes it.
+//
+// Parameters:
+//   - ctxDir: the context directory
+//
+// Returns:
+//   - *Index: an empty index
+func empty(ctxDir string) *Index {
+	return &Index{
+		ctxDir: ctxDir,
+		data: store{
+			Version: cfgSearch.Version,
+			Files:   make(map[string]fileEntry),
+			Terms:   make(map[string][]posting),
+		},
+		docs:  make(map[int]docRef),
+		dirty: true,
+	}
+}
+
+// load reads the stored index. A missing file, a file
+// that does not decode, and a file of another schema
+// version all yield an empty index: the index is a cache
+// and is rebuilt rather than repaired.
+//
+// Parameters:
+//   - ctxDir: the context directory
+//
+// Returns:
+//   - *Index: the stored index, or an empty one
+//   - error: non-nil only when the file exists but cannot
+//     be read
+func load(ctxDir string) (*Index, error) {
+	path := indexPath(ctxDir)
+	data, readErr := ctxIo.SafeReadUserFile(path)
+	if errors.Is(readErr, fs.ErrNotExist) {
+		return empty(ctxDir), nil
+	}
+	if readErr != nil {
+		return nil, errSearch.ReadIndex(path, readErr)
+	}
+
+	ix := empty(ctxDir)
+	var st store
+	if json.Unmarshal(data, &st) != nil ||
+		st.Version != cfgSearch.Version ||
+		st.Files == nil || st.Terms == nil {
+		return ix, nil
+	}
+	ix.data = st
+	ix.dirty = false
+	ix.link()
+	return ix, nil
+}
+
+// sync refreshes the index and saves it when anything
+// changed.
+//
+// Returns:
+//   - error: non-nil if scanning or saving fails
+func (ix *Index) sync() error {
+	changed, refreshErr := ix.refresh()
+	if refreshErr != nil || !changed {
+		return refreshErr
+	}
+	return ix.save()
+}
+
+// refresh re-indexes new, changed, and deleted files in
+// memory.
+//
+// Returns:
+//   - bool: true when the index differs from the stored
+//     file
+//   - error: non-nil if the context directory cannot be
+//     scanned
+func (ix *Index) refresh() (bool, error) {
+	current, scanErr := scan(ix.ctxDir)
+	if scanErr != nil {
+		return false, scanErr
+	}
+
+	stale := make(map[string]bool)
+	for path, fe := range ix.data.Files {
+		info, ok := current[path]
+		if !ok || info.ModTime().UnixNano() != fe.ModTime ||
+			info.Size() != fe.Size {
+			stale[path] = true
+		}
+	}
+	var fresh []string
+	for path := range current {
+		if _, ok := ix.data.Files[path]; !ok || stale[path] {
+			fresh = append(fresh, path)
+		}
+	}
+	if len(stale) == 0 && len(fresh) == 0 && !ix.dirty {
+		return false, nil
+	}
+
+	ix.drop(stale)
+	for _, path := range fresh {
+		ix.add(path, current[path])
+	}
+	ix.link()
+	return true, nil
+}
+
+// scan lists the Markdown files in the corpus: the
+// top-level files of the context directory plus every
+// file under [cfgSearch.Dirs]. Hidden files and
+// directories are skipped.
+//
+// Parameters:
+//   - ctxDir: the context directory
+//
+// Returns:
+//   - map[string]fs.FileInfo: file info by slash-separated
+//     path relative to ctxDir
+//   - error: non-nil if the context directory cannot be
+//     read
+func scan(ctxDir string) (map[string]fs.FileInfo, error) {
+	out := make(map[string]fs.FileInfo)
+	entries, readErr := os.ReadDir(ctxDir)
+	if readErr != nil {
+		return nil, readErr
+	}
+	for _, e := range entries {
+		if !e.Type().IsRegular() || !indexable(e.Name()) {
+			continue
+		}
+		if info, infoErr := e.Info(); infoErr == nil {
+			out[e.Name()] = info
+		}
+	}
+
+	for _, sub := range cfgSearch.Dirs {
+		root := filepath.Join(ctxDir, sub)
+		// Acceptable discard: a missing or unreadable subtree
+		// is simply not indexed.
+		_ = filepath.WalkDir(root, func(
+			path string, e fs.DirEntry, walkErr error,
+		) error {
+			if walkErr != nil {
+				return nil
+			}
+			if e.IsDir() {
+				if path != root &&
+					strings.HasPrefix(e.Name(), token.Dot) {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+			if !e.Type().IsRegular() || !indexable(e.Name()) {
+				return nil
+			}
+			rel, relErr := filepath.Rel(ctxDir, path)
+			info, infoErr := e.Info()
+			if relErr == nil && infoErr == nil {
+				out[filepath.ToSlash(rel)] = info
+			}
+			return nil
+		})
+	}
+	return out, nil
+}
+
+// indexable reports whether a file name belongs in the
+// corpus.
+//
+// Parameters:
+//   - name: file base name
+//
+// Returns:
+//   - bool: true for visible Markdown files
+func indexable(name string) bool {
+	return strings.HasSuffix(name, cfgFile.ExtMarkdown) &&
+		!strings.HasPrefix(name, token.Dot)
+}
+
+// drop removes every document of the given files from the
+// index.
+//
+// Parameters:
+//   - paths: files to forget
+func (ix *Index) drop(paths map[string]bool) {
+	if len(paths) == 0 {
+		return
+	}
+	gone := make(map[int]bool)
+	for path := range paths {
+		for _, d := range ix.data.Files[path].Docs {
+			gone[d.ID] = true
+		}
+		delete(ix.data.Files, path)
+	}
+	for term, list := range ix.data.Terms {
+		kept := list[:0]
+		for _, p := range list {
+			if !gone[p.Doc] {
+				kept = append(kept, p)
+			}
+		}
+		if len(kept) == 0 {
+			delete(ix.data.Terms, term)
+			continue
+		}
+		ix.data.Terms[term] = kept
+	}
+}
+
+// add reads one file, cuts it into documents, and appends
+// their postings. A file that cannot be read (for example,
+// one removed since the scan) is left out and retried on
+// the next sync.
+//
+// Parameters:
+//   - path: slash-separated path relative to the context
+//     directory
+//   - info: the file's current stat
+func (ix *Index) add(path string, info fs.FileInfo) {
+	data, readErr := ctxIo.SafeReadUserFile(
+		filepath.Join(ix.ctxDir, filepath.FromSlash(path)),
+	)
+	if readErr != nil {
+		return
+	}
+
+	fe := fileEntry{
+		ModTime: info.ModTime().UnixNano(),
+		Size:    info.Size(),
+	}
+	for _, s := range sections(path, string(data)) {
+		d := s.doc
+		d.ID = ix.data.NextID
+		ix.data.NextID++
+
+		counts := make(map[string]int)
+		for _, term := range tokenize(s.text) {
+			counts[term]++
+			d.Length++
+		}
+		for term, tf := range counts {
+			ix.data.Terms[term] = append(
+				ix.data.Terms[term], posting{Doc: d.ID, TF: tf},
+			)
+		}
+		fe.Docs = append(fe.Docs, d)
+	}
+	ix.data.Files[path] = fe
+}
+
+// link rebuilds the document ID lookup from the stored
+// files.
+func (ix *Index) link() {
+	ix.docs = make(map[int]docRef)
+	for path, fe := range ix.data.Files {
+		for i := range fe.Docs {
+			ix.docs[fe.Docs[i].ID] = docRef{path: path, pos: i}
+		}
+	}
+}
+
+// save writes the index atomically.
+//
+// Returns:
+//   - error: non-nil if encoding or writing fails
+func (ix *Index) save() error {
+	path := indexPath(ix.ctxDir)
+	data, marshalErr := json.Marshal(ix.data)
+	if marshalErr != nil {
+		return errSearch.WriteIndex(path, marshalErr)
+	}
+	if mkErr := ctxIo.SafeMkdirAll(
+		filepath.Dir(path), cfgFs.PermExec,
+	); mkErr != nil {
+		return errSearch.WriteIndex(path, mkErr)
+	}
+	if writeErr := ctxIo.SafeWriteFileAtomic(
+		path, data, cfgFs.PermFile,
+	); writeErr != nil {
+		return errSearch.WriteIndex(path, writeErr)
+	}
+	ix.dirty = false
+	return nil
+}
+
+// doc resolves a document ID.
+//
+// Parameters:
+//   - id: document ID
+//
+// Returns:
+//   - *doc: the document, or nil when unknown
+func (ix *Index) doc(id int) *doc {
+	ref, ok := ix.docs[id]
+	if !ok {
+		return nil
+	}
+	return &ix.data.Files[ref.path].Docs[ref.pos]
+}
+
+// avgLength returns the mean document length in terms.
+//
+// Returns:
+//   - float64: average length; 1 for an index of empty
+//     documents
+func (ix *Index) avgLength() float64 {
+	total := 0
+	for _, fe := range ix.data.Files {
+		for _, d := range fe.Docs {
+			total += d.Length
+		}
+	}
+	if total == 0 || len(ix.docs) == 0 {
+		return 1
+	}
+	return float64(total) / float64(len(ix.docs))
+}
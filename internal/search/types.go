@@ -0,0 +1,130 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package search
+
+// Index is a loaded, refreshed search index for one
+// context directory.
+//
+// Fields:
+//   - ctxDir: the context directory being indexed
+//   - data: the persisted index
+//   - docs: document ID to location, rebuilt on load
+//   - dirty: true when data differs from the file on disk
+type Index struct {
+	ctxDir string
+	data   store
+	docs   map[int]docRef
+	dirty  bool
+}
+
+// store is the on-disk form of the index.
+//
+// Fields:
+//   - Version: schema version ([cfgSearch.Version])
+//   - NextID: next unused document ID
+//   - Files: indexed files by slash-separated path
+//     relative to the context directory
+//   - Terms: posting list per term
+type store struct {
+	Version int                  `json:"version"`
+	NextID  int                  `json:"next_id"`
+	Files   map[string]fileEntry `json:"files"`
+	Terms   map[string][]posting `json:"terms"`
+}
+
+// fileEntry records one indexed file.
+//
+// Fields:
+//   - ModTime: modification time (Unix nanoseconds) when
+//     indexed
+//   - Size: file size in bytes when indexed
+//   - Docs: documents cut from the file, in file order
+type fileEntry struct {
+	ModTime int64 `json:"mtime"`
+	Size    int64 `json:"size"`
+	Docs    []doc `json:"docs"`
+}
+
+// doc is one indexed document.
+//
+// Fields:
+//   - ID: document ID referenced by postings
+//   - Line: 1-based first line
+//   - End: 1-based last line, inclusive
+//   - Type: document type
+//   - Key: entry timestamp, empty for untimestamped
+//     sections
+//   - Title: heading text
+//   - Date: YYYY-MM-DD, empty when undated
+//   - Length: number of indexed terms
+type doc struct {
+	ID     int    `json:"id"`
+	Line   int    `json:"line"`
+	End    int    `json:"end"`
+	Type   string `json:"type"`
+	Key    string `json:"key,omitempty"`
+	Title  string `json:"title"`
+	Date   string `json:"date,omitempty"`
+	Length int    `json:"len"`
+}
+
+// posting is one document's entry in a term's list.
+//
+// Fields:
+//   - Doc: document ID
+//   - TF: occurrences of the term in the document
+type posting struct {
+	Doc int `json:"d"`
+	TF  int `json:"f"`
+}
+
+// docRef locates a document in the loaded index.
+//
+// Fields:
+//   - path: file path key in store.Files
+//   - pos: position in that file's Docs
+type docRef struct {
+	path string
+	pos  int
+}
+
+// query is parsed query text.
+//
+// Fields:
+//   - terms: every indexed word of the query, phrase
+//     words included
+//   - phrases: word sequences that must appear verbatim
+//   - types: allowed document types (empty = all)
+//   - since: earliest document date, YYYY-MM-DD (empty =
+//     unbounded)
+type query struct {
+	terms   []string
+	phrases [][]string
+	types   map[string]bool
+	since   string
+}
+
+// section is a span of a file before tokenization.
+//
+// Fields:
+//   - doc: document metadata (ID and Length unset)
+//   - text: section text used for indexing
+type section struct {
+	doc  doc
+	text string
+}
+
+// source caches file lines for the duration of one search.
+//
+// Fields:
+//   - ctxDir: the context directory
+//   - files: lines by relative path (nil for an unreadable
+//     file)
+type source struct {
+	ctxDir string
+	files  map[string][]string
+}
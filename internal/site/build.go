@@ -0,0 +1,65 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package site
+
+import (
+	"path/filepath"
+
+	cfgFs "github.com/ActiveMemory/ctx/internal/config/fs"
+	"github.com/ActiveMemory/ctx/internal/entity"
+	errFs "github.com/ActiveMemory/ctx/internal/err/fs"
+	ctxIo "github.com/ActiveMemory/ctx/internal/io"
+)
+
+// Build renders the Markdown tree under src into a static
+// site at dst.
+//
+// Parameters:
+//   - src: directory holding the Markdown sources
+//   - dst: output directory (created if missing); may sit
+//     inside src, in which case it is not read as source
+//   - title: site title shown in every page header
+//   - nav: sidebar navigation with .md paths relative to
+//     src; nil derives it from the tree
+//
+// Returns:
+//   - int: number of pages rendered
+//   - error: non-nil if src cannot be read or the output
+//     cannot be written
+func Build(
+	src, dst, title string, nav []entity.NavItem,
+) (int, error) {
+	t, scanErr := scan(src, dst)
+	if scanErr != nil {
+		return 0, scanErr
+	}
+	if nav == nil {
+		nav = treeNav(t.pages, "")
+	}
+	if mkErr := ctxIo.SafeMkdirAll(dst, cfgFs.PermExec); mkErr != nil {
+		return 0, errFs.Mkdir(dst, mkErr)
+	}
+
+	for _, rel := range t.assets {
+		if copyErr := copyFile(
+			filepath.Join(src, filepath.FromSlash(rel)),
+			filepath.Join(dst, filepath.FromSlash(rel)),
+		); copyErr != nil {
+			return 0, copyErr
+		}
+	}
+	for _, p := range t.pages {
+		if writeErr := writePage(dst, title, nav, p); writeErr != nil {
+			return 0, writeErr
+		}
+	}
+	if assetErr := writeAssets(dst, t.pages); assetErr != nil {
+		return 0, assetErr
+	}
+	prune(dst, t)
+	return len(t.pages), nil
+}
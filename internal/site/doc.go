@@ -0,0 +1,43 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package site builds and serves static HTML sites from
+// Markdown trees, with no external toolchain.
+//
+// `ctx journal site --build` feeds it the generated journal
+// tree (month pages, topic, file, and type indexes);
+// `ctx kb site` feeds it the knowledge base; `ctx serve`
+// uses it to host either.
+//
+// # Build
+//
+// [Build] renders every Markdown file under a source
+// directory to an HTML page at the same relative path,
+// copies every other file as-is, and writes:
+//
+//   - a page layout with a sidebar and a search box,
+//   - the stylesheet and search script under _ctx/,
+//   - _ctx/search.json, the client-side search index
+//     (title, URL, and text of every page).
+//
+// Pages link to each other and to the assets by relative
+// paths, so a built site works from any URL prefix.
+// Navigation is either supplied by the caller or derived
+// from the tree: each directory lists its index page, its
+// other pages, then its subdirectories as sections.
+//
+// HTML files left in the output by an earlier build whose
+// source page has since gone are removed.
+//
+// # Serve
+//
+// [Serve] hosts a built site over HTTP until interrupted.
+//
+// # Public Surface
+//
+//   - [Build]: render a Markdown tree to a site.
+//   - [Serve]: host a directory over HTTP.
+package site
@@ -0,0 +1,75 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package site
+
+import (
+	"path"
+	"slices"
+	"strings"
+
+	cfgFile "github.com/ActiveMemory/ctx/internal/config/file"
+	"github.com/ActiveMemory/ctx/internal/config/token"
+	"github.com/ActiveMemory/ctx/internal/entity"
+)
+
+// treeNav derives navigation from the source tree. A
+// directory lists its index page (at the root only; a
+// subdirectory's index is its section link), then its
+// other pages, then its subdirectories as sections.
+//
+// Parameters:
+//   - pages: every page, sorted by path
+//   - dir: slash-separated directory to list ("" for the
+//     root)
+//
+// Returns:
+//   - []entity.NavItem: the directory's entries
+func treeNav(pages []page, dir string) []entity.NavItem {
+	prefix := ""
+	if dir != "" {
+		prefix = dir + token.Slash
+	}
+
+	var items []entity.NavItem
+	var subdirs []string
+	titles := make(map[string]string)
+	for _, p := range pages {
+		titles[p.rel] = pageTitle(p)
+		rel, ok := strings.CutPrefix(p.rel, prefix)
+		if !ok {
+			continue
+		}
+		if sub, _, nested := strings.Cut(rel, token.Slash); nested {
+			if !slices.Contains(subdirs, sub) {
+				subdirs = append(subdirs, sub)
+			}
+			continue
+		}
+		item := entity.NavItem{Title: pageTitle(p), Path: p.rel}
+		switch {
+		case rel != cfgFile.Index:
+			items = append(items, item)
+		case dir == "":
+			items = append([]entity.NavItem{item}, items...)
+		}
+	}
+
+	for _, sub := range subdirs {
+		subDir := path.Join(dir, sub)
+		section := entity.NavItem{
+			Title:    sub,
+			Children: treeNav(pages, subDir),
+		}
+		index := path.Join(subDir, cfgFile.Index)
+		if title, ok := titles[index]; ok {
+			section.Title = title
+			section.Path = index
+		}
+		items = append(items, section)
+	}
+	return items
+}
@@ -0,0 +1,305 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package site
+
+import (
+	"encoding/json"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	readSite "github.com/ActiveMemory/ctx/internal/assets/read/site"
+	"github.com/ActiveMemory/ctx/internal/assets/tpl"
+	cfgFile "github.com/ActiveMemory/ctx/internal/config/file"
+	cfgFs "github.com/ActiveMemory/ctx/internal/config/fs"
+	cfgSite "github.com/ActiveMemory/ctx/internal/config/site"
+	"github.com/ActiveMemory/ctx/internal/config/token"
+	"github.com/ActiveMemory/ctx/internal/entity"
+	errFs "github.com/ActiveMemory/ctx/internal/err/fs"
+	errSite "github.com/ActiveMemory/ctx/internal/err/site"
+	ctxIo "github.com/ActiveMemory/ctx/internal/io"
+	"github.com/ActiveMemory/ctx/internal/markdown"
+)
+
+// scan reads and renders the source tree. Hidden files and
+// directories are skipped, as is the output directory when
+// it lies inside the source.
+//
+// Parameters:
+//   - src: source root
+//   - dst: output directory
+//
+// Returns:
+//   - tree: rendered pages and other files, sorted
+//   - error: non-nil if the tree cannot be walked or a page
+//     cannot be read
+func scan(src, dst string) (tree, error) {
+	var t tree
+	skip, absErr := filepath.Abs(dst)
+	if absErr != nil {
+		skip = dst
+	}
+	walkErr := filepath.WalkDir(src, func(
+		p string, e fs.DirEntry, walkErr error,
+	) error {
+		if walkErr != nil {
+			return errFs.ReadDir(p, walkErr)
+		}
+		if p != src && strings.HasPrefix(e.Name(), token.Dot) {
+			if e.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if e.IsDir() {
+			if abs, pathErr := filepath.Abs(p); pathErr == nil && abs == skip {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !e.Type().IsRegular() {
+			return nil
+		}
+		rel, relErr := filepath.Rel(src, p)
+		if relErr != nil {
+			return nil
+		}
+		rel = filepath.ToSlash(rel)
+		if !strings.HasSuffix(rel, cfgFile.ExtMarkdown) {
+			t.assets = append(t.assets, rel)
+			return nil
+		}
+		data, readErr := ctxIo.SafeReadUserFile(p)
+		if readErr != nil {
+			return errFs.FileRead(p, readErr)
+		}
+		t.pages = append(t.pages, page{
+			rel: rel, out: markdown.Render(string(data)),
+		})
+		return nil
+	})
+	if walkErr != nil {
+		return tree{}, walkErr
+	}
+	slices.SortFunc(t.pages, func(a, b page) int {
+		return strings.Compare(a.rel, b.rel)
+	})
+	slices.Sort(t.assets)
+	return t, nil
+}
+
+// copyFile copies one non-Markdown file into the output.
+//
+// Parameters:
+//   - from: source file
+//   - to: destination file
+//
+// Returns:
+//   - error: non-nil if reading or writing fails
+func copyFile(from, to string) error {
+	data, readErr := ctxIo.SafeReadUserFile(from)
+	if readErr != nil {
+		return errFs.FileRead(from, readErr)
+	}
+	return writeFile(to, data)
+}
+
+// writeFile writes one output file, creating its
+// directory.
+//
+// Parameters:
+//   - to: destination file
+//   - data: file content
+//
+// Returns:
+//   - error: non-nil if the directory or file cannot be
+//     written
+func writeFile(to string, data []byte) error {
+	d := filepath.Dir(to)
+	if mkErr := ctxIo.SafeMkdirAll(d, cfgFs.PermExec); mkErr != nil {
+		return errFs.Mkdir(d, mkErr)
+	}
+	if writeErr := ctxIo.SafeWriteFile(
+		to, data, cfgFs.PermFile,
+	); writeErr != nil {
+		return errFs.FileWrite(to, writeErr)
+	}
+	return nil
+}
+
+// htmlPath maps a Markdown source path to its page path.
+//
+// Parameters:
+//   - rel: slash-separated .md path
+//
+// Returns:
+//   - string: the same path with an .html extension
+func htmlPath(rel string) string {
+	return strings.TrimSuffix(rel, cfgFile.ExtMarkdown) + cfgFile.ExtHTML
+}
+
+// pageTitle returns a page's title, falling back to its
+// file name.
+//
+// Parameters:
+//   - p: rendered page
+//
+// Returns:
+//   - string: display title
+func pageTitle(p page) string {
+	if p.out.Title != "" {
+		return p.out.Title
+	}
+	return strings.TrimSuffix(path.Base(p.rel), cfgFile.ExtMarkdown)
+}
+
+// links converts navigation items into the sidebar links
+// of one page.
+//
+// Parameters:
+//   - nav: navigation items
+//   - root: relative path from the page to the site root
+//   - current: source path of the page
+//
+// Returns:
+//   - []entity.SiteNavLink: the sidebar entries
+func links(
+	nav []entity.NavItem, root, current string,
+) []entity.SiteNavLink {
+	out := make([]entity.SiteNavLink, 0, len(nav))
+	for _, item := range nav {
+		link := entity.SiteNavLink{
+			Title:    item.Title,
+			Current:  item.Path != "" && item.Path == current,
+			Children: links(item.Children, root, current),
+		}
+		if item.Path != "" {
+			link.Href = root + htmlPath(item.Path)
+		}
+		out = append(out, link)
+	}
+	return out
+}
+
+// writePage lays out one rendered page and writes it.
+//
+// Parameters:
+//   - dst: output root
+//   - title: site title
+//   - nav: site navigation
+//   - p: the page
+//
+// Returns:
+//   - error: non-nil if layout or writing fails
+func writePage(
+	dst, title string, nav []entity.NavItem, p page,
+) error {
+	root := strings.Repeat(cfgSite.RootUp, strings.Count(p.rel, token.Slash))
+	assets := root + cfgSite.AssetDir + token.Slash
+	out, renderErr := tpl.Render(tpl.SitePage, entity.SitePage{
+		SiteTitle: title,
+		Title:     pageTitle(p),
+		Root:      root,
+		Home:      root + cfgSite.IndexHTML,
+		Style:     assets + cfgSite.FileStyle,
+		Script:    assets + cfgSite.FileScript,
+		Index:     assets + cfgSite.FileSearchIndex,
+		Nav:       links(nav, root, p.rel),
+		Body:      p.out.HTML,
+	})
+	if renderErr != nil {
+		return errSite.RenderPage(p.rel, renderErr)
+	}
+	return writeFile(
+		filepath.Join(dst, filepath.FromSlash(htmlPath(p.rel))),
+		[]byte(out),
+	)
+}
+
+// writeAssets writes the stylesheet, the search script,
+// and the search index.
+//
+// Parameters:
+//   - dst: output root
+//   - pages: every rendered page
+//
+// Returns:
+//   - error: non-nil if an asset cannot be read or written
+func writeAssets(dst string, pages []page) error {
+	dir := filepath.Join(dst, cfgSite.AssetDir)
+	style, styleErr := readSite.Style()
+	if styleErr != nil {
+		return styleErr
+	}
+	script, scriptErr := readSite.Script()
+	if scriptErr != nil {
+		return scriptErr
+	}
+
+	docs := make([]searchDoc, 0, len(pages))
+	for _, p := range pages {
+		text := []rune(p.out.Text)
+		docs = append(docs, searchDoc{
+			Title: pageTitle(p),
+			URL:   htmlPath(p.rel),
+			Text:  string(text[:min(len(text), cfgSite.SearchTextMax)]),
+		})
+	}
+	index, marshalErr := json.Marshal(docs)
+	if marshalErr != nil {
+		return errFs.FileWrite(cfgSite.FileSearchIndex, marshalErr)
+	}
+
+	for name, data := range map[string][]byte{
+		cfgSite.FileStyle:       style,
+		cfgSite.FileScript:      script,
+		cfgSite.FileSearchIndex: index,
+	} {
+		if writeErr := writeFile(
+			filepath.Join(dir, name), data,
+		); writeErr != nil {
+			return writeErr
+		}
+	}
+	return nil
+}
+
+// prune removes HTML pages left by an earlier build whose
+// source page no longer exists. Only .html files are
+// touched, and HTML files copied from the source stay.
+//
+// Parameters:
+//   - dst: output root
+//   - t: the tree of this build
+func prune(dst string, t tree) {
+	keep := make(map[string]bool, len(t.pages)+len(t.assets))
+	for _, p := range t.pages {
+		keep[htmlPath(p.rel)] = true
+	}
+	for _, rel := range t.assets {
+		keep[rel] = true
+	}
+	// Acceptable discard: pruning is best-effort; a stale
+	// page that cannot be removed is harmless.
+	_ = filepath.WalkDir(dst, func(
+		p string, e fs.DirEntry, walkErr error,
+	) error {
+		if walkErr != nil || e.IsDir() ||
+			!strings.HasSuffix(p, cfgFile.ExtHTML) {
+			return nil
+		}
+		rel, relErr := filepath.Rel(dst, p)
+		if relErr == nil && !keep[filepath.ToSlash(rel)] {
+			// Acceptable discard: see above.
+			_ = os.Remove(p)
+		}
+		return nil
+	})
+}
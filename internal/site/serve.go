@@ -0,0 +1,59 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package site
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+
+	cfgSite "github.com/ActiveMemory/ctx/internal/config/site"
+	errSite "github.com/ActiveMemory/ctx/internal/err/site"
+)
+
+// Serve hosts a directory over HTTP until interrupted.
+//
+// Parameters:
+//   - dir: directory to serve (a built site)
+//   - addr: listen address (host:port; port 0 picks one)
+//   - ready: called with the bound address once the
+//     server is listening
+//
+// Returns:
+//   - error: non-nil if the listener or the server fails;
+//     nil after an interrupt
+func Serve(dir, addr string, ready func(net.Addr)) error {
+	lis, lisErr := net.Listen(cfgSite.Network, addr)
+	if lisErr != nil {
+		return errSite.Serve(addr, lisErr)
+	}
+	srv := &http.Server{
+		Handler:           http.FileServer(http.Dir(dir)),
+		ReadHeaderTimeout: cfgSite.ReadHeaderTimeout,
+	}
+	ready(lis.Addr())
+
+	ctx, stop := signal.NotifyContext(
+		context.Background(), os.Interrupt,
+	)
+	defer stop()
+	go func() {
+		<-ctx.Done()
+		// Acceptable discard: the server is going away; a
+		// failed close leaves nothing to recover.
+		_ = srv.Close()
+	}()
+
+	if serveErr := srv.Serve(lis); serveErr != nil &&
+		!errors.Is(serveErr, http.ErrServerClosed) {
+		return errSite.Serve(addr, serveErr)
+	}
+	return nil
+}
@@ -0,0 +1,200 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package site
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ActiveMemory/ctx/internal/entity"
+)
+
+// writeTree creates files under root from a path → content map.
+func writeTree(t *testing.T, root string, files map[string]string) {
+	t.Helper()
+	for rel, content := range files {
+		p := filepath.Join(root, filepath.FromSlash(rel))
+		if err := os.MkdirAll(filepath.Dir(p), 0o750); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(p, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func readFile(t *testing.T, p string) string {
+	t.Helper()
+	data, err := os.ReadFile(p)
+	if err != nil {
+		t.Fatalf("read %s: %v", p, err)
+	}
+	return string(data)
+}
+
+func TestBuild(t *testing.T) {
+	src := t.TempDir()
+	dst := filepath.Join(t.TempDir(), "out")
+	writeTree(t, src, map[string]string{
+		"index.md":          "# Home\n\nSee [notes](topics/notes.md).",
+		"topics/index.md":   "# Topics",
+		"topics/notes.md":   "# Notes\n\nsearchable words",
+		"img/logo.svg":      "<svg/>",
+		".hidden/secret.md": "# Secret",
+	})
+
+	n, err := Build(src, dst, "Test Site", nil)
+	if err != nil {
+		t.Fatalf("Build: %v", err)
+	}
+	if n != 3 {
+		t.Errorf("Build rendered %d pages, want 3", n)
+	}
+
+	home := readFile(t, filepath.Join(dst, "index.html"))
+	for _, w := range []string{
+		"<title>Home",
+		`href="topics/notes.html"`,
+		`href="_ctx/style.css"`,
+		"Test Site",
+	} {
+		if !strings.Contains(home, w) {
+			t.Errorf("index.html missing %q", w)
+		}
+	}
+
+	notes := readFile(t, filepath.Join(dst, "topics", "notes.html"))
+	if !strings.Contains(notes, `src="../_ctx/search.js"`) {
+		t.Error("nested page does not link assets relative to root")
+	}
+
+	if got := readFile(t, filepath.Join(dst, "img", "logo.svg")); got != "<svg/>" {
+		t.Errorf("asset not copied verbatim: %q", got)
+	}
+	if _, statErr := os.Stat(
+		filepath.Join(dst, ".hidden", "secret.html"),
+	); !os.IsNotExist(statErr) {
+		t.Error("hidden directory was rendered")
+	}
+
+	var docs []searchDoc
+	index := readFile(t, filepath.Join(dst, "_ctx", "search.json"))
+	if err := json.Unmarshal([]byte(index), &docs); err != nil {
+		t.Fatalf("search.json: %v", err)
+	}
+	found := false
+	for _, d := range docs {
+		if d.URL == "topics/notes.html" &&
+			strings.Contains(d.Text, "searchable words") {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("search index lacks the notes page: %+v", docs)
+	}
+}
+
+func TestBuild_Prune(t *testing.T) {
+	src := t.TempDir()
+	dst := filepath.Join(src, "site")
+	writeTree(t, src, map[string]string{
+		"index.md": "# Home",
+		"old.md":   "# Old",
+		"raw.html": "<p>kept</p>",
+	})
+	if _, err := Build(src, dst, "T", nil); err != nil {
+		t.Fatalf("first Build: %v", err)
+	}
+	if err := os.Remove(filepath.Join(src, "old.md")); err != nil {
+		t.Fatal(err)
+	}
+	n, err := Build(src, dst, "T", nil)
+	if err != nil {
+		t.Fatalf("second Build: %v", err)
+	}
+	if n != 1 {
+		t.Errorf("second Build rendered %d pages, want 1", n)
+	}
+	if _, statErr := os.Stat(
+		filepath.Join(dst, "old.html"),
+	); !os.IsNotExist(statErr) {
+		t.Error("stale page was not pruned")
+	}
+	if _, statErr := os.Stat(filepath.Join(dst, "raw.html")); statErr != nil {
+		t.Error("copied HTML asset was pruned")
+	}
+	if _, statErr := os.Stat(
+		filepath.Join(dst, "site", "index.html"),
+	); !os.IsNotExist(statErr) {
+		t.Error("output directory inside the source was rendered")
+	}
+}
+
+func TestBuild_Nav(t *testing.T) {
+	src := t.TempDir()
+	dst := t.TempDir()
+	writeTree(t, src, map[string]string{
+		"index.md": "# Home",
+		"a.md":     "# Alpha",
+	})
+	nav := []entity.NavItem{
+		{Title: "Start", Path: "index.md"},
+		{Title: "Group", Children: []entity.NavItem{
+			{Title: "First", Path: "a.md"},
+		}},
+	}
+	if _, err := Build(src, dst, "T", nav); err != nil {
+		t.Fatalf("Build: %v", err)
+	}
+	page := readFile(t, filepath.Join(dst, "a.html"))
+	for _, w := range []string{"Start", "Group", `href="a.html"`} {
+		if !strings.Contains(page, w) {
+			t.Errorf("a.html missing nav entry %q", w)
+		}
+	}
+}
+
+func TestTreeNav(t *testing.T) {
+	pages := []page{
+		{rel: "a.md"},
+		{rel: "index.md"},
+		{rel: "sub/b.md"},
+		{rel: "sub/index.md"},
+	}
+	pages[1].out.Title = "Home"
+	pages[3].out.Title = "Sub Section"
+
+	nav := treeNav(pages, "")
+	if len(nav) != 3 {
+		t.Fatalf("treeNav returned %d items, want 3: %+v", len(nav), nav)
+	}
+	if nav[0].Path != "index.md" || nav[0].Title != "Home" {
+		t.Errorf("first item = %+v, want the root index", nav[0])
+	}
+	if nav[1].Path != "a.md" || nav[1].Title != "a" {
+		t.Errorf("second item = %+v, want a.md titled by name", nav[1])
+	}
+	sub := nav[2]
+	if sub.Title != "Sub Section" || sub.Path != "sub/index.md" {
+		t.Errorf("section = %+v, want titled by its index", sub)
+	}
+	if len(sub.Children) != 1 || sub.Children[0].Path != "sub/b.md" {
+		t.Errorf("section children = %+v, want only sub/b.md", sub.Children)
+	}
+}
+
+func TestBuild_MissingSource(t *testing.T) {
+	_, err := Build(
+		filepath.Join(t.TempDir(), "absent"), t.TempDir(), "T", nil,
+	)
+	if err == nil {
+		t.Fatal("expected error for a missing source directory")
+	}
+}
@@ -0,0 +1,34 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package site
+
+import "github.com/ActiveMemory/ctx/internal/entity"
+
+// page is one rendered Markdown source.
+type page struct {
+	// rel is the source path relative to the site root,
+	// slash-separated, with the .md extension.
+	rel string
+	// out is the rendered page.
+	out entity.RenderedPage
+}
+
+// tree is the content of a source directory.
+type tree struct {
+	// pages are the Markdown files, sorted by path.
+	pages []page
+	// assets are the other files, as slash-separated paths
+	// relative to the source root.
+	assets []string
+}
+
+// searchDoc is one entry of the client-side search index.
+type searchDoc struct {
+	Title string `json:"title"`
+	URL   string `json:"url"`
+	Text  string `json:"text"`
+}
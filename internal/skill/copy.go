@@ -10,29 +10,36 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/ActiveMemory/ctx/internal/config/fs"
+	"github.com/ActiveMemory/ctx/internal/config/token"
 	cfgWarn "github.com/ActiveMemory/ctx/internal/config/warn"
 	ctxIo "github.com/ActiveMemory/ctx/internal/io"
 	logWarn "github.com/ActiveMemory/ctx/internal/log/warn"
 )
 
 // copyDir recursively copies the contents of src into dst.
-// Both directories must already exist.
+// Both directories must already exist. Dotfiles at the top
+// level (.git, .DS_Store) are skipped.
 //
 // Parameters:
 //   - src: source directory to copy from
 //   - dst: destination directory to copy into
+//   - top: true for the source root
 //
 // Returns:
 //   - error: read, mkdir, or file-copy failure
-func copyDir(src, dst string) error {
+func copyDir(src, dst string, top bool) error {
 	entries, readErr := os.ReadDir(src)
 	if readErr != nil {
 		return readErr
 	}
 
 	for _, entry := range entries {
+		if top && strings.HasPrefix(entry.Name(), token.Dot) {
+			continue
+		}
 		srcPath := filepath.Join(src, entry.Name())
 		dstPath := filepath.Join(dst, entry.Name())
 
@@ -42,7 +49,7 @@ func copyDir(src, dst string) error {
 			); mkdirErr != nil {
 				return mkdirErr
 			}
-			if recurseErr := copyDir(srcPath, dstPath); recurseErr != nil {
+			if recurseErr := copyDir(srcPath, dstPath, false); recurseErr != nil {
 				return recurseErr
 			}
 			continue
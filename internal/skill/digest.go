@@ -0,0 +1,84 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package skill
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io/fs"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	cfgSkill "github.com/ActiveMemory/ctx/internal/config/skill"
+	"github.com/ActiveMemory/ctx/internal/config/token"
+	errSkill "github.com/ActiveMemory/ctx/internal/err/skill"
+	ctxIo "github.com/ActiveMemory/ctx/internal/io"
+)
+
+// Digest returns the SHA-256 content hash of a skill directory:
+// the hash of one line per file (slash-separated relative path
+// and the file's own SHA-256), in path order. Top-level dotfiles
+// are left out, as [Install] does not copy them, so a source
+// directory and its installed copy hash the same. File modes
+// are not hashed.
+//
+// Parameters:
+//   - dir: the skill directory
+//
+// Returns:
+//   - string: hex-encoded SHA-256
+//   - error: non-nil when the tree cannot be walked or read
+func Digest(dir string) (string, error) {
+	var paths []string
+	walkErr := filepath.WalkDir(dir,
+		func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if path == dir {
+				return nil
+			}
+			rel, relErr := filepath.Rel(dir, path)
+			if relErr != nil {
+				return relErr
+			}
+			if filepath.Dir(rel) == token.Dot &&
+				strings.HasPrefix(d.Name(), token.Dot) {
+				if d.IsDir() {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+			if !d.IsDir() {
+				paths = append(paths, filepath.ToSlash(rel))
+			}
+			return nil
+		},
+	)
+	if walkErr != nil {
+		return "", errSkill.Digest(dir, walkErr)
+	}
+	sort.Strings(paths)
+
+	var sb strings.Builder
+	for _, rel := range paths {
+		data, readErr := ctxIo.SafeReadUserFile(
+			filepath.Join(dir, filepath.FromSlash(rel)),
+		)
+		if readErr != nil {
+			return "", errSkill.Digest(dir, readErr)
+		}
+		sum := sha256.Sum256(data)
+		sb.WriteString(rel)
+		sb.WriteString(cfgSkill.DigestSep)
+		sb.WriteString(hex.EncodeToString(sum[:]))
+		sb.WriteString(token.NewlineLF)
+	}
+	sum := sha256.Sum256([]byte(sb.String()))
+	return hex.EncodeToString(sum[:]), nil
+}
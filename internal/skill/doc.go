@@ -33,6 +33,8 @@
 //     `read`, `write`, `edit`, `glob`, `grep`).
 //   - **allowed-tools**: Claude-Code-style permission
 //     scopes (`Bash(ctx:*)`, `Read`, etc.).
+//   - **version**: optional author-assigned version,
+//     recorded in the lockfile.
 //
 // [manifest.go] parses and validates the frontmatter;
 // missing required fields produce a typed error from
@@ -54,6 +56,29 @@
 //     verifying it lives under the canonical skills
 //     directory (boundary check guards against `..`
 //     escape).
+//   - **[Digest]**: SHA-256 over a skill tree's relative
+//     paths and file contents, ignoring top-level
+//     dotfiles, so a copy and its source hash alike.
+//   - **[ReadLock] / [WriteLock]**: load and persist
+//     `.context/skills.lock`.
+//   - **[Track] / [Untrack]**: add or drop a [LockEntry].
+//   - **[Verify]**: compare installed skills with the lock
+//     without touching sources.
+//   - **[Update]**: reinstall locked skills whose source
+//     changed, skipping locally modified copies unless
+//     forced.
+//
+// # The Lockfile
+//
+// `skills.lock` is JSON, one [LockEntry] per skill:
+// name, source, version, commit, remote, sha256, and
+// install time. Sources inside the project are stored
+// relative to its root so the lock is portable across
+// checkouts. A source outside it is stored as an absolute
+// path, with the commit and origin of the git checkout
+// holding it. Sources are always local directories;
+// updating from a shared repository means pulling it
+// first, so nothing here touches the network.
 //
 // # File-Copy Semantics
 //
@@ -53,7 +53,7 @@ func Install(source, skillsDir string) (*Skill, error) {
 		return nil, errSkill.CreateDest(mkdirErr)
 	}
 
-	if copyErr := copyDir(source, destDir); copyErr != nil {
+	if copyErr := copyDir(source, destDir, true); copyErr != nil {
 		// Clean up partial copy on failure.
 		if rmErr := os.RemoveAll(destDir); rmErr != nil {
 			logWarn.Warn(cfgWarn.Remove, destDir, rmErr)
@@ -0,0 +1,136 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package skill
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/ActiveMemory/ctx/internal/config/fs"
+	cfgSkill "github.com/ActiveMemory/ctx/internal/config/skill"
+	"github.com/ActiveMemory/ctx/internal/config/token"
+	cfgWarn "github.com/ActiveMemory/ctx/internal/config/warn"
+	errSkill "github.com/ActiveMemory/ctx/internal/err/skill"
+	ctxIo "github.com/ActiveMemory/ctx/internal/io"
+	logWarn "github.com/ActiveMemory/ctx/internal/log/warn"
+)
+
+// ReadLock loads skills.lock, and the machine-local sources kept
+// beside it in state/. A missing file is an empty lock.
+//
+// Parameters:
+//   - path: the lockfile path
+//
+// Returns:
+//   - *Lock: the parsed lock
+//   - error: non-nil on read or parse failure
+func ReadLock(path string) (*Lock, error) {
+	lock := &Lock{Version: cfgSkill.LockVersion}
+	if readErr := readJSON(path, lock); readErr != nil {
+		return nil, readErr
+	}
+	if readErr := readJSON(sourcesPath(path), &lock.Local); readErr != nil {
+		return nil, readErr
+	}
+	return lock, nil
+}
+
+// WriteLock writes skills.lock atomically, entries sorted by
+// name. Callers that read first hold the context write lock.
+//
+// Parameters:
+//   - path: the lockfile path
+//   - lock: the lock to persist
+//
+// Returns:
+//   - error: non-nil on marshal or write failure
+func WriteLock(path string, lock *Lock) error {
+	lock.Version = cfgSkill.LockVersion
+	sort.Slice(lock.Skills, func(i, j int) bool {
+		return lock.Skills[i].Name < lock.Skills[j].Name
+	})
+	data, marshalErr := json.MarshalIndent(lock, "", token.Indent2)
+	if marshalErr != nil {
+		return errSkill.LockWrite(path, marshalErr)
+	}
+	data = append(data, token.NewlineLF...)
+	if writeErr := ctxIo.SafeWriteFileAtomic(
+		path, data, fs.PermFile,
+	); writeErr != nil {
+		return errSkill.LockWrite(path, writeErr)
+	}
+	return writeSources(sourcesPath(path), lock.Local)
+}
+
+// Track records an installed skill in the lock, replacing any
+// entry of the same name. Sources inside the project are stored
+// relative to root so the lock works on every checkout. For a
+// source outside it, the lock records the origin, commit, and path
+// of the git checkout holding it, and the directory itself is kept
+// in the lock's machine-local sources; a source with no such
+// checkout is kept there only, with a warning.
+//
+// Parameters:
+//   - lock: the lock to update
+//   - sk: the installed skill (Dir is its installed copy)
+//   - source: the source directory it was installed from
+//   - root: the project root
+//
+// Returns:
+//   - error: non-nil when the source path or the installed
+//     copy's hash cannot be resolved
+func Track(lock *Lock, sk *Skill, source, root string) error {
+	sum, digestErr := Digest(sk.Dir)
+	if digestErr != nil {
+		return digestErr
+	}
+	abs, absErr := filepath.Abs(source)
+	if absErr != nil {
+		return errSkill.NotValidSource(absErr)
+	}
+
+	entry := LockEntry{
+		Name:      sk.Name,
+		Source:    abs,
+		Version:   sk.Version,
+		SHA256:    sum,
+		Installed: time.Now().UTC().Format(time.RFC3339),
+	}
+	Untrack(lock, sk.Name)
+	if rel, inside := within(root, abs); inside {
+		entry.Source = rel
+	} else {
+		entry.Source, entry.Commit, entry.Remote = checkout(abs)
+		if entry.Remote == "" {
+			logWarn.Warn(cfgWarn.SkillLocalSource, sk.Name, abs)
+		}
+		if lock.Local == nil {
+			lock.Local = map[string]string{}
+		}
+		lock.Local[sk.Name] = abs
+	}
+	lock.Skills = append(lock.Skills, entry)
+	return nil
+}
+
+// Untrack drops a skill's entry from the lock, if any.
+//
+// Parameters:
+//   - lock: the lock to update
+//   - name: the skill name
+func Untrack(lock *Lock, name string) {
+	kept := lock.Skills[:0]
+	for _, e := range lock.Skills {
+		if e.Name != name {
+			kept = append(kept, e)
+		}
+	}
+	lock.Skills = kept
+	delete(lock.Local, name)
+}
@@ -0,0 +1,229 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package skill
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ActiveMemory/ctx/internal/config/dir"
+	"github.com/ActiveMemory/ctx/internal/config/fs"
+	cfgSkill "github.com/ActiveMemory/ctx/internal/config/skill"
+	"github.com/ActiveMemory/ctx/internal/config/token"
+	errSkill "github.com/ActiveMemory/ctx/internal/err/skill"
+	execGit "github.com/ActiveMemory/ctx/internal/exec/git"
+	ctxIo "github.com/ActiveMemory/ctx/internal/io"
+)
+
+// within reports whether abs lies inside root and returns its
+// slash-separated path relative to root.
+//
+// Parameters:
+//   - root: the project root
+//   - abs: an absolute path
+//
+// Returns:
+//   - string: the relative path when inside
+//   - bool: true when abs is root or below it
+func within(root, abs string) (string, bool) {
+	if root == "" {
+		return "", false
+	}
+	rootAbs, absErr := filepath.Abs(root)
+	if absErr != nil {
+		return "", false
+	}
+	rel, relErr := filepath.Rel(rootAbs, abs)
+	if relErr != nil || rel == token.ParentDir ||
+		strings.HasPrefix(rel, token.ParentDir+string(filepath.Separator)) {
+		return "", false
+	}
+	return filepath.ToSlash(rel), true
+}
+
+// sourceDir resolves a lock entry's source directory on this
+// machine: the recorded local directory for an outside source, or
+// the in-project path joined to root.
+//
+// Parameters:
+//   - root: the project root
+//   - lock: the lock, with its machine-local sources
+//   - e: the lock entry
+//
+// Returns:
+//   - string: the source directory path, empty when this machine
+//     has no local copy of an outside source
+func sourceDir(root string, lock *Lock, e LockEntry) string {
+	if local, ok := lock.Local[e.Name]; ok {
+		return local
+	}
+	if e.Remote != "" || e.Source == "" {
+		return ""
+	}
+	return filepath.Join(root, filepath.FromSlash(e.Source))
+}
+
+// sourceDetail describes where a lock entry's source lives, for a
+// skill whose source cannot be found.
+//
+// Parameters:
+//   - e: the lock entry
+//
+// Returns:
+//   - string: the in-project path, or the checkout path, commit,
+//     and remote of an outside source
+func sourceDetail(e LockEntry) string {
+	if e.Remote == "" {
+		return e.Source
+	}
+	return fmt.Sprintf(
+		cfgSkill.RemoteDetail, e.Source, short(e.Commit), e.Remote,
+	)
+}
+
+// checkout describes the git checkout holding an outside source
+// directory, for a lock entry that works on any machine.
+//
+// Parameters:
+//   - abs: the absolute source directory
+//
+// Returns:
+//   - string: abs relative to the checkout root, slash-separated
+//   - string: the checked-out commit
+//   - string: the checkout's origin URL, without credentials
+//
+// All three are empty unless the checkout has both a commit and an
+// origin.
+func checkout(abs string) (string, string, string) {
+	commit := execGit.Head(abs)
+	if commit == "" {
+		return "", "", ""
+	}
+	remote := publicRemote(execGit.RemoteURL(abs))
+	if remote == "" {
+		return "", "", ""
+	}
+	prefix, prefixErr := execGit.Prefix(abs)
+	if prefixErr != nil {
+		return "", "", ""
+	}
+	if prefix == "" {
+		prefix = token.Dot
+	}
+	return prefix, commit, remote
+}
+
+// publicRemote drops the userinfo from a remote URL, so a token
+// embedded in the origin URL never reaches the committed lock.
+// SCP-style remotes (git@host:path) carry no secret and are kept
+// as written.
+//
+// Parameters:
+//   - remote: the origin URL
+//
+// Returns:
+//   - string: remote without its user and password
+func publicRemote(remote string) string {
+	u, parseErr := url.Parse(remote)
+	if parseErr != nil || u.User == nil {
+		return remote
+	}
+	u.User = nil
+	return u.String()
+}
+
+// sourcesPath returns the machine-local sources file for a lock.
+//
+// Parameters:
+//   - lockPath: the skills.lock path
+//
+// Returns:
+//   - string: state/skill-sources.json beside it
+func sourcesPath(lockPath string) string {
+	return filepath.Join(
+		filepath.Dir(lockPath), dir.State, cfgSkill.SourcesFile,
+	)
+}
+
+// readJSON decodes the JSON file at path into v. A missing file
+// leaves v untouched.
+//
+// Parameters:
+//   - path: the file to read
+//   - v: the decode target
+//
+// Returns:
+//   - error: non-nil on a read failure other than not-exist, or
+//     on a parse failure
+func readJSON(path string, v any) error {
+	data, readErr := ctxIo.SafeReadUserFile(path)
+	if readErr != nil {
+		if errors.Is(readErr, os.ErrNotExist) {
+			return nil
+		}
+		return errSkill.LockRead(path, readErr)
+	}
+	if parseErr := json.Unmarshal(data, v); parseErr != nil {
+		return errSkill.LockParse(path, parseErr)
+	}
+	return nil
+}
+
+// writeSources persists the machine-local sources, removing the
+// file when there are none.
+//
+// Parameters:
+//   - path: the sources file
+//   - local: source directories by skill name
+//
+// Returns:
+//   - error: non-nil on a directory, marshal, write, or remove
+//     failure
+func writeSources(path string, local map[string]string) error {
+	if len(local) == 0 {
+		if rmErr := os.Remove(path); rmErr != nil &&
+			!errors.Is(rmErr, os.ErrNotExist) {
+			return errSkill.LockWrite(path, rmErr)
+		}
+		return nil
+	}
+	if mkErr := ctxIo.SafeMkdirAll(
+		filepath.Dir(path), fs.PermRestrictedDir,
+	); mkErr != nil {
+		return errSkill.LockWrite(path, mkErr)
+	}
+	data, marshalErr := json.MarshalIndent(local, "", token.Indent2)
+	if marshalErr != nil {
+		return errSkill.LockWrite(path, marshalErr)
+	}
+	data = append(data, token.NewlineLF...)
+	if writeErr := ctxIo.SafeWriteFileAtomic(
+		path, data, fs.PermFile,
+	); writeErr != nil {
+		return errSkill.LockWrite(path, writeErr)
+	}
+	return nil
+}
+
+// short abbreviates a hash for display.
+//
+// Parameters:
+//   - sum: a hex hash
+//
+// Returns:
+//   - string: its first cfgSkill.HashShort characters
+func short(sum string) string {
+	if len(sum) > cfgSkill.HashShort {
+		return sum[:cfgSkill.HashShort]
+	}
+	return sum
+}
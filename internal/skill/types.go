@@ -12,11 +12,65 @@ package skill
 // Fields:
 //   - Name: Unique identifier from frontmatter
 //   - Description: Short summary from frontmatter
+//   - Version: Optional version from frontmatter
 //   - Body: Markdown instruction content after frontmatter
 //   - Dir: Directory path containing the SKILL.md file
 type Skill struct {
 	Name        string `yaml:"name"`
 	Description string `yaml:"description,omitempty"`
+	Version     string `yaml:"version,omitempty"`
 	Body        string `yaml:"-"`
 	Dir         string `yaml:"-"`
 }
+
+// Lock is the content of skills.lock: one entry per installed
+// skill, sorted by name.
+//
+// Fields:
+//   - Version: lockfile format version
+//   - Skills: the locked skills
+//   - Local: source directories on this machine for skills
+//     installed from outside the project, by name; persisted in
+//     state/skill-sources.json, never in skills.lock
+type Lock struct {
+	Version int               `json:"version"`
+	Skills  []LockEntry       `json:"skills"`
+	Local   map[string]string `json:"-"`
+}
+
+// LockEntry records where an installed skill came from and what
+// was installed.
+//
+// Fields:
+//   - Name: skill name (its directory under the skills directory)
+//   - Source: source directory, relative to the project root when
+//     inside it; for an outside source, its path inside the git
+//     checkout holding it (both slash-separated). Empty for an
+//     outside source with no remote, which only this machine can
+//     locate
+//   - Version: version from the SKILL.md frontmatter, if any
+//   - Commit: HEAD of the git checkout holding an outside source
+//   - Remote: origin URL of that checkout
+//   - SHA256: content hash of the installed files (see [Digest])
+//   - Installed: RFC 3339 time of the install or last update
+type LockEntry struct {
+	Name      string `json:"name"`
+	Source    string `json:"source"`
+	Version   string `json:"version,omitempty"`
+	Commit    string `json:"commit,omitempty"`
+	Remote    string `json:"remote,omitempty"`
+	SHA256    string `json:"sha256"`
+	Installed string `json:"installed"`
+}
+
+// Status is the outcome of verifying or updating one skill.
+//
+// Fields:
+//   - Name: skill name
+//   - State: one of the config/skill State* values
+//   - Detail: extra context (hash change, source path), or empty
+type Status struct {
+	Name   string
+	State  string
+	Detail string
+}
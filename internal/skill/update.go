@@ -0,0 +1,46 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package skill
+
+// Update reinstalls locked skills whose source changed since
+// they were installed, and refreshes their lock entries. Sources
+// are local directories (a path or a git checkout the user keeps
+// current), so updating works offline. A skill whose installed
+// copy was edited is skipped unless force is set; one whose
+// source is gone is reported and left alone. The new copy is
+// installed beside the skills directory and swapped in only once
+// it is complete, so a failed reinstall keeps the old one.
+//
+// Parameters:
+//   - skillsDir: root directory where skills are installed
+//   - root: the project root, for relative sources
+//   - lock: the lock to update in place
+//   - names: skills to update; empty means all locked skills
+//   - force: overwrite locally modified skills
+//
+// Returns:
+//   - []Status: one per selected skill
+//   - error: non-nil for a name missing from the lock or a
+//     failed reinstall
+func Update(
+	skillsDir, root string, lock *Lock, names []string, force bool,
+) ([]Status, error) {
+	selected, selectErr := selectEntries(lock, names)
+	if selectErr != nil {
+		return nil, selectErr
+	}
+
+	var statuses []Status
+	for _, e := range selected {
+		status, updateErr := update(skillsDir, root, lock, e, force)
+		if updateErr != nil {
+			return nil, updateErr
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses, nil
+}
@@ -0,0 +1,165 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package skill
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/ActiveMemory/ctx/internal/config/fs"
+	cfgSkill "github.com/ActiveMemory/ctx/internal/config/skill"
+	cfgWarn "github.com/ActiveMemory/ctx/internal/config/warn"
+	errSkill "github.com/ActiveMemory/ctx/internal/err/skill"
+	ctxIo "github.com/ActiveMemory/ctx/internal/io"
+	logWarn "github.com/ActiveMemory/ctx/internal/log/warn"
+)
+
+// selectEntries picks the lock entries named, or all of them.
+//
+// Parameters:
+//   - lock: the lock
+//   - names: requested skill names; empty means all
+//
+// Returns:
+//   - []LockEntry: copies of the selected entries
+//   - error: non-nil when a name has no entry
+func selectEntries(lock *Lock, names []string) ([]LockEntry, error) {
+	if len(names) == 0 {
+		return append([]LockEntry(nil), lock.Skills...), nil
+	}
+	byName := make(map[string]LockEntry, len(lock.Skills))
+	for _, e := range lock.Skills {
+		byName[e.Name] = e
+	}
+	selected := make([]LockEntry, 0, len(names))
+	for _, name := range names {
+		e, ok := byName[name]
+		if !ok {
+			return nil, errSkill.NotLocked(name)
+		}
+		selected = append(selected, e)
+	}
+	return selected, nil
+}
+
+// update brings one locked skill up to date with its source.
+//
+// Parameters:
+//   - skillsDir: root directory where skills are installed
+//   - root: the project root
+//   - lock: the lock to update in place
+//   - e: the skill's entry as selected
+//   - force: overwrite a locally modified copy
+//
+// Returns:
+//   - Status: what happened
+//   - error: non-nil when hashing or reinstalling fails
+func update(
+	skillsDir, root string, lock *Lock, e LockEntry, force bool,
+) (Status, error) {
+	src := sourceDir(root, lock, e)
+	if src == "" {
+		return Status{
+			Name: e.Name, State: cfgSkill.StateNoSource,
+			Detail: sourceDetail(e),
+		}, nil
+	}
+	if _, statErr := ctxIo.SafeStat(src); statErr != nil {
+		return Status{
+			Name: e.Name, State: cfgSkill.StateNoSource, Detail: src,
+		}, nil
+	}
+
+	dir := filepath.Join(skillsDir, e.Name)
+	installed, digestErr := Digest(dir)
+	present := digestErr == nil
+	if present && installed != e.SHA256 && !force {
+		return Status{Name: e.Name, State: cfgSkill.StateSkipped}, nil
+	}
+
+	latest, srcErr := Digest(src)
+	if srcErr != nil {
+		return Status{}, srcErr
+	}
+	if present && installed == latest && latest == e.SHA256 {
+		return Status{Name: e.Name, State: cfgSkill.StateCurrent}, nil
+	}
+
+	sk, installErr := replace(src, skillsDir, e.Name)
+	if installErr != nil {
+		return Status{}, installErr
+	}
+	Untrack(lock, e.Name)
+	if trackErr := Track(lock, sk, src, root); trackErr != nil {
+		return Status{}, trackErr
+	}
+	return Status{
+		Name:   sk.Name,
+		State:  cfgSkill.StateUpdated,
+		Detail: short(e.SHA256) + cfgSkill.HashArrow + short(latest),
+	}, nil
+}
+
+// replace installs src into a staging directory beside skillsDir
+// and swaps it in for the installed copy only once the install has
+// succeeded, so a failed update leaves the old copy in place.
+//
+// Parameters:
+//   - src: the skill source directory
+//   - skillsDir: root directory where skills are installed
+//   - name: the locked skill's name, whose copy is replaced
+//
+// Returns:
+//   - *Skill: the installed skill, Dir pointing into skillsDir
+//   - error: non-nil when staging, installing, or the swap fails
+func replace(src, skillsDir, name string) (*Skill, error) {
+	if mkErr := ctxIo.SafeMkdirAll(
+		skillsDir, fs.PermRestrictedDir,
+	); mkErr != nil {
+		return nil, errSkill.CreateDest(mkErr)
+	}
+	stage, tempErr := os.MkdirTemp(
+		filepath.Dir(skillsDir),
+		fmt.Sprintf(cfgSkill.StagePattern, filepath.Base(skillsDir)),
+	)
+	if tempErr != nil {
+		return nil, errSkill.CreateDest(tempErr)
+	}
+	defer func() {
+		if rmErr := os.RemoveAll(stage); rmErr != nil {
+			logWarn.Warn(cfgWarn.Remove, stage, rmErr)
+		}
+	}()
+
+	sk, installErr := Install(src, stage)
+	if installErr != nil {
+		return nil, installErr
+	}
+
+	old := filepath.Join(skillsDir, name)
+	aside := filepath.Join(stage, cfgSkill.AsideName)
+	moved := true
+	if asideErr := ctxIo.SafeRename(old, aside); asideErr != nil {
+		if !errors.Is(asideErr, os.ErrNotExist) {
+			return nil, errSkill.Remove(name, asideErr)
+		}
+		moved = false
+	}
+	dest := filepath.Join(skillsDir, sk.Name)
+	if swapErr := ctxIo.SafeRename(sk.Dir, dest); swapErr != nil {
+		if moved {
+			if backErr := ctxIo.SafeRename(aside, old); backErr != nil {
+				logWarn.Warn(cfgWarn.Rename, old, backErr)
+			}
+		}
+		return nil, errSkill.Install(sk.Name, swapErr)
+	}
+	sk.Dir = dest
+	return sk, nil
+}
@@ -0,0 +1,71 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package skill
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+
+	cfgSkill "github.com/ActiveMemory/ctx/internal/config/skill"
+	errSkill "github.com/ActiveMemory/ctx/internal/err/skill"
+	ctxIo "github.com/ActiveMemory/ctx/internal/io"
+)
+
+// Verify compares installed skills with the lock. Each locked
+// skill is ok, modified (its files hash differently), or
+// missing; installed skills without an entry are untracked.
+// Sources are not consulted, so verification works offline.
+//
+// Parameters:
+//   - skillsDir: root directory where skills are installed
+//   - lock: the lock to check against
+//
+// Returns:
+//   - []Status: one per locked or installed skill, locked
+//     first in lock order
+//   - error: non-nil when the skills directory or an installed
+//     skill cannot be read
+func Verify(skillsDir string, lock *Lock) ([]Status, error) {
+	var statuses []Status
+	locked := make(map[string]bool, len(lock.Skills))
+	for _, e := range lock.Skills {
+		locked[e.Name] = true
+		dir := filepath.Join(skillsDir, e.Name)
+		if _, statErr := ctxIo.SafeStat(dir); statErr != nil {
+			if !errors.Is(statErr, os.ErrNotExist) {
+				return nil, errSkill.Digest(dir, statErr)
+			}
+			statuses = append(statuses, Status{
+				Name: e.Name, State: cfgSkill.StateMissing,
+			})
+			continue
+		}
+		sum, digestErr := Digest(dir)
+		if digestErr != nil {
+			return nil, digestErr
+		}
+		state := cfgSkill.StateOK
+		if sum != e.SHA256 {
+			state = cfgSkill.StateModified
+		}
+		statuses = append(statuses, Status{Name: e.Name, State: state})
+	}
+
+	entries, readErr := os.ReadDir(skillsDir)
+	if readErr != nil && !errors.Is(readErr, os.ErrNotExist) {
+		return nil, errSkill.ReadDir(skillsDir, readErr)
+	}
+	for _, entry := range entries {
+		if entry.IsDir() && !locked[entry.Name()] {
+			statuses = append(statuses, Status{
+				Name: entry.Name(), State: cfgSkill.StateUntracked,
+			})
+		}
+	}
+	return statuses, nil
+}
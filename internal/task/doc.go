@@ -23,8 +23,14 @@
 //     match, stripping the checkbox prefix.
 //   - [Sub]: reports whether a match represents
 //     a subtask (indented 2+ spaces).
+//   - [Key]: a short stable hash of the task text,
+//     ignoring #tags, used to lease tasks between
+//     agents (ctx fleet).
+//   - [Nodes], [Index], [Unmet], [Cycles], [Prereqs]:
+//     the dependency graph declared by #id, #depends
+//     and #blocked-by annotations (see below).
 //
-// All functions operate on the result of
+// The predicates above operate on the result of
 // ItemPattern.FindStringSubmatch, using the match
 // index constants [MatchIndent], [MatchState], and
 // [MatchContent].
@@ -47,6 +53,19 @@
 // Continuation indents are not separate tasks; the
 // parsers treat them as belonging to the parent task body.
 //
+// # Dependencies
+//
+// A task names itself with #id:<name> and waits on
+// others with #depends:<id>[,<id>] or #blocked-by:<id>.
+// A reference may also be a task's [Key]. IDs are
+// case-folded. A #depends on a task that is no longer
+// in the file counts as met, since completed tasks get
+// archived; a #blocked-by that names no task is an
+// external blocker and stays unmet until removed.
+// ctx_next skips tasks with [Unmet] references, ctx
+// drift reports [Cycles], and ctx task graph draws the
+// whole graph.
+//
 // # Concurrency
 //
 // All functions are pure. Concurrent callers never
@@ -0,0 +1,153 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package task
+
+import (
+	"github.com/ActiveMemory/ctx/internal/config/regex"
+)
+
+// Nodes parses every top-level task in TASKS.md, pending or
+// completed, into a dependency graph node. Subtasks are
+// skipped: they belong to their parent's body.
+//
+// Parameters:
+//   - lines: TASKS.md split into lines
+//
+// Returns:
+//   - []Node: tasks in file order
+func Nodes(lines []string) []Node {
+	var nodes []Node
+	for i, line := range lines {
+		match := regex.Task.FindStringSubmatch(line)
+		if match == nil || Sub(match) {
+			continue
+		}
+		n := annotate(Content(match))
+		n.Line = i + 1
+		n.Done = Completed(match)
+		nodes = append(nodes, n)
+	}
+	return nodes
+}
+
+// Index maps every ID and key in nodes to the node's position,
+// so a reference can be resolved either way. When two tasks
+// share an ID, the first one wins.
+//
+// Parameters:
+//   - nodes: result of [Nodes]
+//
+// Returns:
+//   - map[string]int: reference to index into nodes
+func Index(nodes []Node) map[string]int {
+	index := make(map[string]int, len(nodes)*2)
+	for i, n := range nodes {
+		for _, ref := range []string{n.ID, n.Key} {
+			if _, taken := index[ref]; !taken {
+				index[ref] = i
+			}
+		}
+	}
+	return index
+}
+
+// Unmet returns the blockers of a task that are not yet
+// satisfied.
+//
+// A #depends reference is unmet while the named task is
+// pending; one that names no task in TASKS.md counts as met,
+// since completed tasks get archived away. A #blocked-by
+// reference to a task behaves the same, but one that names no
+// task is an external blocker and stays unmet until the
+// annotation is removed.
+//
+// Parameters:
+//   - content: task text, as returned by [Content]
+//   - nodes: result of [Nodes] for the whole file
+//   - index: result of [Index] for nodes
+//
+// Returns:
+//   - []string: unmet references; empty when the task is ready
+func Unmet(content string, nodes []Node, index map[string]int) []string {
+	n := annotate(content)
+	var unmet []string
+	for _, ref := range n.Depends {
+		if i, ok := index[ref]; ok && !nodes[i].Done {
+			unmet = append(unmet, ref)
+		}
+	}
+	for _, ref := range n.BlockedBy {
+		if i, ok := index[ref]; !ok || !nodes[i].Done {
+			unmet = append(unmet, ref)
+		}
+	}
+	return unmet
+}
+
+// Cycles finds dependency cycles among nodes. Each cycle lists
+// the IDs along it and ends where it started (a, b, a).
+// References to tasks not in nodes cannot close a cycle and
+// are ignored.
+//
+// Parameters:
+//   - nodes: result of [Nodes]
+//
+// Returns:
+//   - [][]string: one ID path per cycle; nil when acyclic
+func Cycles(nodes []Node) [][]string {
+	index := Index(nodes)
+	done := make([]bool, len(nodes))
+	onPath := make([]bool, len(nodes))
+	var path []int
+	var cycles [][]string
+
+	var visit func(i int)
+	visit = func(i int) {
+		onPath[i] = true
+		path = append(path, i)
+		for _, j := range Prereqs(nodes[i], index) {
+			switch {
+			case onPath[j]:
+				cycles = append(cycles, trace(nodes, path, j))
+			case !done[j]:
+				visit(j)
+			}
+		}
+		path = path[:len(path)-1]
+		onPath[i] = false
+		done[i] = true
+	}
+
+	for i := range nodes {
+		if !done[i] {
+			visit(i)
+		}
+	}
+	return cycles
+}
+
+// Prereqs resolves the #depends and #blocked-by references of
+// a node to the tasks they name. References to tasks not in
+// the file are dropped; see [Unmet] for how they count.
+//
+// Parameters:
+//   - n: the dependent task
+//   - index: result of [Index]
+//
+// Returns:
+//   - []int: indexes of prerequisite nodes
+func Prereqs(n Node, index map[string]int) []int {
+	var out []int
+	for _, refs := range [][]string{n.Depends, n.BlockedBy} {
+		for _, ref := range refs {
+			if i, ok := index[ref]; ok {
+				out = append(out, i)
+			}
+		}
+	}
+	return out
+}
@@ -0,0 +1,87 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package task
+
+import (
+	"strings"
+
+	"github.com/ActiveMemory/ctx/internal/config/regex"
+	cfgTask "github.com/ActiveMemory/ctx/internal/config/task"
+	"github.com/ActiveMemory/ctx/internal/i18n"
+)
+
+// annotate reads the dependency annotations out of task text.
+// IDs and references are case-folded so #id:Auth and
+// #depends:auth meet.
+//
+// Parameters:
+//   - content: task text without the checkbox
+//
+// Returns:
+//   - Node: ID, Key, Content, Depends and BlockedBy filled in
+func annotate(content string) Node {
+	n := Node{Key: Key(content), Content: content}
+	all := regex.TaskAnnotation.FindAllStringSubmatch(content, -1)
+	for _, m := range all {
+		value := i18n.Fold(m[2])
+		switch m[1] {
+		case cfgTask.AnnotationID:
+			n.ID = value
+		case cfgTask.AnnotationDepends:
+			n.Depends = append(n.Depends, refs(value)...)
+		case cfgTask.AnnotationBlockedBy:
+			n.BlockedBy = append(n.BlockedBy, refs(value)...)
+		}
+	}
+	if n.ID == "" {
+		n.ID = n.Key
+	}
+	return n
+}
+
+// refs splits a comma-separated annotation value, dropping
+// empty items.
+//
+// Parameters:
+//   - value: annotation value
+//
+// Returns:
+//   - []string: referenced IDs
+func refs(value string) []string {
+	var out []string
+	for _, ref := range strings.Split(value, cfgTask.RefSep) {
+		if ref != "" {
+			out = append(out, ref)
+		}
+	}
+	return out
+}
+
+// trace extracts the cycle closed by an edge back to node j:
+// the stretch of the DFS path from j to its end, then j again.
+//
+// Parameters:
+//   - nodes: all nodes
+//   - path: current DFS path, as node indexes
+//   - j: index of the node the back edge points to
+//
+// Returns:
+//   - []string: IDs along the cycle
+func trace(nodes []Node, path []int, j int) []string {
+	start := 0
+	for k, i := range path {
+		if i == j {
+			start = k
+			break
+		}
+	}
+	var ids []string
+	for _, i := range path[start:] {
+		ids = append(ids, nodes[i].ID)
+	}
+	return append(ids, nodes[j].ID)
+}
@@ -0,0 +1,105 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package task
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestNodesReadsAnnotations(t *testing.T) {
+	nodes := Nodes(strings.Split(strings.Join([]string{
+		"# Tasks",
+		"- [ ] Design schema #id:DB #priority:high",
+		"- [ ] Build API #id:api #depends:db,config",
+		"  - [ ] subtask #depends:api",
+		"- [x] Ship #blocked-by:vendor",
+	}, "\n"), "\n"))
+
+	if len(nodes) != 3 {
+		t.Fatalf("got %d nodes, want 3", len(nodes))
+	}
+	if nodes[0].ID != "db" || nodes[0].Line != 2 {
+		t.Errorf("node 0 = %q line %d, want db line 2",
+			nodes[0].ID, nodes[0].Line)
+	}
+	if !reflect.DeepEqual(nodes[1].Depends, []string{"db", "config"}) {
+		t.Errorf("depends = %v", nodes[1].Depends)
+	}
+	if !nodes[2].Done || nodes[2].ID != nodes[2].Key {
+		t.Errorf("node 2 = %+v, want done with key as ID", nodes[2])
+	}
+	if !reflect.DeepEqual(nodes[2].BlockedBy, []string{"vendor"}) {
+		t.Errorf("blocked-by = %v", nodes[2].BlockedBy)
+	}
+}
+
+func TestUnmet(t *testing.T) {
+	nodes := Nodes([]string{
+		"- [x] Done one #id:done",
+		"- [ ] Open one #id:open",
+	})
+	index := Index(nodes)
+
+	tests := []struct {
+		name    string
+		content string
+		want    []string
+	}{
+		{"no annotations", "Plain task", nil},
+		{"depends on done", "X #depends:done", nil},
+		{"depends on open", "X #depends:open,done", []string{"open"}},
+		{"depends on archived", "X #depends:gone", nil},
+		{"blocked by open", "X #blocked-by:open", []string{"open"}},
+		{"blocked externally", "X #blocked-by:vendor", []string{"vendor"}},
+		{"by key", "X #depends:" + nodes[1].Key, []string{nodes[1].Key}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Unmet(tt.content, nodes, index)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Unmet(%q) = %v, want %v",
+					tt.content, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCycles(t *testing.T) {
+	tests := []struct {
+		name  string
+		lines []string
+		want  [][]string
+	}{
+		{"acyclic", []string{
+			"- [ ] A #id:a #depends:b",
+			"- [ ] B #id:b #depends:c",
+			"- [ ] C #id:c",
+		}, nil},
+		{"two-cycle", []string{
+			"- [ ] A #id:a #depends:b",
+			"- [ ] B #id:b #blocked-by:a",
+		}, [][]string{{"a", "b", "a"}}},
+		{"self", []string{
+			"- [ ] A #id:a #depends:a",
+		}, [][]string{{"a", "a"}}},
+		{"tail into cycle", []string{
+			"- [ ] A #id:a #depends:b",
+			"- [ ] B #id:b #depends:c",
+			"- [ ] C #id:c #depends:b,gone",
+		}, [][]string{{"b", "c", "b"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Cycles(Nodes(tt.lines))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Cycles = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -7,8 +7,15 @@
 package task
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
 	"github.com/ActiveMemory/ctx/internal/config/archive"
+	"github.com/ActiveMemory/ctx/internal/config/fleet"
 	"github.com/ActiveMemory/ctx/internal/config/marker"
+	"github.com/ActiveMemory/ctx/internal/config/token"
+	"github.com/ActiveMemory/ctx/internal/i18n"
 )
 
 // Match indices for accessing capture groups.
@@ -98,3 +105,25 @@ func Content(match []string) string {
 func Sub(match []string) bool {
 	return len(Indent(match)) >= archive.SubTaskMinIndent
 }
+
+// Key returns a stable identifier for a task, derived from
+// its text. Inline #tags are ignored and case and spacing
+// are folded, so marking a task #in-progress or bumping
+// its priority keeps the key; rewording it does not.
+//
+// Parameters:
+//   - content: task text, as returned by [Content]
+//
+// Returns:
+//   - string: fleet.KeyLen hex digits
+func Key(content string) string {
+	var words []string
+	for _, w := range strings.Fields(i18n.Fold(content)) {
+		if strings.HasPrefix(w, token.Hash) {
+			continue
+		}
+		words = append(words, w)
+	}
+	sum := sha256.Sum256([]byte(strings.Join(words, token.Space)))
+	return hex.EncodeToString(sum[:])[:fleet.KeyLen]
+}
@@ -294,3 +294,23 @@ func TestMatchConstants(t *testing.T) {
 			match[MatchContent], "Task content here")
 	}
 }
+
+func TestKey(t *testing.T) {
+	base := Key("Add rate limiter to the API")
+	if len(base) != 12 {
+		t.Fatalf("Key length = %d, want 12", len(base))
+	}
+	same := []string{
+		"Add rate limiter to the API #priority:high",
+		"add  RATE limiter to the API",
+		"#in-progress Add rate limiter to the API",
+	}
+	for _, s := range same {
+		if got := Key(s); got != base {
+			t.Errorf("Key(%q) = %s, want %s", s, got, base)
+		}
+	}
+	if Key("Add rate limiter to the CLI") == base {
+		t.Error("rewording should change the key")
+	}
+}
@@ -0,0 +1,27 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package task
+
+// Node is one top-level task in the TASKS.md dependency graph.
+//
+// Fields:
+//   - ID: the #id annotation, or the task's [Key] when absent
+//   - Key: [Key] of the task text; references may use it too
+//   - Content: task text without the checkbox
+//   - Line: 1-based line number in TASKS.md
+//   - Done: the checkbox is checked
+//   - Depends: references from #depends annotations
+//   - BlockedBy: references from #blocked-by annotations
+type Node struct {
+	ID        string
+	Key       string
+	Content   string
+	Line      int
+	Done      bool
+	Depends   []string
+	BlockedBy []string
+}
@@ -19,12 +19,14 @@ import (
 	errBackup "github.com/ActiveMemory/ctx/internal/err/backup"
 	"github.com/ActiveMemory/ctx/internal/io"
 	"github.com/ActiveMemory/ctx/internal/rc"
+	"github.com/ActiveMemory/ctx/internal/txn"
 )
 
 // WriteArchive writes content to a dated archive file in .context/archive/.
 //
 // Creates the archive directory if needed. If a file for today already exists,
 // the new content is appended. Otherwise, a new file is created with a header.
+// The read and write run under the context directory's write lock.
 //
 // Parameters:
 //   - prefix: File name prefix (e.g., "tasks", "decisions", "learnings")
@@ -53,19 +55,24 @@ func WriteArchive(prefix, heading, content string) (string, error) {
 	)
 
 	nl := token.NewlineLF
-	var finalContent string
 	cleanPath := filepath.Clean(archiveFile)
-	if existing, readErr := io.SafeReadUserFile(cleanPath); readErr == nil {
-		finalContent = string(existing) + nl + content
-	} else {
-		finalContent = heading + archive.DateSep +
-			dateStr + nl + nl + content
-	}
-
-	if writeErr := io.SafeWriteFile(
-		archiveFile, []byte(finalContent), fs.PermFile,
-	); writeErr != nil {
-		return "", errBackup.WriteArchive(writeErr)
+	lockErr := txn.Do(ctxDir, func() error {
+		var finalContent string
+		if existing, readErr := io.SafeReadUserFile(cleanPath); readErr == nil {
+			finalContent = string(existing) + nl + content
+		} else {
+			finalContent = heading + archive.DateSep +
+				dateStr + nl + nl + content
+		}
+		if writeErr := io.SafeWriteFileAtomic(
+			archiveFile, []byte(finalContent), fs.PermFile,
+		); writeErr != nil {
+			return errBackup.WriteArchive(writeErr)
+		}
+		return nil
+	})
+	if lockErr != nil {
+		return "", lockErr
 	}
 
 	return archiveFile, nil
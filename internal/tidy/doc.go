@@ -66,8 +66,9 @@
 //
 // # Concurrency
 //
-// All functions are stateless. Callers serialize through
-// process-level execution; concurrent invocations against
-// the same context dir would race on file writes (no
-// locking is implemented).
+// All functions are stateless. [WriteArchive] appends under
+// the context directory's write lock (internal/txn) and
+// commits with a temp-file rename. The pure functions do no
+// IO; callers that turn their output into a rewrite of
+// TASKS.md hold the same lock across the read and the write.
 package tidy
@@ -22,8 +22,11 @@ import (
 // Discover finds all hook scripts in the hooks directory, grouped
 // by type. It iterates over each valid hook type subdirectory,
 // validates each file via [ValidatePath], and skips invalid
-// entries with a logged warning. Hooks within each type are sorted
-// alphabetically by filename.
+// entries with a logged warning. Each hook carries its hooks.yaml
+// entry ("<type>/<name>"), if any, and hooks within each type are
+// sorted by that entry's priority, then alphabetically by
+// filename. A hooks.yaml entry that matches no script is reported
+// with a logged warning.
 //
 // Returns an empty map without error if hooksDir does not exist.
 //
@@ -32,7 +35,8 @@ import (
 //
 // Returns:
 //   - map[HookType][]HookInfo: discovered hooks grouped by type
-//   - error: non-nil only on unexpected I/O failures
+//   - error: non-nil on unexpected I/O failures or an invalid
+//     hooks.yaml
 func Discover(hooksDir string) (map[HookType][]HookInfo, error) {
 	result := make(map[HookType][]HookInfo)
 
@@ -40,6 +44,12 @@ func Discover(hooksDir string) (map[HookType][]HookInfo, error) {
 		return result, nil
 	}
 
+	manifest, manifestErr := loadManifest(hooksDir)
+	if manifestErr != nil {
+		return nil, manifestErr
+	}
+	used := make(map[string]bool, len(manifest.Hooks))
+
 	for _, ht := range ValidTypes() {
 		typeDir := filepath.Join(hooksDir, ht)
 
@@ -73,15 +83,25 @@ func Discover(hooksDir string) (map[HookType][]HookInfo, error) {
 				continue
 			}
 
+			name := stripExt(e.Name())
+			key := manifestKey(ht, name)
+			spec, found := manifest.Hooks[key]
+			if found {
+				used[key] = true
+			}
 			hooks = append(hooks, HookInfo{
-				Name:    stripExt(e.Name()),
+				Name:    name,
 				Type:    ht,
 				Path:    path,
 				Enabled: info.Mode().Perm()&fs.ExecBitMask != 0,
+				Spec:    spec,
 			})
 		}
 
 		sort.Slice(hooks, func(i, j int) bool {
+			if hooks[i].Spec.Priority != hooks[j].Spec.Priority {
+				return hooks[i].Spec.Priority < hooks[j].Spec.Priority
+			}
 			return hooks[i].Name < hooks[j].Name
 		})
 
@@ -90,6 +110,7 @@ func Discover(hooksDir string) (map[HookType][]HookInfo, error) {
 		}
 	}
 
+	warnUnmatched(manifest, used)
 	return result, nil
 }
 
@@ -27,11 +27,27 @@
 // # Discovery
 //
 // [Discover] scans .context/hooks/<type>/ and returns
-// one [HookInfo] per script, sorted alphabetically.
+// one [HookInfo] per script, sorted by manifest
+// priority and then alphabetically.
 // The executable permission bit controls whether a
 // hook is enabled. [FindByName] locates a single
 // script by its stem for enable/disable operations.
 //
+// # Manifest
+//
+// An optional .context/hooks/hooks.yaml declares a
+// [HookSpec] per hook name: globs on the tool name
+// (tools), globs on the "path" parameter (paths), AI
+// tool identifiers (session_tools), a timeout in
+// seconds, and a priority (lower runs first). A path
+// glob without a slash matches the base name; with one
+// it is anchored at the project root and "**" spans
+// directories. An empty list does not filter. A
+// declared tools or paths list rejects an input that
+// carries no tool or path; an unknown session tool is
+// not filtered on. Hooks without an entry run for
+// every event of their type, as before.
+//
 // # Security
 //
 // Triggers run with the same privileges as the AI
@@ -48,7 +64,9 @@
 // # Execution
 //
 // [RunAll] runs every enabled hook for a given type
-// in alphabetical order. Per-hook behavior:
+// in priority order, skipping hooks whose matchers
+// reject the input before any process is spawned.
+// Per-hook behavior:
 //
 //   - cancel:true halts the chain immediately.
 //   - Non-empty context is appended to the aggregate.
@@ -57,7 +75,8 @@
 //   - Timeout exceeded kills the process group.
 //
 // The default timeout is 10 seconds
-// ([DefaultTimeout]).
+// ([DefaultTimeout]); a manifest timeout overrides it
+// per hook.
 //
 // # Concurrency
 //
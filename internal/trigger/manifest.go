@@ -0,0 +1,110 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package trigger
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	"github.com/ActiveMemory/ctx/internal/config/embed/text"
+	"github.com/ActiveMemory/ctx/internal/config/token"
+	cfgTrigger "github.com/ActiveMemory/ctx/internal/config/trigger"
+	errTrigger "github.com/ActiveMemory/ctx/internal/err/trigger"
+	ctxIo "github.com/ActiveMemory/ctx/internal/io"
+	ctxLog "github.com/ActiveMemory/ctx/internal/log/warn"
+)
+
+// loadManifest reads hooks.yaml from the hooks directory. A
+// missing or empty file yields an empty manifest. Unknown fields,
+// entries not keyed "<type>/<name>", and timeouts outside 0 to
+// MaxTimeout are rejected so a typo cannot silently widen a hook
+// to every event or stall the session it runs in.
+//
+// Parameters:
+//   - hooksDir: root hooks directory (e.g. .context/hooks)
+//
+// Returns:
+//   - *Manifest: the parsed manifest, never nil on success
+//   - error: non-nil on read, parse, or validation failure
+func loadManifest(hooksDir string) (*Manifest, error) {
+	m := &Manifest{}
+	path := filepath.Join(hooksDir, cfgTrigger.ManifestFile)
+
+	data, readErr := ctxIo.SafeReadUserFile(path)
+	if readErr != nil {
+		if errors.Is(readErr, os.ErrNotExist) {
+			return m, nil
+		}
+		return nil, errTrigger.Manifest(path, readErr)
+	}
+
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	if decErr := dec.Decode(m); decErr != nil && !errors.Is(decErr, io.EOF) {
+		return nil, errTrigger.Manifest(path, decErr)
+	}
+
+	types := ValidTypes()
+	for key, spec := range m.Hooks {
+		ht, name, ok := strings.Cut(key, cfgTrigger.KeySep)
+		if !ok || name == "" ||
+			strings.Contains(name, cfgTrigger.KeySep) ||
+			!slices.Contains(types, ht) {
+			return nil, errTrigger.ManifestKey(
+				key, strings.Join(types, token.CommaSpace),
+			)
+		}
+		if spec.Timeout < 0 || spec.Timeout > cfgTrigger.MaxTimeout {
+			return nil, errTrigger.ManifestTimeout(
+				key, cfgTrigger.MaxTimeout,
+			)
+		}
+	}
+	return m, nil
+}
+
+// manifestKey returns the hooks.yaml key for a trigger script.
+//
+// Parameters:
+//   - ht: the trigger type
+//   - name: the script name without extension
+//
+// Returns:
+//   - string: "<type>/<name>"
+func manifestKey(ht HookType, name string) string {
+	return fmt.Sprintf(cfgTrigger.KeyFormat, ht, name)
+}
+
+// warnUnmatched warns about each hooks.yaml entry that no
+// discovered script used, so a misnamed entry does not silently
+// leave its trigger unfiltered.
+//
+// Parameters:
+//   - m: the manifest
+//   - used: the keys discovery matched to a script
+func warnUnmatched(m *Manifest, used map[string]bool) {
+	var unmatched []string
+	for key := range m.Hooks {
+		if !used[key] {
+			unmatched = append(unmatched, key)
+		}
+	}
+	sort.Strings(unmatched)
+	for _, key := range unmatched {
+		ctxLog.Warn(desc.Text(text.DescKeyTriggerUnmatchedWarn), key)
+	}
+}
@@ -0,0 +1,232 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package trigger
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	cfgTrigger "github.com/ActiveMemory/ctx/internal/config/trigger"
+	ctxLog "github.com/ActiveMemory/ctx/internal/log/warn"
+)
+
+func writeManifest(t *testing.T, hooksDir, content string) {
+	t.Helper()
+	if err := os.MkdirAll(hooksDir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(hooksDir, cfgTrigger.ManifestFile)
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestMatches(t *testing.T) {
+	spec := HookSpec{
+		Tools:        []string{"write_*", "edit_file"},
+		Paths:        []string{"internal/crypto/**", "*.pem"},
+		SessionTools: []string{"claude"},
+	}
+	input := func(tool, path, session string) *HookInput {
+		return &HookInput{
+			Tool:       tool,
+			Parameters: map[string]any{cfgTrigger.ParamPath: path},
+			Session:    HookSession{Tool: session},
+		}
+	}
+
+	tests := []struct {
+		name  string
+		input *HookInput
+		want  bool
+	}{
+		{"all match", input("write_file", "internal/crypto/aes.go", "claude"), true},
+		{"nested under **", input("edit_file", "internal/crypto/x/y.go", "claude"), true},
+		{"base-name glob", input("write_file", "keys/dev.pem", "claude"), true},
+		{"dot-relative path", input("write_file", "./internal/crypto/aes.go", ""), true},
+		{"tool mismatch", input("read_file", "internal/crypto/aes.go", "claude"), false},
+		{"path mismatch", input("write_file", "internal/memory/m.go", "claude"), false},
+		{"session mismatch", input("write_file", "internal/crypto/aes.go", "cursor"), false},
+		{"missing tool fails tools", input("", "internal/crypto/aes.go", "claude"), false},
+		{"missing path fails paths", input("write_file", "", "claude"), false},
+		{"unknown session tool does not filter", input("write_file", "internal/crypto/aes.go", ""), true},
+		{"nil input fails declared matchers", nil, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := matches(spec, tt.input); got != tt.want {
+				t.Errorf("matches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if !matches(HookSpec{}, input("anything", "any/where.go", "kiro")) {
+		t.Error("empty spec should match every input")
+	}
+	if !matches(HookSpec{}, nil) {
+		t.Error("empty spec should match a nil input")
+	}
+}
+
+func TestMatchPath_AnyDepth(t *testing.T) {
+	tests := []struct {
+		pattern, path string
+		want          bool
+	}{
+		{"docs/**/*.md", "docs/a.md", true},
+		{"docs/**/*.md", "docs/x/y/a.md", true},
+		{"docs/**/*.md", "src/docs/a.md", false},
+		{"**/testdata/*", "a/b/testdata/f.json", true},
+		{"internal/*.go", "internal/sub/x.go", false},
+	}
+	for _, tt := range tests {
+		if got := matchPath(tt.pattern, tt.path); got != tt.want {
+			t.Errorf("matchPath(%q, %q) = %v, want %v",
+				tt.pattern, tt.path, got, tt.want)
+		}
+	}
+}
+
+func TestDiscover_AppliesManifest(t *testing.T) {
+	hooksDir := filepath.Join(t.TempDir(), "hooks")
+	writeHookScript(t, hooksDir, "pre-tool-use", "alpha.sh", "#!/bin/sh\n")
+	writeHookScript(t, hooksDir, "pre-tool-use", "zeta.sh", "#!/bin/sh\n")
+	writeHookScript(t, hooksDir, "post-tool-use", "zeta.sh", "#!/bin/sh\n")
+	writeManifest(t, hooksDir, `hooks:
+  pre-tool-use/zeta:
+    tools: [write_file]
+    timeout: 3
+    priority: -5
+`)
+
+	all, err := Discover(hooksDir)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	hooks := all[cfgTrigger.PreToolUse]
+	if len(hooks) != 2 || hooks[0].Name != "zeta" {
+		t.Fatalf("expected zeta first by priority, got %+v", hooks)
+	}
+	if hooks[0].Spec.Timeout != 3 || len(hooks[0].Spec.Tools) != 1 {
+		t.Errorf("spec not applied: %+v", hooks[0].Spec)
+	}
+	if len(hooks[1].Spec.Tools) != 0 {
+		t.Errorf("alpha should have an empty spec, got %+v", hooks[1].Spec)
+	}
+	post := all[cfgTrigger.PostToolUse]
+	if len(post) != 1 || len(post[0].Spec.Tools) != 0 {
+		t.Errorf("post-tool-use/zeta should have an empty spec, got %+v", post)
+	}
+}
+
+func TestDiscover_WarnsOnUnmatchedEntries(t *testing.T) {
+	hooksDir := filepath.Join(t.TempDir(), "hooks")
+	writeHookScript(t, hooksDir, "pre-tool-use", "guard.sh", "#!/bin/sh\n")
+	writeManifest(t, hooksDir, `hooks:
+  pre-tool-use/guard:
+    tools: [write_file]
+  post-tool-use/guard:
+    tools: [write_file]
+  session-start/gaurd:
+    timeout: 5
+`)
+	var buf bytes.Buffer
+	restore := ctxLog.SetSink(&buf)
+	defer restore()
+
+	if _, err := Discover(hooksDir); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	got := buf.String()
+	for _, key := range []string{"post-tool-use/guard", "session-start/gaurd"} {
+		if !strings.Contains(got, key) {
+			t.Errorf("no warning for %s in %q", key, got)
+		}
+	}
+	if strings.Contains(got, `"pre-tool-use/guard"`) {
+		t.Errorf("matched entry reported as unmatched: %q", got)
+	}
+}
+
+func TestDiscover_InvalidManifest(t *testing.T) {
+	tests := map[string]string{
+		"unknown key":      "hooks:\n  pre-tool-use/a:\n    tool: [x]\n",
+		"negative timeout": "hooks:\n  pre-tool-use/a:\n    timeout: -1\n",
+		"timeout over cap": "hooks:\n  pre-tool-use/a:\n    timeout: 601\n",
+		"bare name":        "hooks:\n  a:\n    timeout: 1\n",
+		"unknown type":     "hooks:\n  pre-tool/a:\n    timeout: 1\n",
+		"nested name":      "hooks:\n  pre-tool-use/a/b:\n    timeout: 1\n",
+		"malformed yaml":   "hooks: [\n",
+	}
+	for name, content := range tests {
+		t.Run(name, func(t *testing.T) {
+			hooksDir := filepath.Join(t.TempDir(), "hooks")
+			writeManifest(t, hooksDir, content)
+			if _, err := Discover(hooksDir); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
+
+// TestRunAll_SkipsNonMatching verifies that a hook whose matchers
+// reject the input is not spawned.
+func TestRunAll_SkipsNonMatching(t *testing.T) {
+	skipIfWindows(t)
+
+	dir := t.TempDir()
+	hooksDir := filepath.Join(dir, "hooks")
+	marker := filepath.Join(dir, "ran")
+	writeHookScript(t, hooksDir, "pre-tool-use", "guard.sh",
+		"#!/bin/sh\ntouch "+marker+"\necho '{\"context\": \"guarded\"}'")
+	writeManifest(t, hooksDir, "hooks:\n  pre-tool-use/guard:\n    paths: [\"secrets/**\"]\n")
+
+	params := map[string]any{cfgTrigger.ParamPath: "src/main.go"}
+	input := &HookInput{TriggerType: "pre-tool-use", Tool: "write_file", Parameters: params}
+	agg, err := RunAll(hooksDir, cfgTrigger.PreToolUse, input, 5*time.Second)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if agg.Context != "" {
+		t.Errorf("expected no context, got %q", agg.Context)
+	}
+	if _, statErr := os.Stat(marker); !os.IsNotExist(statErr) {
+		t.Error("non-matching hook should not have been spawned")
+	}
+
+	params[cfgTrigger.ParamPath] = "secrets/prod.env"
+	agg, err = RunAll(hooksDir, cfgTrigger.PreToolUse, input, 5*time.Second)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(agg.Context, "guarded") {
+		t.Errorf("matching hook should run, got context %q", agg.Context)
+	}
+}
+
+// TestRunAll_ManifestTimeout verifies that a hooks.yaml timeout
+// overrides the caller's timeout for that hook.
+func TestRunAll_ManifestTimeout(t *testing.T) {
+	skipIfWindows(t)
+
+	hooksDir := filepath.Join(t.TempDir(), "hooks")
+	writeHookScript(t, hooksDir, "session-start", "slow.sh",
+		"#!/bin/sh\nsleep 3\necho '{}'")
+	writeManifest(t, hooksDir, "hooks:\n  session-start/slow:\n    timeout: 1\n")
+
+	agg, err := RunAll(hooksDir, cfgTrigger.SessionStart, &HookInput{}, 30*time.Second)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(agg.Errors) != 1 || !strings.Contains(agg.Errors[0], "timeout after 1s") {
+		t.Errorf("expected a 1s timeout error, got %v", agg.Errors)
+	}
+}
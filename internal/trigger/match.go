@@ -0,0 +1,136 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package trigger
+
+import (
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/ActiveMemory/ctx/internal/config/token"
+	cfgTrigger "github.com/ActiveMemory/ctx/internal/config/trigger"
+)
+
+// matches reports whether a hook's manifest matchers accept the
+// input. Each non-empty matcher list must accept its input value.
+// A declared tools or paths matcher fails when the input carries
+// no tool or path, so a guard scoped to files never runs for an
+// event that names none; an unknown session tool is not filtered
+// on, as with steering tool scopes.
+//
+// Parameters:
+//   - spec: the hook's manifest entry
+//   - input: the event payload; nil carries no values
+//
+// Returns:
+//   - bool: true when the hook should run
+func matches(spec HookSpec, input *HookInput) bool {
+	if input == nil {
+		input = &HookInput{}
+	}
+	if len(spec.Tools) > 0 && (input.Tool == "" ||
+		!slices.ContainsFunc(spec.Tools, func(p string) bool {
+			// Acceptable discard: a malformed pattern simply
+			// does not match.
+			ok, _ := path.Match(p, input.Tool)
+			return ok
+		})) {
+		return false
+	}
+	if input.Session.Tool != "" && len(spec.SessionTools) > 0 &&
+		!slices.Contains(spec.SessionTools, input.Session.Tool) {
+		return false
+	}
+	// Acceptable discard: a missing or non-string path parameter
+	// is an empty path, which no path matcher accepts.
+	p, _ := input.Parameters[cfgTrigger.ParamPath].(string)
+	if len(spec.Paths) > 0 && (p == "" ||
+		!slices.ContainsFunc(spec.Paths, func(g string) bool {
+			return matchPath(g, relPath(p))
+		})) {
+		return false
+	}
+	return true
+}
+
+// matchPath matches a slash-separated path against a glob. A
+// pattern without a slash matches the base name anywhere in the
+// tree; otherwise it is anchored at the project root, and a "**"
+// segment spans zero or more directories.
+//
+// Parameters:
+//   - pattern: the glob from hooks.yaml
+//   - p: the slash-separated path to test
+//
+// Returns:
+//   - bool: true on a match
+func matchPath(pattern, p string) bool {
+	if !strings.Contains(pattern, token.Slash) {
+		// Acceptable discard: a malformed pattern simply does not
+		// match.
+		ok, _ := path.Match(pattern, path.Base(p))
+		return ok
+	}
+	return matchSegments(
+		strings.Split(pattern, token.Slash), strings.Split(p, token.Slash),
+	)
+}
+
+// matchSegments matches path segments against pattern segments,
+// expanding "**" to any number of segments.
+//
+// Parameters:
+//   - pattern: glob segments
+//   - segs: path segments
+//
+// Returns:
+//   - bool: true when every segment is consumed by the pattern
+func matchSegments(pattern, segs []string) bool {
+	if len(pattern) == 0 {
+		return len(segs) == 0
+	}
+	if pattern[0] == cfgTrigger.GlobAnyDepth {
+		for i := 0; i <= len(segs); i++ {
+			if matchSegments(pattern[1:], segs[i:]) {
+				return true
+			}
+		}
+		return false
+	}
+	if len(segs) == 0 {
+		return false
+	}
+	// Acceptable discard: a malformed pattern simply does not match.
+	ok, _ := path.Match(pattern[0], segs[0])
+	return ok && matchSegments(pattern[1:], segs[1:])
+}
+
+// relPath normalizes an input path for matching: slash-separated,
+// cleaned, and relative to the working directory (the project
+// root) when it is absolute and lies beneath it.
+//
+// Parameters:
+//   - p: the path from the hook input
+//
+// Returns:
+//   - string: the path to match globs against
+func relPath(p string) string {
+	if filepath.IsAbs(p) {
+		if wd, wdErr := os.Getwd(); wdErr == nil {
+			rel, relErr := filepath.Rel(wd, p)
+			outside := rel == token.ParentDir || strings.HasPrefix(
+				rel, token.ParentDir+string(filepath.Separator),
+			)
+			if relErr == nil && !outside {
+				p = rel
+			}
+		}
+	}
+	return path.Clean(filepath.ToSlash(p))
+}
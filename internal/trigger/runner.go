@@ -18,9 +18,10 @@ import (
 	ctxLog "github.com/ActiveMemory/ctx/internal/log/warn"
 )
 
-// RunAll executes all enabled hooks for the given type in alphabetical
-// order. It passes input as JSON via stdin and reads HookOutput as JSON
-// from stdout.
+// RunAll executes all enabled hooks for the given type in priority,
+// then alphabetical, order. Hooks whose hooks.yaml matchers reject
+// the input are skipped without spawning a process. It passes input
+// as JSON via stdin and reads HookOutput as JSON from stdout.
 //
 // Behaviour per hook:
 //   - cancel:true in output → halt, set Cancelled/Message, return
@@ -35,7 +36,8 @@ import (
 //   - hooksDir: root hooks directory (e.g. .context/hooks)
 //   - hookType: lifecycle event category
 //   - input: JSON object sent to each hook via stdin
-//   - timeout: per-hook execution timeout; zero uses DefaultTimeout
+//   - timeout: per-hook execution timeout; zero uses DefaultTimeout.
+//     A hooks.yaml timeout overrides it for that hook.
 //
 // Returns:
 //   - *AggregatedOutput: aggregated results from all hooks
@@ -68,11 +70,16 @@ func RunAll(
 	}
 
 	for _, h := range hooks {
-		if !h.Enabled {
+		if !h.Enabled || !matches(h.Spec, input) {
 			continue
 		}
 
-		out, runErr := runOne(h, inputJSON, timeout)
+		hookTimeout := timeout
+		if h.Spec.Timeout > 0 {
+			hookTimeout = time.Duration(h.Spec.Timeout) * time.Second
+		}
+
+		out, runErr := runOne(h, inputJSON, hookTimeout)
 		if runErr != nil {
 			ctxLog.Warn(
 				desc.Text(text.DescKeyTriggerWarn),
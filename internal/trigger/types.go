@@ -54,11 +54,41 @@ type HookOutput struct {
 //   - Type: Lifecycle event category
 //   - Path: Filesystem path to the script
 //   - Enabled: True if the executable permission bit is set
+//   - Spec: Matchers, timeout, and priority from hooks.yaml
 type HookInfo struct {
 	Name    string
 	Type    HookType
 	Path    string
 	Enabled bool
+	Spec    HookSpec
+}
+
+// HookSpec is one hook's entry in hooks.yaml. Empty matcher
+// lists match everything; a hook with no entry runs for every
+// event of its type.
+//
+// Fields:
+//   - Tools: Globs matched against the input tool name
+//   - Paths: Globs matched against the input "path" parameter
+//   - SessionTools: AI tool identifiers (claude, cursor, ...)
+//   - Timeout: Per-hook timeout in seconds, at most MaxTimeout;
+//     zero uses the caller's
+//   - Priority: Run order within the type, lowest first
+type HookSpec struct {
+	Tools        []string `yaml:"tools,omitempty"`
+	Paths        []string `yaml:"paths,omitempty"`
+	SessionTools []string `yaml:"session_tools,omitempty"`
+	Timeout      int      `yaml:"timeout,omitempty"`
+	Priority     int      `yaml:"priority,omitempty"`
+}
+
+// Manifest is the parsed hooks.yaml.
+//
+// Fields:
+//   - Hooks: Specs keyed by "<type>/<name>": the trigger type
+//     and the script filename without extension
+type Manifest struct {
+	Hooks map[string]HookSpec `yaml:"hooks"`
 }
 
 // AggregatedOutput collects results from all triggers in a run.
@@ -0,0 +1,28 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+//go:build !windows
+
+package txn
+
+import (
+	"errors"
+	"syscall"
+)
+
+// alive reports whether a process with the given PID exists.
+// Signal 0 checks for existence without delivering anything; a
+// permission error still means the process is there.
+//
+// Parameters:
+//   - pid: the process ID recorded in the lock file
+//
+// Returns:
+//   - bool: true when the process exists
+func alive(pid int) bool {
+	killErr := syscall.Kill(pid, 0)
+	return killErr == nil || errors.Is(killErr, syscall.EPERM)
+}
@@ -0,0 +1,31 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+//go:build windows
+
+package txn
+
+import "os"
+
+// alive reports whether a process with the given PID exists.
+// On Windows, FindProcess opens a handle and fails when the
+// process is gone.
+//
+// Parameters:
+//   - pid: the process ID recorded in the lock file
+//
+// Returns:
+//   - bool: true when the process exists
+func alive(pid int) bool {
+	proc, findErr := os.FindProcess(pid)
+	if findErr != nil {
+		return false
+	}
+	// Acceptable discard: the handle only served the existence
+	// check.
+	_ = proc.Release()
+	return true
+}
@@ -0,0 +1,40 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package txn serializes writers to one .context directory across
+// processes.
+//
+// Several agents, plus hooks firing ctx system subcommands, run
+// against the same .context/ at once. Each of them reads a file,
+// changes it, and writes it back; without coordination two
+// concurrent writers each start from the same content and the
+// second write silently drops the first one's change.
+//
+// [Do] runs a read-modify-write under an advisory lock file,
+// .context/state/write.lock, created with O_EXCL. The file records
+// the holder's PID, host, and acquisition time. A writer that finds
+// the lock held polls until it is released; it breaks the lock
+// early when the holder is a dead process on this host, and, for a
+// holder it cannot check (another host, or a crash before the
+// holder was recorded), when the lock is older than any write
+// could take. A stale lock is renamed aside and its holder checked
+// again before it is removed, so two writers breaking it at once
+// cannot remove a fresh lock. A live holder that outlasts the wait
+// fails the caller rather than blocking it forever.
+//
+// Writes inside a transaction commit through
+// [io.SafeWriteFileAtomic] (temp file plus rename), so a reader
+// never sees a half-written file even while the lock is held.
+//
+// # Nesting
+//
+// [Do] is reentrant within a process: a mutating helper that
+// locks for itself (entry.Write, tidy.WriteArchive) can run inside
+// a caller's wider transaction. Nesting is tracked per process,
+// not per goroutine; ctx runs one command at a time per process.
+//
+// [io.SafeWriteFileAtomic]: github.com/ActiveMemory/ctx/internal/io
+package txn
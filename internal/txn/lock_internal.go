@@ -0,0 +1,236 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package txn
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	cfgFs "github.com/ActiveMemory/ctx/internal/config/fs"
+	cfgTxn "github.com/ActiveMemory/ctx/internal/config/txn"
+	cfgWarn "github.com/ActiveMemory/ctx/internal/config/warn"
+	errTxn "github.com/ActiveMemory/ctx/internal/err/txn"
+	ctxIo "github.com/ActiveMemory/ctx/internal/io"
+	logWarn "github.com/ActiveMemory/ctx/internal/log/warn"
+)
+
+// held counts, per lock file, how deeply this process is nested
+// inside Do. A lock file appears here only while this process
+// holds it.
+var held = struct {
+	sync.Mutex
+	depth map[string]int
+}{depth: make(map[string]int)}
+
+// enter records a nested Do when this process already holds the
+// lock.
+//
+// Parameters:
+//   - path: the lock file
+//
+// Returns:
+//   - bool: true when the lock was already held and is now nested
+func enter(path string) bool {
+	held.Lock()
+	defer held.Unlock()
+	if held.depth[path] == 0 {
+		return false
+	}
+	held.depth[path]++
+	return true
+}
+
+// leave unwinds one level of Do and releases the lock file when
+// the outermost level returns.
+//
+// Parameters:
+//   - path: the lock file
+func leave(path string) {
+	held.Lock()
+	defer held.Unlock()
+	held.depth[path]--
+	if held.depth[path] > 0 {
+		return
+	}
+	delete(held.depth, path)
+	if unlockErr := ctxIo.SafeUnlock(path); unlockErr != nil {
+		logWarn.Warn(cfgWarn.TxnRelease, path, unlockErr)
+	}
+}
+
+// acquire creates the lock file, waiting for a live holder and
+// breaking a stale one, and records this process as the holder.
+//
+// Parameters:
+//   - path: the lock file
+//
+// Returns:
+//   - error: a filesystem failure, or a timeout naming the holder
+func acquire(path string) error {
+	if mkErr := ctxIo.SafeMkdirAll(
+		filepath.Dir(path), cfgFs.PermRestrictedDir,
+	); mkErr != nil {
+		return errTxn.Lock(path, mkErr)
+	}
+	deadline := time.Now().Add(cfgTxn.Wait)
+	for {
+		ok, tryErr := ctxIo.SafeTryLock(path, cfgFs.PermFile)
+		if tryErr != nil {
+			return errTxn.Lock(path, tryErr)
+		}
+		if ok {
+			return record(path)
+		}
+		holder, stale := inspect(path)
+		if stale {
+			breakLock(path, holder)
+			continue
+		}
+		if time.Now().After(deadline) {
+			return errTxn.Timeout(path, string(holder), cfgTxn.Wait)
+		}
+		time.Sleep(cfgTxn.Poll)
+	}
+}
+
+// record writes this process's identity into a freshly created
+// lock file and marks the lock held.
+//
+// Parameters:
+//   - path: the lock file this process just created
+//
+// Returns:
+//   - error: non-nil when the holder cannot be written; the lock
+//     file is removed again
+func record(path string) error {
+	host, hostErr := os.Hostname()
+	if hostErr != nil {
+		host = ""
+	}
+	owner := fmt.Sprintf(
+		cfgTxn.OwnerFormat, os.Getpid(), host, time.Now().UnixNano(),
+	)
+	if writeErr := ctxIo.SafeWriteFile(
+		path, []byte(owner), cfgFs.PermFile,
+	); writeErr != nil {
+		if unlockErr := ctxIo.SafeUnlock(path); unlockErr != nil {
+			logWarn.Warn(cfgWarn.TxnRelease, path, unlockErr)
+		}
+		return errTxn.Lock(path, writeErr)
+	}
+	held.Lock()
+	held.depth[path] = 1
+	held.Unlock()
+	return nil
+}
+
+// inspect reads a held lock and decides whether it is stale. A
+// holder recorded on this host is stale exactly when its process
+// is dead, however old the lock; a holder on another host, or one
+// not yet recorded, can only be judged by age.
+//
+// Parameters:
+//   - path: the lock file
+//
+// Returns:
+//   - []byte: the recorded holder (nil when the lock vanished)
+//   - bool: true when the lock may be broken, or has already been
+//     released and can be retried at once
+func inspect(path string) ([]byte, bool) {
+	holder, readErr := ctxIo.SafeReadUserFile(path)
+	if errors.Is(readErr, os.ErrNotExist) {
+		return nil, true
+	}
+	if pid, local := localOwner(holder); local {
+		return holder, !alive(pid)
+	}
+	info, statErr := ctxIo.SafeStat(path)
+	if statErr != nil {
+		return holder, false
+	}
+	return holder, time.Since(info.ModTime()) > cfgTxn.StaleAfter
+}
+
+// localOwner parses a recorded holder and reports whether it is a
+// process on this host.
+//
+// Parameters:
+//   - holder: the lock file content
+//
+// Returns:
+//   - int: the holder's PID
+//   - bool: true when the holder parsed and names this host
+func localOwner(holder []byte) (int, bool) {
+	var (
+		pid   int
+		host  string
+		nanos int64
+	)
+	if _, scanErr := fmt.Sscanf(
+		string(holder), cfgTxn.OwnerFormat, &pid, &host, &nanos,
+	); scanErr != nil {
+		return 0, false
+	}
+	self, hostErr := os.Hostname()
+	if hostErr != nil || host != self {
+		return 0, false
+	}
+	return pid, true
+}
+
+// breakLock removes a stale lock, unless another writer replaced
+// it since it was inspected. The lock is renamed aside first, so
+// the owner check and the removal see the same file; a lock that
+// turns out to have been replaced is put back.
+//
+// Parameters:
+//   - path: the lock file
+//   - holder: the stale holder as inspected
+func breakLock(path string, holder []byte) {
+	aside := fmt.Sprintf(
+		cfgTxn.AsideFormat, path, os.Getpid(), time.Now().UnixNano(),
+	)
+	if renameErr := ctxIo.SafeRename(path, aside); renameErr != nil {
+		// Released or broken by someone else: retry the lock.
+		return
+	}
+	current, readErr := ctxIo.SafeReadUserFile(aside)
+	if readErr == nil && bytes.Equal(current, holder) {
+		logWarn.Warn(cfgWarn.TxnStaleLock, path, string(holder))
+	} else {
+		restore(path, current)
+	}
+	if unlockErr := ctxIo.SafeUnlock(aside); unlockErr != nil {
+		logWarn.Warn(cfgWarn.TxnRelease, aside, unlockErr)
+	}
+}
+
+// restore puts back a live lock that breakLock moved aside. It
+// only creates the lock file, never replaces one: when yet another
+// writer took the lock in between, the displaced holder is
+// reported instead.
+//
+// Parameters:
+//   - path: the lock file
+//   - holder: the live holder's recorded content
+func restore(path string, holder []byte) {
+	ok, tryErr := ctxIo.SafeTryLock(path, cfgFs.PermFile)
+	if tryErr != nil || !ok {
+		logWarn.Warn(cfgWarn.TxnRestore, path, string(holder))
+		return
+	}
+	if writeErr := ctxIo.SafeWriteFile(
+		path, holder, cfgFs.PermFile,
+	); writeErr != nil {
+		logWarn.Warn(cfgWarn.TxnRestore, path, string(holder))
+	}
+}
@@ -0,0 +1,92 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package txn
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// writeLock writes a lock file holding owner, last modified age
+// ago.
+func writeLock(t *testing.T, owner string, age time.Duration) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "write.lock")
+	if writeErr := os.WriteFile(path, []byte(owner), 0o600); writeErr != nil {
+		t.Fatal(writeErr)
+	}
+	old := time.Now().Add(-age)
+	if chErr := os.Chtimes(path, old, old); chErr != nil {
+		t.Fatal(chErr)
+	}
+	return path
+}
+
+func TestInspect(t *testing.T) {
+	host, hostErr := os.Hostname()
+	if hostErr != nil {
+		t.Skip("no host name")
+	}
+	self := fmt.Sprintf("%d %s %d", os.Getpid(), host, 1)
+	foreign := fmt.Sprintf("%d %s %d", os.Getpid(), host+"-other", 1)
+
+	tests := []struct {
+		name  string
+		owner string
+		age   time.Duration
+		stale bool
+	}{
+		{"live holder on this host, however old", self, time.Hour, false},
+		{"foreign holder, fresh", foreign, 0, false},
+		{"foreign holder, past the stale age", foreign, time.Hour, true},
+		{"unrecorded holder, fresh", "", 0, false},
+		{"unrecorded holder, past the stale age", "", time.Hour, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := writeLock(t, tt.owner, tt.age)
+			if _, stale := inspect(path); stale != tt.stale {
+				t.Errorf("inspect() stale = %v, want %v", stale, tt.stale)
+			}
+		})
+	}
+}
+
+func TestBreakLock(t *testing.T) {
+	t.Run("removes the inspected holder", func(t *testing.T) {
+		path := writeLock(t, "stale", time.Hour)
+		breakLock(path, []byte("stale"))
+		entries, readErr := os.ReadDir(filepath.Dir(path))
+		if readErr != nil {
+			t.Fatal(readErr)
+		}
+		if len(entries) != 0 {
+			t.Errorf("left behind %v", entries)
+		}
+	})
+	t.Run("keeps a lock replaced since inspection", func(t *testing.T) {
+		path := writeLock(t, "fresh", 0)
+		breakLock(path, []byte("stale"))
+		data, readErr := os.ReadFile(path)
+		if readErr != nil {
+			t.Fatalf("fresh lock removed: %v", readErr)
+		}
+		if string(data) != "fresh" {
+			t.Errorf("lock = %q, want the fresh holder", data)
+		}
+		entries, dirErr := os.ReadDir(filepath.Dir(path))
+		if dirErr != nil {
+			t.Fatal(dirErr)
+		}
+		if len(entries) != 1 {
+			t.Errorf("entries = %v, want only the lock", entries)
+		}
+	})
+}
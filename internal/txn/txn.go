@@ -0,0 +1,38 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package txn
+
+import (
+	"path/filepath"
+
+	cfgDir "github.com/ActiveMemory/ctx/internal/config/dir"
+	cfgTxn "github.com/ActiveMemory/ctx/internal/config/txn"
+)
+
+// Do runs fn while holding the write lock of a context directory.
+// Everything fn reads and writes is seen by no other ctx writer
+// until fn returns. A call nested inside another Do on the same
+// directory runs fn directly.
+//
+// Parameters:
+//   - contextDir: the .context directory to lock
+//   - fn: the read-modify-write to run
+//
+// Returns:
+//   - error: a lock failure or timeout, or fn's error
+func Do(contextDir string, fn func() error) error {
+	path := filepath.Join(contextDir, cfgDir.State, cfgTxn.FileLock)
+	if enter(path) {
+		defer leave(path)
+		return fn()
+	}
+	if lockErr := acquire(path); lockErr != nil {
+		return lockErr
+	}
+	defer leave(path)
+	return fn()
+}
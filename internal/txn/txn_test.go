@@ -0,0 +1,175 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package txn_test
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ActiveMemory/ctx/internal/txn"
+)
+
+// helperEnv makes the test binary act as a concurrent writer for
+// TestDoAcrossProcesses.
+const helperEnv = "CTX_TXN_TEST_HELPER"
+
+func TestMain(m *testing.M) {
+	if dir := os.Getenv(helperEnv); dir != "" {
+		os.Exit(increment(dir, 20))
+	}
+	os.Exit(m.Run())
+}
+
+// increment adds one to the counter file n times, each as a
+// read-modify-write under the lock.
+func increment(dir string, n int) int {
+	counter := filepath.Join(dir, "counter")
+	for range n {
+		doErr := txn.Do(dir, func() error {
+			data, readErr := os.ReadFile(counter)
+			if readErr != nil && !os.IsNotExist(readErr) {
+				return readErr
+			}
+			v, _ := strconv.Atoi(strings.TrimSpace(string(data)))
+			return os.WriteFile(counter, []byte(strconv.Itoa(v+1)), 0o600)
+		})
+		if doErr != nil {
+			fmt.Fprintln(os.Stderr, doErr)
+			return 1
+		}
+	}
+	return 0
+}
+
+func lockPath(dir string) string {
+	return filepath.Join(dir, "state", "write.lock")
+}
+
+func TestDoNestsAndReleases(t *testing.T) {
+	dir := t.TempDir()
+	ran := false
+	doErr := txn.Do(dir, func() error {
+		if _, statErr := os.Stat(lockPath(dir)); statErr != nil {
+			t.Errorf("lock not held inside Do: %v", statErr)
+		}
+		return txn.Do(dir, func() error {
+			ran = true
+			return nil
+		})
+	})
+	if doErr != nil || !ran {
+		t.Fatalf("nested Do: err=%v ran=%v", doErr, ran)
+	}
+	if _, statErr := os.Stat(lockPath(dir)); !os.IsNotExist(statErr) {
+		t.Errorf("lock left behind after Do: %v", statErr)
+	}
+}
+
+func TestDoReturnsFnError(t *testing.T) {
+	dir := t.TempDir()
+	want := fmt.Errorf("boom")
+	if got := txn.Do(dir, func() error { return want }); got != want {
+		t.Errorf("Do() = %v, want %v", got, want)
+	}
+	if _, statErr := os.Stat(lockPath(dir)); !os.IsNotExist(statErr) {
+		t.Errorf("lock left behind after failed fn: %v", statErr)
+	}
+}
+
+func TestDoBreaksStaleLocks(t *testing.T) {
+	host, hostErr := os.Hostname()
+	if hostErr != nil {
+		t.Skip("no host name")
+	}
+	dead := exec.Command(os.Args[0], "-test.run=^$")
+	if runErr := dead.Run(); runErr != nil {
+		t.Fatal(runErr)
+	}
+
+	tests := []struct {
+		name  string
+		owner string
+		age   time.Duration
+	}{
+		{
+			name:  "dead holder on this host",
+			owner: fmt.Sprintf("%d %s %d", dead.Process.Pid, host, 1),
+		},
+		{
+			name:  "unreadable holder past the stale age",
+			owner: "",
+			age:   time.Hour,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			path := lockPath(dir)
+			if mkErr := os.MkdirAll(filepath.Dir(path), 0o750); mkErr != nil {
+				t.Fatal(mkErr)
+			}
+			if writeErr := os.WriteFile(
+				path, []byte(tt.owner), 0o600,
+			); writeErr != nil {
+				t.Fatal(writeErr)
+			}
+			old := time.Now().Add(-tt.age)
+			if chErr := os.Chtimes(path, old, old); chErr != nil {
+				t.Fatal(chErr)
+			}
+
+			start := time.Now()
+			if doErr := txn.Do(dir, func() error { return nil }); doErr != nil {
+				t.Fatalf("Do() = %v", doErr)
+			}
+			if waited := time.Since(start); waited > time.Second {
+				t.Errorf("Do waited %v for a stale lock", waited)
+			}
+		})
+	}
+}
+
+func TestDoAcrossProcesses(t *testing.T) {
+	dir := t.TempDir()
+	const writers = 4
+
+	var wg sync.WaitGroup
+	errs := make([]error, writers)
+	for i := range writers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c := exec.Command(os.Args[0], "-test.run=^$")
+			c.Env = append(os.Environ(), helperEnv+"="+dir)
+			out, runErr := c.CombinedOutput()
+			if runErr != nil {
+				errs[i] = fmt.Errorf("%w: %s", runErr, out)
+			}
+		}()
+	}
+	wg.Wait()
+	for _, e := range errs {
+		if e != nil {
+			t.Fatal(e)
+		}
+	}
+
+	data, readErr := os.ReadFile(filepath.Join(dir, "counter"))
+	if readErr != nil {
+		t.Fatal(readErr)
+	}
+	if got := strings.TrimSpace(string(data)); got != strconv.Itoa(writers*20) {
+		t.Errorf("counter = %s, want %d (lost updates)", got, writers*20)
+	}
+}
@@ -0,0 +1,79 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package ai
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	"github.com/ActiveMemory/ctx/internal/config/embed/text"
+)
+
+// Ping prints a successful reachability check.
+//
+// Parameters:
+//   - cmd: cobra command for output
+//   - name: the backend
+//   - endpoint: the backend base URL
+//   - model: the first listed model
+func Ping(cmd *cobra.Command, name, endpoint, model string) {
+	if cmd == nil {
+		return
+	}
+	cmd.Println(fmt.Sprintf(
+		desc.Text(text.DescKeyWriteAIPing), name, endpoint, model,
+	))
+}
+
+// ClassifyEntry prints one proposed classification.
+//
+// Parameters:
+//   - cmd: cobra command for output
+//   - title: the entry's first line, truncated
+//   - target: the proposed target
+//   - reason: the backend's one-line reason
+func ClassifyEntry(cmd *cobra.Command, title, target, reason string) {
+	if cmd == nil {
+		return
+	}
+	cmd.Println(fmt.Sprintf(
+		desc.Text(text.DescKeyWriteAIClassifyEntry), title, target, reason,
+	))
+}
+
+// ClassifyNone prints the message for a run with nothing to send.
+//
+// Parameters:
+//   - cmd: cobra command for output
+func ClassifyNone(cmd *cobra.Command) {
+	if cmd == nil {
+		return
+	}
+	cmd.Println(desc.Text(text.DescKeyWriteAIClassifyNone))
+}
+
+// ClassifySummary prints the post-run summary.
+//
+// Parameters:
+//   - cmd: cobra command for output
+//   - count: entries classified
+//   - name: the backend
+//   - model: the answering model
+//   - path: the proposal file
+func ClassifySummary(
+	cmd *cobra.Command, count int, name, model, path string,
+) {
+	if cmd == nil {
+		return
+	}
+	cmd.Println(fmt.Sprintf(
+		desc.Text(text.DescKeyWriteAIClassifySummary),
+		count, name, model, path,
+	))
+}
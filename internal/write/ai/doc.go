@@ -0,0 +1,14 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package ai provides terminal output for the ctx ai commands
+// (ai ping, ai classify).
+//
+// Ping confirms the selected backend, its endpoint, and the first
+// model it lists; classify prints each proposed target with the
+// backend's reason and a summary naming the proposal file, so the
+// user knows nothing in .context/ was changed.
+package ai
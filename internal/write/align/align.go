@@ -0,0 +1,115 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package align
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	engine "github.com/ActiveMemory/ctx/internal/align"
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	"github.com/ActiveMemory/ctx/internal/config/embed/text"
+	cfgEntry "github.com/ActiveMemory/ctx/internal/config/entry"
+	cfgToken "github.com/ActiveMemory/ctx/internal/config/token"
+)
+
+// Summary prints the post-run summary.
+//
+// Parameters:
+//   - cmd: cobra command for output
+//   - scanned: sessions scanned this run
+//   - proposed: new candidates this run
+//   - path: the review file
+func Summary(cmd *cobra.Command, scanned, proposed int, path string) {
+	if cmd == nil {
+		return
+	}
+	if proposed == 0 {
+		cmd.Println(fmt.Sprintf(
+			desc.Text(text.DescKeyWriteAlignNothing), scanned,
+		))
+		return
+	}
+	cmd.Println(fmt.Sprintf(
+		desc.Text(text.DescKeyWriteAlignSummary), scanned, proposed, path,
+	))
+}
+
+// ReviewNone prints the no-pending-candidates review message.
+//
+// Parameters:
+//   - cmd: cobra command for output
+func ReviewNone(cmd *cobra.Command) {
+	if cmd == nil {
+		return
+	}
+	cmd.Println(desc.Text(text.DescKeyWriteAlignReviewNone))
+}
+
+// Review renders the pending candidates: a header with the count
+// and review file, then each candidate's id, kind, title, source
+// session, assumption, and signals.
+//
+// Parameters:
+//   - cmd: cobra command for output
+//   - pending: the candidates awaiting review
+//   - path: the review file
+func Review(cmd *cobra.Command, pending []engine.Candidate, path string) {
+	if cmd == nil {
+		return
+	}
+	cmd.Println(fmt.Sprintf(
+		desc.Text(text.DescKeyWriteAlignReviewHeader), len(pending), path,
+	))
+	for _, c := range pending {
+		cmd.Println(fmt.Sprintf(
+			desc.Text(text.DescKeyWriteAlignReviewItem),
+			c.ID, c.Kind, c.Title,
+		))
+		cmd.Println(fmt.Sprintf(
+			desc.Text(text.DescKeyWriteAlignReviewSource),
+			c.Session, c.Date, c.Tool,
+		))
+		cmd.Println(fmt.Sprintf(
+			desc.Text(text.DescKeyWriteAlignReviewAssumption),
+			c.Assumption,
+		))
+		cmd.Println(fmt.Sprintf(
+			desc.Text(text.DescKeyWriteAlignReviewSignals),
+			strings.Join(c.Signals, cfgToken.CommaSpace),
+		))
+	}
+}
+
+// Accepted confirms a candidate written to its context file.
+//
+// Parameters:
+//   - cmd: cobra command for output
+//   - c: the accepted candidate
+func Accepted(cmd *cobra.Command, c engine.Candidate) {
+	if cmd == nil {
+		return
+	}
+	cmd.Println(fmt.Sprintf(
+		desc.Text(text.DescKeyWriteAlignAccepted),
+		c.ID, cfgEntry.MustCtxFile(c.Kind), c.Title,
+	))
+}
+
+// Rejected confirms a discarded candidate.
+//
+// Parameters:
+//   - cmd: cobra command for output
+//   - c: the rejected candidate
+func Rejected(cmd *cobra.Command, c engine.Candidate) {
+	if cmd == nil {
+		return
+	}
+	cmd.Println(fmt.Sprintf(desc.Text(text.DescKeyWriteAlignRejected), c.ID))
+}
@@ -0,0 +1,15 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package align provides terminal output for the ctx align
+// commands (ctx align, align review, align accept/reject).
+//
+// The run prints a one-line summary pointing at the review file;
+// the review renders each pending candidate's id, kind, title,
+// source session, the assumption the agent was acting on, and the
+// signals that found it; the dispositions confirm each accepted or
+// rejected candidate.
+package align
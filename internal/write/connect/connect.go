@@ -58,6 +58,55 @@ func Published(cmd *cobra.Command, count int) {
 	))
 }
 
+// Retracted confirms a retraction was published.
+//
+// Parameters:
+//   - cmd: Cobra command for output
+//   - id: ID of the retracted entry
+func Retracted(cmd *cobra.Command, id string) {
+	cmd.Println(fmt.Sprintf(
+		desc.Text(text.DescKeyWriteConnectRetracted), id,
+	))
+}
+
+// SearchHit prints one hub search match.
+//
+// Parameters:
+//   - cmd: Cobra command for output
+//   - date: entry date (YYYY-MM-DD)
+//   - entryType: entry type
+//   - origin: publishing project
+//   - id: hub entry ID
+//   - title: first line of the entry content
+func SearchHit(
+	cmd *cobra.Command,
+	date, entryType, origin, id, title string,
+) {
+	cmd.Println(fmt.Sprintf(
+		desc.Text(text.DescKeyWriteConnectSearchHit),
+		date, entryType, origin, id, title,
+	))
+}
+
+// SearchNone reports a hub search without matches.
+//
+// Parameters:
+//   - cmd: Cobra command for output
+func SearchNone(cmd *cobra.Command) {
+	cmd.Println(desc.Text(text.DescKeyWriteConnectSearchNone))
+}
+
+// SearchMore notes that a hub search stopped at its limit.
+//
+// Parameters:
+//   - cmd: Cobra command for output
+//   - shown: number of matches printed
+func SearchMore(cmd *cobra.Command, shown int) {
+	cmd.Println(fmt.Sprintf(
+		desc.Text(text.DescKeyWriteConnectSearchMore), shown,
+	))
+}
+
 // Listening confirms the listen stream is active.
 //
 // Parameters:
@@ -113,3 +162,25 @@ func Status(
 		total, clients,
 	))
 }
+
+// Fingerprints prints the certificate fingerprints held in
+// the connection state. Prints nothing over plaintext.
+//
+// Parameters:
+//   - cmd: Cobra command for output
+//   - hubFP: pinned hub certificate fingerprint
+//   - clientFP: client certificate fingerprint (mutual TLS)
+func Fingerprints(cmd *cobra.Command, hubFP, clientFP string) {
+	if hubFP != "" {
+		cmd.Println(fmt.Sprintf(
+			desc.Text(text.DescKeyWriteConnectFingerprintHub),
+			hubFP,
+		))
+	}
+	if clientFP != "" {
+		cmd.Println(fmt.Sprintf(
+			desc.Text(text.DescKeyWriteConnectFingerprintClient),
+			clientFP,
+		))
+	}
+}
@@ -0,0 +1,15 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package fleet provides terminal output for the ctx fleet
+// commands (fleet agents, status, claim, assign, release).
+//
+// Agent profiles print one line each with their capabilities
+// beneath; status prints every pending task with its key and
+// holder, plus live leases whose task is no longer pending.
+// Lease confirmations name the task number, key, agent, and
+// the local time the lease lapses.
+package fleet
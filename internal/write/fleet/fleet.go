@@ -0,0 +1,118 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package fleet
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	"github.com/ActiveMemory/ctx/internal/config/embed/text"
+)
+
+// Agent prints one agent profile.
+//
+// Parameters:
+//   - cmd: cobra command for output
+//   - id: agent ID
+//   - tool: tool the agent runs in ("" = unknown)
+//   - window: context window in tokens ("" = unknown)
+//   - cost: cost tier ("" = unknown)
+//   - capabilities: comma-joined capabilities ("" = none)
+func Agent(
+	cmd *cobra.Command, id, tool, window, cost, capabilities string,
+) {
+	if cmd == nil {
+		return
+	}
+	cmd.Println(fmt.Sprintf(
+		desc.Text(text.DescKeyWriteFleetAgent), id,
+		orUnknown(tool), orUnknown(window), orUnknown(cost),
+	))
+	if capabilities == "" {
+		return
+	}
+	cmd.Println(fmt.Sprintf(
+		desc.Text(text.DescKeyWriteFleetAgentCapabilities),
+		capabilities,
+	))
+}
+
+// NoAgents prints the message for an empty or missing
+// fleet.yaml.
+//
+// Parameters:
+//   - cmd: cobra command for output
+//   - path: fleet.yaml path
+func NoAgents(cmd *cobra.Command, path string) {
+	if cmd == nil {
+		return
+	}
+	cmd.Println(fmt.Sprintf(desc.Text(text.DescKeyWriteFleetNoAgents), path))
+}
+
+// Claimed confirms a granted claim.
+//
+// Parameters:
+//   - cmd: cobra command for output
+//   - index: 1-based pending task number
+//   - key: task key
+//   - agent: claiming agent
+//   - expires: when the lease lapses
+//   - content: task text
+func Claimed(
+	cmd *cobra.Command, index int, key, agent string,
+	expires time.Time, content string,
+) {
+	if cmd == nil {
+		return
+	}
+	cmd.Println(fmt.Sprintf(
+		desc.Text(text.DescKeyWriteFleetClaim),
+		index, key, agent, until(expires), content,
+	))
+}
+
+// Assigned confirms a granted assignment.
+//
+// Parameters:
+//   - cmd: cobra command for output
+//   - index: 1-based pending task number
+//   - key: task key
+//   - agent: assignee
+//   - expires: when the lease lapses
+//   - content: task text
+func Assigned(
+	cmd *cobra.Command, index int, key, agent string,
+	expires time.Time, content string,
+) {
+	if cmd == nil {
+		return
+	}
+	cmd.Println(fmt.Sprintf(
+		desc.Text(text.DescKeyWriteFleetAssign),
+		index, key, agent, until(expires), content,
+	))
+}
+
+// Released confirms a released lease.
+//
+// Parameters:
+//   - cmd: cobra command for output
+//   - key: task key
+//   - agent: releasing agent
+//   - content: task text
+func Released(cmd *cobra.Command, key, agent, content string) {
+	if cmd == nil {
+		return
+	}
+	cmd.Println(fmt.Sprintf(
+		desc.Text(text.DescKeyWriteFleetRelease), key, agent, content,
+	))
+}
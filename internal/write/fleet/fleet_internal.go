@@ -0,0 +1,55 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package fleet
+
+import (
+	"time"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	"github.com/ActiveMemory/ctx/internal/config/embed/text"
+	cfgTime "github.com/ActiveMemory/ctx/internal/config/time"
+)
+
+// orUnknown substitutes the unknown label for an unset
+// profile field.
+//
+// Parameters:
+//   - s: field value
+//
+// Returns:
+//   - string: s, or the unknown label when s is empty
+func orUnknown(s string) string {
+	if s == "" {
+		return desc.Text(text.DescKeyWriteFleetUnknown)
+	}
+	return s
+}
+
+// label names how a lease was granted.
+//
+// Parameters:
+//   - assigned: true for an assign, false for a claim
+//
+// Returns:
+//   - string: the assigned or claimed label
+func label(assigned bool) string {
+	if assigned {
+		return desc.Text(text.DescKeyWriteFleetAssigned)
+	}
+	return desc.Text(text.DescKeyWriteFleetClaimed)
+}
+
+// until formats a lease expiry in local time.
+//
+// Parameters:
+//   - t: expiry
+//
+// Returns:
+//   - string: local date and time, minute precision
+func until(t time.Time) string {
+	return t.Local().Format(cfgTime.DateTimeFmt)
+}
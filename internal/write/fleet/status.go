@@ -0,0 +1,91 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package fleet
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	"github.com/ActiveMemory/ctx/internal/config/embed/text"
+)
+
+// StatusHeld prints a pending task someone holds.
+//
+// Parameters:
+//   - cmd: cobra command for output
+//   - index: 1-based pending task number
+//   - key: task key
+//   - assigned: true for an assign, false for a claim
+//   - agent: holder
+//   - expires: when the lease lapses
+//   - content: task text
+func StatusHeld(
+	cmd *cobra.Command, index int, key string, assigned bool,
+	agent string, expires time.Time, content string,
+) {
+	if cmd == nil {
+		return
+	}
+	cmd.Println(fmt.Sprintf(
+		desc.Text(text.DescKeyWriteFleetStatusHeld),
+		index, key, label(assigned), agent, until(expires), content,
+	))
+}
+
+// StatusFree prints a pending task nobody holds.
+//
+// Parameters:
+//   - cmd: cobra command for output
+//   - index: 1-based pending task number
+//   - key: task key
+//   - content: task text
+func StatusFree(cmd *cobra.Command, index int, key, content string) {
+	if cmd == nil {
+		return
+	}
+	cmd.Println(fmt.Sprintf(
+		desc.Text(text.DescKeyWriteFleetStatusFree), index, key, content,
+	))
+}
+
+// StatusStale prints a live lease whose task is no longer
+// pending (completed or reworded).
+//
+// Parameters:
+//   - cmd: cobra command for output
+//   - key: task key
+//   - assigned: true for an assign, false for a claim
+//   - agent: holder
+//   - expires: when the lease lapses
+//   - content: task text as leased
+func StatusStale(
+	cmd *cobra.Command, key string, assigned bool,
+	agent string, expires time.Time, content string,
+) {
+	if cmd == nil {
+		return
+	}
+	cmd.Println(fmt.Sprintf(
+		desc.Text(text.DescKeyWriteFleetStatusStale),
+		key, label(assigned), agent, until(expires), content,
+	))
+}
+
+// StatusNone prints the message for a TASKS.md with nothing
+// pending.
+//
+// Parameters:
+//   - cmd: cobra command for output
+func StatusNone(cmd *cobra.Command) {
+	if cmd == nil {
+		return
+	}
+	cmd.Println(desc.Text(text.DescKeyWriteFleetStatusNone))
+}
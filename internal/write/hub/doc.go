@@ -19,6 +19,12 @@
 // and prints the peer address. [PeerRemoved] confirms
 // a peer was removed with its address.
 //
+// # Client Policy
+//
+// [Policy] prints a client's access policy: read-only
+// mode, publishable types, and readable types and
+// origins.
+//
 // # Leadership
 //
 // [SteppedDown] confirms that leadership was
@@ -74,6 +74,42 @@ func Revoked(cmd *cobra.Command, clientID string) {
 	))
 }
 
+// Policy prints a client's access policy, one restriction
+// per line. Empty lists print as "any".
+//
+// Parameters:
+//   - cmd: Cobra command for output
+//   - clientID: ID of the client
+//   - project: the client's project name
+//   - readOnly: whether publishes are rejected
+//   - publish: entry types the client may publish
+//   - types: entry types the client may read
+//   - origins: origin projects the client may read
+func Policy(
+	cmd *cobra.Command,
+	clientID, project string,
+	readOnly bool,
+	publish, types, origins []string,
+) {
+	cmd.Println(fmt.Sprintf(
+		desc.Text(text.DescKeyWriteHubPolicyClient),
+		clientID, project,
+	))
+	cmd.Println(fmt.Sprintf(
+		desc.Text(text.DescKeyWriteHubPolicyReadOnly), readOnly,
+	))
+	cmd.Println(fmt.Sprintf(
+		desc.Text(text.DescKeyWriteHubPolicyPublish), list(publish),
+	))
+	cmd.Println(fmt.Sprintf(
+		desc.Text(text.DescKeyWriteHubPolicyReadTypes), list(types),
+	))
+	cmd.Println(fmt.Sprintf(
+		desc.Text(text.DescKeyWriteHubPolicyReadOrigins),
+		list(origins),
+	))
+}
+
 // SteppedDown confirms leadership transfer.
 //
 // Parameters:
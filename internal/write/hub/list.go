@@ -0,0 +1,30 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package hub
+
+import (
+	"strings"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	"github.com/ActiveMemory/ctx/internal/config/embed/text"
+	"github.com/ActiveMemory/ctx/internal/config/token"
+)
+
+// list joins a policy list for display; an empty list
+// means unrestricted.
+//
+// Parameters:
+//   - items: list entries
+//
+// Returns:
+//   - string: comma-separated items, or the "any" label
+func list(items []string) string {
+	if len(items) == 0 {
+		return desc.Text(text.DescKeyWriteHubPolicyAny)
+	}
+	return strings.Join(items, token.CommaSpace)
+}
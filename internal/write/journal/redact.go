@@ -0,0 +1,93 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package journal
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	"github.com/ActiveMemory/ctx/internal/config/embed/text"
+)
+
+// ImportRedacted prints how many secrets import removed from the
+// entry it just wrote. Zero prints nothing.
+//
+// Parameters:
+//   - cmd: Cobra command for output. Nil is a no-op.
+//   - count: number of secrets replaced
+func ImportRedacted(cmd *cobra.Command, count int) {
+	if cmd == nil || count == 0 {
+		return
+	}
+	cmd.Println(fmt.Sprintf(
+		desc.Text(text.DescKeyWriteJournalRedactImported), count))
+}
+
+// RedactFinding prints one secret found by redact --check.
+//
+// Parameters:
+//   - cmd: Cobra command for output. Nil is a no-op.
+//   - filename: journal entry filename
+//   - line: 1-based line of the secret
+//   - rule: name of the rule that matched
+func RedactFinding(
+	cmd *cobra.Command, filename string, line int, rule string,
+) {
+	if cmd == nil {
+		return
+	}
+	cmd.Println(fmt.Sprintf(
+		desc.Text(text.DescKeyWriteJournalRedactFinding),
+		filename, line, rule))
+}
+
+// RedactClean prints that redact --check found no secrets.
+//
+// Parameters:
+//   - cmd: Cobra command for output. Nil is a no-op.
+//   - entries: number of entries scanned
+func RedactClean(cmd *cobra.Command, entries int) {
+	if cmd == nil {
+		return
+	}
+	cmd.Println(fmt.Sprintf(
+		desc.Text(text.DescKeyWriteJournalRedactClean), entries))
+}
+
+// RedactedEntry prints that redact rewrote an entry.
+//
+// Parameters:
+//   - cmd: Cobra command for output. Nil is a no-op.
+//   - filename: journal entry filename
+//   - count: number of secrets replaced
+func RedactedEntry(cmd *cobra.Command, filename string, count int) {
+	if cmd == nil {
+		return
+	}
+	cmd.Println(fmt.Sprintf(
+		desc.Text(text.DescKeyWriteJournalRedactEntry), filename, count))
+}
+
+// RedactSummary prints the totals of a redact run.
+//
+// Parameters:
+//   - cmd: Cobra command for output. Nil is a no-op.
+//   - secrets: number of secrets replaced
+//   - entries: number of entries rewritten
+//   - version: ruleset version recorded in the journal state
+func RedactSummary(
+	cmd *cobra.Command, secrets, entries int, version string,
+) {
+	if cmd == nil {
+		return
+	}
+	cmd.Println(fmt.Sprintf(
+		desc.Text(text.DescKeyWriteJournalRedactSummary),
+		secrets, entries, version))
+}
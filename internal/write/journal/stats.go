@@ -0,0 +1,139 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package journal
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	srcFmt "github.com/ActiveMemory/ctx/internal/cli/journal/core/source/format"
+	"github.com/ActiveMemory/ctx/internal/config/embed/text"
+	"github.com/ActiveMemory/ctx/internal/config/journal"
+	"github.com/ActiveMemory/ctx/internal/config/token"
+	"github.com/ActiveMemory/ctx/internal/entity"
+	"github.com/ActiveMemory/ctx/internal/format"
+)
+
+// StatsTotals prints the totals of a stats report: sessions, turns,
+// average length, token spend, and tool calls.
+//
+// Parameters:
+//   - cmd: Cobra command for output. Nil is a no-op.
+//   - t: the totals bucket
+func StatsTotals(cmd *cobra.Command, t entity.StatsBucket) {
+	if cmd == nil {
+		return
+	}
+	cmd.Println(fmt.Sprintf(
+		desc.Text(text.DescKeyWriteJournalStatsSummary),
+		t.Sessions, t.Turns, srcFmt.Duration(t.AvgDuration), t.AvgTurns,
+	))
+	cmd.Println(fmt.Sprintf(
+		desc.Text(text.DescKeyWriteJournalStatsTokens),
+		format.Tokens(t.TokensIn), format.Tokens(t.TokensOut),
+		format.Tokens(t.Tokens),
+	))
+	cmd.Println(fmt.Sprintf(
+		desc.Text(text.DescKeyWriteJournalStatsTools),
+		t.ToolCalls, t.ToolErrors, t.FailureRate*journal.PercentScale,
+	))
+}
+
+// StatsBreakdown prints one breakdown of a stats report with its
+// keys aligned.
+//
+// Parameters:
+//   - cmd: Cobra command for output. Nil is a no-op.
+//   - title: breakdown label (e.g. "By Week")
+//   - keys: display keys, parallel to buckets
+//   - buckets: the breakdown's buckets
+func StatsBreakdown(
+	cmd *cobra.Command, title string,
+	keys []string, buckets []entity.StatsBucket,
+) {
+	if cmd == nil {
+		return
+	}
+	cmd.Println(fmt.Sprintf(
+		desc.Text(text.DescKeyWriteJournalStatsSection), title,
+	))
+	width := 0
+	for _, k := range keys {
+		width = max(width, len(k))
+	}
+	for i, b := range buckets {
+		cmd.Println(fmt.Sprintf(
+			desc.Text(text.DescKeyWriteJournalStatsRow),
+			width, keys[i], b.Sessions, format.Tokens(b.Tokens),
+			srcFmt.Duration(b.AvgDuration),
+			b.ToolCalls, b.FailureRate*journal.PercentScale,
+		))
+	}
+}
+
+// StatsToolCalls prints the per-tool call counts of a stats report.
+// Prints nothing when no tool was called.
+//
+// Parameters:
+//   - cmd: Cobra command for output. Nil is a no-op.
+//   - title: section label
+//   - calls: per-tool counters, most calls first
+func StatsToolCalls(
+	cmd *cobra.Command, title string, calls []entity.ToolCallStats,
+) {
+	if cmd == nil || len(calls) == 0 {
+		return
+	}
+	cmd.Println(fmt.Sprintf(
+		desc.Text(text.DescKeyWriteJournalStatsSection), title,
+	))
+	width := 0
+	for _, c := range calls {
+		width = max(width, len(c.Name))
+	}
+	for _, c := range calls {
+		cmd.Println(fmt.Sprintf(
+			desc.Text(text.DescKeyWriteJournalStatsToolRow),
+			width, c.Name, c.Calls, c.Errors,
+			c.FailureRate*journal.PercentScale,
+		))
+	}
+}
+
+// StatsJSON prints a stats report as indented JSON.
+//
+// Parameters:
+//   - cmd: Cobra command for output
+//   - st: computed stats
+//
+// Returns:
+//   - error: non-nil only if JSON marshaling fails
+func StatsJSON(cmd *cobra.Command, st entity.SessionStats) error {
+	b, marshalErr := json.MarshalIndent(
+		st, "", token.Space+token.Space,
+	)
+	if marshalErr != nil {
+		return marshalErr
+	}
+	cmd.Println(string(b))
+	return nil
+}
+
+// StatsMarkdown prints a rendered Markdown stats report.
+//
+// Parameters:
+//   - cmd: Cobra command for output. Nil is a no-op.
+//   - content: the rendered report
+func StatsMarkdown(cmd *cobra.Command, content string) {
+	if cmd == nil {
+		return
+	}
+	cmd.Print(content)
+}
@@ -0,0 +1,14 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package mine provides terminal output for the ctx mine commands
+// (ctx mine, mine review, mine accept/reject).
+//
+// The run prints a one-line summary pointing at the review file;
+// the review renders each pending candidate's id, kind, title,
+// source commit, and the signals that proposed it; the
+// dispositions confirm each accepted or rejected candidate.
+package mine
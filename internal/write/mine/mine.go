@@ -0,0 +1,110 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package mine
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	"github.com/ActiveMemory/ctx/internal/config/embed/text"
+	cfgEntry "github.com/ActiveMemory/ctx/internal/config/entry"
+	cfgToken "github.com/ActiveMemory/ctx/internal/config/token"
+	engine "github.com/ActiveMemory/ctx/internal/mine"
+)
+
+// Summary prints the post-run summary.
+//
+// Parameters:
+//   - cmd: cobra command for output
+//   - mined: commits mined this run
+//   - proposed: new candidates this run
+//   - path: the review file
+func Summary(cmd *cobra.Command, mined, proposed int, path string) {
+	if cmd == nil {
+		return
+	}
+	if proposed == 0 {
+		cmd.Println(fmt.Sprintf(
+			desc.Text(text.DescKeyWriteMineNothing), mined,
+		))
+		return
+	}
+	cmd.Println(fmt.Sprintf(
+		desc.Text(text.DescKeyWriteMineSummary), mined, proposed, path,
+	))
+}
+
+// ReviewNone prints the no-pending-candidates review message.
+//
+// Parameters:
+//   - cmd: cobra command for output
+func ReviewNone(cmd *cobra.Command) {
+	if cmd == nil {
+		return
+	}
+	cmd.Println(desc.Text(text.DescKeyWriteMineReviewNone))
+}
+
+// Review renders the pending candidates: a header with the count
+// and review file, then each candidate's id, kind, title, source
+// commit, and signals.
+//
+// Parameters:
+//   - cmd: cobra command for output
+//   - pending: the candidates awaiting review
+//   - path: the review file
+func Review(cmd *cobra.Command, pending []engine.Candidate, path string) {
+	if cmd == nil {
+		return
+	}
+	cmd.Println(fmt.Sprintf(
+		desc.Text(text.DescKeyWriteMineReviewHeader), len(pending), path,
+	))
+	for _, c := range pending {
+		cmd.Println(fmt.Sprintf(
+			desc.Text(text.DescKeyWriteMineReviewItem), c.ID, c.Kind, c.Title,
+		))
+		cmd.Println(fmt.Sprintf(
+			desc.Text(text.DescKeyWriteMineReviewSource),
+			c.Commit, c.Date, c.Author,
+		))
+		cmd.Println(fmt.Sprintf(
+			desc.Text(text.DescKeyWriteMineReviewSignals),
+			strings.Join(c.Signals, cfgToken.CommaSpace),
+		))
+	}
+}
+
+// Accepted confirms a candidate written to its context file.
+//
+// Parameters:
+//   - cmd: cobra command for output
+//   - c: the accepted candidate
+func Accepted(cmd *cobra.Command, c engine.Candidate) {
+	if cmd == nil {
+		return
+	}
+	cmd.Println(fmt.Sprintf(
+		desc.Text(text.DescKeyWriteMineAccepted),
+		c.ID, cfgEntry.MustCtxFile(c.Kind), c.Title,
+	))
+}
+
+// Rejected confirms a discarded candidate.
+//
+// Parameters:
+//   - cmd: cobra command for output
+//   - c: the rejected candidate
+func Rejected(cmd *cobra.Command, c engine.Candidate) {
+	if cmd == nil {
+		return
+	}
+	cmd.Println(fmt.Sprintf(desc.Text(text.DescKeyWriteMineRejected), c.ID))
+}
@@ -6,7 +6,7 @@
 
 // Package remind provides terminal output for the
 // session reminder commands (ctx remind add, list,
-// dismiss).
+// dismiss, snooze).
 //
 // Reminders are short messages attached to a session
 // with an optional date gate. The output functions
@@ -21,8 +21,9 @@
 // # Listing
 //
 // [Item] renders a single reminder with its ID,
-// message, and a "not yet due" annotation when the
-// gate date is in the future relative to today.
+// message, a "not yet due" annotation when its next
+// firing date is in the future relative to today, and
+// any recurrence, branch, or snooze notes.
 // [None] handles the empty-list case.
 //
 // # Dismissing
@@ -30,6 +31,13 @@
 // [Dismissed] confirms removal of a single reminder
 // by ID and message. [DismissedAll] reports bulk
 // dismissal with a count of removed items.
+// [Rescheduled] confirms that a dismissed recurring
+// reminder was kept for its next occurrence.
+//
+// # Snoozing
+//
+// [Snoozed] confirms that a reminder is hidden until
+// the given time.
 //
 // # Maintenance
 //
@@ -22,7 +22,10 @@ import (
 //   - id: reminder ID.
 //   - message: reminder text.
 //   - after: optional date gate (nil if none).
-func Added(cmd *cobra.Command, id int, message string, after *string) {
+//   - notes: schedule annotations appended after the date gate.
+func Added(
+	cmd *cobra.Command, id int, message string, after *string, notes string,
+) {
 	if cmd == nil {
 		return
 	}
@@ -32,7 +35,7 @@ func Added(cmd *cobra.Command, id int, message string, after *string) {
 	}
 	cmd.Println(fmt.Sprintf(
 		desc.Text(text.DescKeyWriteReminderAdded),
-		id, message, suffix))
+		id, message, suffix+notes))
 }
 
 // Item prints a single reminder in the list.
@@ -41,25 +44,27 @@ func Added(cmd *cobra.Command, id int, message string, after *string) {
 //   - cmd: Cobra command for output. Nil is a no-op.
 //   - id: reminder ID.
 //   - message: reminder text.
-//   - after: optional date gate (nil if none).
+//   - next: date the reminder next fires (empty if due now).
+//   - notes: schedule annotations (recurrence, branch, snooze).
 //   - today: current date in YYYY-MM-DD format.
 func Item(
 	cmd *cobra.Command,
 	id int,
 	message string,
-	after *string,
+	next string,
+	notes string,
 	today string,
 ) {
 	if cmd == nil {
 		return
 	}
 	annotation := ""
-	if after != nil && *after > today {
-		annotation = fmt.Sprintf(desc.Text(text.DescKeyWriteReminderNotDue), *after)
+	if next > today {
+		annotation = fmt.Sprintf(desc.Text(text.DescKeyWriteReminderNotDue), next)
 	}
 	cmd.Println(fmt.Sprintf(
 		desc.Text(text.DescKeyWriteReminderItem),
-		id, message, annotation))
+		id, message, annotation+notes))
 }
 
 // Dismissed prints the confirmation for a dismissed reminder.
@@ -77,6 +82,39 @@ func Dismissed(cmd *cobra.Command, id int, message string) {
 		id, message))
 }
 
+// Rescheduled prints the confirmation for a dismissed recurring
+// reminder that was kept for its next occurrence.
+//
+// Parameters:
+//   - cmd: Cobra command for output. Nil is a no-op.
+//   - id: reminder ID.
+//   - message: reminder text.
+//   - next: date of the next occurrence.
+func Rescheduled(cmd *cobra.Command, id int, message, next string) {
+	if cmd == nil {
+		return
+	}
+	cmd.Println(fmt.Sprintf(
+		desc.Text(text.DescKeyWriteReminderRescheduled),
+		id, message, next))
+}
+
+// Snoozed prints the confirmation for a snoozed reminder.
+//
+// Parameters:
+//   - cmd: Cobra command for output. Nil is a no-op.
+//   - id: reminder ID.
+//   - message: reminder text.
+//   - until: when the reminder shows again, formatted.
+func Snoozed(cmd *cobra.Command, id int, message, until string) {
+	if cmd == nil {
+		return
+	}
+	cmd.Println(fmt.Sprintf(
+		desc.Text(text.DescKeyWriteReminderSnoozed),
+		id, message, until))
+}
+
 // None prints the message when there are no reminders.
 //
 // Parameters:
@@ -0,0 +1,14 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package search renders the output of the `ctx search` command: ranked
+// matches from the context search index, either as a heading line per match
+// (path:line, type, title, score) followed by an indented snippet, or as a
+// JSON array of hits (--json).
+//
+// It is the write-side counterpart to [internal/search]: the index ranks,
+// this package emits.
+package search
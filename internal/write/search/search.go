@@ -0,0 +1,73 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package search
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	"github.com/ActiveMemory/ctx/internal/config/embed/text"
+	"github.com/ActiveMemory/ctx/internal/config/token"
+	"github.com/ActiveMemory/ctx/internal/entity"
+)
+
+// Hits prints one heading line per match, best first, with
+// its snippet indented underneath.
+//
+// Parameters:
+//   - cmd: Cobra command for the output stream.
+//   - hits: Ranked matches.
+func Hits(cmd *cobra.Command, hits []entity.SearchHit) {
+	for _, h := range hits {
+		cmd.Println(fmt.Sprintf(
+			desc.Text(text.DescKeyWriteSearchHit),
+			h.Path, h.Line, h.Type, h.Title, h.Score,
+		))
+		if h.Snippet != "" {
+			cmd.Println(fmt.Sprintf(
+				desc.Text(text.DescKeyWriteSearchSnippet), h.Snippet,
+			))
+		}
+	}
+}
+
+// None reports a search without matches.
+//
+// Parameters:
+//   - cmd: Cobra command for the output stream.
+//   - query: The query text as given.
+func None(cmd *cobra.Command, query string) {
+	cmd.Println(fmt.Sprintf(
+		desc.Text(text.DescKeyWriteSearchNone), query,
+	))
+}
+
+// JSON prints the matches as a JSON array.
+//
+// A search with no matches yields "[]", not "null", so consumers can parse
+// unconditionally.
+//
+// Parameters:
+//   - cmd: Cobra command for the output stream.
+//   - hits: Ranked matches.
+//
+// Returns:
+//   - error: Non-nil only if JSON marshaling fails.
+func JSON(cmd *cobra.Command, hits []entity.SearchHit) error {
+	if hits == nil {
+		hits = []entity.SearchHit{}
+	}
+	b, err := json.MarshalIndent(hits, "", token.Space+token.Space)
+	if err != nil {
+		return err
+	}
+	cmd.Println(string(b))
+	return nil
+}
@@ -14,6 +14,7 @@ import (
 
 	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
 	"github.com/ActiveMemory/ctx/internal/config/embed/text"
+	cfgTransport "github.com/ActiveMemory/ctx/internal/config/mcp/transport"
 )
 
 // HubStarted prints the hub server address.
@@ -27,6 +28,17 @@ func HubStarted(cmd *cobra.Command, addr net.Addr) {
 	))
 }
 
+// AdminStarted prints the admin gateway address.
+//
+// Parameters:
+//   - cmd: Cobra command for output
+//   - addr: network address the gateway is listening on
+func AdminStarted(cmd *cobra.Command, addr net.Addr) {
+	cmd.Println(fmt.Sprintf(
+		desc.Text(text.DescKeyWriteServeAdminStarted), addr,
+	))
+}
+
 // AdminToken prints the generated admin token.
 //
 // Parameters:
@@ -59,3 +71,26 @@ func Stopped(cmd *cobra.Command, pid int) {
 		desc.Text(text.DescKeyWriteServeHubStopped), pid,
 	))
 }
+
+// MCPStarted prints the MCP Streamable HTTP endpoint.
+//
+// Parameters:
+//   - cmd: Cobra command for output
+//   - addr: network address the server is listening on
+func MCPStarted(cmd *cobra.Command, addr net.Addr) {
+	cmd.Println(fmt.Sprintf(
+		desc.Text(text.DescKeyWriteServeMCPStarted),
+		addr, cfgTransport.Path,
+	))
+}
+
+// MCPToken prints the generated MCP bearer token.
+//
+// Parameters:
+//   - cmd: Cobra command for output
+//   - token: the generated bearer token
+func MCPToken(cmd *cobra.Command, token string) {
+	cmd.Println(fmt.Sprintf(
+		desc.Text(text.DescKeyWriteServeMCPToken), token,
+	))
+}
@@ -0,0 +1,107 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package setup
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	"github.com/ActiveMemory/ctx/internal/config/embed/text"
+)
+
+// BackendConfigured confirms the backend entry written to .ctxrc.
+//
+// Parameters:
+//   - cmd: Cobra command for output. Nil is a no-op.
+//   - name: the backend
+//   - endpoint: the resolved endpoint
+//   - path: the .ctxrc path
+func BackendConfigured(cmd *cobra.Command, name, endpoint, path string) {
+	if cmd == nil {
+		return
+	}
+	cmd.Println(fmt.Sprintf(
+		desc.Text(text.DescKeyWriteSetupBackend), name, endpoint, path,
+	))
+}
+
+// BackendDefault confirms backends.default was set.
+//
+// Parameters:
+//   - cmd: Cobra command for output. Nil is a no-op.
+//   - name: the new default backend
+func BackendDefault(cmd *cobra.Command, name string) {
+	if cmd == nil {
+		return
+	}
+	cmd.Println(fmt.Sprintf(
+		desc.Text(text.DescKeyWriteSetupBackendDefault), name,
+	))
+}
+
+// BackendEnvHint prints the base URL variable downstream tools read.
+//
+// Parameters:
+//   - cmd: Cobra command for output. Nil is a no-op.
+//   - env: the variable name
+//   - endpoint: the backend base URL
+//   - suffix: the API version path appended to the base URL
+func BackendEnvHint(cmd *cobra.Command, env, endpoint, suffix string) {
+	if cmd == nil {
+		return
+	}
+	cmd.Println(fmt.Sprintf(
+		desc.Text(text.DescKeyWriteSetupBackendEnvHint),
+		env, endpoint, suffix,
+	))
+}
+
+// BackendEnvConflict warns that the base URL variable is already set
+// to a different value. ctx never overwrites it.
+//
+// Parameters:
+//   - cmd: Cobra command for output. Nil is a no-op.
+//   - env: the variable name
+//   - current: its current value
+func BackendEnvConflict(cmd *cobra.Command, env, current string) {
+	if cmd == nil {
+		return
+	}
+	cmd.PrintErrln(fmt.Sprintf(
+		desc.Text(text.DescKeyWriteSetupBackendEnvConflict), env, current,
+	))
+}
+
+// BackendKeyUnset reminds the user to export the API key variable.
+//
+// Parameters:
+//   - cmd: Cobra command for output. Nil is a no-op.
+//   - env: the variable name
+func BackendKeyUnset(cmd *cobra.Command, env string) {
+	if cmd == nil {
+		return
+	}
+	cmd.Println(fmt.Sprintf(
+		desc.Text(text.DescKeyWriteSetupBackendKeyUnset), env,
+	))
+}
+
+// BackendNext suggests the follow-up reachability check.
+//
+// Parameters:
+//   - cmd: Cobra command for output. Nil is a no-op.
+//   - name: the backend
+func BackendNext(cmd *cobra.Command, name string) {
+	if cmd == nil {
+		return
+	}
+	cmd.Println(fmt.Sprintf(
+		desc.Text(text.DescKeyWriteSetupBackendNext), name,
+	))
+}
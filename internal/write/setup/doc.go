@@ -30,6 +30,15 @@
 // printed by [InfoCursorIntegration],
 // [InfoKiroIntegration], and [InfoClineIntegration].
 //
+// # AI Backend
+//
+// ctx setup --backend reports the .ctxrc entry it
+// wrote with [BackendConfigured] and [BackendDefault],
+// the downstream base URL with [BackendEnvHint] (or
+// [BackendEnvConflict] when the variable is already
+// set elsewhere), [BackendKeyUnset] for a missing
+// credential variable, and [BackendNext].
+//
 // # Hook Runtime
 //
 // [Nudge] emits a single-line relay directive.
@@ -0,0 +1,37 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package site
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	"github.com/ActiveMemory/ctx/internal/config/embed/text"
+)
+
+// Built reports a finished static site build.
+//
+// Parameters:
+//   - cmd: Cobra command for output
+//   - pages: number of pages rendered
+//   - dir: output directory
+func Built(cmd *cobra.Command, pages int, dir string) {
+	cmd.Println(fmt.Sprintf(desc.Text(text.DescKeySiteBuilt), pages, dir))
+}
+
+// Serving reports that a site is being served.
+//
+// Parameters:
+//   - cmd: Cobra command for output
+//   - dir: directory being served
+//   - addr: bound listen address
+func Serving(cmd *cobra.Command, dir string, addr net.Addr) {
+	cmd.Println(fmt.Sprintf(desc.Text(text.DescKeySiteServing), dir, addr))
+}
@@ -5,7 +5,8 @@
 //                 SPDX-License-Identifier: Apache-2.0
 
 // Package site provides terminal output for the site
-// feed generation command (ctx site feed).
+// feed generation command (ctx site feed) and the
+// built-in static site renderer.
 //
 // The site command generates RSS/Atom feeds from
 // journal entries and blog posts in the context
@@ -28,4 +29,11 @@
 // that carries pre-computed counts and message
 // lists so the output function contains no
 // business logic.
+//
+// # Static Sites
+//
+// [Built] reports a finished build of a journal or kb
+// site with its page count and output directory.
+// [Serving] reports the address `ctx serve`, `ctx journal
+// site --serve`, and `ctx kb site --serve` listen on.
 package site
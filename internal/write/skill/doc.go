@@ -5,7 +5,8 @@
 //                 SPDX-License-Identifier: Apache-2.0
 
 // Package skill provides terminal output for the skill
-// management commands (ctx skill install, list, remove).
+// management commands (ctx skill install, list, remove,
+// update, verify).
 //
 // Skills are reusable prompt templates that extend
 // agent capabilities. The output functions cover
@@ -30,4 +31,11 @@
 //
 // [Removed] prints a confirmation that the named
 // skill was removed from the skills directory.
+//
+// # Lockfile Checks
+//
+// [Status] prints one skill's outcome from ctx skill
+// verify or ctx skill update, with optional detail.
+// [Verified] and [Updated] print the summaries, and
+// [NoLock] handles an empty skills.lock.
 package skill
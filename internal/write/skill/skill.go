@@ -76,3 +76,49 @@ func Removed(cmd *cobra.Command, name string) {
 	cmd.Println(fmt.Sprintf(
 		desc.Text(text.DescKeyWriteSkillRemoved), name))
 }
+
+// Status prints one skill's verify or update outcome.
+//
+// Parameters:
+//   - cmd: Cobra command for output
+//   - state: Outcome (ok, modified, updated, ...)
+//   - name: Skill name
+//   - detail: Extra context, or empty
+func Status(cmd *cobra.Command, state, name, detail string) {
+	suffix := ""
+	if detail != "" {
+		suffix = fmt.Sprintf(
+			desc.Text(text.DescKeyWriteSkillStatusDetail), detail)
+	}
+	cmd.Println(fmt.Sprintf(
+		desc.Text(text.DescKeyWriteSkillStatus), state, name, suffix))
+}
+
+// Verified prints the verify summary.
+//
+// Parameters:
+//   - cmd: Cobra command for output
+//   - count: Number of skills checked
+func Verified(cmd *cobra.Command, count int) {
+	cmd.Println(fmt.Sprintf(
+		desc.Text(text.DescKeyWriteSkillVerified), count))
+}
+
+// Updated prints the update summary.
+//
+// Parameters:
+//   - cmd: Cobra command for output
+//   - updated: Number of skills reinstalled
+//   - total: Number of skills considered
+func Updated(cmd *cobra.Command, updated, total int) {
+	cmd.Println(fmt.Sprintf(
+		desc.Text(text.DescKeyWriteSkillUpdated), updated, total))
+}
+
+// NoLock prints a message indicating skills.lock has no entries.
+//
+// Parameters:
+//   - cmd: Cobra command for output
+func NoLock(cmd *cobra.Command) {
+	cmd.Println(desc.Text(text.DescKeyWriteSkillNoLock))
+}
@@ -0,0 +1,13 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package task prints output for ctx task subcommands that
+// is not tied to archiving or completion.
+//
+// [Graph] prints the rendered dependency graph as-is, so it
+// can be piped straight into Graphviz or pasted into a
+// Mermaid block.
+package task
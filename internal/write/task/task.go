@@ -0,0 +1,23 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package task
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// Graph prints a rendered task dependency graph.
+//
+// Parameters:
+//   - cmd: Cobra command for output. Nil is a no-op.
+//   - source: DOT or Mermaid source, newline-terminated.
+func Graph(cmd *cobra.Command, source string) {
+	if cmd == nil {
+		return
+	}
+	cmd.Print(source)
+}
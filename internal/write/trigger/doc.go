@@ -23,6 +23,8 @@
 // [TypeHeader] prints a section header for each
 // hook type. [Entry] prints a single hook with
 // its name, enabled/disabled status, and path.
+// [Matcher] prints one hooks.yaml matcher, timeout,
+// or priority beneath it.
 // [Count] prints the total hook count.
 // [NoHooksFound] handles the empty-list case.
 // [BlankLine] separates sections visually.
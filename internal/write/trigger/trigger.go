@@ -77,6 +77,19 @@ func Entry(cmd *cobra.Command, name, status, path string) {
 	))
 }
 
+// Matcher prints one hooks.yaml matcher, timeout, or priority
+// under a hook entry.
+//
+// Parameters:
+//   - cmd: Cobra command for output
+//   - label: Manifest key (tools, paths, ...)
+//   - value: Rendered value
+func Matcher(cmd *cobra.Command, label, value string) {
+	cmd.Println(fmt.Sprintf(
+		desc.Text(text.DescKeyWriteTriggerMatcher), label, value,
+	))
+}
+
 // BlankLine prints a blank line. Nil cmd is a no-op.
 //
 // Parameters: